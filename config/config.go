@@ -38,6 +38,14 @@ type App struct {
 	LogLevel    string        `env:"LOG_LEVEL" envDefault:"debug"`
 	ServerPort  int           `env:"SERVER_PORT" envDefault:"9091"`
 	ReadTimeout time.Duration `env:"SERVER_READ_TIMEOUT" envDefault:"500s"`
+	// ScheduledPostingInterval is how often due scheduled expenditures are posted
+	ScheduledPostingInterval time.Duration `env:"SCHEDULED_POSTING_INTERVAL" envDefault:"1m"`
+	// RecurringBillsInterval is how often the due auto-post recurring bills are generated
+	RecurringBillsInterval time.Duration `env:"RECURRING_BILLS_INTERVAL" envDefault:"1h"`
+	// DuplicateWindowDays is how many days around a new expenditure are searched for duplicates of it
+	DuplicateWindowDays int `env:"DUPLICATE_WINDOW_DAYS" envDefault:"3"`
+	// InvitationTTL is how long the token of a household invitation can be used
+	InvitationTTL time.Duration `env:"INVITATION_TTL" envDefault:"168h"`
 }
 
 // Add MySQL configuration
@@ -780,6 +780,78 @@ func (s *Suite) TestAccount() {
 		},
 	)
 
+	s.Run(
+		"List accounts sorted by current balance descending",
+		func() {
+			apiResponse := s.listAccountRequest(
+				openapi.ListAccountsParams{
+					Limit:  utils.IntPtr(5),
+					Offset: utils.IntPtr(0),
+					Sort:   utils.StringPtr("-currentBalance,name"),
+				},
+			)
+
+			var accounts openapi.AccountList
+			s.decodeResponse(
+				apiResponse,
+				&accounts,
+			)
+
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				"-currentBalance,name",
+				*accounts.Metadata.Sort,
+			)
+			for i := 1; i < len(*accounts.Accounts); i++ {
+				s.GreaterOrEqual(
+					(*accounts.Accounts)[i-1].CurrentBalance,
+					(*accounts.Accounts)[i].CurrentBalance,
+				)
+			}
+		},
+	)
+
+	s.Run(
+		"List accounts with an invalid sort key",
+		func() {
+			apiResponse := s.listAccountRequest(
+				openapi.ListAccountsParams{
+					Limit:  utils.IntPtr(5),
+					Offset: utils.IntPtr(0),
+					Sort:   utils.StringPtr("-password"),
+				},
+			)
+
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrInvalidSortKey.Error()+": password",
+			)
+		},
+	)
+
+	s.Run(
+		"List accounts with a duplicated sort key",
+		func() {
+			apiResponse := s.listAccountRequest(
+				openapi.ListAccountsParams{
+					Limit:  utils.IntPtr(5),
+					Offset: utils.IntPtr(0),
+					Sort:   utils.StringPtr("name,-name"),
+				},
+			)
+
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrDuplicateSortKey.Error()+": name",
+			)
+		},
+	)
+
 	s.Run(
 		"List accounts with currency filter",
 		func() {
@@ -1066,7 +1138,7 @@ func (s *Suite) makeAccountRequest(account *openapi.AccountRequest) (
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 
 	return client.Do(req)
 }
@@ -1093,7 +1165,7 @@ func (s *Suite) updateAccountRequest(account *openapi.Account) (
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 
 	return client.Do(req)
 }
@@ -1116,7 +1188,7 @@ func (s *Suite) deleteAccountRequest(id string) (
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 
 	return client.Do(req)
 }
@@ -1143,7 +1215,7 @@ func (s *Suite) checkCanDeleteAccount(id string) (
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 
 	return client.Do(req)
 }
@@ -1170,7 +1242,7 @@ func (s *Suite) deactivateAccountRequest(id string) (
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 
 	return client.Do(req)
 }
@@ -1197,7 +1269,7 @@ func (s *Suite) activateAccountRequest(id string) (
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 
 	return client.Do(req)
 }
@@ -1216,7 +1288,7 @@ func (s *Suite) getAccountRequest(id string) (
 		return nil, err
 	}
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 
 	if err != nil {
@@ -1269,9 +1341,15 @@ func (s *Suite) listAccountRequest(params openapi.ListAccountsParams) *http.Resp
 			strconv.FormatBool(*params.Active),
 		)
 	}
+	if params.Sort != nil {
+		q.Set(
+			"sort",
+			*params.Sort,
+		)
+	}
 	req.URL.RawQuery = q.Encode()
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -0,0 +1,281 @@
+package integration_test
+
+import (
+	"net/http"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestAllocationPlans() {
+	s.T().Log("Starting TestAllocationPlans")
+
+	// Other tests spend in currency 150, so the plans use their own currency
+	const currency = "140"
+
+	testMember := s.createTestHouseholdMember()
+	account := s.createTestAccountWithBalance(
+		&testMember,
+		currency,
+		5000,
+	)
+	rent := s.createTestCategory(openapi.CategoryTypeExpenditure)
+	leisure := s.createTestCategory(openapi.CategoryTypeExpenditure)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	s.insertIncomeRecurrence(
+		account.Id,
+		3000,
+	)
+
+	spend := func(
+		category openapi.Category,
+		amount float32,
+	) {
+		expenditureReq := s.createTestExpenditureRequest(
+			&account.Id,
+			&category,
+		)
+		expenditureReq.Amount = amount
+		expenditureReq.Currency = currency
+		expenditureReq.Date = openapitypes.Date{Time: today}
+		expenditureReq.Description = "Allocation plan spending"
+		apiResponse, err := s.createExpenditureRequest(expenditureReq)
+		s.Require().NoError(err)
+		s.Require().Equal(
+			http.StatusCreated,
+			apiResponse.StatusCode,
+		)
+	}
+
+	var plan openapi.AllocationPlan
+
+	s.Run(
+		"Plan shows what is left to be assigned",
+		func() {
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/allocation-plans",
+				openapi.AllocationPlanRequest{
+					Month:    openapitypes.Date{Time: today},
+					Currency: currency,
+					Allocations: []openapi.Allocation{
+						{
+							CategoryId: &rent.Id,
+							Amount:     2000,
+						},
+					},
+				},
+			)
+			s.decodeResponse(
+				apiResponse,
+				&plan,
+			)
+
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				domain.MonthStart(today).Format(time.DateOnly),
+				plan.Month.Format(time.DateOnly),
+			)
+			s.Equal(
+				openapi.Expected,
+				plan.IncomeBasis,
+			)
+			s.Equal(
+				float32(3000),
+				plan.ExpectedIncome,
+			)
+			s.Equal(
+				float32(2000),
+				plan.Allocated,
+			)
+			s.Equal(
+				float32(1000),
+				plan.ToBeAssigned,
+			)
+		},
+	)
+
+	s.Run(
+		"Plan is compared with the actual spending",
+		func() {
+			spend(
+				rent,
+				150,
+			)
+			spend(
+				leisure,
+				20,
+			)
+
+			apiResponse := s.apiRequest(
+				http.MethodGet,
+				"/allocation-plans/"+plan.Id,
+				nil,
+			)
+			var got openapi.AllocationPlan
+			s.decodeResponse(
+				apiResponse,
+				&got,
+			)
+
+			s.Require().Len(
+				got.Allocations,
+				1,
+			)
+			s.Equal(
+				float32(150),
+				got.Allocations[0].Actual,
+			)
+			s.Equal(
+				float32(1850),
+				got.Allocations[0].Difference,
+			)
+			s.Equal(
+				float32(170),
+				got.Spent,
+			)
+			s.Equal(
+				float32(20),
+				got.Unplanned,
+			)
+		},
+	)
+
+	s.Run(
+		"Only one plan per month and currency",
+		func() {
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPost,
+					"/allocation-plans",
+					openapi.AllocationPlanRequest{
+						Month:       openapitypes.Date{Time: today},
+						Currency:    currency,
+						Allocations: []openapi.Allocation{},
+					},
+				),
+				http.StatusConflict,
+				domain.ErrAllocationPlanExists.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Allocations cannot exceed the income",
+		func() {
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPut,
+					"/allocation-plans/"+plan.Id,
+					openapi.AllocationPlanUpdateRequest{
+						Allocations: []openapi.Allocation{
+							{
+								CategoryId: &rent.Id,
+								Amount:     2000,
+							},
+							{
+								CategoryId: &leisure.Id,
+								Amount:     1500,
+							},
+						},
+					},
+				),
+				http.StatusBadRequest,
+				domain.ErrAllocationsExceedIncome.Error(),
+			)
+
+			// Nothing was received yet
+			incomeBasis := openapi.Actual
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPut,
+					"/allocation-plans/"+plan.Id,
+					openapi.AllocationPlanUpdateRequest{
+						IncomeBasis: &incomeBasis,
+						Allocations: []openapi.Allocation{
+							{
+								CategoryId: &rent.Id,
+								Amount:     100,
+							},
+						},
+					},
+				),
+				http.StatusBadRequest,
+				domain.ErrAllocationsExceedIncome.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Categories are allocated once",
+		func() {
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPut,
+					"/allocation-plans/"+plan.Id,
+					openapi.AllocationPlanUpdateRequest{
+						Allocations: []openapi.Allocation{
+							{
+								CategoryId: &rent.Id,
+								Amount:     100,
+							},
+							{
+								CategoryId: &rent.Id,
+								Amount:     200,
+							},
+						},
+					},
+				),
+				http.StatusBadRequest,
+				domain.ErrDuplicateAllocation.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Savings goals must belong to the household",
+		func() {
+			missingGoalID := "999999999"
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPut,
+					"/allocation-plans/"+plan.Id,
+					openapi.AllocationPlanUpdateRequest{
+						Allocations: []openapi.Allocation{
+							{
+								SavingsGoalId: &missingGoalID,
+								Amount:        100,
+							},
+						},
+					},
+				),
+				http.StatusBadRequest,
+				domain.ErrSavingsGoalNotFound.Error(),
+			)
+		},
+	)
+}
+
+// insertIncomeRecurrence stores a monthly ingress recurrence pattern, ingresses can't be created
+// through the API yet
+func (s *Suite) insertIncomeRecurrence(
+	accountID string,
+	amount float32,
+) {
+	_, err := s.db.ExecContext(
+		s.ctx,
+		`INSERT INTO ingress_recurrence_patterns (frequency, interval_value, amount, to_account_id, description)
+		 VALUES ('monthly', 1, ?, ?, 'Salary')`,
+		amount,
+		accountID,
+	)
+	s.handleErr(
+		err,
+		"error while inserting ingress recurrence pattern",
+	)
+}
@@ -0,0 +1,352 @@
+package integration_test
+
+import (
+	"net/http"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestBudgets() {
+	s.T().Log("Starting TestBudgets")
+
+	testMember := s.createTestHouseholdMember()
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	monthStart := time.Date(
+		today.Year(),
+		today.Month(),
+		1,
+		0,
+		0,
+		0,
+		0,
+		time.UTC,
+	)
+
+	createBudget := func(request openapi.BudgetRequest) (
+		*http.Response,
+		openapi.Budget,
+	) {
+		apiResponse := s.apiRequest(
+			http.MethodPost,
+			"/budgets",
+			request,
+		)
+		var budget openapi.Budget
+		if apiResponse.StatusCode == http.StatusCreated {
+			s.decodeResponse(
+				apiResponse,
+				&budget,
+			)
+		}
+
+		return apiResponse, budget
+	}
+
+	s.Run(
+		"Status adds up the expenditures of the current period",
+		func() {
+			category := s.createTestCategory(openapi.CategoryTypeExpenditure)
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				1000,
+			)
+
+			apiResponse, budget := createBudget(
+				openapi.BudgetRequest{
+					CategoryId: category.Id,
+					Amount:     200,
+					Currency:   "150",
+					PeriodType: openapi.BudgetPeriodTypeMonthly,
+					StartDate:  openapitypes.Date{Time: monthStart},
+				},
+			)
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.True(budget.Active)
+
+			// Spending in a subcategory counts toward the budget of its parent
+			categoryType := openapi.CategoryTypeExpenditure
+			apiResponse = s.createCategory(
+				&openapi.CategoryRequest{
+					Name:         category.Name + " subcategory",
+					Description:  "Subcategory of a budgeted category",
+					CategoryType: &categoryType,
+					ParentId:     &category.Id,
+				},
+			)
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			var subcategory openapi.Category
+			s.decodeResponse(
+				apiResponse,
+				&subcategory,
+			)
+
+			for _, spending := range []struct {
+				category openapi.Category
+				amount   float32
+			}{
+				{category, 50},
+				{category, 30},
+				{subcategory, 20},
+			} {
+				expenditureReq := s.createTestExpenditureRequest(
+					&account.Id,
+					&spending.category,
+				)
+				expenditureReq.Amount = spending.amount
+				expenditureReq.Date = openapitypes.Date{Time: today}
+				expenditureReq.Description = "Groceries"
+				expenditureResponse, err := s.createExpenditureRequest(expenditureReq)
+				s.Require().NoError(err)
+				s.Require().Equal(
+					http.StatusCreated,
+					expenditureResponse.StatusCode,
+				)
+			}
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/budgets/"+budget.Id+"/status?date="+today.Format(time.DateOnly),
+				nil,
+			)
+			var status openapi.BudgetStatus
+			s.decodeResponse(
+				apiResponse,
+				&status,
+			)
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				float32(100),
+				status.Spent,
+			)
+			s.Equal(
+				float32(100),
+				status.Remaining,
+			)
+			s.Equal(
+				float32(50),
+				status.PercentUsed,
+			)
+			s.Equal(
+				monthStart.Format(time.DateOnly),
+				status.Period.Start.Format(time.DateOnly),
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/budget-overview?date="+today.Format(time.DateOnly),
+				nil,
+			)
+			var overview openapi.BudgetOverview
+			s.decodeResponse(
+				apiResponse,
+				&overview,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.NotEmpty(overview.Budgets)
+			s.Require().NotEmpty(overview.Totals)
+			s.Equal(
+				"150",
+				overview.Totals[0].Currency,
+			)
+		},
+	)
+
+	s.Run(
+		"Custom periods need a length",
+		func() {
+			category := s.createTestCategory(openapi.CategoryTypeExpenditure)
+
+			apiResponse, _ := createBudget(
+				openapi.BudgetRequest{
+					CategoryId: category.Id,
+					Amount:     100,
+					Currency:   "150",
+					PeriodType: openapi.BudgetPeriodTypeCustom,
+					StartDate:  openapitypes.Date{Time: monthStart},
+				},
+			)
+
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrInvalidBudgetPeriodDays.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Status before the budget starts is rejected",
+		func() {
+			category := s.createTestCategory(openapi.CategoryTypeExpenditure)
+
+			_, budget := createBudget(
+				openapi.BudgetRequest{
+					CategoryId: category.Id,
+					Amount:     100,
+					Currency:   "150",
+					PeriodType: openapi.BudgetPeriodTypeWeekly,
+					StartDate:  openapitypes.Date{Time: today},
+				},
+			)
+
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodGet,
+					"/budgets/"+budget.Id+"/status?date="+today.AddDate(
+						0,
+						0,
+						-1,
+					).Format(time.DateOnly),
+					nil,
+				),
+				http.StatusBadRequest,
+				domain.ErrBudgetOutOfRange.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Unspent amounts roll over and periods can be planned apart",
+		func() {
+			category := s.createTestCategory(openapi.CategoryTypeExpenditure)
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				1000,
+			)
+			lastMonthStart := monthStart.AddDate(
+				0,
+				-1,
+				0,
+			)
+			rollover := openapi.BudgetRolloverUnspent
+
+			_, budget := createBudget(
+				openapi.BudgetRequest{
+					CategoryId: category.Id,
+					Amount:     100,
+					Currency:   "150",
+					PeriodType: openapi.BudgetPeriodTypeMonthly,
+					Rollover:   &rollover,
+					StartDate:  openapitypes.Date{Time: lastMonthStart},
+				},
+			)
+			s.Equal(
+				openapi.BudgetRolloverUnspent,
+				budget.Rollover,
+			)
+
+			expenditureReq := s.createTestExpenditureRequest(
+				&account.Id,
+				&category,
+			)
+			expenditureReq.Amount = 40
+			expenditureReq.Date = openapitypes.Date{Time: lastMonthStart}
+			expenditureReq.Description = "Books"
+			expenditureResponse, err := s.createExpenditureRequest(expenditureReq)
+			s.Require().NoError(err)
+			s.Require().Equal(
+				http.StatusCreated,
+				expenditureResponse.StatusCode,
+			)
+
+			apiResponse := s.apiRequest(
+				http.MethodGet,
+				"/budgets/"+budget.Id+"/history?date="+today.Format(time.DateOnly),
+				nil,
+			)
+			var history openapi.BudgetHistory
+			s.decodeResponse(
+				apiResponse,
+				&history,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Require().Len(
+				history.Periods,
+				2,
+			)
+			s.Equal(
+				float32(60),
+				history.Periods[0].Remaining,
+			)
+			s.Equal(
+				float32(60),
+				history.Periods[1].Carried,
+			)
+			s.Equal(
+				float32(160),
+				history.Periods[1].Available,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodPut,
+				"/budgets/"+budget.Id+"/periods/1",
+				openapi.BudgetPeriodAmountRequest{Amount: 150},
+			)
+			var status openapi.BudgetStatus
+			s.decodeResponse(
+				apiResponse,
+				&status,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				float32(150),
+				status.Budgeted,
+			)
+			s.Equal(
+				float32(210),
+				status.Available,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodPost,
+				"/budget-overview/copy-last-period?date="+today.Format(time.DateOnly),
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/budgets/"+budget.Id+"/status?date="+today.Format(time.DateOnly),
+				nil,
+			)
+			s.decodeResponse(
+				apiResponse,
+				&status,
+			)
+			s.Equal(
+				float32(100),
+				status.Budgeted,
+			)
+			s.Equal(
+				float32(160),
+				status.Available,
+			)
+		},
+	)
+}
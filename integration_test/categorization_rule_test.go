@@ -0,0 +1,397 @@
+package integration_test
+
+import (
+	"net/http"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/integration_test/utils"
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestCategorizationRules() {
+	s.T().Log("Starting TestCategorizationRules")
+
+	testMember := s.createTestHouseholdMember()
+	account := s.createTestAccountWithBalance(
+		&testMember,
+		"150",
+		1000,
+	)
+	catchAll := s.createTestCategory(openapi.CategoryTypeExpenditure)
+	streaming := s.createTestCategory(openapi.CategoryTypeExpenditure)
+
+	apiResponse, err := s.createTag(
+		&openapi.TagRequest{
+			Name:    "Subscriptions",
+			TagType: openapi.TagTypeExpenditure,
+		},
+	)
+	s.handleErr(
+		err,
+		"error while creating tag",
+	)
+	s.Require().Equal(
+		http.StatusCreated,
+		apiResponse.StatusCode,
+	)
+	var subscriptions openapi.Tag
+	s.decodeResponse(
+		apiResponse,
+		&subscriptions,
+	)
+
+	createExpenditure := func(
+		category *openapi.Category,
+		description string,
+		amount float32,
+	) openapi.Expenditure {
+		expenditureReq := s.createTestExpenditureRequest(
+			&account.Id,
+			&catchAll,
+		)
+		if category == nil {
+			expenditureReq.Category = nil
+		} else {
+			expenditureReq.Category = category
+		}
+		expenditureReq.Amount = amount
+		expenditureReq.Date = openapitypes.Date{Time: time.Now().UTC().Truncate(24 * time.Hour)}
+		expenditureReq.Description = description
+		apiResponse, errCreate := s.createExpenditureRequest(expenditureReq)
+		s.handleErr(
+			errCreate,
+			"error while creating expenditure",
+		)
+		s.Require().Equal(
+			http.StatusCreated,
+			apiResponse.StatusCode,
+		)
+		var expenditure openapi.Expenditure
+		s.decodeResponse(
+			apiResponse,
+			&expenditure,
+		)
+
+		return expenditure
+	}
+
+	streamingRule := openapi.CategorizationRuleRequest{
+		Name: "Streaming",
+		Description: &openapi.DescriptionCondition{
+			Match:   openapi.Contains,
+			Pattern: "netflix",
+		},
+		MaxAmount:  utils.Float32Ptr(20),
+		CategoryId: &streaming.Id,
+		TagIds:     &[]string{subscriptions.Id},
+	}
+
+	var rule openapi.CategorizationRule
+
+	// Created before the rule, it stays in the catch-all category until the rules are applied
+	oldExpenditure := createExpenditure(
+		&catchAll,
+		"NETFLIX.COM monthly",
+		12.99,
+	)
+
+	s.Run(
+		"Rules are tested against past expenditures without changing them",
+		func() {
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/categorization-rules/preview",
+				openapi.CategorizationRulePreviewRequest{
+					Rule:       streamingRule,
+					CategoryId: &catchAll.Id,
+				},
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var run openapi.CategorizationRun
+			s.decodeResponse(
+				apiResponse,
+				&run,
+			)
+			s.Require().Equal(
+				1,
+				run.Total,
+			)
+			s.Equal(
+				oldExpenditure.Id,
+				run.Categorizations[0].ExpenditureId,
+			)
+			s.Require().NotNil(run.Categorizations[0].CategoryId)
+			s.Equal(
+				streaming.Id,
+				*run.Categorizations[0].CategoryId,
+			)
+			s.Equal(
+				catchAll.Id,
+				s.getExpenditure(oldExpenditure.Id).Category.Id,
+			)
+		},
+	)
+
+	s.Run(
+		"Rules categorize new expenditures created without category",
+		func() {
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/categorization-rules",
+				streamingRule,
+			)
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.decodeResponse(
+				apiResponse,
+				&rule,
+			)
+			s.True(rule.Active)
+
+			expenditure := createExpenditure(
+				nil,
+				"Netflix subscription",
+				12.99,
+			)
+			s.Equal(
+				streaming.Id,
+				expenditure.Category.Id,
+			)
+			s.Require().NotNil(expenditure.Tags)
+			s.Require().Len(
+				*expenditure.Tags,
+				1,
+			)
+			s.Equal(
+				subscriptions.Id,
+				(*expenditure.Tags)[0].Id,
+			)
+
+			// A tag given with the expenditure and added by the rule is linked once
+			expenditureReq := s.createTestExpenditureRequest(
+				&account.Id,
+				&catchAll,
+			)
+			expenditureReq.Category = nil
+			expenditureReq.Amount = 12.99
+			expenditureReq.Description = "Netflix family plan"
+			expenditureReq.Tags = &[]openapi.Tag{subscriptions}
+			apiResponse, err := s.createExpenditureRequest(expenditureReq)
+			s.handleErr(
+				err,
+				"error while creating expenditure",
+			)
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			var tagged openapi.Expenditure
+			s.decodeResponse(
+				apiResponse,
+				&tagged,
+			)
+			s.Require().NotNil(tagged.Tags)
+			s.Len(
+				*tagged.Tags,
+				1,
+			)
+
+			// The amount condition is not met, so the category is still required
+			expenditureReq = s.createTestExpenditureRequest(
+				&account.Id,
+				&catchAll,
+			)
+			expenditureReq.Category = nil
+			expenditureReq.Amount = 50
+			expenditureReq.Description = "Netflix gift card"
+			apiResponse, err = s.createExpenditureRequest(expenditureReq)
+			s.handleErr(
+				err,
+				"error while creating expenditure",
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrExpenditureCategoryRequired.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Rules are applied retroactively to the chosen category",
+		func() {
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/categorization-rules/apply",
+				openapi.CategorizationRuleApplyRequest{
+					CategoryId: &catchAll.Id,
+				},
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var run openapi.CategorizationRun
+			s.decodeResponse(
+				apiResponse,
+				&run,
+			)
+			s.Equal(
+				1,
+				run.Total,
+			)
+
+			expenditure := s.getExpenditure(oldExpenditure.Id)
+			s.Equal(
+				streaming.Id,
+				expenditure.Category.Id,
+			)
+			s.Require().NotNil(expenditure.Tags)
+			s.Len(
+				*expenditure.Tags,
+				1,
+			)
+
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPost,
+					"/categorization-rules/apply",
+					openapi.CategorizationRuleApplyRequest{},
+				),
+				http.StatusBadRequest,
+				domain.ErrRuleApplyScopeRequired.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Rules need conditions, actions and an expenditure category",
+		func() {
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPost,
+					"/categorization-rules",
+					openapi.CategorizationRuleRequest{
+						Name:       "No conditions",
+						CategoryId: &streaming.Id,
+					},
+				),
+				http.StatusBadRequest,
+				domain.ErrRuleWithoutConditions.Error(),
+			)
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPost,
+					"/categorization-rules",
+					openapi.CategorizationRuleRequest{
+						Name: "Broken regex",
+						Description: &openapi.DescriptionCondition{
+							Match:   openapi.Regex,
+							Pattern: "netflix(",
+						},
+						CategoryId: &streaming.Id,
+					},
+				),
+				http.StatusBadRequest,
+				domain.ErrInvalidDescriptionPattern.Error(),
+			)
+
+			ingressCategory := s.createTestCategory(openapi.CategoryTypeIngress)
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPost,
+					"/categorization-rules",
+					openapi.CategorizationRuleRequest{
+						Name:       "Salary",
+						MinAmount:  utils.Float32Ptr(1000),
+						CategoryId: &ingressCategory.Id,
+					},
+				),
+				http.StatusBadRequest,
+				domain.ErrRuleCategoryNotExpenses.Error(),
+			)
+
+			// Categories assigned by rules cannot be deleted
+			rent := s.createTestCategory(openapi.CategoryTypeExpenditure)
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/categorization-rules",
+				openapi.CategorizationRuleRequest{
+					Name:       "Rent",
+					MinAmount:  utils.Float32Ptr(500),
+					CategoryId: &rent.Id,
+				},
+			)
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			var rentRule openapi.CategorizationRule
+			s.decodeResponse(
+				apiResponse,
+				&rentRule,
+			)
+			apiResponse, err := s.deleteCategory(rent.Id)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrCategoryUsedInCategorizationRule.Error(),
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodDelete,
+				"/categorization-rules/"+rentRule.Id,
+				nil,
+			)
+			s.Equal(
+				http.StatusNoContent,
+				apiResponse.StatusCode,
+			)
+		},
+	)
+
+	s.Run(
+		"Deleted rules no longer run",
+		func() {
+			apiResponse := s.apiRequest(
+				http.MethodDelete,
+				"/categorization-rules/"+rule.Id,
+				nil,
+			)
+			s.Equal(
+				http.StatusNoContent,
+				apiResponse.StatusCode,
+			)
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodGet,
+					"/categorization-rules/"+rule.Id,
+					nil,
+				),
+				http.StatusNotFound,
+				domain.ErrCategorizationRuleNotFound.Error(),
+			)
+
+			expenditure := createExpenditure(
+				&catchAll,
+				"Netflix subscription",
+				12.99,
+			)
+			s.Equal(
+				catchAll.Id,
+				expenditure.Category.Id,
+			)
+		},
+	)
+}
@@ -0,0 +1,331 @@
+package integration_test
+
+import (
+	"net/http"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/integration_test/utils"
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestCategoryHierarchy() {
+	s.T().Log("Starting TestCategoryHierarchy")
+
+	createSubcategory := func(
+		name string,
+		categoryType openapi.CategoryType,
+		parentID string,
+	) *http.Response {
+		return s.createCategory(
+			&openapi.CategoryRequest{
+				Name:            name,
+				Description:     name + " description",
+				CategoryType:    &categoryType,
+				Color:           utils.StringPtr("#FF0000"),
+				BackgroundColor: utils.StringPtr("#00FF00"),
+				ParentId:        &parentID,
+			},
+		)
+	}
+	createSubcategoryAndReturn := func(
+		name string,
+		parentID string,
+	) openapi.Category {
+		apiResponse := createSubcategory(
+			name,
+			openapi.CategoryTypeExpenditure,
+			parentID,
+		)
+		s.Require().Equal(
+			http.StatusCreated,
+			apiResponse.StatusCode,
+		)
+		var category openapi.Category
+		s.decodeResponse(
+			apiResponse,
+			&category,
+		)
+
+		return category
+	}
+
+	s.Run(
+		"Subcategories are nested under their parent in the tree",
+		func() {
+			parent := s.createTestCategory(openapi.CategoryTypeExpenditure)
+			child := createSubcategoryAndReturn(
+				"Groceries",
+				parent.Id,
+			)
+			s.Require().NotNil(child.ParentId)
+			s.Equal(
+				parent.Id,
+				*child.ParentId,
+			)
+			grandchild := createSubcategoryAndReturn(
+				"Organic groceries",
+				child.Id,
+			)
+
+			apiResponse := s.apiRequest(
+				http.MethodGet,
+				"/categories?tree=true&type=expenditure",
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var categories struct {
+				Categories []openapi.Category `json:"categories"`
+			}
+			s.decodeResponse(
+				apiResponse,
+				&categories,
+			)
+
+			var node *openapi.Category
+			for i := range categories.Categories {
+				s.NotEqual(
+					child.Id,
+					categories.Categories[i].Id,
+					"subcategories are not listed at the top level",
+				)
+				if categories.Categories[i].Id == parent.Id {
+					node = &categories.Categories[i]
+				}
+			}
+			s.Require().NotNil(node)
+			s.Require().NotNil(node.Children)
+			s.Require().Len(
+				*node.Children,
+				1,
+			)
+			s.Equal(
+				child.Id,
+				(*node.Children)[0].Id,
+			)
+			s.Require().NotNil((*node.Children)[0].Children)
+			s.Require().Len(
+				*(*node.Children)[0].Children,
+				1,
+			)
+			s.Equal(
+				grandchild.Id,
+				(*(*node.Children)[0].Children)[0].Id,
+			)
+		},
+	)
+
+	s.Run(
+		"Parents must exist and have the same type, without cycles",
+		func() {
+			parent := s.createTestCategory(openapi.CategoryTypeExpenditure)
+			child := createSubcategoryAndReturn(
+				"Restaurants",
+				parent.Id,
+			)
+
+			s.assertHttpError(
+				createSubcategory(
+					"Salary bonus",
+					openapi.CategoryTypeIngress,
+					parent.Id,
+				),
+				http.StatusBadRequest,
+				domain.ErrCategoryParentTypeMismatch.Error(),
+			)
+			s.assertHttpError(
+				createSubcategory(
+					"Orphan",
+					openapi.CategoryTypeExpenditure,
+					"999999",
+				),
+				http.StatusBadRequest,
+				domain.ErrCategoryParentNotFound.Error(),
+			)
+
+			categoryType := openapi.CategoryTypeExpenditure
+			apiResponse, err := s.updateCategory(
+				parent.Id,
+				&openapi.CategoryRequest{
+					Name:            parent.Name,
+					Description:     parent.Description,
+					CategoryType:    &categoryType,
+					Color:           parent.Color,
+					BackgroundColor: parent.BackgroundColor,
+					ParentId:        &child.Id,
+				},
+			)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrCategoryCycle.Error(),
+			)
+
+			apiResponse, err = s.deleteCategory(parent.Id)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrCategoryHasSubcategories.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Deactivating a parent deactivates its subcategories",
+		func() {
+			parent := s.createTestCategory(openapi.CategoryTypeExpenditure)
+			child := createSubcategoryAndReturn(
+				"Takeaway",
+				parent.Id,
+			)
+
+			apiResponse, err := s.deactivateCategory(parent.Id)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			s.Equal(
+				http.StatusNoContent,
+				apiResponse.StatusCode,
+			)
+
+			apiResponse, err = s.activateCategory(child.Id)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrCategoryParentInactive.Error(),
+			)
+
+			apiResponse, err = s.activateCategory(parent.Id)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			s.Equal(
+				http.StatusNoContent,
+				apiResponse.StatusCode,
+			)
+			apiResponse, err = s.activateCategory(child.Id)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			s.Equal(
+				http.StatusNoContent,
+				apiResponse.StatusCode,
+			)
+		},
+	)
+
+	s.Run(
+		"Filters and reports include the subcategories",
+		func() {
+			testMember := s.createTestHouseholdMember()
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				1000,
+			)
+			parent := s.createTestCategory(openapi.CategoryTypeExpenditure)
+			child := createSubcategoryAndReturn(
+				"Bakery",
+				parent.Id,
+			)
+
+			today := time.Now().UTC().Truncate(24 * time.Hour)
+			for _, spending := range []struct {
+				category openapi.Category
+				amount   float32
+			}{
+				{
+					category: parent,
+					amount:   40,
+				},
+				{
+					category: child,
+					amount:   25,
+				},
+			} {
+				expenditureReq := s.createTestExpenditureRequest(
+					&account.Id,
+					&spending.category,
+				)
+				expenditureReq.Amount = spending.amount
+				expenditureReq.Date = openapitypes.Date{Time: today}
+				expenditureReq.Description = "Bread and cakes"
+				apiResponse, err := s.createExpenditureRequest(expenditureReq)
+				s.handleErr(
+					err,
+					"error while creating expenditure",
+				)
+				s.Require().Equal(
+					http.StatusCreated,
+					apiResponse.StatusCode,
+				)
+			}
+
+			apiResponse := s.apiRequest(
+				http.MethodGet,
+				"/expenditures?categoryId="+parent.Id+"&limit=10&offset=0",
+				nil,
+			)
+			var expenditures openapi.ExpenditureList
+			s.decodeResponse(
+				apiResponse,
+				&expenditures,
+			)
+			s.Equal(
+				2,
+				expenditures.Metadata.Total,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/reports/planned-spending?currency=150&periods=1&rollUp=true&accountId="+account.Id,
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var report openapi.PlannedSpendingReport
+			s.decodeResponse(
+				apiResponse,
+				&report,
+			)
+			s.Require().Len(
+				report.Periods,
+				1,
+			)
+			s.Require().Len(
+				report.Periods[0].Categories,
+				1,
+			)
+			s.Equal(
+				parent.Id,
+				report.Periods[0].Categories[0].CategoryId,
+			)
+			s.InDelta(
+				65,
+				report.Periods[0].Categories[0].Totals.Unplanned,
+				0.001,
+			)
+		},
+	)
+}
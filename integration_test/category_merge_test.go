@@ -0,0 +1,290 @@
+package integration_test
+
+import (
+	"net/http"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/integration_test/utils"
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestCategoryMerge() {
+	s.T().Log("Starting TestCategoryMerge")
+
+	mergeCategory := func(
+		id string,
+		request openapi.CategoryMergeRequest,
+	) *http.Response {
+		return s.apiRequest(
+			http.MethodPost,
+			"/categories/"+id+"/merge",
+			request,
+		)
+	}
+
+	s.Run(
+		"Merging moves every reference to the target",
+		func() {
+			testMember := s.createTestHouseholdMember()
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				1000,
+			)
+			source := s.createTestCategory(openapi.CategoryTypeExpenditure)
+			target := s.createTestCategory(openapi.CategoryTypeExpenditure)
+
+			expenditureReq := s.createTestExpenditureRequest(
+				&account.Id,
+				&source,
+			)
+			expenditureReq.Amount = 33
+			expenditureReq.Date = openapitypes.Date{Time: time.Now().UTC().Truncate(24 * time.Hour)}
+			expenditureReq.Description = "Duplicated category spending"
+			apiResponse, err := s.createExpenditureRequest(expenditureReq)
+			s.handleErr(
+				err,
+				"error while creating expenditure",
+			)
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodPost,
+				"/budgets",
+				openapi.BudgetRequest{
+					CategoryId: source.Id,
+					Amount:     100,
+					Currency:   "150",
+					PeriodType: openapi.BudgetPeriodTypeMonthly,
+					StartDate:  openapitypes.Date{Time: time.Now().UTC().Truncate(24 * time.Hour)},
+				},
+			)
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			var budget openapi.Budget
+			s.decodeResponse(
+				apiResponse,
+				&budget,
+			)
+
+			categoryType := openapi.CategoryTypeExpenditure
+			_, err = s.createCategoryAndReturn(
+				&openapi.CategoryRequest{
+					Name:            "Merged subcategory",
+					Description:     "Merged subcategory description",
+					CategoryType:    &categoryType,
+					Color:           utils.StringPtr("#FF0000"),
+					BackgroundColor: utils.StringPtr("#00FF00"),
+					ParentId:        &source.Id,
+				},
+			)
+			s.handleErr(
+				err,
+				"error while creating subcategory",
+			)
+
+			deactivate := openapi.CategoryMergeActionDeactivate
+			apiResponse = mergeCategory(
+				source.Id,
+				openapi.CategoryMergeRequest{
+					TargetId:     target.Id,
+					SourceAction: &deactivate,
+				},
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var result openapi.CategoryMergeResult
+			s.decodeResponse(
+				apiResponse,
+				&result,
+			)
+			s.Equal(
+				target.Id,
+				result.Target.Id,
+			)
+			s.Equal(
+				int64(1),
+				result.Moved.Expenditures,
+			)
+			s.Equal(
+				int64(1),
+				result.Moved.Budgets,
+			)
+			s.Equal(
+				int64(1),
+				result.Moved.Subcategories,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/expenditures?categoryId="+target.Id+"&limit=10&offset=0",
+				nil,
+			)
+			var expenditures openapi.ExpenditureList
+			s.decodeResponse(
+				apiResponse,
+				&expenditures,
+			)
+			s.Equal(
+				1,
+				expenditures.Metadata.Total,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/budgets/"+budget.Id,
+				nil,
+			)
+			s.decodeResponse(
+				apiResponse,
+				&budget,
+			)
+			s.Equal(
+				target.Id,
+				budget.CategoryId,
+			)
+
+			// The deactivated source is kept and can be activated again
+			apiResponse, err = s.activateCategory(source.Id)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			s.Equal(
+				http.StatusNoContent,
+				apiResponse.StatusCode,
+			)
+		},
+	)
+
+	s.Run(
+		"Merging deletes the source by default",
+		func() {
+			source := s.createTestCategory(openapi.CategoryTypeIngress)
+			target := s.createTestCategory(openapi.CategoryTypeIngress)
+
+			apiResponse := mergeCategory(
+				source.Id,
+				openapi.CategoryMergeRequest{TargetId: target.Id},
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+
+			apiResponse, err := s.deleteCategory(source.Id)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusNotFound,
+				domain.ErrCategoryNotFound.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Only categories of the same type outside the source tree can be merged",
+		func() {
+			source := s.createTestCategory(openapi.CategoryTypeExpenditure)
+			ingressCategory := s.createTestCategory(openapi.CategoryTypeIngress)
+
+			s.assertHttpError(
+				mergeCategory(
+					source.Id,
+					openapi.CategoryMergeRequest{TargetId: ingressCategory.Id},
+				),
+				http.StatusBadRequest,
+				domain.ErrCategoryMergeTypeMismatch.Error(),
+			)
+			s.assertHttpError(
+				mergeCategory(
+					source.Id,
+					openapi.CategoryMergeRequest{TargetId: source.Id},
+				),
+				http.StatusBadRequest,
+				domain.ErrCategoryMergeSameCategory.Error(),
+			)
+			s.assertHttpError(
+				mergeCategory(
+					source.Id,
+					openapi.CategoryMergeRequest{TargetId: "999999"},
+				),
+				http.StatusBadRequest,
+				domain.ErrCategoryMergeTargetNotFound.Error(),
+			)
+
+			categoryType := openapi.CategoryTypeExpenditure
+			subcategory, err := s.createCategoryAndReturn(
+				&openapi.CategoryRequest{
+					Name:            "Merge target subcategory",
+					Description:     "Merge target subcategory description",
+					CategoryType:    &categoryType,
+					Color:           utils.StringPtr("#FF0000"),
+					BackgroundColor: utils.StringPtr("#00FF00"),
+					ParentId:        &source.Id,
+				},
+			)
+			s.handleErr(
+				err,
+				"error while creating subcategory",
+			)
+			s.assertHttpError(
+				mergeCategory(
+					source.Id,
+					openapi.CategoryMergeRequest{TargetId: subcategory.Id},
+				),
+				http.StatusBadRequest,
+				domain.ErrCategoryMergeIntoSubcategory.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Categories cannot be merged when both have budgets",
+		func() {
+			source := s.createTestCategory(openapi.CategoryTypeExpenditure)
+			target := s.createTestCategory(openapi.CategoryTypeExpenditure)
+			for _, category := range []openapi.Category{
+				source,
+				target,
+			} {
+				apiResponse := s.apiRequest(
+					http.MethodPost,
+					"/budgets",
+					openapi.BudgetRequest{
+						CategoryId: category.Id,
+						Amount:     100,
+						Currency:   "150",
+						PeriodType: openapi.BudgetPeriodTypeMonthly,
+						StartDate:  openapitypes.Date{Time: time.Now().UTC().Truncate(24 * time.Hour)},
+					},
+				)
+				s.Require().Equal(
+					http.StatusCreated,
+					apiResponse.StatusCode,
+				)
+			}
+
+			s.assertHttpError(
+				mergeCategory(
+					source.Id,
+					openapi.CategoryMergeRequest{TargetId: target.Id},
+				),
+				http.StatusBadRequest,
+				domain.ErrCategoryMergeBothBudgeted.Error(),
+			)
+		},
+	)
+}
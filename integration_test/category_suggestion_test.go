@@ -0,0 +1,142 @@
+package integration_test
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestCategorySuggestions() {
+	s.T().Log("Starting TestCategorySuggestions")
+
+	suggest := func(description string) *http.Response {
+		return s.apiRequest(
+			http.MethodGet,
+			"/category-suggestions?description="+url.QueryEscape(description),
+			nil,
+		)
+	}
+
+	s.Run(
+		"Categories are suggested from past expenditures with similar descriptions",
+		func() {
+			testMember := s.createTestHouseholdMember()
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				1000,
+			)
+			groceries := s.createTestCategory(openapi.CategoryTypeExpenditure)
+			leisure := s.createTestCategory(openapi.CategoryTypeExpenditure)
+
+			for i, spending := range []struct {
+				category    openapi.Category
+				description string
+			}{
+				{
+					category:    groceries,
+					description: "Zorblax market 0231",
+				},
+				{
+					category:    groceries,
+					description: "ZORBLAX MARKET weekly shopping",
+				},
+				{
+					category:    leisure,
+					description: "Quintaplex cinema tickets",
+				},
+			} {
+				expenditureReq := s.createTestExpenditureRequest(
+					&account.Id,
+					&spending.category,
+				)
+				expenditureReq.Amount = float32(10 + i)
+				expenditureReq.Date = openapitypes.Date{Time: time.Now().UTC().Truncate(24 * time.Hour)}
+				expenditureReq.Description = spending.description
+				apiResponse, err := s.createExpenditureRequest(expenditureReq)
+				s.handleErr(
+					err,
+					"error while creating expenditure",
+				)
+				s.Require().Equal(
+					http.StatusCreated,
+					apiResponse.StatusCode,
+				)
+			}
+
+			apiResponse := suggest("zorblax market 0918")
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var suggestion openapi.CategorySuggestion
+			s.decodeResponse(
+				apiResponse,
+				&suggestion,
+			)
+			s.Require().NotEmpty(suggestion.Categories)
+			s.Equal(
+				groceries.Id,
+				suggestion.Categories[0].Id,
+			)
+			s.Equal(
+				groceries.Name,
+				suggestion.Categories[0].Name,
+			)
+			s.Greater(
+				suggestion.Categories[0].Confidence,
+				0.5,
+			)
+			s.LessOrEqual(
+				len(suggestion.Categories),
+				3,
+			)
+
+			apiResponse = suggest("quintaplex")
+			s.decodeResponse(
+				apiResponse,
+				&suggestion,
+			)
+			s.Require().NotEmpty(suggestion.Categories)
+			s.Equal(
+				leisure.Id,
+				suggestion.Categories[0].Id,
+			)
+
+			// Retraining learns every expenditure again
+			apiResponse = s.apiRequest(
+				http.MethodPost,
+				"/category-suggestions/retrain",
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var status openapi.SuggestionModelStatus
+			s.decodeResponse(
+				apiResponse,
+				&status,
+			)
+			s.GreaterOrEqual(
+				status.Examples,
+				3,
+			)
+		},
+	)
+
+	s.Run(
+		"Descriptions without words cannot be categorized",
+		func() {
+			s.assertHttpError(
+				suggest("0231 12/05"),
+				http.StatusBadRequest,
+				domain.ErrInvalidSuggestionDescription.Error(),
+			)
+		},
+	)
+}
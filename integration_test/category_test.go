@@ -226,6 +226,44 @@ func (s *Suite) TestCategory() {
 		},
 	)
 
+	s.Run(
+		"Updating a category does not change whether it is active",
+		func() {
+			categoryType := openapi.CategoryTypeExpenditure
+			category := s.createTestCategory(categoryType)
+
+			updateReq := &openapi.CategoryUpdateRequest{
+				Name:            "Still Active Category",
+				Description:     "Updated description",
+				CategoryType:    &categoryType,
+				Color:           utils.StringPtr("#FF0000"),
+				BackgroundColor: utils.StringPtr("#00FF00"),
+				Active:          utils.BoolPtr(true),
+			}
+			apiResponse := s.apiRequest(
+				http.MethodPut,
+				"/categories/"+category.Id,
+				updateReq,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+
+			updateReq.Active = utils.BoolPtr(false)
+			apiResponse = s.apiRequest(
+				http.MethodPut,
+				"/categories/"+category.Id,
+				updateReq,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrCategoryActiveChanged.Error(),
+			)
+		},
+	)
+
 	s.Run(
 		"Update a non-existent category",
 		func() {
@@ -507,7 +545,7 @@ func (s *Suite) createCategory(categoryReq *openapi.CategoryRequest) *http.Respo
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -559,7 +597,7 @@ func (s *Suite) listCategories(categoryType *openapi.CategoryType) (
 		req.URL.RawQuery = q.Encode()
 	}
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -599,7 +637,7 @@ func (s *Suite) updateCategory(
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -625,7 +663,7 @@ func (s *Suite) deleteCategory(id string) (
 		"error while creating request",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -656,7 +694,7 @@ func (s *Suite) activateCategory(id string) (
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -687,7 +725,7 @@ func (s *Suite) deactivateCategory(id string) (
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -0,0 +1,336 @@
+package integration_test
+
+import (
+	"net/http"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/integration_test/utils"
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestExpenditureBatch() {
+	s.T().Log("Starting TestExpenditureBatch")
+
+	testMember := s.createTestHouseholdMember()
+	testCategory := s.createTestCategory(openapi.CategoryTypeExpenditure)
+	today := openapitypes.Date{Time: time.Now().UTC().Truncate(24 * time.Hour)}
+
+	// The third item no longer fits in the balance left by the first two
+	batchItems := func(accountID string) []openapi.ExpenditureBatchItem {
+		items := make(
+			[]openapi.ExpenditureBatchItem,
+			0,
+			3,
+		)
+		for _, description := range []string{
+			"Hotel",
+			"Train tickets",
+			"Museum",
+		} {
+			category := testCategory
+			items = append(
+				items,
+				openapi.ExpenditureBatchItem{
+					AccountId:   utils.StringPtr(accountID),
+					Amount:      utils.Float32Ptr(200),
+					Category:    &category,
+					Currency:    utils.StringPtr("150"),
+					Date:        &today,
+					Description: utils.StringPtr(description),
+				},
+			)
+		}
+
+		return items
+	}
+
+	s.Run(
+		"All or nothing batch creates nothing when an item fails",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				500,
+			)
+
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/expenditures/batch",
+				openapi.ExpenditureBatchRequest{
+					Mode:  openapi.BatchMode(domain.BatchModeAllOrNothing),
+					Items: batchItems(account.Id),
+				},
+			)
+			var result openapi.ExpenditureBatchResult
+			s.decodeResponse(
+				apiResponse,
+				&result,
+			)
+
+			s.Equal(
+				http.StatusUnprocessableEntity,
+				apiResponse.StatusCode,
+			)
+			s.Empty(result.Created)
+			s.Equal(
+				[]openapi.ExpenditureBatchError{
+					{
+						Index:   2,
+						Message: domain.ErrInsufficientBalance.Error(),
+					},
+				},
+				result.Errors,
+			)
+			s.Equal(
+				float32(500),
+				s.getAccountBalance(account.Id),
+			)
+		},
+	)
+
+	s.Run(
+		"Best effort batch creates the valid items",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				500,
+			)
+
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/expenditures/batch",
+				openapi.ExpenditureBatchRequest{
+					Mode:  openapi.BatchMode(domain.BatchModeBestEffort),
+					Items: batchItems(account.Id),
+				},
+			)
+			var result openapi.ExpenditureBatchResult
+			s.decodeResponse(
+				apiResponse,
+				&result,
+			)
+
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Require().Len(
+				result.Created,
+				2,
+			)
+			s.Equal(
+				0,
+				result.Created[0].Index,
+			)
+			s.Equal(
+				1,
+				result.Created[1].Index,
+			)
+			s.Require().Len(
+				result.Errors,
+				1,
+			)
+			s.Equal(
+				2,
+				result.Errors[0].Index,
+			)
+			s.Equal(
+				float32(100),
+				s.getAccountBalance(account.Id),
+			)
+		},
+	)
+
+	s.Run(
+		"Batch items are validated by index",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				500,
+			)
+			items := batchItems(account.Id)[:2]
+			items[1].AccountId = utils.StringPtr("999999")
+
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/expenditures/batch",
+				openapi.ExpenditureBatchRequest{
+					Mode:  openapi.BatchMode(domain.BatchModeBestEffort),
+					Items: items,
+				},
+			)
+			var result openapi.ExpenditureBatchResult
+			s.decodeResponse(
+				apiResponse,
+				&result,
+			)
+
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				[]openapi.ExpenditureBatchError{
+					{
+						Index:   1,
+						Message: domain.ErrAccountNotFound.Error(),
+					},
+				},
+				result.Errors,
+			)
+			s.Equal(
+				float32(300),
+				s.getAccountBalance(account.Id),
+			)
+		},
+	)
+
+	s.Run(
+		"Incomplete items are reported by index",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				500,
+			)
+			items := batchItems(account.Id)
+			items[0].Amount = nil
+			items[1].Description = nil
+
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/expenditures/batch",
+				openapi.ExpenditureBatchRequest{
+					Mode:  openapi.BatchMode(domain.BatchModeBestEffort),
+					Items: items,
+				},
+			)
+			var result openapi.ExpenditureBatchResult
+			s.decodeResponse(
+				apiResponse,
+				&result,
+			)
+
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				[]openapi.ExpenditureBatchError{
+					{
+						Index:   0,
+						Message: domain.ErrInvalidExpenditureAmount.Error(),
+					},
+					{
+						Index:   1,
+						Message: domain.ErrExpenditureDescriptionRequired.Error(),
+					},
+				},
+				result.Errors,
+			)
+			s.Equal(
+				float32(300),
+				s.getAccountBalance(account.Id),
+			)
+
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPost,
+					"/expenditures/batch",
+					openapi.ExpenditureBatchRequest{
+						Mode:  openapi.BatchMode(domain.BatchModeBestEffort),
+						Items: []openapi.ExpenditureBatchItem{},
+					},
+				),
+				http.StatusBadRequest,
+				domain.ErrBatchEmpty.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Batch items are compared with each other for duplicates",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				1000,
+			)
+			items := batchItems(account.Id)
+			items[2].Description = utils.StringPtr("HOTEL")
+			request := openapi.ExpenditureBatchRequest{
+				Mode:  openapi.BatchMode(domain.BatchModeBestEffort),
+				Items: items,
+			}
+
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/expenditures/batch",
+				request,
+			)
+			var result openapi.ExpenditureBatchResult
+			s.decodeResponse(
+				apiResponse,
+				&result,
+			)
+
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				[]openapi.ExpenditureBatchError{
+					{
+						Index: 2,
+						Message: (&domain.DuplicateBatchItemError{
+							Index: 0,
+						}).Error(),
+					},
+				},
+				result.Errors,
+			)
+			s.Equal(
+				float32(600),
+				s.getAccountBalance(account.Id),
+			)
+
+			// Forced, the repeated item is created like any other
+			otherAccount := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				1000,
+			)
+			for i := range request.Items {
+				request.Items[i].AccountId = &otherAccount.Id
+			}
+			apiResponse = s.apiRequest(
+				http.MethodPost,
+				"/expenditures/batch?force=true",
+				request,
+			)
+			var forced openapi.ExpenditureBatchResult
+			s.decodeResponse(
+				apiResponse,
+				&forced,
+			)
+
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Len(
+				forced.Created,
+				3,
+			)
+			s.Empty(forced.Errors)
+			s.Equal(
+				float32(400),
+				s.getAccountBalance(otherAccount.Id),
+			)
+		},
+	)
+}
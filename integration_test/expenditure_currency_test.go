@@ -0,0 +1,254 @@
+package integration_test
+
+import (
+	"net/http"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/integration_test/utils"
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestForeignCurrencyExpenditures() {
+	s.T().Log("Starting TestForeignCurrencyExpenditures")
+
+	testMember := s.createTestHouseholdMember()
+	testCategory := s.createTestCategory(openapi.CategoryTypeExpenditure)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	s.insertExchangeRate(
+		"50",
+		"150",
+		2,
+		today.AddDate(
+			0,
+			0,
+			-10,
+		),
+	)
+	s.insertExchangeRate(
+		"150",
+		"60",
+		4,
+		today.AddDate(
+			0,
+			0,
+			-10,
+		),
+	)
+
+	foreignExpenditure := func(
+		accountID string,
+		currency string,
+		amount float32,
+		description string,
+	) *openapi.ExpenditureRequest {
+		expenditureReq := s.createTestExpenditureRequest(
+			&accountID,
+			&testCategory,
+		)
+		expenditureReq.Currency = currency
+		expenditureReq.Amount = amount
+		expenditureReq.Description = description
+		expenditureReq.Date = openapitypes.Date{Time: today}
+
+		return expenditureReq
+	}
+
+	s.Run(
+		"Expenditure is charged at the given exchange rate",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				500,
+			)
+			expenditureReq := foreignExpenditure(
+				account.Id,
+				"50",
+				100,
+				"Dinner abroad",
+			)
+			expenditureReq.ExchangeRate = utils.Float32Ptr(1.5)
+
+			apiResponse, err := s.createExpenditureRequest(expenditureReq)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			var expenditure openapi.Expenditure
+			s.decodeResponse(
+				apiResponse,
+				&expenditure,
+			)
+
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				float32(100),
+				expenditure.Amount,
+			)
+			s.Equal(
+				"50",
+				expenditure.Currency,
+			)
+			s.Equal(
+				float32(150),
+				expenditure.ChargedAmount,
+			)
+			s.Equal(
+				"150",
+				expenditure.ChargedCurrency,
+			)
+			s.Equal(
+				float32(350),
+				s.getAccountBalance(account.Id),
+			)
+		},
+	)
+
+	s.Run(
+		"Expenditure is charged at the stored exchange rate or its inverse",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				500,
+			)
+
+			apiResponse, err := s.createExpenditureRequest(
+				foreignExpenditure(
+					account.Id,
+					"50",
+					10,
+					"Museum tickets",
+				),
+			)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			var expenditure openapi.Expenditure
+			s.decodeResponse(
+				apiResponse,
+				&expenditure,
+			)
+			s.Equal(
+				float32(20),
+				expenditure.ChargedAmount,
+			)
+
+			apiResponse, err = s.createExpenditureRequest(
+				foreignExpenditure(
+					account.Id,
+					"60",
+					8,
+					"Bus fare",
+				),
+			)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			s.decodeResponse(
+				apiResponse,
+				&expenditure,
+			)
+			s.Equal(
+				float32(2),
+				expenditure.ChargedAmount,
+			)
+			s.Equal(
+				float32(478),
+				s.getAccountBalance(account.Id),
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/reports/payee-spending?currency=50&amountBasis=original&accountId="+account.Id,
+				nil,
+			)
+			var report openapi.PayeeSpendingReport
+			s.decodeResponse(
+				apiResponse,
+				&report,
+			)
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				10.0,
+				report.Total,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/reports/payee-spending?currency=150&accountId="+account.Id,
+				nil,
+			)
+			s.decodeResponse(
+				apiResponse,
+				&report,
+			)
+			s.Equal(
+				22.0,
+				report.Total,
+			)
+		},
+	)
+
+	s.Run(
+		"Expenditure without exchange rate is rejected",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				500,
+			)
+
+			apiResponse, err := s.createExpenditureRequest(
+				foreignExpenditure(
+					account.Id,
+					"70",
+					10,
+					"Souvenirs",
+				),
+			)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrExchangeRateNotFound.Error(),
+			)
+		},
+	)
+}
+
+// There is no endpoint to store exchange rates yet
+func (s *Suite) insertExchangeRate(
+	baseCurrency string,
+	targetCurrency string,
+	rate float32,
+	date time.Time,
+) {
+	_, err := s.db.ExecContext(
+		s.ctx,
+		`INSERT INTO exchange_rates (base_currency, target_currency, rate, date) VALUES (?, ?, ?, ?)`,
+		baseCurrency,
+		targetCurrency,
+		rate,
+		date,
+	)
+	s.handleErr(
+		err,
+		"error while inserting exchange rate",
+	)
+}
@@ -0,0 +1,239 @@
+package integration_test
+
+import (
+	"net/http"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/integration_test/utils"
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestExpenditureRefunds() {
+	s.T().Log("Starting TestExpenditureRefunds")
+
+	testMember := s.createTestHouseholdMember()
+	testCategory := s.createTestCategory(openapi.CategoryTypeExpenditure)
+
+	account := s.createTestAccountWithBalance(
+		&testMember,
+		"150",
+		500,
+	)
+	otherAccount := s.createTestAccountWithBalance(
+		&testMember,
+		"150",
+		0,
+	)
+	expenditureReq := s.createTestExpenditureRequest(
+		&account.Id,
+		&testCategory,
+	)
+	expenditureReq.Amount = 100
+	expenditureReq.Date = openapitypes.Date{Time: time.Now().UTC().Truncate(24 * time.Hour)}
+	apiResponse, err := s.createExpenditureRequest(expenditureReq)
+	s.handleErr(
+		err,
+		"error while creating expenditure",
+	)
+	var expenditure openapi.Expenditure
+	s.decodeResponse(
+		apiResponse,
+		&expenditure,
+	)
+	refundsPath := "/expenditures/" + expenditure.Id + "/refunds"
+
+	s.Run(
+		"Partial refund credits the expenditure account",
+		func() {
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				refundsPath,
+				openapi.RefundRequest{
+					Amount: utils.Float32Ptr(40),
+				},
+			)
+			var refund openapi.Refund
+			s.decodeResponse(
+				apiResponse,
+				&refund,
+			)
+
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				expenditure.Id,
+				refund.ExpenditureId,
+			)
+			s.Equal(
+				account.Id,
+				refund.AccountId,
+			)
+			s.Equal(
+				float32(440),
+				s.getAccountBalance(account.Id),
+			)
+		},
+	)
+
+	s.Run(
+		"Refunding more than is left is rejected",
+		func() {
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				refundsPath,
+				openapi.RefundRequest{
+					Amount: utils.Float32Ptr(70),
+				},
+			)
+
+			s.assertHttpError(
+				apiResponse,
+				http.StatusConflict,
+				domain.ErrRefundExceedsExpenditure.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Refund of what is left to another account",
+		func() {
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				refundsPath,
+				openapi.RefundRequest{
+					AccountId: &otherAccount.Id,
+				},
+			)
+			var refund openapi.Refund
+			s.decodeResponse(
+				apiResponse,
+				&refund,
+			)
+
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				float32(60),
+				refund.Amount,
+			)
+			s.Equal(
+				float32(60),
+				s.getAccountBalance(otherAccount.Id),
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				refundsPath,
+				nil,
+			)
+			var refunds openapi.RefundList
+			s.decodeResponse(
+				apiResponse,
+				&refunds,
+			)
+			s.Len(
+				refunds.Refunds,
+				2,
+			)
+			s.Equal(
+				float32(100),
+				refunds.Refunded,
+			)
+			s.Equal(
+				float32(0),
+				refunds.Remaining,
+			)
+		},
+	)
+
+	s.Run(
+		"Reports are net of refunds",
+		func() {
+			apiResponse := s.apiRequest(
+				http.MethodGet,
+				"/reports/payee-spending?currency=150&accountId="+account.Id,
+				nil,
+			)
+			var report openapi.PayeeSpendingReport
+			s.decodeResponse(
+				apiResponse,
+				&report,
+			)
+
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				0.0,
+				report.Total,
+			)
+		},
+	)
+
+	s.Run(
+		"Future-dated refund stays pending",
+		func() {
+			expenditureReq := s.createTestExpenditureRequest(
+				&account.Id,
+				&testCategory,
+			)
+			expenditureReq.Amount = 50
+			expenditureReq.Date = openapitypes.Date{Time: time.Now().UTC().Truncate(24 * time.Hour)}
+			apiResponse, err := s.createExpenditureRequest(expenditureReq)
+			s.handleErr(
+				err,
+				"error while creating expenditure",
+			)
+			var expenditure openapi.Expenditure
+			s.decodeResponse(
+				apiResponse,
+				&expenditure,
+			)
+			s.Require().Equal(
+				float32(390),
+				s.getAccountBalance(account.Id),
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodPost,
+				"/expenditures/"+expenditure.Id+"/refunds",
+				openapi.RefundRequest{
+					Date: &openapitypes.Date{Time: time.Now().UTC().AddDate(
+						0,
+						0,
+						10,
+					)},
+				},
+			)
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				float32(390),
+				s.getAccountBalance(account.Id),
+			)
+
+			// The pending refund still counts against what is left to refund
+			apiResponse = s.apiRequest(
+				http.MethodPost,
+				"/expenditures/"+expenditure.Id+"/refunds",
+				openapi.RefundRequest{
+					Amount: utils.Float32Ptr(10),
+				},
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusConflict,
+				domain.ErrRefundExceedsExpenditure.Error(),
+			)
+		},
+	)
+}
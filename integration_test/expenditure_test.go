@@ -1,11 +1,16 @@
 package integration_test
 
 import (
+	"fmt"
 	"net/http"
+	"slices"
+	"sync"
+	"time"
 
 	"ghorkov32/proletariat-budget-be/integration_test/utils"
 	"ghorkov32/proletariat-budget-be/internal/core/domain"
 	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
 )
 
 func (s *Suite) TestExpenditures() {
@@ -257,6 +262,7 @@ func (s *Suite) TestExpenditures() {
 				&testCategory,
 			)
 			expenditureReq.Tags = &[]openapi.Tag{testTag}
+			expenditureReq.Description = "Tagged purchase"
 
 			apiResponse, err := s.createExpenditureRequest(expenditureReq)
 			s.handleErr(
@@ -294,7 +300,7 @@ func (s *Suite) TestExpenditures() {
 			expenditureReq := &openapi.ExpenditureRequest{
 				AccountId: testAccount.Id,
 				Amount:    50.0,
-				Category:  testCategory,
+				Category:  &testCategory,
 				Currency:  "150",
 			}
 
@@ -357,7 +363,7 @@ func (s *Suite) TestExpenditures() {
 			expenditureReq := &openapi.ExpenditureRequest{
 				AccountId:   testAccount.Id,
 				Amount:      75.50,
-				Category:    testCategory,
+				Category:    &testCategory,
 				Currency:    "150",
 				Declared:    utils.BoolPtr(true),
 				Description: "Test expenditure with all fields",
@@ -410,6 +416,498 @@ func (s *Suite) TestExpenditures() {
 			)
 		},
 	)
+
+	s.Run(
+		"Future-dated expenditure is kept pending without debiting the account",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				500,
+			)
+			expenditureReq := s.createTestExpenditureRequest(
+				&account.Id,
+				&testCategory,
+			)
+			expenditureReq.Date = openapitypes.Date{Time: time.Now().AddDate(
+				0,
+				0,
+				10,
+			)}
+
+			apiResponse, err := s.createExpenditureRequest(expenditureReq)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+
+			var expenditure openapi.Expenditure
+			s.decodeResponse(
+				apiResponse,
+				&expenditure,
+			)
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				openapi.ExpenditureStatusPending,
+				expenditure.Status,
+			)
+			s.Equal(
+				float32(500),
+				s.getAccountBalance(account.Id),
+			)
+
+			posted, _, err := s.useCases.Expenditure.PostScheduled(
+				s.ctx,
+				time.Now().AddDate(
+					0,
+					0,
+					11,
+				),
+			)
+			s.handleErr(
+				err,
+				"error while posting scheduled expenditures",
+			)
+			s.GreaterOrEqual(
+				posted,
+				1,
+			)
+
+			postedExpenditure := s.getExpenditure(expenditure.Id)
+			s.Equal(
+				openapi.ExpenditureStatusCompleted,
+				postedExpenditure.Status,
+			)
+			s.Equal(
+				500-expenditureReq.Amount,
+				s.getAccountBalance(account.Id),
+			)
+		},
+	)
+
+	s.Run(
+		"Scheduled expenditure fails when the account has insufficient balance on its date",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				50,
+			)
+			expenditureReq := s.createTestExpenditureRequest(
+				&account.Id,
+				&testCategory,
+			)
+			expenditureReq.Date = openapitypes.Date{Time: time.Now().AddDate(
+				0,
+				0,
+				10,
+			)}
+
+			apiResponse, err := s.createExpenditureRequest(expenditureReq)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+
+			var expenditure openapi.Expenditure
+			s.decodeResponse(
+				apiResponse,
+				&expenditure,
+			)
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+
+			_, failed, err := s.useCases.Expenditure.PostScheduled(
+				s.ctx,
+				time.Now().AddDate(
+					0,
+					0,
+					11,
+				),
+			)
+			s.handleErr(
+				err,
+				"error while posting scheduled expenditures",
+			)
+			s.GreaterOrEqual(
+				failed,
+				1,
+			)
+
+			failedExpenditure := s.getExpenditure(expenditure.Id)
+			s.Equal(
+				openapi.ExpenditureStatusFailed,
+				failedExpenditure.Status,
+			)
+			s.Equal(
+				domain.ErrInsufficientBalance.Error(),
+				*failedExpenditure.FailureReason,
+			)
+			s.Equal(
+				float32(50),
+				s.getAccountBalance(account.Id),
+			)
+		},
+	)
+
+	s.Run(
+		"Possible duplicate expenditure is rejected unless forced",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				500,
+			)
+			expenditureReq := s.createTestExpenditureRequest(
+				&account.Id,
+				&testCategory,
+			)
+			expenditureReq.Description = "LIDL groceries"
+			expenditureReq.Date = openapitypes.Date{Time: time.Now().UTC().Truncate(24 * time.Hour)}
+
+			apiResponse, err := s.createExpenditureRequest(expenditureReq)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			var existing openapi.Expenditure
+			s.decodeResponse(
+				apiResponse,
+				&existing,
+			)
+
+			duplicateReq := *expenditureReq
+			duplicateReq.Description = "Lidl"
+			duplicateReq.Date = openapitypes.Date{Time: expenditureReq.Date.AddDate(
+				0,
+				0,
+				1,
+			)}
+			apiResponse, err = s.createExpenditureRequest(&duplicateReq)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusConflict,
+				(&domain.DuplicateTransactionError{
+					Type:       domain.TransactionTypeExpenditure,
+					ExistingID: existing.Id,
+				}).Error(),
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodPost,
+				"/expenditures?force=true",
+				duplicateReq,
+			)
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				float32(500-2*100.50),
+				s.getAccountBalance(account.Id),
+			)
+		},
+	)
+
+	s.Run(
+		"List expenditures sorted by each key in both directions",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				1000,
+			)
+			suffix := fmt.Sprintf(
+				"%d",
+				time.Now().UnixNano(),
+			)
+			categoryType := openapi.CategoryTypeExpenditure
+			newCategory := func(name string) openapi.Category {
+				category, err := s.createCategoryAndReturn(
+					&openapi.CategoryRequest{
+						Name:            name + " " + suffix,
+						Description:     "Sorting category",
+						CategoryType:    &categoryType,
+						Color:           utils.StringPtr("#FF0000"),
+						BackgroundColor: utils.StringPtr("#00FF00"),
+					},
+				)
+				s.handleErr(
+					err,
+					"error while creating category",
+				)
+
+				return category
+			}
+			alpha := newCategory("Alpha")
+			bravo := newCategory("Bravo")
+			charlie := newCategory("Charlie")
+
+			today := time.Now().UTC().Truncate(24 * time.Hour)
+			var ids []string
+			for _, item := range []struct {
+				daysAgo     int
+				amount      float32
+				description string
+				category    openapi.Category
+			}{
+				{
+					daysAgo:     2,
+					amount:      30,
+					description: "Bakery",
+					category:    alpha,
+				},
+				{
+					daysAgo:     1,
+					amount:      10,
+					description: "Cinema",
+					category:    charlie,
+				},
+				{
+					daysAgo:     0,
+					amount:      20,
+					description: "Apothecary",
+					category:    bravo,
+				},
+			} {
+				expenditureReq := s.createTestExpenditureRequest(
+					&account.Id,
+					&item.category,
+				)
+				expenditureReq.Date = openapitypes.Date{Time: today.AddDate(
+					0,
+					0,
+					-item.daysAgo,
+				)}
+				expenditureReq.Amount = item.amount
+				expenditureReq.Description = item.description
+				apiResponse := s.apiRequest(
+					http.MethodPost,
+					"/expenditures?force=true",
+					expenditureReq,
+				)
+				s.Require().Equal(
+					http.StatusCreated,
+					apiResponse.StatusCode,
+				)
+				var expenditure openapi.Expenditure
+				s.decodeResponse(
+					apiResponse,
+					&expenditure,
+				)
+				ids = append(
+					ids,
+					expenditure.Id,
+				)
+			}
+
+			listSorted := func(sort string) []openapi.Expenditure {
+				apiResponse := s.apiRequest(
+					http.MethodGet,
+					"/expenditures?accountId="+account.Id+"&sort="+sort+"&limit=10&offset=0",
+					nil,
+				)
+				s.Require().Equal(
+					http.StatusOK,
+					apiResponse.StatusCode,
+				)
+				var expenditures openapi.ExpenditureList
+				s.decodeResponse(
+					apiResponse,
+					&expenditures,
+				)
+				s.Require().NotNil(expenditures.Metadata.Sort)
+				s.Equal(
+					sort,
+					*expenditures.Metadata.Sort,
+				)
+				s.Require().NotNil(expenditures.Expenditures)
+
+				return *expenditures.Expenditures
+			}
+
+			// Ascending orders of the expenditures created above, by their index
+			for key, ascending := range map[string][]int{
+				"date":        {0, 1, 2},
+				"amount":      {1, 2, 0},
+				"description": {2, 0, 1},
+				"category":    {0, 2, 1},
+			} {
+				expected := make(
+					[]string,
+					0,
+					len(ascending),
+				)
+				for _, i := range ascending {
+					expected = append(
+						expected,
+						ids[i],
+					)
+				}
+
+				var sorted []string
+				for _, expenditure := range listSorted(key) {
+					sorted = append(
+						sorted,
+						expenditure.Id,
+					)
+				}
+				s.Equal(
+					expected,
+					sorted,
+					key,
+				)
+
+				slices.Reverse(expected)
+				sorted = sorted[:0]
+				for _, expenditure := range listSorted("-" + key) {
+					sorted = append(
+						sorted,
+						expenditure.Id,
+					)
+				}
+				s.Equal(
+					expected,
+					sorted,
+					"-"+key,
+				)
+			}
+
+			// Expenditures created within the same second tie on their creation time
+			expenditures := listSorted("createdAt")
+			s.Require().Len(
+				expenditures,
+				len(ids),
+			)
+			for i := 1; i < len(expenditures); i++ {
+				s.False(expenditures[i].CreatedAt.Before(expenditures[i-1].CreatedAt))
+			}
+			expenditures = listSorted("-createdAt")
+			s.Require().Len(
+				expenditures,
+				len(ids),
+			)
+			for i := 1; i < len(expenditures); i++ {
+				s.False(expenditures[i].CreatedAt.After(expenditures[i-1].CreatedAt))
+			}
+		},
+	)
+
+	s.Run(
+		"List expenditures with an invalid sort key",
+		func() {
+			apiResponse := s.apiRequest(
+				http.MethodGet,
+				"/expenditures?sort=-password&limit=10&offset=0",
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrInvalidSortKey.Error()+": password",
+			)
+		},
+	)
+
+	s.Run(
+		"Concurrent expenditures all debit the account",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				1000,
+			)
+			expenditureReq := s.createTestExpenditureRequest(
+				&account.Id,
+				&testCategory,
+			)
+
+			statuses := make(
+				[]int,
+				8,
+			)
+			var wg sync.WaitGroup
+			for i := range statuses {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					apiResponse := s.apiRequest(
+						http.MethodPost,
+						"/expenditures?force=true",
+						expenditureReq,
+					)
+					statuses[i] = apiResponse.StatusCode
+					apiResponse.Body.Close()
+				}()
+			}
+			wg.Wait()
+
+			for _, status := range statuses {
+				s.Equal(
+					http.StatusCreated,
+					status,
+				)
+			}
+			s.InDelta(
+				1000-8*100.50,
+				s.getAccountBalance(account.Id),
+				0.01,
+			)
+		},
+	)
+}
+
+func (s *Suite) getExpenditure(id string) openapi.Expenditure {
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodGet,
+		"http://localhost:9091/expenditures/"+id,
+		nil,
+	)
+	s.handleErr(
+		err,
+		"error while creating request",
+	)
+
+	client := s.client()
+	apiResponse, err := client.Do(req)
+	s.handleErr(
+		err,
+		"error while making request",
+	)
+
+	var expenditure openapi.Expenditure
+	s.decodeResponse(
+		apiResponse,
+		&expenditure,
+	)
+
+	return expenditure
+}
+
+func (s *Suite) getAccountBalance(id string) float32 {
+	apiResponse, err := s.getAccountRequest(id)
+	s.handleErr(
+		err,
+		"error while getting account",
+	)
+
+	var account openapi.Account
+	s.decodeResponse(
+		apiResponse,
+		&account,
+	)
+
+	return account.CurrentBalance
 }
 
 // Helper function to create expenditure request
@@ -439,7 +937,7 @@ func (s *Suite) createExpenditureRequest(expenditureReq *openapi.ExpenditureRequ
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -454,10 +952,13 @@ func (s *Suite) createTestExpenditureRequest(
 	account *string,
 	category *openapi.Category,
 ) *openapi.ExpenditureRequest {
+	// Copy the category so tests can change the request without touching the shared one
+	requestCategory := *category
+
 	return &openapi.ExpenditureRequest{
 		AccountId:   *account,
 		Amount:      100.50,
-		Category:    *category,
+		Category:    &requestCategory,
 		Currency:    "150",
 		Declared:    utils.BoolPtr(true),
 		Description: "Test expenditure for integration testing",
@@ -506,7 +1007,7 @@ func (s *Suite) createTestAccountWithBalance(
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -565,7 +1066,7 @@ func (s *Suite) createTestTag(tagType openapi.TagType) openapi.Tag {
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
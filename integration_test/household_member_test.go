@@ -367,6 +367,37 @@ func (s *Suite) TestHouseholdMember() {
 		},
 	)
 
+	s.Run(
+		"List household members sorted by first name",
+		func() {
+			member := s.createTestHouseholdMember()
+			member.FirstName = "Aaron"
+			_, err := s.updateHouseholdMember(&member)
+			s.handleErr(
+				err,
+				"error while updating member",
+			)
+
+			memberList, err := s.listHouseholdMembers(
+				openapi.ListHouseholdMembersParams{
+					Sort: utils.StringPtr("firstName,-createdAt"),
+				},
+			)
+			s.handleErr(
+				err,
+				"error while listing household members",
+			)
+
+			s.NotEmpty(*memberList.Members)
+			for i := 1; i < len(*memberList.Members); i++ {
+				s.LessOrEqual(
+					(*memberList.Members)[i-1].FirstName,
+					(*memberList.Members)[i].FirstName,
+				)
+			}
+		},
+	)
+
 	s.Run(
 		"List household members filtered by role",
 		func() {
@@ -654,7 +685,7 @@ func (s *Suite) createHouseholdMember(householdMemberReq *openapi.HouseholdMembe
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -699,8 +730,14 @@ func (s *Suite) listHouseholdMembers(params openapi.ListHouseholdMembersParams)
 			strconv.FormatBool(*params.Active),
 		)
 	}
+	if params.Sort != nil {
+		q.Set(
+			"sort",
+			*params.Sort,
+		)
+	}
 	req.URL.RawQuery = q.Encode()
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -733,7 +770,7 @@ func (s *Suite) getHouseholdMember(id string) (
 		"error while creating request",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -777,7 +814,7 @@ func (s *Suite) updateHouseholdMember(member *openapi.HouseholdMember) (
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -800,7 +837,7 @@ func (s *Suite) deleteHouseholdMember(id string) (
 		errReq,
 		"error while creating request",
 	)
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -827,7 +864,7 @@ func (s *Suite) deactivateHouseholdMember(id string) (
 		"Content-Type",
 		"application/json",
 	)
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -855,7 +892,7 @@ func (s *Suite) activateHouseholdMember(id string) (
 		"Content-Type",
 		"application/json",
 	)
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -0,0 +1,506 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/integration_test/utils"
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+)
+
+func (s *Suite) TestHouseholdIsolation() {
+	s.T().Log("Starting TestHouseholdIsolation")
+
+	suffix := fmt.Sprintf(
+		"%d",
+		time.Now().UnixNano(),
+	)
+
+	// The relatives' household, founded by its own admin
+	relativesToken := *s.registerUser("relatives-" + suffix + "@example.com").Token
+	apiResponse := s.userRequest(
+		relativesToken,
+		http.MethodPost,
+		"/households",
+		&openapi.HouseholdRequest{Name: "Relatives " + suffix},
+	)
+	s.Require().Equal(
+		http.StatusCreated,
+		apiResponse.StatusCode,
+	)
+	var relatives openapi.Household
+	s.decodeResponse(
+		apiResponse,
+		&relatives,
+	)
+	s.Equal(
+		"Relatives "+suffix,
+		relatives.Name,
+	)
+
+	// Records of the relatives' household, created by its admin
+	apiResponse = s.userRequest(
+		relativesToken,
+		http.MethodPost,
+		"/household-members",
+		&openapi.HouseholdMemberRequest{
+			Active:    utils.BoolPtr(true),
+			FirstName: "Jane",
+			LastName:  "Roe",
+			Role:      "primary",
+		},
+	)
+	s.Require().Equal(
+		http.StatusCreated,
+		apiResponse.StatusCode,
+	)
+	var member openapi.HouseholdMember
+	s.decodeResponse(
+		apiResponse,
+		&member,
+	)
+
+	accountRequest := s.createTestAccountRequest(
+		&member,
+		"150",
+	)
+	accountRequest.Name = "Relatives account " + suffix
+	apiResponse = s.userRequest(
+		relativesToken,
+		http.MethodPost,
+		"/accounts",
+		accountRequest,
+	)
+	s.Require().Equal(
+		http.StatusCreated,
+		apiResponse.StatusCode,
+	)
+	var account openapi.Account
+	s.decodeResponse(
+		apiResponse,
+		&account,
+	)
+
+	categoryType := openapi.CategoryTypeExpenditure
+	apiResponse = s.userRequest(
+		relativesToken,
+		http.MethodPost,
+		"/categories",
+		&openapi.CategoryRequest{
+			Name:         "Relatives category " + suffix,
+			Description:  "Category of another household",
+			CategoryType: &categoryType,
+		},
+	)
+	s.Require().Equal(
+		http.StatusCreated,
+		apiResponse.StatusCode,
+	)
+	var category openapi.Category
+	s.decodeResponse(
+		apiResponse,
+		&category,
+	)
+
+	tagName := "Household tag " + suffix
+	apiResponse = s.userRequest(
+		relativesToken,
+		http.MethodPost,
+		"/tags",
+		&openapi.TagRequest{
+			Name:    tagName,
+			TagType: openapi.TagTypeExpenditure,
+		},
+	)
+	s.Require().Equal(
+		http.StatusCreated,
+		apiResponse.StatusCode,
+	)
+	var tag openapi.Tag
+	s.decodeResponse(
+		apiResponse,
+		&tag,
+	)
+
+	s.Run(
+		"Current household is the household of the user",
+		func() {
+			apiResponse := s.userRequest(
+				relativesToken,
+				http.MethodGet,
+				"/households/current",
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var current openapi.Household
+			s.decodeResponse(
+				apiResponse,
+				&current,
+			)
+			s.Equal(
+				relatives.Id,
+				current.Id,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/households/current",
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.decodeResponse(
+				apiResponse,
+				&current,
+			)
+			s.Equal(
+				domain.DefaultHouseholdID,
+				current.Id,
+			)
+		},
+	)
+
+	s.Run(
+		"Households of other users are refused",
+		func() {
+			apiResponse := s.householdRequest(
+				relatives.Id,
+				http.MethodGet,
+				"/accounts/"+account.Id,
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusForbidden,
+				domain.ErrHouseholdForbidden.Error(),
+			)
+
+			apiResponse = s.householdRequest(
+				"999999999",
+				http.MethodGet,
+				"/accounts",
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusForbidden,
+				domain.ErrHouseholdForbidden.Error(),
+			)
+
+			unlinked := s.registerUser("unlinked-" + suffix + "@example.com")
+			req := s.newUserRequest(
+				*unlinked.Token,
+				http.MethodGet,
+				"/users/me",
+				nil,
+			)
+			req.Header.Set(
+				"X-Household-ID",
+				domain.DefaultHouseholdID,
+			)
+			apiResponse = s.doRequest(req)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusForbidden,
+				domain.ErrHouseholdForbidden.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Records are visible to their household",
+		func() {
+			apiResponse := s.userRequest(
+				relativesToken,
+				http.MethodGet,
+				"/accounts/"+account.Id,
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var found openapi.Account
+			s.decodeResponse(
+				apiResponse,
+				&found,
+			)
+			s.Equal(
+				accountRequest.Name,
+				found.Name,
+			)
+
+			apiResponse = s.userRequest(
+				relativesToken,
+				http.MethodGet,
+				"/tags",
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var tags []openapi.Tag
+			s.decodeResponse(
+				apiResponse,
+				&tags,
+			)
+			s.Require().Len(
+				tags,
+				1,
+			)
+			s.Equal(
+				tag.Id,
+				tags[0].Id,
+			)
+		},
+	)
+
+	s.Run(
+		"Records are hidden from other households",
+		func() {
+			apiResponse := s.apiRequest(
+				http.MethodGet,
+				"/accounts/"+account.Id,
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusNotFound,
+				domain.ErrAccountNotFound.Error(),
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/household-members/"+member.Id,
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusNotFound,
+				domain.ErrMemberNotFound.Error(),
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/accounts",
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var accounts openapi.AccountList
+			s.decodeResponse(
+				apiResponse,
+				&accounts,
+			)
+			if accounts.Accounts != nil {
+				for _, listed := range *accounts.Accounts {
+					s.NotEqual(
+						account.Id,
+						listed.Id,
+					)
+				}
+			}
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/categories",
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var categories openapi.ListCategories200JSONResponse
+			s.decodeResponse(
+				apiResponse,
+				&categories,
+			)
+			if categories.Categories != nil {
+				for _, listed := range *categories.Categories {
+					s.NotEqual(
+						category.Id,
+						listed.Id,
+					)
+				}
+			}
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/tags",
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var tags []openapi.Tag
+			s.decodeResponse(
+				apiResponse,
+				&tags,
+			)
+			for _, listed := range tags {
+				s.NotEqual(
+					tag.Id,
+					listed.Id,
+				)
+			}
+		},
+	)
+
+	s.Run(
+		"Tag names are unique per household only",
+		func() {
+			apiResponse, err := s.createTag(
+				&openapi.TagRequest{
+					Name:    tagName,
+					TagType: openapi.TagTypeExpenditure,
+				},
+			)
+			s.handleErr(
+				err,
+				"error while creating tag",
+			)
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+		},
+	)
+
+	s.Run(
+		"Records of another household cannot be referenced",
+		func() {
+			defaultMember := s.createTestHouseholdMember()
+			apiResponse := s.userRequest(
+				relativesToken,
+				http.MethodPost,
+				"/accounts",
+				s.createTestAccountRequest(
+					&defaultMember,
+					"150",
+				),
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrMemberNotFound.Error(),
+			)
+
+			apiResponse = s.userRequest(
+				relativesToken,
+				http.MethodPut,
+				"/users/me/household-member",
+				&openapi.UserMemberLinkRequest{MemberId: defaultMember.Id},
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusNotFound,
+				domain.ErrMemberNotFound.Error(),
+			)
+
+			defaultAccount := s.createTestAccountWithBalance(
+				&defaultMember,
+				"150",
+				100,
+			)
+			defaultCategory := s.createTestCategory(openapi.CategoryTypeExpenditure)
+			expenditureReq := s.createTestExpenditureRequest(
+				&defaultAccount.Id,
+				&defaultCategory,
+			)
+			expenditureReq.Tags = &[]openapi.Tag{tag}
+			apiResponse, err := s.createExpenditureRequest(expenditureReq)
+			s.handleErr(
+				err,
+				"error while making request",
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrTagNotFound.Error(),
+			)
+			s.Equal(
+				float32(100),
+				s.getAccountBalance(defaultAccount.Id),
+			)
+		},
+	)
+
+	s.Run(
+		"Use cases require a household",
+		func() {
+			_, err := s.useCases.Account.GetByID(
+				context.Background(),
+				account.Id,
+			)
+			s.ErrorIs(
+				err,
+				domain.ErrHouseholdRequired,
+			)
+
+			_, err = s.useCases.Household.CurrentHousehold(context.Background())
+			s.ErrorIs(
+				err,
+				domain.ErrHouseholdRequired,
+			)
+		},
+	)
+}
+
+// householdRequest makes an API request asking for a household through the household header
+func (s *Suite) householdRequest(
+	householdID string,
+	method string,
+	path string,
+	payload any,
+) *http.Response {
+	var body io.Reader
+	if payload != nil {
+		requestBody, err := utils.PrepareRequestBody(payload)
+		s.handleErr(
+			err,
+			"error while preparing request body",
+		)
+		body = requestBody
+	}
+
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		method,
+		"http://localhost:9091"+path,
+		body,
+	)
+	s.handleErr(
+		err,
+		"error while creating request",
+	)
+	req.Header.Set(
+		"Content-Type",
+		"application/json",
+	)
+	req.Header.Set(
+		"X-Household-ID",
+		householdID,
+	)
+
+	client := s.client()
+	apiResponse, err := client.Do(req)
+	s.handleErr(
+		err,
+		"error while making request",
+	)
+
+	return apiResponse
+}
@@ -0,0 +1,402 @@
+package integration_test
+
+import (
+	"net/http"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/integration_test/utils"
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestInstallmentPlans() {
+	s.T().Log("Starting TestInstallmentPlans")
+
+	testMember := s.createTestHouseholdMember()
+	testCategory := s.createTestCategory(openapi.CategoryTypeExpenditure)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	createPlan := func(request openapi.InstallmentPlanRequest) (
+		*http.Response,
+		openapi.InstallmentPlan,
+	) {
+		apiResponse := s.apiRequest(
+			http.MethodPost,
+			"/installment-plans",
+			request,
+		)
+		var plan openapi.InstallmentPlan
+		if apiResponse.StatusCode == http.StatusCreated {
+			s.decodeResponse(
+				apiResponse,
+				&plan,
+			)
+		}
+
+		return apiResponse, plan
+	}
+
+	s.Run(
+		"Plan charges the due installment and schedules the rest",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				1000,
+			)
+
+			apiResponse, plan := createPlan(
+				openapi.InstallmentPlanRequest{
+					AccountId:       account.Id,
+					CategoryId:      testCategory.Id,
+					Description:     "Fridge",
+					TotalAmount:     300,
+					Installments:    3,
+					InterestRate:    utils.Float32Ptr(10),
+					FirstChargeDate: openapitypes.Date{Time: today},
+				},
+			)
+
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				float32(330),
+				plan.FinancedAmount,
+			)
+			s.Equal(
+				float32(220),
+				plan.RemainingAmount,
+			)
+			s.Equal(
+				2,
+				plan.PendingInstallments,
+			)
+			s.Require().Len(
+				plan.Charges,
+				3,
+			)
+			s.Equal(
+				openapi.ExpenditureStatusCompleted,
+				plan.Charges[0].Status,
+			)
+			s.Equal(
+				openapi.ExpenditureStatusPending,
+				plan.Charges[2].Status,
+			)
+			s.Equal(
+				today.AddDate(
+					0,
+					2,
+					0,
+				).Format(time.DateOnly),
+				plan.Charges[2].ChargeDate.Format(time.DateOnly),
+			)
+			s.Equal(
+				float32(890),
+				s.getAccountBalance(account.Id),
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/expenditures/"+plan.Charges[1].ExpenditureId,
+				nil,
+			)
+			var expenditure openapi.Expenditure
+			s.decodeResponse(
+				apiResponse,
+				&expenditure,
+			)
+			s.Equal(
+				"Fridge (2/3)",
+				expenditure.Description,
+			)
+			s.Equal(
+				&plan.Id,
+				expenditure.InstallmentPlanId,
+			)
+			s.Equal(
+				utils.IntPtr(2),
+				expenditure.InstallmentNumber,
+			)
+		},
+	)
+
+	s.Run(
+		"Last installment absorbs the rounding",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				1000,
+			)
+
+			apiResponse, plan := createPlan(
+				openapi.InstallmentPlanRequest{
+					AccountId:    account.Id,
+					CategoryId:   testCategory.Id,
+					Description:  "Washing machine",
+					TotalAmount:  100,
+					Installments: 3,
+					FirstChargeDate: openapitypes.Date{
+						Time: today.AddDate(
+							0,
+							1,
+							0,
+						),
+					},
+				},
+			)
+
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Require().Len(
+				plan.Charges,
+				3,
+			)
+			s.Equal(
+				float32(33.33),
+				plan.Charges[0].Amount,
+			)
+			s.Equal(
+				float32(33.34),
+				plan.Charges[2].Amount,
+			)
+			s.Equal(
+				float32(1000),
+				s.getAccountBalance(account.Id),
+			)
+		},
+	)
+
+	s.Run(
+		"Obligations and early payoff",
+		func() {
+			member := s.createTestHouseholdMember()
+			account := s.createTestAccountWithBalance(
+				&member,
+				"150",
+				500,
+			)
+
+			_, plan := createPlan(
+				openapi.InstallmentPlanRequest{
+					AccountId:       account.Id,
+					CategoryId:      testCategory.Id,
+					Description:     "Television",
+					TotalAmount:     400,
+					Installments:    4,
+					FirstChargeDate: openapitypes.Date{Time: today},
+				},
+			)
+
+			apiResponse := s.apiRequest(
+				http.MethodGet,
+				"/installment-obligations?householdMemberId="+member.Id,
+				nil,
+			)
+			var obligations openapi.InstallmentObligationList
+			s.decodeResponse(
+				apiResponse,
+				&obligations,
+			)
+			s.Equal(
+				[]openapi.InstallmentObligation{
+					{
+						AccountId:           account.Id,
+						HouseholdMemberId:   member.Id,
+						Currency:            "150",
+						RemainingAmount:     300,
+						PendingInstallments: 3,
+						Plans:               1,
+					},
+				},
+				obligations.Obligations,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodPost,
+				"/installment-plans/"+plan.Id+"/payoff",
+				nil,
+			)
+			var paidOff openapi.InstallmentPlan
+			s.decodeResponse(
+				apiResponse,
+				&paidOff,
+			)
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				float32(0),
+				paidOff.RemainingAmount,
+			)
+			s.Equal(
+				float32(100),
+				s.getAccountBalance(account.Id),
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/installment-obligations?householdMemberId="+member.Id,
+				nil,
+			)
+			s.decodeResponse(
+				apiResponse,
+				&obligations,
+			)
+			s.Empty(obligations.Obligations)
+
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPost,
+					"/installment-plans/"+plan.Id+"/payoff",
+					nil,
+				),
+				http.StatusConflict,
+				domain.ErrInstallmentPlanPaidOff.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Installments failed to be charged are still owed",
+		func() {
+			member := s.createTestHouseholdMember()
+			account := s.createTestAccountWithBalance(
+				&member,
+				"150",
+				50,
+			)
+			tomorrow := today.AddDate(
+				0,
+				0,
+				1,
+			)
+
+			_, plan := createPlan(
+				openapi.InstallmentPlanRequest{
+					AccountId:       account.Id,
+					CategoryId:      testCategory.Id,
+					Description:     "Washing machine",
+					TotalAmount:     200,
+					Installments:    2,
+					FirstChargeDate: openapitypes.Date{Time: tomorrow},
+				},
+			)
+
+			_, failed, err := s.useCases.Expenditure.PostScheduled(
+				s.ctx,
+				time.Now().AddDate(
+					0,
+					0,
+					2,
+				),
+			)
+			s.handleErr(
+				err,
+				"error while posting scheduled expenditures",
+			)
+			s.GreaterOrEqual(
+				failed,
+				1,
+			)
+
+			apiResponse := s.apiRequest(
+				http.MethodGet,
+				"/installment-plans/"+plan.Id,
+				nil,
+			)
+			var failedPlan openapi.InstallmentPlan
+			s.decodeResponse(
+				apiResponse,
+				&failedPlan,
+			)
+			s.Require().Len(
+				failedPlan.Charges,
+				2,
+			)
+			s.Equal(
+				openapi.ExpenditureStatusFailed,
+				failedPlan.Charges[0].Status,
+			)
+			s.Equal(
+				float32(200),
+				failedPlan.RemainingAmount,
+			)
+			s.Equal(
+				2,
+				failedPlan.PendingInstallments,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/installment-obligations?householdMemberId="+member.Id,
+				nil,
+			)
+			var obligations openapi.InstallmentObligationList
+			s.decodeResponse(
+				apiResponse,
+				&obligations,
+			)
+			s.Equal(
+				[]openapi.InstallmentObligation{
+					{
+						AccountId:           account.Id,
+						HouseholdMemberId:   member.Id,
+						Currency:            "150",
+						RemainingAmount:     200,
+						PendingInstallments: 2,
+						Plans:               1,
+					},
+				},
+				obligations.Obligations,
+			)
+		},
+	)
+
+	s.Run(
+		"Plan is not created when an installment cannot be charged",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				50,
+			)
+
+			apiResponse, _ := createPlan(
+				openapi.InstallmentPlanRequest{
+					AccountId:       account.Id,
+					CategoryId:      testCategory.Id,
+					Description:     "Laptop",
+					TotalAmount:     600,
+					Installments:    6,
+					FirstChargeDate: openapitypes.Date{Time: today},
+				},
+			)
+
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrInsufficientBalance.Error(),
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/installment-plans?accountId="+account.Id,
+				nil,
+			)
+			var plans openapi.InstallmentPlanList
+			s.decodeResponse(
+				apiResponse,
+				&plans,
+			)
+			s.Empty(plans.Plans)
+		},
+	)
+}
@@ -0,0 +1,553 @@
+package integration_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/integration_test/utils"
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestInvitations() {
+	s.T().Log("Starting TestInvitations")
+
+	suffix := fmt.Sprintf(
+		"%d",
+		time.Now().UnixNano(),
+	)
+
+	registered := s.registerUser("unlinked-user-" + suffix + "@example.com")
+	s.Nil(registered.User.HouseholdMemberId)
+	unlinkedToken := *registered.Token
+
+	// The admin of the default household invites
+	inviterToken := s.token
+
+	invite := func(
+		token string,
+		email string,
+		memberID *string,
+	) *http.Response {
+		return s.userRequest(
+			token,
+			http.MethodPost,
+			"/invitations",
+			&openapi.InvitationRequest{
+				Email:    openapitypes.Email(email),
+				MemberId: memberID,
+			},
+		)
+	}
+
+	// invitationToken reads the one-time token back from the last mail sent to the address
+	invitationToken := func(email string) string {
+		mails := s.mailer.Sent(email)
+		s.Require().NotEmpty(mails)
+		words := strings.Fields(mails[len(mails)-1].Body)
+
+		return words[len(words)-1]
+	}
+
+	accept := func(token string) *http.Response {
+		return s.apiRequest(
+			http.MethodPost,
+			"/invitations/accept",
+			&openapi.InvitationAcceptRequest{
+				Token:     token,
+				Password:  "An0therP@ssword",
+				FirstName: "Nina",
+				LastName:  "Invitee",
+			},
+		)
+	}
+
+	s.Run(
+		"Inviting requires a user",
+		func() {
+			apiResponse := s.anonymousRequest(
+				http.MethodPost,
+				"/invitations",
+				&openapi.InvitationRequest{
+					Email: openapitypes.Email("anonymous-" + suffix + "@example.com"),
+				},
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusUnauthorized,
+				domain.ErrUnauthenticated.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Inviting requires a user linked to a member",
+		func() {
+			apiResponse := invite(
+				unlinkedToken,
+				"unlinked-"+suffix+"@example.com",
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusForbidden,
+				domain.ErrForbidden.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Users join a household through invitations, not by linking themselves",
+		func() {
+			other := s.registerUser("other-" + suffix + "@example.com")
+			member := s.createTestHouseholdMember()
+
+			apiResponse := s.userRequest(
+				*other.Token,
+				http.MethodPut,
+				"/users/me/household-member",
+				&openapi.UserMemberLinkRequest{MemberId: member.Id},
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusForbidden,
+				domain.ErrForbidden.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Accepting creates a user linked to a new member",
+		func() {
+			email := "new-member-" + suffix + "@example.com"
+			apiResponse := invite(
+				inviterToken,
+				email,
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			var invitation openapi.Invitation
+			s.decodeResponse(
+				apiResponse,
+				&invitation,
+			)
+			s.Equal(
+				email,
+				string(invitation.Email),
+			)
+			s.Nil(invitation.MemberId)
+			token := invitationToken(email)
+
+			apiResponse = accept("not-" + token)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusNotFound,
+				domain.ErrInvitationNotFound.Error(),
+			)
+
+			apiResponse = accept(token)
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			var accepted openapi.LoginResponse
+			s.decodeResponse(
+				apiResponse,
+				&accepted,
+			)
+			s.Require().NotNil(accepted.Token)
+			s.Require().NotNil(accepted.User)
+			s.Equal(
+				email,
+				string(accepted.User.Email),
+			)
+			s.Require().NotNil(accepted.User.HouseholdMemberId)
+			s.Require().NotNil(accepted.User.HouseholdId)
+			s.Equal(
+				domain.DefaultHouseholdID,
+				*accepted.User.HouseholdId,
+			)
+			s.Require().NotNil(accepted.User.Roles)
+			s.Equal(
+				[]string{domain.RoleMember},
+				*accepted.User.Roles,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/household-members/"+*accepted.User.HouseholdMemberId,
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var member openapi.HouseholdMember
+			s.decodeResponse(
+				apiResponse,
+				&member,
+			)
+			s.Equal(
+				"Nina",
+				member.FirstName,
+			)
+			s.Equal(
+				"Invitee",
+				member.LastName,
+			)
+
+			apiResponse = s.userRequest(
+				*accepted.Token,
+				http.MethodGet,
+				"/users/me",
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+
+			apiResponse = accept(token)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusConflict,
+				domain.ErrInvitationAccepted.Error(),
+			)
+
+			apiResponse = invite(
+				inviterToken,
+				email,
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusConflict,
+				domain.ErrUserEmailTaken.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Accepting links the user to the invited member",
+		func() {
+			member := s.createTestHouseholdMember()
+			email := "existing-member-" + suffix + "@example.com"
+			apiResponse := invite(
+				inviterToken,
+				email,
+				&member.Id,
+			)
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+
+			apiResponse = accept(invitationToken(email))
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			var accepted openapi.LoginResponse
+			s.decodeResponse(
+				apiResponse,
+				&accepted,
+			)
+			s.Require().NotNil(accepted.User)
+			s.Require().NotNil(accepted.User.HouseholdMemberId)
+			s.Equal(
+				member.Id,
+				*accepted.User.HouseholdMemberId,
+			)
+
+			apiResponse = invite(
+				inviterToken,
+				"same-member-"+suffix+"@example.com",
+				&member.Id,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusConflict,
+				domain.ErrMemberAlreadyLinked.Error(),
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodDelete,
+				"/household-members/"+member.Id,
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrMemberLinkedToUser.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Only members of the household can be invited as themselves",
+		func() {
+			apiResponse := invite(
+				inviterToken,
+				"missing-member-"+suffix+"@example.com",
+				utils.StringPtr("999999999"),
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrMemberNotFound.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Linked users act on their own household",
+		func() {
+			founder := s.registerUser("founder-" + suffix + "@example.com")
+			apiResponse := s.userRequest(
+				*founder.Token,
+				http.MethodPost,
+				"/households",
+				&openapi.HouseholdRequest{Name: "Elsewhere " + suffix},
+			)
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			var elsewhere openapi.Household
+			s.decodeResponse(
+				apiResponse,
+				&elsewhere,
+			)
+
+			req := s.newUserRequest(
+				inviterToken,
+				http.MethodGet,
+				"/households/current",
+				nil,
+			)
+			req.Header.Set(
+				"X-Household-ID",
+				elsewhere.Id,
+			)
+			apiResponse = s.doRequest(req)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusForbidden,
+				domain.ErrHouseholdForbidden.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Registered users join through an invitation",
+		func() {
+			email := "joiner-" + suffix + "@example.com"
+			joiner := s.registerUser(email)
+			apiResponse := invite(
+				inviterToken,
+				email,
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			token := invitationToken(email)
+
+			join := func(userToken string) *http.Response {
+				return s.userRequest(
+					userToken,
+					http.MethodPost,
+					"/invitations/join",
+					&openapi.InvitationJoinRequest{Token: token},
+				)
+			}
+
+			apiResponse = join(unlinkedToken)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusForbidden,
+				domain.ErrInvitationForOther.Error(),
+			)
+
+			apiResponse = join(*joiner.Token)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var joined openapi.User
+			s.decodeResponse(
+				apiResponse,
+				&joined,
+			)
+			s.Equal(
+				joiner.User.Id,
+				joined.Id,
+			)
+			s.Require().NotNil(joined.HouseholdMemberId)
+			s.Require().NotNil(joined.HouseholdId)
+			s.Equal(
+				domain.DefaultHouseholdID,
+				*joined.HouseholdId,
+			)
+			s.Require().NotNil(joined.Roles)
+			s.Equal(
+				[]string{domain.RoleMember},
+				*joined.Roles,
+			)
+
+			apiResponse = join(*joiner.Token)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusConflict,
+				domain.ErrUserAlreadyLinked.Error(),
+			)
+
+			apiResponse = invite(
+				inviterToken,
+				email,
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusConflict,
+				domain.ErrUserEmailTaken.Error(),
+			)
+		},
+	)
+}
+
+// registerUser registers a user not linked to any member yet and returns it with its bearer token
+func (s *Suite) registerUser(email string) openapi.RegisterUser201JSONResponse {
+	apiResponse := s.apiRequest(
+		http.MethodPost,
+		"/auth/register",
+		&openapi.RegisterUserJSONRequestBody{
+			Email:     openapitypes.Email(email),
+			Password:  "SecureP@ssw0rd",
+			FirstName: "Regina",
+			LastName:  "Registered",
+		},
+	)
+	s.Require().Equal(
+		http.StatusCreated,
+		apiResponse.StatusCode,
+	)
+	var registered openapi.RegisterUser201JSONResponse
+	s.decodeResponse(
+		apiResponse,
+		&registered,
+	)
+	s.Require().NotNil(registered.Token)
+
+	return registered
+}
+
+// userRequest makes an API request authenticated with the bearer token of a user
+func (s *Suite) userRequest(
+	token string,
+	method string,
+	path string,
+	payload any,
+) *http.Response {
+	return s.doRequest(
+		s.newUserRequest(
+			token,
+			method,
+			path,
+			payload,
+		),
+	)
+}
+
+func (s *Suite) newUserRequest(
+	token string,
+	method string,
+	path string,
+	payload any,
+) *http.Request {
+	req := s.newRequest(
+		method,
+		path,
+		payload,
+	)
+	req.Header.Set(
+		"Authorization",
+		"Bearer "+token,
+	)
+
+	return req
+}
+
+// anonymousRequest makes an API request without any bearer token
+func (s *Suite) anonymousRequest(
+	method string,
+	path string,
+	payload any,
+) *http.Response {
+	client := &http.Client{}
+	apiResponse, err := client.Do(
+		s.newRequest(
+			method,
+			path,
+			payload,
+		),
+	)
+	s.handleErr(
+		err,
+		"error while making request",
+	)
+
+	return apiResponse
+}
+
+func (s *Suite) newRequest(
+	method string,
+	path string,
+	payload any,
+) *http.Request {
+	var body io.Reader
+	if payload != nil {
+		requestBody, err := utils.PrepareRequestBody(payload)
+		s.handleErr(
+			err,
+			"error while preparing request body",
+		)
+		body = requestBody
+	}
+
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		method,
+		"http://localhost:9091"+path,
+		body,
+	)
+	s.handleErr(
+		err,
+		"error while creating request",
+	)
+	req.Header.Set(
+		"Content-Type",
+		"application/json",
+	)
+
+	return req
+}
+
+func (s *Suite) doRequest(req *http.Request) *http.Response {
+	client := s.client()
+	apiResponse, err := client.Do(req)
+	s.handleErr(
+		err,
+		"error while making request",
+	)
+
+	return apiResponse
+}
@@ -8,7 +8,6 @@ TRUNCATE TABLE proletariat_budget.household_members;
 TRUNCATE TABLE proletariat_budget.ingress_recurrence_patterns;
 TRUNCATE TABLE proletariat_budget.ingress_tags;
 TRUNCATE TABLE proletariat_budget.ingresses;
-TRUNCATE TABLE proletariat_budget.roles;
 TRUNCATE TABLE proletariat_budget.savings_contribution_tags;
 TRUNCATE TABLE proletariat_budget.savings_contributions;
 TRUNCATE TABLE proletariat_budget.savings_goal_tags;
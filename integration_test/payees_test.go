@@ -0,0 +1,239 @@
+package integration_test
+
+import (
+	"net/http"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestPayees() {
+	s.T().Log("Starting TestPayees")
+
+	testMember := s.createTestHouseholdMember()
+	testCategory := s.createTestCategory(openapi.CategoryTypeExpenditure)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	apiResponse := s.apiRequest(
+		http.MethodPost,
+		"/payees",
+		openapi.PayeeRequest{
+			Name: "Lidl",
+			Aliases: &[]string{
+				"LIDL 123",
+				" lidl 123 ",
+				"lidl",
+			},
+			DefaultCategoryId: &testCategory.Id,
+		},
+	)
+	var payee openapi.Payee
+	s.decodeResponse(
+		apiResponse,
+		&payee,
+	)
+
+	s.Run(
+		"Create a payee with aliases",
+		func() {
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				"Lidl",
+				payee.Name,
+			)
+			// Repeated aliases and aliases equal to the name are dropped
+			s.Equal(
+				[]string{"LIDL 123"},
+				payee.Aliases,
+			)
+		},
+	)
+
+	s.Run(
+		"Create a payee with an alias of another payee",
+		func() {
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/payees",
+				openapi.PayeeRequest{
+					Name:    "Lidl Express",
+					Aliases: &[]string{"Lidl 123"},
+				},
+			)
+
+			s.assertHttpError(
+				apiResponse,
+				http.StatusConflict,
+				domain.ErrPayeeAlreadyExists.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Expenditures are matched to the payee by alias and take its default category",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				500,
+			)
+			expenditureReq := s.createTestExpenditureRequest(
+				&account.Id,
+				&testCategory,
+			)
+			expenditureReq.Category = nil
+			expenditureReq.Description = "lidl 123"
+			expenditureReq.Amount = 30
+			expenditureReq.Date = openapitypes.Date{Time: today}
+
+			apiResponse, err := s.createExpenditureRequest(expenditureReq)
+			s.handleErr(
+				err,
+				"error while creating expenditure",
+			)
+			var expenditure openapi.Expenditure
+			s.decodeResponse(
+				apiResponse,
+				&expenditure,
+			)
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Require().NotNil(expenditure.PayeeId)
+			s.Equal(
+				payee.Id,
+				*expenditure.PayeeId,
+			)
+			s.Equal(
+				testCategory.Id,
+				expenditure.Category.Id,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/expenditures?payeeId="+payee.Id+"&limit=10&offset=0",
+				nil,
+			)
+			var expenditures openapi.ExpenditureList
+			s.decodeResponse(
+				apiResponse,
+				&expenditures,
+			)
+			s.Equal(
+				1,
+				expenditures.Metadata.Total,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/reports/payee-spending?currency=150&accountId="+account.Id,
+				nil,
+			)
+			var report openapi.PayeeSpendingReport
+			s.decodeResponse(
+				apiResponse,
+				&report,
+			)
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Require().Len(
+				report.Payees,
+				1,
+			)
+			s.Equal(
+				payee.Id,
+				*report.Payees[0].PayeeId,
+			)
+			s.Equal(
+				30.0,
+				report.Payees[0].Total,
+			)
+		},
+	)
+
+	s.Run(
+		"Expenditures without category and payee are rejected",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				500,
+			)
+			expenditureReq := s.createTestExpenditureRequest(
+				&account.Id,
+				&testCategory,
+			)
+			expenditureReq.Category = nil
+			expenditureReq.Date = openapitypes.Date{Time: today}
+
+			apiResponse, err := s.createExpenditureRequest(expenditureReq)
+			s.handleErr(
+				err,
+				"error while creating expenditure",
+			)
+
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrExpenditureCategoryRequired.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Update and delete a payee",
+		func() {
+			apiResponse := s.apiRequest(
+				http.MethodPut,
+				"/payees/"+payee.Id,
+				openapi.PayeeRequest{
+					Name:    "Lidl",
+					Aliases: &[]string{"LIDL SAGT DANKE"},
+				},
+			)
+			var updated openapi.Payee
+			s.decodeResponse(
+				apiResponse,
+				&updated,
+			)
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				[]string{"LIDL SAGT DANKE"},
+				updated.Aliases,
+			)
+			s.Nil(updated.DefaultCategoryId)
+
+			apiResponse = s.apiRequest(
+				http.MethodDelete,
+				"/payees/"+payee.Id,
+				nil,
+			)
+			s.Equal(
+				http.StatusNoContent,
+				apiResponse.StatusCode,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/payees/"+payee.Id,
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusNotFound,
+				domain.ErrPayeeNotFound.Error(),
+			)
+		},
+	)
+}
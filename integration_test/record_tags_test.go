@@ -0,0 +1,213 @@
+package integration_test
+
+import (
+	"net/http"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+)
+
+func (s *Suite) TestRecordTags() {
+	s.T().Log("Starting TestRecordTags")
+
+	createTag := func(
+		name string,
+		tagType openapi.TagType,
+	) openapi.Tag {
+		apiResponse, err := s.createTag(
+			&openapi.TagRequest{
+				Name:    name,
+				TagType: tagType,
+			},
+		)
+		s.handleErr(
+			err,
+			"error while creating tag",
+		)
+		s.Require().Equal(
+			http.StatusCreated,
+			apiResponse.StatusCode,
+		)
+		var tag openapi.Tag
+		s.decodeResponse(
+			apiResponse,
+			&tag,
+		)
+
+		return tag
+	}
+
+	testMember := s.createTestHouseholdMember()
+	account := s.createTestAccountWithBalance(
+		&testMember,
+		"150",
+		1000,
+	)
+	category := s.createTestCategory(openapi.CategoryTypeExpenditure)
+	expenditureReq := s.createTestExpenditureRequest(
+		&account.Id,
+		&category,
+	)
+	expenditureReq.Tags = nil
+	apiResponse, err := s.createExpenditureRequest(expenditureReq)
+	s.handleErr(
+		err,
+		"error while creating expenditure",
+	)
+	s.Require().Equal(
+		http.StatusCreated,
+		apiResponse.StatusCode,
+	)
+	var expenditure openapi.Expenditure
+	s.decodeResponse(
+		apiResponse,
+		&expenditure,
+	)
+	recordPath := "/tags/type/expenditure/records/" + expenditure.Id
+
+	food := createTag(
+		"Record tags food",
+		openapi.TagTypeExpenditure,
+	)
+	travel := createTag(
+		"Record tags travel",
+		openapi.TagTypeExpenditure,
+	)
+	gifts := createTag(
+		"Record tags gifts",
+		openapi.TagTypeExpenditure,
+	)
+
+	tagIDs := func(apiResponse *http.Response) []string {
+		s.Require().Equal(
+			http.StatusOK,
+			apiResponse.StatusCode,
+		)
+		var tags []openapi.Tag
+		s.decodeResponse(
+			apiResponse,
+			&tags,
+		)
+		ids := make(
+			[]string,
+			0,
+			len(tags),
+		)
+		for _, tag := range tags {
+			ids = append(
+				ids,
+				tag.Id,
+			)
+		}
+
+		return ids
+	}
+
+	s.Run(
+		"Adding tags twice links them once",
+		func() {
+			for range 2 {
+				s.ElementsMatch(
+					[]string{food.Id, travel.Id},
+					tagIDs(
+						s.apiRequest(
+							http.MethodPost,
+							recordPath,
+							openapi.TagLinkRequest{
+								TagIds: []string{food.Id, travel.Id, food.Id},
+							},
+						),
+					),
+				)
+			}
+		},
+	)
+
+	s.Run(
+		"Removing a tag the record does not have changes nothing",
+		func() {
+			for range 2 {
+				apiResponse := s.apiRequest(
+					http.MethodDelete,
+					recordPath+"/"+food.Id,
+					nil,
+				)
+				s.Equal(
+					http.StatusNoContent,
+					apiResponse.StatusCode,
+				)
+			}
+			s.Equal(
+				[]string{travel.Id},
+				tagIDs(
+					s.apiRequest(
+						http.MethodGet,
+						recordPath,
+						nil,
+					),
+				),
+			)
+		},
+	)
+
+	s.Run(
+		"Setting the tags replaces them",
+		func() {
+			s.Equal(
+				[]string{gifts.Id},
+				tagIDs(
+					s.apiRequest(
+						http.MethodPut,
+						recordPath,
+						openapi.TagLinkRequest{
+							TagIds: []string{gifts.Id},
+						},
+					),
+				),
+			)
+			s.Empty(
+				tagIDs(
+					s.apiRequest(
+						http.MethodPut,
+						recordPath,
+						openapi.TagLinkRequest{
+							TagIds: []string{},
+						},
+					),
+				),
+			)
+		},
+	)
+
+	s.Run(
+		"Tags must match the type of the record",
+		func() {
+			salary := createTag(
+				"Record tags salary",
+				openapi.TagTypeIngress,
+			)
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPost,
+					recordPath,
+					openapi.TagLinkRequest{
+						TagIds: []string{salary.Id},
+					},
+				),
+				http.StatusBadRequest,
+				domain.ErrTagTypeMismatch.Error(),
+			)
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPost,
+					"/tags/type/ingress/records/"+expenditure.Id+"999",
+					openapi.TagLinkRequest{
+						TagIds: []string{salary.Id},
+					},
+				),
+				http.StatusNotFound,
+				domain.ErrTaggedRecordNotFound.Error(),
+			)
+		},
+	)
+}
@@ -0,0 +1,329 @@
+package integration_test
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/integration_test/utils"
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestRecurringBills() {
+	s.T().Log("Starting TestRecurringBills")
+
+	testMember := s.createTestHouseholdMember()
+	testCategory := s.createTestCategory(openapi.CategoryTypeExpenditure)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	s.Run(
+		"Create a recurring bill and list its upcoming bills",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				500,
+			)
+			patternReq := s.createTestRecurrencePatternRequest(
+				account.Id,
+				testCategory.Id,
+				today,
+			)
+
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/recurring-bills",
+				patternReq,
+			)
+			var pattern openapi.ExpenditureRecurrencePattern
+			s.decodeResponse(
+				apiResponse,
+				&pattern,
+			)
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.True(pattern.Active)
+			s.Equal(
+				patternReq.StartDate,
+				pattern.NextDueDate,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/upcoming-bills?from="+today.Format(time.DateOnly)+"&to="+today.AddDate(
+					0,
+					2,
+					0,
+				).Format(time.DateOnly),
+				nil,
+			)
+			var bills openapi.UpcomingBillList
+			s.decodeResponse(
+				apiResponse,
+				&bills,
+			)
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				3,
+				bills.Total,
+			)
+			s.Equal(
+				pattern.Id,
+				bills.Bills[0].PatternId,
+			)
+			s.Equal(
+				openapitypes.Date{Time: today},
+				bills.Bills[0].DueDate,
+			)
+		},
+	)
+
+	s.Run(
+		"Create a recurring bill with an invalid interval",
+		func() {
+			account := s.createTestAccount(
+				&testMember,
+				"150",
+			)
+			patternReq := s.createTestRecurrencePatternRequest(
+				account.Id,
+				testCategory.Id,
+				today,
+			)
+			patternReq.Interval = 0
+
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/recurring-bills",
+				patternReq,
+			)
+
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrInvalidRecurrenceInterval.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Post an estimated bill with its actual amount",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				500,
+			)
+			patternReq := s.createTestRecurrencePatternRequest(
+				account.Id,
+				testCategory.Id,
+				today,
+			)
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/recurring-bills",
+				patternReq,
+			)
+			var pattern openapi.ExpenditureRecurrencePattern
+			s.decodeResponse(
+				apiResponse,
+				&pattern,
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodPost,
+				"/recurring-bills/"+pattern.Id+"/post",
+				openapi.ExpenditureRecurrencePostRequest{
+					Amount: utils.Float32Ptr(52.3),
+				},
+			)
+			var expenditure openapi.Expenditure
+			s.decodeResponse(
+				apiResponse,
+				&expenditure,
+			)
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				float32(52.3),
+				expenditure.Amount,
+			)
+			s.Equal(
+				pattern.Id,
+				*expenditure.RecurrencePatternId,
+			)
+			s.True(*expenditure.Planned)
+			s.Equal(
+				float32(500-52.3),
+				s.getAccountBalance(account.Id),
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/recurring-bills/"+pattern.Id,
+				nil,
+			)
+			var postedPattern openapi.ExpenditureRecurrencePattern
+			s.decodeResponse(
+				apiResponse,
+				&postedPattern,
+			)
+			s.Equal(
+				openapitypes.Date{Time: today.AddDate(
+					0,
+					1,
+					0,
+				)},
+				postedPattern.NextDueDate,
+			)
+		},
+	)
+
+	s.Run(
+		"Auto-post bills are generated when due",
+		func() {
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				500,
+			)
+			patternReq := s.createTestRecurrencePatternRequest(
+				account.Id,
+				testCategory.Id,
+				today.AddDate(
+					0,
+					-2,
+					0,
+				),
+			)
+			patternReq.AutoPost = true
+			patternReq.AmountType = openapi.Fixed
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/recurring-bills",
+				patternReq,
+			)
+			var pattern openapi.ExpenditureRecurrencePattern
+			s.decodeResponse(
+				apiResponse,
+				&pattern,
+			)
+
+			generated, _, err := s.useCases.ExpenditureRecurrence.GenerateDue(
+				s.ctx,
+				time.Now(),
+			)
+			s.handleErr(
+				err,
+				"error while generating recurring bills",
+			)
+			s.Equal(
+				3,
+				generated,
+			)
+			s.Equal(
+				500-3*patternReq.Amount,
+				s.getAccountBalance(account.Id),
+			)
+
+			apiResponse = s.apiRequest(
+				http.MethodGet,
+				"/expenditures?recurrencePatternId="+pattern.Id+"&limit=10&offset=0",
+				nil,
+			)
+			var expenditures openapi.ExpenditureList
+			s.decodeResponse(
+				apiResponse,
+				&expenditures,
+			)
+			s.Equal(
+				3,
+				expenditures.Metadata.Total,
+			)
+		},
+	)
+
+	s.Run(
+		"Post a bill of an unknown recurring bill",
+		func() {
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/recurring-bills/999999/post",
+				openapi.ExpenditureRecurrencePostRequest{},
+			)
+
+			s.assertHttpError(
+				apiResponse,
+				http.StatusNotFound,
+				domain.ErrExpenditureRecurrencePatternNotFound.Error(),
+			)
+		},
+	)
+}
+
+func (s *Suite) createTestRecurrencePatternRequest(
+	accountID string,
+	categoryID string,
+	startDate time.Time,
+) *openapi.ExpenditureRecurrencePatternRequest {
+	return &openapi.ExpenditureRecurrencePatternRequest{
+		AccountId:   accountID,
+		CategoryId:  categoryID,
+		Amount:      45.5,
+		AmountType:  openapi.Estimated,
+		Description: "Electricity bill",
+		Frequency:   openapi.RecurrenceFrequencyMonthly,
+		Interval:    1,
+		StartDate:   openapitypes.Date{Time: startDate},
+		AutoPost:    false,
+	}
+}
+
+func (s *Suite) apiRequest(
+	method string,
+	path string,
+	payload any,
+) *http.Response {
+	var body io.Reader
+	if payload != nil {
+		requestBody, err := utils.PrepareRequestBody(payload)
+		s.handleErr(
+			err,
+			"error while preparing request body",
+		)
+		body = requestBody
+	}
+
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		method,
+		"http://localhost:9091"+path,
+		body,
+	)
+	s.handleErr(
+		err,
+		"error while creating request",
+	)
+	req.Header.Set(
+		"Content-Type",
+		"application/json",
+	)
+
+	client := s.client()
+	apiResponse, err := client.Do(req)
+	s.handleErr(
+		err,
+		"error while making request",
+	)
+
+	return apiResponse
+}
@@ -0,0 +1,300 @@
+package integration_test
+
+import (
+	"encoding/csv"
+	"net/http"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/integration_test/utils"
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestPlannedSpendingReport() {
+	s.T().Log("Starting TestPlannedSpendingReport")
+
+	testMember := s.createTestHouseholdMember()
+	testAccount := s.createTestAccountWithBalance(
+		&testMember,
+		"150",
+		1000,
+	)
+	testCategory := s.createTestCategory(openapi.CategoryTypeExpenditure)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	for _, expense := range []struct {
+		description string
+		planned     bool
+	}{
+		{"Weekly groceries", true},
+		{"Cinema tickets", false},
+		{"Taxi ride", false},
+	} {
+		expenditureReq := s.createTestExpenditureRequest(
+			&testAccount.Id,
+			&testCategory,
+		)
+		expenditureReq.Amount = 100
+		expenditureReq.Description = expense.description
+		expenditureReq.Planned = utils.BoolPtr(expense.planned)
+		expenditureReq.Date = openapitypes.Date{Time: today}
+		apiResponse, err := s.createExpenditureRequest(expenditureReq)
+		s.handleErr(
+			err,
+			"error while creating expenditure",
+		)
+		s.Equal(
+			http.StatusCreated,
+			apiResponse.StatusCode,
+		)
+	}
+
+	s.Run(
+		"Planned vs unplanned totals of the current month",
+		func() {
+			apiResponse := s.reportRequest("/reports/planned-spending?currency=150&period=month&periods=3")
+
+			var report openapi.PlannedSpendingReport
+			s.decodeResponse(
+				apiResponse,
+				&report,
+			)
+
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Require().Len(
+				report.Periods,
+				3,
+			)
+			current := report.Periods[2]
+			s.Equal(
+				100.0,
+				current.Totals.Planned,
+			)
+			s.Equal(
+				200.0,
+				current.Totals.Unplanned,
+			)
+			s.InDelta(
+				2.0/3.0,
+				current.Totals.UnplannedRatio,
+				0.0001,
+			)
+			s.Require().Len(
+				report.TopUnplannedCategories,
+				1,
+			)
+			s.Equal(
+				testCategory.Id,
+				report.TopUnplannedCategories[0].CategoryId,
+			)
+			s.Equal(
+				openapi.Increasing,
+				report.UnplannedTrend,
+			)
+		},
+	)
+
+	s.Run(
+		"Planned vs unplanned report with an invalid number of periods",
+		func() {
+			apiResponse := s.reportRequest("/reports/planned-spending?currency=150&periods=0")
+
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrInvalidReportPeriods.Error(),
+			)
+		},
+	)
+}
+
+func (s *Suite) TestDeclaredExpensesReport() {
+	s.T().Log("Starting TestDeclaredExpensesReport")
+
+	testMember := s.createTestHouseholdMember()
+	testAccount := s.createTestAccountWithBalance(
+		&testMember,
+		"150",
+		1000,
+	)
+	testCategory := s.createTestCategory(openapi.CategoryTypeExpenditure)
+	// fiscal year 2001 starting in July runs from 2001-07-01 to 2002-06-30
+	inside := time.Date(
+		2002,
+		time.March,
+		15,
+		0,
+		0,
+		0,
+		0,
+		time.UTC,
+	)
+	outside := time.Date(
+		2002,
+		time.July,
+		1,
+		0,
+		0,
+		0,
+		0,
+		time.UTC,
+	)
+
+	for _, expense := range []struct {
+		description string
+		date        time.Time
+		declared    bool
+	}{
+		{"Dentist", inside, true},
+		{"Pharmacy", inside, true},
+		{"Bookshop", inside, false},
+		{"Optician", outside, true},
+	} {
+		expenditureReq := s.createTestExpenditureRequest(
+			&testAccount.Id,
+			&testCategory,
+		)
+		expenditureReq.Amount = 40
+		expenditureReq.Description = expense.description
+		expenditureReq.Declared = utils.BoolPtr(expense.declared)
+		expenditureReq.Date = openapitypes.Date{Time: expense.date}
+		apiResponse, err := s.createExpenditureRequest(expenditureReq)
+		s.handleErr(
+			err,
+			"error while creating expenditure",
+		)
+		s.Equal(
+			http.StatusCreated,
+			apiResponse.StatusCode,
+		)
+	}
+
+	path := "/reports/declared-expenses?year=2001&startMonth=7&memberId=" + testMember.Id
+
+	s.Run(
+		"Declared expenses of a fiscal year as JSON",
+		func() {
+			apiResponse := s.reportRequest(path)
+
+			var report openapi.DeclaredExpensesReport
+			s.decodeResponse(
+				apiResponse,
+				&report,
+			)
+
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Contains(
+				apiResponse.Header.Get("Content-Disposition"),
+				"declared-expenses-2001.json",
+			)
+			s.Len(
+				report.Expenses,
+				2,
+			)
+			s.Require().Len(
+				report.Totals,
+				1,
+			)
+			s.Equal(
+				80.0,
+				report.Totals[0].Total,
+			)
+			s.Require().Len(
+				report.ByCategory,
+				1,
+			)
+			s.Equal(
+				testCategory.Id,
+				report.ByCategory[0].Id,
+			)
+			s.Require().Len(
+				report.ByMember,
+				1,
+			)
+			s.Equal(
+				testMember.Id,
+				report.ByMember[0].Id,
+			)
+			s.Equal(
+				2,
+				report.ByMember[0].Count,
+			)
+		},
+	)
+
+	s.Run(
+		"Declared expenses of a fiscal year as CSV",
+		func() {
+			apiResponse := s.reportRequest(path + "&format=csv")
+			defer apiResponse.Body.Close()
+
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				"text/csv",
+				apiResponse.Header.Get("Content-Type"),
+			)
+			rows, err := csv.NewReader(apiResponse.Body).ReadAll()
+			s.handleErr(
+				err,
+				"error while reading CSV",
+			)
+			s.Require().Len(
+				rows,
+				3,
+			)
+			s.Equal(
+				"2002-03-15",
+				rows[1][1],
+			)
+			s.Equal(
+				"40.00",
+				rows[1][3],
+			)
+		},
+	)
+
+	s.Run(
+		"Declared expenses of an invalid fiscal year",
+		func() {
+			apiResponse := s.reportRequest("/reports/declared-expenses?year=0")
+
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrInvalidFiscalYear.Error(),
+			)
+		},
+	)
+}
+
+func (s *Suite) reportRequest(path string) *http.Response {
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodGet,
+		"http://localhost:9091"+path,
+		nil,
+	)
+	s.handleErr(
+		err,
+		"error while creating request",
+	)
+
+	client := s.client()
+	apiResponse, err := client.Do(req)
+	s.handleErr(
+		err,
+		"error while making request",
+	)
+
+	return apiResponse
+}
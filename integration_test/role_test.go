@@ -0,0 +1,561 @@
+package integration_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestRoles() {
+	s.T().Log("Starting TestRoles")
+
+	suffix := fmt.Sprintf(
+		"%d",
+		time.Now().UnixNano(),
+	)
+
+	// A household of its own, whose founder becomes its admin
+	adminToken := *s.registerUser("admin-" + suffix + "@example.com").Token
+	apiResponse := s.userRequest(
+		adminToken,
+		http.MethodPost,
+		"/households",
+		&openapi.HouseholdRequest{Name: "Roles " + suffix},
+	)
+	s.Require().Equal(
+		http.StatusCreated,
+		apiResponse.StatusCode,
+	)
+	var household openapi.Household
+	s.decodeResponse(
+		apiResponse,
+		&household,
+	)
+
+	apiResponse = s.userRequest(
+		adminToken,
+		http.MethodGet,
+		"/users/me",
+		nil,
+	)
+	s.Require().Equal(
+		http.StatusOK,
+		apiResponse.StatusCode,
+	)
+	var admin openapi.User
+	s.decodeResponse(
+		apiResponse,
+		&admin,
+	)
+	s.Require().NotNil(admin.HouseholdId)
+	s.Equal(
+		household.Id,
+		*admin.HouseholdId,
+	)
+	s.Require().NotNil(admin.Roles)
+	s.Equal(
+		[]string{domain.RoleAdmin},
+		*admin.Roles,
+	)
+	s.Require().NotNil(admin.HouseholdMemberId)
+
+	apiResponse = s.userRequest(
+		adminToken,
+		http.MethodGet,
+		"/household-members/"+*admin.HouseholdMemberId,
+		nil,
+	)
+	s.Require().Equal(
+		http.StatusOK,
+		apiResponse.StatusCode,
+	)
+	var adminMember openapi.HouseholdMember
+	s.decodeResponse(
+		apiResponse,
+		&adminMember,
+	)
+
+	apiResponse = s.userRequest(
+		adminToken,
+		http.MethodPost,
+		"/accounts",
+		s.createTestAccountRequest(
+			&adminMember,
+			"150",
+		),
+	)
+	s.Require().Equal(
+		http.StatusCreated,
+		apiResponse.StatusCode,
+	)
+	var account openapi.Account
+	s.decodeResponse(
+		apiResponse,
+		&account,
+	)
+
+	categoryType := openapi.CategoryTypeExpenditure
+	apiResponse = s.userRequest(
+		adminToken,
+		http.MethodPost,
+		"/categories",
+		&openapi.CategoryRequest{
+			Name:         "Pocket money " + suffix,
+			Description:  "Spending of the teenager",
+			CategoryType: &categoryType,
+		},
+	)
+	s.Require().Equal(
+		http.StatusCreated,
+		apiResponse.StatusCode,
+	)
+	var category openapi.Category
+	s.decodeResponse(
+		apiResponse,
+		&category,
+	)
+
+	// The teenager joins through an invitation, as a member
+	teenEmail := "teen-" + suffix + "@example.com"
+	apiResponse = s.userRequest(
+		adminToken,
+		http.MethodPost,
+		"/invitations",
+		&openapi.InvitationRequest{Email: openapitypes.Email(teenEmail)},
+	)
+	s.Require().Equal(
+		http.StatusCreated,
+		apiResponse.StatusCode,
+	)
+	mails := s.mailer.Sent(teenEmail)
+	s.Require().NotEmpty(mails)
+	words := strings.Fields(mails[len(mails)-1].Body)
+	apiResponse = s.apiRequest(
+		http.MethodPost,
+		"/invitations/accept",
+		&openapi.InvitationAcceptRequest{
+			Token:     words[len(words)-1],
+			Password:  "T33nagerP@ss",
+			FirstName: "Terry",
+			LastName:  "Teen",
+		},
+	)
+	s.Require().Equal(
+		http.StatusCreated,
+		apiResponse.StatusCode,
+	)
+	var accepted openapi.LoginResponse
+	s.decodeResponse(
+		apiResponse,
+		&accepted,
+	)
+	s.Require().NotNil(accepted.User)
+	teen := *accepted.User
+	teenToken := *accepted.Token
+	s.Require().NotNil(teen.Roles)
+	s.Equal(
+		[]string{domain.RoleMember},
+		*teen.Roles,
+	)
+
+	setRoles := func(
+		token string,
+		userID string,
+		roles []string,
+	) *http.Response {
+		return s.userRequest(
+			token,
+			http.MethodPut,
+			"/users/"+userID+"/roles",
+			&openapi.UserRolesRequest{Roles: roles},
+		)
+	}
+
+	s.Run(
+		"Households are founded by users not linked yet",
+		func() {
+			apiResponse := s.userRequest(
+				adminToken,
+				http.MethodPost,
+				"/households",
+				&openapi.HouseholdRequest{Name: "Second " + suffix},
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusConflict,
+				domain.ErrUserAlreadyLinked.Error(),
+			)
+
+			apiResponse = s.anonymousRequest(
+				http.MethodPost,
+				"/households",
+				&openapi.HouseholdRequest{Name: "Anonymous " + suffix},
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusUnauthorized,
+				domain.ErrUnauthenticated.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Members log expenditures but do not delete accounts",
+		func() {
+			apiResponse := s.userRequest(
+				teenToken,
+				http.MethodPost,
+				"/expenditures",
+				s.createTestExpenditureRequest(
+					&account.Id,
+					&category,
+				),
+			)
+			s.Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+
+			apiResponse = s.userRequest(
+				teenToken,
+				http.MethodDelete,
+				"/accounts/"+account.Id,
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusForbidden,
+				domain.ErrForbidden.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Members do not manage roles",
+		func() {
+			apiResponse := s.userRequest(
+				teenToken,
+				http.MethodGet,
+				"/users",
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusForbidden,
+				domain.ErrForbidden.Error(),
+			)
+
+			apiResponse = setRoles(
+				teenToken,
+				teen.Id,
+				[]string{domain.RoleAdmin},
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusForbidden,
+				domain.ErrForbidden.Error(),
+			)
+
+			apiResponse = s.userRequest(
+				teenToken,
+				http.MethodPut,
+				"/users/me/household-member",
+				&openapi.UserMemberLinkRequest{MemberId: adminMember.Id},
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusForbidden,
+				domain.ErrForbidden.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Anonymous requests are refused but for the public operations",
+		func() {
+			apiResponse := s.anonymousRequest(
+				http.MethodGet,
+				"/accounts/"+account.Id,
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusUnauthorized,
+				domain.ErrUnauthenticated.Error(),
+			)
+
+			apiResponse = s.anonymousRequest(
+				http.MethodGet,
+				"/roles",
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusUnauthorized,
+				domain.ErrUnauthenticated.Error(),
+			)
+
+			apiResponse = s.anonymousRequest(
+				http.MethodPost,
+				"/auth/login",
+				&openapi.LoginRequest{
+					Email:    openapitypes.Email("admin-" + suffix + "@example.com"),
+					Password: "SecureP@ssw0rd",
+				},
+			)
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+		},
+	)
+
+	s.Run(
+		"Roles list their permissions",
+		func() {
+			apiResponse := s.userRequest(
+				teenToken,
+				http.MethodGet,
+				"/roles",
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var roles []openapi.Role
+			s.decodeResponse(
+				apiResponse,
+				&roles,
+			)
+			permissions := make(map[string][]openapi.Permission)
+			for _, role := range roles {
+				permissions[role.Name] = role.Permissions
+			}
+			s.Equal(
+				map[string][]openapi.Permission{
+					domain.RoleAdmin: {
+						openapi.PermissionRead,
+						openapi.PermissionWrite,
+						openapi.PermissionDelete,
+						openapi.PermissionManage,
+					},
+					domain.RoleMember: {
+						openapi.PermissionRead,
+						openapi.PermissionWrite,
+					},
+					domain.RoleViewer: {
+						openapi.PermissionRead,
+					},
+				},
+				permissions,
+			)
+		},
+	)
+
+	s.Run(
+		"Admins list the users of their household",
+		func() {
+			apiResponse := s.userRequest(
+				adminToken,
+				http.MethodGet,
+				"/users",
+				nil,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var users []openapi.User
+			s.decodeResponse(
+				apiResponse,
+				&users,
+			)
+			s.Require().Len(
+				users,
+				2,
+			)
+			s.Equal(
+				admin.Id,
+				users[0].Id,
+			)
+			s.Equal(
+				teen.Id,
+				users[1].Id,
+			)
+		},
+	)
+
+	s.Run(
+		"Viewers only read",
+		func() {
+			apiResponse := setRoles(
+				adminToken,
+				teen.Id,
+				[]string{domain.RoleViewer},
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var viewer openapi.User
+			s.decodeResponse(
+				apiResponse,
+				&viewer,
+			)
+			s.Require().NotNil(viewer.Roles)
+			s.Equal(
+				[]string{domain.RoleViewer},
+				*viewer.Roles,
+			)
+
+			apiResponse = s.userRequest(
+				teenToken,
+				http.MethodGet,
+				"/accounts/"+account.Id,
+				nil,
+			)
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+
+			apiResponse = s.userRequest(
+				teenToken,
+				http.MethodPost,
+				"/expenditures",
+				s.createTestExpenditureRequest(
+					&account.Id,
+					&category,
+				),
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusForbidden,
+				domain.ErrForbidden.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Roles are given once",
+		func() {
+			apiResponse := setRoles(
+				adminToken,
+				teen.Id,
+				[]string{
+					domain.RoleViewer,
+					domain.RoleViewer,
+				},
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var viewer openapi.User
+			s.decodeResponse(
+				apiResponse,
+				&viewer,
+			)
+			s.Require().NotNil(viewer.Roles)
+			s.Equal(
+				[]string{domain.RoleViewer},
+				*viewer.Roles,
+			)
+		},
+	)
+
+	s.Run(
+		"Invalid roles are refused",
+		func() {
+			apiResponse := setRoles(
+				adminToken,
+				teen.Id,
+				[]string{"boss"},
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrRoleNotFound.Error(),
+			)
+
+			apiResponse = setRoles(
+				adminToken,
+				teen.Id,
+				[]string{},
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrUserRolesEmpty.Error(),
+			)
+
+			apiResponse = setRoles(
+				adminToken,
+				"999999999",
+				[]string{domain.RoleMember},
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusNotFound,
+				domain.ErrUserNotFound.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Household keeps an admin",
+		func() {
+			apiResponse := setRoles(
+				adminToken,
+				admin.Id,
+				[]string{domain.RoleMember},
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusConflict,
+				domain.ErrLastAdmin.Error(),
+			)
+
+			apiResponse = setRoles(
+				adminToken,
+				teen.Id,
+				[]string{
+					domain.RoleAdmin,
+					domain.RoleMember,
+				},
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+
+			apiResponse = setRoles(
+				adminToken,
+				admin.Id,
+				[]string{domain.RoleMember},
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+
+			apiResponse = s.userRequest(
+				adminToken,
+				http.MethodGet,
+				"/users",
+				nil,
+			)
+			s.assertHttpError(
+				apiResponse,
+				http.StatusForbidden,
+				domain.ErrForbidden.Error(),
+			)
+		},
+	)
+}
@@ -0,0 +1,206 @@
+package integration_test
+
+import (
+	"net/http"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+)
+
+func (s *Suite) TestSearch() {
+	s.T().Log("Starting TestSearch")
+
+	testMember := s.createTestHouseholdMember()
+	testAccount := s.createTestAccount(
+		&testMember,
+		"150",
+	)
+	testCategory := s.createTestCategory(openapi.CategoryTypeExpenditure)
+
+	expenditureReq := s.createTestExpenditureRequest(
+		&testAccount.Id,
+		&testCategory,
+	)
+	expenditureReq.Description = "Dentist appointment for the kids"
+	apiResponse, err := s.createExpenditureRequest(expenditureReq)
+	s.handleErr(
+		err,
+		"error while creating expenditure",
+	)
+	var expenditure openapi.Expenditure
+	s.decodeResponse(
+		apiResponse,
+		&expenditure,
+	)
+
+	s.Run(
+		"Search finds an expenditure by its description",
+		func() {
+			apiResponse := s.searchRequest(
+				openapi.SearchParams{
+					Q: "dentist",
+				},
+			)
+
+			var result openapi.SearchResult
+			s.decodeResponse(
+				apiResponse,
+				&result,
+			)
+
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Equal(
+				1,
+				result.Metadata.Total,
+			)
+			s.Require().Len(
+				result.Hits,
+				1,
+			)
+			s.Equal(
+				expenditure.Id,
+				result.Hits[0].Id,
+			)
+			s.Equal(
+				openapi.SearchHitType("expenditure"),
+				result.Hits[0].Type,
+			)
+			s.Positive(result.Hits[0].Score)
+			s.Contains(
+				result.Hits[0].Highlights,
+				openapi.SearchHighlight{
+					Field:   "description",
+					Snippet: "<em>Dentist</em> appointment for the kids",
+				},
+			)
+		},
+	)
+
+	s.Run(
+		"Highlights are HTML escaped and match non-ASCII words",
+		func() {
+			expenditureReq := s.createTestExpenditureRequest(
+				&testAccount.Id,
+				&testCategory,
+			)
+			expenditureReq.Description = "Órtesis <b>bucal</b> & más"
+			apiResponse, err := s.createExpenditureRequest(expenditureReq)
+			s.handleErr(
+				err,
+				"error while creating expenditure",
+			)
+			s.Require().Equal(
+				http.StatusCreated,
+				apiResponse.StatusCode,
+			)
+
+			apiResponse = s.searchRequest(
+				openapi.SearchParams{
+					Q: "órtesis",
+				},
+			)
+
+			var result openapi.SearchResult
+			s.decodeResponse(
+				apiResponse,
+				&result,
+			)
+
+			s.Require().Len(
+				result.Hits,
+				1,
+			)
+			s.Contains(
+				result.Hits[0].Highlights,
+				openapi.SearchHighlight{
+					Field:   "description",
+					Snippet: "<em>Órtesis</em> &lt;b&gt;bucal&lt;/b&gt; &amp; más",
+				},
+			)
+		},
+	)
+
+	s.Run(
+		"Search restricted to other entity types returns no hits",
+		func() {
+			apiResponse := s.searchRequest(
+				openapi.SearchParams{
+					Q: "dentist",
+					Type: &[]openapi.SearchHitType{
+						"ingress",
+						"transfer",
+					},
+				},
+			)
+
+			var result openapi.SearchResult
+			s.decodeResponse(
+				apiResponse,
+				&result,
+			)
+
+			s.Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			s.Empty(result.Hits)
+		},
+	)
+
+	s.Run(
+		"Search with a query that has no searchable words",
+		func() {
+			apiResponse := s.searchRequest(
+				openapi.SearchParams{
+					Q: "a b",
+				},
+			)
+
+			s.assertHttpError(
+				apiResponse,
+				http.StatusBadRequest,
+				domain.ErrInvalidSearchQuery.Error(),
+			)
+		},
+	)
+}
+
+func (s *Suite) searchRequest(params openapi.SearchParams) *http.Response {
+	req, err := http.NewRequestWithContext(
+		s.ctx,
+		http.MethodGet,
+		"http://localhost:9091/search",
+		nil,
+	)
+	s.handleErr(
+		err,
+		"error while creating request",
+	)
+
+	q := req.URL.Query()
+	q.Set(
+		"q",
+		params.Q,
+	)
+	if params.Type != nil {
+		for _, t := range *params.Type {
+			q.Add(
+				"type",
+				string(t),
+			)
+		}
+	}
+	req.URL.RawQuery = q.Encode()
+
+	client := s.client()
+	apiResponse, err := client.Do(req)
+	s.handleErr(
+		err,
+		"error while making request",
+	)
+
+	return apiResponse
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 	"time"
@@ -11,9 +12,11 @@ import (
 	"ghorkov32/proletariat-budget-be/config"
 	"ghorkov32/proletariat-budget-be/integration_test/containers"
 	"ghorkov32/proletariat-budget-be/integration_test/utils"
+	"ghorkov32/proletariat-budget-be/internal/adapter/driven/mail"
 	"ghorkov32/proletariat-budget-be/internal/adapter/driven/mysql"
 	"ghorkov32/proletariat-budget-be/internal/adapter/driving/middleware"
 	"ghorkov32/proletariat-budget-be/internal/adapter/driving/resthttp"
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
 	"ghorkov32/proletariat-budget-be/internal/core/port"
 	"ghorkov32/proletariat-budget-be/internal/core/usecase"
 	"ghorkov32/proletariat-budget-be/openapi"
@@ -26,7 +29,11 @@ type Suite struct {
 	dbContainer *containers.MysqlContainer
 	db          *sql.DB
 	server      *resthttp.App
+	useCases    *usecase.UseCases
+	mailer      *mail.LocalMailer
 	ctx         context.Context
+	// token is the bearer token of the admin of the default household
+	token string
 }
 
 func TestIntegrationSuite(t *testing.T) {
@@ -73,7 +80,11 @@ func (s *Suite) SetupSuite() {
 	)
 	s.db = db
 
-	s.ctx = context.Background()
+	// use cases called directly act on the default household, as the requests of its admin do
+	s.ctx = domain.WithHouseholdID(
+		context.Background(),
+		domain.DefaultHouseholdID,
+	)
 
 	err = s.runMigrations()
 	s.handleErr(
@@ -82,14 +93,22 @@ func (s *Suite) SetupSuite() {
 	)
 
 	ports := instantiatePorts(db)
+	s.mailer = (*ports.Mailer).(*mail.LocalMailer)
 
-	useCases := instantiateUseCases(ports)
+	useCases := instantiateUseCases(
+		ports,
+		s.config.App,
+	)
+	s.useCases = useCases
+	s.token = s.bootstrapAdmin()
 
 	controller := resthttp.NewController(*useCases)
 
 	handler := openapi.NewStrictHandler(
 		controller,
-		nil,
+		[]openapi.StrictMiddlewareFunc{
+			middleware.NewAuthorizationMiddleware().Authorize,
+		},
 	)
 
 	oapiSpecs, errSw := openapi.GetSwagger()
@@ -102,6 +121,8 @@ func (s *Suite) SetupSuite() {
 		openapi.Handler(handler),
 		middleware.DetailedRequestLogger,
 		middleware.OpenAPIValidationMiddleware(oapiSpecs),
+		middleware.NewAuthMiddleware(useCases.Auth).Identify,
+		middleware.NewHouseholdMiddleware().Scope,
 	)
 	go s.server.Start()
 
@@ -109,6 +130,65 @@ func (s *Suite) SetupSuite() {
 	time.Sleep(1 * time.Second)
 }
 
+// bootstrapAdmin registers a user and makes it the admin of the default household, as the operators do
+// with the -bootstrap-admin flag, and returns its bearer token
+func (s *Suite) bootstrapAdmin() string {
+	email := "admin@example.com"
+	token, _, err := s.useCases.Auth.Register(
+		s.ctx,
+		domain.User{
+			Email:     email,
+			FirstName: "Ada",
+			LastName:  "Admin",
+		},
+		"SecureP@ssw0rd",
+	)
+	s.handleErr(
+		err,
+		"failed to register the admin",
+	)
+	err = s.useCases.Household.BootstrapAdmin(
+		s.ctx,
+		domain.DefaultHouseholdID,
+		email,
+	)
+	s.handleErr(
+		err,
+		"failed to bootstrap the admin",
+	)
+
+	return token.Token
+}
+
+// bearerTransport authenticates the requests that carry no token of their own with the token of the
+// admin of the default household
+type bearerTransport struct {
+	token string
+}
+
+func (t bearerTransport) RoundTrip(req *http.Request) (
+	*http.Response,
+	error,
+) {
+	if req.Header.Get("Authorization") == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(
+			"Authorization",
+			"Bearer "+t.token,
+		)
+	}
+
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// client returns the HTTP client of the requests of the tests, made by the admin of the default household
+// unless they are authenticated otherwise
+func (s *Suite) client() *http.Client {
+	return &http.Client{
+		Transport: bearerTransport{token: s.token},
+	}
+}
+
 func (s *Suite) handleErr(
 	err error,
 	msg string,
@@ -130,63 +210,161 @@ func instantiatePorts(db *sql.DB) *port.Ports {
 		db,
 		os.Getenv("JWT_SECRET"),
 	)
+	allocationPlanRepo := mysql.NewAllocationPlanRepo(db)
+	budgetRepo := mysql.NewBudgetRepo(db)
+	categorizationRuleRepo := mysql.NewCategorizationRuleRepo(db)
 	categoryRepo := mysql.NewCategoryRepo(db)
+	categorySuggestionRepo := mysql.NewCategorySuggestionRepo(db)
 	tagsRepo := mysql.NewTagsRepo(db)
+	exchangeRateRepo := mysql.NewExchangeRateRepo(db)
 	expenditureRepo := mysql.NewExpenditureRepo(
 		db,
 		tagsRepo,
 	)
+	expenditureRecurrencePatternRepo := mysql.NewExpenditureRecurrencePatternRepo(db)
+	householdRepo := mysql.NewHouseholdRepo(db)
 	householdMembersRepo := mysql.NewHouseholdMemberRepository(db)
+	installmentPlanRepo := mysql.NewInstallmentPlanRepo(db)
+	invitationRepo := mysql.NewInvitationRepo(db)
 	ingressRepo := mysql.NewIngressRepo(
 		db,
 		tagsRepo,
 	)
+	mailer := mail.NewLocalMailer()
+	payeeRepo := mysql.NewPayeeRepo(db)
 	savingsGoalRepo := mysql.NewSavingGoalRepo(
 		db,
 		tagsRepo,
 	)
+	reportRepo := mysql.NewReportRepo(db)
+	roleRepo := mysql.NewRoleRepo(db)
+	searchRepo := mysql.NewSearchRepo(db)
 	transactionRepo := mysql.NewTransactionRepo(
 		db,
 		tagsRepo,
 	)
 
 	return &port.Ports{
-		Account:          &accountRepo,
-		Auth:             &authRepo,
-		Category:         &categoryRepo,
-		Expenditure:      &expenditureRepo,
-		HouseholdMembers: &householdMembersRepo,
-		Ingress:          &ingressRepo,
-		SavingGoal:       &savingsGoalRepo,
-		Tags:             &tagsRepo,
-		Transaction:      &transactionRepo,
+		Account:                      &accountRepo,
+		AllocationPlan:               &allocationPlanRepo,
+		Auth:                         &authRepo,
+		Budget:                       &budgetRepo,
+		CategorizationRule:           &categorizationRuleRepo,
+		Category:                     &categoryRepo,
+		CategorySuggestion:           &categorySuggestionRepo,
+		ExchangeRate:                 &exchangeRateRepo,
+		Expenditure:                  &expenditureRepo,
+		ExpenditureRecurrencePattern: &expenditureRecurrencePatternRepo,
+		Household:                    &householdRepo,
+		HouseholdMembers:             &householdMembersRepo,
+		InstallmentPlan:              &installmentPlanRepo,
+		Invitation:                   &invitationRepo,
+		Ingress:                      &ingressRepo,
+		Mailer:                       &mailer,
+		Payee:                        &payeeRepo,
+		Report:                       &reportRepo,
+		Role:                         &roleRepo,
+		SavingGoal:                   &savingsGoalRepo,
+		Search:                       &searchRepo,
+		Tags:                         &tagsRepo,
+		Transaction:                  &transactionRepo,
 	}
 }
 
-func instantiateUseCases(ports *port.Ports) *usecase.UseCases {
+func instantiateUseCases(
+	ports *port.Ports,
+	appConfig *config.App,
+) *usecase.UseCases {
 	account := usecase.NewAccountUseCase(
 		*ports.Account,
 		*ports.HouseholdMembers,
 	)
-	auth := usecase.NewAuthUseCase(*ports.Auth)
+	auth := usecase.NewAuthUseCase(
+		*ports.Auth,
+		*ports.HouseholdMembers,
+	)
+	household := usecase.NewHouseholdUseCase(
+		*ports.Household,
+		*ports.Auth,
+	)
 	householdMember := usecase.NewHouseholdMemberUseCase(*ports.HouseholdMembers)
 	category := usecase.NewCategoryUseCase(*ports.Category)
+	categorySuggestion := usecase.NewCategorySuggestionUseCase(
+		*ports.CategorySuggestion,
+		*ports.Category,
+		*ports.Tags,
+	)
+	categorizationRule := usecase.NewCategorizationRuleUseCase(
+		*ports.CategorizationRule,
+		*ports.Category,
+		*ports.Tags,
+	)
+	allocationPlan := usecase.NewAllocationPlanUseCase(
+		*ports.AllocationPlan,
+		*ports.Category,
+		*ports.SavingGoal,
+	)
+	budget := usecase.NewBudgetUseCase(
+		*ports.Budget,
+		*ports.Category,
+	)
 	expenditure := usecase.NewExpenditureUseCase(
 		*ports.Expenditure,
 		*ports.Account,
 		*ports.Tags,
 		*ports.Category,
 		*ports.Transaction,
+		*ports.Payee,
+		*ports.CategorizationRule,
+		*ports.ExchangeRate,
+		appConfig.DuplicateWindowDays,
+	)
+	expenditureRecurrence := usecase.NewExpenditureRecurrenceUseCase(
+		*ports.ExpenditureRecurrencePattern,
+		expenditure,
+	)
+	installmentPlan := usecase.NewInstallmentPlanUseCase(
+		*ports.InstallmentPlan,
+		expenditure,
 	)
+	invitation := usecase.NewInvitationUseCase(
+		*ports.Invitation,
+		*ports.Auth,
+		*ports.HouseholdMembers,
+		*ports.Mailer,
+		appConfig.InvitationTTL,
+	)
+	payee := usecase.NewPayeeUseCase(*ports.Payee)
 	tags := usecase.NewTagsUseCase(*ports.Tags)
+	report := usecase.NewReportUseCase(
+		*ports.Report,
+		*ports.Tags,
+	)
+	role := usecase.NewRoleUseCase(
+		*ports.Role,
+		*ports.Auth,
+	)
+	search := usecase.NewSearchUseCase(*ports.Search)
 
 	return &usecase.UseCases{
-		Account:         account,
-		Auth:            auth,
-		HouseholdMember: householdMember,
-		Category:        category,
-		Expenditure:     expenditure,
-		Tags:            tags,
+		Account:               account,
+		AllocationPlan:        allocationPlan,
+		Auth:                  auth,
+		Budget:                budget,
+		Household:             household,
+		HouseholdMember:       householdMember,
+		CategorizationRule:    categorizationRule,
+		Category:              category,
+		CategorySuggestion:    categorySuggestion,
+		Expenditure:           expenditure,
+		ExpenditureRecurrence: expenditureRecurrence,
+		InstallmentPlan:       installmentPlan,
+		Invitation:            invitation,
+		Payee:                 payee,
+		Report:                report,
+		Role:                  role,
+		Search:                search,
+		Tags:                  tags,
 	}
 }
 
@@ -250,15 +428,26 @@ func (s *Suite) ClearTables() {
 	statements := []string{
 		"SET FOREIGN_KEY_CHECKS = 0",
 		"TRUNCATE TABLE proletariat_budget.accounts",
+		"TRUNCATE TABLE proletariat_budget.allocation_plans",
+		"TRUNCATE TABLE proletariat_budget.allocations",
+		"TRUNCATE TABLE proletariat_budget.budget_period_amounts",
+		"TRUNCATE TABLE proletariat_budget.budgets",
+		"TRUNCATE TABLE proletariat_budget.categorization_rule_tags",
+		"TRUNCATE TABLE proletariat_budget.categorization_rules",
 		"TRUNCATE TABLE proletariat_budget.categories",
 		"TRUNCATE TABLE proletariat_budget.exchange_rates",
+		"TRUNCATE TABLE proletariat_budget.expenditure_recurrence_patterns",
+		"TRUNCATE TABLE proletariat_budget.expenditure_refunds",
 		"TRUNCATE TABLE proletariat_budget.expenditure_tags",
 		"TRUNCATE TABLE proletariat_budget.expenditures",
 		"TRUNCATE TABLE proletariat_budget.household_members",
 		"TRUNCATE TABLE proletariat_budget.ingress_recurrence_patterns",
+		"TRUNCATE TABLE proletariat_budget.installment_plans",
+		"TRUNCATE TABLE proletariat_budget.invitations",
 		"TRUNCATE TABLE proletariat_budget.ingress_tags",
 		"TRUNCATE TABLE proletariat_budget.ingresses",
-		"TRUNCATE TABLE proletariat_budget.roles",
+		"TRUNCATE TABLE proletariat_budget.payee_aliases",
+		"TRUNCATE TABLE proletariat_budget.payees",
 		"TRUNCATE TABLE proletariat_budget.savings_contribution_tags",
 		"TRUNCATE TABLE proletariat_budget.savings_contributions",
 		"TRUNCATE TABLE proletariat_budget.savings_goal_tags",
@@ -0,0 +1,247 @@
+package integration_test
+
+import (
+	"net/http"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+)
+
+func (s *Suite) TestTagRenameAndMerge() {
+	s.T().Log("Starting TestTagRenameAndMerge")
+
+	createTag := func(
+		name string,
+		tagType openapi.TagType,
+	) openapi.Tag {
+		apiResponse, err := s.createTag(
+			&openapi.TagRequest{
+				Name:    name,
+				TagType: tagType,
+			},
+		)
+		s.handleErr(
+			err,
+			"error while creating tag",
+		)
+		s.Require().Equal(
+			http.StatusCreated,
+			apiResponse.StatusCode,
+		)
+		var tag openapi.Tag
+		s.decodeResponse(
+			apiResponse,
+			&tag,
+		)
+
+		return tag
+	}
+
+	s.Run(
+		"Tag names are unique per type",
+		func() {
+			expenditureTag := createTag(
+				"Per type groceries",
+				openapi.TagTypeExpenditure,
+			)
+			ingressTag := createTag(
+				"Per type groceries",
+				openapi.TagTypeIngress,
+			)
+			s.NotEqual(
+				expenditureTag.Id,
+				ingressTag.Id,
+			)
+		},
+	)
+
+	s.Run(
+		"Tags are renamed but keep their type",
+		func() {
+			tag := createTag(
+				"Rename me",
+				openapi.TagTypeExpenditure,
+			)
+			taken := createTag(
+				"Already taken",
+				openapi.TagTypeExpenditure,
+			)
+
+			apiResponse := s.apiRequest(
+				http.MethodPut,
+				"/tags/"+tag.Id,
+				openapi.TagRequest{
+					Name:    "Renamed",
+					TagType: openapi.TagTypeExpenditure,
+				},
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var renamed openapi.Tag
+			s.decodeResponse(
+				apiResponse,
+				&renamed,
+			)
+			s.Equal(
+				tag.Id,
+				renamed.Id,
+			)
+			s.Equal(
+				"Renamed",
+				renamed.Name,
+			)
+
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPut,
+					"/tags/"+tag.Id,
+					openapi.TagRequest{
+						Name:    taken.Name,
+						TagType: openapi.TagTypeExpenditure,
+					},
+				),
+				http.StatusConflict,
+				domain.ErrTagAlreadyExists.Error(),
+			)
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPut,
+					"/tags/"+tag.Id,
+					openapi.TagRequest{
+						Name:    "Renamed",
+						TagType: openapi.TagTypeIngress,
+					},
+				),
+				http.StatusBadRequest,
+				domain.ErrTagTypeChange.Error(),
+			)
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPut,
+					"/tags/999999999",
+					openapi.TagRequest{
+						Name:    "Missing",
+						TagType: openapi.TagTypeExpenditure,
+					},
+				),
+				http.StatusNotFound,
+				domain.ErrTagNotFound.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Merging a tag relinks its expenditures to the target",
+		func() {
+			source := createTag(
+				"Merge source",
+				openapi.TagTypeExpenditure,
+			)
+			target := createTag(
+				"Merge target",
+				openapi.TagTypeExpenditure,
+			)
+
+			testMember := s.createTestHouseholdMember()
+			account := s.createTestAccountWithBalance(
+				&testMember,
+				"150",
+				1000,
+			)
+			category := s.createTestCategory(openapi.CategoryTypeExpenditure)
+			createExpenditure := func(tags []openapi.Tag) openapi.Expenditure {
+				expenditureReq := s.createTestExpenditureRequest(
+					&account.Id,
+					&category,
+				)
+				expenditureReq.Tags = &tags
+				apiResponse, err := s.createExpenditureRequest(expenditureReq)
+				s.handleErr(
+					err,
+					"error while creating expenditure",
+				)
+				s.Require().Equal(
+					http.StatusCreated,
+					apiResponse.StatusCode,
+				)
+				var expenditure openapi.Expenditure
+				s.decodeResponse(
+					apiResponse,
+					&expenditure,
+				)
+
+				return expenditure
+			}
+			onlySource := createExpenditure([]openapi.Tag{source})
+			both := createExpenditure([]openapi.Tag{source, target})
+
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPost,
+					"/tags/"+source.Id+"/merge",
+					openapi.TagMergeRequest{
+						TargetId: createTag(
+							"Merge target",
+							openapi.TagTypeIngress,
+						).Id,
+					},
+				),
+				http.StatusBadRequest,
+				domain.ErrTagMergeTypeMismatch.Error(),
+			)
+
+			apiResponse := s.apiRequest(
+				http.MethodPost,
+				"/tags/"+source.Id+"/merge",
+				openapi.TagMergeRequest{
+					TargetId: target.Id,
+				},
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var result openapi.TagMergeResult
+			s.decodeResponse(
+				apiResponse,
+				&result,
+			)
+			s.Equal(
+				target.Id,
+				result.Target.Id,
+			)
+			// The expenditure already tagged with the target keeps a single link
+			s.Equal(
+				int64(1),
+				result.Moved,
+			)
+
+			for _, expenditureID := range []string{onlySource.Id, both.Id} {
+				expenditure := s.getExpenditure(expenditureID)
+				s.Require().NotNil(expenditure.Tags)
+				s.Require().Len(
+					*expenditure.Tags,
+					1,
+				)
+				s.Equal(
+					target.Id,
+					(*expenditure.Tags)[0].Id,
+				)
+			}
+
+			s.assertHttpError(
+				s.apiRequest(
+					http.MethodPost,
+					"/tags/"+source.Id+"/merge",
+					openapi.TagMergeRequest{
+						TargetId: target.Id,
+					},
+				),
+				http.StatusNotFound,
+				domain.ErrTagNotFound.Error(),
+			)
+		},
+	)
+}
@@ -0,0 +1,240 @@
+package integration_test
+
+import (
+	"net/http"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+)
+
+func (s *Suite) TestTagReports() {
+	s.T().Log("Starting TestTagReports")
+
+	createTag := func(
+		name string,
+		tagType openapi.TagType,
+	) openapi.Tag {
+		apiResponse, err := s.createTag(
+			&openapi.TagRequest{
+				Name:    name,
+				TagType: tagType,
+			},
+		)
+		s.handleErr(
+			err,
+			"error while creating tag",
+		)
+		s.Require().Equal(
+			http.StatusCreated,
+			apiResponse.StatusCode,
+		)
+		var tag openapi.Tag
+		s.decodeResponse(
+			apiResponse,
+			&tag,
+		)
+
+		return tag
+	}
+
+	testMember := s.createTestHouseholdMember()
+	account := s.createTestAccountWithBalance(
+		&testMember,
+		"150",
+		1000,
+	)
+	lodging := s.createTestCategory(openapi.CategoryTypeExpenditure)
+	food := s.createTestCategory(openapi.CategoryTypeExpenditure)
+	vacation := createTag(
+		"vacation-report",
+		openapi.TagTypeExpenditure,
+	)
+	kid := createTag(
+		"kid:report",
+		openapi.TagTypeExpenditure,
+	)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	for _, expense := range []struct {
+		category openapi.Category
+		amount   float32
+		tags     []openapi.Tag
+	}{
+		{lodging, 100, []openapi.Tag{vacation}},
+		{food, 50, []openapi.Tag{vacation, kid}},
+		{lodging, 30, []openapi.Tag{kid}},
+	} {
+		expenditureReq := s.createTestExpenditureRequest(
+			&account.Id,
+			&expense.category,
+		)
+		expenditureReq.Amount = expense.amount
+		expenditureReq.Date = openapitypes.Date{Time: today}
+		expenditureReq.Tags = &expense.tags
+		apiResponse, err := s.createExpenditureRequest(expenditureReq)
+		s.handleErr(
+			err,
+			"error while creating expenditure",
+		)
+		s.Require().Equal(
+			http.StatusCreated,
+			apiResponse.StatusCode,
+		)
+	}
+
+	s.Run(
+		"Totals per tag count records with several tags in each of them",
+		func() {
+			apiResponse := s.reportRequest("/reports/tag-spending?currency=150&accountId=" + account.Id)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var report openapi.TagSpendingReport
+			s.decodeResponse(
+				apiResponse,
+				&report,
+			)
+			s.Require().Len(
+				report.Expenditures,
+				2,
+			)
+			s.Equal(
+				vacation.Id,
+				report.Expenditures[0].TagId,
+			)
+			s.InDelta(
+				150,
+				report.Expenditures[0].Total,
+				0.001,
+			)
+			s.Equal(
+				2,
+				report.Expenditures[0].Count,
+			)
+			s.Equal(
+				kid.Id,
+				report.Expenditures[1].TagId,
+			)
+			s.InDelta(
+				80,
+				report.Expenditures[1].Total,
+				0.001,
+			)
+			s.Empty(report.Ingresses)
+		},
+	)
+
+	s.Run(
+		"Tag intersections and unions count each record once",
+		func() {
+			combination := func(match string) openapi.TagCombinationReport {
+				apiResponse := s.reportRequest(
+					"/reports/tag-combination?currency=150&accountId=" + account.Id +
+						"&tagIds=" + vacation.Id + "&tagIds=" + kid.Id + "&match=" + match,
+				)
+				s.Require().Equal(
+					http.StatusOK,
+					apiResponse.StatusCode,
+				)
+				var report openapi.TagCombinationReport
+				s.decodeResponse(
+					apiResponse,
+					&report,
+				)
+
+				return report
+			}
+
+			intersection := combination("all")
+			s.InDelta(
+				50,
+				intersection.Total,
+				0.001,
+			)
+			s.Equal(
+				1,
+				intersection.Count,
+			)
+			s.Len(
+				intersection.Tags,
+				2,
+			)
+
+			union := combination("any")
+			s.InDelta(
+				180,
+				union.Total,
+				0.001,
+			)
+			s.Equal(
+				3,
+				union.Count,
+			)
+
+			salary := createTag(
+				"salary-report",
+				openapi.TagTypeIngress,
+			)
+			s.assertHttpError(
+				s.reportRequest(
+					"/reports/tag-combination?currency=150&tagIds="+vacation.Id+"&tagIds="+salary.Id,
+				),
+				http.StatusBadRequest,
+				domain.ErrTagReportTypeMismatch.Error(),
+			)
+		},
+	)
+
+	s.Run(
+		"Tag spending is broken down by category",
+		func() {
+			apiResponse := s.reportRequest(
+				"/reports/tag-spending/" + vacation.Id + "/categories?currency=150&accountId=" + account.Id,
+			)
+			s.Require().Equal(
+				http.StatusOK,
+				apiResponse.StatusCode,
+			)
+			var report openapi.TagCategoryReport
+			s.decodeResponse(
+				apiResponse,
+				&report,
+			)
+			s.Equal(
+				vacation.Id,
+				report.Tag.Id,
+			)
+			s.Require().Len(
+				report.Categories,
+				2,
+			)
+			s.Equal(
+				lodging.Id,
+				report.Categories[0].CategoryId,
+			)
+			s.InDelta(
+				100,
+				report.Categories[0].Total,
+				0.001,
+			)
+			s.Equal(
+				food.Id,
+				report.Categories[1].CategoryId,
+			)
+			s.InDelta(
+				150,
+				report.Total,
+				0.001,
+			)
+
+			s.assertHttpError(
+				s.reportRequest("/reports/tag-spending/999999999/categories?currency=150"),
+				http.StatusNotFound,
+				domain.ErrTagNotFound.Error(),
+			)
+		},
+	)
+}
@@ -942,7 +942,7 @@ func (s *Suite) createTag(tagRequest *openapi.TagRequest) (
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -972,7 +972,7 @@ func (s *Suite) deleteTag(tagId string) (
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -1002,7 +1002,7 @@ func (s *Suite) listTags() (
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
@@ -1035,7 +1035,7 @@ func (s *Suite) listTagsByType(tagType openapi.TagType) (
 		"application/json",
 	)
 
-	client := &http.Client{}
+	client := s.client()
 	apiResponse, err := client.Do(req)
 	s.handleErr(
 		err,
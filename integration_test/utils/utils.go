@@ -65,3 +65,7 @@ func ExecuteSQLFile(
 func IntPtr(i int) *int {
 	return &i
 }
+
+func Float32Ptr(f float32) *float32 {
+	return &f
+}
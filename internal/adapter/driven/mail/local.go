@@ -0,0 +1,68 @@
+package mail
+
+import (
+	"context"
+	"sync"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+	"github.com/rs/zerolog/log"
+)
+
+// outboxSize is how many of the latest mails the local mailer keeps
+const outboxSize = 100
+
+// LocalMailer stands in for a mail server: it logs the mails it is asked to send and keeps the latest
+// ones in memory so they can be read back, e.g. to pick up the token of an invitation
+type LocalMailer struct {
+	mu     sync.Mutex
+	outbox []domain.Mail
+}
+
+func NewLocalMailer() port.Mailer {
+	return &LocalMailer{}
+}
+
+func (m *LocalMailer) Send(
+	ctx context.Context,
+	mail domain.Mail,
+) error {
+	log.Info().
+		Str("to", mail.To).
+		Str("subject", mail.Subject).
+		Str("body", mail.Body).
+		Msg("mail sent")
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.outbox = append(
+		m.outbox,
+		mail,
+	)
+	if len(m.outbox) > outboxSize {
+		m.outbox = m.outbox[len(m.outbox)-outboxSize:]
+	}
+
+	return nil
+}
+
+// Sent returns the latest mails sent to the address, oldest first
+func (m *LocalMailer) Sent(to string) []domain.Mail {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mails := make(
+		[]domain.Mail,
+		0,
+	)
+	for _, mail := range m.outbox {
+		if mail.To == to {
+			mails = append(
+				mails,
+				mail,
+			)
+		}
+	}
+
+	return mails
+}
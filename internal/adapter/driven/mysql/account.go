@@ -12,6 +12,23 @@ import (
 	"ghorkov32/proletariat-budget-be/internal/core/port"
 )
 
+// accountSortColumns whitelists the sort keys accepted by List
+var accountSortColumns = map[string]string{
+	"name":           "a.name",
+	"type":           "a.type",
+	"currency":       "a.currency",
+	"initialBalance": "a.initial_balance",
+	"currentBalance": "a.current_balance",
+	"createdAt":      "a.created_at",
+}
+
+var accountDefaultSort = []domain.SortOrder{
+	{
+		Key:        "createdAt",
+		Descending: true,
+	},
+}
+
 type AccountRepoImpl struct {
 	db *sql.DB
 }
@@ -27,17 +44,22 @@ func (r *AccountRepoImpl) Create(
 	*string,
 	error,
 ) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	query := `
         INSERT INTO accounts (
-            name, type, institution, currency, initial_balance, 
+            household_id, name, type, institution, currency, initial_balance, 
             current_balance, active, description, account_number,  owner,
             account_information, created_at, updated_at
-        ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), now())
+        ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, NOW(), now())
     `
 
 	result, err := r.db.ExecContext(
 		ctx,
 		query,
+		household,
 		account.Name,
 		account.Type,
 		account.Institution,
@@ -76,20 +98,25 @@ func (r *AccountRepoImpl) GetByID(
 	*domain.Account,
 	error,
 ) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	query := `SELECT 
 				a.id, a.name, type, institution, currency, 
 				initial_balance, current_balance, a.active, 
 				description, account_number, account_information, 
 				a.created_at, a.updated_at, a.owner, hm.id, hm.name, hm.surname, hm.nickname, hm.role, hm.active, hm.created_at, hm.updated_at
-				FROM accounts a left join proletariat_budget.household_members hm on a.owner = hm.id  WHERE a.id =?`
+				FROM accounts a left join proletariat_budget.household_members hm on a.owner = hm.id  WHERE a.id =? AND a.household_id = ?`
 
 	account := &domain.Account{
 		Owner: &domain.HouseholdMember{},
 	}
-	err := r.db.QueryRowContext(
+	err = r.db.QueryRowContext(
 		ctx,
 		query,
 		id,
+		household,
 	).Scan(
 		&account.ID,
 		&account.Name,
@@ -130,18 +157,22 @@ func (r *AccountRepoImpl) Update(
 	ctx context.Context,
 	account domain.Account,
 ) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
 	query := `
         UPDATE accounts SET 
             name =?, type =?, institution =?, currency =?, initial_balance =?, 
             current_balance =?, active =?, description =?, account_number =?, 
             account_information =?, updated_at =?, owner =? 
-        WHERE id =?
+        WHERE id =? AND household_id = ?
     `
 
 	now := time.Now()
 	account.UpdatedAt = now
 
-	_, err := r.db.ExecContext(
+	_, err = r.db.ExecContext(
 		ctx,
 		query,
 		account.Name,
@@ -157,6 +188,7 @@ func (r *AccountRepoImpl) Update(
 		account.UpdatedAt,
 		account.OwnerID,
 		account.ID,
+		household,
 	)
 
 	if err != nil {
@@ -170,11 +202,16 @@ func (r *AccountRepoImpl) Delete(
 	ctx context.Context,
 	id string,
 ) error {
-	query := `DELETE FROM accounts WHERE id =?`
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	query := `DELETE FROM accounts WHERE id =? AND household_id = ?`
 	result, err := r.db.ExecContext(
 		ctx,
 		query,
 		id,
+		household,
 	)
 	if err != nil {
 		return translateError(err)
@@ -197,6 +234,10 @@ func (r *AccountRepoImpl) List(
 	*domain.AccountList,
 	error,
 ) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	query := `SELECT a.id,
 					   a.name,
 					   type,
@@ -221,14 +262,8 @@ func (r *AccountRepoImpl) List(
 				FROM accounts a
 						 left join household_members hm on a.owner = hm.id`
 
-	whereClause := make(
-		[]string,
-		0,
-	)
-	args := make(
-		[]any,
-		0,
-	)
+	whereClause := []string{"a.household_id = ?"}
+	args := []any{household}
 	if params.Currency != nil {
 		whereClause = append(
 			whereClause,
@@ -261,17 +296,24 @@ func (r *AccountRepoImpl) List(
 	}
 
 	queryCount := "SELECT COUNT(*) FROM accounts a"
-	if len(whereClause) > 0 {
-		query += " WHERE " + strings.Join(
-			whereClause,
-			AND_CLAUSE,
-		)
-		queryCount += " WHERE " + strings.Join(
-			whereClause,
-			AND_CLAUSE,
-		)
+	query += " WHERE " + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	)
+	queryCount += " WHERE " + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	)
+	orderBy, errOrderBy := buildOrderByClause(
+		params.Sort,
+		accountSortColumns,
+		accountDefaultSort,
+		"a.id",
+	)
+	if errOrderBy != nil {
+		return nil, errOrderBy
 	}
-	query += " ORDER BY a.created_at DESC"
+	query += orderBy
 	stmtCount, errQueryCountStmt := r.db.PrepareContext(
 		ctx,
 		queryCount,
@@ -295,6 +337,10 @@ func (r *AccountRepoImpl) List(
 				Total:  0,
 				Limit:  *params.Limit,
 				Offset: *params.Offset,
+				Sort: appliedSortExpression(
+					params.Sort,
+					accountDefaultSort,
+				),
 			},
 			Accounts: accounts,
 		}, nil
@@ -357,6 +403,10 @@ func (r *AccountRepoImpl) List(
 				Total:  count,
 				Limit:  *params.Limit,
 				Offset: *params.Offset,
+				Sort: appliedSortExpression(
+					params.Sort,
+					accountDefaultSort,
+				),
 			},
 			Accounts: accounts,
 		},
@@ -370,12 +420,17 @@ func (r *AccountRepoImpl) HasTransactions(
 	bool,
 	error,
 ) {
-	query := `SELECT COUNT(*) FROM transactions WHERE account_id =?`
+	household, err := householdID(ctx)
+	if err != nil {
+		return false, err
+	}
+	query := `SELECT COUNT(*) FROM transactions t JOIN accounts a ON a.id = t.account_id WHERE t.account_id =? AND a.household_id = ?`
 	var count int
-	err := r.db.QueryRowContext(
+	err = r.db.QueryRowContext(
 		ctx,
 		query,
 		id,
+		household,
 	).Scan(&count)
 	if err != nil {
 		return false, translateError(err)
@@ -0,0 +1,610 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+const allocationPlanColumns = `id,
+					   month,
+					   currency,
+					   income_basis,
+					   created_at,
+					   updated_at`
+
+type AllocationPlanRepoImpl struct {
+	db *sql.DB
+}
+
+func NewAllocationPlanRepo(db *sql.DB) port.AllocationPlanRepo {
+	return &AllocationPlanRepoImpl{db: db}
+}
+
+func (r AllocationPlanRepoImpl) Create(
+	ctx context.Context,
+	plan domain.AllocationPlan,
+) (
+	string,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return "", translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	result, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO allocation_plans (household_id, month, currency, income_basis) VALUES (?, ?, ?, ?)`,
+		household,
+		plan.Month,
+		plan.Currency,
+		plan.IncomeBasis,
+	)
+	if err != nil {
+		return "", translateError(err)
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return "", fmt.Errorf(
+			"failed to get last insert ID: %w",
+			err,
+		)
+	}
+	id := strconv.FormatInt(
+		lastID,
+		10,
+	)
+
+	err = r.insertAllocations(
+		ctx,
+		tx,
+		id,
+		plan.Allocations,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", translateError(err)
+	}
+
+	return id, nil
+}
+
+func (r AllocationPlanRepoImpl) GetByID(
+	ctx context.Context,
+	id string,
+) (
+	*domain.AllocationPlan,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + allocationPlanColumns + `
+					FROM allocation_plans WHERE id=? AND household_id=?`
+
+	plan, err := r.scanPlan(
+		r.db.QueryRowContext(
+			ctx,
+			query,
+			id,
+			household,
+		),
+	)
+	if errors.Is(
+		err,
+		sql.ErrNoRows,
+	) {
+		return nil, port.ErrRecordNotFound
+	} else if err != nil {
+		return nil, translateError(err)
+	}
+
+	plan.Allocations, err = r.listAllocations(
+		ctx,
+		plan.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (r AllocationPlanRepoImpl) List(
+	ctx context.Context,
+	params domain.AllocationPlanListParams,
+) (
+	[]domain.AllocationPlan,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	whereClause := []string{"household_id = ?"}
+	args := []any{household}
+
+	if params.Month != nil {
+		whereClause = append(
+			whereClause,
+			"month = ?",
+		)
+		args = append(
+			args,
+			*params.Month,
+		)
+	}
+	if params.Currency != nil {
+		whereClause = append(
+			whereClause,
+			"currency = ?",
+		)
+		args = append(
+			args,
+			*params.Currency,
+		)
+	}
+
+	query := `SELECT ` + allocationPlanColumns + `
+					FROM allocation_plans WHERE ` + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	)
+	query += " ORDER BY month DESC, currency, id"
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	plans := make(
+		[]domain.AllocationPlan,
+		0,
+	)
+	for rows.Next() {
+		plan, errScan := r.scanPlan(rows)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		plans = append(
+			plans,
+			*plan,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	for i := range plans {
+		plans[i].Allocations, err = r.listAllocations(
+			ctx,
+			plans[i].ID,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return plans, nil
+}
+
+func (r AllocationPlanRepoImpl) Update(
+	ctx context.Context,
+	plan domain.AllocationPlan,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	var exists bool
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT TRUE FROM allocation_plans WHERE id = ? AND household_id = ? FOR UPDATE`,
+		plan.ID,
+		household,
+	).Scan(&exists)
+	if err != nil {
+		return translateError(err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE allocation_plans SET income_basis = ? WHERE id = ?`,
+		plan.IncomeBasis,
+		plan.ID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`DELETE FROM allocations WHERE allocation_plan_id = ?`,
+		plan.ID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	err = r.insertAllocations(
+		ctx,
+		tx,
+		plan.ID,
+		plan.Allocations,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func (r AllocationPlanRepoImpl) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	result, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM allocation_plans WHERE id = ? AND household_id = ?`,
+		id,
+		household,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, errRowsAffected := result.RowsAffected()
+	if errRowsAffected != nil {
+		return translateError(errRowsAffected)
+	}
+	if rowsAffected == 0 {
+		return port.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r AllocationPlanRepoImpl) IncomeRecurrences(
+	ctx context.Context,
+	currency string,
+	month time.Time,
+) (
+	[]domain.IncomeRecurrence,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT irp.frequency, irp.interval_value, COALESCE(irp.amount, 0)
+			  FROM ingress_recurrence_patterns irp
+					   INNER JOIN accounts a ON irp.to_account_id = a.id
+			  WHERE a.currency = ?
+				AND a.household_id = ?
+				AND (irp.end_date IS NULL OR irp.end_date >= ?)`
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		currency,
+		household,
+		month,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	recurrences := make(
+		[]domain.IncomeRecurrence,
+		0,
+	)
+	for rows.Next() {
+		var recurrence domain.IncomeRecurrence
+		errScan := rows.Scan(
+			&recurrence.Frequency,
+			&recurrence.Interval,
+			&recurrence.Amount,
+		)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		recurrences = append(
+			recurrences,
+			recurrence,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return recurrences, nil
+}
+
+func (r AllocationPlanRepoImpl) Ingresses(
+	ctx context.Context,
+	currency string,
+	from time.Time,
+	to time.Time,
+) (
+	domain.MonthIncome,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return domain.MonthIncome{}, err
+	}
+	query := `SELECT COALESCE(SUM(IF(i.from_recurrency_pattern_id IS NULL, t.amount, 0)), 0),
+					 COALESCE(SUM(IF(t.status = ?, t.amount, 0)), 0)
+			  FROM ingresses i
+					   INNER JOIN transactions t ON i.transaction_id = t.id
+			  WHERE t.currency = ?
+				AND i.category_id IN (` + householdCategoriesQuery + `)
+				AND t.status IN (?, ?)
+				AND t.transaction_date >= ?
+				AND t.transaction_date < ?
+				AND ` + notRolledBackCondition
+
+	var income domain.MonthIncome
+	err = r.db.QueryRowContext(
+		ctx,
+		query,
+		domain.TransactionStatusCompleted,
+		currency,
+		household,
+		domain.TransactionStatusCompleted,
+		domain.TransactionStatusPending,
+		from,
+		to,
+	).Scan(
+		&income.OneOff,
+		&income.Received,
+	)
+	if err != nil {
+		return domain.MonthIncome{}, translateError(err)
+	}
+
+	return income, nil
+}
+
+func (r AllocationPlanRepoImpl) Actuals(
+	ctx context.Context,
+	currency string,
+	from time.Time,
+	to time.Time,
+) (
+	domain.MonthActuals,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return domain.MonthActuals{}, err
+	}
+	actuals := domain.MonthActuals{
+		Spent:       make(map[string]float32),
+		Contributed: make(map[string]float32),
+	}
+
+	querySpent := `SELECT e.category_id, SUM(t.amount - COALESCE(rf.refunded, 0))
+				   FROM expenditures e
+							INNER JOIN transactions t ON e.transaction_id = t.id
+							` + refundsJoin + `
+				   WHERE t.currency = ?
+					 AND e.category_id IN (` + householdCategoriesQuery + `)
+					 AND t.status = ?
+					 AND t.transaction_date >= ?
+					 AND t.transaction_date < ?
+					 AND ` + notRolledBackCondition + `
+				   GROUP BY e.category_id`
+	err = r.sumByKey(
+		ctx,
+		actuals.Spent,
+		querySpent,
+		currency,
+		household,
+		domain.TransactionStatusCompleted,
+		from,
+		to,
+	)
+	if err != nil {
+		return domain.MonthActuals{}, err
+	}
+
+	queryContributed := `SELECT sc.savings_goal_id, SUM(t.amount)
+						 FROM savings_contributions sc
+								  INNER JOIN transfers tr ON sc.transfer_id = tr.id
+								  INNER JOIN transactions t ON tr.incoming_transaction_id = t.id
+						 WHERE t.currency = ?
+						   AND sc.savings_goal_id IN (` + householdSavingsGoalsQuery + `)
+						   AND t.status = ?
+						   AND sc.date >= ?
+						   AND sc.date < ?
+						 GROUP BY sc.savings_goal_id`
+	err = r.sumByKey(
+		ctx,
+		actuals.Contributed,
+		queryContributed,
+		currency,
+		household,
+		domain.TransactionStatusCompleted,
+		from,
+		to,
+	)
+	if err != nil {
+		return domain.MonthActuals{}, err
+	}
+
+	return actuals, nil
+}
+
+func (r AllocationPlanRepoImpl) sumByKey(
+	ctx context.Context,
+	sums map[string]float32,
+	query string,
+	args ...any,
+) error {
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key string
+		var sum float32
+		errScan := rows.Scan(
+			&key,
+			&sum,
+		)
+		if errScan != nil {
+			return translateError(errScan)
+		}
+		sums[key] = sum
+	}
+	if err = rows.Err(); err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func (r AllocationPlanRepoImpl) insertAllocations(
+	ctx context.Context,
+	tx *sql.Tx,
+	planID string,
+	allocations []domain.Allocation,
+) error {
+	for _, allocation := range allocations {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO allocations (allocation_plan_id, category_id, savings_goal_id, amount) VALUES (?, ?, ?, ?)`,
+			planID,
+			allocation.CategoryID,
+			allocation.SavingsGoalID,
+			allocation.Amount,
+		)
+		if err != nil {
+			return translateError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r AllocationPlanRepoImpl) listAllocations(
+	ctx context.Context,
+	planID string,
+) (
+	[]domain.Allocation,
+	error,
+) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT category_id, savings_goal_id, amount FROM allocations WHERE allocation_plan_id = ? ORDER BY id`,
+		planID,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	allocations := make(
+		[]domain.Allocation,
+		0,
+	)
+	for rows.Next() {
+		var allocation domain.Allocation
+		var categoryID, savingsGoalID sql.NullString
+		errScan := rows.Scan(
+			&categoryID,
+			&savingsGoalID,
+			&allocation.Amount,
+		)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		if categoryID.Valid {
+			allocation.CategoryID = &categoryID.String
+		}
+		if savingsGoalID.Valid {
+			allocation.SavingsGoalID = &savingsGoalID.String
+		}
+		allocations = append(
+			allocations,
+			allocation,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return allocations, nil
+}
+
+func (r AllocationPlanRepoImpl) scanPlan(row rowScanner) (
+	*domain.AllocationPlan,
+	error,
+) {
+	var plan domain.AllocationPlan
+
+	err := row.Scan(
+		&plan.ID,
+		&plan.Month,
+		&plan.Currency,
+		&plan.IncomeBasis,
+		&plan.CreatedAt,
+		&plan.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &plan, nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"ghorkov32/proletariat-budget-be/internal/core/domain"
@@ -26,6 +29,17 @@ func NewAuthRepository(
 	}
 }
 
+// userColumns selects a user u with the household of its member hm and its role names, it expects the
+// userMemberJoin
+const (
+	userColumns = `u.id, u.email, u.password_hash, u.name, u.surname, u.household_member_id, hm.household_id,
+       (SELECT GROUP_CONCAT(r.name ORDER BY r.name) FROM user_roles ur JOIN roles r ON r.id = ur.role_id WHERE ur.user_id = u.id),
+       u.created_at, u.updated_at`
+	userMemberJoin = `LEFT JOIN household_members hm ON hm.id = u.household_member_id`
+)
+
+// CreateUser stores a user whose Password is already hashed. The email doubles as the username, and
+// the salt is part of the bcrypt hash so none is stored apart.
 func (r *AuthRepoImpl) CreateUser(
 	ctx context.Context,
 	user domain.User,
@@ -33,7 +47,32 @@ func (r *AuthRepoImpl) CreateUser(
 	string,
 	error,
 ) {
-	return "", port.ErrUnimplementedError // Replace with actual implementation
+	result, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO users (household_member_id, username, password_hash, salt, email, name, surname)
+         VALUES (?, ?, ?, '', ?, ?, ?)`,
+		user.HouseholdMemberID,
+		user.Email,
+		user.Password,
+		user.Email,
+		user.FirstName,
+		user.LastName,
+	)
+	if err != nil {
+		return "", translateError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return "", fmt.Errorf(
+			"failed to get last insert ID: %w",
+			err,
+		)
+	}
+
+	return strconv.FormatInt(
+		id,
+		10,
+	), nil
 }
 
 func (r *AuthRepoImpl) GetUserByEmail(
@@ -43,7 +82,11 @@ func (r *AuthRepoImpl) GetUserByEmail(
 	*domain.User,
 	error,
 ) {
-	return nil, port.ErrUnimplementedError
+	return r.getUser(
+		ctx,
+		"u.email = ?",
+		email,
+	)
 }
 
 func (r *AuthRepoImpl) GetUserByID(
@@ -53,7 +96,129 @@ func (r *AuthRepoImpl) GetUserByID(
 	*domain.User,
 	error,
 ) {
-	return nil, port.ErrUnimplementedError
+	return r.getUser(
+		ctx,
+		"u.id = ?",
+		id,
+	)
+}
+
+func (r *AuthRepoImpl) GetUserByMemberID(
+	ctx context.Context,
+	memberID string,
+) (
+	*domain.User,
+	error,
+) {
+	return r.getUser(
+		ctx,
+		"u.household_member_id = ?",
+		memberID,
+	)
+}
+
+func (r *AuthRepoImpl) getUser(
+	ctx context.Context,
+	condition string,
+	arg any,
+) (
+	*domain.User,
+	error,
+) {
+	user, err := scanUser(
+		r.db.QueryRowContext(
+			ctx,
+			"SELECT "+userColumns+" FROM users u "+userMemberJoin+" WHERE "+condition, //nolint:gosec // static strings injected here only
+			arg,
+		),
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return user, nil
+}
+
+func (r *AuthRepoImpl) ListUsers(ctx context.Context) (
+	[]domain.User,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT "+userColumns+" FROM users u "+userMemberJoin+" WHERE hm.household_id = ? ORDER BY u.id",
+		household,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	users := make(
+		[]domain.User,
+		0,
+	)
+	for rows.Next() {
+		user, errScan := scanUser(rows)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		users = append(
+			users,
+			*user,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return users, nil
+}
+
+// scanUser scans a row of userColumns
+func scanUser(row rowScanner) (
+	*domain.User,
+	error,
+) {
+	var user domain.User
+	var memberID, householdID, roles sql.NullString
+	err := row.Scan(
+		&user.ID,
+		&user.Email,
+		&user.Password,
+		&user.FirstName,
+		&user.LastName,
+		&memberID,
+		&householdID,
+		&roles,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if memberID.Valid {
+		user.HouseholdMemberID = &memberID.String
+	}
+	if householdID.Valid {
+		user.HouseholdID = &householdID.String
+	}
+	user.Roles = make(
+		[]string,
+		0,
+	)
+	if roles.Valid {
+		user.Roles = strings.Split(
+			roles.String,
+			",",
+		)
+	}
+
+	return &user, nil
 }
 
 func (r *AuthRepoImpl) UpdateUser(
@@ -61,7 +226,56 @@ func (r *AuthRepoImpl) UpdateUser(
 	id string,
 	user domain.User,
 ) error {
-	return port.ErrUnimplementedError
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE users SET name = ?, surname = ? WHERE id = ?`,
+		user.FirstName,
+		user.LastName,
+		id,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, errRowsAffected := result.RowsAffected()
+	if errRowsAffected != nil {
+		return fmt.Errorf(
+			"failed to update user: %w",
+			errRowsAffected,
+		)
+	}
+	if rowsAffected == 0 {
+		return port.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *AuthRepoImpl) LinkMember(
+	ctx context.Context,
+	userID string,
+	memberID string,
+) error {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE users SET household_member_id = ? WHERE id = ?`,
+		memberID,
+		userID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, errRowsAffected := result.RowsAffected()
+	if errRowsAffected != nil {
+		return fmt.Errorf(
+			"failed to link user to member: %w",
+			errRowsAffected,
+		)
+	}
+	if rowsAffected == 0 {
+		return port.ErrRecordNotFound
+	}
+
+	return nil
 }
 
 func (r *AuthRepoImpl) CreateToken(
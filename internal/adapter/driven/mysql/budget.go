@@ -0,0 +1,450 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+const budgetColumns = `id,
+					   category_id,
+					   amount,
+					   currency,
+					   period_type,
+					   period_days,
+					   rollover,
+					   start_date,
+					   end_date,
+					   active,
+					   created_at,
+					   updated_at`
+
+type BudgetRepoImpl struct {
+	db *sql.DB
+}
+
+func NewBudgetRepo(db *sql.DB) port.BudgetRepo {
+	return &BudgetRepoImpl{db: db}
+}
+
+func (r BudgetRepoImpl) Create(
+	ctx context.Context,
+	budget domain.Budget,
+) (
+	string,
+	error,
+) {
+	queryInsert := `INSERT INTO budgets
+						(category_id, amount, currency, period_type, period_days, rollover, start_date, end_date, active)
+					VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	result, errInsert := r.db.ExecContext(
+		ctx,
+		queryInsert,
+		budget.CategoryID,
+		budget.Amount,
+		budget.Currency,
+		budget.PeriodType,
+		budget.PeriodDays,
+		budget.Rollover,
+		budget.StartDate,
+		budget.EndDate,
+		budget.Active,
+	)
+	if errInsert != nil {
+		return "", translateError(errInsert)
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return "", fmt.Errorf(
+			"failed to get last insert ID: %w",
+			err,
+		)
+	}
+
+	return strconv.FormatInt(
+		lastID,
+		10,
+	), nil
+}
+
+func (r BudgetRepoImpl) GetByID(
+	ctx context.Context,
+	id string,
+) (
+	*domain.Budget,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + budgetColumns + `
+					FROM budgets WHERE id=? AND category_id IN (` + householdCategoriesQuery + `)`
+
+	budget, err := r.scanBudget(
+		r.db.QueryRowContext(
+			ctx,
+			query,
+			id,
+			household,
+		),
+	)
+	if errors.Is(
+		err,
+		sql.ErrNoRows,
+	) {
+		return nil, port.ErrRecordNotFound
+	} else if err != nil {
+		return nil, translateError(err)
+	}
+
+	return budget, nil
+}
+
+func (r BudgetRepoImpl) List(
+	ctx context.Context,
+	params domain.BudgetListParams,
+) (
+	[]domain.Budget,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	whereClause := []string{"category_id IN (" + householdCategoriesQuery + ")"}
+	args := []any{household}
+
+	if params.CategoryID != nil {
+		whereClause = append(
+			whereClause,
+			"category_id = ?",
+		)
+		args = append(
+			args,
+			*params.CategoryID,
+		)
+	}
+	if params.Active != nil {
+		whereClause = append(
+			whereClause,
+			"active = ?",
+		)
+		args = append(
+			args,
+			*params.Active,
+		)
+	}
+
+	query := `SELECT ` + budgetColumns + `
+					FROM budgets WHERE ` + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	)
+	query += " ORDER BY category_id, id"
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	budgets := make(
+		[]domain.Budget,
+		0,
+	)
+	for rows.Next() {
+		budget, errScan := r.scanBudget(rows)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		budgets = append(
+			budgets,
+			*budget,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return budgets, nil
+}
+
+func (r BudgetRepoImpl) Update(
+	ctx context.Context,
+	budget domain.Budget,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	queryUpdate := `UPDATE budgets
+					SET category_id=?, amount=?, currency=?, period_type=?, period_days=?, rollover=?, start_date=?,
+						end_date=?, active=?
+					WHERE id=? AND category_id IN (` + householdCategoriesQuery + `)`
+
+	result, err := r.db.ExecContext(
+		ctx,
+		queryUpdate,
+		budget.CategoryID,
+		budget.Amount,
+		budget.Currency,
+		budget.PeriodType,
+		budget.PeriodDays,
+		budget.Rollover,
+		budget.StartDate,
+		budget.EndDate,
+		budget.Active,
+		budget.ID,
+		household,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, errRowsAffected := result.RowsAffected()
+	if errRowsAffected != nil {
+		return translateError(errRowsAffected)
+	}
+	if rowsAffected == 0 {
+		// MySQL reports 0 affected rows when nothing changed, so make sure the budget exists
+		_, errGet := r.GetByID(
+			ctx,
+			budget.ID,
+		)
+
+		return errGet
+	}
+
+	return nil
+}
+
+func (r BudgetRepoImpl) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	result, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM budgets WHERE id=? AND category_id IN (`+householdCategoriesQuery+`)`,
+		id,
+		household,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, errRowsAffected := result.RowsAffected()
+	if errRowsAffected != nil {
+		return translateError(errRowsAffected)
+	}
+	if rowsAffected == 0 {
+		return port.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r BudgetRepoImpl) DailySpent(
+	ctx context.Context,
+	categoryID string,
+	currency string,
+	from time.Time,
+	to time.Time,
+) (
+	[]domain.DailySpending,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT DATE(t.transaction_date)                      AS day,
+					 SUM(t.amount - COALESCE(rf.refunded, 0)) AS spent
+			  FROM expenditures e
+					   INNER JOIN transactions t ON e.transaction_id = t.id
+					   ` + refundsJoin + `
+			  WHERE e.category_id IN (` + categoryTreeQuery + `)
+				AND e.category_id IN (` + householdCategoriesQuery + `)
+				AND t.currency = ?
+				AND t.status = ?
+				AND t.transaction_date >= ?
+				AND t.transaction_date < ?
+				AND ` + notRolledBackCondition + `
+			  GROUP BY day
+			  ORDER BY day`
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		categoryID,
+		household,
+		currency,
+		domain.TransactionStatusCompleted,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	spending := make(
+		[]domain.DailySpending,
+		0,
+	)
+	for rows.Next() {
+		var day domain.DailySpending
+		errScan := rows.Scan(
+			&day.Date,
+			&day.Amount,
+		)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		spending = append(
+			spending,
+			day,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return spending, nil
+}
+
+func (r BudgetRepoImpl) PeriodAmounts(
+	ctx context.Context,
+	budgetID string,
+) (
+	domain.BudgetPeriodAmounts,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT period_number, amount FROM budget_period_amounts WHERE budget_id=? AND budget_id IN (`+householdBudgetsQuery+`)`,
+		budgetID,
+		household,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	amounts := make(domain.BudgetPeriodAmounts)
+	for rows.Next() {
+		var number int
+		var amount float32
+		errScan := rows.Scan(
+			&number,
+			&amount,
+		)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		amounts[number] = amount
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return amounts, nil
+}
+
+func (r BudgetRepoImpl) SetPeriodAmount(
+	ctx context.Context,
+	budgetID string,
+	number int,
+	amount float32,
+) error {
+	queryUpsert := `INSERT INTO budget_period_amounts (budget_id, period_number, amount)
+					VALUES (?, ?, ?)
+					ON DUPLICATE KEY UPDATE amount=VALUES(amount)`
+
+	_, err := r.db.ExecContext(
+		ctx,
+		queryUpsert,
+		budgetID,
+		number,
+		amount,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func (r BudgetRepoImpl) DeletePeriodAmounts(
+	ctx context.Context,
+	budgetID string,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(
+		ctx,
+		`DELETE FROM budget_period_amounts WHERE budget_id=? AND budget_id IN (`+householdBudgetsQuery+`)`,
+		budgetID,
+		household,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func (r BudgetRepoImpl) scanBudget(row rowScanner) (
+	*domain.Budget,
+	error,
+) {
+	var budget domain.Budget
+	var periodDays sql.NullInt64
+	var endDate sql.NullTime
+
+	err := row.Scan(
+		&budget.ID,
+		&budget.CategoryID,
+		&budget.Amount,
+		&budget.Currency,
+		&budget.PeriodType,
+		&periodDays,
+		&budget.Rollover,
+		&budget.StartDate,
+		&endDate,
+		&budget.Active,
+		&budget.CreatedAt,
+		&budget.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if periodDays.Valid {
+		days := int(periodDays.Int64)
+		budget.PeriodDays = &days
+	}
+	if endDate.Valid {
+		budget.EndDate = &endDate.Time
+	}
+
+	return &budget, nil
+}
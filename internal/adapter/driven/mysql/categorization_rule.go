@@ -0,0 +1,614 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+const categorizationRuleColumns = `id,
+					   name,
+					   priority,
+					   active,
+					   description_match,
+					   description_pattern,
+					   min_amount,
+					   max_amount,
+					   account_id,
+					   payee_id,
+					   category_id,
+					   created_at,
+					   updated_at`
+
+type CategorizationRuleRepoImpl struct {
+	db *sql.DB
+}
+
+func NewCategorizationRuleRepo(db *sql.DB) port.CategorizationRuleRepo {
+	return &CategorizationRuleRepoImpl{db: db}
+}
+
+func (r CategorizationRuleRepoImpl) Create(
+	ctx context.Context,
+	rule domain.CategorizationRule,
+) (
+	string,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return "", translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	match, pattern := descriptionColumns(rule.Description)
+	result, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO categorization_rules (household_id, name, priority, active, description_match, description_pattern,
+                                           min_amount, max_amount, account_id, payee_id, category_id)
+         VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		household,
+		rule.Name,
+		rule.Priority,
+		rule.Active,
+		match,
+		pattern,
+		rule.MinAmount,
+		rule.MaxAmount,
+		rule.AccountID,
+		rule.PayeeID,
+		rule.CategoryID,
+	)
+	if err != nil {
+		return "", translateError(err)
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return "", fmt.Errorf(
+			"failed to get last insert ID: %w",
+			err,
+		)
+	}
+	id := strconv.FormatInt(
+		lastID,
+		10,
+	)
+
+	err = r.insertTags(
+		ctx,
+		tx,
+		id,
+		rule.TagIDs,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", translateError(err)
+	}
+
+	return id, nil
+}
+
+func (r CategorizationRuleRepoImpl) GetByID(
+	ctx context.Context,
+	id string,
+) (
+	*domain.CategorizationRule,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + categorizationRuleColumns + `
+					FROM categorization_rules WHERE id=? AND household_id=?`
+
+	rule, err := r.scanRule(
+		r.db.QueryRowContext(
+			ctx,
+			query,
+			id,
+			household,
+		),
+	)
+	if errors.Is(
+		err,
+		sql.ErrNoRows,
+	) {
+		return nil, port.ErrRecordNotFound
+	} else if err != nil {
+		return nil, translateError(err)
+	}
+
+	rule.TagIDs, err = r.listTags(
+		ctx,
+		rule.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (r CategorizationRuleRepoImpl) List(
+	ctx context.Context,
+	params domain.CategorizationRuleListParams,
+) (
+	[]domain.CategorizationRule,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + categorizationRuleColumns + `
+					FROM categorization_rules WHERE household_id = ?`
+	args := []any{household}
+	if params.Active != nil {
+		query += " AND active = ?"
+		args = append(
+			args,
+			*params.Active,
+		)
+	}
+	query += " ORDER BY priority, id"
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	rules := make(
+		[]domain.CategorizationRule,
+		0,
+	)
+	for rows.Next() {
+		rule, errScan := r.scanRule(rows)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		rules = append(
+			rules,
+			*rule,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	for i := range rules {
+		rules[i].TagIDs, err = r.listTags(
+			ctx,
+			rules[i].ID,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rules, nil
+}
+
+func (r CategorizationRuleRepoImpl) Update(
+	ctx context.Context,
+	rule domain.CategorizationRule,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	var exists bool
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT TRUE FROM categorization_rules WHERE id = ? AND household_id = ? FOR UPDATE`,
+		rule.ID,
+		household,
+	).Scan(&exists)
+	if err != nil {
+		return translateError(err)
+	}
+
+	match, pattern := descriptionColumns(rule.Description)
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE categorization_rules
+         SET name = ?, priority = ?, active = ?, description_match = ?, description_pattern = ?, min_amount = ?,
+             max_amount = ?, account_id = ?, payee_id = ?, category_id = ?
+         WHERE id = ?`,
+		rule.Name,
+		rule.Priority,
+		rule.Active,
+		match,
+		pattern,
+		rule.MinAmount,
+		rule.MaxAmount,
+		rule.AccountID,
+		rule.PayeeID,
+		rule.CategoryID,
+		rule.ID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`DELETE FROM categorization_rule_tags WHERE rule_id = ?`,
+		rule.ID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	err = r.insertTags(
+		ctx,
+		tx,
+		rule.ID,
+		rule.TagIDs,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func (r CategorizationRuleRepoImpl) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	result, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM categorization_rules WHERE id = ? AND household_id = ?`,
+		id,
+		household,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, errRowsAffected := result.RowsAffected()
+	if errRowsAffected != nil {
+		return translateError(errRowsAffected)
+	}
+	if rowsAffected == 0 {
+		return port.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r CategorizationRuleRepoImpl) Candidates(
+	ctx context.Context,
+	params domain.RuleCandidateParams,
+) (
+	[]domain.RuleCandidate,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	whereClause := []string{"e.category_id IN (" + householdCategoriesQuery + ")"}
+	args := []any{household}
+
+	if len(params.ExpenditureIDs) > 0 {
+		whereClause = append(
+			whereClause,
+			"e.id IN ("+strings.TrimSuffix(
+				strings.Repeat(
+					"?,",
+					len(params.ExpenditureIDs),
+				),
+				",",
+			)+")",
+		)
+		for _, id := range params.ExpenditureIDs {
+			args = append(
+				args,
+				id,
+			)
+		}
+	}
+	if params.CategoryID != nil {
+		whereClause = append(
+			whereClause,
+			"e.category_id = ?",
+		)
+		args = append(
+			args,
+			*params.CategoryID,
+		)
+	}
+	if params.StartDate != nil {
+		whereClause = append(
+			whereClause,
+			"t.transaction_date >= ?",
+		)
+		args = append(
+			args,
+			*params.StartDate,
+		)
+	}
+	if params.EndDate != nil {
+		whereClause = append(
+			whereClause,
+			"t.transaction_date < ?",
+		)
+		args = append(
+			args,
+			params.EndDate.AddDate(
+				0,
+				0,
+				1,
+			),
+		)
+	}
+
+	query := `SELECT e.id,
+                     t.transaction_date,
+                     t.description,
+                     t.amount,
+                     t.currency,
+                     t.account_id,
+                     e.payee_id,
+                     e.category_id,
+                     GROUP_CONCAT(et.tag_id ORDER BY et.tag_id SEPARATOR ',')
+              FROM expenditures e
+                       INNER JOIN transactions t ON e.transaction_id = t.id
+                       LEFT JOIN expenditure_tags et ON e.id = et.expenditure_id
+              WHERE ` + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	)
+	query += `
+              GROUP BY e.id, t.transaction_date, t.description, t.amount, t.currency, t.account_id, e.payee_id,
+                       e.category_id
+              ORDER BY t.transaction_date DESC, e.id DESC`
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	candidates := make(
+		[]domain.RuleCandidate,
+		0,
+	)
+	for rows.Next() {
+		var candidate domain.RuleCandidate
+		var tagIDs sql.NullString
+		errScan := rows.Scan(
+			&candidate.ExpenditureID,
+			&candidate.Date,
+			&candidate.Description,
+			&candidate.Amount,
+			&candidate.Currency,
+			&candidate.AccountID,
+			&candidate.PayeeID,
+			&candidate.CategoryID,
+			&tagIDs,
+		)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		if tagIDs.Valid {
+			candidate.TagIDs = strings.Split(
+				tagIDs.String,
+				",",
+			)
+		}
+		candidates = append(
+			candidates,
+			candidate,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return candidates, nil
+}
+
+func (r CategorizationRuleRepoImpl) Apply(
+	ctx context.Context,
+	categorizations []domain.Categorization,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	for _, categorization := range categorizations {
+		if categorization.CategoryID != nil {
+			_, err = tx.ExecContext(
+				ctx,
+				`UPDATE expenditures SET category_id = ? WHERE id = ? AND category_id IN (`+householdCategoriesQuery+`)`,
+				*categorization.CategoryID,
+				categorization.ExpenditureID,
+				household,
+			)
+			if err != nil {
+				return translateError(err)
+			}
+		}
+
+		for _, tagID := range categorization.AddedTagIDs {
+			_, err = tx.ExecContext(
+				ctx,
+				`INSERT INTO expenditure_tags (tag_id, expenditure_id) VALUES (?, ?)`,
+				tagID,
+				categorization.ExpenditureID,
+			)
+			if err != nil {
+				return translateError(err)
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func (r CategorizationRuleRepoImpl) insertTags(
+	ctx context.Context,
+	tx *sql.Tx,
+	ruleID string,
+	tagIDs []string,
+) error {
+	for _, tagID := range tagIDs {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO categorization_rule_tags (rule_id, tag_id) VALUES (?, ?)`,
+			ruleID,
+			tagID,
+		)
+		if err != nil {
+			return translateError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r CategorizationRuleRepoImpl) listTags(
+	ctx context.Context,
+	ruleID string,
+) (
+	[]string,
+	error,
+) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT tag_id FROM categorization_rule_tags WHERE rule_id = ? ORDER BY tag_id`,
+		ruleID,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	tagIDs := make(
+		[]string,
+		0,
+	)
+	for rows.Next() {
+		var tagID string
+		errScan := rows.Scan(&tagID)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		tagIDs = append(
+			tagIDs,
+			tagID,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return tagIDs, nil
+}
+
+func (r CategorizationRuleRepoImpl) scanRule(row rowScanner) (
+	*domain.CategorizationRule,
+	error,
+) {
+	var rule domain.CategorizationRule
+	var match, pattern sql.NullString
+
+	err := row.Scan(
+		&rule.ID,
+		&rule.Name,
+		&rule.Priority,
+		&rule.Active,
+		&match,
+		&pattern,
+		&rule.MinAmount,
+		&rule.MaxAmount,
+		&rule.AccountID,
+		&rule.PayeeID,
+		&rule.CategoryID,
+		&rule.CreatedAt,
+		&rule.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if match.Valid {
+		rule.Description = &domain.DescriptionCondition{
+			Match:   domain.DescriptionMatch(match.String),
+			Pattern: pattern.String,
+		}
+	}
+
+	return &rule, nil
+}
+
+// descriptionColumns returns the values of the description match and pattern columns, null without condition
+func descriptionColumns(condition *domain.DescriptionCondition) (
+	match *domain.DescriptionMatch,
+	pattern *string,
+) {
+	if condition == nil {
+		return nil, nil
+	}
+
+	return &condition.Match, &condition.Pattern
+}
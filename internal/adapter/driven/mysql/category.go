@@ -6,11 +6,23 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"ghorkov32/proletariat-budget-be/internal/core/domain"
 	"ghorkov32/proletariat-budget-be/internal/core/port"
 )
 
+// categoryTreeQuery selects the id of the category ? and of every category under it
+const categoryTreeQuery = `WITH RECURSIVE category_tree AS (SELECT id
+                                                          FROM categories
+                                                          WHERE id = ?
+                                                          UNION ALL
+                                                          SELECT sc.id
+                                                          FROM categories sc
+                                                                   INNER JOIN category_tree ct ON sc.parent_id = ct.id)
+                           SELECT id
+                           FROM category_tree`
+
 type CategoryRepoImpl struct {
 	db *sql.DB
 }
@@ -26,17 +38,23 @@ func (c CategoryRepoImpl) Create(
 	string,
 	error,
 ) {
-	queryInsert := `INSERT INTO categories  (name, description, color, background_color, active, category_type) 
-						VALUES (?,?,?,?,?,?)`
+	household, err := householdID(ctx)
+	if err != nil {
+		return "", err
+	}
+	queryInsert := `INSERT INTO categories  (household_id, name, description, color, background_color, active, category_type, parent_id) 
+						VALUES (?,?,?,?,?,?,?,?)`
 	result, errInsert := c.db.ExecContext(
 		ctx,
 		queryInsert,
+		household,
 		category.Name,
 		category.Description,
 		category.Color,
 		category.BackgroundColor,
 		category.Active,
 		category.CategoryType,
+		category.ParentID,
 	)
 	if errInsert != nil {
 		return "", translateError(errInsert)
@@ -60,7 +78,11 @@ func (c CategoryRepoImpl) Update(
 	ctx context.Context,
 	category domain.Category,
 ) error {
-	queryUpdate := `UPDATE categories SET name=?, description=?, color=?, background_color=?, active=?, category_type=? WHERE id=?`
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	queryUpdate := `UPDATE categories SET name=?, description=?, color=?, background_color=?, active=?, category_type=?, parent_id=? WHERE id=? AND household_id=?`
 
 	result, err := c.db.ExecContext(
 		ctx,
@@ -71,13 +93,12 @@ func (c CategoryRepoImpl) Update(
 		category.BackgroundColor,
 		category.Active,
 		category.CategoryType,
+		category.ParentID,
 		category.ID,
+		household,
 	)
 	if err != nil {
-		return fmt.Errorf(
-			"failed to update category: %w",
-			err,
-		)
+		return translateError(err)
 	}
 	rowsAffected, errRowsAffected := result.RowsAffected()
 	if errRowsAffected != nil {
@@ -94,12 +115,17 @@ func (c CategoryRepoImpl) Delete(
 	ctx context.Context,
 	id string,
 ) error {
-	queryUpdate := `delete from categories where id=?`
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	queryUpdate := `delete from categories where id=? and household_id=?`
 
 	result, err := c.db.ExecContext(
 		ctx,
 		queryUpdate,
 		id,
+		household,
 	)
 	if err != nil {
 		return translateError(err)
@@ -122,13 +148,18 @@ func (c CategoryRepoImpl) GetByID(
 	*domain.Category,
 	error,
 ) {
-	query := `SELECT id, name, description, color, background_color, active, category_type FROM categories WHERE id=?`
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT id, name, description, color, background_color, active, category_type, parent_id FROM categories WHERE id=? AND household_id=?`
 
 	var category domain.Category
-	err := c.db.QueryRowContext(
+	err = c.db.QueryRowContext(
 		ctx,
 		query,
 		id,
+		household,
 	).Scan(
 		&category.ID,
 		&category.Name,
@@ -137,6 +168,7 @@ func (c CategoryRepoImpl) GetByID(
 		&category.BackgroundColor,
 		&category.Active,
 		&category.CategoryType,
+		&category.ParentID,
 	)
 	if errors.Is(
 		err,
@@ -154,11 +186,16 @@ func (c CategoryRepoImpl) List(ctx context.Context) (
 	[]domain.Category,
 	error,
 ) {
-	query := `SELECT id, name, description, color, background_color, active, category_type FROM categories WHERE active=true`
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT id, name, description, color, background_color, active, category_type, parent_id FROM categories WHERE active=true AND household_id=?`
 
 	rows, err := c.db.QueryContext(
 		ctx,
 		query,
+		household,
 	)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -179,6 +216,7 @@ func (c CategoryRepoImpl) List(ctx context.Context) (
 			&category.BackgroundColor,
 			&category.Active,
 			&category.CategoryType,
+			&category.ParentID,
 		)
 		if err != nil {
 			return nil, translateError(err)
@@ -199,12 +237,17 @@ func (c CategoryRepoImpl) FindByType(
 	[]domain.Category,
 	error,
 ) {
-	query := `SELECT id, name, description, color, background_color, active, category_type FROM categories WHERE category_type=? AND active=true`
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT id, name, description, color, background_color, active, category_type, parent_id FROM categories WHERE category_type=? AND active=true AND household_id=?`
 
 	rows, err := c.db.QueryContext(
 		ctx,
 		query,
 		categoryType,
+		household,
 	)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -224,6 +267,7 @@ func (c CategoryRepoImpl) FindByType(
 			&category.BackgroundColor,
 			&category.Active,
 			&category.CategoryType,
+			&category.ParentID,
 		)
 		if err != nil {
 			return nil, translateError(err)
@@ -244,11 +288,16 @@ func (c CategoryRepoImpl) FindByIDs(
 	[]domain.Category,
 	error,
 ) {
-	query := `SELECT id, name, description, color, background_color, active FROM categories WHERE id IN (?) AND active=true`
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT id, name, description, color, background_color, active, parent_id FROM categories WHERE id IN (?) AND active=true AND household_id=?`
 	rows, err := c.db.QueryContext(
 		ctx,
 		query,
 		ids,
+		household,
 	)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -267,6 +316,7 @@ func (c CategoryRepoImpl) FindByIDs(
 			&category.Color,
 			&category.BackgroundColor,
 			&category.Active,
+			&category.ParentID,
 		)
 		if err != nil {
 			return nil, translateError(err)
@@ -279,3 +329,276 @@ func (c CategoryRepoImpl) FindByIDs(
 
 	return categories, nil
 }
+
+// Descendants returns every category under the given one, at any depth
+func (c CategoryRepoImpl) Descendants(
+	ctx context.Context,
+	id string,
+) (
+	[]domain.Category,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `WITH RECURSIVE category_tree AS (SELECT id
+	                                           FROM categories
+	                                           WHERE parent_id = ? AND household_id = ?
+	                                           UNION ALL
+	                                           SELECT sc.id
+	                                           FROM categories sc
+	                                                    INNER JOIN category_tree ct ON sc.parent_id = ct.id)
+	          SELECT c.id, c.name, c.description, c.color, c.background_color, c.active, c.category_type, c.parent_id
+	          FROM categories c
+	                   INNER JOIN category_tree ct ON c.id = ct.id`
+
+	rows, err := c.db.QueryContext(
+		ctx,
+		query,
+		id,
+		household,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	categories := make(
+		[]domain.Category,
+		0,
+	)
+	for rows.Next() {
+		var category domain.Category
+		err = rows.Scan(
+			&category.ID,
+			&category.Name,
+			&category.Description,
+			&category.Color,
+			&category.BackgroundColor,
+			&category.Active,
+			&category.CategoryType,
+			&category.ParentID,
+		)
+		if err != nil {
+			return nil, translateError(err)
+		}
+		categories = append(
+			categories,
+			category,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return categories, nil
+}
+
+// Deactivate deactivates the given categories at once
+func (c CategoryRepoImpl) Deactivate(
+	ctx context.Context,
+	ids []string,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	args := make(
+		[]any,
+		0,
+		len(ids)+1,
+	)
+	for _, id := range ids {
+		args = append(
+			args,
+			id,
+		)
+	}
+	args = append(
+		args,
+		household,
+	)
+
+	query := `UPDATE categories SET active=false WHERE id IN (` + strings.TrimSuffix(
+		strings.Repeat(
+			"?,",
+			len(ids),
+		),
+		",",
+	) + `) AND household_id = ?`
+	_, err = c.db.ExecContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
+// Merge moves everything using the source category to the target one and then deletes or deactivates
+// the source, all in one transaction. It refuses to when both categories have budgets.
+func (c CategoryRepoImpl) Merge(
+	ctx context.Context,
+	sourceID string,
+	targetID string,
+	action domain.CategoryMergeAction,
+) (
+	domain.CategoryReassignments,
+	error,
+) {
+	var moved domain.CategoryReassignments
+
+	household, err := householdID(ctx)
+	if err != nil {
+		return moved, err
+	}
+
+	tx, err := c.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return moved, translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	var exists bool
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT TRUE FROM categories WHERE id = ? AND household_id = ? FOR UPDATE`,
+		sourceID,
+		household,
+	).Scan(&exists)
+	if err != nil {
+		return moved, translateError(err)
+	}
+
+	// Moving the budgets of the source to a target with budgets of its own would limit the same
+	// spending twice. The budgets of both are locked so none is created meanwhile.
+	var budgeted int
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT COUNT(DISTINCT category_id) FROM budgets WHERE category_id IN (?, ?) FOR UPDATE`,
+		sourceID,
+		targetID,
+	).Scan(&budgeted)
+	if err != nil {
+		return moved, translateError(err)
+	}
+	if budgeted == 2 {
+		return moved, domain.ErrCategoryMergeBothBudgeted
+	}
+
+	var merged int64
+	for _, reassignment := range []struct {
+		query string
+		args  []any
+		moved *int64
+	}{
+		{
+			query: `UPDATE expenditures SET category_id = ? WHERE category_id = ?`,
+			args:  []any{targetID, sourceID},
+			moved: &moved.Expenditures,
+		},
+		{
+			query: `UPDATE ingresses SET category_id = ? WHERE category_id = ?`,
+			args:  []any{targetID, sourceID},
+			moved: &moved.Ingresses,
+		},
+		{
+			query: `UPDATE savings_goals SET category_id = ? WHERE category_id = ?`,
+			args:  []any{targetID, sourceID},
+			moved: &moved.SavingsGoals,
+		},
+		{
+			query: `UPDATE expenditure_recurrence_patterns SET category_id = ? WHERE category_id = ?`,
+			args:  []any{targetID, sourceID},
+			moved: &moved.RecurringBills,
+		},
+		{
+			query: `UPDATE installment_plans SET category_id = ? WHERE category_id = ?`,
+			args:  []any{targetID, sourceID},
+			moved: &moved.InstallmentPlans,
+		},
+		{
+			query: `UPDATE budgets SET category_id = ? WHERE category_id = ?`,
+			args:  []any{targetID, sourceID},
+			moved: &moved.Budgets,
+		},
+		// A plan allocating to both categories keeps a single allocation to the target with both amounts
+		{
+			query: `UPDATE allocations ta
+                        INNER JOIN allocations sa ON sa.allocation_plan_id = ta.allocation_plan_id AND sa.category_id = ?
+                    SET ta.amount = ta.amount + sa.amount
+                    WHERE ta.category_id = ?`,
+			args:  []any{sourceID, targetID},
+			moved: &merged,
+		},
+		{
+			query: `DELETE sa
+                    FROM allocations sa
+                             INNER JOIN allocations ta
+                                        ON ta.allocation_plan_id = sa.allocation_plan_id AND ta.category_id = ?
+                    WHERE sa.category_id = ?`,
+			args:  []any{targetID, sourceID},
+			moved: &moved.Allocations,
+		},
+		{
+			query: `UPDATE allocations SET category_id = ? WHERE category_id = ?`,
+			args:  []any{targetID, sourceID},
+			moved: &moved.Allocations,
+		},
+		{
+			query: `UPDATE payees SET default_category_id = ? WHERE default_category_id = ?`,
+			args:  []any{targetID, sourceID},
+			moved: &moved.Payees,
+		},
+		{
+			query: `UPDATE categorization_rules SET category_id = ? WHERE category_id = ?`,
+			args:  []any{targetID, sourceID},
+			moved: &moved.Rules,
+		},
+		{
+			query: `UPDATE categories SET parent_id = ? WHERE parent_id = ?`,
+			args:  []any{targetID, sourceID},
+			moved: &moved.Subcategories,
+		},
+	} {
+		result, errExec := tx.ExecContext(
+			ctx,
+			reassignment.query,
+			reassignment.args...,
+		)
+		if errExec != nil {
+			return moved, translateError(errExec)
+		}
+		rowsAffected, errRowsAffected := result.RowsAffected()
+		if errRowsAffected != nil {
+			return moved, translateError(errRowsAffected)
+		}
+		*reassignment.moved += rowsAffected
+	}
+
+	query := `DELETE FROM categories WHERE id = ?`
+	if action == domain.CategoryMergeDeactivate {
+		query = `UPDATE categories SET active = false WHERE id = ?`
+	}
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		sourceID,
+	)
+	if err != nil {
+		return moved, translateError(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return moved, translateError(err)
+	}
+
+	return moved, nil
+}
@@ -0,0 +1,101 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+type CategorySuggestionRepoImpl struct {
+	db *sql.DB
+}
+
+func NewCategorySuggestionRepo(db *sql.DB) port.CategorySuggestionRepo {
+	return &CategorySuggestionRepoImpl{db: db}
+}
+
+func (r CategorySuggestionRepoImpl) TrainingExamples(
+	ctx context.Context,
+	afterID string,
+	limit int,
+) (
+	[]domain.TrainingExample,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT e.id,
+                     t.description,
+                     e.category_id,
+                     GROUP_CONCAT(et.tag_id ORDER BY et.tag_id SEPARATOR ',')
+              FROM expenditures e
+                       INNER JOIN transactions t ON e.transaction_id = t.id
+                       LEFT JOIN expenditure_tags et ON e.id = et.expenditure_id
+              WHERE e.category_id IN (` + householdCategoriesQuery + `)`
+	args := []any{household}
+	if afterID != "" {
+		query += `
+                AND e.id > ?`
+		args = append(
+			args,
+			afterID,
+		)
+	}
+	query += `
+              GROUP BY e.id, t.description, e.category_id
+              ORDER BY e.id
+              LIMIT ?`
+	args = append(
+		args,
+		limit,
+	)
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	examples := make(
+		[]domain.TrainingExample,
+		0,
+	)
+	for rows.Next() {
+		var example domain.TrainingExample
+		var tagIDs sql.NullString
+		errScan := rows.Scan(
+			&example.ExpenditureID,
+			&example.Description,
+			&example.CategoryID,
+			&tagIDs,
+		)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		if tagIDs.Valid {
+			example.TagIDs = strings.Split(
+				tagIDs.String,
+				",",
+			)
+		}
+		examples = append(
+			examples,
+			example,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return examples, nil
+}
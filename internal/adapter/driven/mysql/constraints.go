@@ -22,10 +22,32 @@ const (
 	FKTransactionAccount  ForeignKeyConstraint = "fk_transaction_account"
 	FKTransactionCurrency ForeignKeyConstraint = "fk_transaction_currency"
 
+	FKTransactionOriginalCurrency ForeignKeyConstraint = "fk_transaction_original_currency"
+
+	// Category constraints
+	FKCategoryParent ForeignKeyConstraint = "fk_category_parent"
+
 	// Expenditure constraints
 	FKExpenditureCategory    ForeignKeyConstraint = "fk_expenditure_category"
 	FKExpenditureTransaction ForeignKeyConstraint = "fk_expenditure_transaction"
 
+	FKExpenditureRecurrencePattern ForeignKeyConstraint = "fk_expenditure_recurrence_pattern"
+	FKExpenditurePayee             ForeignKeyConstraint = "fk_expenditure_payee"
+
+	FKExpenditureInstallmentPlan ForeignKeyConstraint = "fk_expenditure_installment_plan"
+
+	// Expenditure refunds constraints
+	FKExpenditureRefundExpenditure ForeignKeyConstraint = "fk_expenditure_refund_expenditure"
+	FKExpenditureRefundTransaction ForeignKeyConstraint = "fk_expenditure_refund_transaction"
+
+	// Expenditure recurrence patterns constraints
+	FKExpenditureRecurrenceCategory ForeignKeyConstraint = "fk_expenditure_recurrence_category"
+	FKExpenditureRecurrenceAccount  ForeignKeyConstraint = "fk_expenditure_recurrence_account"
+
+	// Installment plans constraints
+	FKInstallmentPlanAccount  ForeignKeyConstraint = "fk_installment_plan_account"
+	FKInstallmentPlanCategory ForeignKeyConstraint = "fk_installment_plan_category"
+
 	// Expenditure tags constraints
 	FKExpenditureTagsExpenditureID ForeignKeyConstraint = "fk_expenditure_tags_expenditure_id"
 	FKExpenditureTagsTagID         ForeignKeyConstraint = "fk_expenditure_tags_tag_id"
@@ -34,6 +56,7 @@ const (
 	FKIngressCategory          ForeignKeyConstraint = "fk_ingress_category"
 	FKIngressRecurrencyPattern ForeignKeyConstraint = "fk_ingress_recurrency_pattern"
 	FKIngressTransaction       ForeignKeyConstraint = "fk_ingress_transaction"
+	FKIngressPayee             ForeignKeyConstraint = "fk_ingress_payee"
 
 	// Ingress tags constraints
 	FKIngressTagsIngress ForeignKeyConstraint = "fk_ingress"
@@ -42,6 +65,28 @@ const (
 	// Ingress recurrence patterns constraints
 	FKIngressRecurrencePatternAccount ForeignKeyConstraint = "fk_ingress_recurrence_pattern_account"
 
+	// Budgets constraints
+	FKBudgetCategory           ForeignKeyConstraint = "fk_budget_category"
+	FKBudgetCurrency           ForeignKeyConstraint = "fk_budget_currency"
+	FKBudgetPeriodAmountBudget ForeignKeyConstraint = "fk_budget_period_amount_budget"
+
+	// Allocation plans constraints
+	FKAllocationPlanCurrency ForeignKeyConstraint = "fk_allocation_plan_currency"
+	FKAllocationPlan         ForeignKeyConstraint = "fk_allocation_plan"
+	FKAllocationCategory     ForeignKeyConstraint = "fk_allocation_category"
+	FKAllocationSavingsGoal  ForeignKeyConstraint = "fk_allocation_savings_goal"
+
+	// Payee constraints
+	FKPayeeDefaultCategory ForeignKeyConstraint = "fk_payee_default_category"
+	FKPayeeAliasPayee      ForeignKeyConstraint = "fk_payee_alias_payee"
+
+	// Categorization rules constraints
+	FKCategorizationRuleAccount  ForeignKeyConstraint = "fk_categorization_rule_account"
+	FKCategorizationRulePayee    ForeignKeyConstraint = "fk_categorization_rule_payee"
+	FKCategorizationRuleCategory ForeignKeyConstraint = "fk_categorization_rule_category"
+	FKCategorizationRuleTagRule  ForeignKeyConstraint = "fk_categorization_rule_tag_rule"
+	FKCategorizationRuleTagTag   ForeignKeyConstraint = "fk_categorization_rule_tag_tag"
+
 	// Savings goals constraints
 	FKSavingsGoalAccount    ForeignKeyConstraint = "fk_savings_goal_account"
 	FKSavingsGoalCategoryID ForeignKeyConstraint = "fk_savings_goal_category_id"
@@ -80,6 +125,13 @@ const (
 	FKUserRolesUser ForeignKeyConstraint = "fk_user_roles_user"
 	FKUserRolesRole ForeignKeyConstraint = "fk_user_roles_role"
 
+	// User constraints
+	FKUserHouseholdMember ForeignKeyConstraint = "fk_user_household_member"
+
+	// Invitation constraints
+	FKInvitationHouseholdMember ForeignKeyConstraint = "fk_invitation_household_member"
+	FKInvitationInvitedBy       ForeignKeyConstraint = "fk_invitation_invited_by"
+
 	// Transaction rollbacks constraints
 	FKTransactionRollbacksRollbackTransaction ForeignKeyConstraint = "fk_transaction_rollbacks_rollback_transaction"
 	FKTransactionRollbacksTransaction         ForeignKeyConstraint = "fk_transaction_rollbacks_transaction"
@@ -118,6 +170,20 @@ var ForeignKeyErrorMap = map[ForeignKeyConstraint]map[uint16]error{
 		}, // shouldn't happen because there's no way to delete a currency, but we'll create a custom error anyways just in case
 		1452: domain.ErrInvalidCurrency,
 	},
+	FKTransactionOriginalCurrency: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'transactions' table for key 'original_currency'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because there's no way to delete a currency, but we'll create a custom error anyways just in case
+		1452: domain.ErrInvalidCurrency,
+	},
+
+	// Category constraints
+	FKCategoryParent: {
+		1451: domain.ErrCategoryHasSubcategories,
+		1452: domain.ErrCategoryParentNotFound,
+	},
 
 	// Expenditure constraints
 	FKExpenditureCategory: {
@@ -137,6 +203,71 @@ var ForeignKeyErrorMap = map[ForeignKeyConstraint]map[uint16]error{
 		1452: domain.ErrTransactionNotFound,
 	},
 
+	FKExpenditureRecurrencePattern: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'expenditures' table for key 'from_recurrence_pattern_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because the key is set to null when the pattern is deleted
+		1452: domain.ErrExpenditureRecurrencePatternNotFound,
+	},
+
+	FKExpenditurePayee: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'expenditures' table for key 'payee_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because the key is set to null when the payee is deleted
+		1452: domain.ErrPayeeNotFound,
+	},
+
+	FKExpenditureInstallmentPlan: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'expenditures' table for key 'installment_plan_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because plans are only deleted before their installments are created
+		1452: domain.ErrInstallmentPlanNotFound,
+	},
+
+	// Expenditure refunds constraints
+	FKExpenditureRefundExpenditure: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'expenditure_refunds' table for key 'expenditure_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because expenditures are immutable
+		1452: domain.ErrExpenditureNotFound,
+	},
+	FKExpenditureRefundTransaction: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'expenditure_refunds' table for key 'transaction_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because there's no way to delete a single TX
+		1452: domain.ErrTransactionNotFound,
+	},
+
+	// Expenditure recurrence patterns constraints
+	FKExpenditureRecurrenceCategory: {
+		1451: domain.ErrCategoryUsedInEntity,
+		1452: domain.ErrCategoryNotFound,
+	},
+	FKExpenditureRecurrenceAccount: {
+		1451: domain.ErrAccountHasActiveRecurrencePatterns,
+		1452: domain.ErrAccountNotFound,
+	},
+
+	// Installment plans constraints
+	FKInstallmentPlanAccount: {
+		1451: domain.ErrAccountHasTransactions,
+		1452: domain.ErrAccountNotFound,
+	},
+	FKInstallmentPlanCategory: {
+		1451: domain.ErrCategoryUsedInExpenditure,
+		1452: domain.ErrCategoryNotFound,
+	},
+
 	// Expenditure tags constraints
 	FKExpenditureTagsExpenditureID: {
 		1451: &port.InfrastructureError{
@@ -177,6 +308,15 @@ var ForeignKeyErrorMap = map[ForeignKeyConstraint]map[uint16]error{
 		1452: domain.ErrTransactionNotFound,
 	},
 
+	FKIngressPayee: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'ingresses' table for key 'payee_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because the key is set to null when the payee is deleted
+		1452: domain.ErrPayeeNotFound,
+	},
+
 	// Ingress tags constraints
 	FKIngressTagsIngress: {
 		1451: &port.InfrastructureError{
@@ -197,6 +337,114 @@ var ForeignKeyErrorMap = map[ForeignKeyConstraint]map[uint16]error{
 		1452: domain.ErrAccountNotFound,
 	},
 
+	// Budgets constraints
+	FKBudgetCategory: {
+		1451: domain.ErrCategoryUsedInBudget,
+		1452: domain.ErrCategoryNotFound,
+	},
+	FKBudgetCurrency: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'budgets' table for key 'currency'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because there's no option to delete a currency
+		1452: domain.ErrInvalidCurrency,
+	},
+	FKBudgetPeriodAmountBudget: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'budget_period_amounts' table for key 'budget_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because the period amounts are deleted with their budget
+		1452: domain.ErrBudgetNotFound,
+	},
+
+	// Allocation plans constraints
+	FKAllocationPlanCurrency: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'allocation_plans' table for key 'currency'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because there's no option to delete a currency
+		1452: domain.ErrInvalidCurrency,
+	},
+	FKAllocationPlan: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'allocations' table for key 'allocation_plan_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because the allocations are deleted with their plan
+		1452: domain.ErrAllocationPlanNotFound,
+	},
+	FKAllocationCategory: {
+		1451: domain.ErrCategoryUsedInAllocationPlan,
+		1452: domain.ErrCategoryNotFound,
+	},
+	FKAllocationSavingsGoal: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'allocations' table for key 'savings_goal_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because the allocations are deleted with their savings goal
+		1452: domain.ErrSavingsGoalNotFound,
+	},
+
+	// Payee constraints
+	FKPayeeDefaultCategory: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'payees' table for key 'default_category_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because the key is set to null when the category is deleted
+		1452: domain.ErrCategoryNotFound,
+	},
+	FKPayeeAliasPayee: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'payee_aliases' table for key 'payee_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because aliases are deleted with their payee
+		1452: domain.ErrPayeeNotFound,
+	},
+
+	// Categorization rules constraints
+	FKCategorizationRuleAccount: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'categorization_rules' table for key 'account_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because rules are deleted with their account
+		1452: domain.ErrAccountNotFound,
+	},
+	FKCategorizationRulePayee: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'categorization_rules' table for key 'payee_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because rules are deleted with their payee
+		1452: domain.ErrPayeeNotFound,
+	},
+	FKCategorizationRuleCategory: {
+		1451: domain.ErrCategoryUsedInCategorizationRule,
+		1452: domain.ErrCategoryNotFound,
+	},
+	FKCategorizationRuleTagRule: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'categorization_rule_tags' table for key 'rule_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because the tags are deleted with their rule
+		1452: domain.ErrCategorizationRuleNotFound,
+	},
+	FKCategorizationRuleTagTag: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'categorization_rule_tags' table for key 'tag_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because the rule tags are deleted with their tag
+		1452: domain.ErrTagNotFound,
+	},
+
 	// Savings goals constraints
 	FKSavingsGoalAccount: {
 		1451: domain.ErrAccountHasActiveSavingsGoals,
@@ -282,6 +530,40 @@ var ForeignKeyErrorMap = map[ForeignKeyConstraint]map[uint16]error{
 		1451: domain.ErrTagInUse,
 		1452: domain.ErrTagNotFound,
 	},
+
+	// User roles constraints
+	FKUserRolesUser: {
+		1451: domain.ErrUserHasActiveRoles,
+		1452: domain.ErrUserNotFound,
+	},
+	FKUserRolesRole: {
+		1451: domain.ErrRoleInUse,
+		1452: domain.ErrRoleNotFound,
+	},
+
+	// User constraints
+	FKUserHouseholdMember: {
+		1451: domain.ErrMemberLinkedToUser,
+		1452: domain.ErrMemberNotFound,
+	},
+
+	// Invitation constraints
+	FKInvitationHouseholdMember: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'invitations' table for key 'household_member_id'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because invitations are deleted with their member
+		1452: domain.ErrMemberNotFound,
+	},
+	FKInvitationInvitedBy: {
+		1451: &port.InfrastructureError{
+			Type:    "unknown_constraint_error",
+			Message: "depending foreign key violation on 'invitations' table for key 'invited_by'",
+			Cause:   ErrUnhandledConstraint,
+		}, // shouldn't happen because there's no way to delete a user
+		1452: domain.ErrUserNotFound,
+	},
 }
 
 // String returns the string representation of the foreign key constraint
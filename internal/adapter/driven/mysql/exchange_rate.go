@@ -0,0 +1,92 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+type ExchangeRateRepoImpl struct {
+	db *sql.DB
+}
+
+func NewExchangeRateRepo(db *sql.DB) port.ExchangeRateRepo {
+	return &ExchangeRateRepoImpl{db: db}
+}
+
+func (r ExchangeRateRepoImpl) GetRate(
+	ctx context.Context,
+	baseCurrency string,
+	targetCurrency string,
+	date time.Time,
+) (
+	*domain.ExchangeRate,
+	error,
+) {
+	rate, err := r.latestRate(
+		ctx,
+		baseCurrency,
+		targetCurrency,
+		date,
+	)
+	if !errors.Is(
+		err,
+		port.ErrRecordNotFound,
+	) {
+		return rate, err
+	}
+
+	inverse, err := r.latestRate(
+		ctx,
+		targetCurrency,
+		baseCurrency,
+		date,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rateFromInverse := inverse.Inverse()
+
+	return &rateFromInverse, nil
+}
+
+func (r ExchangeRateRepoImpl) latestRate(
+	ctx context.Context,
+	baseCurrency string,
+	targetCurrency string,
+	date time.Time,
+) (
+	*domain.ExchangeRate,
+	error,
+) {
+	query := `SELECT base_currency, target_currency, rate, date
+			  FROM exchange_rates
+			  WHERE base_currency = ?
+				AND target_currency = ?
+				AND date <= ?
+			  ORDER BY date DESC
+			  LIMIT 1`
+
+	var rate domain.ExchangeRate
+	err := r.db.QueryRowContext(
+		ctx,
+		query,
+		baseCurrency,
+		targetCurrency,
+		date,
+	).Scan(
+		&rate.BaseCurrency,
+		&rate.TargetCurrency,
+		&rate.Rate,
+		&rate.Date,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &rate, nil
+}
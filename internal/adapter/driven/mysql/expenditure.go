@@ -5,14 +5,32 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"ghorkov32/proletariat-budget-be/internal/core/domain"
 	"ghorkov32/proletariat-budget-be/internal/core/port"
 )
 
+// expenditureSortColumns whitelists the sort keys accepted by FindExpenditures
+var expenditureSortColumns = map[string]string{
+	"date":        "t.transaction_date",
+	"amount":      "t.amount",
+	"description": "t.description",
+	"category":    "c.name",
+	"createdAt":   "e.created_at",
+}
+
+var expenditureDefaultSort = []domain.SortOrder{
+	{
+		Key:        "createdAt",
+		Descending: true,
+	},
+}
+
 type ExpenditureRepo struct {
 	db       *sql.DB
 	tagsRepo port.TagsRepo
@@ -33,8 +51,9 @@ func (r *ExpenditureRepo) Create(
 	error,
 ) {
 	queryInsert := `insert into expenditures
-						(category_id, declared, planned, transaction_id, created_at)
-					VALUES (?, ?, ?, ?, ?)`
+						(category_id, declared, planned, transaction_id, from_recurrence_pattern_id, payee_id, installment_plan_id,
+						 installment_number, created_at)
+					VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
 	result, errInsert := r.db.ExecContext(
 		ctx,
 		queryInsert,
@@ -42,6 +61,10 @@ func (r *ExpenditureRepo) Create(
 		expenditure.Declared,
 		expenditure.Planned,
 		expenditure.Transaction.ID,
+		expenditure.RecurrencePatternID,
+		expenditure.PayeeID,
+		expenditure.InstallmentPlanID,
+		expenditure.InstallmentNumber,
 		expenditure.Date,
 	)
 	if errInsert != nil {
@@ -65,6 +88,461 @@ func (r *ExpenditureRepo) Create(
 	return lastIDStr, nil
 }
 
+func (r *ExpenditureRepo) CreateBatch(
+	ctx context.Context,
+	expenditures []domain.Expenditure,
+	mode domain.BatchMode,
+) (
+	[]string,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	// The accounts debited by the batch are locked in the order of their IDs, so concurrent batches
+	// sharing accounts wait for each other instead of deadlocking
+	var accountIDs []string
+	for _, expenditure := range expenditures {
+		if isCompleted(expenditure.Transaction) && !slices.Contains(
+			accountIDs,
+			expenditure.Transaction.AccountID,
+		) {
+			accountIDs = append(
+				accountIDs,
+				expenditure.Transaction.AccountID,
+			)
+		}
+	}
+	sort.Strings(accountIDs)
+	accounts := make(map[string]*domain.Account)
+	for _, accountID := range accountIDs {
+		accounts[accountID], err = lockAccountBalance(
+			ctx,
+			tx,
+			accountID,
+			household,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make(
+		[]string,
+		0,
+		len(expenditures),
+	)
+	// Debits are added up so every account is updated once, whatever the batch size
+	debits := make(map[string]float32)
+	for i, expenditure := range expenditures {
+		transaction := expenditure.Transaction
+		if isCompleted(transaction) {
+			// The balance is checked again on the locked account, it may have changed since the batch was prepared
+			account := accounts[transaction.AccountID]
+			if !account.HasSufficientBalance(transaction.Amount) {
+				if mode == domain.BatchModeAllOrNothing {
+					return nil, &domain.BatchItemError{
+						Index: i,
+						Err:   domain.ErrInsufficientBalance,
+					}
+				}
+				ids = append(
+					ids,
+					"",
+				)
+
+				continue
+			}
+			account.DebitBalance(transaction.Amount)
+			transaction.Complete(account.CurrentBalance)
+			debits[transaction.AccountID] += transaction.Amount
+		}
+
+		id, errInsert := r.insertBatchItem(
+			ctx,
+			tx,
+			expenditure,
+		)
+		if errInsert != nil {
+			return nil, errInsert
+		}
+		ids = append(
+			ids,
+			id,
+		)
+	}
+
+	now := time.Now()
+	for _, accountID := range accountIDs {
+		if debits[accountID] == 0 {
+			continue
+		}
+		_, err = tx.ExecContext(
+			ctx,
+			`UPDATE accounts SET current_balance = current_balance - ?, updated_at = ? WHERE id = ?`,
+			debits[accountID],
+			now,
+			accountID,
+		)
+		if err != nil {
+			return nil, translateError(err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return ids, nil
+}
+
+// isCompleted tells whether the transaction is settled at once, rather than left pending for the poster
+func isCompleted(transaction *domain.Transaction) bool {
+	return transaction.Status != nil && *transaction.Status == domain.TransactionStatusCompleted
+}
+
+// insertBatchItem inserts the transaction, the expenditure and its tag links of a batch item
+func (r *ExpenditureRepo) insertBatchItem(
+	ctx context.Context,
+	tx *sql.Tx,
+	expenditure domain.Expenditure,
+) (
+	string,
+	error,
+) {
+	transaction := expenditure.Transaction
+	result, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO transactions
+			(account_id, amount, currency, original_amount, original_currency, exchange_rate, transaction_date, description,
+			 transaction_type, balance_after, status, failure_reason)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		transaction.AccountID,
+		transaction.Amount,
+		transaction.Currency,
+		transaction.OriginalAmount,
+		transaction.OriginalCurrency,
+		transaction.ExchangeRate,
+		transaction.TransactionDate,
+		transaction.Description,
+		transaction.TransactionType,
+		transaction.BalanceAfter,
+		transaction.Status,
+		transaction.FailureReason,
+	)
+	if err != nil {
+		return "", translateError(err)
+	}
+	transactionID, err := result.LastInsertId()
+	if err != nil {
+		return "", translateError(err)
+	}
+
+	result, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO expenditures
+			(category_id, declared, planned, transaction_id, from_recurrence_pattern_id, payee_id, installment_plan_id,
+			 installment_number, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		expenditure.Category.ID,
+		expenditure.Declared,
+		expenditure.Planned,
+		transactionID,
+		expenditure.RecurrencePatternID,
+		expenditure.PayeeID,
+		expenditure.InstallmentPlanID,
+		expenditure.InstallmentNumber,
+		expenditure.Date,
+	)
+	if err != nil {
+		return "", translateError(err)
+	}
+	expenditureID, err := result.LastInsertId()
+	if err != nil {
+		return "", translateError(err)
+	}
+
+	id := strconv.FormatInt(
+		expenditureID,
+		10,
+	)
+	if expenditure.Tags != nil {
+		tagIDs := make(
+			[]string,
+			0,
+			len(*expenditure.Tags),
+		)
+		for _, tag := range *expenditure.Tags {
+			tagIDs = append(
+				tagIDs,
+				tag.ID,
+			)
+		}
+		err = addRecordTags(
+			ctx,
+			tx,
+			"expenditure_tags",
+			"expenditure_id",
+			id,
+			tagIDs,
+		)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return id, nil
+}
+
+func (r *ExpenditureRepo) CreateRefund(
+	ctx context.Context,
+	expenditureID string,
+	transaction domain.Transaction,
+) (
+	*domain.Refund,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	// Locking the expenditure serializes its refunds, so concurrent ones cannot exceed it together
+	var charged float32
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT t.amount
+		FROM expenditures e
+				 INNER JOIN transactions t ON e.transaction_id = t.id
+				 INNER JOIN accounts a ON t.account_id = a.id
+		WHERE e.id = ?
+		  AND a.household_id = ?
+		FOR UPDATE`,
+		expenditureID,
+		household,
+	).Scan(&charged)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	var refunded float32
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT COALESCE(SUM(COALESCE(t.original_amount, t.amount)), 0)
+		FROM expenditure_refunds er
+				 INNER JOIN transactions t ON er.transaction_id = t.id
+		WHERE er.expenditure_id = ?
+		  AND t.status NOT IN (?, ?)`,
+		expenditureID,
+		domain.TransactionStatusFailed,
+		domain.TransactionStatusCancelled,
+	).Scan(&refunded)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	refund := domain.Refund{
+		ExpenditureID: expenditureID,
+		Transaction:   &transaction,
+	}
+	if err = refund.FitsIn(charged - refunded); err != nil {
+		return nil, err
+	}
+
+	// Pending refunds are credited by the poster once due
+	if transaction.Status == nil || *transaction.Status != domain.TransactionStatusPending {
+		account, errLock := lockAccountBalance(
+			ctx,
+			tx,
+			transaction.AccountID,
+			household,
+		)
+		if errLock != nil {
+			return nil, errLock
+		}
+		account.CreditBalance(transaction.Amount)
+		transaction.Complete(account.CurrentBalance)
+
+		_, err = tx.ExecContext(
+			ctx,
+			`UPDATE accounts SET current_balance = current_balance + ?, updated_at = ? WHERE id = ?`,
+			transaction.Amount,
+			time.Now(),
+			transaction.AccountID,
+		)
+		if err != nil {
+			return nil, translateError(err)
+		}
+	}
+
+	result, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO transactions
+			(account_id, amount, currency, original_amount, original_currency, exchange_rate, transaction_date,
+			 description, transaction_type, balance_after, status)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		transaction.AccountID,
+		transaction.Amount,
+		transaction.Currency,
+		transaction.OriginalAmount,
+		transaction.OriginalCurrency,
+		transaction.ExchangeRate,
+		transaction.TransactionDate,
+		transaction.Description,
+		transaction.TransactionType,
+		transaction.BalanceAfter,
+		transaction.Status,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	transactionID, err := result.LastInsertId()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	txID := strconv.FormatInt(
+		transactionID,
+		10,
+	)
+	transaction.ID = &txID
+
+	result, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO expenditure_refunds (expenditure_id, transaction_id) VALUES (?, ?)`,
+		expenditureID,
+		transactionID,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	refundID, err := result.LastInsertId()
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, translateError(err)
+	}
+
+	refund.ID = strconv.FormatInt(
+		refundID,
+		10,
+	)
+	refund.CreatedAt = time.Now()
+
+	return &refund, nil
+}
+
+func (r *ExpenditureRepo) ListRefunds(
+	ctx context.Context,
+	expenditureID string,
+) (
+	[]domain.Refund,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT er.id,
+					 er.expenditure_id,
+					 er.created_at,
+					 t.id,
+					 t.account_id,
+					 t.amount,
+					 t.currency,
+					 t.original_amount,
+					 t.original_currency,
+					 t.exchange_rate,
+					 t.transaction_date,
+					 t.description,
+					 t.transaction_type,
+					 t.balance_after,
+					 t.status,
+					 t.created_at
+			  FROM expenditure_refunds er
+					   INNER JOIN transactions t ON er.transaction_id = t.id
+					   INNER JOIN accounts a ON t.account_id = a.id
+			  WHERE er.expenditure_id = ?
+				AND a.household_id = ?
+			  ORDER BY t.transaction_date, er.id`
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		expenditureID,
+		household,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	refunds := make(
+		[]domain.Refund,
+		0,
+	)
+	for rows.Next() {
+		var refund domain.Refund
+		var transaction domain.Transaction
+		err = rows.Scan(
+			&refund.ID,
+			&refund.ExpenditureID,
+			&refund.CreatedAt,
+			&transaction.ID,
+			&transaction.AccountID,
+			&transaction.Amount,
+			&transaction.Currency,
+			&transaction.OriginalAmount,
+			&transaction.OriginalCurrency,
+			&transaction.ExchangeRate,
+			&transaction.TransactionDate,
+			&transaction.Description,
+			&transaction.TransactionType,
+			&transaction.BalanceAfter,
+			&transaction.Status,
+			&transaction.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to scan refund: %w",
+				err,
+			)
+		}
+		refund.Transaction = &transaction
+		refunds = append(
+			refunds,
+			refund,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return refunds, nil
+}
+
 func (r *ExpenditureRepo) GetByID(
 	ctx context.Context,
 	id string,
@@ -72,15 +550,29 @@ func (r *ExpenditureRepo) GetByID(
 	*domain.Expenditure,
 	error,
 ) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	querySelect := `select e.id,
 						   e.declared,
 						   e.planned,
+						   e.from_recurrence_pattern_id,
+						   e.payee_id,
+						   e.installment_plan_id,
+						   e.installment_number,
 						   e.created_at,
 						   t.account_id,
 						   t.amount,
 						   t.currency,
+						   t.original_amount,
+						   t.original_currency,
+						   t.exchange_rate,
 						   t.transaction_date,
 						   t.description,
+						   t.status,
+						   t.failure_reason,
 						   c.id,
 						   c.name,
 						   c.description,
@@ -93,28 +585,40 @@ func (r *ExpenditureRepo) GetByID(
 							 inner join transactions t ON e.transaction_id = t.id
 							 left join expenditure_tags et on e.id = et.expenditure_id
 					where e.id =?
-					group by e.id, e.declared, e.planned, e.transaction_id, e.created_at, t.account_id, t.amount, t.currency,
-							 t.transaction_date, t.description, c.id, c.name, c.description, c.color, c.background_color, c.active,
+					  and c.household_id =?
+					group by e.id, e.declared, e.planned, e.from_recurrence_pattern_id, e.payee_id, e.installment_plan_id, e.installment_number, e.transaction_id, e.created_at, t.account_id, t.amount, t.currency,
+							 t.original_amount, t.original_currency, t.exchange_rate,
+							 t.transaction_date, t.description, t.status, t.failure_reason, c.id, c.name, c.description, c.color, c.background_color, c.active,
 							 c.category_type`
 
 	var expenditure domain.Expenditure
 	transaction := domain.Transaction{}
 	category := domain.Category{}
 	var tagsList *string
-	err := r.db.QueryRowContext(
+	err = r.db.QueryRowContext(
 		ctx,
 		querySelect,
 		id,
+		household,
 	).Scan(
 		&expenditure.ID,
 		&expenditure.Declared,
 		&expenditure.Planned,
+		&expenditure.RecurrencePatternID,
+		&expenditure.PayeeID,
+		&expenditure.InstallmentPlanID,
+		&expenditure.InstallmentNumber,
 		&transaction.CreatedAt,
 		&transaction.AccountID,
 		&transaction.Amount,
 		&transaction.Currency,
+		&transaction.OriginalAmount,
+		&transaction.OriginalCurrency,
+		&transaction.ExchangeRate,
 		&expenditure.Date,
 		&transaction.Description,
+		&transaction.Status,
+		&transaction.FailureReason,
 		&category.ID,
 		&category.Name,
 		&category.Description,
@@ -186,12 +690,21 @@ func (r *ExpenditureRepo) FindExpenditures(
 	baseSelectQuery := `select e.id,
                            e.declared,
                            e.planned,
+                           e.from_recurrence_pattern_id,
+                           e.payee_id,
+                           e.installment_plan_id,
+                           e.installment_number,
                            e.created_at,
                            t.account_id,
                            t.amount,
                            t.currency,
+                           t.original_amount,
+                           t.original_currency,
+                           t.exchange_rate,
                            t.transaction_date,
                            t.description,
+                           t.status,
+                           t.failure_reason,
                            c.id,
                            c.name,
                            c.description,
@@ -204,13 +717,30 @@ func (r *ExpenditureRepo) FindExpenditures(
                              inner join transactions t ON e.transaction_id = t.id
                              left join expenditure_tags et on e.id = et.expenditure_id`
 
-	baseCountQuery := `select COUNT(*)
+	baseCountQuery := `select COUNT(DISTINCT e.id)
                         from expenditures e
                              inner join categories c ON e.category_id = c.id
                              inner join transactions t ON e.transaction_id = t.id
                              left join expenditure_tags et on e.id = et.expenditure_id`
 
-	whereClause, args := r.buildWhereClause(queryParams)
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	whereClause, args := r.buildWhereClause(
+		household,
+		queryParams,
+	)
+
+	orderBy, err := buildOrderByClause(
+		queryParams.Sort,
+		expenditureSortColumns,
+		expenditureDefaultSort,
+		"e.id",
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	count, err := r.getExpendituresCount(
 		ctx,
@@ -226,6 +756,7 @@ func (r *ExpenditureRepo) FindExpenditures(
 		ctx,
 		baseSelectQuery,
 		whereClause,
+		orderBy,
 		args,
 		queryParams,
 	)
@@ -247,22 +778,30 @@ func (r *ExpenditureRepo) FindExpenditures(
 			Total:  count,
 			Limit:  *queryParams.Limit,
 			Offset: *queryParams.Offset,
+			Sort: appliedSortExpression(
+				queryParams.Sort,
+				expenditureDefaultSort,
+			),
 		},
 		Expenditures: expenditures,
 	}, nil
 }
 
-func (r *ExpenditureRepo) buildWhereClause(queryParams domain.ExpenditureListParams) (
+func (r *ExpenditureRepo) buildWhereClause(
+	household string,
+	queryParams domain.ExpenditureListParams,
+) (
 	whereCondition string,
 	arguments []any,
 ) {
-	var args []any
-	var whereConditions []string
+	args := []any{household}
+	whereConditions := []string{"c.household_id = ?"}
 
+	// Filtering by a category includes its subcategories
 	if queryParams.CategoryID != nil {
 		whereConditions = append(
 			whereConditions,
-			"e.category_id = ?",
+			"e.category_id IN ("+categoryTreeQuery+")",
 		)
 		args = append(
 			args,
@@ -334,6 +873,17 @@ func (r *ExpenditureRepo) buildWhereClause(queryParams domain.ExpenditureListPar
 		)
 	}
 
+	if queryParams.Status != nil {
+		whereConditions = append(
+			whereConditions,
+			"t.status = ?",
+		)
+		args = append(
+			args,
+			*queryParams.Status,
+		)
+	}
+
 	if queryParams.AccountID != nil {
 		whereConditions = append(
 			whereConditions,
@@ -345,8 +895,38 @@ func (r *ExpenditureRepo) buildWhereClause(queryParams domain.ExpenditureListPar
 		)
 	}
 
-	if len(whereConditions) == 0 {
-		return "", args
+	if queryParams.RecurrencePatternID != nil {
+		whereConditions = append(
+			whereConditions,
+			"e.from_recurrence_pattern_id = ?",
+		)
+		args = append(
+			args,
+			*queryParams.RecurrencePatternID,
+		)
+	}
+
+	if queryParams.PayeeID != nil {
+		whereConditions = append(
+			whereConditions,
+			"e.payee_id = ?",
+		)
+		args = append(
+			args,
+			*queryParams.PayeeID,
+		)
+	}
+
+	if queryParams.Amount != nil {
+		// Amounts are stored as decimals, so compare to the cent instead of the float value
+		whereConditions = append(
+			whereConditions,
+			"ABS(t.amount - ?) < 0.005",
+		)
+		args = append(
+			args,
+			*queryParams.Amount,
+		)
 	}
 
 	return " WHERE " + strings.Join(
@@ -470,7 +1050,7 @@ func (r *ExpenditureRepo) getExpendituresCount(
 
 func (r *ExpenditureRepo) getExpenditures(
 	ctx context.Context,
-	baseQuery, whereClause string,
+	baseQuery, whereClause, orderBy string,
 	args []any,
 	queryParams domain.ExpenditureListParams,
 ) (
@@ -479,13 +1059,14 @@ func (r *ExpenditureRepo) getExpenditures(
 	error,
 ) {
 	query := baseQuery + whereClause +
-		` GROUP BY e.id, e.declared, e.planned, e.transaction_id, e.created_at, t.account_id, t.amount, t.currency,
-          t.transaction_date, t.description, c.id, c.name, c.description, c.color, c.background_color, c.active, c.category_type` +
-		" ORDER BY created_at DESC" +
+		` GROUP BY e.id, e.declared, e.planned, e.from_recurrence_pattern_id, e.payee_id, e.installment_plan_id, e.installment_number, e.transaction_id, e.created_at, t.account_id, t.amount, t.currency,
+          t.original_amount, t.original_currency, t.exchange_rate,
+          t.transaction_date, t.description, t.status, t.failure_reason, c.id, c.name, c.description, c.color, c.background_color, c.active, c.category_type` +
+		orderBy +
 		fmt.Sprintf(
 			" LIMIT %d OFFSET %d",
-			queryParams.Limit,
-			queryParams.Offset,
+			*queryParams.Limit,
+			*queryParams.Offset,
 		)
 
 	stmt, err := r.db.PrepareContext(
@@ -548,12 +1129,21 @@ func (r *ExpenditureRepo) scanExpenditureRow(rows *sql.Rows) (
 		&expenditure.ID,
 		&expenditure.Declared,
 		&expenditure.Planned,
+		&expenditure.RecurrencePatternID,
+		&expenditure.PayeeID,
+		&expenditure.InstallmentPlanID,
+		&expenditure.InstallmentNumber,
 		&transaction.CreatedAt,
 		&transaction.AccountID,
 		&transaction.Amount,
 		&transaction.Currency,
+		&transaction.OriginalAmount,
+		&transaction.OriginalCurrency,
+		&transaction.ExchangeRate,
 		&expenditure.Date,
 		&transaction.Description,
+		&transaction.Status,
+		&transaction.FailureReason,
 		&category.ID,
 		&category.Name,
 		&category.Description,
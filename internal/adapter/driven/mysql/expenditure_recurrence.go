@@ -0,0 +1,362 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+const expenditureRecurrencePatternColumns = `id,
+					   category_id,
+					   account_id,
+					   amount,
+					   amount_type,
+					   description,
+					   frequency,
+					   interval_value,
+					   start_date,
+					   end_date,
+					   next_due_date,
+					   auto_post,
+					   active,
+					   created_at,
+					   updated_at`
+
+type ExpenditureRecurrencePatternRepoImpl struct {
+	db *sql.DB
+}
+
+func NewExpenditureRecurrencePatternRepo(db *sql.DB) port.ExpenditureRecurrencePatternRepo {
+	return &ExpenditureRecurrencePatternRepoImpl{db: db}
+}
+
+func (r ExpenditureRecurrencePatternRepoImpl) Create(
+	ctx context.Context,
+	pattern domain.ExpenditureRecurrencePattern,
+) (
+	string,
+	error,
+) {
+	queryInsert := `INSERT INTO expenditure_recurrence_patterns
+						(category_id, account_id, amount, amount_type, description, frequency, interval_value,
+						 start_date, end_date, next_due_date, auto_post, active)
+					VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	result, errInsert := r.db.ExecContext(
+		ctx,
+		queryInsert,
+		pattern.CategoryID,
+		pattern.AccountID,
+		pattern.Amount,
+		pattern.AmountType,
+		pattern.Description,
+		pattern.Frequency,
+		pattern.Interval,
+		pattern.StartDate,
+		pattern.EndDate,
+		pattern.NextDueDate,
+		pattern.AutoPost,
+		pattern.Active,
+	)
+	if errInsert != nil {
+		return "", translateError(errInsert)
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return "", fmt.Errorf(
+			"failed to get last insert ID: %w",
+			err,
+		)
+	}
+
+	return strconv.FormatInt(
+		lastID,
+		10,
+	), nil
+}
+
+func (r ExpenditureRecurrencePatternRepoImpl) GetByID(
+	ctx context.Context,
+	id string,
+) (
+	*domain.ExpenditureRecurrencePattern,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + expenditureRecurrencePatternColumns + `
+					FROM expenditure_recurrence_patterns WHERE id=? AND account_id IN (` + householdAccountsQuery + `)`
+
+	pattern, err := r.scanPattern(
+		r.db.QueryRowContext(
+			ctx,
+			query,
+			id,
+			household,
+		),
+	)
+	if errors.Is(
+		err,
+		sql.ErrNoRows,
+	) {
+		return nil, port.ErrRecordNotFound
+	} else if err != nil {
+		return nil, translateError(err)
+	}
+
+	return pattern, nil
+}
+
+func (r ExpenditureRecurrencePatternRepoImpl) List(
+	ctx context.Context,
+	params domain.ExpenditureRecurrencePatternListParams,
+) (
+	[]domain.ExpenditureRecurrencePattern,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	whereClause := []string{"account_id IN (" + householdAccountsQuery + ")"}
+	args := []any{household}
+
+	if params.Active != nil {
+		whereClause = append(
+			whereClause,
+			"active = ?",
+		)
+		args = append(
+			args,
+			*params.Active,
+		)
+	}
+	if params.AccountID != nil {
+		whereClause = append(
+			whereClause,
+			"account_id = ?",
+		)
+		args = append(
+			args,
+			*params.AccountID,
+		)
+	}
+	if params.AutoPost != nil {
+		whereClause = append(
+			whereClause,
+			"auto_post = ?",
+		)
+		args = append(
+			args,
+			*params.AutoPost,
+		)
+	}
+	if params.DueBefore != nil {
+		whereClause = append(
+			whereClause,
+			"next_due_date <= ?",
+		)
+		args = append(
+			args,
+			*params.DueBefore,
+		)
+	}
+
+	query := `SELECT ` + expenditureRecurrencePatternColumns + `
+					FROM expenditure_recurrence_patterns WHERE ` + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	)
+	query += " ORDER BY next_due_date, id"
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	patterns := make(
+		[]domain.ExpenditureRecurrencePattern,
+		0,
+	)
+	for rows.Next() {
+		pattern, errScan := r.scanPattern(rows)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		patterns = append(
+			patterns,
+			*pattern,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return patterns, nil
+}
+
+func (r ExpenditureRecurrencePatternRepoImpl) Update(
+	ctx context.Context,
+	pattern domain.ExpenditureRecurrencePattern,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	queryUpdate := `UPDATE expenditure_recurrence_patterns
+					SET category_id=?, account_id=?, amount=?, amount_type=?, description=?, frequency=?,
+						interval_value=?, start_date=?, end_date=?, next_due_date=?, auto_post=?, active=?
+					WHERE id=? AND account_id IN (` + householdAccountsQuery + `)`
+
+	result, err := r.db.ExecContext(
+		ctx,
+		queryUpdate,
+		pattern.CategoryID,
+		pattern.AccountID,
+		pattern.Amount,
+		pattern.AmountType,
+		pattern.Description,
+		pattern.Frequency,
+		pattern.Interval,
+		pattern.StartDate,
+		pattern.EndDate,
+		pattern.NextDueDate,
+		pattern.AutoPost,
+		pattern.Active,
+		pattern.ID,
+		household,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, errRowsAffected := result.RowsAffected()
+	if errRowsAffected != nil {
+		return translateError(errRowsAffected)
+	}
+	if rowsAffected == 0 {
+		// MySQL reports 0 affected rows when nothing changed, so make sure the pattern exists
+		_, errGet := r.GetByID(
+			ctx,
+			pattern.ID,
+		)
+
+		return errGet
+	}
+
+	return nil
+}
+
+func (r ExpenditureRecurrencePatternRepoImpl) AdvanceNextDueDate(
+	ctx context.Context,
+	pattern domain.ExpenditureRecurrencePattern,
+	currentDueDate time.Time,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	queryUpdate := `UPDATE expenditure_recurrence_patterns
+					SET next_due_date=?, active=?
+					WHERE id=? AND next_due_date=? AND account_id IN (` + householdAccountsQuery + `)`
+
+	result, err := r.db.ExecContext(
+		ctx,
+		queryUpdate,
+		pattern.NextDueDate,
+		pattern.Active,
+		pattern.ID,
+		currentDueDate,
+		household,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, errRowsAffected := result.RowsAffected()
+	if errRowsAffected != nil {
+		return translateError(errRowsAffected)
+	}
+	if rowsAffected == 0 {
+		return port.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r ExpenditureRecurrencePatternRepoImpl) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	result, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM expenditure_recurrence_patterns WHERE id=? AND account_id IN (`+householdAccountsQuery+`)`,
+		id,
+		household,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, errRowsAffected := result.RowsAffected()
+	if errRowsAffected != nil {
+		return translateError(errRowsAffected)
+	}
+	if rowsAffected == 0 {
+		return port.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func (r ExpenditureRecurrencePatternRepoImpl) scanPattern(row rowScanner) (
+	*domain.ExpenditureRecurrencePattern,
+	error,
+) {
+	var pattern domain.ExpenditureRecurrencePattern
+	var endDate sql.NullTime
+
+	err := row.Scan(
+		&pattern.ID,
+		&pattern.CategoryID,
+		&pattern.AccountID,
+		&pattern.Amount,
+		&pattern.AmountType,
+		&pattern.Description,
+		&pattern.Frequency,
+		&pattern.Interval,
+		&pattern.StartDate,
+		&endDate,
+		&pattern.NextDueDate,
+		&pattern.AutoPost,
+		&pattern.Active,
+		&pattern.CreatedAt,
+		&pattern.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if endDate.Valid {
+		pattern.EndDate = &endDate.Time
+	}
+
+	return &pattern, nil
+}
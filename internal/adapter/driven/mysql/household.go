@@ -0,0 +1,275 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+// Subqueries selecting the ids of the rows of a household, for the tables that reach their household
+// through an account or a category
+const (
+	householdAccountsQuery   = `SELECT id FROM accounts WHERE household_id = ?`
+	householdCategoriesQuery = `SELECT id FROM categories WHERE household_id = ?`
+	householdBudgetsQuery    = `SELECT hb.id
+                                  FROM budgets hb
+                                           INNER JOIN categories hbc ON hbc.id = hb.category_id
+                                  WHERE hbc.household_id = ?`
+	householdSavingsGoalsQuery = `SELECT sg.id
+                                  FROM savings_goals sg
+                                           INNER JOIN accounts hsa ON hsa.id = sg.account_id
+                                  WHERE hsa.household_id = ?`
+)
+
+// householdID returns the household of the context every query is scoped to, a context without
+// household is refused rather than reading or writing the data of every household
+func householdID(ctx context.Context) (
+	string,
+	error,
+) {
+	id, ok := domain.HouseholdIDFromContext(ctx)
+	if !ok {
+		return "", domain.ErrHouseholdRequired
+	}
+
+	return id, nil
+}
+
+type HouseholdRepoImpl struct {
+	db *sql.DB
+}
+
+func NewHouseholdRepo(db *sql.DB) port.HouseholdRepo {
+	return &HouseholdRepoImpl{db: db}
+}
+
+func (r *HouseholdRepoImpl) Create(
+	ctx context.Context,
+	household domain.Household,
+	founder domain.HouseholdMember,
+	userID string,
+) (
+	string,
+	error,
+) {
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return "", translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	result, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO households (name) VALUES (?)`,
+		household.Name,
+	)
+	if err != nil {
+		return "", translateError(err)
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return "", fmt.Errorf(
+			"failed to get last insert ID: %w",
+			err,
+		)
+	}
+	id := strconv.FormatInt(
+		lastID,
+		10,
+	)
+
+	err = joinAsAdmin(
+		ctx,
+		tx,
+		id,
+		founder,
+		userID,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", translateError(err)
+	}
+
+	return id, nil
+}
+
+func (r *HouseholdRepoImpl) AddAdmin(
+	ctx context.Context,
+	id string,
+	member domain.HouseholdMember,
+	userID string,
+) error {
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	err = joinAsAdmin(
+		ctx,
+		tx,
+		id,
+		member,
+		userID,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
+// joinAsAdmin creates the member in the household and links the user to it with the admin role. Only
+// a user not linked yet is linked, so concurrent joins of the same user fail here.
+func joinAsAdmin(
+	ctx context.Context,
+	tx *sql.Tx,
+	household string,
+	member domain.HouseholdMember,
+	userID string,
+) error {
+	result, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO household_members (household_id, name, surname, nickname, role, active, created_at, updated_at) VALUES (?,?,?,?,?,true, NOW(), NOW())`,
+		household,
+		member.FirstName,
+		member.LastName,
+		member.Nickname,
+		member.Role,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	memberID, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf(
+			"failed to get last insert ID: %w",
+			err,
+		)
+	}
+
+	result, err = tx.ExecContext(
+		ctx,
+		`UPDATE users SET household_member_id = ? WHERE id = ? AND household_member_id IS NULL`,
+		memberID,
+		userID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(
+			"failed to link user to member: %w",
+			err,
+		)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrUserAlreadyLinked
+	}
+
+	result, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO user_roles (user_id, role_id) SELECT ?, id FROM roles WHERE name = ?`,
+		userID,
+		domain.RoleAdmin,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, err = result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(
+			"failed to grant user role: %w",
+			err,
+		)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrRoleNotFound
+	}
+
+	return nil
+}
+
+func (r *HouseholdRepoImpl) GetByID(
+	ctx context.Context,
+	id string,
+) (
+	*domain.Household,
+	error,
+) {
+	var household domain.Household
+	err := r.db.QueryRowContext(
+		ctx,
+		`SELECT id, name, created_at FROM households WHERE id = ?`,
+		id,
+	).Scan(
+		&household.ID,
+		&household.Name,
+		&household.CreatedAt,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &household, nil
+}
+
+func (r *HouseholdRepoImpl) List(ctx context.Context) (
+	[]domain.Household,
+	error,
+) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, name, created_at FROM households ORDER BY id`,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	households := make(
+		[]domain.Household,
+		0,
+	)
+	for rows.Next() {
+		var household domain.Household
+		err = rows.Scan(
+			&household.ID,
+			&household.Name,
+			&household.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to scan household: %w",
+				err,
+			)
+		}
+		households = append(
+			households,
+			household,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return households, nil
+}
@@ -11,6 +11,21 @@ import (
 	"ghorkov32/proletariat-budget-be/internal/core/port"
 )
 
+// householdMemberSortColumns whitelists the sort keys accepted by List
+var householdMemberSortColumns = map[string]string{
+	"firstName": "name",
+	"lastName":  "surname",
+	"nickname":  "nickname",
+	"role":      "role",
+	"createdAt": "created_at",
+}
+
+var householdMemberDefaultSort = []domain.SortOrder{
+	{
+		Key: "createdAt",
+	},
+}
+
 type HouseholdMemberRepository struct {
 	db *sql.DB
 }
@@ -26,10 +41,15 @@ func (h HouseholdMemberRepository) Create(
 	string,
 	error,
 ) {
-	query := `INSERT INTO household_members (name, surname, nickname, role, active, created_at, updated_at) VALUES (?,?,?,?,true, now(), NOW())`
+	household, err := householdID(ctx)
+	if err != nil {
+		return "", err
+	}
+	query := `INSERT INTO household_members (household_id, name, surname, nickname, role, active, created_at, updated_at) VALUES (?,?,?,?,?,true, now(), NOW())`
 	result, err := h.db.ExecContext(
 		ctx,
 		query,
+		household,
 		householdMember.FirstName,
 		householdMember.LastName,
 		householdMember.Nickname,
@@ -57,7 +77,11 @@ func (h HouseholdMemberRepository) Update(
 	id string,
 	householdMember domain.HouseholdMember,
 ) error {
-	query := `UPDATE household_members SET name =?, surname =?, nickname =?, role =?, updated_at = NOW() WHERE id =?`
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE household_members SET name =?, surname =?, nickname =?, role =?, updated_at = NOW() WHERE id =? AND household_id = ?`
 	result, err := h.db.ExecContext(
 		ctx,
 		query,
@@ -66,6 +90,7 @@ func (h HouseholdMemberRepository) Update(
 		householdMember.Nickname,
 		householdMember.Role,
 		id,
+		household,
 	)
 	if err != nil {
 		return translateError(err)
@@ -88,11 +113,16 @@ func (h HouseholdMemberRepository) Delete(
 	ctx context.Context,
 	id string,
 ) error {
-	query := `DELETE FROM household_members WHERE id =?`
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	query := `DELETE FROM household_members WHERE id =? AND household_id = ?`
 	result, err := h.db.ExecContext(
 		ctx,
 		query,
 		id,
+		household,
 	)
 	if err != nil {
 		return translateError(err)
@@ -115,11 +145,16 @@ func (h HouseholdMemberRepository) Deactivate(
 	ctx context.Context,
 	id string,
 ) error {
-	query := `UPDATE household_members SET active = false, updated_at = NOW() WHERE id =?`
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE household_members SET active = false, updated_at = NOW() WHERE id =? AND household_id = ?`
 	result, err := h.db.ExecContext(
 		ctx,
 		query,
 		id,
+		household,
 	)
 	if err != nil {
 		return translateError(err)
@@ -142,11 +177,16 @@ func (h HouseholdMemberRepository) Activate(
 	ctx context.Context,
 	id string,
 ) error {
-	query := `UPDATE household_members SET active = true, updated_at = NOW() WHERE id =?`
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE household_members SET active = true, updated_at = NOW() WHERE id =? AND household_id = ?`
 	result, err := h.db.ExecContext(
 		ctx,
 		query,
 		id,
+		household,
 	)
 	if err != nil {
 		return translateError(err)
@@ -172,14 +212,19 @@ func (h HouseholdMemberRepository) GetByID(
 	*domain.HouseholdMember,
 	error,
 ) {
-	query := `SELECT id, name, surname, nickname, role, active, created_at, updated_at FROM household_members WHERE id =?`
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT id, name, surname, nickname, role, active, created_at, updated_at FROM household_members WHERE id =? AND household_id = ?`
 	var householdMember domain.HouseholdMember
 	row := h.db.QueryRowContext(
 		ctx,
 		query,
 		id,
+		household,
 	)
-	err := row.Scan(
+	err = row.Scan(
 		&householdMember.ID,
 		&householdMember.FirstName,
 		&householdMember.LastName,
@@ -203,12 +248,17 @@ func (h HouseholdMemberRepository) CanDelete(
 	bool,
 	error,
 ) {
-	query := `SELECT COUNT(*) FROM accounts WHERE owner = ? AND active = true`
+	household, err := householdID(ctx)
+	if err != nil {
+		return false, err
+	}
+	query := `SELECT COUNT(*) FROM accounts WHERE owner = ? AND active = true AND household_id = ?`
 	var count int
-	err := h.db.QueryRowContext(
+	err = h.db.QueryRowContext(
 		ctx,
 		query,
 		id,
+		household,
 	).Scan(&count)
 	if err != nil {
 		return false, translateError(err)
@@ -224,16 +274,14 @@ func (h HouseholdMemberRepository) List(
 	*domain.HouseholdMemberList,
 	error,
 ) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	query := `SELECT id, name, surname, nickname, role, active, created_at, updated_at FROM household_members`
 
-	whereClause := make(
-		[]string,
-		0,
-	)
-	args := make(
-		[]any,
-		0,
-	)
+	whereClause := []string{"household_id = ?"}
+	args := []any{household}
 	if params.Active != nil {
 		whereClause = append(
 			whereClause,
@@ -254,12 +302,20 @@ func (h HouseholdMemberRepository) List(
 			*params.Role,
 		)
 	}
-	if len(whereClause) > 0 {
-		query += " WHERE " + strings.Join(
-			whereClause,
-			AND_CLAUSE,
-		)
+	query += " WHERE " + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	)
+	orderBy, err := buildOrderByClause(
+		params.Sort,
+		householdMemberSortColumns,
+		householdMemberDefaultSort,
+		"id",
+	)
+	if err != nil {
+		return nil, err
 	}
+	query += orderBy
 	rows, err := h.db.QueryContext(
 		ctx,
 		query,
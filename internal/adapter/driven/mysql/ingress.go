@@ -81,8 +81,13 @@ func (i IngressRepo) UpdateRecurrencePattern(
 	id string,
 	recurrencePattern openapi.RecurrencePattern,
 ) error {
-	queryUpdate := `UPDATE ingress_recurrence_patterns SET frequency=?, interval_value=?, amount=?, to_account_id=?, description=?, end_date=? WHERE id=?`
-	_, err := i.db.ExecContext(
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	queryUpdate := `UPDATE ingress_recurrence_patterns SET frequency=?, interval_value=?, amount=?, to_account_id=?, description=?, end_date=?
+                    WHERE id=? AND to_account_id IN (` + householdAccountsQuery + `)`
+	_, err = i.db.ExecContext(
 		ctx,
 		queryUpdate,
 		recurrencePattern.Frequency,
@@ -92,6 +97,7 @@ func (i IngressRepo) UpdateRecurrencePattern(
 		recurrencePattern.Description,
 		recurrencePattern.EndDate,
 		id,
+		household,
 	)
 	if err != nil {
 		return fmt.Errorf(
@@ -107,11 +113,16 @@ func (i IngressRepo) DeleteRecurrencePattern(
 	ctx context.Context,
 	id string,
 ) error {
-	queryDelete := `DELETE FROM ingress_recurrence_patterns WHERE id=?`
-	_, err := i.db.ExecContext(
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	queryDelete := `DELETE FROM ingress_recurrence_patterns WHERE id=? AND to_account_id IN (` + householdAccountsQuery + `)`
+	_, err = i.db.ExecContext(
 		ctx,
 		queryDelete,
 		id,
+		household,
 	)
 	if err != nil {
 		return fmt.Errorf(
@@ -130,19 +141,24 @@ func (i IngressRepo) GetRecurrencePattern(
 	*openapi.RecurrencePattern,
 	error,
 ) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	query := `SELECT id, 
 					 frequency,
 					 interval_value,
 					 amount,
 					 to_account_id,
 					 description,
-						 end_date FROM ingress_recurrence_patterns WHERE id=?`
+						 end_date FROM ingress_recurrence_patterns WHERE id=? AND to_account_id IN (` + householdAccountsQuery + `)`
 
 	var recurrencePattern openapi.RecurrencePattern
-	err := i.db.QueryRowContext(
+	err = i.db.QueryRowContext(
 		ctx,
 		query,
 		id,
+		household,
 	).Scan(
 		&recurrencePattern.Id,
 		&recurrencePattern.Frequency,
@@ -168,13 +184,14 @@ func (i IngressRepo) Create(
 	error,
 ) {
 	queryInsert := `insert into ingresses
-						(category_id, source, from_recurrency_pattern_id, transaction_id, created_at) 
-					VALUES (?,?,?,?,now())`
+						(category_id, source, payee_id, from_recurrency_pattern_id, transaction_id, created_at) 
+					VALUES (?,?,?,?,?,now())`
 	result, errInsert := i.db.ExecContext(
 		ctx,
 		queryInsert,
 		ingress.Category,
 		ingress.Source,
+		ingress.PayeeId,
 		ingress.RecurrencePattern.Id,
 		transactionID,
 		ingress.CreatedAt,
@@ -209,8 +226,13 @@ func (i IngressRepo) GetByID(
 	*openapi.Ingress,
 	error,
 ) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	query := `select i.id,
 					   i.source,
+					   i.payee_id,
 					   i.created_at,
 					   t.amount,
 					   t.currency,
@@ -235,8 +257,10 @@ func (i IngressRepo) GetByID(
 						 left join proletariat_budget.ingress_recurrence_patterns irp on i.from_recurrency_pattern_id = irp.id
 						 left join proletariat_budget.ingress_tags it ON i.id = it.ingress_id
 				WHERE i.id = ?
+				  AND c.household_id = ?
 				GROUP BY i.id,
 						 i.source,
+						 i.payee_id,
 						 i.created_at,
 						 t.amount,
 						 t.currency,
@@ -257,13 +281,15 @@ func (i IngressRepo) GetByID(
 	var ingress openapi.Ingress
 	ingressRecurrencePattern := openapi.RecurrencePattern{}
 	var tags string
-	err := i.db.QueryRowContext(
+	err = i.db.QueryRowContext(
 		ctx,
 		query,
 		id,
+		household,
 	).Scan(
 		&ingress.Id,
 		&ingress.Source,
+		&ingress.PayeeId,
 		&ingress.CreatedAt,
 		&ingress.Amount,
 		&ingress.Currency,
@@ -303,6 +329,7 @@ func (i IngressRepo) List(
 ) {
 	querySelect := `select i.id,
 						   i.source,
+						   i.payee_id,
 						   i.created_at,
 						   t.amount,
 						   t.currency,
@@ -328,6 +355,7 @@ func (i IngressRepo) List(
 							 left join proletariat_budget.ingress_tags it ON i.id = it.ingress_id
 					GROUP BY i.id,
 							 i.source,
+							 i.payee_id,
 							 i.created_at,
 							 t.amount,
 							 t.currency,
@@ -353,14 +381,12 @@ func (i IngressRepo) List(
 					left join proletariat_budget.ingress_recurrence_patterns irp on i.from_recurrency_pattern_id = irp.id
 					left join proletariat_budget.ingress_tags it ON i.id = it.ingress_id`
 
-	whereClause := make(
-		[]string,
-		0,
-	)
-	args := make(
-		[]any,
-		0,
-	)
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	whereClause := []string{"c.household_id =?"}
+	args := []any{household}
 
 	if params.Category != nil {
 		whereClause = append(
@@ -465,10 +491,8 @@ func (i IngressRepo) List(
 			*params.IsRecurring,
 		)
 	}
-	if len(whereClause) > 0 {
-		querySelect += " WHERE "
-		queryCount += " WHERE "
-	}
+	querySelect += " WHERE "
+	queryCount += " WHERE "
 	for i, clause := range whereClause {
 		if i > 0 {
 			querySelect += AND_CLAUSE
@@ -489,7 +513,7 @@ func (i IngressRepo) List(
 	var ingresses []openapi.Ingress
 	var count int
 
-	err := i.db.QueryRowContext(
+	err = i.db.QueryRowContext(
 		ctx,
 		queryCount,
 		countArgs...,
@@ -520,6 +544,7 @@ func (i IngressRepo) List(
 		errScan := rows.Scan(
 			&ingress.Id,
 			&ingress.Source,
+			&ingress.PayeeId,
 			&ingress.CreatedAt,
 			&ingress.Amount,
 			&ingress.Currency,
@@ -0,0 +1,473 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+const installmentPlanColumns = `id,
+					   account_id,
+					   category_id,
+					   description,
+					   total_amount,
+					   interest_rate,
+					   installments,
+					   first_charge_date,
+					   created_at`
+
+type InstallmentPlanRepoImpl struct {
+	db *sql.DB
+}
+
+func NewInstallmentPlanRepo(db *sql.DB) port.InstallmentPlanRepo {
+	return &InstallmentPlanRepoImpl{db: db}
+}
+
+func (r InstallmentPlanRepoImpl) Create(
+	ctx context.Context,
+	plan domain.InstallmentPlan,
+) (
+	string,
+	error,
+) {
+	queryInsert := `INSERT INTO installment_plans
+						(account_id, category_id, description, total_amount, interest_rate, installments, first_charge_date)
+					VALUES (?, ?, ?, ?, ?, ?, ?)`
+	result, errInsert := r.db.ExecContext(
+		ctx,
+		queryInsert,
+		plan.AccountID,
+		plan.CategoryID,
+		plan.Description,
+		plan.TotalAmount,
+		plan.InterestRate,
+		plan.Installments,
+		plan.FirstChargeDate,
+	)
+	if errInsert != nil {
+		return "", translateError(errInsert)
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return "", fmt.Errorf(
+			"failed to get last insert ID: %w",
+			err,
+		)
+	}
+
+	return strconv.FormatInt(
+		lastID,
+		10,
+	), nil
+}
+
+func (r InstallmentPlanRepoImpl) GetByID(
+	ctx context.Context,
+	id string,
+) (
+	*domain.InstallmentPlan,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT ` + installmentPlanColumns + `
+					FROM installment_plans WHERE id=? AND account_id IN (` + householdAccountsQuery + `)`
+
+	plan, err := r.scanPlan(
+		r.db.QueryRowContext(
+			ctx,
+			query,
+			id,
+			household,
+		),
+	)
+	if errors.Is(
+		err,
+		sql.ErrNoRows,
+	) {
+		return nil, port.ErrRecordNotFound
+	} else if err != nil {
+		return nil, translateError(err)
+	}
+
+	plan.Charges, err = r.listCharges(
+		ctx,
+		plan.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (r InstallmentPlanRepoImpl) List(
+	ctx context.Context,
+	params domain.InstallmentPlanListParams,
+) (
+	[]domain.InstallmentPlan,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	whereClause := []string{"account_id IN (" + householdAccountsQuery + ")"}
+	args := []any{household}
+
+	if params.AccountID != nil {
+		whereClause = append(
+			whereClause,
+			"account_id = ?",
+		)
+		args = append(
+			args,
+			*params.AccountID,
+		)
+	}
+
+	query := `SELECT ` + installmentPlanColumns + `
+					FROM installment_plans WHERE ` + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	)
+	query += " ORDER BY first_charge_date, id"
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	plans := make(
+		[]domain.InstallmentPlan,
+		0,
+	)
+	for rows.Next() {
+		plan, errScan := r.scanPlan(rows)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		plans = append(
+			plans,
+			*plan,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	for i := range plans {
+		plans[i].Charges, err = r.listCharges(
+			ctx,
+			plans[i].ID,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return plans, nil
+}
+
+func (r InstallmentPlanRepoImpl) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	result, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM installment_plans WHERE id=? AND account_id IN (`+householdAccountsQuery+`)`,
+		id,
+		household,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, errRowsAffected := result.RowsAffected()
+	if errRowsAffected != nil {
+		return translateError(errRowsAffected)
+	}
+	if rowsAffected == 0 {
+		return port.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r InstallmentPlanRepoImpl) Obligations(
+	ctx context.Context,
+	params domain.InstallmentObligationParams,
+) (
+	[]domain.InstallmentObligation,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Installments the poster failed to charge are still owed
+	whereClause := []string{"t.status IN (?, ?)", "a.household_id = ?"}
+	args := []any{domain.TransactionStatusPending, domain.TransactionStatusFailed, household}
+
+	if params.AccountID != nil {
+		whereClause = append(
+			whereClause,
+			"a.id = ?",
+		)
+		args = append(
+			args,
+			*params.AccountID,
+		)
+	}
+	if params.HouseholdMemberID != nil {
+		whereClause = append(
+			whereClause,
+			"a.owner = ?",
+		)
+		args = append(
+			args,
+			*params.HouseholdMemberID,
+		)
+	}
+
+	query := `SELECT a.id,
+					 a.owner,
+					 t.currency,
+					 SUM(t.amount),
+					 COUNT(*),
+					 COUNT(DISTINCT ip.id)
+			  FROM installment_plans ip
+					   INNER JOIN expenditures e ON e.installment_plan_id = ip.id
+					   INNER JOIN transactions t ON e.transaction_id = t.id
+					   INNER JOIN accounts a ON ip.account_id = a.id
+			  WHERE ` + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	) + `
+			  GROUP BY a.id, a.owner, t.currency
+			  ORDER BY a.owner, a.id`
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	obligations := make(
+		[]domain.InstallmentObligation,
+		0,
+	)
+	for rows.Next() {
+		var obligation domain.InstallmentObligation
+		err = rows.Scan(
+			&obligation.AccountID,
+			&obligation.HouseholdMemberID,
+			&obligation.Currency,
+			&obligation.RemainingAmount,
+			&obligation.PendingInstallments,
+			&obligation.Plans,
+		)
+		if err != nil {
+			return nil, translateError(err)
+		}
+		obligations = append(
+			obligations,
+			obligation,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return obligations, nil
+}
+
+func (r InstallmentPlanRepoImpl) PayOff(
+	ctx context.Context,
+	accountID string,
+	transactions []domain.Transaction,
+	paidOn time.Time,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	account, err := lockAccountBalance(
+		ctx,
+		tx,
+		accountID,
+		household,
+	)
+	if err != nil {
+		return err
+	}
+	var debit float32
+	for _, transaction := range transactions {
+		debit += transaction.Amount
+	}
+	if !account.HasSufficientBalance(debit) {
+		return domain.ErrInsufficientBalance
+	}
+
+	for _, transaction := range transactions {
+		account.DebitBalance(transaction.Amount)
+		transaction.Complete(account.CurrentBalance)
+		result, errUpdate := tx.ExecContext(
+			ctx,
+			`UPDATE transactions
+			SET status = ?,
+				balance_after = ?,
+				failure_reason = NULL,
+				transaction_date = ?
+			WHERE id = ?
+			  AND status IN (?, ?)
+			  AND account_id IN (`+householdAccountsQuery+`)`,
+			transaction.Status,
+			transaction.BalanceAfter,
+			paidOn,
+			transaction.ID,
+			domain.TransactionStatusPending,
+			domain.TransactionStatusFailed,
+			household,
+		)
+		if errUpdate != nil {
+			return translateError(errUpdate)
+		}
+		affected, errAffected := result.RowsAffected()
+		if errAffected != nil {
+			return translateError(errAffected)
+		}
+		if affected == 0 {
+			return port.ErrRecordNotFound
+		}
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE accounts SET current_balance = current_balance - ?, updated_at = ? WHERE id = ?`,
+		debit,
+		time.Now(),
+		accountID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func (r InstallmentPlanRepoImpl) listCharges(
+	ctx context.Context,
+	planID string,
+) (
+	[]domain.InstallmentCharge,
+	error,
+) {
+	query := `SELECT e.installment_number,
+					 e.id,
+					 t.id,
+					 t.amount,
+					 t.currency,
+					 t.transaction_date,
+					 t.status
+			  FROM expenditures e
+					   INNER JOIN transactions t ON e.transaction_id = t.id
+			  WHERE e.installment_plan_id = ?
+			  ORDER BY e.installment_number`
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		planID,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	var charges []domain.InstallmentCharge
+	for rows.Next() {
+		var charge domain.InstallmentCharge
+		err = rows.Scan(
+			&charge.Number,
+			&charge.ExpenditureID,
+			&charge.TransactionID,
+			&charge.Amount,
+			&charge.Currency,
+			&charge.ChargeDate,
+			&charge.Status,
+		)
+		if err != nil {
+			return nil, translateError(err)
+		}
+		charges = append(
+			charges,
+			charge,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return charges, nil
+}
+
+func (r InstallmentPlanRepoImpl) scanPlan(row rowScanner) (
+	*domain.InstallmentPlan,
+	error,
+) {
+	var plan domain.InstallmentPlan
+
+	err := row.Scan(
+		&plan.ID,
+		&plan.AccountID,
+		&plan.CategoryID,
+		&plan.Description,
+		&plan.TotalAmount,
+		&plan.InterestRate,
+		&plan.Installments,
+		&plan.FirstChargeDate,
+		&plan.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &plan, nil
+}
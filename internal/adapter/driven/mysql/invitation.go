@@ -0,0 +1,341 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+type InvitationRepo struct {
+	db *sql.DB
+}
+
+func NewInvitationRepo(db *sql.DB) port.InvitationRepo {
+	return &InvitationRepo{db: db}
+}
+
+func (r *InvitationRepo) Create(
+	ctx context.Context,
+	invitation domain.Invitation,
+	tokenHash string,
+) (
+	string,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO invitations (household_id, email, token_hash, household_member_id, invited_by, expires_at)
+         VALUES (?, ?, ?, ?, ?, ?)`,
+		household,
+		invitation.Email,
+		tokenHash,
+		invitation.MemberID,
+		invitation.InvitedBy,
+		invitation.ExpiresAt,
+	)
+	if err != nil {
+		return "", translateError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return "", fmt.Errorf(
+			"failed to get last insert ID: %w",
+			err,
+		)
+	}
+
+	return strconv.FormatInt(
+		id,
+		10,
+	), nil
+}
+
+func (r *InvitationRepo) GetByTokenHash(
+	ctx context.Context,
+	tokenHash string,
+) (
+	*domain.Invitation,
+	error,
+) {
+	var invitation domain.Invitation
+	var memberID sql.NullString
+	var acceptedAt sql.NullTime
+	err := r.db.QueryRowContext(
+		ctx,
+		`SELECT id, household_id, email, household_member_id, invited_by, expires_at, accepted_at, created_at
+         FROM invitations
+         WHERE token_hash = ?`,
+		tokenHash,
+	).Scan(
+		&invitation.ID,
+		&invitation.HouseholdID,
+		&invitation.Email,
+		&memberID,
+		&invitation.InvitedBy,
+		&invitation.ExpiresAt,
+		&acceptedAt,
+		&invitation.CreatedAt,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	if memberID.Valid {
+		invitation.MemberID = &memberID.String
+	}
+	if acceptedAt.Valid {
+		invitation.AcceptedAt = &acceptedAt.Time
+	}
+
+	return &invitation, nil
+}
+
+func (r *InvitationRepo) Accept(
+	ctx context.Context,
+	invitation domain.Invitation,
+	user domain.User,
+	newMember *domain.HouseholdMember,
+) (
+	string,
+	error,
+) {
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return "", translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	memberID, err := claimInvitation(
+		ctx,
+		tx,
+		invitation,
+		newMember,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO users (household_member_id, username, password_hash, salt, email, name, surname)
+         VALUES (?, ?, ?, '', ?, ?, ?)`,
+		memberID,
+		user.Email,
+		user.Password,
+		user.Email,
+		user.FirstName,
+		user.LastName,
+	)
+	if err != nil {
+		return "", translateError(err)
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return "", fmt.Errorf(
+			"failed to get last insert ID: %w",
+			err,
+		)
+	}
+	userID := strconv.FormatInt(
+		lastID,
+		10,
+	)
+
+	err = grantInvitedRoles(
+		ctx,
+		tx,
+		invitation,
+		userID,
+		user.Roles,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", translateError(err)
+	}
+
+	return userID, nil
+}
+
+func (r *InvitationRepo) Join(
+	ctx context.Context,
+	invitation domain.Invitation,
+	userID string,
+	roles []string,
+	newMember *domain.HouseholdMember,
+) error {
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	memberID, err := claimInvitation(
+		ctx,
+		tx,
+		invitation,
+		newMember,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Only a user not linked yet is linked, so concurrent joins of the same user fail here
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE users SET household_member_id = ? WHERE id = ? AND household_member_id IS NULL`,
+		memberID,
+		userID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(
+			"failed to link user to member: %w",
+			err,
+		)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrUserAlreadyLinked
+	}
+
+	err = grantInvitedRoles(
+		ctx,
+		tx,
+		invitation,
+		userID,
+		roles,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
+// claimInvitation marks the invitation accepted and returns the member the invitee is linked to, the
+// invited one or the new member it creates. Claiming the invitation first makes concurrent acceptances
+// of the same token fail here.
+func claimInvitation(
+	ctx context.Context,
+	tx *sql.Tx,
+	invitation domain.Invitation,
+	newMember *domain.HouseholdMember,
+) (
+	*string,
+	error,
+) {
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE invitations SET accepted_at = NOW() WHERE id = ? AND accepted_at IS NULL`,
+		invitation.ID,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to accept invitation: %w",
+			err,
+		)
+	}
+	if rowsAffected == 0 {
+		return nil, domain.ErrInvitationAccepted
+	}
+
+	if newMember == nil {
+		return invitation.MemberID, nil
+	}
+	result, err = tx.ExecContext(
+		ctx,
+		`INSERT INTO household_members (household_id, name, surname, nickname, role, active, created_at, updated_at) VALUES (?,?,?,?,?,true, NOW(), NOW())`,
+		invitation.HouseholdID,
+		newMember.FirstName,
+		newMember.LastName,
+		newMember.Nickname,
+		newMember.Role,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get last insert ID: %w",
+			err,
+		)
+	}
+	memberID := strconv.FormatInt(
+		lastID,
+		10,
+	)
+
+	return &memberID, nil
+}
+
+// grantInvitedRoles grants the roles to the user that accepted the invitation and records it as its
+// acceptor
+func grantInvitedRoles(
+	ctx context.Context,
+	tx *sql.Tx,
+	invitation domain.Invitation,
+	userID string,
+	roles []string,
+) error {
+	for _, role := range roles {
+		result, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO user_roles (user_id, role_id) SELECT ?, id FROM roles WHERE name = ?`,
+			userID,
+			role,
+		)
+		if err != nil {
+			return translateError(err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf(
+				"failed to grant user role: %w",
+				err,
+			)
+		}
+		if rowsAffected == 0 {
+			return domain.ErrRoleNotFound
+		}
+	}
+
+	_, err := tx.ExecContext(
+		ctx,
+		`UPDATE invitations SET accepted_by = ? WHERE id = ?`,
+		userID,
+		invitation.ID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,370 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+// payeeAliasesSeparator must match the GROUP_CONCAT separator of payeeSelect
+const payeeAliasesSeparator = "\n"
+
+const payeeSelect = `SELECT p.id,
+					   p.name,
+					   p.default_category_id,
+					   p.created_at,
+					   p.updated_at,
+					   GROUP_CONCAT(pa.alias ORDER BY pa.alias SEPARATOR '\n') AS aliases
+				FROM payees p
+						 LEFT JOIN payee_aliases pa ON p.id = pa.payee_id`
+
+const payeeGroupBy = ` GROUP BY p.id, p.name, p.default_category_id, p.created_at, p.updated_at`
+
+type PayeeRepoImpl struct {
+	db *sql.DB
+}
+
+func NewPayeeRepo(db *sql.DB) port.PayeeRepo {
+	return &PayeeRepoImpl{db: db}
+}
+
+func (r PayeeRepoImpl) Create(
+	ctx context.Context,
+	payee domain.Payee,
+) (
+	string,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return "", translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	result, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO payees (household_id, name, default_category_id) VALUES (?, ?, ?)`,
+		household,
+		payee.Name,
+		payee.DefaultCategoryID,
+	)
+	if err != nil {
+		return "", translateError(err)
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return "", fmt.Errorf(
+			"failed to get last insert ID: %w",
+			err,
+		)
+	}
+	id := strconv.FormatInt(
+		lastID,
+		10,
+	)
+
+	err = r.insertAliases(
+		ctx,
+		tx,
+		household,
+		id,
+		payee.Aliases,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", translateError(err)
+	}
+
+	return id, nil
+}
+
+func (r PayeeRepoImpl) GetByID(
+	ctx context.Context,
+	id string,
+) (
+	*domain.Payee,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	payee, err := r.scanPayee(
+		r.db.QueryRowContext(
+			ctx,
+			payeeSelect+` WHERE p.id = ? AND p.household_id = ?`+payeeGroupBy,
+			id,
+			household,
+		),
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return payee, nil
+}
+
+func (r PayeeRepoImpl) List(
+	ctx context.Context,
+	params domain.PayeeListParams,
+) (
+	[]domain.Payee,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	whereClause := []string{"p.household_id = ?"}
+	args := []any{household}
+
+	if params.Name != nil {
+		whereClause = append(
+			whereClause,
+			`(p.name LIKE ? OR EXISTS (SELECT 1 FROM payee_aliases pa2 WHERE pa2.payee_id = p.id AND pa2.alias LIKE ?))`,
+		)
+		args = append(
+			args,
+			"%"+*params.Name+"%",
+			"%"+*params.Name+"%",
+		)
+	}
+
+	query := payeeSelect + " WHERE " + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	)
+	query += payeeGroupBy + " ORDER BY p.name, p.id"
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	payees := make(
+		[]domain.Payee,
+		0,
+	)
+	for rows.Next() {
+		payee, errScan := r.scanPayee(rows)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		payees = append(
+			payees,
+			*payee,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return payees, nil
+}
+
+func (r PayeeRepoImpl) Update(
+	ctx context.Context,
+	payee domain.Payee,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	var exists bool
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT TRUE FROM payees WHERE id = ? AND household_id = ? FOR UPDATE`,
+		payee.ID,
+		household,
+	).Scan(&exists)
+	if err != nil {
+		return translateError(err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE payees SET name = ?, default_category_id = ? WHERE id = ?`,
+		payee.Name,
+		payee.DefaultCategoryID,
+		payee.ID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`DELETE FROM payee_aliases WHERE payee_id = ?`,
+		payee.ID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	err = r.insertAliases(
+		ctx,
+		tx,
+		household,
+		payee.ID,
+		payee.Aliases,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
+func (r PayeeRepoImpl) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM payees WHERE id = ? AND household_id = ?`,
+		id,
+		household,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	rowsAffected, errRowsAffected := result.RowsAffected()
+	if errRowsAffected != nil {
+		return translateError(errRowsAffected)
+	}
+	if rowsAffected == 0 {
+		return port.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r PayeeRepoImpl) FindByAlias(
+	ctx context.Context,
+	alias string,
+) (
+	*domain.Payee,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The default collation compares case-insensitively
+	query := payeeSelect + ` WHERE p.household_id = ? AND (p.name = ? OR EXISTS (SELECT 1 FROM payee_aliases pa2 WHERE pa2.payee_id = p.id AND pa2.alias = ?))` +
+		payeeGroupBy + ` ORDER BY p.id LIMIT 1`
+
+	payee, err := r.scanPayee(
+		r.db.QueryRowContext(
+			ctx,
+			query,
+			household,
+			alias,
+			alias,
+		),
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return payee, nil
+}
+
+func (r PayeeRepoImpl) insertAliases(
+	ctx context.Context,
+	tx *sql.Tx,
+	household string,
+	payeeID string,
+	aliases []string,
+) error {
+	for _, alias := range aliases {
+		_, err := tx.ExecContext(
+			ctx,
+			`INSERT INTO payee_aliases (household_id, payee_id, alias) VALUES (?, ?, ?)`,
+			household,
+			payeeID,
+			alias,
+		)
+		if err != nil {
+			return translateError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r PayeeRepoImpl) scanPayee(row rowScanner) (
+	*domain.Payee,
+	error,
+) {
+	var payee domain.Payee
+	var aliases sql.NullString
+
+	err := row.Scan(
+		&payee.ID,
+		&payee.Name,
+		&payee.DefaultCategoryID,
+		&payee.CreatedAt,
+		&payee.UpdatedAt,
+		&aliases,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	payee.Aliases = make(
+		[]string,
+		0,
+	)
+	if aliases.Valid && aliases.String != "" {
+		payee.Aliases = strings.Split(
+			aliases.String,
+			payeeAliasesSeparator,
+		)
+	}
+
+	return &payee, nil
+}
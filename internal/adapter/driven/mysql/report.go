@@ -0,0 +1,780 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+// notRolledBackCondition leaves out the transactions that were reversed by a rollback
+const notRolledBackCondition = `NOT EXISTS (SELECT 1 FROM transaction_rollbacks tr WHERE tr.transaction_id = t.id)`
+
+// refundsJoin adds rf.refunded, what was refunded of each expenditure e in the currency it was charged in
+const refundsJoin = `LEFT JOIN (SELECT er.expenditure_id,
+                                          SUM(COALESCE(rt.original_amount, rt.amount)) AS refunded
+                                   FROM expenditure_refunds er
+                                            INNER JOIN transactions rt ON er.transaction_id = rt.id
+                                   WHERE rt.status = 'completed'
+                                   GROUP BY er.expenditure_id) rf ON rf.expenditure_id = e.id`
+
+// amountColumns returns the amount, net of refunds, and the currency of the expenditure transactions t
+// reports use for the amount basis. It expects the refundsJoin.
+func amountColumns(basis domain.AmountBasis) (
+	amount string,
+	currency string,
+) {
+	if basis == domain.AmountBasisOriginal {
+		return "ROUND(COALESCE(t.original_amount, t.amount) * (1 - COALESCE(rf.refunded, 0) / t.amount), 2)",
+			"COALESCE(t.original_currency, t.currency)"
+	}
+
+	return "(t.amount - COALESCE(rf.refunded, 0))", "t.currency"
+}
+
+// categoryJoin joins the category c of each expenditure e, or its top level category when rolling up
+func categoryJoin(rollUp bool) string {
+	if !rollUp {
+		return "INNER JOIN categories c ON e.category_id = c.id"
+	}
+
+	return `INNER JOIN (WITH RECURSIVE category_roots AS (SELECT id, id AS root_id
+                                                          FROM categories
+                                                          WHERE parent_id IS NULL
+                                                          UNION ALL
+                                                          SELECT sc.id, cr.root_id
+                                                          FROM categories sc
+                                                                   INNER JOIN category_roots cr ON sc.parent_id = cr.id)
+                           SELECT id, root_id
+                           FROM category_roots) cr ON e.category_id = cr.id
+                       INNER JOIN categories c ON cr.root_id = c.id`
+}
+
+type ReportRepo struct {
+	db *sql.DB
+}
+
+func NewReportRepo(db *sql.DB) port.ReportRepo {
+	return &ReportRepo{db: db}
+}
+
+func (r *ReportRepo) PlannedSpending(
+	ctx context.Context,
+	params domain.PlannedSpendingParams,
+	from time.Time,
+	to time.Time,
+) (
+	[]domain.PlannedSpendingEntry,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, currency := amountColumns(params.AmountBasis)
+	whereClause := []string{
+		"t.account_id IN (" + householdAccountsQuery + ")",
+		"t.status = ?",
+		currency + " = ?",
+		"t.transaction_date >= ?",
+		"t.transaction_date < ?",
+		notRolledBackCondition,
+	}
+	args := []any{
+		household,
+		domain.TransactionStatusCompleted,
+		params.Currency,
+		from,
+		to,
+	}
+	if params.AccountID != nil {
+		whereClause = append(
+			whereClause,
+			"t.account_id = ?",
+		)
+		args = append(
+			args,
+			*params.AccountID,
+		)
+	}
+
+	query := `SELECT DATE(t.transaction_date) AS spent_on,
+                     c.id,
+                     c.name,
+                     e.planned,
+                     SUM(` + amount + `)
+              FROM expenditures e
+                       INNER JOIN transactions t ON e.transaction_id = t.id
+                       ` + categoryJoin(params.RollUp) + `
+                       ` + refundsJoin + `
+              WHERE ` + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	) + `
+              GROUP BY spent_on, c.id, c.name, e.planned
+              ORDER BY spent_on, c.id`
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	entries := make(
+		[]domain.PlannedSpendingEntry,
+		0,
+	)
+	for rows.Next() {
+		var entry domain.PlannedSpendingEntry
+		err = rows.Scan(
+			&entry.Date,
+			&entry.CategoryID,
+			&entry.CategoryName,
+			&entry.Planned,
+			&entry.Amount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to scan planned spending entry: %w",
+				err,
+			)
+		}
+		entries = append(
+			entries,
+			entry,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return entries, nil
+}
+
+func (r *ReportRepo) PayeeSpending(
+	ctx context.Context,
+	params domain.PayeeSpendingParams,
+	from time.Time,
+	to time.Time,
+) (
+	[]domain.PayeeSpending,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, currency := amountColumns(params.AmountBasis)
+	whereClause := []string{
+		"t.account_id IN (" + householdAccountsQuery + ")",
+		"t.status = ?",
+		currency + " = ?",
+		"t.transaction_date >= ?",
+		"t.transaction_date < ?",
+		notRolledBackCondition,
+	}
+	args := []any{
+		household,
+		domain.TransactionStatusCompleted,
+		params.Currency,
+		from,
+		to,
+	}
+	if params.AccountID != nil {
+		whereClause = append(
+			whereClause,
+			"t.account_id = ?",
+		)
+		args = append(
+			args,
+			*params.AccountID,
+		)
+	}
+
+	query := `SELECT p.id,
+                     p.name,
+                     SUM(` + amount + `) AS total,
+                     COUNT(*)
+              FROM expenditures e
+                       INNER JOIN transactions t ON e.transaction_id = t.id
+                       LEFT JOIN payees p ON e.payee_id = p.id
+                       ` + refundsJoin + `
+              WHERE ` + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	) + `
+              GROUP BY p.id, p.name
+              ORDER BY total DESC, p.name`
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	entries := make(
+		[]domain.PayeeSpending,
+		0,
+	)
+	for rows.Next() {
+		var entry domain.PayeeSpending
+		var name sql.NullString
+		err = rows.Scan(
+			&entry.PayeeID,
+			&name,
+			&entry.Total,
+			&entry.Count,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to scan payee spending entry: %w",
+				err,
+			)
+		}
+		entry.PayeeName = name.String
+		entries = append(
+			entries,
+			entry,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return entries, nil
+}
+
+func (r *ReportRepo) DeclaredExpenses(
+	ctx context.Context,
+	params domain.DeclaredExpensesParams,
+	from time.Time,
+	to time.Time,
+) (
+	[]domain.DeclaredExpense,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, currency := amountColumns(params.AmountBasis)
+	whereClause := []string{
+		"t.account_id IN (" + householdAccountsQuery + ")",
+		"e.declared = TRUE",
+		"t.status = ?",
+		"t.transaction_date >= ?",
+		"t.transaction_date < ?",
+		notRolledBackCondition,
+	}
+	args := []any{
+		household,
+		domain.TransactionStatusCompleted,
+		from,
+		to,
+	}
+	if params.Currency != nil {
+		whereClause = append(
+			whereClause,
+			currency+" = ?",
+		)
+		args = append(
+			args,
+			*params.Currency,
+		)
+	}
+	if params.MemberID != nil {
+		whereClause = append(
+			whereClause,
+			"a.owner = ?",
+		)
+		args = append(
+			args,
+			*params.MemberID,
+		)
+	}
+
+	query := `SELECT e.id,
+                     t.transaction_date,
+                     t.description,
+                     ` + amount + `,
+                     ` + currency + `,
+                     c.id,
+                     c.name,
+                     GROUP_CONCAT(tg.id ORDER BY tg.name, tg.id SEPARATOR ',')     AS tag_ids,
+                     GROUP_CONCAT(tg.name ORDER BY tg.name, tg.id SEPARATOR '\n')  AS tag_names,
+                     a.id,
+                     a.name,
+                     hm.id,
+                     CONCAT(hm.name, ' ', hm.surname)
+              FROM expenditures e
+                       INNER JOIN transactions t ON e.transaction_id = t.id
+                       ` + categoryJoin(params.RollUp) + `
+                       INNER JOIN accounts a ON t.account_id = a.id
+                       INNER JOIN household_members hm ON a.owner = hm.id
+                       LEFT JOIN expenditure_tags et ON e.id = et.expenditure_id
+                       LEFT JOIN tags tg ON et.tag_id = tg.id
+                       ` + refundsJoin + `
+              WHERE ` + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	) + `
+              GROUP BY e.id, t.transaction_date, t.description, ` + amount + `, ` + currency + `, c.id, c.name,
+                       a.id, a.name, hm.id, hm.name, hm.surname
+              ORDER BY t.transaction_date, e.id`
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	expenses := make(
+		[]domain.DeclaredExpense,
+		0,
+	)
+	for rows.Next() {
+		expense, errScan := r.scanDeclaredExpense(rows)
+		if errScan != nil {
+			return nil, errScan
+		}
+		expenses = append(
+			expenses,
+			expense,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return expenses, nil
+}
+
+func (r *ReportRepo) scanDeclaredExpense(rows *sql.Rows) (
+	domain.DeclaredExpense,
+	error,
+) {
+	var expense domain.DeclaredExpense
+	var description, tagIDs, tagNames sql.NullString
+
+	err := rows.Scan(
+		&expense.ExpenditureID,
+		&expense.Date,
+		&description,
+		&expense.Amount,
+		&expense.Currency,
+		&expense.CategoryID,
+		&expense.CategoryName,
+		&tagIDs,
+		&tagNames,
+		&expense.AccountID,
+		&expense.AccountName,
+		&expense.MemberID,
+		&expense.MemberName,
+	)
+	if err != nil {
+		return expense, fmt.Errorf(
+			"failed to scan declared expense: %w",
+			err,
+		)
+	}
+
+	expense.Description = description.String
+	expense.Tags = make(
+		[]domain.TagLabel,
+		0,
+	)
+	if tagIDs.Valid && tagIDs.String != "" {
+		ids := strings.Split(
+			tagIDs.String,
+			",",
+		)
+		names := strings.Split(
+			tagNames.String,
+			tagNamesSeparator,
+		)
+		for i, id := range ids {
+			tag := domain.TagLabel{ID: id}
+			if i < len(names) {
+				tag.Name = names[i]
+			}
+			expense.Tags = append(
+				expense.Tags,
+				tag,
+			)
+		}
+	}
+
+	return expense, nil
+}
+
+// taggedRecords describes the records e of a tag type, expenditures or ingresses, with the joins and
+// columns tag reports use. Ingresses are aliased e as well so the category joins apply to both.
+type taggedRecords struct {
+	junctionTable string
+	foreignKey    string
+	// from selects the records e with their transactions t
+	from     string
+	amount   string
+	currency string
+}
+
+func taggedRecordsOf(
+	tagType domain.TagType,
+	basis domain.AmountBasis,
+) (
+	*taggedRecords,
+	error,
+) {
+	switch tagType {
+	case domain.TagTypeExpenditure:
+		amount, currency := amountColumns(basis)
+
+		return &taggedRecords{
+			junctionTable: "expenditure_tags",
+			foreignKey:    "expenditure_id",
+			from: `expenditures e
+                       INNER JOIN transactions t ON e.transaction_id = t.id
+                       ` + refundsJoin,
+			amount:   amount,
+			currency: currency,
+		}, nil
+	case domain.TagTypeIngress:
+		records := &taggedRecords{
+			junctionTable: "ingress_tags",
+			foreignKey:    "ingress_id",
+			from: `ingresses e
+                       INNER JOIN transactions t ON e.transaction_id = t.id`,
+			amount:   "t.amount",
+			currency: "t.currency",
+		}
+		if basis == domain.AmountBasisOriginal {
+			records.amount = "COALESCE(t.original_amount, t.amount)"
+			records.currency = "COALESCE(t.original_currency, t.currency)"
+		}
+
+		return records, nil
+	default:
+		return nil, domain.ErrTagReportUnsupportedTag
+	}
+}
+
+// where returns the conditions every tag report applies to the transactions of the household's records
+func (tr *taggedRecords) where(
+	household string,
+	params domain.TagSpendingParams,
+	from time.Time,
+	to time.Time,
+) (
+	[]string,
+	[]any,
+) {
+	whereClause := []string{
+		"t.account_id IN (" + householdAccountsQuery + ")",
+		"t.status = ?",
+		tr.currency + " = ?",
+		"t.transaction_date >= ?",
+		"t.transaction_date < ?",
+		notRolledBackCondition,
+	}
+	args := []any{
+		household,
+		domain.TransactionStatusCompleted,
+		params.Currency,
+		from,
+		to,
+	}
+	if params.AccountID != nil {
+		whereClause = append(
+			whereClause,
+			"t.account_id = ?",
+		)
+		args = append(
+			args,
+			*params.AccountID,
+		)
+	}
+
+	return whereClause, args
+}
+
+func (r *ReportRepo) TagSpending(
+	ctx context.Context,
+	params domain.TagSpendingParams,
+	from time.Time,
+	to time.Time,
+) (
+	[]domain.TagSpending,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(
+		[]domain.TagSpending,
+		0,
+	)
+	for _, tagType := range []domain.TagType{domain.TagTypeExpenditure, domain.TagTypeIngress} {
+		records, err := taggedRecordsOf(
+			tagType,
+			params.AmountBasis,
+		)
+		if err != nil {
+			return nil, err
+		}
+		whereClause, args := records.where(
+			household,
+			params,
+			from,
+			to,
+		)
+
+		// Junction tables without a unique key may link a record to a tag twice
+		query := `SELECT tg.id,
+                         tg.name,
+                         tg.type,
+                         SUM(` + records.amount + `) AS total,
+                         COUNT(*)
+                  FROM ` + records.from + `
+                           INNER JOIN (SELECT DISTINCT ` + records.foreignKey + ` AS record_id, tag_id
+                                       FROM ` + records.junctionTable + `) rt ON rt.record_id = e.id
+                           INNER JOIN tags tg ON rt.tag_id = tg.id
+                  WHERE ` + strings.Join(
+			whereClause,
+			AND_CLAUSE,
+		) + `
+                  GROUP BY tg.id, tg.name, tg.type
+                  ORDER BY total DESC, tg.name`
+
+		rows, err := r.db.QueryContext(
+			ctx,
+			query,
+			args...,
+		)
+		if err != nil {
+			return nil, translateError(err)
+		}
+		for rows.Next() {
+			var entry domain.TagSpending
+			err = rows.Scan(
+				&entry.TagID,
+				&entry.TagName,
+				&entry.TagType,
+				&entry.Total,
+				&entry.Count,
+			)
+			if err != nil {
+				rows.Close()
+
+				return nil, fmt.Errorf(
+					"failed to scan tag spending entry: %w",
+					err,
+				)
+			}
+			entries = append(
+				entries,
+				entry,
+			)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, translateError(err)
+		}
+	}
+
+	return entries, nil
+}
+
+func (r *ReportRepo) TagCombination(
+	ctx context.Context,
+	params domain.TagCombinationParams,
+	tagType domain.TagType,
+	from time.Time,
+	to time.Time,
+) (
+	total float64,
+	count int,
+	err error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	records, err := taggedRecordsOf(
+		tagType,
+		params.AmountBasis,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+	whereClause, args := records.where(
+		household,
+		params.TagSpendingParams,
+		from,
+		to,
+	)
+
+	// Records with all the tags have as many distinct tags among them, records with any have at least one
+	matches := len(params.TagIDs)
+	if params.Match == domain.TagMatchAny {
+		matches = 1
+	}
+	whereClause = append(
+		whereClause,
+		`e.id IN (SELECT `+records.foreignKey+`
+                  FROM `+records.junctionTable+`
+                  WHERE tag_id IN (`+strings.TrimSuffix(
+			strings.Repeat(
+				"?,",
+				len(params.TagIDs),
+			),
+			",",
+		)+`)
+                  GROUP BY `+records.foreignKey+`
+                  HAVING COUNT(DISTINCT tag_id) >= ?)`,
+	)
+	for _, tagID := range params.TagIDs {
+		args = append(
+			args,
+			tagID,
+		)
+	}
+	args = append(
+		args,
+		matches,
+	)
+
+	query := `SELECT COALESCE(SUM(` + records.amount + `), 0),
+                     COUNT(*)
+              FROM ` + records.from + `
+              WHERE ` + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	)
+
+	err = r.db.QueryRowContext(
+		ctx,
+		query,
+		args...,
+	).Scan(
+		&total,
+		&count,
+	)
+	if err != nil {
+		return 0, 0, translateError(err)
+	}
+
+	return total, count, nil
+}
+
+func (r *ReportRepo) TagCategorySpending(
+	ctx context.Context,
+	params domain.TagCategoryParams,
+	tagType domain.TagType,
+	from time.Time,
+	to time.Time,
+) (
+	[]domain.TagCategorySpending,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := taggedRecordsOf(
+		tagType,
+		params.AmountBasis,
+	)
+	if err != nil {
+		return nil, err
+	}
+	whereClause, args := records.where(
+		household,
+		params.TagSpendingParams,
+		from,
+		to,
+	)
+	whereClause = append(
+		whereClause,
+		`EXISTS (SELECT 1 FROM `+records.junctionTable+` jt WHERE jt.`+records.foreignKey+` = e.id AND jt.tag_id = ?)`,
+	)
+	args = append(
+		args,
+		params.TagID,
+	)
+
+	query := `SELECT c.id,
+                     c.name,
+                     SUM(` + records.amount + `) AS total,
+                     COUNT(*)
+              FROM ` + records.from + `
+                       ` + categoryJoin(params.RollUp) + `
+              WHERE ` + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	) + `
+              GROUP BY c.id, c.name
+              ORDER BY total DESC, c.name`
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		query,
+		args...,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	entries := make(
+		[]domain.TagCategorySpending,
+		0,
+	)
+	for rows.Next() {
+		var entry domain.TagCategorySpending
+		err = rows.Scan(
+			&entry.CategoryID,
+			&entry.CategoryName,
+			&entry.Total,
+			&entry.Count,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to scan tag category spending entry: %w",
+				err,
+			)
+		}
+		entries = append(
+			entries,
+			entry,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,159 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+// householdRoleUsersQuery counts the users of a household holding a role
+const householdRoleUsersQuery = `SELECT COUNT(*)
+         FROM user_roles ur
+                  JOIN roles r ON r.id = ur.role_id
+                  JOIN users u ON u.id = ur.user_id
+                  JOIN household_members hm ON hm.id = u.household_member_id
+         WHERE hm.household_id = ? AND r.name = ?`
+
+type RoleRepoImpl struct {
+	db *sql.DB
+}
+
+func NewRoleRepo(db *sql.DB) port.RoleRepo {
+	return &RoleRepoImpl{db: db}
+}
+
+func (r *RoleRepoImpl) List(ctx context.Context) (
+	[]domain.Role,
+	error,
+) {
+	rows, err := r.db.QueryContext(
+		ctx,
+		`SELECT id, name FROM roles ORDER BY id`,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	roles := make(
+		[]domain.Role,
+		0,
+	)
+	for rows.Next() {
+		var role domain.Role
+		if err = rows.Scan(
+			&role.ID,
+			&role.Name,
+		); err != nil {
+			return nil, translateError(err)
+		}
+		roles = append(
+			roles,
+			role,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return roles, nil
+}
+
+func (r *RoleRepoImpl) SetUserRoles(
+	ctx context.Context,
+	userID string,
+	roles []string,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	// Role changes of the household are serialized on its row, so concurrent demotions cannot both
+	// count the other admin and leave none
+	var id string
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT id FROM households WHERE id = ? FOR UPDATE`,
+		household,
+	).Scan(&id)
+	if err != nil {
+		return translateError(err)
+	}
+
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT u.id
+         FROM users u
+                  JOIN household_members hm ON hm.id = u.household_member_id
+         WHERE u.id = ? AND hm.household_id = ?
+         FOR UPDATE`,
+		userID,
+		household,
+	).Scan(&id)
+	if err != nil {
+		return translateError(err)
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`DELETE FROM user_roles WHERE user_id = ?`,
+		userID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	for _, role := range roles {
+		result, errInsert := tx.ExecContext(
+			ctx,
+			`INSERT INTO user_roles (user_id, role_id) SELECT ?, id FROM roles WHERE name = ?`,
+			userID,
+			role,
+		)
+		if errInsert != nil {
+			return translateError(errInsert)
+		}
+		rowsAffected, errRowsAffected := result.RowsAffected()
+		if errRowsAffected != nil {
+			return fmt.Errorf(
+				"failed to set user roles: %w",
+				errRowsAffected,
+			)
+		}
+		if rowsAffected == 0 {
+			return domain.ErrRoleNotFound
+		}
+	}
+
+	var admins int
+	err = tx.QueryRowContext(
+		ctx,
+		householdRoleUsersQuery,
+		household,
+		domain.RoleAdmin,
+	).Scan(&admins)
+	if err != nil {
+		return translateError(err)
+	}
+	if admins == 0 {
+		return domain.ErrLastAdmin
+	}
+
+	if err = tx.Commit(); err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
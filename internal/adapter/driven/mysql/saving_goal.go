@@ -94,6 +94,10 @@ func (s SavingGoalRepoImpl) Update(
 	id string,
 	savingsGoal openapi.SavingsGoalRequest,
 ) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
 	queryUpdate := `UPDATE savings_goals
 					SET name=?,
 						category_id=?,
@@ -109,8 +113,9 @@ func (s SavingGoalRepoImpl) Update(
 						auto_contribute_frequency=?,
 						status=?,
 						updated_at=NOW()
-					WHERE id = ?`
-	_, err := s.db.ExecContext(
+					WHERE id = ?
+					  AND account_id IN (` + householdAccountsQuery + `)`
+	_, err = s.db.ExecContext(
 		ctx,
 		queryUpdate,
 		savingsGoal.Name,
@@ -127,6 +132,7 @@ func (s SavingGoalRepoImpl) Update(
 		savingsGoal.AutoContributeFrequency,
 		"active",
 		id,
+		household,
 	)
 	if err != nil {
 		return err
@@ -140,12 +146,17 @@ func (s SavingGoalRepoImpl) Delete(
 	id string,
 ) error {
 	// Won't delete the record, just updating the status to "inactive"
-	query := `UPDATE savings_goals SET status =?, updated_at = NOW() WHERE id =?`
-	_, err := s.db.ExecContext(
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	query := `UPDATE savings_goals SET status =?, updated_at = NOW() WHERE id =? AND account_id IN (` + householdAccountsQuery + `)`
+	_, err = s.db.ExecContext(
 		ctx,
 		query,
 		"inactive",
 		id,
+		household,
 	)
 	if err != nil {
 		return fmt.Errorf(
@@ -164,6 +175,10 @@ func (s SavingGoalRepoImpl) GetByID(
 	*openapi.SavingsGoal,
 	error,
 ) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	query := `select sg.id,
 					   sg.name,
 					   sg.description,
@@ -194,6 +209,7 @@ func (s SavingGoalRepoImpl) GetByID(
 						 inner join categories c ON sg.category_id = c.id
 						 left join savings_goal_tags sgt ON sg.id = sgt.savings_goal_id
 				where sg.id =?
+				  and c.household_id =?
 				group by sg.id, sg.name, sg.description, sg.target_amount, sg.currency, sg.target_date, sg.initial_amount,
 						 sg.current_amount, sg.percent_complete, sg.account_id, sg.priority, sg.auto_contribute,
 						 sg.auto_contribute_amount, sg.auto_contribute_frequency, sg.status, sg.projected_completion_date,
@@ -201,10 +217,11 @@ func (s SavingGoalRepoImpl) GetByID(
 `
 	var savingsGoal openapi.SavingsGoal
 	var tags string
-	err := s.db.QueryRowContext(
+	err = s.db.QueryRowContext(
 		ctx,
 		query,
 		id,
+		household,
 	).Scan(
 		&savingsGoal.Id,
 		&savingsGoal.Name,
@@ -231,6 +248,7 @@ func (s SavingGoalRepoImpl) GetByID(
 		&savingsGoal.Category.Color,
 		&savingsGoal.Category.BackgroundColor,
 		&savingsGoal.Category.Active,
+		&savingsGoal.Category.CategoryType,
 	)
 	if err != nil {
 		return nil, translateError(err)
@@ -243,6 +261,10 @@ func (s SavingGoalRepoImpl) List(ctx context.Context) (
 	[]openapi.SavingsGoal,
 	error,
 ) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	query := `select sg.id,
 					   sg.name,
 					   sg.description,
@@ -273,6 +295,7 @@ func (s SavingGoalRepoImpl) List(ctx context.Context) (
 						 inner join categories c ON sg.category_id = c.id
 						 left join savings_goal_tags sgt ON sg.id = sgt.savings_goal_id
 				where sg.status != 'inactive'
+				  and c.household_id =?
 				group by sg.id, sg.name, sg.description, sg.target_amount, sg.currency, sg.target_date, sg.initial_amount,
 						 sg.current_amount, sg.percent_complete, sg.account_id, sg.priority, sg.auto_contribute,
 						 sg.auto_contribute_amount, sg.auto_contribute_frequency, sg.status, sg.projected_completion_date,
@@ -284,6 +307,7 @@ func (s SavingGoalRepoImpl) List(ctx context.Context) (
 	rows, err := s.db.QueryContext(
 		ctx,
 		query,
+		household,
 	)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -343,18 +367,23 @@ func (s SavingGoalRepoImpl) MarkAsCompleted(
 	ctx context.Context,
 	id string,
 ) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
 	query := `
 	UPDATE
 	savings_goals
 	SET
 	status =?, updated_at = NOW()
 	WHERE
-	id =?`
-	_, err := s.db.ExecContext(
+	id =? AND account_id IN (` + householdAccountsQuery + `)`
+	_, err = s.db.ExecContext(
 		ctx,
 		query,
 		"completed",
 		id,
+		household,
 	)
 	if err != nil {
 		return fmt.Errorf(
@@ -370,18 +399,23 @@ func (s SavingGoalRepoImpl) MarkAsAbandoned(
 	ctx context.Context,
 	id string,
 ) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
 	query := `
 	UPDATE
 	savings_goals
 	SET
 	status =?, updated_at = NOW()
 	WHERE
-	id =?`
-	_, err := s.db.ExecContext(
+	id =? AND account_id IN (` + householdAccountsQuery + `)`
+	_, err = s.db.ExecContext(
 		ctx,
 		query,
 		"abandoned",
 		id,
+		household,
 	)
 	if err != nil {
 		return fmt.Errorf(
@@ -436,11 +470,16 @@ func (s SavingGoalRepoImpl) DeleteWithdrawal(
 	ctx context.Context,
 	id string,
 ) error {
-	queryDelete := `DELETE FROM savings_withdrawals WHERE id=?`
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	queryDelete := `DELETE FROM savings_withdrawals WHERE id=? AND savings_goal_id IN (` + householdSavingsGoalsQuery + `)`
 	_, errDelete := s.db.ExecContext(
 		ctx,
 		queryDelete,
 		id,
+		household,
 	)
 	if errDelete != nil {
 		return fmt.Errorf(
@@ -459,6 +498,10 @@ func (s SavingGoalRepoImpl) GetWithdrawalByID(
 	*openapi.SavingsWithdrawal,
 	error,
 ) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	query := `select sw.id,
 					   sw.savings_goal_id,
 					   sw.date,
@@ -473,16 +516,19 @@ func (s SavingGoalRepoImpl) GetWithdrawalByID(
 						 inner join transfers tr ON sw.transfer_id = tr.id
 						 inner join transactions t ON tr.transaction_id = t.id
 						 left join savings_withdrawal_tags swt ON sw.id = swt.withdrawal_id
+				where sw.id = ?
+				  and sw.savings_goal_id IN (` + householdSavingsGoalsQuery + `)
 				group by sw.id, sw.savings_goal_id, sw.date, sw.reason, sw.transfer_id, sw.created_at, sw.updated_at,
 						 tr.destination_amount, tr.destination_account_id, t.description`
 	row := s.db.QueryRowContext(
 		ctx,
 		query,
 		id,
+		household,
 	)
 	var withdrawal openapi.SavingsWithdrawal
 	var tags string
-	err := row.Scan(
+	err = row.Scan(
 		&withdrawal.Id,
 		&withdrawal.SavingsGoalId,
 		&withdrawal.Date,
@@ -544,11 +590,16 @@ func (s SavingGoalRepoImpl) DeleteContribution(
 	ctx context.Context,
 	id string,
 ) error {
-	queryDelete := `DELETE FROM savings_contributions WHERE id=?`
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	queryDelete := `DELETE FROM savings_contributions WHERE id=? AND savings_goal_id IN (` + householdSavingsGoalsQuery + `)`
 	_, errDelete := s.db.ExecContext(
 		ctx,
 		queryDelete,
 		id,
+		household,
 	)
 	if errDelete != nil {
 		return fmt.Errorf(
@@ -567,6 +618,10 @@ func (s SavingGoalRepoImpl) GetContributionByID(
 	*openapi.SavingsContribution,
 	error,
 ) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	query := `select sc.id,
 					   sc.savings_goal_id,
 					   sc.date,
@@ -580,6 +635,7 @@ func (s SavingGoalRepoImpl) GetContributionByID(
 						 inner join transactions t on tr.transaction_id = t.id
 						 left join savings_contribution_tags sct on sc.id = sct.contribution_id
 				where sc.id = ?
+				  and sc.savings_goal_id IN (` + householdSavingsGoalsQuery + `)
 				group by sc.id,
 						 sc.savings_goal_id,
 						 sc.date,
@@ -591,10 +647,11 @@ func (s SavingGoalRepoImpl) GetContributionByID(
 		ctx,
 		query,
 		id,
+		household,
 	)
 	var contribution openapi.SavingsContribution
 	var tags string
-	err := row.Scan(
+	err = row.Scan(
 		&contribution.Id,
 		&contribution.SavingsGoalId,
 		&contribution.Date,
@@ -637,6 +694,7 @@ func (s SavingGoalRepoImpl) ListSavingsTransactions(
 						 left join proletariat_budget.savings_contribution_tags sct on sc.id = sct.contribution_id
 						 left join proletariat_budget.savings_withdrawal_tags swt on sw.id = swt.withdrawal_id
 				where (sc.savings_goal_id = ? or sw.savings_goal_id = ?)
+				  and tr.source_account_id IN (` + householdAccountsQuery + `)
 				`
 	queryCount := `select COUNT(*) from transfers tr
 						 inner join transactions t on t.id = tr.transaction_id
@@ -644,7 +702,8 @@ func (s SavingGoalRepoImpl) ListSavingsTransactions(
 						 left join proletariat_budget.savings_withdrawals sw on tr.id = sw.transfer_id
 						 left join proletariat_budget.savings_contribution_tags sct on sc.id = sct.contribution_id
 						 left join proletariat_budget.savings_withdrawal_tags swt on sw.id = swt.withdrawal_id
-					where (sc.savings_goal_id = ? or sw.savings_goal_id = ?)`
+					where (sc.savings_goal_id = ? or sw.savings_goal_id = ?)
+					  and tr.source_account_id IN (` + householdAccountsQuery + `)`
 
 	groupBy := ` group by tr.id, sc.id, sw.id `
 	orderBy := ` order by tr.created_at DESC `
@@ -663,10 +722,15 @@ func (s SavingGoalRepoImpl) ListSavingsTransactions(
 		[]any,
 		0,
 	)
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	args = append(
 		args,
 		savingGoalID,
 		savingGoalID,
+		household,
 	)
 
 	if params.StartDate != nil && params.EndDate != nil {
@@ -0,0 +1,395 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+const (
+	fullTextMatch     = "MATCH(%s) AGAINST (? IN NATURAL LANGUAGE MODE)"
+	tagNamesSeparator = "\n"
+)
+
+type SearchRepo struct {
+	db *sql.DB
+}
+
+func NewSearchRepo(db *sql.DB) port.SearchRepo {
+	return &SearchRepo{db: db}
+}
+
+func (r *SearchRepo) Search(
+	ctx context.Context,
+	params domain.SearchParams,
+) (
+	*domain.SearchResult,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hitsQuery, args := r.buildHitsQuery(
+		household,
+		params,
+	)
+	if hitsQuery == "" {
+		return &domain.SearchResult{
+			Hits: []domain.SearchHit{},
+			Metadata: domain.ListMetadata{
+				Limit:  params.Limit,
+				Offset: params.Offset,
+			},
+		}, nil
+	}
+
+	var count int
+	err = r.db.QueryRowContext(
+		ctx,
+		"SELECT COUNT(*) FROM ("+hitsQuery+") hits WHERE hits.score > 0", //nolint:gosec // static strings injected here only
+		args...,
+	).Scan(&count)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	rows, err := r.db.QueryContext(
+		ctx,
+		"SELECT * FROM ("+hitsQuery+") hits WHERE hits.score > 0"+ //nolint:gosec // static strings injected here only
+			" ORDER BY hits.score DESC, hits.transaction_date DESC, hits.hit_type, hits.id LIMIT ? OFFSET ?",
+		append(
+			args,
+			params.Limit,
+			params.Offset,
+		)...,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	hits := make(
+		[]domain.SearchHit,
+		0,
+	)
+	for rows.Next() {
+		hit, errScan := r.scanHit(rows)
+		if errScan != nil {
+			return nil, errScan
+		}
+		hits = append(
+			hits,
+			hit,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return &domain.SearchResult{
+		Hits: hits,
+		Metadata: domain.ListMetadata{
+			Total:  count,
+			Limit:  params.Limit,
+			Offset: params.Offset,
+		},
+	}, nil
+}
+
+// buildHitsQuery unions one ranked sub-select per requested entity type. Every sub-select
+// exposes the same columns so the outer query can rank and paginate across entities.
+func (r *SearchRepo) buildHitsQuery(
+	household string,
+	params domain.SearchParams,
+) (
+	string,
+	[]any,
+) {
+	var selects []string
+	var args []any
+
+	if params.IncludesType(domain.SearchHitTypeExpenditure) {
+		query, queryArgs := r.expenditureHits(
+			household,
+			params,
+		)
+		selects = append(
+			selects,
+			query,
+		)
+		args = append(
+			args,
+			queryArgs...,
+		)
+	}
+	if params.IncludesType(domain.SearchHitTypeIngress) {
+		query, queryArgs := r.ingressHits(
+			household,
+			params,
+		)
+		selects = append(
+			selects,
+			query,
+		)
+		args = append(
+			args,
+			queryArgs...,
+		)
+	}
+	if params.IncludesType(domain.SearchHitTypeTransfer) {
+		query, queryArgs := r.transferHits(
+			household,
+			params,
+		)
+		selects = append(
+			selects,
+			query,
+		)
+		args = append(
+			args,
+			queryArgs...,
+		)
+	}
+
+	return strings.Join(
+		selects,
+		" UNION ALL ",
+	), args
+}
+
+func (r *SearchRepo) expenditureHits(
+	household string,
+	params domain.SearchParams,
+) (
+	string,
+	[]any,
+) {
+	tagNames, tagScore := r.tagColumns(
+		"expenditure_tags",
+		"expenditure_id",
+		"e.id",
+	)
+	query := `SELECT CAST(e.id AS CHAR) AS id,
+                     'expenditure'     AS hit_type,
+                     t.transaction_date,
+                     t.amount,
+                     t.currency,
+                     t.account_id,
+                     t.description,
+                     NULL              AS source,
+                     c.name            AS category_name,
+                     ` + tagNames + ` AS tag_names,
+                     ` + fmt.Sprintf(fullTextMatch, "t.description") + ` +
+                     ` + fmt.Sprintf(fullTextMatch, "c.name") + ` +
+                     ` + tagScore + ` AS score
+              FROM expenditures e
+                       INNER JOIN transactions t ON e.transaction_id = t.id
+                       INNER JOIN categories c ON e.category_id = c.id`
+	args := []any{
+		params.Query,
+		params.Query,
+		params.Query,
+	}
+
+	return r.withFilters(
+		query,
+		args,
+		household,
+		params,
+	)
+}
+
+func (r *SearchRepo) ingressHits(
+	household string,
+	params domain.SearchParams,
+) (
+	string,
+	[]any,
+) {
+	tagNames, tagScore := r.tagColumns(
+		"ingress_tags",
+		"ingress_id",
+		"i.id",
+	)
+	query := `SELECT CAST(i.id AS CHAR) AS id,
+                     'ingress'         AS hit_type,
+                     t.transaction_date,
+                     t.amount,
+                     t.currency,
+                     t.account_id,
+                     t.description,
+                     i.source,
+                     c.name            AS category_name,
+                     ` + tagNames + ` AS tag_names,
+                     ` + fmt.Sprintf(fullTextMatch, "t.description") + ` +
+                     COALESCE(` + fmt.Sprintf(fullTextMatch, "i.source") + `, 0) +
+                     ` + fmt.Sprintf(fullTextMatch, "c.name") + ` +
+                     ` + tagScore + ` AS score
+              FROM ingresses i
+                       INNER JOIN transactions t ON i.transaction_id = t.id
+                       INNER JOIN categories c ON i.category_id = c.id`
+	args := []any{
+		params.Query,
+		params.Query,
+		params.Query,
+		params.Query,
+	}
+
+	return r.withFilters(
+		query,
+		args,
+		household,
+		params,
+	)
+}
+
+func (r *SearchRepo) transferHits(
+	household string,
+	params domain.SearchParams,
+) (
+	string,
+	[]any,
+) {
+	query := `SELECT CAST(tr.id AS CHAR) AS id,
+                     'transfer'         AS hit_type,
+                     t.transaction_date,
+                     t.amount,
+                     t.currency,
+                     t.account_id,
+                     t.description,
+                     NULL               AS source,
+                     NULL               AS category_name,
+                     NULL               AS tag_names,
+                     ` + fmt.Sprintf(fullTextMatch, "t.description") + ` AS score
+              FROM transfers tr
+                       INNER JOIN transactions t ON tr.outgoing_transaction_id = t.id`
+	args := []any{
+		params.Query,
+	}
+
+	return r.withFilters(
+		query,
+		args,
+		household,
+		params,
+	)
+}
+
+// tagColumns returns the aggregated tag names and the best tag match score of an entity
+func (r *SearchRepo) tagColumns(
+	junctionTable, junctionColumn, entityColumn string,
+) (
+	names string,
+	score string,
+) {
+	from := fmt.Sprintf(
+		" FROM %s jt INNER JOIN tags tg ON tg.id = jt.tag_id WHERE jt.%s = %s",
+		junctionTable,
+		junctionColumn,
+		entityColumn,
+	)
+	names = "(SELECT GROUP_CONCAT(tg.name ORDER BY tg.name SEPARATOR '\\n')" + from + ")"
+	score = "COALESCE((SELECT MAX(" + fmt.Sprintf(
+		fullTextMatch,
+		"tg.name",
+	) + ")" + from + "), 0)"
+
+	return names, score
+}
+
+// withFilters restricts a sub-select to the household's accounts and the requested dates
+func (r *SearchRepo) withFilters(
+	query string,
+	args []any,
+	household string,
+	params domain.SearchParams,
+) (
+	string,
+	[]any,
+) {
+	whereClause := []string{"t.account_id IN (" + householdAccountsQuery + ")"}
+	args = append(
+		args,
+		household,
+	)
+	if params.StartDate != nil {
+		whereClause = append(
+			whereClause,
+			"t.transaction_date >= ?",
+		)
+		args = append(
+			args,
+			*params.StartDate,
+		)
+	}
+	if params.EndDate != nil {
+		whereClause = append(
+			whereClause,
+			"t.transaction_date < DATE_ADD(?, INTERVAL 1 DAY)",
+		)
+		args = append(
+			args,
+			*params.EndDate,
+		)
+	}
+	query += " WHERE " + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	)
+
+	return query, args
+}
+
+func (r *SearchRepo) scanHit(rows *sql.Rows) (
+	domain.SearchHit,
+	error,
+) {
+	var hit domain.SearchHit
+	var hitType string
+	var description, source, categoryName, tagNames sql.NullString
+
+	err := rows.Scan(
+		&hit.ID,
+		&hitType,
+		&hit.Date,
+		&hit.Amount,
+		&hit.Currency,
+		&hit.AccountID,
+		&description,
+		&source,
+		&categoryName,
+		&tagNames,
+		&hit.Score,
+	)
+	if err != nil {
+		return hit, fmt.Errorf(
+			"failed to scan search hit: %w",
+			err,
+		)
+	}
+
+	hit.Type = domain.SearchHitType(hitType)
+	if description.Valid {
+		hit.Description = &description.String
+	}
+	if source.Valid {
+		hit.Source = &source.String
+	}
+	if categoryName.Valid {
+		hit.CategoryName = &categoryName.String
+	}
+	if tagNames.Valid && tagNames.String != "" {
+		hit.TagNames = strings.Split(
+			tagNames.String,
+			tagNamesSeparator,
+		)
+	}
+
+	return hit, nil
+}
@@ -0,0 +1,74 @@
+package mysql
+
+import (
+	"fmt"
+	"strings"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+// buildOrderByClause translates the requested sort orders into an ORDER BY clause using
+// the repository whitelist of API keys to columns. Unknown keys are rejected so no
+// user input ever reaches the query text. The tie breaker keeps pagination stable.
+func buildOrderByClause(
+	orders []domain.SortOrder,
+	columns map[string]string,
+	defaultOrder []domain.SortOrder,
+	tieBreaker string,
+) (
+	string,
+	error,
+) {
+	if len(orders) == 0 {
+		orders = defaultOrder
+	}
+
+	clauses := make(
+		[]string,
+		0,
+		len(orders)+1,
+	)
+
+	for _, order := range orders {
+		column, ok := columns[order.Key]
+		if !ok {
+			return "", fmt.Errorf(
+				"%w: %s",
+				domain.ErrInvalidSortKey,
+				order.Key,
+			)
+		}
+
+		direction := "ASC"
+		if order.Descending {
+			direction = "DESC"
+		}
+
+		clauses = append(
+			clauses,
+			column+" "+direction,
+		)
+	}
+
+	clauses = append(
+		clauses,
+		tieBreaker+" ASC",
+	)
+
+	return " ORDER BY " + strings.Join(
+		clauses,
+		", ",
+	), nil
+}
+
+// appliedSortExpression returns the sort expression reported back in the list metadata
+func appliedSortExpression(
+	orders []domain.SortOrder,
+	defaultOrder []domain.SortOrder,
+) string {
+	if len(orders) == 0 {
+		return domain.FormatSortOrders(defaultOrder)
+	}
+
+	return domain.FormatSortOrders(orders)
+}
@@ -29,10 +29,15 @@ func (t TagsRepoImpl) Create(
 	string,
 	error,
 ) {
-	queryInsert := `INSERT INTO tags (name, description, color, background_color, type, created_at) VALUES (?,?,?,?,?, now())`
+	household, err := householdID(ctx)
+	if err != nil {
+		return "", err
+	}
+	queryInsert := `INSERT INTO tags (household_id, name, description, color, background_color, type, created_at) VALUES (?,?,?,?,?,?, now())`
 	result, errInsert := t.db.ExecContext(
 		ctx,
 		queryInsert,
+		household,
 		tag.Name,
 		tag.Description,
 		tag.Color,
@@ -65,15 +70,20 @@ func (t TagsRepoImpl) GetByNameAndType(
 	*domain.Tag,
 	error,
 ) {
-	querySelect := `SELECT id, name, description, color, background_color, type FROM tags WHERE name=? AND type=?`
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	querySelect := `SELECT id, name, description, color, background_color, type FROM tags WHERE name=? AND type=? AND household_id=?`
 	row := t.db.QueryRowContext(
 		ctx,
 		querySelect,
 		name,
 		tagType,
+		household,
 	)
 	var tag domain.Tag
-	err := row.Scan(
+	err = row.Scan(
 		&tag.ID,
 		&tag.Name,
 		&tag.Description,
@@ -93,13 +103,18 @@ func (t TagsRepoImpl) GetByNameAndType(
 
 	return &tag, nil
 }
+
 func (t TagsRepoImpl) Update(
 	ctx context.Context,
 	id string,
 	tag domain.Tag,
 ) error {
-	queryUpdate := `UPDATE tags SET name=?, description=?, color=?, background_color=?, type=? WHERE id=?`
-	_, err := t.db.ExecContext(
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	queryUpdate := `UPDATE tags SET name=?, description=?, color=?, background_color=?, type=? WHERE id=? AND household_id=?`
+	_, err = t.db.ExecContext(
 		ctx,
 		queryUpdate,
 		tag.Name,
@@ -108,6 +123,7 @@ func (t TagsRepoImpl) Update(
 		tag.BackgroundColor,
 		tag.TagType,
 		id,
+		household,
 	)
 	if err != nil {
 		return translateError(err)
@@ -169,12 +185,17 @@ func (t TagsRepoImpl) GetByID(
 	*domain.Tag,
 	error,
 ) {
-	query := `SELECT id, name, description, color, background_color, type FROM tags WHERE id=?`
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT id, name, description, color, background_color, type FROM tags WHERE id=? AND household_id=?`
 	var tag domain.Tag
-	err := t.db.QueryRowContext(
+	err = t.db.QueryRowContext(
 		ctx,
 		query,
 		id,
+		household,
 	).Scan(
 		&tag.ID,
 		&tag.Name,
@@ -197,7 +218,11 @@ func (t TagsRepoImpl) GetByIDs(
 	*[]*domain.Tag,
 	error,
 ) {
-	query := `SELECT id, name, description, color, background_color, type FROM tags WHERE id IN (?)`
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT id, name, description, color, background_color, type FROM tags WHERE id IN (?) AND household_id=?`
 	strIDs := strings.Join(
 		ids,
 		",",
@@ -206,6 +231,7 @@ func (t TagsRepoImpl) GetByIDs(
 		ctx,
 		query,
 		strIDs,
+		household,
 	)
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -243,11 +269,16 @@ func (t TagsRepoImpl) List(
 	*[]*domain.Tag,
 	error,
 ) {
-	query := `SELECT id, name, description, color, background_color, type FROM tags`
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := `SELECT id, name, description, color, background_color, type FROM tags WHERE household_id=?`
 	var tags []*domain.Tag
 	res, err := t.db.QueryContext(
 		ctx,
 		query,
+		household,
 	)
 	if err != nil {
 		return nil, translateError(err)
@@ -283,12 +314,10 @@ func (t TagsRepoImpl) ListByType(
 	*[]*domain.Tag,
 	error,
 ) {
-	// We'll assume that all the tags are the same type
-	junctionTable, junctionForeignKey, err := t.getJunctionTableByType(tagType)
+	household, err := householdID(ctx)
 	if err != nil {
 		return nil, err
 	}
-
 	query :=
 		`select 
 				t.id,
@@ -298,31 +327,48 @@ func (t TagsRepoImpl) ListByType(
 				t.background_color,
 				t.type
 			from tags t
-			where t.type =?`
+			where t.type =? and t.household_id =?`
+	args := []any{tagType, household}
 
 	if ids != nil {
+		if len(*ids) == 0 {
+			return &[]*domain.Tag{}, nil
+		}
+		// We'll assume that all the tags are the same type
+		junctionTable, junctionForeignKey, err := t.getJunctionTableByType(tagType)
+		if err != nil {
+			return nil, err
+		}
+		//nolint:gosec // static strings injected here only
 		query += fmt.Sprintf(
 			` AND exists (
 				select 1
 				from %s jt
 				where jt.tag_id = t.id
+				  and jt.%s IN (%s)
 				)`,
 			*junctionTable,
-		)
-		query += fmt.Sprintf(
-			` and jt.%s IN (%s)`,
 			*junctionForeignKey,
-			strings.Join(
-				*ids,
+			strings.TrimSuffix(
+				strings.Repeat(
+					"?,",
+					len(*ids),
+				),
 				",",
 			),
 		)
+		for _, id := range *ids {
+			args = append(
+				args,
+				id,
+			)
+		}
 	}
 	query += " order by t.id"
 	rows, err := t.db.QueryContext(
 		ctx,
 		query,
-		tagType,
+		args...,
 	)
 	if err != nil {
 		return nil, translateError(err)
@@ -351,6 +397,131 @@ func (t TagsRepoImpl) ListByType(
 	return &tags, nil
 }
 
+// tagJunctions are the tables linking tags to other records, with the column of the linked record
+var tagJunctions = []struct {
+	table      string
+	foreignKey string
+}{
+	{
+		table:      "expenditure_tags",
+		foreignKey: "expenditure_id",
+	},
+	{
+		table:      "ingress_tags",
+		foreignKey: "ingress_id",
+	},
+	{
+		table:      "savings_goal_tags",
+		foreignKey: "savings_goal_id",
+	},
+	{
+		table:      "savings_contribution_tags",
+		foreignKey: "contribution_id",
+	},
+	{
+		table:      "savings_withdrawal_tags",
+		foreignKey: "withdrawal_id",
+	},
+	{
+		table:      "categorization_rule_tags",
+		foreignKey: "rule_id",
+	},
+}
+
+// Merge relinks every record tagged with the source to the target and deletes the source, all in one
+// transaction. Records tagged with both keep a single link to the target.
+func (t TagsRepoImpl) Merge(
+	ctx context.Context,
+	sourceID string,
+	targetID string,
+) (
+	int64,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := t.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return 0, translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	var exists bool
+	err = tx.QueryRowContext(
+		ctx,
+		`SELECT TRUE FROM tags WHERE id = ? AND household_id = ? FOR UPDATE`,
+		sourceID,
+		household,
+	).Scan(&exists)
+	if err != nil {
+		return 0, translateError(err)
+	}
+
+	var moved int64
+	for _, junction := range tagJunctions {
+		//nolint:gosec // static strings injected here only
+		queryDeleteTagged := fmt.Sprintf(
+			`DELETE s
+			FROM %[1]s s
+					 INNER JOIN %[1]s t ON t.%[2]s = s.%[2]s AND t.tag_id = ?
+			WHERE s.tag_id = ?`,
+			junction.table,
+			junction.foreignKey,
+		)
+		_, err = tx.ExecContext(
+			ctx,
+			queryDeleteTagged,
+			targetID,
+			sourceID,
+		)
+		if err != nil {
+			return 0, translateError(err)
+		}
+
+		//nolint:gosec // static strings injected here only
+		queryMove := fmt.Sprintf(
+			`UPDATE %s SET tag_id = ? WHERE tag_id = ?`,
+			junction.table,
+		)
+		result, errExec := tx.ExecContext(
+			ctx,
+			queryMove,
+			targetID,
+			sourceID,
+		)
+		if errExec != nil {
+			return 0, translateError(errExec)
+		}
+		rowsAffected, errRowsAffected := result.RowsAffected()
+		if errRowsAffected != nil {
+			return 0, translateError(errRowsAffected)
+		}
+		moved += rowsAffected
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`DELETE FROM tags WHERE id = ?`,
+		sourceID,
+	)
+	if err != nil {
+		return 0, translateError(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, translateError(err)
+	}
+
+	return moved, nil
+}
+
 func (t TagsRepoImpl) LinkTagsToType(
 	ctx context.Context,
 	foreignID string,
@@ -400,6 +571,232 @@ func (t TagsRepoImpl) LinkTagsToType(
 	return nil
 }
 
+// taggedRecordTables are the tables of the records each tag type is linked to, with the condition tying
+// them to the household ?
+var taggedRecordTables = map[domain.TagType]struct {
+	table     string
+	household string
+}{
+	domain.TagTypeExpenditure: {
+		table:     "expenditures",
+		household: "category_id IN (" + householdCategoriesQuery + ")",
+	},
+	domain.TagTypeIngress: {
+		table:     "ingresses",
+		household: "category_id IN (" + householdCategoriesQuery + ")",
+	},
+	domain.TagTypeSavingsGoal: {
+		table:     "savings_goals",
+		household: "account_id IN (" + householdAccountsQuery + ")",
+	},
+	domain.TagTypeSavingsContribution: {
+		table:     "savings_contributions",
+		household: "savings_goal_id IN (" + householdSavingsGoalsQuery + ")",
+	},
+	domain.TagTypeSavingsWithdrawal: {
+		table:     "savings_withdrawals",
+		household: "savings_goal_id IN (" + householdSavingsGoalsQuery + ")",
+	},
+}
+
+func (t TagsRepoImpl) RecordExists(
+	ctx context.Context,
+	tagType domain.TagType,
+	recordID string,
+) (
+	bool,
+	error,
+) {
+	records, ok := taggedRecordTables[tagType]
+	if !ok {
+		return false, domain.ErrUnknownTagType
+	}
+	household, err := householdID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var exists bool
+	//nolint:gosec // static strings injected here only
+	err = t.db.QueryRowContext(
+		ctx,
+		fmt.Sprintf(
+			`SELECT EXISTS(SELECT 1 FROM %s WHERE id = ? AND %s)`,
+			records.table,
+			records.household,
+		),
+		recordID,
+		household,
+	).Scan(&exists)
+	if err != nil {
+		return false, translateError(err)
+	}
+
+	return exists, nil
+}
+
+// SetRecordTags replaces the tags of a record in one transaction
+func (t TagsRepoImpl) SetRecordTags(
+	ctx context.Context,
+	tagType domain.TagType,
+	recordID string,
+	tagIDs []string,
+) error {
+	junctionTable, junctionForeignKey, err := t.getJunctionTableByType(tagType)
+	if err != nil {
+		return err
+	}
+
+	tx, err := t.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	//nolint:gosec // static strings injected here only
+	_, err = tx.ExecContext(
+		ctx,
+		fmt.Sprintf(
+			`DELETE FROM %s WHERE %s = ?`,
+			*junctionTable,
+			*junctionForeignKey,
+		),
+		recordID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	err = addRecordTags(
+		ctx,
+		tx,
+		*junctionTable,
+		*junctionForeignKey,
+		recordID,
+		tagIDs,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
+// AddRecordTags links the tags to a record, tags already linked are left as they are
+func (t TagsRepoImpl) AddRecordTags(
+	ctx context.Context,
+	tagType domain.TagType,
+	recordID string,
+	tagIDs []string,
+) error {
+	junctionTable, junctionForeignKey, err := t.getJunctionTableByType(tagType)
+	if err != nil {
+		return err
+	}
+
+	tx, err := t.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	err = addRecordTags(
+		ctx,
+		tx,
+		*junctionTable,
+		*junctionForeignKey,
+		recordID,
+		tagIDs,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
+// RemoveRecordTag unlinks the tag from a record, nothing happens when it is not linked
+func (t TagsRepoImpl) RemoveRecordTag(
+	ctx context.Context,
+	tagType domain.TagType,
+	recordID string,
+	tagID string,
+) error {
+	junctionTable, junctionForeignKey, err := t.getJunctionTableByType(tagType)
+	if err != nil {
+		return err
+	}
+
+	//nolint:gosec // static strings injected here only
+	_, err = t.db.ExecContext(
+		ctx,
+		fmt.Sprintf(
+			`DELETE FROM %s WHERE %s = ? AND tag_id = ?`,
+			*junctionTable,
+			*junctionForeignKey,
+		),
+		recordID,
+		tagID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
+// addRecordTags inserts the links missing between the record and the tags. Not every junction table has a
+// unique key, so existing links are skipped explicitly.
+func addRecordTags(
+	ctx context.Context,
+	tx *sql.Tx,
+	junctionTable string,
+	junctionForeignKey string,
+	recordID string,
+	tagIDs []string,
+) error {
+	//nolint:gosec // static strings injected here only
+	queryInsert := fmt.Sprintf(
+		`INSERT INTO %[1]s (tag_id, %[2]s)
+		SELECT ?, ?
+		FROM DUAL
+		WHERE NOT EXISTS (SELECT 1 FROM %[1]s WHERE tag_id = ? AND %[2]s = ?)`,
+		junctionTable,
+		junctionForeignKey,
+	)
+	for _, tagID := range tagIDs {
+		_, err := tx.ExecContext(
+			ctx,
+			queryInsert,
+			tagID,
+			recordID,
+			tagID,
+			recordID,
+		)
+		if err != nil {
+			return translateError(err)
+		}
+	}
+
+	return nil
+}
+
 func (t TagsRepoImpl) getJunctionTableByType(tagType domain.TagType) (
 	jtable *string,
 	fk *string,
@@ -418,11 +815,11 @@ func (t TagsRepoImpl) getJunctionTableByType(tagType domain.TagType) (
 		junctionTable = "savings_goal_tags"
 		foreignKey = "savings_goal_id"
 	case "savings_withdrawal":
-		junctionTable = "saving_withdrawal_tags"
-		foreignKey = "saving_withdrawal_id"
+		junctionTable = "savings_withdrawal_tags"
+		foreignKey = "withdrawal_id"
 	case "savings_contribution":
 		junctionTable = "savings_contribution_tags"
-		foreignKey = "savings_contribution_id"
+		foreignKey = "contribution_id"
 	default:
 		return nil, nil, domain.ErrUnknownTagType
 	}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"strconv"
+	"time"
 
 	"ghorkov32/proletariat-budget-be/internal/core/domain"
 	"ghorkov32/proletariat-budget-be/internal/core/port"
@@ -34,11 +35,15 @@ func (t TransactionRepoImpl) Create(
 					(account_id,
 					 amount,
 					 currency,
+					 original_amount,
+					 original_currency,
+					 exchange_rate,
 					 transaction_date,
 					 description,
 					 transaction_type,
 					 balance_after, 
-					 status)
+					 status,
+					 failure_reason)
 					VALUES (?,
 							?,
 							?,
@@ -46,6 +51,10 @@ func (t TransactionRepoImpl) Create(
 							?,
 							?,
 							?,
+							?,
+							?,
+							?,
+							?,
 							?)`
 	result, errInsert := t.db.ExecContext(
 		ctx,
@@ -53,11 +62,15 @@ func (t TransactionRepoImpl) Create(
 		transaction.AccountID,
 		transaction.Amount,
 		transaction.Currency,
+		transaction.OriginalAmount,
+		transaction.OriginalCurrency,
+		transaction.ExchangeRate,
 		transaction.TransactionDate,
 		transaction.Description,
 		transaction.TransactionType,
 		transaction.BalanceAfter,
 		transaction.Status,
+		transaction.FailureReason,
 	)
 	if errInsert != nil {
 		return "", translateError(errInsert)
@@ -80,31 +93,46 @@ func (t TransactionRepoImpl) GetByID(
 	*domain.Transaction,
 	error,
 ) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	querySelect := `SELECT id, 
 					   account_id, 
 					   amount, 
 					   currency, 
+					   original_amount,
+					   original_currency,
+					   exchange_rate,
 					   transaction_date, 
 					   description, 
 					   transaction_type, 
 					   balance_after, 
-					   status 
-					FROM transactions WHERE id=?`
+					   status,
+					   failure_reason,
+					   created_at
+					FROM transactions WHERE id=? AND account_id IN (` + householdAccountsQuery + `)`
 	var transaction domain.Transaction
-	err := t.db.QueryRowContext(
+	err = t.db.QueryRowContext(
 		ctx,
 		querySelect,
 		id,
+		household,
 	).Scan(
 		&transaction.ID,
 		&transaction.AccountID,
 		&transaction.Amount,
 		&transaction.Currency,
+		&transaction.OriginalAmount,
+		&transaction.OriginalCurrency,
+		&transaction.ExchangeRate,
 		&transaction.TransactionDate,
 		&transaction.Description,
 		&transaction.TransactionType,
 		&transaction.BalanceAfter,
 		&transaction.Status,
+		&transaction.FailureReason,
+		&transaction.CreatedAt,
 	)
 	if errors.Is(
 		err,
@@ -119,6 +147,330 @@ func (t TransactionRepoImpl) GetByID(
 	return &transaction, nil
 }
 
+func (t TransactionRepoImpl) ListDuePending(
+	ctx context.Context,
+	transactionType domain.TransactionType,
+	dueAt time.Time,
+) (
+	[]domain.Transaction,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	querySelect := `SELECT id,
+					   account_id,
+					   amount,
+					   currency,
+					   original_amount,
+					   original_currency,
+					   exchange_rate,
+					   transaction_date,
+					   description,
+					   transaction_type,
+					   balance_after,
+					   status,
+					   failure_reason,
+					   created_at
+					FROM transactions
+					WHERE status = ?
+					  AND transaction_type = ?
+					  AND transaction_date <= ?
+					  AND account_id IN (` + householdAccountsQuery + `)
+					ORDER BY transaction_date, id`
+	rows, err := t.db.QueryContext(
+		ctx,
+		querySelect,
+		domain.TransactionStatusPending,
+		transactionType,
+		dueAt,
+		household,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer rows.Close()
+
+	transactions := make(
+		[]domain.Transaction,
+		0,
+	)
+	for rows.Next() {
+		var transaction domain.Transaction
+		errScan := rows.Scan(
+			&transaction.ID,
+			&transaction.AccountID,
+			&transaction.Amount,
+			&transaction.Currency,
+			&transaction.OriginalAmount,
+			&transaction.OriginalCurrency,
+			&transaction.ExchangeRate,
+			&transaction.TransactionDate,
+			&transaction.Description,
+			&transaction.TransactionType,
+			&transaction.BalanceAfter,
+			&transaction.Status,
+			&transaction.FailureReason,
+			&transaction.CreatedAt,
+		)
+		if errScan != nil {
+			return nil, translateError(errScan)
+		}
+		transactions = append(
+			transactions,
+			transaction,
+		)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return transactions, nil
+}
+
+func (t TransactionRepoImpl) UpdateStatus(
+	ctx context.Context,
+	transaction domain.Transaction,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+	queryUpdate := `UPDATE transactions
+					SET status = ?,
+						balance_after = ?,
+						failure_reason = ?
+					WHERE id = ?
+					  AND status = ?
+					  AND account_id IN (` + householdAccountsQuery + `)`
+	result, err := t.db.ExecContext(
+		ctx,
+		queryUpdate,
+		transaction.Status,
+		transaction.BalanceAfter,
+		transaction.FailureReason,
+		transaction.ID,
+		domain.TransactionStatusPending,
+		household,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return translateError(err)
+	}
+	if affected == 0 {
+		return port.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (t TransactionRepoImpl) Debit(
+	ctx context.Context,
+	transaction domain.Transaction,
+) (
+	*domain.Transaction,
+	error,
+) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := t.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	account, err := lockAccountBalance(
+		ctx,
+		tx,
+		transaction.AccountID,
+		household,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !account.HasSufficientBalance(transaction.Amount) {
+		return nil, domain.ErrInsufficientBalance
+	}
+	account.DebitBalance(transaction.Amount)
+	transaction.Complete(account.CurrentBalance)
+
+	result, err := tx.ExecContext(
+		ctx,
+		`INSERT INTO transactions
+			(account_id, amount, currency, original_amount, original_currency, exchange_rate, transaction_date,
+			 description, transaction_type, balance_after, status, failure_reason)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		transaction.AccountID,
+		transaction.Amount,
+		transaction.Currency,
+		transaction.OriginalAmount,
+		transaction.OriginalCurrency,
+		transaction.ExchangeRate,
+		transaction.TransactionDate,
+		transaction.Description,
+		transaction.TransactionType,
+		transaction.BalanceAfter,
+		transaction.Status,
+		transaction.FailureReason,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	lastID, err := result.LastInsertId()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	id := strconv.FormatInt(
+		lastID,
+		10,
+	)
+	transaction.ID = &id
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE accounts SET current_balance = current_balance - ?, updated_at = ? WHERE id = ?`,
+		transaction.Amount,
+		time.Now(),
+		transaction.AccountID,
+	)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, translateError(err)
+	}
+
+	return &transaction, nil
+}
+
+// lockAccountBalance reads the balance of an account of the household and locks its row until the
+// transaction ends, so concurrent updates of the balance are applied one after the other and none is lost
+func lockAccountBalance(
+	ctx context.Context,
+	tx *sql.Tx,
+	accountID string,
+	household string,
+) (
+	*domain.Account,
+	error,
+) {
+	var account domain.Account
+	err := tx.QueryRowContext(
+		ctx,
+		`SELECT current_balance FROM accounts WHERE id = ? AND household_id = ? FOR UPDATE`,
+		accountID,
+		household,
+	).Scan(&account.CurrentBalance)
+	if err != nil {
+		if errors.Is(
+			err,
+			sql.ErrNoRows,
+		) {
+			return nil, domain.ErrAccountNotFound
+		}
+
+		return nil, translateError(err)
+	}
+
+	return &account, nil
+}
+
+func (t TransactionRepoImpl) Post(
+	ctx context.Context,
+	transaction domain.Transaction,
+) error {
+	household, err := householdID(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := t.db.BeginTx(
+		ctx,
+		nil,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	defer tx.Rollback() //nolint:errcheck // rollback after commit is a no-op
+
+	account, err := lockAccountBalance(
+		ctx,
+		tx,
+		transaction.AccountID,
+		household,
+	)
+	if err != nil {
+		return err
+	}
+	// Refunds credit the account, everything else is debited from it
+	change := transaction.Amount
+	if transaction.TransactionType == domain.TransactionTypeRefund {
+		account.CreditBalance(transaction.Amount)
+		change = -transaction.Amount
+	} else {
+		if !account.HasSufficientBalance(transaction.Amount) {
+			return domain.ErrInsufficientBalance
+		}
+		account.DebitBalance(transaction.Amount)
+	}
+	transaction.Complete(account.CurrentBalance)
+
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE transactions
+		SET status = ?,
+			balance_after = ?,
+			failure_reason = NULL
+		WHERE id = ?
+		  AND account_id = ?
+		  AND status = ?`,
+		transaction.Status,
+		transaction.BalanceAfter,
+		transaction.ID,
+		transaction.AccountID,
+		domain.TransactionStatusPending,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return translateError(err)
+	}
+	if affected == 0 {
+		return port.ErrRecordNotFound
+	}
+
+	_, err = tx.ExecContext(
+		ctx,
+		`UPDATE accounts SET current_balance = current_balance - ?, updated_at = ? WHERE id = ?`,
+		change,
+		time.Now(),
+		transaction.AccountID,
+	)
+	if err != nil {
+		return translateError(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return translateError(err)
+	}
+
+	return nil
+}
+
 func (t TransactionRepoImpl) List(
 	ctx context.Context,
 	params openapi.ListTransactionsParams,
@@ -65,6 +65,11 @@ func (t TransferRepoImpl) GetByID(
 	*openapi.Transfer,
 	error,
 ) {
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	query := `select tr.created_at,
 					   tinc.description,
 					   tr.destination_account_id,
@@ -80,14 +85,16 @@ func (t TransferRepoImpl) GetByID(
 				from transfers tr
 						 inner join transactions tinc on tr.incoming_transaction_id = tinc.id
 						 inner join transactions tout on tr.outgoing_transaction_id = tout.id
-				where tr.id = ?`
+				where tr.id = ?
+				  and tr.source_account_id in (` + householdAccountsQuery + `)`
 	row := t.db.QueryRowContext(
 		ctx,
 		query,
 		id,
+		household,
 	)
 	var transfer openapi.Transfer
-	err := row.Scan(
+	err = row.Scan(
 		&transfer.Date,
 		&transfer.Description,
 		&transfer.DestinationAccountId,
@@ -130,16 +137,14 @@ func (t TransferRepoImpl) List(
 				from transfers tr
 						 inner join transactions tinc on tr.incoming_transaction_id = tinc.id
 						 inner join transactions tout on tr.outgoing_transaction_id = tout.id`
-	countQuery := `SELECT COUNT(*) FROM transfers`
+	countQuery := `SELECT COUNT(*) FROM transfers tr`
 
-	whereClause := make(
-		[]string,
-		0,
-	)
-	args := make(
-		[]any,
-		0,
-	)
+	household, err := householdID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	whereClause := []string{"tr.source_account_id IN (" + householdAccountsQuery + ")"}
+	args := []any{household}
 
 	if params.SourceAccountId != nil {
 		whereClause = append(
@@ -197,16 +202,14 @@ func (t TransferRepoImpl) List(
 		params.Offset,
 	)
 
-	if len(whereClause) > 0 {
-		selectQuery += " WHERE " + strings.Join(
-			whereClause,
-			AND_CLAUSE,
-		)
-		countQuery += " WHERE " + strings.Join(
-			whereClause,
-			AND_CLAUSE,
-		)
-	}
+	selectQuery += " WHERE " + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	)
+	countQuery += " WHERE " + strings.Join(
+		whereClause,
+		AND_CLAUSE,
+	)
 	query := selectQuery + limitOffset
 	countQuery += limitOffset
 
@@ -1,10 +1,10 @@
 package middleware
 
 import (
-	"context"
 	"net/http"
 	"strings"
 
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
 	"ghorkov32/proletariat-budget-be/internal/core/usecase"
 )
 
@@ -12,17 +12,33 @@ type AuthMiddleware struct {
 	authUseCase *usecase.AuthUseCase
 }
 
-const (
-	USER_CONTEXT_KEY = "user"
-)
-
 func NewAuthMiddleware(authUseCase *usecase.AuthUseCase) *AuthMiddleware {
 	return &AuthMiddleware{
 		authUseCase: authUseCase,
 	}
 }
 
+// Authenticate refuses the requests without a valid bearer token
 func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
+	return m.authenticate(
+		next,
+		true,
+	)
+}
+
+// Identify adds the user of the bearer token to the request context when there is one, requests
+// without an Authorization header go through anonymously and the operations needing a user refuse them
+func (m *AuthMiddleware) Identify(next http.Handler) http.Handler {
+	return m.authenticate(
+		next,
+		false,
+	)
+}
+
+func (m *AuthMiddleware) authenticate(
+	next http.Handler,
+	required bool,
+) http.Handler {
 	return http.HandlerFunc(
 		func(
 			w http.ResponseWriter,
@@ -31,6 +47,14 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 			// Extract token from Authorization header
 			authHeader := r.Header.Get("Authorization")
 			if authHeader == "" {
+				if !required {
+					next.ServeHTTP(
+						w,
+						r,
+					)
+
+					return
+				}
 				http.Error(
 					w,
 					"Unauthorized",
@@ -72,17 +96,15 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 				return
 			}
 
-			// Add user to context
-			ctx := context.WithValue(
-				r.Context(),
-				USER_CONTEXT_KEY,
-				user,
-			)
-
 			// Call the next handler with the authenticated context
 			next.ServeHTTP(
 				w,
-				r.WithContext(ctx),
+				r.WithContext(
+					domain.WithUser(
+						r.Context(),
+						user,
+					),
+				),
 			)
 		},
 	)
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+)
+
+type AuthorizationMiddleware struct{}
+
+func NewAuthorizationMiddleware() *AuthorizationMiddleware {
+	return &AuthorizationMiddleware{}
+}
+
+// Authorize guards every OpenAPI operation with the permission it needs from the roles of the user of
+// the request. It runs in the strict handler, after the authentication and household middlewares, to
+// know which operation is called. Anonymous requests are refused but for the public operations.
+func (m *AuthorizationMiddleware) Authorize(
+	next openapi.StrictHandlerFunc,
+	operationID string,
+) openapi.StrictHandlerFunc {
+	return func(
+		ctx context.Context,
+		w http.ResponseWriter,
+		r *http.Request,
+		request any,
+	) (
+		any,
+		error,
+	) {
+		if publicOperations[operationID] {
+			return next(
+				ctx,
+				w,
+				r,
+				request,
+			)
+		}
+
+		user, ok := domain.UserFromContext(ctx)
+		if !ok {
+			writeError(
+				w,
+				http.StatusUnauthorized,
+				domain.ErrUnauthenticated.Error(),
+			)
+
+			return nil, nil
+		}
+
+		if selfServiceOperations[operationID] {
+			return next(
+				ctx,
+				w,
+				r,
+				request,
+			)
+		}
+
+		permission, ok := operationPermissions[operationID]
+		if !ok {
+			permission = domain.PermissionManage
+		}
+		if !user.Can(permission) {
+			writeError(
+				w,
+				http.StatusForbidden,
+				domain.ErrForbidden.Error(),
+			)
+
+			return nil, nil
+		}
+
+		return next(
+			ctx,
+			w,
+			r,
+			request,
+		)
+	}
+}
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	"github.com/rs/zerolog/log"
+)
+
+const HOUSEHOLD_HEADER = "X-Household-ID"
+
+type HouseholdMiddleware struct{}
+
+func NewHouseholdMiddleware() *HouseholdMiddleware {
+	return &HouseholdMiddleware{}
+}
+
+// Scope scopes the request context to the household of the member the authenticated user is linked to,
+// so every repository only sees the data of that household. The X-Household-ID header may only name
+// that household: it is refused from anonymous callers, from users not linked to a member yet and for any
+// other household. Requests of anonymous and unlinked callers are left without household, the operations
+// they may call need none.
+func (m *HouseholdMiddleware) Scope(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(
+			w http.ResponseWriter,
+			r *http.Request,
+		) {
+			householdID := r.Header.Get(HOUSEHOLD_HEADER)
+			user, ok := domain.UserFromContext(r.Context())
+			if !ok {
+				if householdID != "" {
+					writeError(
+						w,
+						http.StatusUnauthorized,
+						domain.ErrUnauthenticated.Error(),
+					)
+
+					return
+				}
+				next.ServeHTTP(
+					w,
+					r,
+				)
+
+				return
+			}
+
+			if householdID != "" && (user.HouseholdID == nil || householdID != *user.HouseholdID) {
+				writeError(
+					w,
+					http.StatusForbidden,
+					domain.ErrHouseholdForbidden.Error(),
+				)
+
+				return
+			}
+			if user.HouseholdID == nil {
+				next.ServeHTTP(
+					w,
+					r,
+				)
+
+				return
+			}
+
+			next.ServeHTTP(
+				w,
+				r.WithContext(
+					domain.WithHouseholdID(
+						r.Context(),
+						*user.HouseholdID,
+					),
+				),
+			)
+		},
+	)
+}
+
+// writeError writes an error response with the body of the openapi.Error schema
+func writeError(
+	w http.ResponseWriter,
+	statusCode int,
+	message string,
+) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	err := json.NewEncoder(w).Encode(
+		openapi.Error{
+			Message: message,
+		},
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("Failed to encode error response")
+	}
+}
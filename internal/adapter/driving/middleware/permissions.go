@@ -0,0 +1,150 @@
+package middleware
+
+import "ghorkov32/proletariat-budget-be/internal/core/domain"
+
+// publicOperations are the only operations that can be called without a bearer token
+var publicOperations = map[string]bool{
+	"AcceptInvitation": true,
+	"Login":            true,
+	"RegisterUser":     true,
+}
+
+// selfServiceOperations act on the authenticated user only, so they need no role. The users not linked
+// to a member yet hold no role and found their own household or join one through them.
+var selfServiceOperations = map[string]bool{
+	"CreateHousehold": true,
+	"GetCurrentUser":  true,
+	"JoinHousehold":   true,
+	"RefreshToken":    true,
+}
+
+// operationPermissions is the permission each operation needs from the roles of the user, operations
+// missing here need domain.PermissionManage
+var operationPermissions = map[string]domain.Permission{
+	// Reading records, balances and reports
+	"CanDeleteAccount":                  domain.PermissionRead,
+	"GetAccount":                        domain.PermissionRead,
+	"GetAllocationPlan":                 domain.PermissionRead,
+	"GetBalances":                       domain.PermissionRead,
+	"GetBudget":                         domain.PermissionRead,
+	"GetBudgetHistory":                  domain.PermissionRead,
+	"GetBudgetOverview":                 domain.PermissionRead,
+	"GetBudgetStatus":                   domain.PermissionRead,
+	"GetCategorizationRule":             domain.PermissionRead,
+	"GetCurrentHousehold":               domain.PermissionRead,
+	"GetDeclaredExpensesReport":         domain.PermissionRead,
+	"GetExchangeRates":                  domain.PermissionRead,
+	"GetExpenditure":                    domain.PermissionRead,
+	"GetExpenditureRecurrencePattern":   domain.PermissionRead,
+	"GetHouseholdMember":                domain.PermissionRead,
+	"GetIngress":                        domain.PermissionRead,
+	"GetIngressRecurrencePattern":       domain.PermissionRead,
+	"GetInstallmentPlan":                domain.PermissionRead,
+	"GetPayee":                          domain.PermissionRead,
+	"GetPayeeSpendingReport":            domain.PermissionRead,
+	"GetPlannedSpendingReport":          domain.PermissionRead,
+	"GetSavingsGoal":                    domain.PermissionRead,
+	"GetSavingsProgress":                domain.PermissionRead,
+	"GetTagCategoryReport":              domain.PermissionRead,
+	"GetTagCombinationReport":           domain.PermissionRead,
+	"GetTagSpendingReport":              domain.PermissionRead,
+	"GetTransfer":                       domain.PermissionRead,
+	"ListAccounts":                      domain.PermissionRead,
+	"ListAllocationPlans":               domain.PermissionRead,
+	"ListBudgets":                       domain.PermissionRead,
+	"ListCategories":                    domain.PermissionRead,
+	"ListCategorizationRules":           domain.PermissionRead,
+	"ListExpenditureRecurrencePatterns": domain.PermissionRead,
+	"ListExpenditureRefunds":            domain.PermissionRead,
+	"ListExpenditures":                  domain.PermissionRead,
+	"ListHouseholdMembers":              domain.PermissionRead,
+	"ListIngresses":                     domain.PermissionRead,
+	"ListInstallmentObligations":        domain.PermissionRead,
+	"ListInstallmentPlans":              domain.PermissionRead,
+	"ListPayees":                        domain.PermissionRead,
+	"ListRecordTags":                    domain.PermissionRead,
+	"ListRoles":                         domain.PermissionRead,
+	"ListSavingsContributions":          domain.PermissionRead,
+	"ListSavingsGoals":                  domain.PermissionRead,
+	"ListSavingsTransactions":           domain.PermissionRead,
+	"ListSavingsWithdrawals":            domain.PermissionRead,
+	"ListTags":                          domain.PermissionRead,
+	"ListTagsByType":                    domain.PermissionRead,
+	"ListTransactions":                  domain.PermissionRead,
+	"ListTransfers":                     domain.PermissionRead,
+	"ListUpcomingBills":                 domain.PermissionRead,
+	"PreviewCategorizationRule":         domain.PermissionRead,
+	"Search":                            domain.PermissionRead,
+	"SuggestCategory":                   domain.PermissionRead,
+
+	// Creating and updating records
+	"ActivateAccount":                    domain.PermissionWrite,
+	"ActivateCategory":                   domain.PermissionWrite,
+	"ActivateHouseholdMember":            domain.PermissionWrite,
+	"AddRecordTags":                      domain.PermissionWrite,
+	"AddSavingsContribution":             domain.PermissionWrite,
+	"AddSavingsWithdrawal":               domain.PermissionWrite,
+	"ApplyCategorizationRules":           domain.PermissionWrite,
+	"CopyLastBudgetPeriod":               domain.PermissionWrite,
+	"CreateAccount":                      domain.PermissionWrite,
+	"CreateAllocationPlan":               domain.PermissionWrite,
+	"CreateBudget":                       domain.PermissionWrite,
+	"CreateCategorizationRule":           domain.PermissionWrite,
+	"CreateCategory":                     domain.PermissionWrite,
+	"CreateExpenditure":                  domain.PermissionWrite,
+	"CreateExpenditureBatch":             domain.PermissionWrite,
+	"CreateExpenditureRecurrencePattern": domain.PermissionWrite,
+	"CreateHouseholdMember":              domain.PermissionWrite,
+	"CreateIngress":                      domain.PermissionWrite,
+	"CreateIngressRecurrencePattern":     domain.PermissionWrite,
+	"CreateInstallmentPlan":              domain.PermissionWrite,
+	"CreatePayee":                        domain.PermissionWrite,
+	"CreateSavingsGoal":                  domain.PermissionWrite,
+	"CreateTag":                          domain.PermissionWrite,
+	"CreateTransfer":                     domain.PermissionWrite,
+	"PayOffInstallmentPlan":              domain.PermissionWrite,
+	"PostExpenditureRecurrencePattern":   domain.PermissionWrite,
+	"RefundExpenditure":                  domain.PermissionWrite,
+	"RemoveRecordTag":                    domain.PermissionWrite,
+	"RetrainCategorySuggestions":         domain.PermissionWrite,
+	"SetBudgetPeriodAmount":              domain.PermissionWrite,
+	"SetRecordTags":                      domain.PermissionWrite,
+	"UpdateAccount":                      domain.PermissionWrite,
+	"UpdateAllocationPlan":               domain.PermissionWrite,
+	"UpdateBudget":                       domain.PermissionWrite,
+	"UpdateCategorizationRule":           domain.PermissionWrite,
+	"UpdateCategory":                     domain.PermissionWrite,
+	"UpdateExpenditureRecurrencePattern": domain.PermissionWrite,
+	"UpdateHouseholdMember":              domain.PermissionWrite,
+	"UpdateIngressRecurrencePattern":     domain.PermissionWrite,
+	"UpdatePayee":                        domain.PermissionWrite,
+	"UpdateSavingsGoal":                  domain.PermissionWrite,
+	"UpdateTag":                          domain.PermissionWrite,
+
+	// Deleting, deactivating, merging away and rolling back records
+	"DeactivateAccount":                  domain.PermissionDelete,
+	"DeactivateCategory":                 domain.PermissionDelete,
+	"DeactivateHouseholdMember":          domain.PermissionDelete,
+	"DeleteAccount":                      domain.PermissionDelete,
+	"DeleteAllocationPlan":               domain.PermissionDelete,
+	"DeleteBudget":                       domain.PermissionDelete,
+	"DeleteCategorizationRule":           domain.PermissionDelete,
+	"DeleteCategory":                     domain.PermissionDelete,
+	"DeleteExpenditureRecurrencePattern": domain.PermissionDelete,
+	"DeleteHouseholdMember":              domain.PermissionDelete,
+	"DeleteIngressRecurrencePattern":     domain.PermissionDelete,
+	"DeletePayee":                        domain.PermissionDelete,
+	"DeleteSavingsGoal":                  domain.PermissionDelete,
+	"DeleteTag":                          domain.PermissionDelete,
+	"MergeCategory":                      domain.PermissionDelete,
+	"MergeTag":                           domain.PermissionDelete,
+	"RollbackExpenditure":                domain.PermissionDelete,
+	"RollbackIngress":                    domain.PermissionDelete,
+	"RollbackTransfer":                   domain.PermissionDelete,
+
+	// Managing the household and its users
+	"CreateInvitation":      domain.PermissionManage,
+	"LinkCurrentUserMember": domain.PermissionManage,
+	"ListUsers":             domain.PermissionManage,
+	"SetUserRoles":          domain.PermissionManage,
+}
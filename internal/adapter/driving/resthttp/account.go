@@ -11,8 +11,25 @@ import (
 )
 
 func (c *Controller) ListAccounts(ctx context.Context, request openapi.ListAccountsRequestObject) (openapi.ListAccountsResponseObject, error) {
-	accounts, err := c.useCases.Account.List(ctx, *FromOAPIAccountListParams(&request.Params))
+	params, err := FromOAPIAccountListParams(&request.Params)
 	if err != nil {
+		return openapi.ListAccounts400JSONResponse{
+			N400JSONResponse: openapi.N400JSONResponse{
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	accounts, err := c.useCases.Account.List(ctx, *params)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidSortKey) {
+			return openapi.ListAccounts400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+
 		log.Err(err).Msg("Failed to list accounts")
 
 		return openapi.ListAccounts500JSONResponse{
@@ -0,0 +1,219 @@
+package resthttp
+
+import (
+	"context"
+	"errors"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	"github.com/rs/zerolog/log"
+)
+
+func (c *Controller) CreateAllocationPlan(
+	ctx context.Context,
+	request openapi.CreateAllocationPlanRequestObject,
+) (
+	openapi.CreateAllocationPlanResponseObject,
+	error,
+) {
+	plan, err := c.useCases.AllocationPlan.Create(
+		ctx,
+		*FromOAPIAllocationPlanRequest(request.Body),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrAllocationPlanExists,
+		) {
+			return openapi.CreateAllocationPlan409JSONResponse{
+				N409JSONResponse: openapi.N409JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if isInvalidAllocationPlanError(err) {
+			return openapi.CreateAllocationPlan400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to create allocation plan")
+
+		return openapi.CreateAllocationPlan500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to create allocation plan",
+			},
+		}, nil
+	}
+
+	return openapi.CreateAllocationPlan201JSONResponse(*ToOAPIAllocationPlan(plan)), nil
+}
+
+func (c *Controller) ListAllocationPlans(
+	ctx context.Context,
+	request openapi.ListAllocationPlansRequestObject,
+) (
+	openapi.ListAllocationPlansResponseObject,
+	error,
+) {
+	params := domain.AllocationPlanListParams{
+		Currency: request.Params.Currency,
+	}
+	if request.Params.Month != nil {
+		params.Month = &request.Params.Month.Time
+	}
+
+	plans, err := c.useCases.AllocationPlan.List(
+		ctx,
+		params,
+	)
+	if err != nil {
+		log.Err(err).Msg("Failed to list allocation plans")
+
+		return openapi.ListAllocationPlans500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to list allocation plans",
+			},
+		}, nil
+	}
+
+	return openapi.ListAllocationPlans200JSONResponse(*ToOAPIAllocationPlanList(plans)), nil
+}
+
+func (c *Controller) GetAllocationPlan(
+	ctx context.Context,
+	request openapi.GetAllocationPlanRequestObject,
+) (
+	openapi.GetAllocationPlanResponseObject,
+	error,
+) {
+	plan, err := c.useCases.AllocationPlan.Get(
+		ctx,
+		request.Id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrAllocationPlanNotFound,
+		) {
+			return openapi.GetAllocationPlan404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to get allocation plan")
+
+		return openapi.GetAllocationPlan500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to get allocation plan",
+			},
+		}, nil
+	}
+
+	return openapi.GetAllocationPlan200JSONResponse(*ToOAPIAllocationPlan(plan)), nil
+}
+
+func (c *Controller) UpdateAllocationPlan(
+	ctx context.Context,
+	request openapi.UpdateAllocationPlanRequestObject,
+) (
+	openapi.UpdateAllocationPlanResponseObject,
+	error,
+) {
+	plan, err := c.useCases.AllocationPlan.Update(
+		ctx,
+		*FromOAPIAllocationPlanUpdateRequest(
+			request.Body,
+			request.Id,
+		),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrAllocationPlanNotFound,
+		) {
+			return openapi.UpdateAllocationPlan404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if isInvalidAllocationPlanError(err) {
+			return openapi.UpdateAllocationPlan400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to update allocation plan")
+
+		return openapi.UpdateAllocationPlan500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to update allocation plan",
+			},
+		}, nil
+	}
+
+	return openapi.UpdateAllocationPlan200JSONResponse(*ToOAPIAllocationPlan(plan)), nil
+}
+
+func (c *Controller) DeleteAllocationPlan(
+	ctx context.Context,
+	request openapi.DeleteAllocationPlanRequestObject,
+) (
+	openapi.DeleteAllocationPlanResponseObject,
+	error,
+) {
+	err := c.useCases.AllocationPlan.Delete(
+		ctx,
+		request.Id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrAllocationPlanNotFound,
+		) {
+			return openapi.DeleteAllocationPlan404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to delete allocation plan")
+
+		return openapi.DeleteAllocationPlan500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to delete allocation plan",
+			},
+		}, nil
+	}
+
+	return openapi.DeleteAllocationPlan204Response{}, nil
+}
+
+func isInvalidAllocationPlanError(err error) bool {
+	for _, invalid := range []error{
+		domain.ErrInvalidAllocationPlanCurrency,
+		domain.ErrInvalidIncomeBasis,
+		domain.ErrInvalidAllocationTarget,
+		domain.ErrInvalidAllocationAmount,
+		domain.ErrDuplicateAllocation,
+		domain.ErrAllocationCategoryNotExpenses,
+		domain.ErrAllocationsExceedIncome,
+		domain.ErrCategoryNotFound,
+		domain.ErrCategoryInactive,
+		domain.ErrSavingsGoalNotFound,
+		domain.ErrInvalidCurrency,
+	} {
+		if errors.Is(
+			err,
+			invalid,
+		) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -2,8 +2,11 @@ package resthttp
 
 import (
 	"context"
+	"errors"
 
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
 	"ghorkov32/proletariat-budget-be/openapi"
+	"github.com/rs/zerolog/log"
 )
 
 func (c *Controller) Login(
@@ -13,7 +16,26 @@ func (c *Controller) Login(
 	openapi.LoginResponseObject,
 	error,
 ) {
-	panic("implement me")
+	token, user, err := c.useCases.Auth.Login(
+		ctx,
+		string(request.Body.Email),
+		request.Body.Password,
+	)
+	if err != nil {
+		if !errors.Is(
+			err,
+			domain.ErrInvalidCredentials,
+		) {
+			log.Err(err).Msg("Failed to login")
+		}
+
+		return openapi.Login401JSONResponse{}, nil
+	}
+
+	return openapi.Login200JSONResponse(*ToOAPILoginResponse(
+		token,
+		user,
+	)), nil
 }
 
 func (c *Controller) RefreshToken(
@@ -23,7 +45,25 @@ func (c *Controller) RefreshToken(
 	openapi.RefreshTokenResponseObject,
 	error,
 ) {
-	panic("implement me")
+	user, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return openapi.RefreshToken401JSONResponse{}, nil
+	}
+
+	token, refreshed, err := c.useCases.Auth.RefreshToken(
+		ctx,
+		user.ID,
+	)
+	if err != nil {
+		log.Err(err).Msg("Failed to refresh token")
+
+		return openapi.RefreshToken401JSONResponse{}, nil
+	}
+
+	return openapi.RefreshToken200JSONResponse(*ToOAPILoginResponse(
+		token,
+		refreshed,
+	)), nil
 }
 
 func (c *Controller) RegisterUser(
@@ -33,5 +73,115 @@ func (c *Controller) RegisterUser(
 	openapi.RegisterUserResponseObject,
 	error,
 ) {
-	panic("implement me")
+	token, user, err := c.useCases.Auth.Register(
+		ctx,
+		domain.User{
+			Email:     string(request.Body.Email),
+			FirstName: request.Body.FirstName,
+			LastName:  request.Body.LastName,
+		},
+		request.Body.Password,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrUserEmailTaken,
+		) || errors.Is(
+			err,
+			domain.ErrUserEmailEmpty,
+		) || errors.Is(
+			err,
+			domain.ErrUserPasswordTooShort,
+		) {
+			return openapi.RegisterUser400JSONResponse{
+				Message: err.Error(),
+			}, nil
+		}
+		log.Err(err).Msg("Failed to register user")
+
+		return openapi.RegisterUser500JSONResponse{
+			Message: "Failed to register user",
+		}, nil
+	}
+
+	return openapi.RegisterUser201JSONResponse{
+		Token:     &token.Token,
+		ExpiresAt: &token.ExpiresAt,
+		User:      ToOAPIUser(user),
+	}, nil
+}
+
+func (c *Controller) GetCurrentUser(
+	ctx context.Context,
+	request openapi.GetCurrentUserRequestObject,
+) (
+	openapi.GetCurrentUserResponseObject,
+	error,
+) {
+	user, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return openapi.GetCurrentUser401Response{}, nil
+	}
+
+	return openapi.GetCurrentUser200JSONResponse(*ToOAPIUser(user)), nil
+}
+
+func (c *Controller) LinkCurrentUserMember(
+	ctx context.Context,
+	request openapi.LinkCurrentUserMemberRequestObject,
+) (
+	openapi.LinkCurrentUserMemberResponseObject,
+	error,
+) {
+	user, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return openapi.LinkCurrentUserMember401Response{}, nil
+	}
+
+	linked, err := c.useCases.Auth.LinkMember(
+		ctx,
+		*user,
+		request.Body.MemberId,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrMemberNotFound,
+		) {
+			return openapi.LinkCurrentUserMember404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrForbidden,
+		) {
+			return openapi.LinkCurrentUserMember403JSONResponse{
+				N403JSONResponse: openapi.N403JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrMemberAlreadyLinked,
+		) {
+			return openapi.LinkCurrentUserMember409JSONResponse{
+				N409JSONResponse: openapi.N409JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to link user to member")
+
+		return openapi.LinkCurrentUserMember500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to link user to member",
+			},
+		}, nil
+	}
+
+	return openapi.LinkCurrentUserMember200JSONResponse(*ToOAPIUser(linked)), nil
 }
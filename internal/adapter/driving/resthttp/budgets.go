@@ -0,0 +1,407 @@
+package resthttp
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"github.com/rs/zerolog/log"
+)
+
+func (c *Controller) CreateBudget(
+	ctx context.Context,
+	request openapi.CreateBudgetRequestObject,
+) (
+	openapi.CreateBudgetResponseObject,
+	error,
+) {
+	budget, err := c.useCases.Budget.Create(
+		ctx,
+		*FromOAPIBudgetRequest(
+			request.Body,
+			nil,
+		),
+	)
+	if err != nil {
+		if isInvalidBudgetError(err) {
+			return openapi.CreateBudget400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to create budget")
+
+		return openapi.CreateBudget500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to create budget",
+			},
+		}, nil
+	}
+
+	return openapi.CreateBudget201JSONResponse(*ToOAPIBudget(budget)), nil
+}
+
+func (c *Controller) ListBudgets(
+	ctx context.Context,
+	request openapi.ListBudgetsRequestObject,
+) (
+	openapi.ListBudgetsResponseObject,
+	error,
+) {
+	budgets, err := c.useCases.Budget.List(
+		ctx,
+		domain.BudgetListParams{
+			CategoryID: request.Params.CategoryId,
+			Active:     request.Params.Active,
+		},
+	)
+	if err != nil {
+		log.Err(err).Msg("Failed to list budgets")
+
+		return openapi.ListBudgets500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to list budgets",
+			},
+		}, nil
+	}
+
+	return openapi.ListBudgets200JSONResponse(*ToOAPIBudgetList(budgets)), nil
+}
+
+func (c *Controller) GetBudget(
+	ctx context.Context,
+	request openapi.GetBudgetRequestObject,
+) (
+	openapi.GetBudgetResponseObject,
+	error,
+) {
+	budget, err := c.useCases.Budget.Get(
+		ctx,
+		request.Id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrBudgetNotFound,
+		) {
+			return openapi.GetBudget404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to get budget")
+
+		return openapi.GetBudget500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to get budget",
+			},
+		}, nil
+	}
+
+	return openapi.GetBudget200JSONResponse(*ToOAPIBudget(budget)), nil
+}
+
+func (c *Controller) UpdateBudget(
+	ctx context.Context,
+	request openapi.UpdateBudgetRequestObject,
+) (
+	openapi.UpdateBudgetResponseObject,
+	error,
+) {
+	budget, err := c.useCases.Budget.Update(
+		ctx,
+		*FromOAPIBudgetRequest(
+			request.Body,
+			&request.Id,
+		),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrBudgetNotFound,
+		) {
+			return openapi.UpdateBudget404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if isInvalidBudgetError(err) {
+			return openapi.UpdateBudget400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to update budget")
+
+		return openapi.UpdateBudget500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to update budget",
+			},
+		}, nil
+	}
+
+	return openapi.UpdateBudget200JSONResponse(*ToOAPIBudget(budget)), nil
+}
+
+func (c *Controller) DeleteBudget(
+	ctx context.Context,
+	request openapi.DeleteBudgetRequestObject,
+) (
+	openapi.DeleteBudgetResponseObject,
+	error,
+) {
+	err := c.useCases.Budget.Delete(
+		ctx,
+		request.Id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrBudgetNotFound,
+		) {
+			return openapi.DeleteBudget404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to delete budget")
+
+		return openapi.DeleteBudget500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to delete budget",
+			},
+		}, nil
+	}
+
+	return openapi.DeleteBudget204Response{}, nil
+}
+
+func (c *Controller) GetBudgetStatus(
+	ctx context.Context,
+	request openapi.GetBudgetStatusRequestObject,
+) (
+	openapi.GetBudgetStatusResponseObject,
+	error,
+) {
+	status, err := c.useCases.Budget.Status(
+		ctx,
+		request.Id,
+		dateOrToday(request.Params.Date),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrBudgetNotFound,
+		) {
+			return openapi.GetBudgetStatus404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrBudgetOutOfRange,
+		) {
+			return openapi.GetBudgetStatus400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to get budget status")
+
+		return openapi.GetBudgetStatus500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to get budget status",
+			},
+		}, nil
+	}
+
+	return openapi.GetBudgetStatus200JSONResponse(*ToOAPIBudgetStatus(status)), nil
+}
+
+func (c *Controller) GetBudgetOverview(
+	ctx context.Context,
+	request openapi.GetBudgetOverviewRequestObject,
+) (
+	openapi.GetBudgetOverviewResponseObject,
+	error,
+) {
+	overview, err := c.useCases.Budget.Overview(
+		ctx,
+		dateOrToday(request.Params.Date),
+	)
+	if err != nil {
+		log.Err(err).Msg("Failed to get budget overview")
+
+		return openapi.GetBudgetOverview500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to get budget overview",
+			},
+		}, nil
+	}
+
+	return openapi.GetBudgetOverview200JSONResponse(*ToOAPIBudgetOverview(overview)), nil
+}
+
+func (c *Controller) GetBudgetHistory(
+	ctx context.Context,
+	request openapi.GetBudgetHistoryRequestObject,
+) (
+	openapi.GetBudgetHistoryResponseObject,
+	error,
+) {
+	history, err := c.useCases.Budget.History(
+		ctx,
+		request.Id,
+		dateOrToday(request.Params.Date),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrBudgetNotFound,
+		) {
+			return openapi.GetBudgetHistory404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrBudgetOutOfRange,
+		) {
+			return openapi.GetBudgetHistory400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to get budget history")
+
+		return openapi.GetBudgetHistory500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to get budget history",
+			},
+		}, nil
+	}
+
+	return openapi.GetBudgetHistory200JSONResponse(*ToOAPIBudgetHistory(history)), nil
+}
+
+func (c *Controller) SetBudgetPeriodAmount(
+	ctx context.Context,
+	request openapi.SetBudgetPeriodAmountRequestObject,
+) (
+	openapi.SetBudgetPeriodAmountResponseObject,
+	error,
+) {
+	status, err := c.useCases.Budget.SetPeriodAmount(
+		ctx,
+		request.Id,
+		request.Number,
+		request.Body.Amount,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrBudgetNotFound,
+		) {
+			return openapi.SetBudgetPeriodAmount404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrInvalidBudgetPeriodNumber,
+		) || errors.Is(
+			err,
+			domain.ErrInvalidBudgetPeriodAmount,
+		) || errors.Is(
+			err,
+			domain.ErrBudgetOutOfRange,
+		) {
+			return openapi.SetBudgetPeriodAmount400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to set budget period amount")
+
+		return openapi.SetBudgetPeriodAmount500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to set budget period amount",
+			},
+		}, nil
+	}
+
+	return openapi.SetBudgetPeriodAmount200JSONResponse(*ToOAPIBudgetStatus(status)), nil
+}
+
+func (c *Controller) CopyLastBudgetPeriod(
+	ctx context.Context,
+	request openapi.CopyLastBudgetPeriodRequestObject,
+) (
+	openapi.CopyLastBudgetPeriodResponseObject,
+	error,
+) {
+	overview, err := c.useCases.Budget.CopyLastPeriod(
+		ctx,
+		dateOrToday(request.Params.Date),
+	)
+	if err != nil {
+		log.Err(err).Msg("Failed to copy last budget period")
+
+		return openapi.CopyLastBudgetPeriod500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to copy last budget period",
+			},
+		}, nil
+	}
+
+	return openapi.CopyLastBudgetPeriod200JSONResponse(*ToOAPIBudgetOverview(overview)), nil
+}
+
+func dateOrToday(date *openapitypes.Date) time.Time {
+	if date != nil {
+		return date.Time
+	}
+
+	return time.Now().UTC().Truncate(24 * time.Hour)
+}
+
+func isInvalidBudgetError(err error) bool {
+	for _, invalid := range []error{
+		domain.ErrInvalidBudgetAmount,
+		domain.ErrInvalidBudgetCurrency,
+		domain.ErrInvalidBudgetPeriodType,
+		domain.ErrInvalidBudgetPeriodDays,
+		domain.ErrInvalidBudgetRollover,
+		domain.ErrInvalidBudgetEndDate,
+		domain.ErrBudgetCategoryNotExpenses,
+		domain.ErrCategoryNotFound,
+		domain.ErrCategoryInactive,
+		domain.ErrInvalidCurrency,
+	} {
+		if errors.Is(
+			err,
+			invalid,
+		) {
+			return true
+		}
+	}
+
+	return false
+}
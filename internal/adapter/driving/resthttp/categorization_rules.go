@@ -0,0 +1,283 @@
+package resthttp
+
+import (
+	"context"
+	"errors"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	"github.com/rs/zerolog/log"
+)
+
+func (c *Controller) CreateCategorizationRule(
+	ctx context.Context,
+	request openapi.CreateCategorizationRuleRequestObject,
+) (
+	openapi.CreateCategorizationRuleResponseObject,
+	error,
+) {
+	rule, err := c.useCases.CategorizationRule.Create(
+		ctx,
+		*FromOAPICategorizationRuleRequest(
+			request.Body,
+			nil,
+		),
+	)
+	if err != nil {
+		if isInvalidCategorizationRuleError(err) {
+			return openapi.CreateCategorizationRule400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to create categorization rule")
+
+		return openapi.CreateCategorizationRule500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to create categorization rule",
+			},
+		}, nil
+	}
+
+	return openapi.CreateCategorizationRule201JSONResponse(*ToOAPICategorizationRule(rule)), nil
+}
+
+func (c *Controller) ListCategorizationRules(
+	ctx context.Context,
+	request openapi.ListCategorizationRulesRequestObject,
+) (
+	openapi.ListCategorizationRulesResponseObject,
+	error,
+) {
+	rules, err := c.useCases.CategorizationRule.List(
+		ctx,
+		domain.CategorizationRuleListParams{
+			Active: request.Params.Active,
+		},
+	)
+	if err != nil {
+		log.Err(err).Msg("Failed to list categorization rules")
+
+		return openapi.ListCategorizationRules500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to list categorization rules",
+			},
+		}, nil
+	}
+
+	return openapi.ListCategorizationRules200JSONResponse(*ToOAPICategorizationRuleList(rules)), nil
+}
+
+func (c *Controller) GetCategorizationRule(
+	ctx context.Context,
+	request openapi.GetCategorizationRuleRequestObject,
+) (
+	openapi.GetCategorizationRuleResponseObject,
+	error,
+) {
+	rule, err := c.useCases.CategorizationRule.Get(
+		ctx,
+		request.Id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrCategorizationRuleNotFound,
+		) {
+			return openapi.GetCategorizationRule404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to get categorization rule")
+
+		return openapi.GetCategorizationRule500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to get categorization rule",
+			},
+		}, nil
+	}
+
+	return openapi.GetCategorizationRule200JSONResponse(*ToOAPICategorizationRule(rule)), nil
+}
+
+func (c *Controller) UpdateCategorizationRule(
+	ctx context.Context,
+	request openapi.UpdateCategorizationRuleRequestObject,
+) (
+	openapi.UpdateCategorizationRuleResponseObject,
+	error,
+) {
+	rule, err := c.useCases.CategorizationRule.Update(
+		ctx,
+		*FromOAPICategorizationRuleRequest(
+			request.Body,
+			&request.Id,
+		),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrCategorizationRuleNotFound,
+		) {
+			return openapi.UpdateCategorizationRule404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if isInvalidCategorizationRuleError(err) {
+			return openapi.UpdateCategorizationRule400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to update categorization rule")
+
+		return openapi.UpdateCategorizationRule500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to update categorization rule",
+			},
+		}, nil
+	}
+
+	return openapi.UpdateCategorizationRule200JSONResponse(*ToOAPICategorizationRule(rule)), nil
+}
+
+func (c *Controller) DeleteCategorizationRule(
+	ctx context.Context,
+	request openapi.DeleteCategorizationRuleRequestObject,
+) (
+	openapi.DeleteCategorizationRuleResponseObject,
+	error,
+) {
+	err := c.useCases.CategorizationRule.Delete(
+		ctx,
+		request.Id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrCategorizationRuleNotFound,
+		) {
+			return openapi.DeleteCategorizationRule404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to delete categorization rule")
+
+		return openapi.DeleteCategorizationRule500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to delete categorization rule",
+			},
+		}, nil
+	}
+
+	return openapi.DeleteCategorizationRule204Response{}, nil
+}
+
+func (c *Controller) PreviewCategorizationRule(
+	ctx context.Context,
+	request openapi.PreviewCategorizationRuleRequestObject,
+) (
+	openapi.PreviewCategorizationRuleResponseObject,
+	error,
+) {
+	rule, params := FromOAPICategorizationRulePreviewRequest(request.Body)
+	run, err := c.useCases.CategorizationRule.Preview(
+		ctx,
+		*rule,
+		*params,
+	)
+	if err != nil {
+		if isInvalidCategorizationRuleError(err) || errors.Is(
+			err,
+			domain.ErrInvalidCategorizationDateRange,
+		) {
+			return openapi.PreviewCategorizationRule400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to preview categorization rule")
+
+		return openapi.PreviewCategorizationRule500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to preview categorization rule",
+			},
+		}, nil
+	}
+
+	return openapi.PreviewCategorizationRule200JSONResponse(*ToOAPICategorizationRun(run)), nil
+}
+
+func (c *Controller) ApplyCategorizationRules(
+	ctx context.Context,
+	request openapi.ApplyCategorizationRulesRequestObject,
+) (
+	openapi.ApplyCategorizationRulesResponseObject,
+	error,
+) {
+	run, err := c.useCases.CategorizationRule.Apply(
+		ctx,
+		*FromOAPICategorizationRuleApplyRequest(request.Body),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrRuleApplyScopeRequired,
+		) || errors.Is(
+			err,
+			domain.ErrInvalidCategorizationDateRange,
+		) {
+			return openapi.ApplyCategorizationRules400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to apply categorization rules")
+
+		return openapi.ApplyCategorizationRules500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to apply categorization rules",
+			},
+		}, nil
+	}
+
+	return openapi.ApplyCategorizationRules200JSONResponse(*ToOAPICategorizationRun(run)), nil
+}
+
+func isInvalidCategorizationRuleError(err error) bool {
+	for _, invalid := range []error{
+		domain.ErrCategorizationRuleNameEmpty,
+		domain.ErrInvalidRulePriority,
+		domain.ErrRuleWithoutConditions,
+		domain.ErrRuleWithoutActions,
+		domain.ErrInvalidDescriptionMatch,
+		domain.ErrInvalidDescriptionPattern,
+		domain.ErrInvalidRuleAmountRange,
+		domain.ErrRuleCategoryNotExpenses,
+		domain.ErrRuleTagNotExpenses,
+		domain.ErrCategoryNotFound,
+		domain.ErrCategoryInactive,
+		domain.ErrTagNotFound,
+		domain.ErrAccountNotFound,
+		domain.ErrPayeeNotFound,
+	} {
+		if errors.Is(
+			err,
+			invalid,
+		) {
+			return true
+		}
+	}
+
+	return false
+}
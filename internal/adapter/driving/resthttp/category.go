@@ -16,6 +16,28 @@ func (c *Controller) ListCategories(
 	openapi.ListCategoriesResponseObject,
 	error,
 ) {
+	if request.Params.Tree != nil && *request.Params.Tree {
+		tree, err := c.useCases.Category.CategoryTree(
+			ctx,
+			FromOAPICategoryType(request.Params.Type),
+		)
+		if err != nil {
+			log.Err(err).Msg("Failed to list category tree")
+
+			return openapi.ListCategories500JSONResponse{
+				N500JSONResponse: openapi.N500JSONResponse{
+					Message: "Failed to list categories",
+				},
+			}, nil
+		}
+		total := len(tree)
+
+		return openapi.ListCategories200JSONResponse{
+			Categories: ToOAPICategoryTree(tree),
+			Total:      &total,
+		}, nil
+	}
+
 	categories, err := c.useCases.Category.ListCategories(
 		ctx,
 		FromOAPICategoryType(request.Params.Type),
@@ -52,6 +74,13 @@ func (c *Controller) CreateCategory(
 		),
 	)
 	if err != nil {
+		if isInvalidCategoryParentError(err) {
+			return openapi.CreateCategory400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
 		log.Err(err).Msg("Failed to create category")
 
 		return openapi.CreateCategory500JSONResponse{
@@ -97,6 +126,18 @@ func (c *Controller) DeleteCategory(
 		) || errors.Is(
 			err,
 			domain.ErrCategoryUsedInIngress,
+		) || errors.Is(
+			err,
+			domain.ErrCategoryUsedInBudget,
+		) || errors.Is(
+			err,
+			domain.ErrCategoryUsedInAllocationPlan,
+		) || errors.Is(
+			err,
+			domain.ErrCategoryUsedInCategorizationRule,
+		) || errors.Is(
+			err,
+			domain.ErrCategoryHasSubcategories,
 		) || errors.Is(
 			err,
 			domain.ErrCategoryUsedInEntity,
@@ -128,10 +169,11 @@ func (c *Controller) UpdateCategory(
 ) {
 	category, err := c.useCases.Category.UpdateCategory(
 		ctx,
-		*FromOAPICategoryRequest(
+		*FromOAPICategoryUpdateRequest(
 			request.Body,
-			&request.Id,
+			request.Id,
 		),
+		request.Body.Active,
 	)
 	if err != nil {
 		if errors.Is(
@@ -144,6 +186,16 @@ func (c *Controller) UpdateCategory(
 				},
 			}, nil
 		}
+		if isInvalidCategoryParentError(err) || errors.Is(
+			err,
+			domain.ErrCategoryActiveChanged,
+		) {
+			return openapi.UpdateCategory400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
 		log.Err(err).Msg("Failed to update category")
 
 		return openapi.UpdateCategory500JSONResponse{
@@ -181,6 +233,9 @@ func (c *Controller) ActivateCategory(
 		if errors.Is(
 			err,
 			domain.ErrCategoryAlreadyActive,
+		) || errors.Is(
+			err,
+			domain.ErrCategoryParentInactive,
 		) {
 			return openapi.ActivateCategory400JSONResponse{
 				N400JSONResponse: openapi.N400JSONResponse{
@@ -243,3 +298,90 @@ func (c *Controller) DeactivateCategory(
 
 	return openapi.DeactivateCategory204Response{}, nil
 }
+
+func (c *Controller) MergeCategory(
+	ctx context.Context,
+	request openapi.MergeCategoryRequestObject,
+) (
+	openapi.MergeCategoryResponseObject,
+	error,
+) {
+	action := domain.CategoryMergeDelete
+	if request.Body.SourceAction != nil {
+		action = domain.CategoryMergeAction(*request.Body.SourceAction)
+	}
+
+	result, err := c.useCases.Category.Merge(
+		ctx,
+		request.Id,
+		request.Body.TargetId,
+		action,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrCategoryNotFound,
+		) {
+			return openapi.MergeCategory404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if isInvalidCategoryMergeError(err) {
+			return openapi.MergeCategory400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to merge category")
+
+		return openapi.MergeCategory500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to merge category",
+			},
+		}, nil
+	}
+
+	return openapi.MergeCategory200JSONResponse(*ToOAPICategoryMergeResult(result)), nil
+}
+
+func isInvalidCategoryMergeError(err error) bool {
+	for _, invalid := range []error{
+		domain.ErrInvalidCategoryMergeAction,
+		domain.ErrCategoryMergeTargetNotFound,
+		domain.ErrCategoryMergeSameCategory,
+		domain.ErrCategoryMergeTypeMismatch,
+		domain.ErrCategoryMergeIntoSubcategory,
+		domain.ErrCategoryMergeBothBudgeted,
+		domain.ErrCategoryInactive,
+	} {
+		if errors.Is(
+			err,
+			invalid,
+		) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isInvalidCategoryParentError(err error) bool {
+	for _, invalid := range []error{
+		domain.ErrCategoryParentNotFound,
+		domain.ErrCategoryParentInactive,
+		domain.ErrCategoryParentTypeMismatch,
+		domain.ErrCategoryCycle,
+	} {
+		if errors.Is(
+			err,
+			invalid,
+		) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,76 @@
+package resthttp
+
+import (
+	"context"
+	"errors"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	"github.com/rs/zerolog/log"
+)
+
+const defaultSuggestionLimit = 3
+
+func (c *Controller) SuggestCategory(
+	ctx context.Context,
+	request openapi.SuggestCategoryRequestObject,
+) (
+	openapi.SuggestCategoryResponseObject,
+	error,
+) {
+	limit := defaultSuggestionLimit
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	suggestion, err := c.useCases.CategorySuggestion.Suggest(
+		ctx,
+		request.Params.Description,
+		limit,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrInvalidSuggestionDescription,
+		) || errors.Is(
+			err,
+			domain.ErrInvalidSuggestionLimit,
+		) {
+			return openapi.SuggestCategory400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to suggest category")
+
+		return openapi.SuggestCategory500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to suggest category",
+			},
+		}, nil
+	}
+
+	return openapi.SuggestCategory200JSONResponse(*ToOAPICategorySuggestion(suggestion)), nil
+}
+
+func (c *Controller) RetrainCategorySuggestions(
+	ctx context.Context,
+	request openapi.RetrainCategorySuggestionsRequestObject,
+) (
+	openapi.RetrainCategorySuggestionsResponseObject,
+	error,
+) {
+	status, err := c.useCases.CategorySuggestion.Retrain(ctx)
+	if err != nil {
+		log.Err(err).Msg("Failed to retrain category suggestions")
+
+		return openapi.RetrainCategorySuggestions500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to retrain category suggestions",
+			},
+		}, nil
+	}
+
+	return openapi.RetrainCategorySuggestions200JSONResponse(*ToOAPISuggestionModelStatus(status)), nil
+}
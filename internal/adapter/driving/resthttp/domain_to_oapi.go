@@ -90,22 +90,40 @@ func ToOAPIAccountList(al *domain.AccountList) *openapi.AccountList {
 
 	return &openapi.AccountList{
 		Accounts: &oapiAccounts,
-		Metadata: &openapi.ListMetadata{
-			Total:  al.Metadata.Total,
-			Limit:  al.Metadata.Limit,
-			Offset: al.Metadata.Offset,
-		},
+		Metadata: ToOAPIListMetadata(al.Metadata),
+	}
+}
+
+func ToOAPIListMetadata(m domain.ListMetadata) *openapi.ListMetadata {
+	metadata := &openapi.ListMetadata{
+		Total:  m.Total,
+		Limit:  m.Limit,
+		Offset: m.Offset,
 	}
+	if m.Sort != "" {
+		metadata.Sort = &m.Sort
+	}
+
+	return metadata
 }
 
-func FromOAPIAccountListParams(params *openapi.ListAccountsParams) *domain.AccountListParams {
+func FromOAPIAccountListParams(params *openapi.ListAccountsParams) (
+	*domain.AccountListParams,
+	error,
+) {
+	sort, err := domain.ParseSortOrders(params.Sort)
+	if err != nil {
+		return nil, err
+	}
+
 	return &domain.AccountListParams{
 		Type:     params.Type,
 		Currency: params.Currency,
 		Active:   params.Active,
 		Limit:    params.Limit,
 		Offset:   params.Offset,
-	}
+		Sort:     sort,
+	}, nil
 }
 
 func ToOAPIHouseholdMember(member *domain.HouseholdMember) *openapi.HouseholdMember {
@@ -162,11 +180,20 @@ func ToOAPIHouseholdMemberList(members *domain.HouseholdMemberList) *openapi.Hou
 	}
 }
 
-func FromOAPIHouseholdMemberListParams(params *openapi.ListHouseholdMembersParams) *domain.HouseholdMemberListParams {
+func FromOAPIHouseholdMemberListParams(params *openapi.ListHouseholdMembersParams) (
+	*domain.HouseholdMemberListParams,
+	error,
+) {
+	sort, err := domain.ParseSortOrders(params.Sort)
+	if err != nil {
+		return nil, err
+	}
+
 	return &domain.HouseholdMemberListParams{
 		Role:   params.Role,
 		Active: params.Active,
-	}
+		Sort:   sort,
+	}, nil
 }
 
 func FromOAPIExpenditure(e *openapi.ExpenditureRequest) *domain.Transaction {
@@ -192,6 +219,7 @@ func FromOAPIExpenditureRequestTransaction(e *openapi.ExpenditureRequest) *domai
 		TransactionDate: e.Date.Time,
 		Description:     e.Description,
 		TransactionType: domain.TransactionTypeExpenditure,
+		ExchangeRate:    e.ExchangeRate,
 	}
 }
 
@@ -259,6 +287,7 @@ func ToOAPICategory(category *domain.Category) *openapi.Category {
 		Color:           &category.Color,
 		BackgroundColor: &category.BackgroundColor,
 		CategoryType:    ToOAPICategoryCategoryType(&category.CategoryType),
+		ParentId:        category.ParentID,
 	}
 }
 
@@ -298,6 +327,42 @@ func ToOAPICategoryList(categories *[]domain.Category) *[]openapi.Category {
 	return &oapiCategories
 }
 
+func ToOAPICategoryMergeResult(result *domain.CategoryMergeResult) *openapi.CategoryMergeResult {
+	return &openapi.CategoryMergeResult{
+		Target: *ToOAPICategory(&result.Target),
+		Moved: openapi.CategoryReassignments{
+			Expenditures:     result.Moved.Expenditures,
+			Ingresses:        result.Moved.Ingresses,
+			SavingsGoals:     result.Moved.SavingsGoals,
+			RecurringBills:   result.Moved.RecurringBills,
+			InstallmentPlans: result.Moved.InstallmentPlans,
+			Budgets:          result.Moved.Budgets,
+			Allocations:      result.Moved.Allocations,
+			Payees:           result.Moved.Payees,
+			Rules:            result.Moved.Rules,
+			Subcategories:    result.Moved.Subcategories,
+		},
+	}
+}
+
+func ToOAPICategoryTree(nodes []domain.CategoryNode) *[]openapi.Category {
+	oapiCategories := make(
+		[]openapi.Category,
+		0,
+		len(nodes),
+	)
+	for _, node := range nodes {
+		category := ToOAPICategory(&node.Category)
+		category.Children = ToOAPICategoryTree(node.Children)
+		oapiCategories = append(
+			oapiCategories,
+			*category,
+		)
+	}
+
+	return &oapiCategories
+}
+
 func FromOAPITag(tag openapi.Tag) *domain.Tag {
 	return &domain.Tag{
 		ID:              tag.Id,
@@ -381,6 +446,29 @@ func ToOAPITag(tag *domain.Tag) *openapi.Tag {
 	}
 }
 
+func ToOAPITagList(tags []*domain.Tag) []openapi.Tag {
+	tagList := make(
+		[]openapi.Tag,
+		0,
+		len(tags),
+	)
+	for _, tag := range tags {
+		tagList = append(
+			tagList,
+			*ToOAPITag(tag),
+		)
+	}
+
+	return tagList
+}
+
+func ToOAPITagMergeResult(result *domain.TagMergeResult) *openapi.TagMergeResult {
+	return &openapi.TagMergeResult{
+		Target: *ToOAPITag(&result.Target),
+		Moved:  result.Moved,
+	}
+}
+
 func FromOAPIExpenditureRequest(e *openapi.ExpenditureRequest) *domain.Expenditure {
 	var tagList []*domain.Tag
 	if e.Tags != nil {
@@ -417,13 +505,20 @@ func FromOAPIExpenditureRequest(e *openapi.ExpenditureRequest) *domain.Expenditu
 		planned = *e.Planned
 	}
 
+	// The category can be left out for payees with a default category
+	var category *domain.Category
+	if e.Category != nil {
+		category = FromOAPICategory(e.Category)
+	}
+
 	return &domain.Expenditure{
-		Category:    FromOAPICategory(&e.Category),
+		Category:    category,
 		Declared:    declared,
 		Planned:     planned,
 		Transaction: FromOAPIExpenditureRequestTransaction(e),
 		Tags:        &tagList,
 		Date:        date,
+		PayeeID:     e.PayeeId,
 	}
 }
 
@@ -443,36 +538,84 @@ func ToOAPIExpenditure(e *domain.Expenditure) *openapi.Expenditure {
 		}
 	}
 
+	status := openapi.ExpenditureStatusCompleted
+	if e.Transaction.Status != nil {
+		status = openapi.ExpenditureStatus(*e.Transaction.Status)
+	}
+
+	// Amount and currency are the ones the expenditure was made in, the charged ones are in account currency
+	amount := e.Transaction.Amount
+	if e.Transaction.OriginalAmount != nil {
+		amount = *e.Transaction.OriginalAmount
+	}
+	currency := e.Transaction.Currency
+	if e.Transaction.OriginalCurrency != nil {
+		currency = *e.Transaction.OriginalCurrency
+	}
+
 	return &openapi.Expenditure{
-		AccountId:   e.Transaction.AccountID,
-		Amount:      e.Transaction.Amount,
-		Category:    *ToOAPICategory(e.Category),
-		CreatedAt:   e.Transaction.CreatedAt,
-		Currency:    e.Transaction.Currency,
-		Date:        openapitypes.Date{Time: e.Date},
-		Declared:    &e.Declared,
-		Description: e.Transaction.Description,
-		Id:          e.ID,
-		Planned:     &e.Planned,
-		Tags:        &tagList,
-		UpdatedAt:   e.Transaction.UpdatedAt,
+		AccountId:           e.Transaction.AccountID,
+		Amount:              amount,
+		Category:            *ToOAPICategory(e.Category),
+		ChargedAmount:       e.Transaction.Amount,
+		ChargedCurrency:     e.Transaction.Currency,
+		CreatedAt:           e.Transaction.CreatedAt,
+		Currency:            currency,
+		Date:                openapitypes.Date{Time: e.Date},
+		Declared:            &e.Declared,
+		Description:         e.Transaction.Description,
+		ExchangeRate:        e.Transaction.ExchangeRate,
+		FailureReason:       e.Transaction.FailureReason,
+		Id:                  e.ID,
+		InstallmentNumber:   e.InstallmentNumber,
+		InstallmentPlanId:   e.InstallmentPlanID,
+		PayeeId:             e.PayeeID,
+		Planned:             &e.Planned,
+		RecurrencePatternId: e.RecurrencePatternID,
+		Status:              status,
+		Tags:                &tagList,
+		UpdatedAt:           e.Transaction.UpdatedAt,
 	}
 }
 
-func FromOAPIExpenditureListParams(p *openapi.ListExpendituresParams) *domain.ExpenditureListParams {
-	return &domain.ExpenditureListParams{
-		CategoryID:  p.CategoryId,
-		StartDate:   &p.StartDate.Time,
-		EndDate:     &p.EndDate.Time,
-		Declared:    p.Declared,
-		Planned:     p.Planned,
-		Currency:    p.Currency,
-		Description: p.Description,
-		AccountID:   p.AccountId,
-		Tags:        p.Tags,
-		Limit:       p.Limit,
-		Offset:      p.Offset,
+func FromOAPIExpenditureListParams(p *openapi.ListExpendituresParams) (
+	*domain.ExpenditureListParams,
+	error,
+) {
+	sort, err := domain.ParseSortOrders(p.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	var status *domain.TransactionStatus
+	if p.Status != nil {
+		transactionStatus := domain.TransactionStatus(*p.Status)
+		status = &transactionStatus
+	}
+
+	params := &domain.ExpenditureListParams{
+		CategoryID:          p.CategoryId,
+		Declared:            p.Declared,
+		Planned:             p.Planned,
+		Currency:            p.Currency,
+		Description:         p.Description,
+		AccountID:           p.AccountId,
+		Tags:                p.Tags,
+		Status:              status,
+		RecurrencePatternID: p.RecurrencePatternId,
+		PayeeID:             p.PayeeId,
+		Limit:               p.Limit,
+		Offset:              p.Offset,
+		Sort:                sort,
+	}
+	if p.StartDate != nil {
+		params.StartDate = &p.StartDate.Time
 	}
+	if p.EndDate != nil {
+		params.EndDate = &p.EndDate.Time
+	}
+
+	return params, nil
 }
 
 func ToOAPIExpenditureList(p *domain.ExpenditureList) *openapi.ExpenditureList {
@@ -489,11 +632,7 @@ func ToOAPIExpenditureList(p *domain.ExpenditureList) *openapi.ExpenditureList {
 	}
 
 	return &openapi.ExpenditureList{
-		Metadata: &openapi.ListMetadata{
-			Total:  p.Metadata.Total,
-			Offset: p.Metadata.Offset,
-			Limit:  p.Metadata.Limit,
-		},
+		Metadata:     ToOAPIListMetadata(p.Metadata),
 		Expenditures: &expenditures,
 	}
 }
@@ -573,9 +712,27 @@ func FromOAPICategoryRequest(
 		Color:           *c.Color,
 		BackgroundColor: *c.BackgroundColor,
 		CategoryType:    domain.CategoryType(*c.CategoryType),
+		ParentID:        c.ParentId,
 	}
 }
 
+func FromOAPICategoryUpdateRequest(
+	c *openapi.CategoryUpdateRequest,
+	id string,
+) *domain.Category {
+	return FromOAPICategoryRequest(
+		&openapi.CategoryRequest{
+			Name:            c.Name,
+			Description:     c.Description,
+			Color:           c.Color,
+			BackgroundColor: c.BackgroundColor,
+			CategoryType:    c.CategoryType,
+			ParentId:        c.ParentId,
+		},
+		&id,
+	)
+}
+
 func FromOAPICategory(c *openapi.Category) *domain.Category {
 	category := FromOAPICategoryTypeType(*c.CategoryType)
 
@@ -587,5 +744,1430 @@ func FromOAPICategory(c *openapi.Category) *domain.Category {
 		Color:           *c.Color,
 		BackgroundColor: *c.BackgroundColor,
 		CategoryType:    *category,
+		ParentID:        c.ParentId,
+	}
+}
+
+func FromOAPISearchParams(p *openapi.SearchParams) domain.SearchParams {
+	params := domain.SearchParams{
+		Query:  p.Q,
+		Limit:  defaultSearchLimit,
+		Offset: 0,
+	}
+	if p.Type != nil {
+		for _, t := range *p.Type {
+			params.Types = append(
+				params.Types,
+				domain.SearchHitType(t),
+			)
+		}
+	}
+	if p.StartDate != nil {
+		params.StartDate = &p.StartDate.Time
+	}
+	if p.EndDate != nil {
+		params.EndDate = &p.EndDate.Time
+	}
+	if p.Limit != nil {
+		params.Limit = *p.Limit
+	}
+	if p.Offset != nil {
+		params.Offset = *p.Offset
+	}
+
+	return params
+}
+
+func ToOAPISearchResult(r *domain.SearchResult) *openapi.SearchResult {
+	hits := make(
+		[]openapi.SearchHit,
+		0,
+		len(r.Hits),
+	)
+	for _, hit := range r.Hits {
+		highlights := make(
+			[]openapi.SearchHighlight,
+			0,
+			len(hit.Highlights),
+		)
+		for _, highlight := range hit.Highlights {
+			highlights = append(
+				highlights,
+				openapi.SearchHighlight{
+					Field:   highlight.Field,
+					Snippet: highlight.Snippet,
+				},
+			)
+		}
+
+		oapiHit := openapi.SearchHit{
+			Id:           hit.ID,
+			Type:         openapi.SearchHitType(hit.Type),
+			Date:         hit.Date,
+			Amount:       hit.Amount,
+			Currency:     hit.Currency,
+			AccountId:    hit.AccountID,
+			Description:  hit.Description,
+			Source:       hit.Source,
+			CategoryName: hit.CategoryName,
+			Score:        hit.Score,
+			Highlights:   highlights,
+		}
+		if len(hit.TagNames) > 0 {
+			oapiHit.Tags = &hit.TagNames
+		}
+
+		hits = append(
+			hits,
+			oapiHit,
+		)
+	}
+
+	return &openapi.SearchResult{
+		Hits:     hits,
+		Metadata: *ToOAPIListMetadata(r.Metadata),
+	}
+}
+
+func FromOAPIExpenditureRecurrencePatternRequest(
+	r *openapi.ExpenditureRecurrencePatternRequest,
+	id *string,
+) *domain.ExpenditureRecurrencePattern {
+	pattern := &domain.ExpenditureRecurrencePattern{
+		CategoryID:  r.CategoryId,
+		AccountID:   r.AccountId,
+		Amount:      r.Amount,
+		AmountType:  domain.RecurrenceAmountType(r.AmountType),
+		Description: r.Description,
+		Frequency:   domain.RecurrenceFrequency(r.Frequency),
+		Interval:    r.Interval,
+		StartDate:   r.StartDate.Time,
+		AutoPost:    r.AutoPost,
+		Active:      true,
+	}
+	if id != nil {
+		pattern.ID = *id
+	}
+	if r.EndDate != nil {
+		pattern.EndDate = &r.EndDate.Time
+	}
+	if r.Active != nil {
+		pattern.Active = *r.Active
+	}
+
+	return pattern
+}
+
+func ToOAPIExpenditureRecurrencePattern(p *domain.ExpenditureRecurrencePattern) *openapi.ExpenditureRecurrencePattern {
+	pattern := &openapi.ExpenditureRecurrencePattern{
+		Id:          p.ID,
+		CategoryId:  p.CategoryID,
+		AccountId:   p.AccountID,
+		Amount:      p.Amount,
+		AmountType:  openapi.RecurrenceAmountType(p.AmountType),
+		Description: p.Description,
+		Frequency:   openapi.RecurrenceFrequency(p.Frequency),
+		Interval:    p.Interval,
+		StartDate:   openapitypes.Date{Time: p.StartDate},
+		NextDueDate: openapitypes.Date{Time: p.NextDueDate},
+		AutoPost:    p.AutoPost,
+		Active:      p.Active,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+	if p.EndDate != nil {
+		pattern.EndDate = &openapitypes.Date{Time: *p.EndDate}
+	}
+
+	return pattern
+}
+
+func ToOAPIExpenditureRecurrencePatternList(patterns []domain.ExpenditureRecurrencePattern) *openapi.ExpenditureRecurrencePatternList {
+	list := make(
+		[]openapi.ExpenditureRecurrencePattern,
+		0,
+		len(patterns),
+	)
+	for _, p := range patterns {
+		list = append(
+			list,
+			*ToOAPIExpenditureRecurrencePattern(&p),
+		)
+	}
+
+	return &openapi.ExpenditureRecurrencePatternList{
+		Patterns: list,
+		Total:    len(list),
+	}
+}
+
+func ToOAPIUpcomingBillList(bills []domain.UpcomingBill) *openapi.UpcomingBillList {
+	list := make(
+		[]openapi.UpcomingBill,
+		0,
+		len(bills),
+	)
+	for _, bill := range bills {
+		list = append(
+			list,
+			openapi.UpcomingBill{
+				PatternId:   bill.PatternID,
+				CategoryId:  bill.CategoryID,
+				AccountId:   bill.AccountID,
+				Description: bill.Description,
+				Amount:      bill.Amount,
+				AmountType:  openapi.RecurrenceAmountType(bill.AmountType),
+				DueDate:     openapitypes.Date{Time: bill.DueDate},
+				AutoPost:    bill.AutoPost,
+			},
+		)
+	}
+
+	return &openapi.UpcomingBillList{
+		Bills: list,
+		Total: len(list),
+	}
+}
+
+func FromOAPIPlannedSpendingParams(p *openapi.GetPlannedSpendingReportParams) domain.PlannedSpendingParams {
+	params := domain.PlannedSpendingParams{
+		Period:        domain.ReportPeriodMonth,
+		Periods:       defaultReportPeriods,
+		EndDate:       time.Now().UTC(),
+		Currency:      p.Currency,
+		AccountID:     p.AccountId,
+		TopCategories: defaultReportTopCategories,
+		AmountBasis:   fromOAPIAmountBasis(p.AmountBasis),
+	}
+	if p.Period != nil {
+		params.Period = domain.ReportPeriod(*p.Period)
+	}
+	if p.Periods != nil {
+		params.Periods = *p.Periods
+	}
+	if p.EndDate != nil {
+		params.EndDate = p.EndDate.Time
+	}
+	if p.Top != nil && *p.Top >= 0 {
+		params.TopCategories = *p.Top
+	}
+	if p.RollUp != nil {
+		params.RollUp = *p.RollUp
+	}
+
+	return params
+}
+
+// fromOAPIAmountBasis defaults reports to the amounts charged to the accounts
+func fromOAPIAmountBasis(basis *openapi.AmountBasis) domain.AmountBasis {
+	if basis == nil {
+		return domain.AmountBasisCharged
+	}
+
+	return domain.AmountBasis(*basis)
+}
+
+func ToOAPIPlannedSpendingReport(r *domain.PlannedSpendingReport) *openapi.PlannedSpendingReport {
+	periods := make(
+		[]openapi.PlannedSpendingPeriod,
+		0,
+		len(r.Periods),
+	)
+	for _, period := range r.Periods {
+		periods = append(
+			periods,
+			openapi.PlannedSpendingPeriod{
+				Start:      openapitypes.Date{Time: period.Start},
+				End:        openapitypes.Date{Time: period.End},
+				Totals:     toOAPIPlannedSpendingTotals(period.Totals),
+				Categories: toOAPICategoryPlannedSpending(period.Categories),
+			},
+		)
+	}
+
+	return &openapi.PlannedSpendingReport{
+		Period:                 openapi.ReportPeriod(r.Period),
+		Currency:               r.Currency,
+		Periods:                periods,
+		Totals:                 toOAPIPlannedSpendingTotals(r.Totals),
+		TopUnplannedCategories: toOAPICategoryPlannedSpending(r.TopUnplannedCategories),
+		UnplannedTrend:         openapi.PlannedSpendingReportUnplannedTrend(r.UnplannedTrend),
+	}
+}
+
+func toOAPIPlannedSpendingTotals(t domain.PlannedSpendingTotals) openapi.PlannedSpendingTotals {
+	return openapi.PlannedSpendingTotals{
+		Planned:        t.Planned,
+		Unplanned:      t.Unplanned,
+		UnplannedRatio: t.UnplannedRatio,
+	}
+}
+
+func toOAPICategoryPlannedSpending(categories []domain.CategoryPlannedSpending) []openapi.CategoryPlannedSpending {
+	result := make(
+		[]openapi.CategoryPlannedSpending,
+		0,
+		len(categories),
+	)
+	for _, category := range categories {
+		result = append(
+			result,
+			openapi.CategoryPlannedSpending{
+				CategoryId:   category.CategoryID,
+				CategoryName: category.CategoryName,
+				Totals:       toOAPIPlannedSpendingTotals(category.PlannedSpendingTotals),
+			},
+		)
+	}
+
+	return result
+}
+
+func FromOAPIDeclaredExpensesParams(p *openapi.GetDeclaredExpensesReportParams) domain.DeclaredExpensesParams {
+	params := domain.DeclaredExpensesParams{
+		FiscalYear:           p.Year,
+		FiscalYearStartMonth: time.January,
+		Currency:             p.Currency,
+		MemberID:             p.MemberId,
+		AmountBasis:          fromOAPIAmountBasis(p.AmountBasis),
+	}
+	if p.StartMonth != nil {
+		params.FiscalYearStartMonth = time.Month(*p.StartMonth)
+	}
+	if p.RollUp != nil {
+		params.RollUp = *p.RollUp
+	}
+
+	return params
+}
+
+func ToOAPIDeclaredExpensesReport(r *domain.DeclaredExpensesReport) *openapi.DeclaredExpensesReport {
+	expenses := make(
+		[]openapi.DeclaredExpense,
+		0,
+		len(r.Expenses),
+	)
+	for _, expense := range r.Expenses {
+		tags := make(
+			[]openapi.TagLabel,
+			0,
+			len(expense.Tags),
+		)
+		for _, tag := range expense.Tags {
+			tags = append(
+				tags,
+				openapi.TagLabel{
+					Id:   tag.ID,
+					Name: tag.Name,
+				},
+			)
+		}
+		expenses = append(
+			expenses,
+			openapi.DeclaredExpense{
+				ExpenditureId: expense.ExpenditureID,
+				Date:          openapitypes.Date{Time: expense.Date},
+				Description:   expense.Description,
+				Amount:        expense.Amount,
+				Currency:      expense.Currency,
+				CategoryId:    expense.CategoryID,
+				CategoryName:  expense.CategoryName,
+				Tags:          tags,
+				AccountId:     expense.AccountID,
+				AccountName:   expense.AccountName,
+				MemberId:      expense.MemberID,
+				MemberName:    expense.MemberName,
+			},
+		)
+	}
+
+	return &openapi.DeclaredExpensesReport{
+		FiscalYear: r.FiscalYear,
+		Start:      openapitypes.Date{Time: r.Start},
+		End:        openapitypes.Date{Time: r.End},
+		Totals:     toOAPIDeclaredExpenseTotals(r.Totals),
+		ByCategory: toOAPIDeclaredExpenseTotals(r.ByCategory),
+		ByTag:      toOAPIDeclaredExpenseTotals(r.ByTag),
+		ByMember:   toOAPIDeclaredExpenseTotals(r.ByMember),
+		Expenses:   expenses,
+	}
+}
+
+func toOAPIDeclaredExpenseTotals(totals []domain.DeclaredExpenseTotal) []openapi.DeclaredExpenseTotal {
+	result := make(
+		[]openapi.DeclaredExpenseTotal,
+		0,
+		len(totals),
+	)
+	for _, total := range totals {
+		result = append(
+			result,
+			openapi.DeclaredExpenseTotal{
+				Id:       total.ID,
+				Name:     total.Name,
+				Currency: total.Currency,
+				Total:    total.Total,
+				Count:    total.Count,
+			},
+		)
+	}
+
+	return result
+}
+
+func FromOAPIPayeeRequest(
+	p *openapi.PayeeRequest,
+	id *string,
+) *domain.Payee {
+	payeeID := ""
+	if id != nil {
+		payeeID = *id
+	}
+	var aliases []string
+	if p.Aliases != nil {
+		aliases = *p.Aliases
+	}
+
+	return &domain.Payee{
+		ID:                payeeID,
+		Name:              p.Name,
+		Aliases:           aliases,
+		DefaultCategoryID: p.DefaultCategoryId,
+	}
+}
+
+func ToOAPIPayee(p *domain.Payee) *openapi.Payee {
+	return &openapi.Payee{
+		Id:                p.ID,
+		Name:              p.Name,
+		Aliases:           p.Aliases,
+		DefaultCategoryId: p.DefaultCategoryID,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+	}
+}
+
+func ToOAPIPayeeList(payees []domain.Payee) *openapi.PayeeList {
+	list := make(
+		[]openapi.Payee,
+		0,
+		len(payees),
+	)
+	for i := range payees {
+		list = append(
+			list,
+			*ToOAPIPayee(&payees[i]),
+		)
+	}
+
+	return &openapi.PayeeList{
+		Payees: list,
+		Total:  len(list),
+	}
+}
+
+func FromOAPIPayeeSpendingParams(
+	p *openapi.GetPayeeSpendingReportParams,
+	today time.Time,
+) domain.PayeeSpendingParams {
+	params := domain.PayeeSpendingParams{
+		From: domain.ReportPeriodMonth.Start(today),
+		To: time.Date(
+			today.Year(),
+			today.Month(),
+			today.Day(),
+			0,
+			0,
+			0,
+			0,
+			time.UTC,
+		),
+		Currency:    p.Currency,
+		AccountID:   p.AccountId,
+		AmountBasis: fromOAPIAmountBasis(p.AmountBasis),
+	}
+	if p.From != nil {
+		params.From = p.From.Time
+	}
+	if p.To != nil {
+		params.To = p.To.Time
+	}
+
+	return params
+}
+
+func ToOAPIPayeeSpendingReport(r *domain.PayeeSpendingReport) *openapi.PayeeSpendingReport {
+	payees := make(
+		[]openapi.PayeeSpending,
+		0,
+		len(r.Payees),
+	)
+	for _, payee := range r.Payees {
+		payees = append(
+			payees,
+			toOAPIPayeeSpending(payee),
+		)
+	}
+
+	return &openapi.PayeeSpendingReport{
+		Currency:   r.Currency,
+		From:       openapitypes.Date{Time: r.From},
+		To:         openapitypes.Date{Time: r.To},
+		Payees:     payees,
+		Unassigned: toOAPIPayeeSpending(r.Unassigned),
+		Total:      r.Total,
+	}
+}
+
+func toOAPIPayeeSpending(p domain.PayeeSpending) openapi.PayeeSpending {
+	return openapi.PayeeSpending{
+		PayeeId:   p.PayeeID,
+		PayeeName: p.PayeeName,
+		Total:     p.Total,
+		Count:     p.Count,
+	}
+}
+
+// fromOAPITagSpendingParams builds the parameters shared by the tag reports, the range defaults to the
+// current month up to today
+func fromOAPITagSpendingParams(
+	currency string,
+	from *openapitypes.Date,
+	to *openapitypes.Date,
+	accountID *string,
+	basis *openapi.AmountBasis,
+	today time.Time,
+) domain.TagSpendingParams {
+	params := domain.TagSpendingParams{
+		From: domain.ReportPeriodMonth.Start(today),
+		To: time.Date(
+			today.Year(),
+			today.Month(),
+			today.Day(),
+			0,
+			0,
+			0,
+			0,
+			time.UTC,
+		),
+		Currency:    currency,
+		AccountID:   accountID,
+		AmountBasis: fromOAPIAmountBasis(basis),
+	}
+	if from != nil {
+		params.From = from.Time
+	}
+	if to != nil {
+		params.To = to.Time
+	}
+
+	return params
+}
+
+func FromOAPITagSpendingParams(
+	p *openapi.GetTagSpendingReportParams,
+	today time.Time,
+) domain.TagSpendingParams {
+	return fromOAPITagSpendingParams(
+		p.Currency,
+		p.From,
+		p.To,
+		p.AccountId,
+		p.AmountBasis,
+		today,
+	)
+}
+
+func FromOAPITagCombinationParams(
+	p *openapi.GetTagCombinationReportParams,
+	today time.Time,
+) domain.TagCombinationParams {
+	params := domain.TagCombinationParams{
+		TagSpendingParams: fromOAPITagSpendingParams(
+			p.Currency,
+			p.From,
+			p.To,
+			p.AccountId,
+			p.AmountBasis,
+			today,
+		),
+		TagIDs: p.TagIds,
+		Match:  domain.TagMatchAll,
+	}
+	if p.Match != nil {
+		params.Match = domain.TagMatch(*p.Match)
+	}
+
+	return params
+}
+
+func FromOAPITagCategoryParams(
+	tagID string,
+	p *openapi.GetTagCategoryReportParams,
+	today time.Time,
+) domain.TagCategoryParams {
+	params := domain.TagCategoryParams{
+		TagSpendingParams: fromOAPITagSpendingParams(
+			p.Currency,
+			p.From,
+			p.To,
+			p.AccountId,
+			p.AmountBasis,
+			today,
+		),
+		TagID: tagID,
+	}
+	if p.RollUp != nil {
+		params.RollUp = *p.RollUp
+	}
+
+	return params
+}
+
+func ToOAPITagSpendingReport(r *domain.TagSpendingReport) *openapi.TagSpendingReport {
+	return &openapi.TagSpendingReport{
+		Currency:     r.Currency,
+		From:         openapitypes.Date{Time: r.From},
+		To:           openapitypes.Date{Time: r.To},
+		Expenditures: toOAPITagSpendingList(r.Expenditures),
+		Ingresses:    toOAPITagSpendingList(r.Ingresses),
+	}
+}
+
+func toOAPITagSpendingList(entries []domain.TagSpending) []openapi.TagSpending {
+	tags := make(
+		[]openapi.TagSpending,
+		0,
+		len(entries),
+	)
+	for _, entry := range entries {
+		tags = append(
+			tags,
+			openapi.TagSpending{
+				TagId:   entry.TagID,
+				TagName: entry.TagName,
+				TagType: *ToOAPITagType(&entry.TagType),
+				Total:   entry.Total,
+				Count:   entry.Count,
+			},
+		)
+	}
+
+	return tags
+}
+
+func ToOAPITagCombinationReport(r *domain.TagCombinationReport) *openapi.TagCombinationReport {
+	tags := make(
+		[]openapi.Tag,
+		0,
+		len(r.Tags),
+	)
+	for _, tag := range r.Tags {
+		tags = append(
+			tags,
+			*ToOAPITag(&tag),
+		)
+	}
+
+	return &openapi.TagCombinationReport{
+		Currency: r.Currency,
+		From:     openapitypes.Date{Time: r.From},
+		To:       openapitypes.Date{Time: r.To},
+		Tags:     tags,
+		Match:    openapi.TagMatch(r.Match),
+		Total:    r.Total,
+		Count:    r.Count,
+	}
+}
+
+func ToOAPITagCategoryReport(r *domain.TagCategoryReport) *openapi.TagCategoryReport {
+	categories := make(
+		[]openapi.TagCategorySpending,
+		0,
+		len(r.Categories),
+	)
+	for _, category := range r.Categories {
+		categories = append(
+			categories,
+			openapi.TagCategorySpending{
+				CategoryId:   category.CategoryID,
+				CategoryName: category.CategoryName,
+				Total:        category.Total,
+				Count:        category.Count,
+			},
+		)
+	}
+
+	return &openapi.TagCategoryReport{
+		Currency:   r.Currency,
+		From:       openapitypes.Date{Time: r.From},
+		To:         openapitypes.Date{Time: r.To},
+		Tag:        *ToOAPITag(&r.Tag),
+		Categories: categories,
+		Total:      r.Total,
+		Count:      r.Count,
+	}
+}
+
+func FromOAPIExpenditureBatchRequest(r *openapi.ExpenditureBatchRequest) (
+	[]domain.Expenditure,
+	domain.BatchMode,
+) {
+	expenditures := make(
+		[]domain.Expenditure,
+		0,
+		len(r.Items),
+	)
+	for i := range r.Items {
+		expenditures = append(
+			expenditures,
+			*FromOAPIExpenditureRequest(fromOAPIExpenditureBatchItem(&r.Items[i])),
+		)
+	}
+
+	return expenditures, domain.BatchMode(r.Mode)
+}
+
+// fromOAPIExpenditureBatchItem fills the missing fields of a batch item with their zero value, the
+// batch reports them by index
+func fromOAPIExpenditureBatchItem(i *openapi.ExpenditureBatchItem) *openapi.ExpenditureRequest {
+	request := &openapi.ExpenditureRequest{
+		Category:     i.Category,
+		Declared:     i.Declared,
+		ExchangeRate: i.ExchangeRate,
+		PayeeId:      i.PayeeId,
+		Planned:      i.Planned,
+		Tags:         i.Tags,
+	}
+	if i.AccountId != nil {
+		request.AccountId = *i.AccountId
+	}
+	if i.Amount != nil {
+		request.Amount = *i.Amount
+	}
+	if i.Currency != nil {
+		request.Currency = *i.Currency
+	}
+	if i.Date != nil {
+		request.Date = *i.Date
+	}
+	if i.Description != nil {
+		request.Description = *i.Description
+	}
+
+	return request
+}
+
+func ToOAPIExpenditureBatchResult(r *domain.ExpenditureBatchResult) *openapi.ExpenditureBatchResult {
+	created := make(
+		[]openapi.ExpenditureBatchCreated,
+		0,
+		len(r.Created),
+	)
+	for _, item := range r.Created {
+		created = append(
+			created,
+			openapi.ExpenditureBatchCreated{
+				Index:       item.Index,
+				Expenditure: *ToOAPIExpenditure(&item.Expenditure),
+			},
+		)
+	}
+
+	errs := make(
+		[]openapi.ExpenditureBatchError,
+		0,
+		len(r.Errors),
+	)
+	for _, item := range r.Errors {
+		errs = append(
+			errs,
+			openapi.ExpenditureBatchError{
+				Index:   item.Index,
+				Message: item.Err.Error(),
+			},
+		)
+	}
+
+	return &openapi.ExpenditureBatchResult{
+		Mode:    openapi.BatchMode(r.Mode),
+		Created: created,
+		Errors:  errs,
+	}
+}
+
+func FromOAPIRefundRequest(
+	expenditureID string,
+	r *openapi.RefundRequest,
+) domain.RefundRequest {
+	request := domain.RefundRequest{
+		ExpenditureID: expenditureID,
+		Amount:        r.Amount,
+		AccountID:     r.AccountId,
+		Description:   r.Description,
+	}
+	if r.Date != nil {
+		request.Date = &r.Date.Time
+	}
+
+	return request
+}
+
+func ToOAPIRefund(r *domain.Refund) *openapi.Refund {
+	return &openapi.Refund{
+		Id:               r.ID,
+		ExpenditureId:    r.ExpenditureID,
+		AccountId:        r.Transaction.AccountID,
+		Amount:           r.Amount(),
+		CreditedAmount:   r.Transaction.Amount,
+		CreditedCurrency: r.Transaction.Currency,
+		Date:             openapitypes.Date{Time: r.Transaction.TransactionDate},
+		Description:      r.Transaction.Description,
+		CreatedAt:        r.CreatedAt,
+	}
+}
+
+func ToOAPIRefundList(l *domain.RefundList) *openapi.RefundList {
+	refunds := make(
+		[]openapi.Refund,
+		0,
+		len(l.Refunds),
+	)
+	for i := range l.Refunds {
+		refunds = append(
+			refunds,
+			*ToOAPIRefund(&l.Refunds[i]),
+		)
+	}
+
+	return &openapi.RefundList{
+		Refunds:   refunds,
+		Refunded:  l.Refunded,
+		Remaining: l.Remaining,
+	}
+}
+
+func FromOAPIInstallmentPlanRequest(r *openapi.InstallmentPlanRequest) *domain.InstallmentPlan {
+	plan := &domain.InstallmentPlan{
+		AccountID:       r.AccountId,
+		CategoryID:      r.CategoryId,
+		Description:     r.Description,
+		TotalAmount:     r.TotalAmount,
+		Installments:    r.Installments,
+		FirstChargeDate: r.FirstChargeDate.Time,
+	}
+	if r.InterestRate != nil {
+		plan.InterestRate = *r.InterestRate
+	}
+
+	return plan
+}
+
+func ToOAPIInstallmentPlan(p *domain.InstallmentPlan) *openapi.InstallmentPlan {
+	charges := make(
+		[]openapi.InstallmentCharge,
+		0,
+		len(p.Charges),
+	)
+	for _, charge := range p.Charges {
+		charges = append(
+			charges,
+			openapi.InstallmentCharge{
+				Number:        charge.Number,
+				ExpenditureId: charge.ExpenditureID,
+				Amount:        charge.Amount,
+				Currency:      charge.Currency,
+				ChargeDate:    openapitypes.Date{Time: charge.ChargeDate},
+				Status:        openapi.ExpenditureStatus(charge.Status),
+			},
+		)
+	}
+
+	return &openapi.InstallmentPlan{
+		Id:                  p.ID,
+		AccountId:           p.AccountID,
+		CategoryId:          p.CategoryID,
+		Description:         p.Description,
+		TotalAmount:         p.TotalAmount,
+		InterestRate:        p.InterestRate,
+		Installments:        p.Installments,
+		FirstChargeDate:     openapitypes.Date{Time: p.FirstChargeDate},
+		FinancedAmount:      p.FinancedAmount(),
+		RemainingAmount:     p.RemainingAmount(),
+		PendingInstallments: len(p.UnpaidCharges()),
+		Charges:             charges,
+		CreatedAt:           p.CreatedAt,
+	}
+}
+
+func ToOAPIInstallmentPlanList(plans []domain.InstallmentPlan) *openapi.InstallmentPlanList {
+	list := make(
+		[]openapi.InstallmentPlan,
+		0,
+		len(plans),
+	)
+	for i := range plans {
+		list = append(
+			list,
+			*ToOAPIInstallmentPlan(&plans[i]),
+		)
+	}
+
+	return &openapi.InstallmentPlanList{
+		Plans: list,
+		Total: len(list),
+	}
+}
+
+func ToOAPIInstallmentObligationList(obligations []domain.InstallmentObligation) *openapi.InstallmentObligationList {
+	list := make(
+		[]openapi.InstallmentObligation,
+		0,
+		len(obligations),
+	)
+	for _, obligation := range obligations {
+		list = append(
+			list,
+			openapi.InstallmentObligation{
+				AccountId:           obligation.AccountID,
+				HouseholdMemberId:   obligation.HouseholdMemberID,
+				Currency:            obligation.Currency,
+				RemainingAmount:     obligation.RemainingAmount,
+				PendingInstallments: obligation.PendingInstallments,
+				Plans:               obligation.Plans,
+			},
+		)
+	}
+
+	return &openapi.InstallmentObligationList{
+		Obligations: list,
+	}
+}
+
+func FromOAPIBudgetRequest(
+	r *openapi.BudgetRequest,
+	id *string,
+) *domain.Budget {
+	budget := &domain.Budget{
+		CategoryID: r.CategoryId,
+		Amount:     r.Amount,
+		Currency:   r.Currency,
+		PeriodType: domain.BudgetPeriodType(r.PeriodType),
+		PeriodDays: r.PeriodDays,
+		Rollover:   domain.BudgetRolloverReset,
+		StartDate:  r.StartDate.Time,
+		Active:     true,
+	}
+	if id != nil {
+		budget.ID = *id
+	}
+	if r.EndDate != nil {
+		budget.EndDate = &r.EndDate.Time
+	}
+	if r.Rollover != nil {
+		budget.Rollover = domain.BudgetRollover(*r.Rollover)
+	}
+	if r.Active != nil {
+		budget.Active = *r.Active
+	}
+
+	return budget
+}
+
+func ToOAPIBudget(b *domain.Budget) *openapi.Budget {
+	budget := &openapi.Budget{
+		Id:         b.ID,
+		CategoryId: b.CategoryID,
+		Amount:     b.Amount,
+		Currency:   b.Currency,
+		PeriodType: openapi.BudgetPeriodType(b.PeriodType),
+		PeriodDays: b.PeriodDays,
+		Rollover:   openapi.BudgetRollover(b.Rollover),
+		StartDate:  openapitypes.Date{Time: b.StartDate},
+		Active:     b.Active,
+		CreatedAt:  b.CreatedAt,
+		UpdatedAt:  b.UpdatedAt,
+	}
+	if b.EndDate != nil {
+		budget.EndDate = &openapitypes.Date{Time: *b.EndDate}
+	}
+
+	return budget
+}
+
+func ToOAPIBudgetList(budgets []domain.Budget) *openapi.BudgetList {
+	list := make(
+		[]openapi.Budget,
+		0,
+		len(budgets),
+	)
+	for i := range budgets {
+		list = append(
+			list,
+			*ToOAPIBudget(&budgets[i]),
+		)
+	}
+
+	return &openapi.BudgetList{
+		Budgets: list,
+		Total:   len(list),
+	}
+}
+
+func ToOAPIBudgetStatus(s *domain.BudgetStatus) *openapi.BudgetStatus {
+	return &openapi.BudgetStatus{
+		Budget:      *ToOAPIBudget(&s.Budget),
+		Period:      toOAPIBudgetPeriod(s.Period),
+		Budgeted:    s.Budgeted,
+		Carried:     s.Carried,
+		Available:   s.Available,
+		Spent:       s.Spent,
+		Remaining:   s.Remaining,
+		PercentUsed: s.PercentUsed,
+	}
+}
+
+func ToOAPIBudgetHistory(h *domain.BudgetHistory) *openapi.BudgetHistory {
+	periods := make(
+		[]openapi.BudgetPeriodStatus,
+		0,
+		len(h.Periods),
+	)
+	for _, p := range h.Periods {
+		periods = append(
+			periods,
+			openapi.BudgetPeriodStatus{
+				Period:      toOAPIBudgetPeriod(p.Period),
+				Budgeted:    p.Budgeted,
+				Carried:     p.Carried,
+				Available:   p.Available,
+				Spent:       p.Spent,
+				Remaining:   p.Remaining,
+				PercentUsed: p.PercentUsed,
+			},
+		)
+	}
+
+	return &openapi.BudgetHistory{
+		Budget:  *ToOAPIBudget(&h.Budget),
+		Periods: periods,
+	}
+}
+
+func toOAPIBudgetPeriod(p domain.BudgetPeriod) openapi.BudgetPeriod {
+	return openapi.BudgetPeriod{
+		Number: p.Number,
+		Start:  openapitypes.Date{Time: p.Start},
+		End:    openapitypes.Date{Time: p.End},
+	}
+}
+
+func ToOAPIBudgetOverview(o *domain.BudgetOverview) *openapi.BudgetOverview {
+	statuses := make(
+		[]openapi.BudgetStatus,
+		0,
+		len(o.Statuses),
+	)
+	for i := range o.Statuses {
+		statuses = append(
+			statuses,
+			*ToOAPIBudgetStatus(&o.Statuses[i]),
+		)
+	}
+	totals := make(
+		[]openapi.BudgetTotal,
+		0,
+		len(o.Totals),
+	)
+	for _, total := range o.Totals {
+		totals = append(
+			totals,
+			openapi.BudgetTotal{
+				Currency:  total.Currency,
+				Budgeted:  total.Budgeted,
+				Available: total.Available,
+				Spent:     total.Spent,
+				Remaining: total.Remaining,
+			},
+		)
+	}
+
+	return &openapi.BudgetOverview{
+		Date:    openapitypes.Date{Time: o.Date},
+		Budgets: statuses,
+		Totals:  totals,
+	}
+}
+
+func FromOAPIAllocationPlanRequest(r *openapi.AllocationPlanRequest) *domain.AllocationPlan {
+	plan := FromOAPIAllocationPlanUpdateRequest(
+		&openapi.AllocationPlanUpdateRequest{
+			IncomeBasis: r.IncomeBasis,
+			Allocations: r.Allocations,
+		},
+		"",
+	)
+	plan.Month = r.Month.Time
+	plan.Currency = r.Currency
+
+	return plan
+}
+
+func FromOAPIAllocationPlanUpdateRequest(
+	r *openapi.AllocationPlanUpdateRequest,
+	id string,
+) *domain.AllocationPlan {
+	plan := &domain.AllocationPlan{
+		ID:          id,
+		IncomeBasis: domain.IncomeBasisExpected,
+		Allocations: make(
+			[]domain.Allocation,
+			0,
+			len(r.Allocations),
+		),
+	}
+	if r.IncomeBasis != nil {
+		plan.IncomeBasis = domain.IncomeBasis(*r.IncomeBasis)
+	}
+	for _, allocation := range r.Allocations {
+		plan.Allocations = append(
+			plan.Allocations,
+			domain.Allocation{
+				CategoryID:    allocation.CategoryId,
+				SavingsGoalID: allocation.SavingsGoalId,
+				Amount:        allocation.Amount,
+			},
+		)
+	}
+
+	return plan
+}
+
+func ToOAPIAllocationPlan(s *domain.AllocationPlanStatus) *openapi.AllocationPlan {
+	allocations := make(
+		[]openapi.AllocationStatus,
+		0,
+		len(s.Allocations),
+	)
+	for _, allocation := range s.Allocations {
+		allocations = append(
+			allocations,
+			openapi.AllocationStatus{
+				CategoryId:    allocation.CategoryID,
+				SavingsGoalId: allocation.SavingsGoalID,
+				Amount:        allocation.Amount,
+				Actual:        allocation.Actual,
+				Difference:    allocation.Difference,
+			},
+		)
+	}
+
+	return &openapi.AllocationPlan{
+		Id:             s.Plan.ID,
+		Month:          openapitypes.Date{Time: s.Plan.Month},
+		Currency:       s.Plan.Currency,
+		IncomeBasis:    openapi.IncomeBasis(s.Plan.IncomeBasis),
+		Allocations:    allocations,
+		ExpectedIncome: s.ExpectedIncome,
+		ActualIncome:   s.ActualIncome,
+		Allocated:      s.Allocated,
+		ToBeAssigned:   s.ToBeAssigned,
+		Spent:          s.Spent,
+		Contributed:    s.Contributed,
+		Unplanned:      s.Unplanned,
+		CreatedAt:      s.Plan.CreatedAt,
+		UpdatedAt:      s.Plan.UpdatedAt,
+	}
+}
+
+func ToOAPIAllocationPlanList(statuses []domain.AllocationPlanStatus) *openapi.AllocationPlanList {
+	list := make(
+		[]openapi.AllocationPlan,
+		0,
+		len(statuses),
+	)
+	for i := range statuses {
+		list = append(
+			list,
+			*ToOAPIAllocationPlan(&statuses[i]),
+		)
+	}
+
+	return &openapi.AllocationPlanList{
+		Plans: list,
+		Total: len(list),
+	}
+}
+
+func FromOAPICategorizationRuleRequest(
+	r *openapi.CategorizationRuleRequest,
+	id *string,
+) *domain.CategorizationRule {
+	ruleID := ""
+	if id != nil {
+		ruleID = *id
+	}
+	priority := 0
+	if r.Priority != nil {
+		priority = *r.Priority
+	}
+	active := true
+	if r.Active != nil {
+		active = *r.Active
+	}
+	var description *domain.DescriptionCondition
+	if r.Description != nil {
+		description = &domain.DescriptionCondition{
+			Match:   domain.DescriptionMatch(r.Description.Match),
+			Pattern: r.Description.Pattern,
+		}
+	}
+	var tagIDs []string
+	if r.TagIds != nil {
+		tagIDs = *r.TagIds
+	}
+
+	return &domain.CategorizationRule{
+		ID:          ruleID,
+		Name:        r.Name,
+		Priority:    priority,
+		Active:      active,
+		Description: description,
+		MinAmount:   r.MinAmount,
+		MaxAmount:   r.MaxAmount,
+		AccountID:   r.AccountId,
+		PayeeID:     r.PayeeId,
+		CategoryID:  r.CategoryId,
+		TagIDs:      tagIDs,
+	}
+}
+
+func ToOAPICategorizationRule(r *domain.CategorizationRule) *openapi.CategorizationRule {
+	var description *openapi.DescriptionCondition
+	if r.Description != nil {
+		description = &openapi.DescriptionCondition{
+			Match:   openapi.DescriptionMatch(r.Description.Match),
+			Pattern: r.Description.Pattern,
+		}
+	}
+
+	return &openapi.CategorizationRule{
+		Id:          r.ID,
+		Name:        r.Name,
+		Priority:    r.Priority,
+		Active:      r.Active,
+		Description: description,
+		MinAmount:   r.MinAmount,
+		MaxAmount:   r.MaxAmount,
+		AccountId:   r.AccountID,
+		PayeeId:     r.PayeeID,
+		CategoryId:  r.CategoryID,
+		TagIds:      r.TagIDs,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+func ToOAPICategorizationRuleList(rules []domain.CategorizationRule) *openapi.CategorizationRuleList {
+	list := make(
+		[]openapi.CategorizationRule,
+		0,
+		len(rules),
+	)
+	for i := range rules {
+		list = append(
+			list,
+			*ToOAPICategorizationRule(&rules[i]),
+		)
+	}
+
+	return &openapi.CategorizationRuleList{
+		Rules: list,
+		Total: len(list),
+	}
+}
+
+func FromOAPICategorizationRuleApplyRequest(r *openapi.CategorizationRuleApplyRequest) *domain.RuleCandidateParams {
+	params := &domain.RuleCandidateParams{
+		CategoryID: r.CategoryId,
+	}
+	if r.ExpenditureIds != nil {
+		params.ExpenditureIDs = *r.ExpenditureIds
+	}
+	if r.StartDate != nil {
+		params.StartDate = &r.StartDate.Time
+	}
+	if r.EndDate != nil {
+		params.EndDate = &r.EndDate.Time
+	}
+
+	return params
+}
+
+func FromOAPICategorizationRulePreviewRequest(r *openapi.CategorizationRulePreviewRequest) (
+	*domain.CategorizationRule,
+	*domain.RuleCandidateParams,
+) {
+	rule := FromOAPICategorizationRuleRequest(
+		&r.Rule,
+		nil,
+	)
+	params := FromOAPICategorizationRuleApplyRequest(
+		&openapi.CategorizationRuleApplyRequest{
+			CategoryId:     r.CategoryId,
+			EndDate:        r.EndDate,
+			ExpenditureIds: r.ExpenditureIds,
+			StartDate:      r.StartDate,
+		},
+	)
+
+	return rule, params
+}
+
+func ToOAPICategorizationRun(run *domain.CategorizationRun) *openapi.CategorizationRun {
+	categorizations := make(
+		[]openapi.Categorization,
+		0,
+		len(run.Categorizations),
+	)
+	for _, categorization := range run.Categorizations {
+		ruleIDs := categorization.RuleIDs
+		if ruleIDs == nil {
+			ruleIDs = make(
+				[]string,
+				0,
+			)
+		}
+		categorizations = append(
+			categorizations,
+			openapi.Categorization{
+				ExpenditureId:  categorization.ExpenditureID,
+				Date:           openapitypes.Date{Time: categorization.Date},
+				Description:    categorization.Description,
+				Amount:         categorization.Amount,
+				Currency:       categorization.Currency,
+				FromCategoryId: categorization.FromCategoryID,
+				CategoryId:     categorization.CategoryID,
+				AddedTagIds:    categorization.AddedTagIDs,
+				RuleIds:        ruleIDs,
+			},
+		)
+	}
+
+	return &openapi.CategorizationRun{
+		Categorizations: categorizations,
+		Total:           run.Total,
+	}
+}
+
+func ToOAPICategorySuggestion(s *domain.CategorySuggestion) *openapi.CategorySuggestion {
+	return &openapi.CategorySuggestion{
+		Categories: ToOAPIScoredSuggestions(s.Categories),
+		Tags:       ToOAPIScoredSuggestions(s.Tags),
+		Status:     *ToOAPISuggestionModelStatus(&s.Status),
+	}
+}
+
+func ToOAPIScoredSuggestions(suggestions []domain.ScoredSuggestion) []openapi.ScoredSuggestion {
+	scored := make(
+		[]openapi.ScoredSuggestion,
+		0,
+		len(suggestions),
+	)
+	for _, suggestion := range suggestions {
+		scored = append(
+			scored,
+			openapi.ScoredSuggestion{
+				Id:         suggestion.ID,
+				Name:       suggestion.Name,
+				Confidence: suggestion.Confidence,
+			},
+		)
+	}
+
+	return scored
+}
+
+func ToOAPISuggestionModelStatus(s *domain.SuggestionModelStatus) *openapi.SuggestionModelStatus {
+	return &openapi.SuggestionModelStatus{
+		Examples:   s.Examples,
+		Categories: s.Categories,
+		Tags:       s.Tags,
+		Vocabulary: s.Vocabulary,
+	}
+}
+
+func FromOAPIHouseholdRequest(h *openapi.HouseholdRequest) *domain.Household {
+	return &domain.Household{
+		Name: h.Name,
+	}
+}
+
+func ToOAPIHousehold(h *domain.Household) *openapi.Household {
+	return &openapi.Household{
+		Id:        h.ID,
+		Name:      h.Name,
+		CreatedAt: h.CreatedAt,
+	}
+}
+
+func ToOAPIUser(u *domain.User) *openapi.User {
+	return &openapi.User{
+		Id:                u.ID,
+		Email:             openapitypes.Email(u.Email),
+		FirstName:         u.FirstName,
+		LastName:          u.LastName,
+		HouseholdMemberId: u.HouseholdMemberID,
+		HouseholdId:       u.HouseholdID,
+		Roles:             &u.Roles,
+		CreatedAt:         u.CreatedAt,
+		UpdatedAt:         u.UpdatedAt,
+	}
+}
+
+func ToOAPILoginResponse(
+	token *domain.AuthToken,
+	user *domain.User,
+) *openapi.LoginResponse {
+	return &openapi.LoginResponse{
+		Token:     &token.Token,
+		ExpiresAt: &token.ExpiresAt,
+		User:      ToOAPIUser(user),
+	}
+}
+
+func FromOAPIInvitationRequest(i *openapi.InvitationRequest) *domain.Invitation {
+	return &domain.Invitation{
+		Email:    string(i.Email),
+		MemberID: i.MemberId,
+	}
+}
+
+func ToOAPIInvitation(i *domain.Invitation) *openapi.Invitation {
+	return &openapi.Invitation{
+		Id:        i.ID,
+		Email:     openapitypes.Email(i.Email),
+		MemberId:  i.MemberID,
+		ExpiresAt: i.ExpiresAt,
+	}
+}
+
+func ToOAPIRole(r *domain.Role) *openapi.Role {
+	permissions := make(
+		[]openapi.Permission,
+		0,
+		len(r.Permissions),
+	)
+	for _, permission := range r.Permissions {
+		permissions = append(
+			permissions,
+			openapi.Permission(permission),
+		)
+	}
+
+	return &openapi.Role{
+		Id:          r.ID,
+		Name:        r.Name,
+		Permissions: permissions,
 	}
 }
@@ -16,11 +16,31 @@ func (c *Controller) ListExpenditures(
 	openapi.ListExpendituresResponseObject,
 	error,
 ) {
+	params, err := FromOAPIExpenditureListParams(&request.Params)
+	if err != nil {
+		return openapi.ListExpenditures400JSONResponse{
+			N400JSONResponse: openapi.N400JSONResponse{
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
 	list, err := c.useCases.Expenditure.List(
 		ctx,
-		*FromOAPIExpenditureListParams(&request.Params),
+		*params,
 	)
 	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrInvalidSortKey,
+		) {
+			return openapi.ListExpenditures400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+
 		log.Err(err).Msg("Failed to list expenditures")
 
 		return openapi.ListExpenditures500JSONResponse{
@@ -43,9 +63,13 @@ func (c *Controller) CreateExpenditure(
 	expenditure, err := c.useCases.Expenditure.Create(
 		ctx,
 		*FromOAPIExpenditureRequest(request.Body),
+		request.Params.Force != nil && *request.Params.Force,
 	)
 	if err != nil {
 		if errors.Is(
+			err,
+			domain.ErrPossibleDuplicate,
+		) || errors.Is(
 			err,
 			domain.ErrCategoryInactive,
 		) || errors.Is(
@@ -70,6 +94,18 @@ func (c *Controller) CreateExpenditure(
 		) || errors.Is(
 			err,
 			domain.ErrTagNotFound,
+		) || errors.Is(
+			err,
+			domain.ErrPayeeNotFound,
+		) || errors.Is(
+			err,
+			domain.ErrExpenditureCategoryRequired,
+		) || errors.Is(
+			err,
+			domain.ErrExchangeRateNotFound,
+		) || errors.Is(
+			err,
+			domain.ErrInvalidExchangeRate,
 		) {
 			return openapi.CreateExpenditure400JSONResponse{
 				N400JSONResponse: openapi.N400JSONResponse{
@@ -90,6 +126,54 @@ func (c *Controller) CreateExpenditure(
 	return openapi.CreateExpenditure201JSONResponse(*ToOAPIExpenditure(expenditure)), nil
 }
 
+func (c *Controller) CreateExpenditureBatch(
+	ctx context.Context,
+	request openapi.CreateExpenditureBatchRequestObject,
+) (
+	openapi.CreateExpenditureBatchResponseObject,
+	error,
+) {
+	expenditures, mode := FromOAPIExpenditureBatchRequest(request.Body)
+	result, err := c.useCases.Expenditure.CreateBatch(
+		ctx,
+		expenditures,
+		mode,
+		request.Params.Force != nil && *request.Params.Force,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrBatchEmpty,
+		) || errors.Is(
+			err,
+			domain.ErrBatchTooLarge,
+		) || errors.Is(
+			err,
+			domain.ErrInvalidBatchMode,
+		) {
+			return openapi.CreateExpenditureBatch400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+
+		log.Err(err).Msg("Failed to create expenditure batch")
+
+		return openapi.CreateExpenditureBatch500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to create expenditure batch",
+			},
+		}, nil
+	}
+
+	if len(result.Created) == 0 {
+		return openapi.CreateExpenditureBatch422JSONResponse(*ToOAPIExpenditureBatchResult(result)), nil
+	}
+
+	return openapi.CreateExpenditureBatch201JSONResponse(*ToOAPIExpenditureBatchResult(result)), nil
+}
+
 func (c *Controller) GetExpenditure(
 	ctx context.Context,
 	request openapi.GetExpenditureRequestObject,
@@ -124,3 +208,111 @@ func (c *Controller) GetExpenditure(
 
 	return openapi.GetExpenditure200JSONResponse(*ToOAPIExpenditure(expenditure)), nil
 }
+
+func (c *Controller) RefundExpenditure(
+	ctx context.Context,
+	request openapi.RefundExpenditureRequestObject,
+) (
+	openapi.RefundExpenditureResponseObject,
+	error,
+) {
+	refund, err := c.useCases.Expenditure.Refund(
+		ctx,
+		FromOAPIRefundRequest(
+			request.Id,
+			request.Body,
+		),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrExpenditureNotFound,
+		) {
+			return openapi.RefundExpenditure404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrRefundExceedsExpenditure,
+		) || errors.Is(
+			err,
+			domain.ErrExpenditureNotRefundable,
+		) || errors.Is(
+			err,
+			domain.ErrAccountInactive,
+		) {
+			return openapi.RefundExpenditure409JSONResponse{
+				N409JSONResponse: openapi.N409JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrInvalidRefundAmount,
+		) || errors.Is(
+			err,
+			domain.ErrAccountNotFound,
+		) || errors.Is(
+			err,
+			domain.ErrExchangeRateNotFound,
+		) || errors.Is(
+			err,
+			domain.ErrInvalidExchangeRate,
+		) {
+			return openapi.RefundExpenditure400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+
+		log.Err(err).Msg("Failed to refund expenditure")
+
+		return openapi.RefundExpenditure500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to refund expenditure",
+			},
+		}, nil
+	}
+
+	return openapi.RefundExpenditure201JSONResponse(*ToOAPIRefund(refund)), nil
+}
+
+func (c *Controller) ListExpenditureRefunds(
+	ctx context.Context,
+	request openapi.ListExpenditureRefundsRequestObject,
+) (
+	openapi.ListExpenditureRefundsResponseObject,
+	error,
+) {
+	refunds, err := c.useCases.Expenditure.ListRefunds(
+		ctx,
+		request.Id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrExpenditureNotFound,
+		) {
+			return openapi.ListExpenditureRefunds404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+
+		log.Err(err).Msg("Failed to list expenditure refunds")
+
+		return openapi.ListExpenditureRefunds500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to list expenditure refunds",
+			},
+		}, nil
+	}
+
+	return openapi.ListExpenditureRefunds200JSONResponse(*ToOAPIRefundList(refunds)), nil
+}
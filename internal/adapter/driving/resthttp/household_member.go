@@ -10,8 +10,25 @@ import (
 )
 
 func (c *Controller) ListHouseholdMembers(ctx context.Context, request openapi.ListHouseholdMembersRequestObject) (openapi.ListHouseholdMembersResponseObject, error) {
-	list, err := c.useCases.HouseholdMember.ListHouseholdMembers(ctx, FromOAPIHouseholdMemberListParams(&request.Params))
+	params, err := FromOAPIHouseholdMemberListParams(&request.Params)
 	if err != nil {
+		return openapi.ListHouseholdMembers400JSONResponse{
+			N400JSONResponse: openapi.N400JSONResponse{
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	list, err := c.useCases.HouseholdMember.ListHouseholdMembers(ctx, params)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidSortKey) {
+			return openapi.ListHouseholdMembers400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+
 		return openapi.ListHouseholdMembers500JSONResponse{
 			N500JSONResponse: openapi.N500JSONResponse{
 				Message: "Internal Server Error",
@@ -53,7 +70,7 @@ func (c *Controller) DeleteHouseholdMember(ctx context.Context, request openapi.
 					Message: err.Error(),
 				},
 			}, nil
-		} else if errors.Is(err, domain.ErrMemberHasActiveAccounts) {
+		} else if errors.Is(err, domain.ErrMemberHasActiveAccounts) || errors.Is(err, domain.ErrMemberLinkedToUser) {
 			return openapi.DeleteHouseholdMember400JSONResponse{
 				N400JSONResponse: openapi.N400JSONResponse{
 					Message: err.Error(),
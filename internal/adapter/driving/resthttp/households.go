@@ -0,0 +1,91 @@
+package resthttp
+
+import (
+	"context"
+	"errors"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	"github.com/rs/zerolog/log"
+)
+
+func (c *Controller) CreateHousehold(
+	ctx context.Context,
+	request openapi.CreateHouseholdRequestObject,
+) (
+	openapi.CreateHouseholdResponseObject,
+	error,
+) {
+	household, err := c.useCases.Household.CreateHousehold(
+		ctx,
+		*FromOAPIHouseholdRequest(request.Body),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrHouseholdNameEmpty,
+		) {
+			return openapi.CreateHousehold400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrUnauthenticated,
+		) {
+			return openapi.CreateHousehold401Response{}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrUserAlreadyLinked,
+		) {
+			return openapi.CreateHousehold409JSONResponse{
+				N409JSONResponse: openapi.N409JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to create household")
+
+		return openapi.CreateHousehold500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to create household",
+			},
+		}, nil
+	}
+
+	return openapi.CreateHousehold201JSONResponse(*ToOAPIHousehold(household)), nil
+}
+
+func (c *Controller) GetCurrentHousehold(
+	ctx context.Context,
+	request openapi.GetCurrentHouseholdRequestObject,
+) (
+	openapi.GetCurrentHouseholdResponseObject,
+	error,
+) {
+	household, err := c.useCases.Household.CurrentHousehold(ctx)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrHouseholdNotFound,
+		) {
+			return openapi.GetCurrentHousehold404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to get current household")
+
+		return openapi.GetCurrentHousehold500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to get current household",
+			},
+		}, nil
+	}
+
+	return openapi.GetCurrentHousehold200JSONResponse(*ToOAPIHousehold(household)), nil
+}
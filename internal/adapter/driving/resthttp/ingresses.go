@@ -10,6 +10,8 @@ func (c *Controller) ListIngresses(ctx context.Context, request openapi.ListIngr
 	panic("implement me")
 }
 
+// CreateIngress is not implemented yet. Its use case must check for duplicates the way
+// ExpenditureUseCase.checkDuplicate does, that part of the duplicate detection waits for it.
 func (c *Controller) CreateIngress(ctx context.Context, request openapi.CreateIngressRequestObject) (openapi.CreateIngressResponseObject, error) {
 	panic("implement me")
 }
@@ -0,0 +1,215 @@
+package resthttp
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	"github.com/rs/zerolog/log"
+)
+
+func (c *Controller) CreateInstallmentPlan(
+	ctx context.Context,
+	request openapi.CreateInstallmentPlanRequestObject,
+) (
+	openapi.CreateInstallmentPlanResponseObject,
+	error,
+) {
+	plan, err := c.useCases.InstallmentPlan.Create(
+		ctx,
+		*FromOAPIInstallmentPlanRequest(request.Body),
+	)
+	if err != nil {
+		if isInvalidInstallmentPlanError(err) {
+			return openapi.CreateInstallmentPlan400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to create installment plan")
+
+		return openapi.CreateInstallmentPlan500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to create installment plan",
+			},
+		}, nil
+	}
+
+	return openapi.CreateInstallmentPlan201JSONResponse(*ToOAPIInstallmentPlan(plan)), nil
+}
+
+func (c *Controller) ListInstallmentPlans(
+	ctx context.Context,
+	request openapi.ListInstallmentPlansRequestObject,
+) (
+	openapi.ListInstallmentPlansResponseObject,
+	error,
+) {
+	plans, err := c.useCases.InstallmentPlan.List(
+		ctx,
+		domain.InstallmentPlanListParams{
+			AccountID: request.Params.AccountId,
+		},
+	)
+	if err != nil {
+		log.Err(err).Msg("Failed to list installment plans")
+
+		return openapi.ListInstallmentPlans500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to list installment plans",
+			},
+		}, nil
+	}
+
+	return openapi.ListInstallmentPlans200JSONResponse(*ToOAPIInstallmentPlanList(plans)), nil
+}
+
+func (c *Controller) GetInstallmentPlan(
+	ctx context.Context,
+	request openapi.GetInstallmentPlanRequestObject,
+) (
+	openapi.GetInstallmentPlanResponseObject,
+	error,
+) {
+	plan, err := c.useCases.InstallmentPlan.Get(
+		ctx,
+		request.Id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrInstallmentPlanNotFound,
+		) {
+			return openapi.GetInstallmentPlan404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to get installment plan")
+
+		return openapi.GetInstallmentPlan500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to get installment plan",
+			},
+		}, nil
+	}
+
+	return openapi.GetInstallmentPlan200JSONResponse(*ToOAPIInstallmentPlan(plan)), nil
+}
+
+func (c *Controller) PayOffInstallmentPlan(
+	ctx context.Context,
+	request openapi.PayOffInstallmentPlanRequestObject,
+) (
+	openapi.PayOffInstallmentPlanResponseObject,
+	error,
+) {
+	plan, err := c.useCases.InstallmentPlan.PayOff(
+		ctx,
+		request.Id,
+		time.Now(),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrInstallmentPlanNotFound,
+		) {
+			return openapi.PayOffInstallmentPlan404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrInstallmentPlanPaidOff,
+		) || errors.Is(
+			err,
+			domain.ErrInstallmentPlanChanged,
+		) || errors.Is(
+			err,
+			domain.ErrAccountInactive,
+		) || errors.Is(
+			err,
+			domain.ErrInsufficientBalance,
+		) {
+			return openapi.PayOffInstallmentPlan409JSONResponse{
+				N409JSONResponse: openapi.N409JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrAccountNotFound,
+		) {
+			return openapi.PayOffInstallmentPlan400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to pay off installment plan")
+
+		return openapi.PayOffInstallmentPlan500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to pay off installment plan",
+			},
+		}, nil
+	}
+
+	return openapi.PayOffInstallmentPlan200JSONResponse(*ToOAPIInstallmentPlan(plan)), nil
+}
+
+func (c *Controller) ListInstallmentObligations(
+	ctx context.Context,
+	request openapi.ListInstallmentObligationsRequestObject,
+) (
+	openapi.ListInstallmentObligationsResponseObject,
+	error,
+) {
+	obligations, err := c.useCases.InstallmentPlan.Obligations(
+		ctx,
+		domain.InstallmentObligationParams{
+			AccountID:         request.Params.AccountId,
+			HouseholdMemberID: request.Params.HouseholdMemberId,
+		},
+	)
+	if err != nil {
+		log.Err(err).Msg("Failed to list installment obligations")
+
+		return openapi.ListInstallmentObligations500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to list installment obligations",
+			},
+		}, nil
+	}
+
+	return openapi.ListInstallmentObligations200JSONResponse(*ToOAPIInstallmentObligationList(obligations)), nil
+}
+
+func isInvalidInstallmentPlanError(err error) bool {
+	for _, invalid := range []error{
+		domain.ErrInvalidInstallmentTotalAmount,
+		domain.ErrInvalidInstallmentCount,
+		domain.ErrInvalidInstallmentInterest,
+		domain.ErrAccountNotFound,
+		domain.ErrAccountInactive,
+		domain.ErrCategoryNotFound,
+		domain.ErrCategoryInactive,
+		domain.ErrInsufficientBalance,
+	} {
+		if errors.Is(
+			err,
+			invalid,
+		) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,223 @@
+package resthttp
+
+import (
+	"context"
+	"errors"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	"github.com/rs/zerolog/log"
+)
+
+func (c *Controller) CreateInvitation(
+	ctx context.Context,
+	request openapi.CreateInvitationRequestObject,
+) (
+	openapi.CreateInvitationResponseObject,
+	error,
+) {
+	user, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return openapi.CreateInvitation401Response{}, nil
+	}
+
+	invitation, err := c.useCases.Invitation.Invite(
+		ctx,
+		*user,
+		*FromOAPIInvitationRequest(request.Body),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrUserNotLinked,
+		) {
+			return openapi.CreateInvitation403JSONResponse{
+				N403JSONResponse: openapi.N403JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrUserEmailTaken,
+		) || errors.Is(
+			err,
+			domain.ErrMemberAlreadyLinked,
+		) {
+			return openapi.CreateInvitation409JSONResponse{
+				N409JSONResponse: openapi.N409JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrInvitationEmailEmpty,
+		) || errors.Is(
+			err,
+			domain.ErrMemberNotFound,
+		) {
+			return openapi.CreateInvitation400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to create invitation")
+
+		return openapi.CreateInvitation500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to create invitation",
+			},
+		}, nil
+	}
+
+	return openapi.CreateInvitation201JSONResponse(*ToOAPIInvitation(invitation)), nil
+}
+
+func (c *Controller) AcceptInvitation(
+	ctx context.Context,
+	request openapi.AcceptInvitationRequestObject,
+) (
+	openapi.AcceptInvitationResponseObject,
+	error,
+) {
+	token, user, err := c.useCases.Invitation.Accept(
+		ctx,
+		domain.InvitationAcceptance{
+			Token:     request.Body.Token,
+			Password:  request.Body.Password,
+			FirstName: request.Body.FirstName,
+			LastName:  request.Body.LastName,
+		},
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrInvitationNotFound,
+		) {
+			return openapi.AcceptInvitation404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrInvitationAccepted,
+		) || errors.Is(
+			err,
+			domain.ErrUserEmailTaken,
+		) || errors.Is(
+			err,
+			domain.ErrMemberAlreadyLinked,
+		) {
+			return openapi.AcceptInvitation409JSONResponse{
+				N409JSONResponse: openapi.N409JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrInvitationExpired,
+		) || errors.Is(
+			err,
+			domain.ErrUserPasswordTooShort,
+		) {
+			return openapi.AcceptInvitation400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to accept invitation")
+
+		return openapi.AcceptInvitation500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to accept invitation",
+			},
+		}, nil
+	}
+
+	return openapi.AcceptInvitation201JSONResponse(*ToOAPILoginResponse(
+		token,
+		user,
+	)), nil
+}
+
+func (c *Controller) JoinHousehold(
+	ctx context.Context,
+	request openapi.JoinHouseholdRequestObject,
+) (
+	openapi.JoinHouseholdResponseObject,
+	error,
+) {
+	user, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return openapi.JoinHousehold401Response{}, nil
+	}
+
+	joined, err := c.useCases.Invitation.Join(
+		ctx,
+		*user,
+		request.Body.Token,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrInvitationNotFound,
+		) {
+			return openapi.JoinHousehold404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrInvitationForOther,
+		) {
+			return openapi.JoinHousehold403JSONResponse{
+				N403JSONResponse: openapi.N403JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrInvitationAccepted,
+		) || errors.Is(
+			err,
+			domain.ErrUserAlreadyLinked,
+		) || errors.Is(
+			err,
+			domain.ErrMemberAlreadyLinked,
+		) {
+			return openapi.JoinHousehold409JSONResponse{
+				N409JSONResponse: openapi.N409JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrInvitationExpired,
+		) {
+			return openapi.JoinHousehold400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to join household")
+
+		return openapi.JoinHousehold500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to join household",
+			},
+		}, nil
+	}
+
+	return openapi.JoinHousehold200JSONResponse(*ToOAPIUser(joined)), nil
+}
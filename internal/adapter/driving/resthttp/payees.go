@@ -0,0 +1,214 @@
+package resthttp
+
+import (
+	"context"
+	"errors"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	"github.com/rs/zerolog/log"
+)
+
+func (c *Controller) CreatePayee(
+	ctx context.Context,
+	request openapi.CreatePayeeRequestObject,
+) (
+	openapi.CreatePayeeResponseObject,
+	error,
+) {
+	payee, err := c.useCases.Payee.Create(
+		ctx,
+		*FromOAPIPayeeRequest(
+			request.Body,
+			nil,
+		),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrPayeeAlreadyExists,
+		) {
+			return openapi.CreatePayee409JSONResponse{
+				N409JSONResponse: openapi.N409JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrPayeeNameEmpty,
+		) || errors.Is(
+			err,
+			domain.ErrCategoryNotFound,
+		) {
+			return openapi.CreatePayee400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to create payee")
+
+		return openapi.CreatePayee500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to create payee",
+			},
+		}, nil
+	}
+
+	return openapi.CreatePayee201JSONResponse(*ToOAPIPayee(payee)), nil
+}
+
+func (c *Controller) ListPayees(
+	ctx context.Context,
+	request openapi.ListPayeesRequestObject,
+) (
+	openapi.ListPayeesResponseObject,
+	error,
+) {
+	payees, err := c.useCases.Payee.List(
+		ctx,
+		domain.PayeeListParams{
+			Name: request.Params.Name,
+		},
+	)
+	if err != nil {
+		log.Err(err).Msg("Failed to list payees")
+
+		return openapi.ListPayees500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to list payees",
+			},
+		}, nil
+	}
+
+	return openapi.ListPayees200JSONResponse(*ToOAPIPayeeList(payees)), nil
+}
+
+func (c *Controller) GetPayee(
+	ctx context.Context,
+	request openapi.GetPayeeRequestObject,
+) (
+	openapi.GetPayeeResponseObject,
+	error,
+) {
+	payee, err := c.useCases.Payee.Get(
+		ctx,
+		request.Id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrPayeeNotFound,
+		) {
+			return openapi.GetPayee404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to get payee")
+
+		return openapi.GetPayee500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to get payee",
+			},
+		}, nil
+	}
+
+	return openapi.GetPayee200JSONResponse(*ToOAPIPayee(payee)), nil
+}
+
+func (c *Controller) UpdatePayee(
+	ctx context.Context,
+	request openapi.UpdatePayeeRequestObject,
+) (
+	openapi.UpdatePayeeResponseObject,
+	error,
+) {
+	payee, err := c.useCases.Payee.Update(
+		ctx,
+		*FromOAPIPayeeRequest(
+			request.Body,
+			&request.Id,
+		),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrPayeeNotFound,
+		) {
+			return openapi.UpdatePayee404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrPayeeAlreadyExists,
+		) {
+			return openapi.UpdatePayee409JSONResponse{
+				N409JSONResponse: openapi.N409JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrPayeeNameEmpty,
+		) || errors.Is(
+			err,
+			domain.ErrCategoryNotFound,
+		) {
+			return openapi.UpdatePayee400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to update payee")
+
+		return openapi.UpdatePayee500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to update payee",
+			},
+		}, nil
+	}
+
+	return openapi.UpdatePayee200JSONResponse(*ToOAPIPayee(payee)), nil
+}
+
+func (c *Controller) DeletePayee(
+	ctx context.Context,
+	request openapi.DeletePayeeRequestObject,
+) (
+	openapi.DeletePayeeResponseObject,
+	error,
+) {
+	err := c.useCases.Payee.Delete(
+		ctx,
+		request.Id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrPayeeNotFound,
+		) {
+			return openapi.DeletePayee404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to delete payee")
+
+		return openapi.DeletePayee500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to delete payee",
+			},
+		}, nil
+	}
+
+	return openapi.DeletePayee204Response{}, nil
+}
@@ -0,0 +1,347 @@
+package resthttp
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	"github.com/rs/zerolog/log"
+)
+
+// defaultUpcomingBillsDays is the range of the upcoming bills listing when no end date is given
+const defaultUpcomingBillsDays = 30
+
+func (c *Controller) CreateExpenditureRecurrencePattern(
+	ctx context.Context,
+	request openapi.CreateExpenditureRecurrencePatternRequestObject,
+) (
+	openapi.CreateExpenditureRecurrencePatternResponseObject,
+	error,
+) {
+	pattern, err := c.useCases.ExpenditureRecurrence.Create(
+		ctx,
+		*FromOAPIExpenditureRecurrencePatternRequest(
+			request.Body,
+			nil,
+		),
+	)
+	if err != nil {
+		if isInvalidRecurrencePatternError(err) {
+			return openapi.CreateExpenditureRecurrencePattern400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to create expenditure recurrence pattern")
+
+		return openapi.CreateExpenditureRecurrencePattern500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to create recurring bill",
+			},
+		}, nil
+	}
+
+	return openapi.CreateExpenditureRecurrencePattern201JSONResponse(*ToOAPIExpenditureRecurrencePattern(pattern)), nil
+}
+
+func (c *Controller) ListExpenditureRecurrencePatterns(
+	ctx context.Context,
+	request openapi.ListExpenditureRecurrencePatternsRequestObject,
+) (
+	openapi.ListExpenditureRecurrencePatternsResponseObject,
+	error,
+) {
+	patterns, err := c.useCases.ExpenditureRecurrence.List(
+		ctx,
+		domain.ExpenditureRecurrencePatternListParams{
+			Active:    request.Params.Active,
+			AccountID: request.Params.AccountId,
+			AutoPost:  request.Params.AutoPost,
+		},
+	)
+	if err != nil {
+		log.Err(err).Msg("Failed to list expenditure recurrence patterns")
+
+		return openapi.ListExpenditureRecurrencePatterns500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to list recurring bills",
+			},
+		}, nil
+	}
+
+	return openapi.ListExpenditureRecurrencePatterns200JSONResponse(*ToOAPIExpenditureRecurrencePatternList(patterns)), nil
+}
+
+func (c *Controller) GetExpenditureRecurrencePattern(
+	ctx context.Context,
+	request openapi.GetExpenditureRecurrencePatternRequestObject,
+) (
+	openapi.GetExpenditureRecurrencePatternResponseObject,
+	error,
+) {
+	pattern, err := c.useCases.ExpenditureRecurrence.Get(
+		ctx,
+		request.Id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrExpenditureRecurrencePatternNotFound,
+		) {
+			return openapi.GetExpenditureRecurrencePattern404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to get expenditure recurrence pattern")
+
+		return openapi.GetExpenditureRecurrencePattern500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to get recurring bill",
+			},
+		}, nil
+	}
+
+	return openapi.GetExpenditureRecurrencePattern200JSONResponse(*ToOAPIExpenditureRecurrencePattern(pattern)), nil
+}
+
+func (c *Controller) UpdateExpenditureRecurrencePattern(
+	ctx context.Context,
+	request openapi.UpdateExpenditureRecurrencePatternRequestObject,
+) (
+	openapi.UpdateExpenditureRecurrencePatternResponseObject,
+	error,
+) {
+	pattern, err := c.useCases.ExpenditureRecurrence.Update(
+		ctx,
+		*FromOAPIExpenditureRecurrencePatternRequest(
+			request.Body,
+			&request.Id,
+		),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrExpenditureRecurrencePatternNotFound,
+		) {
+			return openapi.UpdateExpenditureRecurrencePattern404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if isInvalidRecurrencePatternError(err) {
+			return openapi.UpdateExpenditureRecurrencePattern400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to update expenditure recurrence pattern")
+
+		return openapi.UpdateExpenditureRecurrencePattern500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to update recurring bill",
+			},
+		}, nil
+	}
+
+	return openapi.UpdateExpenditureRecurrencePattern200JSONResponse(*ToOAPIExpenditureRecurrencePattern(pattern)), nil
+}
+
+func (c *Controller) DeleteExpenditureRecurrencePattern(
+	ctx context.Context,
+	request openapi.DeleteExpenditureRecurrencePatternRequestObject,
+) (
+	openapi.DeleteExpenditureRecurrencePatternResponseObject,
+	error,
+) {
+	err := c.useCases.ExpenditureRecurrence.Delete(
+		ctx,
+		request.Id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrExpenditureRecurrencePatternNotFound,
+		) {
+			return openapi.DeleteExpenditureRecurrencePattern404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to delete expenditure recurrence pattern")
+
+		return openapi.DeleteExpenditureRecurrencePattern500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to delete recurring bill",
+			},
+		}, nil
+	}
+
+	return openapi.DeleteExpenditureRecurrencePattern204Response{}, nil
+}
+
+func (c *Controller) PostExpenditureRecurrencePattern(
+	ctx context.Context,
+	request openapi.PostExpenditureRecurrencePatternRequestObject,
+) (
+	openapi.PostExpenditureRecurrencePatternResponseObject,
+	error,
+) {
+	var amount *float32
+	if request.Body != nil {
+		amount = request.Body.Amount
+	}
+
+	expenditure, err := c.useCases.ExpenditureRecurrence.Post(
+		ctx,
+		request.Id,
+		amount,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrExpenditureRecurrencePatternNotFound,
+		) {
+			return openapi.PostExpenditureRecurrencePattern404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrRecurrencePatternInactive,
+		) || errors.Is(
+			err,
+			domain.ErrRecurrencePatternFinished,
+		) || errors.Is(
+			err,
+			domain.ErrCategoryInactive,
+		) || errors.Is(
+			err,
+			domain.ErrAccountInactive,
+		) || errors.Is(
+			err,
+			domain.ErrInsufficientBalance,
+		) {
+			return openapi.PostExpenditureRecurrencePattern409JSONResponse{
+				N409JSONResponse: openapi.N409JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrInvalidRecurrenceAmount,
+		) || errors.Is(
+			err,
+			domain.ErrAccountNotFound,
+		) || errors.Is(
+			err,
+			domain.ErrCategoryNotFound,
+		) {
+			return openapi.PostExpenditureRecurrencePattern400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to post expenditure recurrence pattern")
+
+		return openapi.PostExpenditureRecurrencePattern500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to post recurring bill",
+			},
+		}, nil
+	}
+
+	return openapi.PostExpenditureRecurrencePattern201JSONResponse(*ToOAPIExpenditure(expenditure)), nil
+}
+
+func (c *Controller) ListUpcomingBills(
+	ctx context.Context,
+	request openapi.ListUpcomingBillsRequestObject,
+) (
+	openapi.ListUpcomingBillsResponseObject,
+	error,
+) {
+	now := time.Now()
+	from := time.Date(
+		now.Year(),
+		now.Month(),
+		now.Day(),
+		0,
+		0,
+		0,
+		0,
+		time.UTC,
+	)
+	if request.Params.From != nil {
+		from = request.Params.From.Time
+	}
+	until := from.AddDate(
+		0,
+		0,
+		defaultUpcomingBillsDays,
+	)
+	if request.Params.To != nil {
+		until = request.Params.To.Time
+	}
+
+	bills, err := c.useCases.ExpenditureRecurrence.Upcoming(
+		ctx,
+		from,
+		until,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrInvalidUpcomingBillsRange,
+		) {
+			return openapi.ListUpcomingBills400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to list upcoming bills")
+
+		return openapi.ListUpcomingBills500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to list upcoming bills",
+			},
+		}, nil
+	}
+
+	return openapi.ListUpcomingBills200JSONResponse(*ToOAPIUpcomingBillList(bills)), nil
+}
+
+func isInvalidRecurrencePatternError(err error) bool {
+	for _, invalid := range []error{
+		domain.ErrInvalidRecurrenceFrequency,
+		domain.ErrInvalidRecurrenceInterval,
+		domain.ErrInvalidRecurrenceAmount,
+		domain.ErrInvalidRecurrenceAmountType,
+		domain.ErrInvalidRecurrenceEndDate,
+		domain.ErrAccountNotFound,
+		domain.ErrAccountInactive,
+		domain.ErrCategoryNotFound,
+		domain.ErrCategoryInactive,
+	} {
+		if errors.Is(
+			err,
+			invalid,
+		) {
+			return true
+		}
+	}
+
+	return false
+}
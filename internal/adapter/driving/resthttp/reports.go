@@ -0,0 +1,384 @@
+package resthttp
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultReportPeriods       = 6
+	defaultReportTopCategories = 5
+)
+
+func (c *Controller) GetPlannedSpendingReport(
+	ctx context.Context,
+	request openapi.GetPlannedSpendingReportRequestObject,
+) (
+	openapi.GetPlannedSpendingReportResponseObject,
+	error,
+) {
+	report, err := c.useCases.Report.PlannedSpending(
+		ctx,
+		FromOAPIPlannedSpendingParams(&request.Params),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrInvalidReportPeriod,
+		) || errors.Is(
+			err,
+			domain.ErrInvalidReportPeriods,
+		) || errors.Is(
+			err,
+			domain.ErrInvalidReportCurrency,
+		) || errors.Is(
+			err,
+			domain.ErrInvalidAmountBasis,
+		) {
+			return openapi.GetPlannedSpendingReport400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to build planned spending report")
+
+		return openapi.GetPlannedSpendingReport500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to build planned spending report",
+			},
+		}, nil
+	}
+
+	return openapi.GetPlannedSpendingReport200JSONResponse(*ToOAPIPlannedSpendingReport(report)), nil
+}
+
+func (c *Controller) GetDeclaredExpensesReport(
+	ctx context.Context,
+	request openapi.GetDeclaredExpensesReportRequestObject,
+) (
+	openapi.GetDeclaredExpensesReportResponseObject,
+	error,
+) {
+	report, err := c.useCases.Report.DeclaredExpenses(
+		ctx,
+		FromOAPIDeclaredExpensesParams(&request.Params),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrInvalidFiscalYear,
+		) || errors.Is(
+			err,
+			domain.ErrInvalidFiscalYearStartMonth,
+		) || errors.Is(
+			err,
+			domain.ErrInvalidAmountBasis,
+		) {
+			return openapi.GetDeclaredExpensesReport400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to build declared expenses report")
+
+		return openapi.GetDeclaredExpensesReport500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to build declared expenses report",
+			},
+		}, nil
+	}
+
+	fileName := fmt.Sprintf(
+		"declared-expenses-%d",
+		report.FiscalYear,
+	)
+
+	if request.Params.Format != nil && *request.Params.Format == openapi.Csv {
+		body, errCSV := declaredExpensesCSV(report)
+		if errCSV != nil {
+			log.Err(errCSV).Msg("Failed to write declared expenses CSV")
+
+			return openapi.GetDeclaredExpensesReport500JSONResponse{
+				N500JSONResponse: openapi.N500JSONResponse{
+					Message: "Failed to build declared expenses report",
+				},
+			}, nil
+		}
+
+		return openapi.GetDeclaredExpensesReport200TextcsvResponse{
+			Body:          body,
+			ContentLength: int64(body.Len()),
+			Headers: openapi.GetDeclaredExpensesReport200ResponseHeaders{
+				ContentDisposition: `attachment; filename="` + fileName + `.csv"`,
+			},
+		}, nil
+	}
+
+	return openapi.GetDeclaredExpensesReport200JSONResponse{
+		Body: *ToOAPIDeclaredExpensesReport(report),
+		Headers: openapi.GetDeclaredExpensesReport200ResponseHeaders{
+			ContentDisposition: `attachment; filename="` + fileName + `.json"`,
+		},
+	}, nil
+}
+
+func (c *Controller) GetPayeeSpendingReport(
+	ctx context.Context,
+	request openapi.GetPayeeSpendingReportRequestObject,
+) (
+	openapi.GetPayeeSpendingReportResponseObject,
+	error,
+) {
+	report, err := c.useCases.Report.PayeeSpending(
+		ctx,
+		FromOAPIPayeeSpendingParams(
+			&request.Params,
+			time.Now().UTC(),
+		),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrInvalidReportCurrency,
+		) || errors.Is(
+			err,
+			domain.ErrInvalidReportRange,
+		) || errors.Is(
+			err,
+			domain.ErrInvalidAmountBasis,
+		) {
+			return openapi.GetPayeeSpendingReport400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to build payee spending report")
+
+		return openapi.GetPayeeSpendingReport500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to build payee spending report",
+			},
+		}, nil
+	}
+
+	return openapi.GetPayeeSpendingReport200JSONResponse(*ToOAPIPayeeSpendingReport(report)), nil
+}
+
+func (c *Controller) GetTagSpendingReport(
+	ctx context.Context,
+	request openapi.GetTagSpendingReportRequestObject,
+) (
+	openapi.GetTagSpendingReportResponseObject,
+	error,
+) {
+	report, err := c.useCases.Report.TagSpending(
+		ctx,
+		FromOAPITagSpendingParams(
+			&request.Params,
+			time.Now().UTC(),
+		),
+	)
+	if err != nil {
+		if isInvalidTagReportError(err) {
+			return openapi.GetTagSpendingReport400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to build tag spending report")
+
+		return openapi.GetTagSpendingReport500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to build tag spending report",
+			},
+		}, nil
+	}
+
+	return openapi.GetTagSpendingReport200JSONResponse(*ToOAPITagSpendingReport(report)), nil
+}
+
+func (c *Controller) GetTagCombinationReport(
+	ctx context.Context,
+	request openapi.GetTagCombinationReportRequestObject,
+) (
+	openapi.GetTagCombinationReportResponseObject,
+	error,
+) {
+	report, err := c.useCases.Report.TagCombination(
+		ctx,
+		FromOAPITagCombinationParams(
+			&request.Params,
+			time.Now().UTC(),
+		),
+	)
+	if err != nil {
+		if isInvalidTagReportError(err) || errors.Is(
+			err,
+			domain.ErrTagNotFound,
+		) {
+			return openapi.GetTagCombinationReport400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to build tag combination report")
+
+		return openapi.GetTagCombinationReport500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to build tag combination report",
+			},
+		}, nil
+	}
+
+	return openapi.GetTagCombinationReport200JSONResponse(*ToOAPITagCombinationReport(report)), nil
+}
+
+func (c *Controller) GetTagCategoryReport(
+	ctx context.Context,
+	request openapi.GetTagCategoryReportRequestObject,
+) (
+	openapi.GetTagCategoryReportResponseObject,
+	error,
+) {
+	report, err := c.useCases.Report.TagCategorySpending(
+		ctx,
+		FromOAPITagCategoryParams(
+			request.TagId,
+			&request.Params,
+			time.Now().UTC(),
+		),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrTagNotFound,
+		) {
+			return openapi.GetTagCategoryReport404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if isInvalidTagReportError(err) {
+			return openapi.GetTagCategoryReport400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to build tag category report")
+
+		return openapi.GetTagCategoryReport500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to build tag category report",
+			},
+		}, nil
+	}
+
+	return openapi.GetTagCategoryReport200JSONResponse(*ToOAPITagCategoryReport(report)), nil
+}
+
+func isInvalidTagReportError(err error) bool {
+	for _, invalid := range []error{
+		domain.ErrInvalidReportCurrency,
+		domain.ErrInvalidReportRange,
+		domain.ErrInvalidAmountBasis,
+		domain.ErrTagReportTagsRequired,
+		domain.ErrTagReportTypeMismatch,
+		domain.ErrTagReportUnsupportedTag,
+		domain.ErrInvalidTagMatch,
+	} {
+		if errors.Is(
+			err,
+			invalid,
+		) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// declaredExpensesCSV writes one row per declared expense, tags are joined with '|'
+func declaredExpensesCSV(report *domain.DeclaredExpensesReport) (
+	*bytes.Buffer,
+	error,
+) {
+	body := &bytes.Buffer{}
+	writer := csv.NewWriter(body)
+
+	err := writer.Write(
+		[]string{
+			"expenditure_id",
+			"date",
+			"description",
+			"amount",
+			"currency",
+			"category",
+			"tags",
+			"account",
+			"member",
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, expense := range report.Expenses {
+		tagNames := make(
+			[]string,
+			0,
+			len(expense.Tags),
+		)
+		for _, tag := range expense.Tags {
+			tagNames = append(
+				tagNames,
+				tag.Name,
+			)
+		}
+
+		err = writer.Write(
+			[]string{
+				expense.ExpenditureID,
+				expense.Date.Format(time.DateOnly),
+				expense.Description,
+				strconv.FormatFloat(
+					expense.Amount,
+					'f',
+					2, //nolint:mnd // amounts are stored with two decimals
+					64,
+				),
+				expense.Currency,
+				expense.CategoryName,
+				strings.Join(
+					tagNames,
+					"|",
+				),
+				expense.AccountName,
+				expense.MemberName,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+
+	return body, writer.Error()
+}
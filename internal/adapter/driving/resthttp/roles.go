@@ -0,0 +1,134 @@
+package resthttp
+
+import (
+	"context"
+	"errors"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	"github.com/rs/zerolog/log"
+)
+
+func (c *Controller) ListRoles(
+	ctx context.Context,
+	request openapi.ListRolesRequestObject,
+) (
+	openapi.ListRolesResponseObject,
+	error,
+) {
+	roles, err := c.useCases.Role.ListRoles(ctx)
+	if err != nil {
+		log.Err(err).Msg("Failed to list roles")
+
+		return openapi.ListRoles500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to list roles",
+			},
+		}, nil
+	}
+
+	response := make(
+		openapi.ListRoles200JSONResponse,
+		0,
+		len(roles),
+	)
+	for _, role := range roles {
+		response = append(
+			response,
+			*ToOAPIRole(&role),
+		)
+	}
+
+	return response, nil
+}
+
+func (c *Controller) ListUsers(
+	ctx context.Context,
+	request openapi.ListUsersRequestObject,
+) (
+	openapi.ListUsersResponseObject,
+	error,
+) {
+	users, err := c.useCases.Role.ListUsers(ctx)
+	if err != nil {
+		log.Err(err).Msg("Failed to list users")
+
+		return openapi.ListUsers500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to list users",
+			},
+		}, nil
+	}
+
+	response := make(
+		openapi.ListUsers200JSONResponse,
+		0,
+		len(users),
+	)
+	for _, user := range users {
+		response = append(
+			response,
+			*ToOAPIUser(&user),
+		)
+	}
+
+	return response, nil
+}
+
+func (c *Controller) SetUserRoles(
+	ctx context.Context,
+	request openapi.SetUserRolesRequestObject,
+) (
+	openapi.SetUserRolesResponseObject,
+	error,
+) {
+	user, err := c.useCases.Role.SetUserRoles(
+		ctx,
+		request.Id,
+		request.Body.Roles,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrUserNotFound,
+		) {
+			return openapi.SetUserRoles404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrRoleNotFound,
+		) || errors.Is(
+			err,
+			domain.ErrUserRolesEmpty,
+		) {
+			return openapi.SetUserRoles400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrLastAdmin,
+		) {
+			return openapi.SetUserRoles409JSONResponse{
+				N409JSONResponse: openapi.N409JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to set user roles")
+
+		return openapi.SetUserRoles500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to set user roles",
+			},
+		}, nil
+	}
+
+	return openapi.SetUserRoles200JSONResponse(*ToOAPIUser(user)), nil
+}
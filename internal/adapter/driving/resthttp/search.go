@@ -0,0 +1,50 @@
+package resthttp
+
+import (
+	"context"
+	"errors"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/openapi"
+	"github.com/rs/zerolog/log"
+)
+
+const defaultSearchLimit = 20
+
+func (c *Controller) Search(
+	ctx context.Context,
+	request openapi.SearchRequestObject,
+) (
+	openapi.SearchResponseObject,
+	error,
+) {
+	result, err := c.useCases.Search.Search(
+		ctx,
+		FromOAPISearchParams(&request.Params),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrInvalidSearchQuery,
+		) || errors.Is(
+			err,
+			domain.ErrInvalidSearchType,
+		) {
+			return openapi.Search400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+
+		log.Err(err).Msg("Failed to search")
+
+		return openapi.Search500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to search",
+			},
+		}, nil
+	}
+
+	return openapi.Search200JSONResponse(*ToOAPISearchResult(result)), nil
+}
@@ -86,6 +86,102 @@ func (c *Controller) CreateTag(
 	return openapi.CreateTag201JSONResponse(*ToOAPITag(tag)), nil
 }
 
+func (c *Controller) UpdateTag(
+	ctx context.Context,
+	request openapi.UpdateTagRequestObject,
+) (
+	openapi.UpdateTagResponseObject,
+	error,
+) {
+	tag, err := c.useCases.Tags.UpdateTag(
+		ctx,
+		request.Id,
+		FromOAPITagRequest(
+			*request.Body,
+		),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrTagNotFound,
+		) {
+			return openapi.UpdateTag404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if errors.Is(
+			err,
+			domain.ErrTagAlreadyExists,
+		) {
+			return openapi.UpdateTag409JSONResponse{
+				N409JSONResponse: openapi.N409JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if isInvalidTagError(err) {
+			return openapi.UpdateTag400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to update tag")
+
+		return openapi.UpdateTag500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to update tag",
+			},
+		}, nil
+	}
+
+	return openapi.UpdateTag200JSONResponse(*ToOAPITag(tag)), nil
+}
+
+func (c *Controller) MergeTag(
+	ctx context.Context,
+	request openapi.MergeTagRequestObject,
+) (
+	openapi.MergeTagResponseObject,
+	error,
+) {
+	result, err := c.useCases.Tags.MergeTag(
+		ctx,
+		request.Id,
+		request.Body.TargetId,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrTagNotFound,
+		) {
+			return openapi.MergeTag404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if isInvalidTagMergeError(err) {
+			return openapi.MergeTag400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to merge tag")
+
+		return openapi.MergeTag500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to merge tag",
+			},
+		}, nil
+	}
+
+	return openapi.MergeTag200JSONResponse(*ToOAPITagMergeResult(result)), nil
+}
+
 func (c *Controller) DeleteTag(
 	ctx context.Context,
 	request openapi.DeleteTagRequestObject,
@@ -149,3 +245,225 @@ func (c *Controller) ListTagsByType(
 
 	return openapi.ListTagsByType200JSONResponse(tagList), nil
 }
+
+func (c *Controller) ListRecordTags(
+	ctx context.Context,
+	request openapi.ListRecordTagsRequestObject,
+) (
+	openapi.ListRecordTagsResponseObject,
+	error,
+) {
+	tags, err := c.useCases.Tags.ListRecordTags(
+		ctx,
+		*FromOAPITagType(&request.Type),
+		request.RecordId,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrTaggedRecordNotFound,
+		) {
+			return openapi.ListRecordTags404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if isInvalidRecordTagError(err) {
+			return openapi.ListRecordTags400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to list record tags")
+
+		return openapi.ListRecordTags500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to list record tags",
+			},
+		}, nil
+	}
+
+	return openapi.ListRecordTags200JSONResponse(ToOAPITagList(tags)), nil
+}
+
+func (c *Controller) SetRecordTags(
+	ctx context.Context,
+	request openapi.SetRecordTagsRequestObject,
+) (
+	openapi.SetRecordTagsResponseObject,
+	error,
+) {
+	tags, err := c.useCases.Tags.SetRecordTags(
+		ctx,
+		*FromOAPITagType(&request.Type),
+		request.RecordId,
+		request.Body.TagIds,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrTaggedRecordNotFound,
+		) {
+			return openapi.SetRecordTags404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if isInvalidRecordTagError(err) {
+			return openapi.SetRecordTags400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to set record tags")
+
+		return openapi.SetRecordTags500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to set record tags",
+			},
+		}, nil
+	}
+
+	return openapi.SetRecordTags200JSONResponse(ToOAPITagList(tags)), nil
+}
+
+func (c *Controller) AddRecordTags(
+	ctx context.Context,
+	request openapi.AddRecordTagsRequestObject,
+) (
+	openapi.AddRecordTagsResponseObject,
+	error,
+) {
+	tags, err := c.useCases.Tags.AddRecordTags(
+		ctx,
+		*FromOAPITagType(&request.Type),
+		request.RecordId,
+		request.Body.TagIds,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrTaggedRecordNotFound,
+		) {
+			return openapi.AddRecordTags404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if isInvalidRecordTagError(err) {
+			return openapi.AddRecordTags400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to add record tags")
+
+		return openapi.AddRecordTags500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to add record tags",
+			},
+		}, nil
+	}
+
+	return openapi.AddRecordTags200JSONResponse(ToOAPITagList(tags)), nil
+}
+
+func (c *Controller) RemoveRecordTag(
+	ctx context.Context,
+	request openapi.RemoveRecordTagRequestObject,
+) (
+	openapi.RemoveRecordTagResponseObject,
+	error,
+) {
+	err := c.useCases.Tags.RemoveRecordTag(
+		ctx,
+		*FromOAPITagType(&request.Type),
+		request.RecordId,
+		request.TagId,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			domain.ErrTaggedRecordNotFound,
+		) {
+			return openapi.RemoveRecordTag404JSONResponse{
+				N404JSONResponse: openapi.N404JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if isInvalidRecordTagError(err) {
+			return openapi.RemoveRecordTag400JSONResponse{
+				N400JSONResponse: openapi.N400JSONResponse{
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		log.Err(err).Msg("Failed to remove record tag")
+
+		return openapi.RemoveRecordTag500JSONResponse{
+			N500JSONResponse: openapi.N500JSONResponse{
+				Message: "Failed to remove record tag",
+			},
+		}, nil
+	}
+
+	return openapi.RemoveRecordTag204Response{}, nil
+}
+
+func isInvalidTagError(err error) bool {
+	for _, invalid := range []error{
+		domain.ErrTagNameEmpty,
+		domain.ErrUnknownTagType,
+		domain.ErrTagTypeChange,
+	} {
+		if errors.Is(
+			err,
+			invalid,
+		) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isInvalidTagMergeError(err error) bool {
+	for _, invalid := range []error{
+		domain.ErrTagMergeSameTag,
+		domain.ErrTagMergeTargetNotFound,
+		domain.ErrTagMergeTypeMismatch,
+	} {
+		if errors.Is(
+			err,
+			invalid,
+		) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isInvalidRecordTagError(err error) bool {
+	for _, invalid := range []error{
+		domain.ErrUnknownTagType,
+		domain.ErrTagTypeMismatch,
+		domain.ErrTagNotFound,
+	} {
+		if errors.Is(
+			err,
+			invalid,
+		) {
+			return true
+		}
+	}
+
+	return false
+}
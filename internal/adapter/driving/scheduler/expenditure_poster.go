@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/usecase"
+	"github.com/rs/zerolog/log"
+)
+
+// ExpenditurePoster periodically settles the scheduled (future-dated) expenditures that became due
+type ExpenditurePoster struct {
+	expenditureUseCase *usecase.ExpenditureUseCase
+	householdUseCase   *usecase.HouseholdUseCase
+	interval           time.Duration
+}
+
+func NewExpenditurePoster(
+	expenditureUseCase *usecase.ExpenditureUseCase,
+	householdUseCase *usecase.HouseholdUseCase,
+	interval time.Duration,
+) *ExpenditurePoster {
+	return &ExpenditurePoster{
+		expenditureUseCase: expenditureUseCase,
+		householdUseCase:   householdUseCase,
+		interval:           interval,
+	}
+}
+
+// Start runs the poster until the context is canceled. It posts once right away so
+// expenditures that became due while the application was down are not delayed.
+func (p *ExpenditurePoster) Start(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("scheduled expenditure poster stopped")
+
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *ExpenditurePoster) run(ctx context.Context) {
+	forEachHousehold(
+		ctx,
+		p.householdUseCase,
+		func(
+			ctx context.Context,
+			household domain.Household,
+		) {
+			posted, failed, err := p.expenditureUseCase.PostScheduled(
+				ctx,
+				time.Now(),
+			)
+			if err != nil {
+				log.Err(err).Str("household", household.ID).Msg("failed to post scheduled expenditures")
+
+				return
+			}
+
+			if posted > 0 || failed > 0 {
+				log.Info().
+					Str("household", household.ID).
+					Int("posted", posted).
+					Int("failed", failed).
+					Msg("scheduled expenditures settled")
+			}
+		},
+	)
+}
@@ -0,0 +1,37 @@
+package scheduler
+
+import (
+	"context"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/usecase"
+	"github.com/rs/zerolog/log"
+)
+
+// forEachHousehold runs a job once per household with the context scoped to it, the data of a
+// household is only reachable from a context scoped to that household
+func forEachHousehold(
+	ctx context.Context,
+	householdUseCase *usecase.HouseholdUseCase,
+	job func(
+		ctx context.Context,
+		household domain.Household,
+	),
+) {
+	households, err := householdUseCase.ListHouseholds(ctx)
+	if err != nil {
+		log.Err(err).Msg("failed to list households")
+
+		return
+	}
+
+	for _, household := range households {
+		job(
+			domain.WithHouseholdID(
+				ctx,
+				household.ID,
+			),
+			household,
+		)
+	}
+}
@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/usecase"
+	"github.com/rs/zerolog/log"
+)
+
+// RecurringBillGenerator periodically records the due occurrences of the auto-post recurring bills
+type RecurringBillGenerator struct {
+	recurrenceUseCase *usecase.ExpenditureRecurrenceUseCase
+	householdUseCase  *usecase.HouseholdUseCase
+	interval          time.Duration
+}
+
+func NewRecurringBillGenerator(
+	recurrenceUseCase *usecase.ExpenditureRecurrenceUseCase,
+	householdUseCase *usecase.HouseholdUseCase,
+	interval time.Duration,
+) *RecurringBillGenerator {
+	return &RecurringBillGenerator{
+		recurrenceUseCase: recurrenceUseCase,
+		householdUseCase:  householdUseCase,
+		interval:          interval,
+	}
+}
+
+// Start runs the generator until the context is canceled. Like the expenditure poster it runs
+// once right away so bills that fell due while the application was down are caught up.
+func (g *RecurringBillGenerator) Start(ctx context.Context) {
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+
+	for {
+		g.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("recurring bill generator stopped")
+
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (g *RecurringBillGenerator) run(ctx context.Context) {
+	forEachHousehold(
+		ctx,
+		g.householdUseCase,
+		func(
+			ctx context.Context,
+			household domain.Household,
+		) {
+			generated, skipped, err := g.recurrenceUseCase.GenerateDue(
+				ctx,
+				time.Now(),
+			)
+			if err != nil {
+				log.Err(err).Str("household", household.ID).Msg("failed to generate recurring bills")
+
+				return
+			}
+
+			if generated > 0 || skipped > 0 {
+				log.Info().
+					Str("household", household.ID).
+					Int("generated", generated).
+					Int("skipped", skipped).
+					Msg("recurring bills generated")
+			}
+		},
+	)
+}
@@ -17,9 +17,10 @@ func (al *AccountList) Count() int {
 }
 
 type AccountListParams struct {
-	Type     *string `form:"type,omitempty" json:"type,omitempty"`
-	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
-	Active   *bool   `form:"active,omitempty" json:"active,omitempty"`
-	Limit    *int    `form:"limit,omitempty" json:"limit,omitempty"`
-	Offset   *int    `form:"offset,omitempty" json:"offset,omitempty"`
+	Type     *string     `form:"type,omitempty" json:"type,omitempty"`
+	Currency *string     `form:"currency,omitempty" json:"currency,omitempty"`
+	Active   *bool       `form:"active,omitempty" json:"active,omitempty"`
+	Limit    *int        `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset   *int        `form:"offset,omitempty" json:"offset,omitempty"`
+	Sort     []SortOrder `form:"sort,omitempty" json:"sort,omitempty"`
 }
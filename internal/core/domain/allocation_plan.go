@@ -0,0 +1,273 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Allocation plan domain errors
+var (
+	ErrAllocationPlanNotFound        = errors.New("allocation plan not found")
+	ErrAllocationPlanExists          = errors.New("an allocation plan already exists for this month and currency")
+	ErrInvalidAllocationPlanCurrency = errors.New("allocation plan currency is required")
+	ErrInvalidIncomeBasis            = errors.New("invalid income basis")
+	ErrInvalidAllocationTarget       = errors.New("allocations go to either a category or a savings goal")
+	ErrInvalidAllocationAmount       = errors.New("allocation amount must be greater than zero")
+	ErrDuplicateAllocation           = errors.New("category or savings goal allocated more than once")
+	ErrAllocationCategoryNotExpenses = errors.New("income can only be allocated to expenditure categories")
+	ErrAllocationsExceedIncome       = errors.New("allocations exceed the income of the month")
+)
+
+// IncomeBasis tells which income of the month the allocations of a plan must fit in
+type IncomeBasis string
+
+const (
+	// IncomeBasisExpected is the income expected from the ingress recurrence patterns and the ingresses
+	// of the month
+	IncomeBasisExpected IncomeBasis = "expected"
+	// IncomeBasisActual is the income already received in the month
+	IncomeBasisActual IncomeBasis = "actual"
+)
+
+// IsValid checks if the income basis is valid
+func (b IncomeBasis) IsValid() bool {
+	return b == IncomeBasisExpected || b == IncomeBasisActual
+}
+
+// AllocationPlan assigns the income of a month in a currency to expenditure categories and savings goals,
+// until nothing is left to be assigned
+type AllocationPlan struct {
+	ID string `json:"id"`
+	// Month is the first day of the planned month
+	Month       time.Time    `json:"month"`
+	Currency    string       `json:"currency"`
+	IncomeBasis IncomeBasis  `json:"income_basis"`
+	Allocations []Allocation `json:"allocations"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// Allocation is the part of the income assigned to either a category or a savings goal
+type Allocation struct {
+	CategoryID    *string `json:"category_id,omitempty"`
+	SavingsGoalID *string `json:"savings_goal_id,omitempty"`
+	Amount        float32 `json:"amount"`
+}
+
+// Validate checks the allocation plan business rules
+func (p *AllocationPlan) Validate() error {
+	if p.Currency == "" {
+		return ErrInvalidAllocationPlanCurrency
+	}
+	if !p.IncomeBasis.IsValid() {
+		return ErrInvalidIncomeBasis
+	}
+
+	seen := make(map[string]bool)
+	for _, allocation := range p.Allocations {
+		if (allocation.CategoryID == nil) == (allocation.SavingsGoalID == nil) {
+			return ErrInvalidAllocationTarget
+		}
+		if allocation.Amount <= 0 {
+			return ErrInvalidAllocationAmount
+		}
+
+		key := allocation.key()
+		if seen[key] {
+			return ErrDuplicateAllocation
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+func (a Allocation) key() string {
+	if a.CategoryID != nil {
+		return "category:" + *a.CategoryID
+	}
+
+	return "savings_goal:" + *a.SavingsGoalID
+}
+
+// Allocated adds up every allocation of the plan
+func (p *AllocationPlan) Allocated() float32 {
+	var allocated float32
+	for _, allocation := range p.Allocations {
+		allocated += allocation.Amount
+	}
+
+	return roundCents(allocated)
+}
+
+// Range returns the [from, to) date range of the planned month
+func (p *AllocationPlan) Range() (
+	from time.Time,
+	to time.Time,
+) {
+	return p.Month, p.Month.AddDate(
+		0,
+		1,
+		0,
+	)
+}
+
+// MonthStart returns the first day of the month of date
+func MonthStart(date time.Time) time.Time {
+	return time.Date(
+		date.Year(),
+		date.Month(),
+		1,
+		0,
+		0,
+		0,
+		0,
+		time.UTC,
+	)
+}
+
+type AllocationPlanListParams struct {
+	Month    *time.Time `json:"month,omitempty"`
+	Currency *string    `json:"currency,omitempty"`
+}
+
+// IncomeRecurrence is an ingress recurrence pattern running in a month
+type IncomeRecurrence struct {
+	Frequency RecurrenceFrequency `json:"frequency"`
+	Interval  int                 `json:"interval"`
+	Amount    float32             `json:"amount"`
+}
+
+// MonthlyAmount estimates what the recurrence brings in the month. Patterns have no start date, so
+// daily and weekly ones are spread over the days of the month and yearly ones over twelve months.
+func (r IncomeRecurrence) MonthlyAmount(month time.Time) float32 {
+	interval := float32(max(
+		r.Interval,
+		1,
+	))
+	days := float32(MonthStart(month).AddDate(
+		0,
+		1,
+		-1,
+	).Day())
+
+	switch r.Frequency {
+	case RecurrenceFrequencyDaily:
+		return roundCents(r.Amount * days / interval)
+	case RecurrenceFrequencyWeekly:
+		return roundCents(r.Amount * days / (daysPerWeek * interval))
+	case RecurrenceFrequencyMonthly:
+		return roundCents(r.Amount / interval)
+	case RecurrenceFrequencyYearly:
+		return roundCents(r.Amount / (12 * interval)) //nolint:mnd // months per year
+	}
+
+	return 0
+}
+
+// MonthIncome is the income of a month in a currency
+type MonthIncome struct {
+	// Recurring is expected from the ingress recurrence patterns
+	Recurring float32 `json:"recurring"`
+	// OneOff comes from the ingresses of the month not generated by a recurrence pattern
+	OneOff float32 `json:"one_off"`
+	// Received adds up the completed ingresses of the month
+	Received float32 `json:"received"`
+}
+
+// Expected returns the income expected in the month
+func (i MonthIncome) Expected() float32 {
+	return roundCents(i.Recurring + i.OneOff)
+}
+
+// Income returns the income of the month on the given basis
+func (i MonthIncome) Income(basis IncomeBasis) float32 {
+	if basis == IncomeBasisActual {
+		return i.Received
+	}
+
+	return i.Expected()
+}
+
+// MonthActuals is what was really spent per category and contributed per savings goal in a month
+type MonthActuals struct {
+	Spent       map[string]float32 `json:"spent"`
+	Contributed map[string]float32 `json:"contributed"`
+}
+
+// AllocationStatus compares an allocation with what was really spent or contributed
+type AllocationStatus struct {
+	Allocation
+	Actual float32 `json:"actual"`
+	// Difference is what is left of the allocation, negative when more was spent or contributed
+	Difference float32 `json:"difference"`
+}
+
+// AllocationPlanStatus is an allocation plan with the income of its month and the actual spending
+type AllocationPlanStatus struct {
+	Plan           AllocationPlan     `json:"plan"`
+	ExpectedIncome float32            `json:"expected_income"`
+	ActualIncome   float32            `json:"actual_income"`
+	Allocated      float32            `json:"allocated"`
+	ToBeAssigned   float32            `json:"to_be_assigned"`
+	Allocations    []AllocationStatus `json:"allocations"`
+	Spent          float32            `json:"spent"`
+	Contributed    float32            `json:"contributed"`
+	// Unplanned is what was spent in categories the plan did not allocate anything to
+	Unplanned float32 `json:"unplanned"`
+}
+
+// NewAllocationPlanStatus compares the plan with the income and actuals of its month. ToBeAssigned is
+// what is left of the income on the plan basis, negative when the plan allocates more than that.
+func NewAllocationPlanStatus(
+	plan AllocationPlan,
+	income MonthIncome,
+	actuals MonthActuals,
+) AllocationPlanStatus {
+	status := AllocationPlanStatus{
+		Plan:           plan,
+		ExpectedIncome: income.Expected(),
+		ActualIncome:   income.Received,
+		Allocated:      plan.Allocated(),
+		Allocations: make(
+			[]AllocationStatus,
+			0,
+			len(plan.Allocations),
+		),
+	}
+	status.ToBeAssigned = roundCents(income.Income(plan.IncomeBasis) - status.Allocated)
+
+	planned := make(map[string]bool)
+	for _, allocation := range plan.Allocations {
+		var actual float32
+		if allocation.CategoryID != nil {
+			actual = actuals.Spent[*allocation.CategoryID]
+			planned[*allocation.CategoryID] = true
+		} else {
+			actual = actuals.Contributed[*allocation.SavingsGoalID]
+		}
+		status.Allocations = append(
+			status.Allocations,
+			AllocationStatus{
+				Allocation: allocation,
+				Actual:     actual,
+				Difference: roundCents(allocation.Amount - actual),
+			},
+		)
+	}
+
+	for categoryID, spent := range actuals.Spent {
+		status.Spent += spent
+		if !planned[categoryID] {
+			status.Unplanned += spent
+		}
+	}
+	for _, contributed := range actuals.Contributed {
+		status.Contributed += contributed
+	}
+	status.Spent = roundCents(status.Spent)
+	status.Unplanned = roundCents(status.Unplanned)
+	status.Contributed = roundCents(status.Contributed)
+
+	return status
+}
@@ -1,30 +1,85 @@
 package domain
 
 import (
+	"context"
 	"errors"
 	"time"
 )
 
 // User and role domain errors
 var (
-	ErrUserNotFound       = errors.New("user not found")
-	ErrUserHasActiveRoles = errors.New("user has active roles and cannot be deleted")
-	ErrRoleNotFound       = errors.New("role not found")
-	ErrRoleInUse          = errors.New("role is in use and cannot be deleted")
+	ErrUserNotFound         = errors.New("user not found")
+	ErrUserHasActiveRoles   = errors.New("user has active roles and cannot be deleted")
+	ErrRoleNotFound         = errors.New("role not found")
+	ErrRoleInUse            = errors.New("role is in use and cannot be deleted")
+	ErrInvalidCredentials   = errors.New("invalid credentials")
+	ErrUserEmailTaken       = errors.New("email is already in use")
+	ErrUserEmailEmpty       = errors.New("user email cannot be empty")
+	ErrUserPasswordTooShort = errors.New("user password must be at least 8 characters long")
+	ErrUnauthenticated      = errors.New("authentication required")
+	ErrUserNotLinked        = errors.New("user is not linked to a household member")
+	ErrUserAlreadyLinked    = errors.New("user is already linked to a household member")
+	ErrMemberAlreadyLinked  = errors.New("member is already linked to a user")
+	ErrMemberLinkedToUser   = errors.New("member is linked to a user")
 )
 
+// UserPasswordMinLength is the shortest password accepted for a user
+const UserPasswordMinLength = 8
+
 type User struct {
-	ID        string    `json:"id"`
-	Email     string    `json:"email"`
-	Password  string    `json:"-"` // Never expose password
-	FirstName string    `json:"firstName"`
-	LastName  string    `json:"lastName"`
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	Password  string `json:"-"` // Never expose password
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	// HouseholdMemberID is the member the user logs in as, nil until the user is linked to one
+	HouseholdMemberID *string `json:"householdMemberId,omitempty"`
+	// HouseholdID is the household of the linked member, the one the user acts on
+	HouseholdID *string `json:"householdId,omitempty"`
+	// Roles are the names of the roles of the user in their household
+	Roles     []string  `json:"roles"`
 	CreatedAt time.Time `json:"createdAt"`
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// Validate checks the user to register, password is the plain text password before it is hashed
+func (u User) Validate(password string) error {
+	if u.Email == "" {
+		return ErrUserEmailEmpty
+	}
+	if len(password) < UserPasswordMinLength {
+		return ErrUserPasswordTooShort
+	}
+
+	return nil
+}
+
 type AuthToken struct {
 	Token     string    `json:"token"`
 	ExpiresAt time.Time `json:"expiresAt"`
 	UserID    string    `json:"userId"`
 }
+
+type userContextKey struct{}
+
+// WithUser returns a context carrying the authenticated user of a request
+func WithUser(
+	ctx context.Context,
+	user *User,
+) context.Context {
+	return context.WithValue(
+		ctx,
+		userContextKey{},
+		user,
+	)
+}
+
+// UserFromContext returns the authenticated user of the context, if any
+func UserFromContext(ctx context.Context) (
+	*User,
+	bool,
+) {
+	user, ok := ctx.Value(userContextKey{}).(*User)
+
+	return user, ok && user != nil
+}
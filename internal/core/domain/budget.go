@@ -0,0 +1,412 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// Budget domain errors
+var (
+	ErrBudgetNotFound            = errors.New("budget not found")
+	ErrInvalidBudgetAmount       = errors.New("budget amount must be greater than zero")
+	ErrInvalidBudgetCurrency     = errors.New("budget currency is required")
+	ErrInvalidBudgetPeriodType   = errors.New("invalid budget period type")
+	ErrInvalidBudgetPeriodDays   = errors.New("custom budget periods must last at least 1 day, other periods take no length")
+	ErrInvalidBudgetEndDate      = errors.New("budget end date must not be before its start date")
+	ErrBudgetCategoryNotExpenses = errors.New("budgets can only limit expenditure categories")
+	ErrBudgetOutOfRange          = errors.New("date is outside the budget validity")
+	ErrInvalidBudgetRollover     = errors.New("invalid budget rollover")
+	ErrInvalidBudgetPeriodNumber = errors.New("budget period numbers start at 0")
+	ErrInvalidBudgetPeriodAmount = errors.New("budget period amount must not be negative")
+)
+
+// BudgetPeriodType tells how long each period of a budget lasts
+type BudgetPeriodType string
+
+const (
+	BudgetPeriodWeekly  BudgetPeriodType = "weekly"
+	BudgetPeriodMonthly BudgetPeriodType = "monthly"
+	// BudgetPeriodCustom periods last PeriodDays, e.g. fortnightly pay cycles
+	BudgetPeriodCustom BudgetPeriodType = "custom"
+)
+
+// IsValid checks if the budget period type is valid
+func (t BudgetPeriodType) IsValid() bool {
+	switch t {
+	case BudgetPeriodWeekly, BudgetPeriodMonthly, BudgetPeriodCustom:
+		return true
+	}
+
+	return false
+}
+
+// BudgetRollover tells what is carried from the remaining amount of a budget period to the next one
+type BudgetRollover string
+
+const (
+	// BudgetRolloverReset starts every period with its own amount
+	BudgetRolloverReset BudgetRollover = "reset"
+	// BudgetRolloverUnspent adds what was not spent to the next period
+	BudgetRolloverUnspent BudgetRollover = "unspent"
+	// BudgetRolloverOverspent deducts what was overspent from the next period
+	BudgetRolloverOverspent BudgetRollover = "overspent"
+	// BudgetRolloverAll carries both unspent and overspent amounts
+	BudgetRolloverAll BudgetRollover = "all"
+)
+
+// IsValid checks if the budget rollover is valid
+func (r BudgetRollover) IsValid() bool {
+	switch r {
+	case BudgetRolloverReset, BudgetRolloverUnspent, BudgetRolloverOverspent, BudgetRolloverAll:
+		return true
+	}
+
+	return false
+}
+
+// Carry returns what is carried to the next period from the remaining amount of a period
+func (r BudgetRollover) Carry(remaining float32) float32 {
+	switch {
+	case r == BudgetRolloverAll,
+		r == BudgetRolloverUnspent && remaining > 0,
+		r == BudgetRolloverOverspent && remaining < 0:
+		return remaining
+	}
+
+	return 0
+}
+
+// Budget limits what can be spent in an expenditure category on every period, starting on StartDate.
+// Monthly periods keep the day of month of the start date, so a budget starting on the 1st follows
+// calendar months.
+type Budget struct {
+	ID         string           `json:"id"`
+	CategoryID string           `json:"category_id"`
+	Amount     float32          `json:"amount"`
+	Currency   string           `json:"currency"`
+	PeriodType BudgetPeriodType `json:"period_type"`
+	PeriodDays *int             `json:"period_days,omitempty"`
+	Rollover   BudgetRollover   `json:"rollover"`
+	StartDate  time.Time        `json:"start_date"`
+	EndDate    *time.Time       `json:"end_date,omitempty"`
+	Active     bool             `json:"active"`
+	CreatedAt  time.Time        `json:"created_at"`
+	UpdatedAt  time.Time        `json:"updated_at"`
+}
+
+// Validate checks the budget business rules
+func (b *Budget) Validate() error {
+	if b.Amount <= 0 {
+		return ErrInvalidBudgetAmount
+	}
+	if b.Currency == "" {
+		return ErrInvalidBudgetCurrency
+	}
+	if !b.PeriodType.IsValid() {
+		return ErrInvalidBudgetPeriodType
+	}
+	if (b.PeriodType == BudgetPeriodCustom) != (b.PeriodDays != nil) ||
+		(b.PeriodDays != nil && *b.PeriodDays < 1) {
+		return ErrInvalidBudgetPeriodDays
+	}
+	if !b.Rollover.IsValid() {
+		return ErrInvalidBudgetRollover
+	}
+	if b.EndDate != nil && b.EndDate.Before(b.StartDate) {
+		return ErrInvalidBudgetEndDate
+	}
+
+	return nil
+}
+
+// BudgetPeriod is one period of a budget, Number counts the periods from 0 on the budget start date
+type BudgetPeriod struct {
+	Number int       `json:"number"`
+	Start  time.Time `json:"start"`
+	// End is the last day of the period, included
+	End time.Time `json:"end"`
+}
+
+// Range returns the [from, to) date range covered by the period
+func (p BudgetPeriod) Range() (
+	from time.Time,
+	to time.Time,
+) {
+	return p.Start, p.End.AddDate(
+		0,
+		0,
+		1,
+	)
+}
+
+// Period returns the period with the given number
+func (b *Budget) Period(number int) BudgetPeriod {
+	return BudgetPeriod{
+		Number: number,
+		Start:  b.periodStart(number),
+		End: b.periodStart(number+1).AddDate(
+			0,
+			0,
+			-1,
+		),
+	}
+}
+
+// PeriodAt returns the period containing date, or ErrBudgetOutOfRange before the budget start or
+// after its end
+func (b *Budget) PeriodAt(date time.Time) (
+	BudgetPeriod,
+	error,
+) {
+	day := truncateToDay(date)
+	start := truncateToDay(b.StartDate)
+	if day.Before(start) || (b.EndDate != nil && day.After(truncateToDay(*b.EndDate))) {
+		return BudgetPeriod{}, ErrBudgetOutOfRange
+	}
+
+	return b.Period(b.periodNumber(day)), nil
+}
+
+// InRange checks if the period with the given number starts within the budget validity
+func (b *Budget) InRange(number int) bool {
+	return number >= 0 && (b.EndDate == nil || !b.periodStart(number).After(truncateToDay(*b.EndDate)))
+}
+
+// SamePeriods checks if both budgets split time in the same periods
+func (b *Budget) SamePeriods(other Budget) bool {
+	return b.PeriodType == other.PeriodType &&
+		truncateToDay(b.StartDate).Equal(truncateToDay(other.StartDate)) &&
+		b.periodLength() == other.periodLength()
+}
+
+func (b *Budget) periodNumber(day time.Time) int {
+	start := truncateToDay(b.StartDate)
+
+	var number int
+	switch b.PeriodType {
+	case BudgetPeriodMonthly:
+		number = (day.Year()-start.Year())*12 + int(day.Month()-start.Month()) //nolint:mnd // months per year
+		if b.periodStart(number).After(day) {
+			number--
+		}
+	case BudgetPeriodWeekly, BudgetPeriodCustom:
+		number = int(day.Sub(start).Hours()/24) / b.periodLength() //nolint:mnd // hours per day
+	}
+
+	return number
+}
+
+func (b *Budget) periodStart(number int) time.Time {
+	start := truncateToDay(b.StartDate)
+	if b.PeriodType == BudgetPeriodMonthly {
+		return addMonthsClamped(
+			start,
+			number,
+			start.Day(),
+		)
+	}
+
+	return start.AddDate(
+		0,
+		0,
+		number*b.periodLength(),
+	)
+}
+
+func (b *Budget) periodLength() int {
+	if b.PeriodType == BudgetPeriodCustom && b.PeriodDays != nil {
+		return *b.PeriodDays
+	}
+
+	return daysPerWeek
+}
+
+func truncateToDay(date time.Time) time.Time {
+	return time.Date(
+		date.Year(),
+		date.Month(),
+		date.Day(),
+		0,
+		0,
+		0,
+		0,
+		time.UTC,
+	)
+}
+
+type BudgetListParams struct {
+	CategoryID *string `json:"category_id,omitempty"`
+	Active     *bool   `json:"active,omitempty"`
+}
+
+// BudgetPeriodAmounts holds the amounts of the budget periods that differ from the budget amount, by
+// period number
+type BudgetPeriodAmounts map[int]float32
+
+// PeriodAmount returns the amount budgeted for the period with the given number
+func (b *Budget) PeriodAmount(
+	number int,
+	amounts BudgetPeriodAmounts,
+) float32 {
+	if amount, ok := amounts[number]; ok {
+		return amount
+	}
+
+	return b.Amount
+}
+
+// DailySpending is what was spent on a day
+type DailySpending struct {
+	Date   time.Time `json:"date"`
+	Amount float32   `json:"amount"`
+}
+
+// BudgetPeriodStatus is how much of a budget was available and spent in one of its periods
+type BudgetPeriodStatus struct {
+	Period BudgetPeriod `json:"period"`
+	// Budgeted is the amount of the period, Carried what the rollover brought from the previous one
+	Budgeted  float32 `json:"budgeted"`
+	Carried   float32 `json:"carried"`
+	Available float32 `json:"available"`
+	Spent     float32 `json:"spent"`
+	// Remaining is negative when the period is overspent
+	Remaining   float32 `json:"remaining"`
+	PercentUsed float32 `json:"percent_used"`
+}
+
+// History computes the status of every budget period up to last from what was spent each day. What
+// remains of a period is carried to the next one as the budget rollover says.
+func (b *Budget) History(
+	last int,
+	amounts BudgetPeriodAmounts,
+	spending []DailySpending,
+) []BudgetPeriodStatus {
+	if last < 0 {
+		return []BudgetPeriodStatus{}
+	}
+
+	spent := make(
+		[]float32,
+		last+1,
+	)
+	for _, day := range spending {
+		number := b.periodNumber(truncateToDay(day.Date))
+		if number >= 0 && number <= last {
+			spent[number] += day.Amount
+		}
+	}
+
+	history := make(
+		[]BudgetPeriodStatus,
+		0,
+		last+1,
+	)
+	var carried float32
+	for number := 0; number <= last; number++ {
+		status := newBudgetPeriodStatus(
+			b.Period(number),
+			b.PeriodAmount(
+				number,
+				amounts,
+			),
+			carried,
+			roundCents(spent[number]),
+		)
+		history = append(
+			history,
+			status,
+		)
+		carried = b.Rollover.Carry(status.Remaining)
+	}
+
+	return history
+}
+
+func newBudgetPeriodStatus(
+	period BudgetPeriod,
+	budgeted float32,
+	carried float32,
+	spent float32,
+) BudgetPeriodStatus {
+	available := roundCents(budgeted + carried)
+	status := BudgetPeriodStatus{
+		Period:    period,
+		Budgeted:  budgeted,
+		Carried:   carried,
+		Available: available,
+		Spent:     spent,
+		Remaining: roundCents(available - spent),
+	}
+	switch {
+	case available > 0:
+		status.PercentUsed = roundCents(spent / available * 100) //nolint:mnd // percentage
+	case spent > 0 || available < 0:
+		// nothing was available, so whatever is spent uses it all
+		status.PercentUsed = 100
+	}
+
+	return status
+}
+
+// BudgetStatus is the status of a budget in one of its periods
+type BudgetStatus struct {
+	Budget Budget `json:"budget"`
+	BudgetPeriodStatus
+}
+
+// BudgetHistory is the status of every period of a budget up to a date
+type BudgetHistory struct {
+	Budget  Budget               `json:"budget"`
+	Periods []BudgetPeriodStatus `json:"periods"`
+}
+
+// BudgetOverview is the status of every active budget on a date, with totals per currency
+type BudgetOverview struct {
+	Date     time.Time      `json:"date"`
+	Statuses []BudgetStatus `json:"statuses"`
+	Totals   []BudgetTotal  `json:"totals"`
+}
+
+type BudgetTotal struct {
+	Currency  string  `json:"currency"`
+	Budgeted  float32 `json:"budgeted"`
+	Available float32 `json:"available"`
+	Spent     float32 `json:"spent"`
+	Remaining float32 `json:"remaining"`
+}
+
+// NewBudgetOverview adds up the statuses per currency, in the order the currencies first appear
+func NewBudgetOverview(
+	date time.Time,
+	statuses []BudgetStatus,
+) BudgetOverview {
+	overview := BudgetOverview{
+		Date:     date,
+		Statuses: statuses,
+		Totals:   make([]BudgetTotal, 0),
+	}
+	totalIndex := make(map[string]int)
+	for _, status := range statuses {
+		i, ok := totalIndex[status.Budget.Currency]
+		if !ok {
+			i = len(overview.Totals)
+			totalIndex[status.Budget.Currency] = i
+			overview.Totals = append(
+				overview.Totals,
+				BudgetTotal{Currency: status.Budget.Currency},
+			)
+		}
+		total := &overview.Totals[i]
+		total.Budgeted = roundCents(total.Budgeted + status.Budgeted)
+		total.Available = roundCents(total.Available + status.Available)
+		total.Spent = roundCents(total.Spent + status.Spent)
+		total.Remaining = roundCents(total.Available - total.Spent)
+	}
+
+	return overview
+}
+
+func roundCents(amount float32) float32 {
+	return float32(math.Round(float64(amount)*100) / 100) //nolint:mnd // cents
+}
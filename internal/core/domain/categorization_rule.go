@@ -0,0 +1,283 @@
+package domain
+
+import (
+	"errors"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Categorization rule domain errors
+var (
+	ErrCategorizationRuleNotFound     = errors.New("categorization rule not found")
+	ErrCategorizationRuleNameEmpty    = errors.New("categorization rule name cannot be empty")
+	ErrInvalidRulePriority            = errors.New("categorization rule priority must not be negative")
+	ErrRuleWithoutConditions          = errors.New("categorization rules need at least one condition")
+	ErrRuleWithoutActions             = errors.New("categorization rules must assign a category or tags")
+	ErrInvalidDescriptionMatch        = errors.New("invalid description match type")
+	ErrInvalidDescriptionPattern      = errors.New("invalid description pattern")
+	ErrInvalidRuleAmountRange         = errors.New("rule amounts must not be negative and the minimum must not exceed the maximum")
+	ErrRuleCategoryNotExpenses        = errors.New("categorization rules can only assign expenditure categories")
+	ErrRuleTagNotExpenses             = errors.New("categorization rules can only assign expenditure tags")
+	ErrRuleApplyScopeRequired         = errors.New("choose the expenditures or the category to run the rules on")
+	ErrInvalidCategorizationDateRange = errors.New("categorization start date must not be after its end date")
+)
+
+// DescriptionMatch tells how the description pattern of a rule is compared with transaction descriptions
+type DescriptionMatch string
+
+const (
+	// DescriptionMatchContains matches descriptions containing the pattern, ignoring case
+	DescriptionMatchContains DescriptionMatch = "contains"
+	// DescriptionMatchRegex matches descriptions against the pattern as a regular expression
+	DescriptionMatchRegex DescriptionMatch = "regex"
+)
+
+// IsValid checks if the description match type is valid
+func (m DescriptionMatch) IsValid() bool {
+	return m == DescriptionMatchContains || m == DescriptionMatchRegex
+}
+
+// DescriptionCondition matches the description of transactions
+type DescriptionCondition struct {
+	Match   DescriptionMatch `json:"match"`
+	Pattern string           `json:"pattern"`
+}
+
+// CategorizationRule assigns a category and tags to the expenditures matching every one of its conditions.
+// Rules run by priority, lowest first.
+type CategorizationRule struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Priority int    `json:"priority"`
+	Active   bool   `json:"active"`
+
+	// Conditions, every given one must match
+	Description *DescriptionCondition `json:"description,omitempty"`
+	MinAmount   *float32              `json:"min_amount,omitempty"`
+	MaxAmount   *float32              `json:"max_amount,omitempty"`
+	AccountID   *string               `json:"account_id,omitempty"`
+	PayeeID     *string               `json:"payee_id,omitempty"`
+
+	// Actions
+	CategoryID *string  `json:"category_id,omitempty"`
+	TagIDs     []string `json:"tag_ids"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Validate checks the categorization rule business rules
+func (r *CategorizationRule) Validate() error {
+	r.Name = strings.TrimSpace(r.Name)
+	if r.Name == "" {
+		return ErrCategorizationRuleNameEmpty
+	}
+	if r.Priority < 0 {
+		return ErrInvalidRulePriority
+	}
+	if r.Description == nil && r.MinAmount == nil && r.MaxAmount == nil && r.AccountID == nil && r.PayeeID == nil {
+		return ErrRuleWithoutConditions
+	}
+	if r.CategoryID == nil && len(r.TagIDs) == 0 {
+		return ErrRuleWithoutActions
+	}
+
+	if r.Description != nil {
+		if !r.Description.Match.IsValid() {
+			return ErrInvalidDescriptionMatch
+		}
+		if strings.TrimSpace(r.Description.Pattern) == "" {
+			return ErrInvalidDescriptionPattern
+		}
+		if r.Description.Match == DescriptionMatchRegex {
+			_, err := regexp.Compile(r.Description.Pattern)
+			if err != nil {
+				return ErrInvalidDescriptionPattern
+			}
+		}
+	}
+
+	if (r.MinAmount != nil && *r.MinAmount < 0) || (r.MaxAmount != nil && *r.MaxAmount < 0) {
+		return ErrInvalidRuleAmountRange
+	}
+	if r.MinAmount != nil && r.MaxAmount != nil && *r.MinAmount > *r.MaxAmount {
+		return ErrInvalidRuleAmountRange
+	}
+
+	return nil
+}
+
+// Matches checks the expenditure meets every condition of the rule. Amounts are compared with what was
+// charged to the account.
+func (r *CategorizationRule) Matches(candidate RuleCandidate) bool {
+	if r.AccountID != nil && *r.AccountID != candidate.AccountID {
+		return false
+	}
+	if r.PayeeID != nil && (candidate.PayeeID == nil || *r.PayeeID != *candidate.PayeeID) {
+		return false
+	}
+	if r.MinAmount != nil && candidate.Amount < *r.MinAmount {
+		return false
+	}
+	if r.MaxAmount != nil && candidate.Amount > *r.MaxAmount {
+		return false
+	}
+	if r.Description == nil {
+		return true
+	}
+
+	if r.Description.Match == DescriptionMatchRegex {
+		pattern, err := regexp.Compile(r.Description.Pattern)
+
+		return err == nil && pattern.MatchString(candidate.Description)
+	}
+
+	return strings.Contains(
+		strings.ToLower(candidate.Description),
+		strings.ToLower(r.Description.Pattern),
+	)
+}
+
+type CategorizationRuleListParams struct {
+	Active *bool `json:"active,omitempty"`
+}
+
+// RuleCandidate is an expenditure as categorization rules see it
+type RuleCandidate struct {
+	ExpenditureID string    `json:"expenditure_id"`
+	Date          time.Time `json:"date"`
+	Description   string    `json:"description"`
+	Amount        float32   `json:"amount"`
+	Currency      string    `json:"currency"`
+	AccountID     string    `json:"account_id"`
+	PayeeID       *string   `json:"payee_id,omitempty"`
+	CategoryID    string    `json:"category_id"`
+	TagIDs        []string  `json:"tag_ids"`
+}
+
+// NewRuleCandidate returns the candidate of an expenditure being created
+func NewRuleCandidate(expenditure Expenditure) RuleCandidate {
+	candidate := RuleCandidate{
+		ExpenditureID: expenditure.ID,
+		Date:          expenditure.Transaction.TransactionDate,
+		Description:   expenditure.Transaction.Description,
+		Amount:        expenditure.Transaction.Amount,
+		Currency:      expenditure.Transaction.Currency,
+		AccountID:     expenditure.Transaction.AccountID,
+		PayeeID:       expenditure.PayeeID,
+	}
+	if expenditure.Category != nil {
+		candidate.CategoryID = expenditure.Category.ID
+	}
+	if expenditure.Tags != nil {
+		for _, tag := range *expenditure.Tags {
+			candidate.TagIDs = append(
+				candidate.TagIDs,
+				tag.ID,
+			)
+		}
+	}
+
+	return candidate
+}
+
+// RuleCandidateParams chooses the past expenditures rules are run on
+type RuleCandidateParams struct {
+	ExpenditureIDs []string `json:"expenditure_ids,omitempty"`
+	// CategoryID chooses the expenditures of a category, e.g. a catch-all one for uncategorized spending
+	CategoryID *string    `json:"category_id,omitempty"`
+	StartDate  *time.Time `json:"start_date,omitempty"`
+	EndDate    *time.Time `json:"end_date,omitempty"`
+}
+
+// Validate checks the date range of the params
+func (p *RuleCandidateParams) Validate() error {
+	if p.StartDate != nil && p.EndDate != nil && p.StartDate.After(*p.EndDate) {
+		return ErrInvalidCategorizationDateRange
+	}
+
+	return nil
+}
+
+// Categorization is what the rules matching an expenditure assign to it
+type Categorization struct {
+	ExpenditureID string    `json:"expenditure_id"`
+	Date          time.Time `json:"date"`
+	Description   string    `json:"description"`
+	Amount        float32   `json:"amount"`
+	Currency      string    `json:"currency"`
+	// FromCategoryID is the category of the expenditure before the rules run
+	FromCategoryID string `json:"from_category_id"`
+	// CategoryID is set by the first matching rule assigning a category
+	CategoryID *string `json:"category_id,omitempty"`
+	// AddedTagIDs are the tags of every matching rule the expenditure does not have yet
+	AddedTagIDs []string `json:"added_tag_ids"`
+	RuleIDs     []string `json:"rule_ids"`
+}
+
+// Changed tells if the rules change the category or the tags of the expenditure
+func (c *Categorization) Changed() bool {
+	return (c.CategoryID != nil && *c.CategoryID != c.FromCategoryID) || len(c.AddedTagIDs) > 0
+}
+
+// Categorize runs the active rules, sorted by priority, on the candidate. Every matching rule adds its
+// tags, the first one assigning a category decides it. Nil is returned when no rule matches.
+func Categorize(
+	rules []CategorizationRule,
+	candidate RuleCandidate,
+) *Categorization {
+	var categorization *Categorization
+	for _, rule := range rules {
+		if !rule.Active || !rule.Matches(candidate) {
+			continue
+		}
+
+		if categorization == nil {
+			categorization = &Categorization{
+				ExpenditureID:  candidate.ExpenditureID,
+				Date:           candidate.Date,
+				Description:    candidate.Description,
+				Amount:         candidate.Amount,
+				Currency:       candidate.Currency,
+				FromCategoryID: candidate.CategoryID,
+				AddedTagIDs: make(
+					[]string,
+					0,
+				),
+			}
+		}
+		categorization.RuleIDs = append(
+			categorization.RuleIDs,
+			rule.ID,
+		)
+		if categorization.CategoryID == nil {
+			categorization.CategoryID = rule.CategoryID
+		}
+		for _, tagID := range rule.TagIDs {
+			if slices.Contains(
+				candidate.TagIDs,
+				tagID,
+			) || slices.Contains(
+				categorization.AddedTagIDs,
+				tagID,
+			) {
+				continue
+			}
+			categorization.AddedTagIDs = append(
+				categorization.AddedTagIDs,
+				tagID,
+			)
+		}
+	}
+
+	return categorization
+}
+
+// CategorizationRun lists what the rules assigned to past expenditures
+type CategorizationRun struct {
+	Categorizations []Categorization `json:"categorizations"`
+	// Total counts the categorizations before any limit
+	Total int `json:"total"`
+}
@@ -1,19 +1,37 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"sort"
+)
 
 // Category domain errors
 var (
-	ErrCategoryNotFound              = errors.New("category not found")
-	ErrCategoryHasActiveSavingsGoals = errors.New("category has active savings goals and cannot be deleted")
-	ErrCategoryInactive              = errors.New("category is inactive")
-	ErrCategoryAlreadyActive         = errors.New("category is already active")
-	ErrCategoryAlreadyInactive       = errors.New("category is already inactive")
-	ErrCategoryUsedInExpenditure     = errors.New("category is used in expenditures")
-	ErrCategoryUsedInTransfer        = errors.New("category is used in transfers")
-	ErrCategoryUsedInSavingGoal      = errors.New("category is used in saving goals")
-	ErrCategoryUsedInIngress         = errors.New("category is used in ingresses")
-	ErrCategoryUsedInEntity          = errors.New("category is used in entity")
+	ErrCategoryNotFound                 = errors.New("category not found")
+	ErrCategoryHasActiveSavingsGoals    = errors.New("category has active savings goals and cannot be deleted")
+	ErrCategoryInactive                 = errors.New("category is inactive")
+	ErrCategoryAlreadyActive            = errors.New("category is already active")
+	ErrCategoryAlreadyInactive          = errors.New("category is already inactive")
+	ErrCategoryActiveChanged            = errors.New("category cannot be activated or deactivated by updating it, use its activate and deactivate endpoints")
+	ErrCategoryUsedInExpenditure        = errors.New("category is used in expenditures")
+	ErrCategoryUsedInTransfer           = errors.New("category is used in transfers")
+	ErrCategoryUsedInSavingGoal         = errors.New("category is used in saving goals")
+	ErrCategoryUsedInIngress            = errors.New("category is used in ingresses")
+	ErrCategoryUsedInEntity             = errors.New("category is used in entity")
+	ErrCategoryUsedInBudget             = errors.New("category is used in budgets")
+	ErrCategoryUsedInAllocationPlan     = errors.New("category is used in allocation plans")
+	ErrCategoryUsedInCategorizationRule = errors.New("category is used in categorization rules")
+	ErrCategoryHasSubcategories         = errors.New("category has subcategories")
+	ErrCategoryParentNotFound           = errors.New("parent category not found")
+	ErrCategoryParentInactive           = errors.New("parent category is inactive")
+	ErrCategoryParentTypeMismatch       = errors.New("parent and subcategories must have the same category type")
+	ErrCategoryCycle                    = errors.New("category cannot be placed under itself or its subcategories")
+	ErrCategoryMergeTargetNotFound      = errors.New("merge target category not found")
+	ErrCategoryMergeSameCategory        = errors.New("a category cannot be merged into itself")
+	ErrCategoryMergeTypeMismatch        = errors.New("only categories of the same type can be merged")
+	ErrCategoryMergeIntoSubcategory     = errors.New("a category cannot be merged into one of its subcategories")
+	ErrCategoryMergeBothBudgeted        = errors.New("both categories have budgets, delete those of one of them before merging")
+	ErrInvalidCategoryMergeAction       = errors.New("invalid category merge action")
 )
 
 type Category struct {
@@ -24,6 +42,8 @@ type Category struct {
 	BackgroundColor string       `json:"background_color"`
 	Active          bool         `json:"active"`
 	CategoryType    CategoryType `json:"type"`
+	// ParentID is the category this one is grouped under, nil for top level categories
+	ParentID *string `json:"parent_id,omitempty"`
 }
 
 type CategoryType string
@@ -52,3 +72,106 @@ func (c *Category) Deactivate() error {
 
 	return nil
 }
+
+// CategoryMergeAction tells what happens to the source category of a merge once it is empty
+type CategoryMergeAction string
+
+const (
+	CategoryMergeDelete     CategoryMergeAction = "delete"
+	CategoryMergeDeactivate CategoryMergeAction = "deactivate"
+)
+
+// IsValid checks if the merge action is valid
+func (a CategoryMergeAction) IsValid() bool {
+	return a == CategoryMergeDelete || a == CategoryMergeDeactivate
+}
+
+// CategoryReassignments counts the records moved from one category to another
+type CategoryReassignments struct {
+	Expenditures     int64 `json:"expenditures"`
+	Ingresses        int64 `json:"ingresses"`
+	SavingsGoals     int64 `json:"savings_goals"`
+	RecurringBills   int64 `json:"recurring_bills"`
+	InstallmentPlans int64 `json:"installment_plans"`
+	Budgets          int64 `json:"budgets"`
+	// Allocations of the source in a plan that also allocates to the target are added to the target one
+	Allocations   int64 `json:"allocations"`
+	Payees        int64 `json:"payees"`
+	Rules         int64 `json:"rules"`
+	Subcategories int64 `json:"subcategories"`
+}
+
+// CategoryMergeResult is the target of a merge with what was moved to it
+type CategoryMergeResult struct {
+	Target Category              `json:"target"`
+	Moved  CategoryReassignments `json:"moved"`
+}
+
+// CategoryNode is a category with the categories grouped under it
+type CategoryNode struct {
+	Category
+	Children []CategoryNode `json:"children"`
+}
+
+// NewCategoryTree nests the categories under their parents. Categories whose parent is not in the list
+// are placed at the top level. Every level is sorted by name.
+func NewCategoryTree(categories []Category) []CategoryNode {
+	listed := make(map[string]bool)
+	for _, category := range categories {
+		listed[category.ID] = true
+	}
+
+	children := make(map[string][]Category)
+	var roots []Category
+	for _, category := range categories {
+		if category.ParentID == nil || !listed[*category.ParentID] {
+			roots = append(
+				roots,
+				category,
+			)
+
+			continue
+		}
+		children[*category.ParentID] = append(
+			children[*category.ParentID],
+			category,
+		)
+	}
+
+	return categoryNodes(
+		roots,
+		children,
+	)
+}
+
+func categoryNodes(
+	categories []Category,
+	children map[string][]Category,
+) []CategoryNode {
+	sort.Slice(
+		categories,
+		func(i, j int) bool {
+			return categories[i].Name < categories[j].Name
+		},
+	)
+
+	nodes := make(
+		[]CategoryNode,
+		0,
+		len(categories),
+	)
+	for _, category := range categories {
+		nodes = append(
+			nodes,
+			CategoryNode{
+				Category: category,
+				Children: categoryNodes(
+					children[category.ID],
+					children,
+				),
+			},
+		)
+	}
+
+	return nodes
+}
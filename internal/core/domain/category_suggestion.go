@@ -0,0 +1,285 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// minSuggestionTokenLength drops initials and other short noise from descriptions
+const minSuggestionTokenLength = 2
+
+var (
+	ErrInvalidSuggestionDescription = errors.New("description must contain at least one word to suggest a category")
+	ErrInvalidSuggestionLimit       = errors.New("suggestion limit must be positive")
+)
+
+// TrainingExample is a past expenditure the suggestion model learns from
+type TrainingExample struct {
+	ExpenditureID string   `json:"expenditure_id"`
+	Description   string   `json:"description"`
+	CategoryID    string   `json:"category_id"`
+	TagIDs        []string `json:"tag_ids"`
+}
+
+// SuggestionTokens splits a description into the lowercase words the suggestion model uses. Numbers are
+// left out as they are usually dates, amounts or references that change on every transaction.
+func SuggestionTokens(description string) []string {
+	words := strings.FieldsFunc(
+		strings.ToLower(description),
+		func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		},
+	)
+
+	tokens := make(
+		[]string,
+		0,
+		len(words),
+	)
+	for _, word := range words {
+		if len([]rune(word)) < minSuggestionTokenLength || strings.IndexFunc(
+			word,
+			unicode.IsLetter,
+		) < 0 {
+			continue
+		}
+		tokens = append(
+			tokens,
+			word,
+		)
+	}
+
+	return tokens
+}
+
+// tokenCounts counts the descriptions of a label and the words in them
+type tokenCounts struct {
+	docs   int
+	total  int
+	tokens map[string]int
+}
+
+func newTokenCounts() *tokenCounts {
+	return &tokenCounts{tokens: make(map[string]int)}
+}
+
+func (c *tokenCounts) add(tokens []string) {
+	c.docs++
+	c.total += len(tokens)
+	for _, token := range tokens {
+		c.tokens[token]++
+	}
+}
+
+// logLikelihood is the log probability of the tokens in the label with Laplace smoothing
+func (c *tokenCounts) logLikelihood(
+	tokens []string,
+	vocabulary int,
+) float64 {
+	var likelihood float64
+	for _, token := range tokens {
+		likelihood += math.Log(float64(c.tokens[token]+1) / float64(c.total+vocabulary))
+	}
+
+	return likelihood
+}
+
+// SuggestionModel is a naive Bayes model over the words of expenditure descriptions. Categories are
+// ranked against each other, while each tag is a yes or no decision as an expenditure can have
+// several tags. It learns one expenditure at a time, so it is trained incrementally.
+type SuggestionModel struct {
+	all        *tokenCounts
+	categories map[string]*tokenCounts
+	tags       map[string]*tokenCounts
+	// LastExpenditureID is the last expenditure learned, newer ones are learned on the next training
+	LastExpenditureID string
+}
+
+func NewSuggestionModel() *SuggestionModel {
+	return &SuggestionModel{
+		all:        newTokenCounts(),
+		categories: make(map[string]*tokenCounts),
+		tags:       make(map[string]*tokenCounts),
+	}
+}
+
+// Learn adds a past expenditure to the model, those without words in their description are skipped
+func (m *SuggestionModel) Learn(example TrainingExample) {
+	m.LastExpenditureID = example.ExpenditureID
+
+	tokens := SuggestionTokens(example.Description)
+	if len(tokens) == 0 {
+		return
+	}
+
+	m.all.add(tokens)
+	if _, ok := m.categories[example.CategoryID]; !ok {
+		m.categories[example.CategoryID] = newTokenCounts()
+	}
+	m.categories[example.CategoryID].add(tokens)
+	for _, tagID := range example.TagIDs {
+		if _, ok := m.tags[tagID]; !ok {
+			m.tags[tagID] = newTokenCounts()
+		}
+		m.tags[tagID].add(tokens)
+	}
+}
+
+// Status describes what the model learned
+func (m *SuggestionModel) Status() SuggestionModelStatus {
+	return SuggestionModelStatus{
+		Examples:   m.all.docs,
+		Categories: len(m.categories),
+		Tags:       len(m.tags),
+		Vocabulary: len(m.all.tokens),
+	}
+}
+
+// Suggest ranks the learned categories and tags for the description. Category confidences are the
+// posterior probabilities of the categories and add up to one, tag confidences are the probability
+// of each tag on its own and only tags more likely than not are suggested.
+func (m *SuggestionModel) Suggest(description string) (
+	*CategorySuggestion,
+	error,
+) {
+	tokens := SuggestionTokens(description)
+	if len(tokens) == 0 {
+		return nil, ErrInvalidSuggestionDescription
+	}
+
+	suggestion := &CategorySuggestion{
+		Categories: make(
+			[]ScoredSuggestion,
+			0,
+		),
+		Tags: make(
+			[]ScoredSuggestion,
+			0,
+		),
+		Status: m.Status(),
+	}
+
+	// Words never seen carry no evidence
+	known := make(
+		[]string,
+		0,
+		len(tokens),
+	)
+	for _, token := range tokens {
+		if m.all.tokens[token] > 0 {
+			known = append(
+				known,
+				token,
+			)
+		}
+	}
+	if len(known) == 0 {
+		return suggestion, nil
+	}
+
+	vocabulary := len(m.all.tokens)
+	scores := make(
+		map[string]float64,
+		len(m.categories),
+	)
+	best := math.Inf(-1)
+	for categoryID, counts := range m.categories {
+		score := math.Log(float64(counts.docs)/float64(m.all.docs)) + counts.logLikelihood(
+			known,
+			vocabulary,
+		)
+		scores[categoryID] = score
+		best = math.Max(
+			best,
+			score,
+		)
+	}
+	// Normalizing in the exponent avoids underflow with long descriptions
+	var sum float64
+	for _, score := range scores {
+		sum += math.Exp(score - best)
+	}
+	for categoryID, score := range scores {
+		suggestion.Categories = append(
+			suggestion.Categories,
+			ScoredSuggestion{
+				ID:         categoryID,
+				Confidence: math.Exp(score-best) / sum,
+			},
+		)
+	}
+
+	for tagID, counts := range m.tags {
+		others := &tokenCounts{
+			docs:   m.all.docs - counts.docs,
+			total:  m.all.total - counts.total,
+			tokens: make(map[string]int),
+		}
+		for _, token := range known {
+			others.tokens[token] = m.all.tokens[token] - counts.tokens[token]
+		}
+
+		withTag := math.Log(float64(counts.docs+1)/float64(m.all.docs+2)) + counts.logLikelihood(
+			known,
+			vocabulary,
+		)
+		withoutTag := math.Log(float64(others.docs+1)/float64(m.all.docs+2)) + others.logLikelihood(
+			known,
+			vocabulary,
+		)
+		confidence := 1 / (1 + math.Exp(withoutTag-withTag))
+		if confidence <= 0.5 {
+			continue
+		}
+		suggestion.Tags = append(
+			suggestion.Tags,
+			ScoredSuggestion{
+				ID:         tagID,
+				Confidence: confidence,
+			},
+		)
+	}
+
+	sortSuggestions(suggestion.Categories)
+	sortSuggestions(suggestion.Tags)
+
+	return suggestion, nil
+}
+
+func sortSuggestions(suggestions []ScoredSuggestion) {
+	sort.Slice(
+		suggestions,
+		func(i, j int) bool {
+			if suggestions[i].Confidence != suggestions[j].Confidence {
+				return suggestions[i].Confidence > suggestions[j].Confidence
+			}
+
+			return suggestions[i].ID < suggestions[j].ID
+		},
+	)
+}
+
+// ScoredSuggestion is a suggested category or tag with the confidence of the model, between 0 and 1
+type ScoredSuggestion struct {
+	ID         string  `json:"id"`
+	Name       string  `json:"name"`
+	Confidence float64 `json:"confidence"`
+}
+
+// CategorySuggestion lists the suggested categories and tags of a description, most likely first
+type CategorySuggestion struct {
+	Categories []ScoredSuggestion    `json:"categories"`
+	Tags       []ScoredSuggestion    `json:"tags"`
+	Status     SuggestionModelStatus `json:"status"`
+}
+
+// SuggestionModelStatus counts what the suggestion model learned from
+type SuggestionModelStatus struct {
+	Examples   int `json:"examples"`
+	Categories int `json:"categories"`
+	Tags       int `json:"tags"`
+	Vocabulary int `json:"vocabulary"`
+}
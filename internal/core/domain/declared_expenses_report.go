@@ -0,0 +1,214 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+var (
+	ErrInvalidFiscalYear           = errors.New("invalid fiscal year")
+	ErrInvalidFiscalYearStartMonth = errors.New("fiscal year start month must be between 1 and 12")
+)
+
+type DeclaredExpensesParams struct {
+	FiscalYear int `json:"fiscal_year"`
+	// FiscalYearStartMonth is the month fiscal years begin in, fiscal year N starts on its first day in year N
+	FiscalYearStartMonth time.Month  `json:"fiscal_year_start_month"`
+	Currency             *string     `json:"currency,omitempty"`
+	MemberID             *string     `json:"member_id,omitempty"`
+	AmountBasis          AmountBasis `json:"amount_basis"`
+	// RollUp reports the expenses of subcategories under their top level category
+	RollUp bool `json:"roll_up"`
+}
+
+// Validate checks the report parameters
+func (p *DeclaredExpensesParams) Validate() error {
+	if p.FiscalYear < 1 {
+		return ErrInvalidFiscalYear
+	}
+	if p.FiscalYearStartMonth < time.January || p.FiscalYearStartMonth > time.December {
+		return ErrInvalidFiscalYearStartMonth
+	}
+	if !p.AmountBasis.IsValid() {
+		return ErrInvalidAmountBasis
+	}
+
+	return nil
+}
+
+// Range returns the [from, to) date range of the fiscal year
+func (p *DeclaredExpensesParams) Range() (
+	from time.Time,
+	to time.Time,
+) {
+	from = time.Date(
+		p.FiscalYear,
+		p.FiscalYearStartMonth,
+		1,
+		0,
+		0,
+		0,
+		0,
+		time.UTC,
+	)
+
+	return from, from.AddDate(
+		1,
+		0,
+		0,
+	)
+}
+
+// DeclaredExpense is an expenditure declared for tax purposes, with the member owning the paying account
+type DeclaredExpense struct {
+	ExpenditureID string     `json:"expenditure_id"`
+	Date          time.Time  `json:"date"`
+	Description   string     `json:"description"`
+	Amount        float64    `json:"amount"`
+	Currency      string     `json:"currency"`
+	CategoryID    string     `json:"category_id"`
+	CategoryName  string     `json:"category_name"`
+	Tags          []TagLabel `json:"tags"`
+	AccountID     string     `json:"account_id"`
+	AccountName   string     `json:"account_name"`
+	MemberID      string     `json:"member_id"`
+	MemberName    string     `json:"member_name"`
+}
+
+// TagLabel identifies a tag in reports
+type TagLabel struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// DeclaredExpenseTotal sums the declared expenses of a category, tag or member in one currency
+type DeclaredExpenseTotal struct {
+	ID       string  `json:"id"`
+	Name     string  `json:"name"`
+	Currency string  `json:"currency"`
+	Total    float64 `json:"total"`
+	Count    int     `json:"count"`
+}
+
+type DeclaredExpensesReport struct {
+	FiscalYear int       `json:"fiscal_year"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	// Totals sums every declared expense per currency, ID and Name are empty
+	Totals     []DeclaredExpenseTotal `json:"totals"`
+	ByCategory []DeclaredExpenseTotal `json:"by_category"`
+	// ByTag counts an expense once for each of its tags, so its totals may add up to more than Totals
+	ByTag    []DeclaredExpenseTotal `json:"by_tag"`
+	ByMember []DeclaredExpenseTotal `json:"by_member"`
+	Expenses []DeclaredExpense      `json:"expenses"`
+}
+
+// NewDeclaredExpensesReport groups the declared expenses of the fiscal year by category, tag and member
+func NewDeclaredExpensesReport(
+	params DeclaredExpensesParams,
+	expenses []DeclaredExpense,
+) *DeclaredExpensesReport {
+	from, to := params.Range()
+	report := &DeclaredExpensesReport{
+		FiscalYear: params.FiscalYear,
+		Start:      from,
+		End: to.AddDate(
+			0,
+			0,
+			-1,
+		),
+		Expenses: expenses,
+	}
+
+	totals := newDeclaredExpenseTotals()
+	byCategory := newDeclaredExpenseTotals()
+	byTag := newDeclaredExpenseTotals()
+	byMember := newDeclaredExpenseTotals()
+	for _, expense := range expenses {
+		totals.add(
+			"",
+			"",
+			expense,
+		)
+		byCategory.add(
+			expense.CategoryID,
+			expense.CategoryName,
+			expense,
+		)
+		byMember.add(
+			expense.MemberID,
+			expense.MemberName,
+			expense,
+		)
+		for _, tag := range expense.Tags {
+			byTag.add(
+				tag.ID,
+				tag.Name,
+				expense,
+			)
+		}
+	}
+
+	report.Totals = totals.sorted()
+	report.ByCategory = byCategory.sorted()
+	report.ByTag = byTag.sorted()
+	report.ByMember = byMember.sorted()
+
+	return report
+}
+
+type declaredExpenseTotals map[string]*DeclaredExpenseTotal
+
+func newDeclaredExpenseTotals() declaredExpenseTotals {
+	return make(declaredExpenseTotals)
+}
+
+func (t declaredExpenseTotals) add(
+	id string,
+	name string,
+	expense DeclaredExpense,
+) {
+	key := id + "\x00" + expense.Currency
+	total, ok := t[key]
+	if !ok {
+		total = &DeclaredExpenseTotal{
+			ID:       id,
+			Name:     name,
+			Currency: expense.Currency,
+		}
+		t[key] = total
+	}
+	total.Total += expense.Amount
+	total.Count++
+}
+
+// sorted returns the totals by name and currency so the report is stable between downloads
+func (t declaredExpenseTotals) sorted() []DeclaredExpenseTotal {
+	sorted := make(
+		[]DeclaredExpenseTotal,
+		0,
+		len(t),
+	)
+	for _, total := range t {
+		sorted = append(
+			sorted,
+			*total,
+		)
+	}
+	sort.Slice(
+		sorted,
+		func(i, j int) bool {
+			if sorted[i].Name != sorted[j].Name {
+				return sorted[i].Name < sorted[j].Name
+			}
+			if sorted[i].ID != sorted[j].ID {
+				return sorted[i].ID < sorted[j].ID
+			}
+
+			return sorted[i].Currency < sorted[j].Currency
+		},
+	)
+
+	return sorted
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var ErrPossibleDuplicate = errors.New("possible duplicate transaction")
+
+// descriptionSimilarityThreshold is the share of common words above which two descriptions are similar
+const descriptionSimilarityThreshold = 0.5
+
+// DuplicateTransactionError names the existing record a new transaction looks like
+type DuplicateTransactionError struct {
+	Type       TransactionType
+	ExistingID string
+}
+
+func (e *DuplicateTransactionError) Error() string {
+	return fmt.Sprintf(
+		"possible duplicate of %s %s, use force to create it anyway",
+		e.Type,
+		e.ExistingID,
+	)
+}
+
+func (e *DuplicateTransactionError) Unwrap() error {
+	return ErrPossibleDuplicate
+}
+
+// DuplicateBatchItemError names the earlier item of the same batch a new transaction looks like
+type DuplicateBatchItemError struct {
+	Index int
+}
+
+func (e *DuplicateBatchItemError) Error() string {
+	return fmt.Sprintf(
+		"possible duplicate of item %d of the batch, use force to create it anyway",
+		e.Index,
+	)
+}
+
+func (e *DuplicateBatchItemError) Unwrap() error {
+	return ErrPossibleDuplicate
+}
+
+// PossibleDuplicates compares two expenditures not stored yet as the duplicate detection does with
+// stored ones: same account, category and amount, dates at most days apart and similar descriptions
+func PossibleDuplicates(
+	a Expenditure,
+	b Expenditure,
+	days int,
+) bool {
+	from, to := DuplicateWindow(
+		a.Transaction.TransactionDate,
+		days,
+	)
+
+	return a.Transaction.AccountID == b.Transaction.AccountID &&
+		a.Category.ID == b.Category.ID &&
+		a.Transaction.Amount == b.Transaction.Amount &&
+		!b.Transaction.TransactionDate.Before(from) &&
+		!b.Transaction.TransactionDate.After(to) &&
+		SimilarDescriptions(
+			a.Transaction.Description,
+			b.Transaction.Description,
+		)
+}
+
+// DuplicateWindow returns the dates around date, both included, in which a transaction with the
+// same account, amount and category could be a duplicate
+func DuplicateWindow(
+	date time.Time,
+	days int,
+) (
+	from time.Time,
+	to time.Time,
+) {
+	return date.AddDate(
+			0,
+			0,
+			-days,
+		), date.AddDate(
+			0,
+			0,
+			days,
+		)
+}
+
+// SimilarDescriptions compares descriptions word by word, ignoring case and punctuation. They are
+// similar when the words of one are all in the other ("Lidl" and "LIDL 123") or when they share
+// at least half of their words.
+func SimilarDescriptions(
+	a string,
+	b string,
+) bool {
+	wordsA := descriptionWords(a)
+	wordsB := descriptionWords(b)
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return len(wordsA) == len(wordsB)
+	}
+
+	common := 0
+	for word := range wordsA {
+		if wordsB[word] {
+			common++
+		}
+	}
+	if common == min(
+		len(wordsA),
+		len(wordsB),
+	) {
+		return true
+	}
+
+	union := len(wordsA) + len(wordsB) - common
+
+	return float64(common)/float64(union) >= descriptionSimilarityThreshold
+}
+
+func descriptionWords(description string) map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(
+		strings.ToLower(description),
+		func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		},
+	) {
+		words[word] = true
+	}
+
+	return words
+}
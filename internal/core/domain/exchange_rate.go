@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+var (
+	ErrExchangeRateNotFound = errors.New("no exchange rate found for the transaction currency and date")
+	ErrInvalidExchangeRate  = errors.New("exchange rate must be greater than zero")
+)
+
+// ExchangeRate is how many units of the target currency one unit of the base currency is worth on
+// a date
+type ExchangeRate struct {
+	BaseCurrency   string    `json:"base_currency"`
+	TargetCurrency string    `json:"target_currency"`
+	Rate           float32   `json:"rate"`
+	Date           time.Time `json:"date"`
+}
+
+// Inverse returns the rate from the target currency back to the base currency
+func (r ExchangeRate) Inverse() ExchangeRate {
+	return ExchangeRate{
+		BaseCurrency:   r.TargetCurrency,
+		TargetCurrency: r.BaseCurrency,
+		Rate:           1 / r.Rate,
+		Date:           r.Date,
+	}
+}
+
+// ChargeIn converts the transaction to the given currency at rate, keeping the original amount and
+// currency. The charged amount is rounded to cents like every stored amount.
+func (t *Transaction) ChargeIn(
+	currency string,
+	rate float32,
+) error {
+	if rate <= 0 {
+		return ErrInvalidExchangeRate
+	}
+
+	originalAmount := t.Amount
+	originalCurrency := t.Currency
+	t.OriginalAmount = &originalAmount
+	t.OriginalCurrency = &originalCurrency
+	t.ExchangeRate = &rate
+	t.Amount = float32(math.Round(float64(originalAmount)*float64(rate)*100) / 100)
+	t.Currency = currency
+
+	return nil
+}
@@ -14,8 +14,37 @@ type Expenditure struct {
 	// Making a pointer to each tag since there can be a lot if expenditures are listed
 	Tags *[]*Tag   `json:"tags,omitempty"`
 	Date time.Time `json:"date"`
+	// RecurrencePatternID links expenditures generated from a recurring bill back to its pattern
+	RecurrencePatternID *string `json:"recurrence_pattern_id,omitempty"`
+	PayeeID             *string `json:"payee_id,omitempty"`
+
+	// InstallmentPlanID links the expenditures charging the installments of a plan, numbered from 1
+	InstallmentPlanID *string `json:"installment_plan_id,omitempty"`
+	InstallmentNumber *int    `json:"installment_number,omitempty"`
 }
 
 var (
-	ErrExpenditureNotFound = errors.New("expenditure not found")
+	ErrExpenditureNotFound            = errors.New("expenditure not found")
+	ErrExpenditureAccountRequired     = errors.New("expenditure account is required")
+	ErrExpenditureCurrencyRequired    = errors.New("expenditure currency is required")
+	ErrExpenditureDescriptionRequired = errors.New("expenditure description is required")
+	ErrInvalidExpenditureAmount       = errors.New("expenditure amount must be greater than zero")
 )
+
+// Validate checks the fields a new expenditure needs, before its account and category are looked up
+func (e *Expenditure) Validate() error {
+	if e.Transaction.AccountID == "" {
+		return ErrExpenditureAccountRequired
+	}
+	if e.Transaction.Currency == "" {
+		return ErrExpenditureCurrencyRequired
+	}
+	if e.Transaction.Description == "" {
+		return ErrExpenditureDescriptionRequired
+	}
+	if e.Transaction.Amount <= 0 {
+		return ErrInvalidExpenditureAmount
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Expenditure batch errors
+var (
+	ErrBatchEmpty       = errors.New("batch must contain at least one expenditure")
+	ErrBatchTooLarge    = fmt.Errorf("batch cannot contain more than %d expenditures", MaxExpenditureBatchSize)
+	ErrInvalidBatchMode = errors.New("invalid batch mode")
+)
+
+// MaxExpenditureBatchSize caps the expenditures created by a single batch
+const MaxExpenditureBatchSize = 500
+
+// BatchMode tells what happens to the valid items of a batch when others fail
+type BatchMode string
+
+const (
+	// BatchModeAllOrNothing creates nothing when any item fails
+	BatchModeAllOrNothing BatchMode = "allOrNothing"
+	// BatchModeBestEffort creates the valid items and reports the failed ones
+	BatchModeBestEffort BatchMode = "bestEffort"
+)
+
+// IsValid checks if the batch mode is valid
+func (m BatchMode) IsValid() bool {
+	switch m {
+	case BatchModeAllOrNothing, BatchModeBestEffort:
+		return true
+	}
+
+	return false
+}
+
+// BatchItemError is the reason the item at Index of a batch could not be created
+type BatchItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf(
+		"item %d: %s",
+		e.Index,
+		e.Err,
+	)
+}
+
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
+
+// ExpenditureBatchResult holds the created expenditures and the failed items of a batch, both
+// ordered by their index in the batch
+type ExpenditureBatchResult struct {
+	Mode    BatchMode
+	Created []ExpenditureBatchItem
+	Errors  []BatchItemError
+}
+
+// ExpenditureBatchItem is an expenditure created from the item at Index of a batch
+type ExpenditureBatchItem struct {
+	Index       int
+	Expenditure Expenditure
+}
+
+// ValidateExpenditureBatch checks the batch size and mode before its items are looked at
+func ValidateExpenditureBatch(
+	expenditures []Expenditure,
+	mode BatchMode,
+) error {
+	if !mode.IsValid() {
+		return ErrInvalidBatchMode
+	}
+	if len(expenditures) == 0 {
+		return ErrBatchEmpty
+	}
+	if len(expenditures) > MaxExpenditureBatchSize {
+		return ErrBatchTooLarge
+	}
+
+	return nil
+}
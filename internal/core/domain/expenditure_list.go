@@ -8,15 +8,22 @@ type ExpenditureList struct {
 }
 
 type ExpenditureListParams struct {
-	CategoryID  *string    `json:"categoryid"`
-	StartDate   *time.Time `json:"date_from"`
-	EndDate     *time.Time `json:"date_to"`
-	Declared    *bool      `json:"declared"`
-	Planned     *bool      `json:"planned"`
-	Currency    *string    `json:"currency"`
-	Description *string    `json:"description"`
-	AccountID   *string    `json:"account_id"`
-	Tags        *[]string  `json:"tags"`
-	Limit       *int       `json:"limit"`
-	Offset      *int       `json:"offset"`
+	CategoryID  *string            `json:"categoryid"`
+	StartDate   *time.Time         `json:"date_from"`
+	EndDate     *time.Time         `json:"date_to"`
+	Declared    *bool              `json:"declared"`
+	Planned     *bool              `json:"planned"`
+	Currency    *string            `json:"currency"`
+	Description *string            `json:"description"`
+	AccountID   *string            `json:"account_id"`
+	Tags        *[]string          `json:"tags"`
+	Status      *TransactionStatus `json:"status"`
+	// RecurrencePatternID lists the expenditures generated from a recurring bill
+	RecurrencePatternID *string `json:"recurrence_pattern_id"`
+	PayeeID             *string `json:"payee_id"`
+	// Amount matches the exact transaction amount, to the cent
+	Amount *float32    `json:"amount"`
+	Limit  *int        `json:"limit"`
+	Offset *int        `json:"offset"`
+	Sort   []SortOrder `json:"sort"`
 }
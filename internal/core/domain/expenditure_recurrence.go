@@ -0,0 +1,286 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// Expenditure recurrence pattern domain errors
+var (
+	ErrExpenditureRecurrencePatternNotFound = errors.New("expenditure recurrence pattern not found")
+	ErrRecurrencePatternInactive            = errors.New("recurrence pattern is inactive")
+	ErrRecurrencePatternFinished            = errors.New("recurrence pattern has no occurrences left")
+	ErrInvalidRecurrenceFrequency           = errors.New("invalid recurrence frequency")
+	ErrInvalidRecurrenceInterval            = errors.New("recurrence interval must be at least 1")
+	ErrInvalidRecurrenceAmount              = errors.New("recurrence amount must be greater than zero")
+	ErrInvalidRecurrenceAmountType          = errors.New("invalid recurrence amount type")
+	ErrInvalidRecurrenceEndDate             = errors.New("recurrence end date must not be before its start date")
+	ErrInvalidUpcomingBillsRange            = errors.New("upcoming bills range end must not be before its start")
+)
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceFrequencyDaily   RecurrenceFrequency = "daily"
+	RecurrenceFrequencyWeekly  RecurrenceFrequency = "weekly"
+	RecurrenceFrequencyMonthly RecurrenceFrequency = "monthly"
+	RecurrenceFrequencyYearly  RecurrenceFrequency = "yearly"
+)
+
+const daysPerWeek = 7
+
+// IsValid checks if the recurrence frequency is valid
+func (f RecurrenceFrequency) IsValid() bool {
+	switch f {
+	case RecurrenceFrequencyDaily, RecurrenceFrequencyWeekly, RecurrenceFrequencyMonthly, RecurrenceFrequencyYearly:
+		return true
+	}
+
+	return false
+}
+
+// Next returns the occurrence that follows date. Monthly and yearly occurrences keep the
+// anchor day of month and are clamped to the last day of shorter months (31st -> 28th/30th).
+func (f RecurrenceFrequency) Next(
+	date time.Time,
+	interval int,
+	anchorDay int,
+) time.Time {
+	switch f {
+	case RecurrenceFrequencyDaily:
+		return date.AddDate(
+			0,
+			0,
+			interval,
+		)
+	case RecurrenceFrequencyWeekly:
+		return date.AddDate(
+			0,
+			0,
+			interval*daysPerWeek,
+		)
+	case RecurrenceFrequencyMonthly:
+		return addMonthsClamped(
+			date,
+			interval,
+			anchorDay,
+		)
+	case RecurrenceFrequencyYearly:
+		return addMonthsClamped(
+			date,
+			interval*12, //nolint:mnd // months per year
+			anchorDay,
+		)
+	}
+
+	return date
+}
+
+func addMonthsClamped(
+	date time.Time,
+	months int,
+	anchorDay int,
+) time.Time {
+	firstOfMonth := time.Date(
+		date.Year(),
+		date.Month()+time.Month(months),
+		1,
+		date.Hour(),
+		date.Minute(),
+		date.Second(),
+		date.Nanosecond(),
+		date.Location(),
+	)
+	lastDay := firstOfMonth.AddDate(
+		0,
+		1,
+		-1,
+	).Day()
+	day := anchorDay
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return firstOfMonth.AddDate(
+		0,
+		0,
+		day-1,
+	)
+}
+
+// RecurrenceAmountType tells if the amount of a recurring bill is known (fixed)
+// or only an estimation to be confirmed when the bill is posted (e.g. utilities)
+type RecurrenceAmountType string
+
+const (
+	RecurrenceAmountTypeFixed     RecurrenceAmountType = "fixed"
+	RecurrenceAmountTypeEstimated RecurrenceAmountType = "estimated"
+)
+
+// IsValid checks if the recurrence amount type is valid
+func (t RecurrenceAmountType) IsValid() bool {
+	return t == RecurrenceAmountTypeFixed || t == RecurrenceAmountTypeEstimated
+}
+
+type ExpenditureRecurrencePattern struct {
+	ID          string               `json:"id"`
+	CategoryID  string               `json:"category_id"`
+	AccountID   string               `json:"account_id"`
+	Amount      float32              `json:"amount"`
+	AmountType  RecurrenceAmountType `json:"amount_type"`
+	Description string               `json:"description"`
+	Frequency   RecurrenceFrequency  `json:"frequency"`
+	Interval    int                  `json:"interval"`
+	StartDate   time.Time            `json:"start_date"`
+	EndDate     *time.Time           `json:"end_date,omitempty"`
+	NextDueDate time.Time            `json:"next_due_date"`
+	// AutoPost generates the expenditures automatically when due, otherwise they wait for confirmation
+	AutoPost  bool      `json:"auto_post"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Validate checks the pattern business rules
+func (p *ExpenditureRecurrencePattern) Validate() error {
+	if !p.Frequency.IsValid() {
+		return ErrInvalidRecurrenceFrequency
+	}
+	if p.Interval < 1 {
+		return ErrInvalidRecurrenceInterval
+	}
+	if p.Amount <= 0 {
+		return ErrInvalidRecurrenceAmount
+	}
+	if !p.AmountType.IsValid() {
+		return ErrInvalidRecurrenceAmountType
+	}
+	if p.EndDate != nil && p.EndDate.Before(p.StartDate) {
+		return ErrInvalidRecurrenceEndDate
+	}
+
+	return nil
+}
+
+// IsFinished returns true when the next occurrence falls after the end date
+func (p *ExpenditureRecurrencePattern) IsFinished() bool {
+	return p.EndDate != nil && p.NextDueDate.After(*p.EndDate)
+}
+
+// AdvanceNextDueDate moves the pattern to its following occurrence
+func (p *ExpenditureRecurrencePattern) AdvanceNextDueDate() {
+	p.NextDueDate = p.Frequency.Next(
+		p.NextDueDate,
+		p.Interval,
+		p.StartDate.Day(),
+	)
+}
+
+// Occurrences returns the due dates from the next due date up to and including until
+func (p *ExpenditureRecurrencePattern) Occurrences(until time.Time) []time.Time {
+	var occurrences []time.Time
+	date := p.NextDueDate
+	for !date.After(until) && (p.EndDate == nil || !date.After(*p.EndDate)) {
+		occurrences = append(
+			occurrences,
+			date,
+		)
+		date = p.Frequency.Next(
+			date,
+			p.Interval,
+			p.StartDate.Day(),
+		)
+	}
+
+	return occurrences
+}
+
+// NewExpenditure builds the expenditure of the next occurrence, amount overrides the
+// pattern amount when the actual bill differs from the estimation
+func (p *ExpenditureRecurrencePattern) NewExpenditure(
+	currency string,
+	amount *float32,
+) Expenditure {
+	expenditureAmount := p.Amount
+	if amount != nil {
+		expenditureAmount = *amount
+	}
+	patternID := p.ID
+
+	return Expenditure{
+		Category: &Category{ID: p.CategoryID},
+		Declared: false,
+		Planned:  true,
+		Transaction: &Transaction{
+			AccountID:       p.AccountID,
+			Amount:          expenditureAmount,
+			Currency:        currency,
+			TransactionDate: p.NextDueDate,
+			Description:     p.Description,
+			TransactionType: TransactionTypeExpenditure,
+		},
+		Date:                p.NextDueDate,
+		RecurrencePatternID: &patternID,
+	}
+}
+
+type ExpenditureRecurrencePatternListParams struct {
+	Active    *bool      `json:"active,omitempty"`
+	AccountID *string    `json:"account_id,omitempty"`
+	AutoPost  *bool      `json:"auto_post,omitempty"`
+	DueBefore *time.Time `json:"due_before,omitempty"`
+}
+
+// UpcomingBill is a future occurrence of an expenditure recurrence pattern
+type UpcomingBill struct {
+	PatternID   string               `json:"pattern_id"`
+	CategoryID  string               `json:"category_id"`
+	AccountID   string               `json:"account_id"`
+	Description string               `json:"description"`
+	Amount      float32              `json:"amount"`
+	AmountType  RecurrenceAmountType `json:"amount_type"`
+	DueDate     time.Time            `json:"due_date"`
+	AutoPost    bool                 `json:"auto_post"`
+}
+
+// UpcomingBills expands the patterns into their occurrences until the given date, sorted by due date
+func UpcomingBills(
+	patterns []ExpenditureRecurrencePattern,
+	from time.Time,
+	until time.Time,
+) []UpcomingBill {
+	bills := make(
+		[]UpcomingBill,
+		0,
+	)
+	for _, pattern := range patterns {
+		for _, dueDate := range pattern.Occurrences(until) {
+			if dueDate.Before(from) {
+				continue
+			}
+			bills = append(
+				bills,
+				UpcomingBill{
+					PatternID:   pattern.ID,
+					CategoryID:  pattern.CategoryID,
+					AccountID:   pattern.AccountID,
+					Description: pattern.Description,
+					Amount:      pattern.Amount,
+					AmountType:  pattern.AmountType,
+					DueDate:     dueDate,
+					AutoPost:    pattern.AutoPost,
+				},
+			)
+		}
+	}
+
+	sort.SliceStable(
+		bills,
+		func(i, j int) bool {
+			return bills[i].DueDate.Before(bills[j].DueDate)
+		},
+	)
+
+	return bills
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrHouseholdNotFound  = errors.New("household not found")
+	ErrHouseholdRequired  = errors.New("household is required")
+	ErrHouseholdNameEmpty = errors.New("household name cannot be empty")
+	ErrHouseholdForbidden = errors.New("household is not the user's household")
+)
+
+// DefaultHouseholdID is the household created with the schema, which owns the data recorded before
+// households existed
+const DefaultHouseholdID = "1"
+
+// Household is the family whose members, accounts, categories, tags and everything recorded under them
+// are kept apart from other households on the same instance
+type Household struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (h Household) Validate() error {
+	if h.Name == "" {
+		return ErrHouseholdNameEmpty
+	}
+
+	return nil
+}
+
+type householdContextKey struct{}
+
+// WithHouseholdID returns a context scoped to the household, every repository reads and writes the
+// data of the household in its context only
+func WithHouseholdID(
+	ctx context.Context,
+	householdID string,
+) context.Context {
+	return context.WithValue(
+		ctx,
+		householdContextKey{},
+		householdID,
+	)
+}
+
+// HouseholdIDFromContext returns the household the context is scoped to
+func HouseholdIDFromContext(ctx context.Context) (
+	string,
+	bool,
+) {
+	householdID, ok := ctx.Value(householdContextKey{}).(string)
+
+	return householdID, ok && householdID != ""
+}
@@ -6,8 +6,9 @@ type HouseholdMemberList struct {
 }
 
 type HouseholdMemberListParams struct {
-	Active *bool   `json:"active,omitempty"`
-	Role   *string `json:"role,omitempty"`
+	Active *bool       `json:"active,omitempty"`
+	Role   *string     `json:"role,omitempty"`
+	Sort   []SortOrder `json:"sort,omitempty"`
 }
 
 // IsEmpty returns true if the household member list is empty
@@ -0,0 +1,187 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Installment plan domain errors
+var (
+	ErrInstallmentPlanNotFound       = errors.New("installment plan not found")
+	ErrInstallmentPlanPaidOff        = errors.New("installment plan has no unpaid installments")
+	ErrInstallmentPlanChanged        = errors.New("installment plan installments changed while paying it off")
+	ErrInvalidInstallmentTotalAmount = errors.New("installment plan total amount must be greater than zero")
+	ErrInvalidInstallmentCount       = fmt.Errorf("installment plan must have between 1 and %d installments", MaxInstallments)
+	ErrInvalidInstallmentInterest    = errors.New("installment plan interest rate must not be negative")
+)
+
+// MaxInstallments caps the installments of a single plan
+const MaxInstallments = 120
+
+// InstallmentPlan splits a purchase into monthly charges, each one recorded as an expenditure
+// that stays pending until its charge date
+type InstallmentPlan struct {
+	ID          string  `json:"id"`
+	AccountID   string  `json:"account_id"`
+	CategoryID  string  `json:"category_id"`
+	Description string  `json:"description"`
+	TotalAmount float32 `json:"total_amount"`
+	// InterestRate is the percentage added once to the total amount to finance it
+	InterestRate    float32             `json:"interest_rate"`
+	Installments    int                 `json:"installments"`
+	FirstChargeDate time.Time           `json:"first_charge_date"`
+	CreatedAt       time.Time           `json:"created_at"`
+	Charges         []InstallmentCharge `json:"charges,omitempty"`
+}
+
+// InstallmentCharge is the expenditure charging one installment of a plan
+type InstallmentCharge struct {
+	Number        int               `json:"number"`
+	ExpenditureID string            `json:"expenditure_id"`
+	TransactionID string            `json:"transaction_id"`
+	Amount        float32           `json:"amount"`
+	Currency      string            `json:"currency"`
+	ChargeDate    time.Time         `json:"charge_date"`
+	Status        TransactionStatus `json:"status"`
+}
+
+// Validate checks the plan business rules
+func (p *InstallmentPlan) Validate() error {
+	if p.TotalAmount <= 0 {
+		return ErrInvalidInstallmentTotalAmount
+	}
+	if p.Installments < 1 || p.Installments > MaxInstallments {
+		return ErrInvalidInstallmentCount
+	}
+	if p.InterestRate < 0 {
+		return ErrInvalidInstallmentInterest
+	}
+
+	return nil
+}
+
+// FinancedAmount is the total amount plus its interest, rounded to cents
+func (p *InstallmentPlan) FinancedAmount() float32 {
+	return float32(p.financedCents()) / 100
+}
+
+func (p *InstallmentPlan) financedCents() int64 {
+	return int64(math.Round(float64(p.TotalAmount) * (100 + float64(p.InterestRate))))
+}
+
+// InstallmentAmounts splits the financed amount in equal installments, the last one absorbs the
+// rounding so they always add up to the financed amount
+func (p *InstallmentPlan) InstallmentAmounts() []float32 {
+	financed := p.financedCents()
+	installment := financed / int64(p.Installments)
+	amounts := make(
+		[]float32,
+		p.Installments,
+	)
+	for i := range amounts {
+		amounts[i] = float32(installment) / 100
+	}
+	amounts[p.Installments-1] = float32(financed-installment*int64(p.Installments-1)) / 100
+
+	return amounts
+}
+
+// DueDate returns the charge date of the installment number, counted from 1. Installments are
+// monthly and keep the day of month of the first charge
+func (p *InstallmentPlan) DueDate(number int) time.Time {
+	return addMonthsClamped(
+		p.FirstChargeDate,
+		number-1,
+		p.FirstChargeDate.Day(),
+	)
+}
+
+// NewExpenditures builds the expenditure charging each installment in the account currency
+func (p *InstallmentPlan) NewExpenditures(currency string) []Expenditure {
+	planID := p.ID
+	expenditures := make(
+		[]Expenditure,
+		0,
+		p.Installments,
+	)
+	for i, amount := range p.InstallmentAmounts() {
+		number := i + 1
+		dueDate := p.DueDate(number)
+		expenditures = append(
+			expenditures,
+			Expenditure{
+				Category: &Category{ID: p.CategoryID},
+				Planned:  true,
+				Transaction: &Transaction{
+					AccountID:       p.AccountID,
+					Amount:          amount,
+					Currency:        currency,
+					TransactionDate: dueDate,
+					Description: fmt.Sprintf(
+						"%s (%d/%d)",
+						p.Description,
+						number,
+						p.Installments,
+					),
+					TransactionType: TransactionTypeExpenditure,
+				},
+				Date:              dueDate,
+				InstallmentPlanID: &planID,
+				InstallmentNumber: &number,
+			},
+		)
+	}
+
+	return expenditures
+}
+
+// IsUnpaid tells whether the installment is still owed, either not due yet or failed to be charged
+func (c InstallmentCharge) IsUnpaid() bool {
+	return c.Status == TransactionStatusPending || c.Status == TransactionStatusFailed
+}
+
+// UnpaidCharges returns the installments not charged yet, failed ones included, in charge order
+func (p *InstallmentPlan) UnpaidCharges() []InstallmentCharge {
+	var unpaid []InstallmentCharge
+	for _, charge := range p.Charges {
+		if charge.IsUnpaid() {
+			unpaid = append(
+				unpaid,
+				charge,
+			)
+		}
+	}
+
+	return unpaid
+}
+
+// RemainingAmount adds up the installments not charged yet, failed ones included
+func (p *InstallmentPlan) RemainingAmount() float32 {
+	var remaining float32
+	for _, charge := range p.UnpaidCharges() {
+		remaining += charge.Amount
+	}
+
+	return float32(math.Round(float64(remaining)*100) / 100)
+}
+
+type InstallmentPlanListParams struct {
+	AccountID *string `json:"account_id,omitempty"`
+}
+
+type InstallmentObligationParams struct {
+	AccountID         *string `json:"account_id,omitempty"`
+	HouseholdMemberID *string `json:"household_member_id,omitempty"`
+}
+
+// InstallmentObligation is what is still owed on the installment plans of an account
+type InstallmentObligation struct {
+	AccountID           string  `json:"account_id"`
+	HouseholdMemberID   string  `json:"household_member_id"`
+	Currency            string  `json:"currency"`
+	RemainingAmount     float32 `json:"remaining_amount"`
+	PendingInstallments int     `json:"pending_installments"`
+	Plans               int     `json:"plans"`
+}
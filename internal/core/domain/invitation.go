@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvitationNotFound   = errors.New("invitation not found")
+	ErrInvitationExpired    = errors.New("invitation has expired")
+	ErrInvitationAccepted   = errors.New("invitation has already been accepted")
+	ErrInvitationEmailEmpty = errors.New("invitation email cannot be empty")
+	ErrInvitationForOther   = errors.New("invitation was sent to another email")
+)
+
+// Invitation asks someone to join a household. It is sent by email with a one-time token, accepting it
+// creates the invitee's user, or takes the one the invitee registered on their own, and links it to the
+// invited member, or to a new member when none was given.
+type Invitation struct {
+	ID          string     `json:"id"`
+	HouseholdID string     `json:"household_id"`
+	Email       string     `json:"email"`
+	MemberID    *string    `json:"member_id,omitempty"`
+	InvitedBy   string     `json:"invited_by"`
+	ExpiresAt   time.Time  `json:"expires_at"`
+	AcceptedAt  *time.Time `json:"accepted_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+func (i Invitation) Validate() error {
+	if i.Email == "" {
+		return ErrInvitationEmailEmpty
+	}
+
+	return nil
+}
+
+// Usable returns why the invitation cannot be accepted at the given time, nil when it can
+func (i Invitation) Usable(now time.Time) error {
+	if i.AcceptedAt != nil {
+		return ErrInvitationAccepted
+	}
+	if !now.Before(i.ExpiresAt) {
+		return ErrInvitationExpired
+	}
+
+	return nil
+}
+
+// InvitationAcceptance is what the invitee gives to accept an invitation. The names are used for the
+// new member when the invitation does not name an existing one.
+type InvitationAcceptance struct {
+	Token     string
+	Password  string
+	FirstName string
+	LastName  string
+}
+
+// Mail is an email sent by the application
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
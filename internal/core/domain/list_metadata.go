@@ -1,9 +1,10 @@
 package domain
 
 type ListMetadata struct {
-	Total  int `json:"total"`
-	Limit  int `json:"limit"`
-	Offset int `json:"offset"`
+	Total  int    `json:"total"`
+	Limit  int    `json:"limit"`
+	Offset int    `json:"offset"`
+	Sort   string `json:"sort,omitempty"`
 }
 
 // NewListMetadata creates a new ListMetadata instance
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const descendingSortPrefix = "-"
+
+var (
+	ErrInvalidSortKey   = errors.New("invalid sort key")
+	ErrDuplicateSortKey = errors.New("duplicate sort key")
+)
+
+// SortOrder is a single key of a list sort expression
+type SortOrder struct {
+	Key        string `json:"key"`
+	Descending bool   `json:"descending"`
+}
+
+// ParseSortOrders parses a comma-separated sort expression such as "-date,amount".
+// Keys are only checked for shape here; each repository validates them against its own whitelist.
+func ParseSortOrders(expression *string) ([]SortOrder, error) {
+	if expression == nil || strings.TrimSpace(*expression) == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(
+		*expression,
+		",",
+	)
+	orders := make(
+		[]SortOrder,
+		0,
+		len(parts),
+	)
+	seen := make(map[string]bool)
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		descending := strings.HasPrefix(
+			part,
+			descendingSortPrefix,
+		)
+		key := strings.TrimPrefix(
+			strings.TrimPrefix(
+				part,
+				descendingSortPrefix,
+			),
+			"+",
+		)
+
+		if key == "" {
+			return nil, fmt.Errorf(
+				"%w: empty key in %q",
+				ErrInvalidSortKey,
+				*expression,
+			)
+		}
+
+		if seen[key] {
+			return nil, fmt.Errorf(
+				"%w: %s",
+				ErrDuplicateSortKey,
+				key,
+			)
+		}
+
+		seen[key] = true
+		orders = append(
+			orders,
+			SortOrder{
+				Key:        key,
+				Descending: descending,
+			},
+		)
+	}
+
+	return orders, nil
+}
+
+// FormatSortOrders renders sort orders back into the API sort expression
+func FormatSortOrders(orders []SortOrder) string {
+	parts := make(
+		[]string,
+		0,
+		len(orders),
+	)
+
+	for _, order := range orders {
+		if order.Descending {
+			parts = append(
+				parts,
+				descendingSortPrefix+order.Key,
+			)
+
+			continue
+		}
+
+		parts = append(
+			parts,
+			order.Key,
+		)
+	}
+
+	return strings.Join(
+		parts,
+		",",
+	)
+}
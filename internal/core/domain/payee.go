@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Payee domain errors
+var (
+	ErrPayeeNotFound               = errors.New("payee not found")
+	ErrPayeeNameEmpty              = errors.New("payee name cannot be empty")
+	ErrPayeeAlreadyExists          = errors.New("payee with this name or alias already exists")
+	ErrExpenditureCategoryRequired = errors.New("expenditure category is required when the payee has no default category")
+)
+
+// Payee is the merchant or counterpart of a transaction. Aliases are the other spellings its
+// transactions show up with ("LIDL 123", "lidl"), so they can be matched back to the payee.
+type Payee struct {
+	ID                string    `json:"id"`
+	Name              string    `json:"name"`
+	Aliases           []string  `json:"aliases"`
+	DefaultCategoryID *string   `json:"default_category_id,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+type PayeeListParams struct {
+	// Name matches payees whose name or one of its aliases contains it
+	Name *string `json:"name,omitempty"`
+}
+
+// Normalize trims the name and aliases, and drops empty, repeated or name-equal aliases
+func (p *Payee) Normalize() {
+	p.Name = strings.TrimSpace(p.Name)
+
+	seen := map[string]bool{
+		NormalizePayeeAlias(p.Name): true,
+	}
+	aliases := make(
+		[]string,
+		0,
+		len(p.Aliases),
+	)
+	for _, alias := range p.Aliases {
+		alias = strings.TrimSpace(alias)
+		key := NormalizePayeeAlias(alias)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		aliases = append(
+			aliases,
+			alias,
+		)
+	}
+	p.Aliases = aliases
+}
+
+// Validate checks the payee fields, it expects a normalized payee
+func (p *Payee) Validate() error {
+	if p.Name == "" {
+		return ErrPayeeNameEmpty
+	}
+
+	return nil
+}
+
+// NormalizePayeeAlias returns the form names and aliases are compared with, case and surrounding
+// spaces are ignored
+func NormalizePayeeAlias(alias string) string {
+	return strings.ToLower(strings.TrimSpace(alias))
+}
@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidReportRange = errors.New("report end date must not be before its start date")
+
+type PayeeSpendingParams struct {
+	// From and To are the first and last days of the report, both included
+	From        time.Time   `json:"from"`
+	To          time.Time   `json:"to"`
+	Currency    string      `json:"currency"`
+	AccountID   *string     `json:"account_id,omitempty"`
+	AmountBasis AmountBasis `json:"amount_basis"`
+}
+
+// Validate checks the report parameters
+func (p *PayeeSpendingParams) Validate() error {
+	if p.Currency == "" {
+		return ErrInvalidReportCurrency
+	}
+	if p.To.Before(p.From) {
+		return ErrInvalidReportRange
+	}
+	if !p.AmountBasis.IsValid() {
+		return ErrInvalidAmountBasis
+	}
+
+	return nil
+}
+
+// Range returns the [from, to) date range covered by the report
+func (p *PayeeSpendingParams) Range() (
+	from time.Time,
+	to time.Time,
+) {
+	return p.From, p.To.AddDate(
+		0,
+		0,
+		1,
+	)
+}
+
+// PayeeSpending is the amount spent at a payee, PayeeID is nil for expenditures without payee
+type PayeeSpending struct {
+	PayeeID   *string `json:"payee_id,omitempty"`
+	PayeeName string  `json:"payee_name"`
+	Total     float64 `json:"total"`
+	Count     int     `json:"count"`
+}
+
+type PayeeSpendingReport struct {
+	Currency string    `json:"currency"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	// Payees go from the biggest spending to the smallest
+	Payees     []PayeeSpending `json:"payees"`
+	Unassigned PayeeSpending   `json:"unassigned"`
+	Total      float64         `json:"total"`
+}
+
+// NewPayeeSpendingReport splits the spending without payee from the per payee spending. The
+// entries are expected ordered by total, biggest first.
+func NewPayeeSpendingReport(
+	params PayeeSpendingParams,
+	entries []PayeeSpending,
+) *PayeeSpendingReport {
+	report := &PayeeSpendingReport{
+		Currency: params.Currency,
+		From:     params.From,
+		To:       params.To,
+		Payees: make(
+			[]PayeeSpending,
+			0,
+			len(entries),
+		),
+	}
+
+	for _, entry := range entries {
+		report.Total += entry.Total
+		if entry.PayeeID == nil {
+			report.Unassigned.Total += entry.Total
+			report.Unassigned.Count += entry.Count
+
+			continue
+		}
+		report.Payees = append(
+			report.Payees,
+			entry,
+		)
+	}
+
+	return report
+}
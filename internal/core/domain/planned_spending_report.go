@@ -0,0 +1,288 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+var ErrInvalidReportCurrency = errors.New("report currency is required")
+
+// unplannedTrendTolerance is the unplanned ratio change under which the trend is considered stable
+const unplannedTrendTolerance = 0.05
+
+type SpendingTrend string
+
+const (
+	SpendingTrendIncreasing SpendingTrend = "increasing"
+	SpendingTrendDecreasing SpendingTrend = "decreasing"
+	SpendingTrendStable     SpendingTrend = "stable"
+)
+
+type PlannedSpendingParams struct {
+	Period ReportPeriod `json:"period"`
+	// Periods is the number of periods in the trend, the last one contains EndDate
+	Periods       int         `json:"periods"`
+	EndDate       time.Time   `json:"end_date"`
+	Currency      string      `json:"currency"`
+	AccountID     *string     `json:"account_id,omitempty"`
+	TopCategories int         `json:"top_categories"`
+	AmountBasis   AmountBasis `json:"amount_basis"`
+	// RollUp reports the spending of subcategories under their top level category
+	RollUp bool `json:"roll_up"`
+}
+
+// Validate checks the report parameters
+func (p *PlannedSpendingParams) Validate() error {
+	if !p.Period.IsValid() {
+		return ErrInvalidReportPeriod
+	}
+	if p.Periods < 1 || p.Periods > MaxReportPeriods {
+		return ErrInvalidReportPeriods
+	}
+	if p.Currency == "" {
+		return ErrInvalidReportCurrency
+	}
+	if !p.AmountBasis.IsValid() {
+		return ErrInvalidAmountBasis
+	}
+
+	return nil
+}
+
+// Range returns the [from, to) date range covered by the report
+func (p *PlannedSpendingParams) Range() (
+	from time.Time,
+	to time.Time,
+) {
+	last := p.Period.Start(p.EndDate)
+
+	return p.Period.Shift(
+			last,
+			1-p.Periods,
+		), p.Period.Shift(
+			last,
+			1,
+		)
+}
+
+// PlannedSpendingEntry is the spent amount of a category on a day, split by planned status
+type PlannedSpendingEntry struct {
+	Date         time.Time `json:"date"`
+	CategoryID   string    `json:"category_id"`
+	CategoryName string    `json:"category_name"`
+	Planned      bool      `json:"planned"`
+	Amount       float64   `json:"amount"`
+}
+
+type PlannedSpendingTotals struct {
+	Planned   float64 `json:"planned"`
+	Unplanned float64 `json:"unplanned"`
+	// UnplannedRatio is the share of the spending that was not planned, from 0 to 1
+	UnplannedRatio float64 `json:"unplanned_ratio"`
+}
+
+func (t *PlannedSpendingTotals) add(
+	amount float64,
+	planned bool,
+) {
+	if planned {
+		t.Planned += amount
+	} else {
+		t.Unplanned += amount
+	}
+
+	total := t.Planned + t.Unplanned
+	t.UnplannedRatio = 0
+	if total > 0 {
+		t.UnplannedRatio = t.Unplanned / total
+	}
+}
+
+type CategoryPlannedSpending struct {
+	CategoryID   string `json:"category_id"`
+	CategoryName string `json:"category_name"`
+	PlannedSpendingTotals
+}
+
+type PlannedSpendingPeriod struct {
+	Start      time.Time                 `json:"start"`
+	End        time.Time                 `json:"end"`
+	Totals     PlannedSpendingTotals     `json:"totals"`
+	Categories []CategoryPlannedSpending `json:"categories"`
+}
+
+type PlannedSpendingReport struct {
+	Period   ReportPeriod `json:"period"`
+	Currency string       `json:"currency"`
+	// Periods go from the oldest to the one containing the end date
+	Periods                []PlannedSpendingPeriod   `json:"periods"`
+	Totals                 PlannedSpendingTotals     `json:"totals"`
+	TopUnplannedCategories []CategoryPlannedSpending `json:"top_unplanned_categories"`
+	// UnplannedTrend compares the unplanned ratio of the last period with the average of the previous ones
+	UnplannedTrend SpendingTrend `json:"unplanned_trend"`
+}
+
+// NewPlannedSpendingReport groups the entries by period and category
+func NewPlannedSpendingReport(
+	params PlannedSpendingParams,
+	entries []PlannedSpendingEntry,
+) *PlannedSpendingReport {
+	from, _ := params.Range()
+	report := &PlannedSpendingReport{
+		Period:   params.Period,
+		Currency: params.Currency,
+		Periods: make(
+			[]PlannedSpendingPeriod,
+			params.Periods,
+		),
+	}
+
+	periodCategories := make(
+		[]map[string]*CategoryPlannedSpending,
+		params.Periods,
+	)
+	for i := range report.Periods {
+		start := params.Period.Shift(
+			from,
+			i,
+		)
+		report.Periods[i] = PlannedSpendingPeriod{
+			Start: start,
+			End: params.Period.Shift(
+				start,
+				1,
+			).AddDate(
+				0,
+				0,
+				-1,
+			),
+		}
+		periodCategories[i] = make(map[string]*CategoryPlannedSpending)
+	}
+
+	overallCategories := make(map[string]*CategoryPlannedSpending)
+	for _, entry := range entries {
+		index := report.periodIndex(entry.Date)
+		if index < 0 {
+			continue
+		}
+
+		report.Periods[index].Totals.add(
+			entry.Amount,
+			entry.Planned,
+		)
+		report.Totals.add(
+			entry.Amount,
+			entry.Planned,
+		)
+		addCategorySpending(
+			periodCategories[index],
+			entry,
+		)
+		addCategorySpending(
+			overallCategories,
+			entry,
+		)
+	}
+
+	for i := range report.Periods {
+		report.Periods[i].Categories = sortedCategorySpending(periodCategories[i])
+	}
+
+	report.TopUnplannedCategories = make(
+		[]CategoryPlannedSpending,
+		0,
+		params.TopCategories,
+	)
+	for _, category := range sortedCategorySpending(overallCategories) {
+		if len(report.TopUnplannedCategories) == params.TopCategories || category.Unplanned <= 0 {
+			break
+		}
+		report.TopUnplannedCategories = append(
+			report.TopUnplannedCategories,
+			category,
+		)
+	}
+
+	report.UnplannedTrend = report.unplannedTrend()
+
+	return report
+}
+
+func (r *PlannedSpendingReport) periodIndex(date time.Time) int {
+	for i := range r.Periods {
+		if !date.Before(r.Periods[i].Start) && !date.After(r.Periods[i].End) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (r *PlannedSpendingReport) unplannedTrend() SpendingTrend {
+	if len(r.Periods) < 2 { //nolint:mnd // a trend needs at least two periods
+		return SpendingTrendStable
+	}
+
+	var previous float64
+	for _, period := range r.Periods[:len(r.Periods)-1] {
+		previous += period.Totals.UnplannedRatio
+	}
+	previous /= float64(len(r.Periods) - 1)
+
+	change := r.Periods[len(r.Periods)-1].Totals.UnplannedRatio - previous
+	switch {
+	case change > unplannedTrendTolerance:
+		return SpendingTrendIncreasing
+	case change < -unplannedTrendTolerance:
+		return SpendingTrendDecreasing
+	}
+
+	return SpendingTrendStable
+}
+
+func addCategorySpending(
+	categories map[string]*CategoryPlannedSpending,
+	entry PlannedSpendingEntry,
+) {
+	category, ok := categories[entry.CategoryID]
+	if !ok {
+		category = &CategoryPlannedSpending{
+			CategoryID:   entry.CategoryID,
+			CategoryName: entry.CategoryName,
+		}
+		categories[entry.CategoryID] = category
+	}
+	category.add(
+		entry.Amount,
+		entry.Planned,
+	)
+}
+
+// sortedCategorySpending orders the categories by unplanned spending, biggest first
+func sortedCategorySpending(categories map[string]*CategoryPlannedSpending) []CategoryPlannedSpending {
+	sorted := make(
+		[]CategoryPlannedSpending,
+		0,
+		len(categories),
+	)
+	for _, category := range categories {
+		sorted = append(
+			sorted,
+			*category,
+		)
+	}
+	sort.Slice(
+		sorted,
+		func(i, j int) bool {
+			if sorted[i].Unplanned != sorted[j].Unplanned {
+				return sorted[i].Unplanned > sorted[j].Unplanned
+			}
+
+			return sorted[i].CategoryName < sorted[j].CategoryName
+		},
+	)
+
+	return sorted
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidRefundAmount      = errors.New("refund amount must be greater than zero")
+	ErrRefundExceedsExpenditure = errors.New("refund amount exceeds what is left to refund of the expenditure")
+	ErrExpenditureNotRefundable = errors.New("only completed expenditures can be refunded")
+)
+
+// refundAmountTolerance absorbs the rounding of amounts stored with cents
+const refundAmountTolerance = 0.005
+
+// Refund credits an account with part or all of an expenditure. Its transaction is in the currency
+// the expenditure was charged in, converted when the refunded account uses another one.
+type Refund struct {
+	ID            string       `json:"id"`
+	ExpenditureID string       `json:"expenditure_id"`
+	Transaction   *Transaction `json:"transaction"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+// RefundRequest holds the optional settings of a refund, each of them defaults to the expenditure
+type RefundRequest struct {
+	ExpenditureID string
+	// Amount defaults to what is left to refund
+	Amount *float32
+	// AccountID defaults to the account the expenditure was charged to
+	AccountID   *string
+	Date        *time.Time
+	Description *string
+}
+
+// RefundList holds the refunds of an expenditure with what was refunded and is left to refund, in
+// the currency the expenditure was charged in
+type RefundList struct {
+	Refunds   []Refund `json:"refunds"`
+	Refunded  float32  `json:"refunded"`
+	Remaining float32  `json:"remaining"`
+}
+
+// Amount returns the refunded amount in the currency the expenditure was charged in
+func (r Refund) Amount() float32 {
+	if r.Transaction.OriginalAmount != nil {
+		return *r.Transaction.OriginalAmount
+	}
+
+	return r.Transaction.Amount
+}
+
+// Counts tells whether the refund is part of what was refunded, failed and canceled refunds are not
+func (r Refund) Counts() bool {
+	return r.Transaction.Status == nil ||
+		(*r.Transaction.Status != TransactionStatusFailed && *r.Transaction.Status != TransactionStatusCancelled)
+}
+
+// FitsIn checks the refund fits in what is left to refund of its expenditure
+func (r Refund) FitsIn(remaining float32) error {
+	if r.Amount() > remaining+refundAmountTolerance {
+		return ErrRefundExceedsExpenditure
+	}
+
+	return nil
+}
+
+// RefundAmount returns the amount to refund, what is left when none is requested, checking it is
+// positive and fits in what is left
+func (r RefundRequest) RefundAmount(remaining float32) (
+	float32,
+	error,
+) {
+	amount := remaining
+	if r.Amount != nil {
+		amount = *r.Amount
+	}
+	if amount <= 0 {
+		if r.Amount == nil {
+			return 0, ErrRefundExceedsExpenditure
+		}
+
+		return 0, ErrInvalidRefundAmount
+	}
+	if amount > remaining+refundAmountTolerance {
+		return 0, ErrRefundExceedsExpenditure
+	}
+
+	return amount, nil
+}
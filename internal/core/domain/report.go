@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidReportPeriod  = errors.New("invalid report period")
+	ErrInvalidReportPeriods = errors.New("number of report periods must be between 1 and 36")
+	ErrInvalidAmountBasis   = errors.New("invalid amount basis")
+)
+
+// MaxReportPeriods caps the trend length so a report never scans an unbounded date range
+const MaxReportPeriods = 36
+
+const monthsPerQuarter = 3
+
+// ReportPeriod is the calendar bucket report figures are grouped by
+type ReportPeriod string
+
+const (
+	ReportPeriodMonth   ReportPeriod = "month"
+	ReportPeriodQuarter ReportPeriod = "quarter"
+	ReportPeriodYear    ReportPeriod = "year"
+)
+
+// IsValid checks if the report period is valid
+func (p ReportPeriod) IsValid() bool {
+	switch p {
+	case ReportPeriodMonth, ReportPeriodQuarter, ReportPeriodYear:
+		return true
+	}
+
+	return false
+}
+
+// Start returns the first day of the period that contains date
+func (p ReportPeriod) Start(date time.Time) time.Time {
+	month := date.Month()
+	switch p {
+	case ReportPeriodQuarter:
+		month -= (month - 1) % monthsPerQuarter
+	case ReportPeriodYear:
+		month = time.January
+	case ReportPeriodMonth:
+	}
+
+	return time.Date(
+		date.Year(),
+		month,
+		1,
+		0,
+		0,
+		0,
+		0,
+		time.UTC,
+	)
+}
+
+// Shift moves a period start by the given number of periods, backwards when negative
+func (p ReportPeriod) Shift(
+	start time.Time,
+	periods int,
+) time.Time {
+	switch p {
+	case ReportPeriodQuarter:
+		return start.AddDate(
+			0,
+			periods*monthsPerQuarter,
+			0,
+		)
+	case ReportPeriodYear:
+		return start.AddDate(
+			periods,
+			0,
+			0,
+		)
+	case ReportPeriodMonth:
+	}
+
+	return start.AddDate(
+		0,
+		periods,
+		0,
+	)
+}
+
+// AmountBasis is the amount reports use for transactions made in another currency than their account
+type AmountBasis string
+
+const (
+	// AmountBasisCharged uses the amount charged to the account, in the account currency
+	AmountBasisCharged AmountBasis = "charged"
+	// AmountBasisOriginal uses the amount in the currency the transaction was made in
+	AmountBasisOriginal AmountBasis = "original"
+)
+
+// IsValid checks if the amount basis is valid
+func (b AmountBasis) IsValid() bool {
+	switch b {
+	case AmountBasisCharged, AmountBasisOriginal:
+		return true
+	}
+
+	return false
+}
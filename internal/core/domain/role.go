@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+)
+
+var (
+	ErrForbidden      = errors.New("the user's roles do not allow this operation")
+	ErrLastAdmin      = errors.New("household must keep at least one admin")
+	ErrUserRolesEmpty = errors.New("user must have at least one role")
+)
+
+// Roles seeded with the schema
+const (
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+	RoleViewer = "viewer"
+)
+
+// Permission is what a role allows a user to do on the data of their household
+type Permission string
+
+const (
+	// PermissionRead allows viewing records, balances and reports
+	PermissionRead Permission = "read"
+	// PermissionWrite allows creating and updating records, e.g. logging expenditures
+	PermissionWrite Permission = "write"
+	// PermissionDelete allows deleting, deactivating and rolling back records
+	PermissionDelete Permission = "delete"
+	// PermissionManage allows managing the household, its invitations and the roles of its users
+	PermissionManage Permission = "manage"
+)
+
+// rolePermissions is the permission matrix of the roles
+var rolePermissions = map[string][]Permission{
+	RoleAdmin: {
+		PermissionRead,
+		PermissionWrite,
+		PermissionDelete,
+		PermissionManage,
+	},
+	RoleMember: {
+		PermissionRead,
+		PermissionWrite,
+	},
+	RoleViewer: {
+		PermissionRead,
+	},
+}
+
+type Role struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// RolePermissions returns the permissions of the role, none for an unknown role
+func RolePermissions(role string) []Permission {
+	return rolePermissions[role]
+}
+
+// ValidateRoles checks the roles to give a user
+func ValidateRoles(roles []string) error {
+	if len(roles) == 0 {
+		return ErrUserRolesEmpty
+	}
+	for _, role := range roles {
+		if _, ok := rolePermissions[role]; !ok {
+			return ErrRoleNotFound
+		}
+	}
+
+	return nil
+}
+
+// UniqueRoles returns the roles sorted, each one once
+func UniqueRoles(roles []string) []string {
+	return slices.Compact(slices.Sorted(slices.Values(roles)))
+}
+
+func (u User) HasRole(role string) bool {
+	return slices.Contains(
+		u.Roles,
+		role,
+	)
+}
+
+// Can tells whether any of the user's roles grants the permission
+func (u User) Can(permission Permission) bool {
+	for _, role := range u.Roles {
+		if slices.Contains(
+			rolePermissions[role],
+			permission,
+		) {
+			return true
+		}
+	}
+
+	return false
+}
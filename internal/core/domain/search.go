@@ -0,0 +1,217 @@
+package domain
+
+import (
+	"errors"
+	"html"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// minSearchTermLength mirrors the InnoDB full-text minimum token size
+const minSearchTermLength = 3
+
+const (
+	highlightOpenTag  = "<em>"
+	highlightCloseTag = "</em>"
+)
+
+var (
+	ErrInvalidSearchQuery = errors.New("search query must contain at least one word of three or more characters")
+	ErrInvalidSearchType  = errors.New("invalid search hit type")
+)
+
+type SearchHitType string
+
+const (
+	SearchHitTypeExpenditure SearchHitType = "expenditure"
+	SearchHitTypeIngress     SearchHitType = "ingress"
+	SearchHitTypeTransfer    SearchHitType = "transfer"
+)
+
+// IsValid returns true if the hit type is one of the searchable entities
+func (t SearchHitType) IsValid() bool {
+	switch t {
+	case SearchHitTypeExpenditure, SearchHitTypeIngress, SearchHitTypeTransfer:
+		return true
+	}
+
+	return false
+}
+
+type SearchParams struct {
+	Query     string          `json:"query"`
+	Types     []SearchHitType `json:"types,omitempty"`
+	StartDate *time.Time      `json:"start_date,omitempty"`
+	EndDate   *time.Time      `json:"end_date,omitempty"`
+	Limit     int             `json:"limit"`
+	Offset    int             `json:"offset"`
+}
+
+// IncludesType returns true if hits of the given type were requested
+func (p *SearchParams) IncludesType(hitType SearchHitType) bool {
+	if len(p.Types) == 0 {
+		return true
+	}
+
+	for _, t := range p.Types {
+		if t == hitType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SearchHighlight is a matched field of a hit, HTML escaped, with the matching words wrapped in <em> tags
+type SearchHighlight struct {
+	Field   string `json:"field"`
+	Snippet string `json:"snippet"`
+}
+
+type SearchHit struct {
+	ID           string            `json:"id"`
+	Type         SearchHitType     `json:"type"`
+	Date         time.Time         `json:"date"`
+	Amount       float32           `json:"amount"`
+	Currency     string            `json:"currency"`
+	AccountID    string            `json:"account_id"`
+	Description  *string           `json:"description,omitempty"`
+	Source       *string           `json:"source,omitempty"`
+	CategoryName *string           `json:"category_name,omitempty"`
+	TagNames     []string          `json:"tag_names,omitempty"`
+	Score        float64           `json:"score"`
+	Highlights   []SearchHighlight `json:"highlights,omitempty"`
+}
+
+type SearchResult struct {
+	Hits     []SearchHit  `json:"hits"`
+	Metadata ListMetadata `json:"metadata"`
+}
+
+// SearchTerms splits a free text query into the words the full-text index can match
+func SearchTerms(query string) []string {
+	words := strings.FieldsFunc(
+		strings.ToLower(query),
+		func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		},
+	)
+
+	terms := make(
+		[]string,
+		0,
+		len(words),
+	)
+	seen := make(map[string]bool)
+	for _, word := range words {
+		if len([]rune(word)) < minSearchTermLength || seen[word] {
+			continue
+		}
+		seen[word] = true
+		terms = append(
+			terms,
+			word,
+		)
+	}
+
+	return terms
+}
+
+// SearchHighlighter wraps the words starting with one of the search terms in <em> tags
+type SearchHighlighter struct {
+	matcher *regexp.Regexp
+}
+
+// NewSearchHighlighter compiles the matcher of the terms once, to highlight every hit of a search
+func NewSearchHighlighter(terms []string) *SearchHighlighter {
+	quoted := make(
+		[]string,
+		0,
+		len(terms),
+	)
+	for _, term := range terms {
+		quoted = append(
+			quoted,
+			regexp.QuoteMeta(term),
+		)
+	}
+
+	// \b only knows ASCII word characters and RE2 has no lookbehind, so the character before the
+	// word is matched too and the word itself is the first group
+	return &SearchHighlighter{
+		matcher: regexp.MustCompile(`(?i)(?:^|[^\p{L}\p{N}_])((?:` + strings.Join(
+			quoted,
+			"|",
+		) + `)[\p{L}\p{N}_]*)`),
+	}
+}
+
+// Highlight returns the text HTML escaped with the matching words wrapped in <em> tags, and whether
+// any word matched
+func (s *SearchHighlighter) Highlight(text string) (
+	string,
+	bool,
+) {
+	matches := s.matcher.FindAllStringSubmatchIndex(
+		text,
+		-1,
+	)
+	if len(matches) == 0 {
+		return "", false
+	}
+
+	var snippet strings.Builder
+	last := 0
+	for _, match := range matches {
+		start, end := match[2], match[3]
+		snippet.WriteString(html.EscapeString(text[last:start]))
+		snippet.WriteString(highlightOpenTag)
+		snippet.WriteString(html.EscapeString(text[start:end]))
+		snippet.WriteString(highlightCloseTag)
+		last = end
+	}
+	snippet.WriteString(html.EscapeString(text[last:]))
+
+	return snippet.String(), true
+}
+
+// Highlight fills the hit highlights with every searchable field that contains one of the terms
+func (h *SearchHit) Highlight(highlighter *SearchHighlighter) {
+	addHighlight := func(field string, value *string) {
+		if value == nil {
+			return
+		}
+		snippet, ok := highlighter.Highlight(*value)
+		if !ok {
+			return
+		}
+		h.Highlights = append(
+			h.Highlights,
+			SearchHighlight{
+				Field:   field,
+				Snippet: snippet,
+			},
+		)
+	}
+
+	addHighlight(
+		"description",
+		h.Description,
+	)
+	addHighlight(
+		"source",
+		h.Source,
+	)
+	addHighlight(
+		"category",
+		h.CategoryName,
+	)
+	for i := range h.TagNames {
+		addHighlight(
+			"tag",
+			&h.TagNames[i],
+		)
+	}
+}
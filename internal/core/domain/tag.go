@@ -4,11 +4,18 @@ import "errors"
 
 // Tag domain errors
 var (
-	ErrTagNotFound      = errors.New("tag not found")
-	ErrTagInUse         = errors.New("tag is in use and cannot be deleted")
-	ErrUnknownTagType   = errors.New("unknown tag type")
-	ErrTagAlreadyExists = errors.New("tag with this name and type already exists")
-	ErrTagNameEmpty     = errors.New("tag name cannot be empty")
+	ErrTagNotFound          = errors.New("tag not found")
+	ErrTagInUse             = errors.New("tag is in use and cannot be deleted")
+	ErrUnknownTagType       = errors.New("unknown tag type")
+	ErrTagAlreadyExists     = errors.New("tag with this name and type already exists")
+	ErrTagNameEmpty         = errors.New("tag name cannot be empty")
+	ErrTagTypeChange        = errors.New("tag type cannot be changed")
+	ErrTagTypeMismatch      = errors.New("tag type does not match the tagged record")
+	ErrTaggedRecordNotFound = errors.New("tagged record not found")
+
+	ErrTagMergeSameTag        = errors.New("a tag cannot be merged into itself")
+	ErrTagMergeTargetNotFound = errors.New("target tag not found")
+	ErrTagMergeTypeMismatch   = errors.New("tags can only be merged into a tag of the same type")
 )
 
 type Tag struct {
@@ -45,3 +52,10 @@ func (t Tag) Validate() error {
 
 	return nil
 }
+
+// TagMergeResult is the target of a merge with the number of links moved to it
+type TagMergeResult struct {
+	Target Tag `json:"target"`
+	// Moved counts the links moved to the target, links to records already tagged with the target are dropped
+	Moved int64 `json:"moved"`
+}
@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrTagReportTagsRequired   = errors.New("at least one tag is required")
+	ErrTagReportTypeMismatch   = errors.New("combined tags must all have the same type")
+	ErrTagReportUnsupportedTag = errors.New("only expenditure and ingress tags can be reported")
+	ErrInvalidTagMatch         = errors.New("invalid tag match")
+)
+
+// TagMatch tells whether a combination of tags counts the records with all of the tags or with any of them
+type TagMatch string
+
+const (
+	TagMatchAll TagMatch = "all"
+	TagMatchAny TagMatch = "any"
+)
+
+// IsValid checks if the tag match is valid
+func (m TagMatch) IsValid() bool {
+	return m == TagMatchAll || m == TagMatchAny
+}
+
+// IsReportable tells whether amounts are reported for the tags of the type, only expenditures and
+// ingresses are tagged records with an amount of their own
+func (t TagType) IsReportable() bool {
+	return t == TagTypeExpenditure || t == TagTypeIngress
+}
+
+type TagSpendingParams struct {
+	// From and To are the first and last days of the report, both included
+	From        time.Time   `json:"from"`
+	To          time.Time   `json:"to"`
+	Currency    string      `json:"currency"`
+	AccountID   *string     `json:"account_id,omitempty"`
+	AmountBasis AmountBasis `json:"amount_basis"`
+}
+
+// Validate checks the report parameters
+func (p *TagSpendingParams) Validate() error {
+	if p.Currency == "" {
+		return ErrInvalidReportCurrency
+	}
+	if p.To.Before(p.From) {
+		return ErrInvalidReportRange
+	}
+	if !p.AmountBasis.IsValid() {
+		return ErrInvalidAmountBasis
+	}
+
+	return nil
+}
+
+// Range returns the [from, to) date range covered by the report
+func (p *TagSpendingParams) Range() (
+	from time.Time,
+	to time.Time,
+) {
+	return p.From, p.To.AddDate(
+		0,
+		0,
+		1,
+	)
+}
+
+// TagSpending is the amount of the expenditures or ingresses linked to a tag, depending on its type
+type TagSpending struct {
+	TagID   string  `json:"tag_id"`
+	TagName string  `json:"tag_name"`
+	TagType TagType `json:"tag_type"`
+	Total   float64 `json:"total"`
+	Count   int     `json:"count"`
+}
+
+type TagSpendingReport struct {
+	Currency string    `json:"currency"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	// Expenditures and Ingresses go from the biggest total to the smallest. A record with several tags
+	// is counted in each of them, so the totals do not add up to the spending of the range.
+	Expenditures []TagSpending `json:"expenditures"`
+	Ingresses    []TagSpending `json:"ingresses"`
+}
+
+// NewTagSpendingReport splits the entries of expenditure tags from those of ingress tags. The entries
+// are expected ordered by total, biggest first.
+func NewTagSpendingReport(
+	params TagSpendingParams,
+	entries []TagSpending,
+) *TagSpendingReport {
+	report := &TagSpendingReport{
+		Currency: params.Currency,
+		From:     params.From,
+		To:       params.To,
+		Expenditures: make(
+			[]TagSpending,
+			0,
+		),
+		Ingresses: make(
+			[]TagSpending,
+			0,
+		),
+	}
+
+	for _, entry := range entries {
+		if entry.TagType == TagTypeIngress {
+			report.Ingresses = append(
+				report.Ingresses,
+				entry,
+			)
+
+			continue
+		}
+		report.Expenditures = append(
+			report.Expenditures,
+			entry,
+		)
+	}
+
+	return report
+}
+
+type TagCombinationParams struct {
+	TagSpendingParams
+	TagIDs []string `json:"tag_ids"`
+	Match  TagMatch `json:"match"`
+}
+
+// Validate checks the report parameters
+func (p *TagCombinationParams) Validate() error {
+	if len(p.TagIDs) == 0 {
+		return ErrTagReportTagsRequired
+	}
+	if !p.Match.IsValid() {
+		return ErrInvalidTagMatch
+	}
+
+	return p.TagSpendingParams.Validate()
+}
+
+// TagCombinationReport is the amount of the records linked to all of the tags, their intersection, or
+// to any of them, their union. Records with several of the tags are counted once.
+type TagCombinationReport struct {
+	Currency string    `json:"currency"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Tags     []Tag     `json:"tags"`
+	Match    TagMatch  `json:"match"`
+	Total    float64   `json:"total"`
+	Count    int       `json:"count"`
+}
+
+type TagCategoryParams struct {
+	TagSpendingParams
+	TagID string `json:"tag_id"`
+	// RollUp reports the amounts of subcategories under their top level category
+	RollUp bool `json:"roll_up"`
+}
+
+// TagCategorySpending is the amount of the records of a tag in one category
+type TagCategorySpending struct {
+	CategoryID   string  `json:"category_id"`
+	CategoryName string  `json:"category_name"`
+	Total        float64 `json:"total"`
+	Count        int     `json:"count"`
+}
+
+// TagCategoryReport breaks the amount of the records linked to a tag down by category
+type TagCategoryReport struct {
+	Currency string    `json:"currency"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Tag      Tag       `json:"tag"`
+	// Categories go from the biggest total to the smallest
+	Categories []TagCategorySpending `json:"categories"`
+	Total      float64               `json:"total"`
+	Count      int                   `json:"count"`
+}
+
+// NewTagCategoryReport adds up the category entries of the tag, expected ordered by total, biggest first
+func NewTagCategoryReport(
+	params TagCategoryParams,
+	tag Tag,
+	entries []TagCategorySpending,
+) *TagCategoryReport {
+	report := &TagCategoryReport{
+		Currency:   params.Currency,
+		From:       params.From,
+		To:         params.To,
+		Tag:        tag,
+		Categories: entries,
+	}
+	for _, entry := range entries {
+		report.Total += entry.Total
+		report.Count += entry.Count
+	}
+
+	return report
+}
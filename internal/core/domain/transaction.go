@@ -6,7 +6,8 @@ import (
 )
 
 var (
-	ErrTransactionNotFound = errors.New("transaction not found")
+	ErrTransactionNotFound   = errors.New("transaction not found")
+	ErrTransactionNotPending = errors.New("transaction is not pending")
 )
 
 type Transaction struct {
@@ -19,8 +20,15 @@ type Transaction struct {
 	TransactionType TransactionType    `json:"transaction_type"`
 	BalanceAfter    *float32           `json:"balance_after"`
 	Status          *TransactionStatus `json:"status"`
+	FailureReason   *string            `json:"failure_reason,omitempty"`
 	CreatedAt       time.Time          `json:"created_at"`
 	UpdatedAt       time.Time          `json:"updated_at"`
+
+	// OriginalAmount, OriginalCurrency and ExchangeRate are set when the transaction was made in a
+	// currency other than its account, Amount and Currency are then the charge in account currency
+	OriginalAmount   *float32 `json:"original_amount,omitempty"`
+	OriginalCurrency *string  `json:"original_currency,omitempty"`
+	ExchangeRate     *float32 `json:"exchange_rate,omitempty"`
 }
 type TransactionType string
 
@@ -29,6 +37,7 @@ const (
 	TransactionTypeIngress     TransactionType = "ingress"
 	TransactionTypeTransfer    TransactionType = "transfer"
 	TransactionTypeRollback    TransactionType = "rollback"
+	TransactionTypeRefund      TransactionType = "refund"
 )
 
 // String returns the string representation of the transaction type
@@ -73,6 +82,35 @@ func (s TransactionStatus) String() string {
 	return string(s)
 }
 
+// IsScheduled returns true if the transaction is dated after the given moment
+// and therefore must be kept pending until its date
+func (t *Transaction) IsScheduled(now time.Time) bool {
+	return t.TransactionDate.After(now)
+}
+
+// Schedule marks the transaction as pending without touching any account balance
+func (t *Transaction) Schedule() {
+	statusPending := TransactionStatusPending
+	t.Status = &statusPending
+	t.BalanceAfter = nil
+}
+
+// Complete marks the transaction as completed with the resulting account balance
+func (t *Transaction) Complete(balanceAfter float32) {
+	statusCompleted := TransactionStatusCompleted
+	t.Status = &statusCompleted
+	t.BalanceAfter = &balanceAfter
+	t.FailureReason = nil
+}
+
+// Fail marks the transaction as failed, keeping the reason for the user
+func (t *Transaction) Fail(reason error) {
+	statusFailed := TransactionStatusFailed
+	failureReason := reason.Error()
+	t.Status = &statusFailed
+	t.FailureReason = &failureReason
+}
+
 func RollbackTransaction(t *Transaction) *Transaction {
 	statusCompleted := TransactionStatusCompleted
 	balanceAfter := *t.BalanceAfter + t.Amount
@@ -0,0 +1,73 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+type AllocationPlanRepo interface {
+	Create(
+		ctx context.Context,
+		plan domain.AllocationPlan,
+	) (
+		string,
+		error,
+	)
+	GetByID(
+		ctx context.Context,
+		id string,
+	) (
+		*domain.AllocationPlan,
+		error,
+	)
+	List(
+		ctx context.Context,
+		params domain.AllocationPlanListParams,
+	) (
+		[]domain.AllocationPlan,
+		error,
+	)
+	// Update replaces the income basis and the allocations of the plan
+	Update(
+		ctx context.Context,
+		plan domain.AllocationPlan,
+	) error
+	Delete(
+		ctx context.Context,
+		id string,
+	) error
+	// IncomeRecurrences returns the ingress recurrence patterns into accounts in currency that did not end
+	// before month
+	IncomeRecurrences(
+		ctx context.Context,
+		currency string,
+		month time.Time,
+	) (
+		[]domain.IncomeRecurrence,
+		error,
+	)
+	// Ingresses adds up the one-off and the received ingresses in currency in [from, to), leaving
+	// Recurring empty
+	Ingresses(
+		ctx context.Context,
+		currency string,
+		from time.Time,
+		to time.Time,
+	) (
+		domain.MonthIncome,
+		error,
+	)
+	// Actuals adds up the completed expenditures per category, net of their refunds, and the
+	// contributions per savings goal in currency in [from, to)
+	Actuals(
+		ctx context.Context,
+		currency string,
+		from time.Time,
+		to time.Time,
+	) (
+		domain.MonthActuals,
+		error,
+	)
+}
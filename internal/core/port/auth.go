@@ -10,7 +10,13 @@ type AuthRepo interface {
 	CreateUser(ctx context.Context, user domain.User) (string, error)
 	GetUserByEmail(ctx context.Context, email string) (*domain.User, error)
 	GetUserByID(ctx context.Context, id string) (*domain.User, error)
+	// GetUserByMemberID returns the user linked to the household member, ErrRecordNotFound when none is
+	GetUserByMemberID(ctx context.Context, memberID string) (*domain.User, error)
 	UpdateUser(ctx context.Context, id string, user domain.User) error
+	// LinkMember binds the user to a household member, a member is linked to one user at most
+	LinkMember(ctx context.Context, userID string, memberID string) error
+	// ListUsers lists the users linked to members of the household of the context
+	ListUsers(ctx context.Context) ([]domain.User, error)
 
 	// Token management
 	CreateToken(ctx context.Context, userID string) (*domain.AuthToken, error)
@@ -0,0 +1,70 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+type BudgetRepo interface {
+	Create(
+		ctx context.Context,
+		budget domain.Budget,
+	) (
+		string,
+		error,
+	)
+	GetByID(
+		ctx context.Context,
+		id string,
+	) (
+		*domain.Budget,
+		error,
+	)
+	List(
+		ctx context.Context,
+		params domain.BudgetListParams,
+	) (
+		[]domain.Budget,
+		error,
+	)
+	Update(
+		ctx context.Context,
+		budget domain.Budget,
+	) error
+	Delete(
+		ctx context.Context,
+		id string,
+	) error
+	// DailySpent adds up per day the completed expenditures of the category and its subcategories
+	// charged in currency in [from, to), net of their refunds
+	DailySpent(
+		ctx context.Context,
+		categoryID string,
+		currency string,
+		from time.Time,
+		to time.Time,
+	) (
+		[]domain.DailySpending,
+		error,
+	)
+	PeriodAmounts(
+		ctx context.Context,
+		budgetID string,
+	) (
+		domain.BudgetPeriodAmounts,
+		error,
+	)
+	// SetPeriodAmount sets the amount of a single budget period, replacing the one set before
+	SetPeriodAmount(
+		ctx context.Context,
+		budgetID string,
+		number int,
+		amount float32,
+	) error
+	DeletePeriodAmounts(
+		ctx context.Context,
+		budgetID string,
+	) error
+}
@@ -0,0 +1,53 @@
+package port
+
+import (
+	"context"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+type CategorizationRuleRepo interface {
+	Create(
+		ctx context.Context,
+		rule domain.CategorizationRule,
+	) (
+		string,
+		error,
+	)
+	GetByID(
+		ctx context.Context,
+		id string,
+	) (
+		*domain.CategorizationRule,
+		error,
+	)
+	// List returns the rules sorted by priority
+	List(
+		ctx context.Context,
+		params domain.CategorizationRuleListParams,
+	) (
+		[]domain.CategorizationRule,
+		error,
+	)
+	Update(
+		ctx context.Context,
+		rule domain.CategorizationRule,
+	) error
+	Delete(
+		ctx context.Context,
+		id string,
+	) error
+	// Candidates returns the past expenditures chosen by the params, newest first
+	Candidates(
+		ctx context.Context,
+		params domain.RuleCandidateParams,
+	) (
+		[]domain.RuleCandidate,
+		error,
+	)
+	// Apply sets the category and adds the tags of the categorizations in one transaction
+	Apply(
+		ctx context.Context,
+		categorizations []domain.Categorization,
+	) error
+}
@@ -47,4 +47,24 @@ type CategoryRepo interface {
 		[]domain.Category,
 		error,
 	)
+	Descendants(
+		ctx context.Context,
+		id string,
+	) (
+		[]domain.Category,
+		error,
+	)
+	Deactivate(
+		ctx context.Context,
+		ids []string,
+	) error
+	Merge(
+		ctx context.Context,
+		sourceID string,
+		targetID string,
+		action domain.CategoryMergeAction,
+	) (
+		domain.CategoryReassignments,
+		error,
+	)
 }
@@ -0,0 +1,20 @@
+package port
+
+import (
+	"context"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+type CategorySuggestionRepo interface {
+	// TrainingExamples returns up to limit expenditures newer than afterID, oldest first. An empty
+	// afterID starts from the first expenditure.
+	TrainingExamples(
+		ctx context.Context,
+		afterID string,
+		limit int,
+	) (
+		[]domain.TrainingExample,
+		error,
+	)
+}
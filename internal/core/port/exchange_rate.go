@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+type ExchangeRateRepo interface {
+	// GetRate returns the latest rate from baseCurrency to targetCurrency dated on or before date,
+	// derived from the opposite rate when only that one is stored
+	GetRate(ctx context.Context, baseCurrency string, targetCurrency string, date time.Time) (*domain.ExchangeRate, error)
+}
@@ -11,4 +11,18 @@ type ExpenditureRepo interface {
 	Create(ctx context.Context, expenditure domain.Expenditure) (string, error)
 	GetByID(ctx context.Context, id string) (*domain.Expenditure, error)
 	FindExpenditures(ctx context.Context, queryParams domain.ExpenditureListParams) (*domain.ExpenditureList, error)
+	// CreateBatch stores the expenditures with their transactions and tags, and debits each account
+	// by the total of its completed transactions, in one transaction. The accounts are locked and their
+	// balance checked again for every completed transaction. An item its account no longer covers fails
+	// the whole batch with a *domain.BatchItemError in all-or-nothing mode; in best-effort mode it is
+	// skipped and its ID left empty.
+	CreateBatch(ctx context.Context, expenditures []domain.Expenditure, mode domain.BatchMode) ([]string, error)
+
+	// Refund operations
+	// CreateRefund stores the refund of the expenditure with its transaction and credits the account
+	// unless the transaction is pending, all at once. The expenditure is locked while checking the
+	// refund fits in what is left to refund, otherwise domain.ErrRefundExceedsExpenditure is returned.
+	CreateRefund(ctx context.Context, expenditureID string, transaction domain.Transaction) (*domain.Refund, error)
+	// ListRefunds returns the refunds of the expenditure with their transactions, oldest first
+	ListRefunds(ctx context.Context, expenditureID string) ([]domain.Refund, error)
 }
@@ -0,0 +1,47 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+type ExpenditureRecurrencePatternRepo interface {
+	Create(
+		ctx context.Context,
+		pattern domain.ExpenditureRecurrencePattern,
+	) (
+		string,
+		error,
+	)
+	GetByID(
+		ctx context.Context,
+		id string,
+	) (
+		*domain.ExpenditureRecurrencePattern,
+		error,
+	)
+	List(
+		ctx context.Context,
+		params domain.ExpenditureRecurrencePatternListParams,
+	) (
+		[]domain.ExpenditureRecurrencePattern,
+		error,
+	)
+	Update(
+		ctx context.Context,
+		pattern domain.ExpenditureRecurrencePattern,
+	) error
+	// AdvanceNextDueDate moves the pattern to its next occurrence only if it is still due on
+	// currentDueDate, so concurrent posters can't generate the same occurrence twice
+	AdvanceNextDueDate(
+		ctx context.Context,
+		pattern domain.ExpenditureRecurrencePattern,
+		currentDueDate time.Time,
+	) error
+	Delete(
+		ctx context.Context,
+		id string,
+	) error
+}
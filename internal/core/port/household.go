@@ -0,0 +1,41 @@
+package port
+
+import (
+	"context"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+// HouseholdRepo manages the households themselves, it is the only repository not scoped to the
+// household of the context
+type HouseholdRepo interface {
+	// Create creates the household with the founder as its first member, and links the user to that
+	// member as the admin of the household in the same transaction
+	Create(
+		ctx context.Context,
+		household domain.Household,
+		founder domain.HouseholdMember,
+		userID string,
+	) (
+		string,
+		error,
+	)
+	// AddAdmin links the user to a new member of an existing household as one of its admins
+	AddAdmin(
+		ctx context.Context,
+		id string,
+		member domain.HouseholdMember,
+		userID string,
+	) error
+	GetByID(
+		ctx context.Context,
+		id string,
+	) (
+		*domain.Household,
+		error,
+	)
+	List(ctx context.Context) (
+		[]domain.Household,
+		error,
+	)
+}
@@ -0,0 +1,56 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+type InstallmentPlanRepo interface {
+	Create(
+		ctx context.Context,
+		plan domain.InstallmentPlan,
+	) (
+		string,
+		error,
+	)
+	// GetByID returns the plan with its charges ordered by installment number
+	GetByID(
+		ctx context.Context,
+		id string,
+	) (
+		*domain.InstallmentPlan,
+		error,
+	)
+	List(
+		ctx context.Context,
+		params domain.InstallmentPlanListParams,
+	) (
+		[]domain.InstallmentPlan,
+		error,
+	)
+	// Delete removes a plan that has no installments yet
+	Delete(
+		ctx context.Context,
+		id string,
+	) error
+	// Obligations adds up the pending installments per account
+	Obligations(
+		ctx context.Context,
+		params domain.InstallmentObligationParams,
+	) (
+		[]domain.InstallmentObligation,
+		error,
+	)
+	// PayOff completes the given pending or failed transactions on paidOn and debits their account at
+	// once, from its balance locked for the transaction. It fails, changing nothing, with
+	// domain.ErrInsufficientBalance when the account cannot cover them all, and with ErrRecordNotFound
+	// when any of them was charged meanwhile
+	PayOff(
+		ctx context.Context,
+		accountID string,
+		transactions []domain.Transaction,
+		paidOn time.Time,
+	) error
+}
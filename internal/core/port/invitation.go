@@ -0,0 +1,47 @@
+package port
+
+import (
+	"context"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+type InvitationRepo interface {
+	Create(
+		ctx context.Context,
+		invitation domain.Invitation,
+		tokenHash string,
+	) (
+		string,
+		error,
+	)
+	// GetByTokenHash looks the invitation up across households, the invitee has no household yet
+	GetByTokenHash(
+		ctx context.Context,
+		tokenHash string,
+	) (
+		*domain.Invitation,
+		error,
+	)
+	// Accept marks the invitation accepted and creates the invitee's user, with its roles, linked to
+	// the invited member or to the given new member, all at once. An invitation is accepted once only.
+	Accept(
+		ctx context.Context,
+		invitation domain.Invitation,
+		user domain.User,
+		newMember *domain.HouseholdMember,
+	) (
+		string,
+		error,
+	)
+	// Join marks the invitation accepted and links the existing user, not linked to any member yet, to
+	// the invited member or to the given new member with the roles, all at once. It fails with
+	// domain.ErrUserAlreadyLinked when the user was linked meanwhile.
+	Join(
+		ctx context.Context,
+		invitation domain.Invitation,
+		userID string,
+		roles []string,
+		newMember *domain.HouseholdMember,
+	) error
+}
@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+type Mailer interface {
+	Send(
+		ctx context.Context,
+		mail domain.Mail,
+	) error
+}
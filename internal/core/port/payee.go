@@ -0,0 +1,49 @@
+package port
+
+import (
+	"context"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+type PayeeRepo interface {
+	// Create stores the payee together with its aliases
+	Create(
+		ctx context.Context,
+		payee domain.Payee,
+	) (
+		string,
+		error,
+	)
+	GetByID(
+		ctx context.Context,
+		id string,
+	) (
+		*domain.Payee,
+		error,
+	)
+	List(
+		ctx context.Context,
+		params domain.PayeeListParams,
+	) (
+		[]domain.Payee,
+		error,
+	)
+	// Update replaces the payee fields and its aliases
+	Update(
+		ctx context.Context,
+		payee domain.Payee,
+	) error
+	Delete(
+		ctx context.Context,
+		id string,
+	) error
+	// FindByAlias returns the payee whose name or one of its aliases equals alias, ignoring case
+	FindByAlias(
+		ctx context.Context,
+		alias string,
+	) (
+		*domain.Payee,
+		error,
+	)
+}
@@ -1,13 +1,27 @@
 package port
 
 type Ports struct {
-	Account          *AccountRepo
-	Auth             *AuthRepo
-	Category         *CategoryRepo
-	Expenditure      *ExpenditureRepo
-	HouseholdMembers *HouseholdMembersRepo
-	Ingress          *IngressRepo
-	SavingGoal       *SavingsGoalRepo
-	Tags             *TagsRepo
-	Transaction      *TransactionRepo
+	Account                      *AccountRepo
+	AllocationPlan               *AllocationPlanRepo
+	Auth                         *AuthRepo
+	Budget                       *BudgetRepo
+	CategorizationRule           *CategorizationRuleRepo
+	Category                     *CategoryRepo
+	CategorySuggestion           *CategorySuggestionRepo
+	ExchangeRate                 *ExchangeRateRepo
+	Expenditure                  *ExpenditureRepo
+	ExpenditureRecurrencePattern *ExpenditureRecurrencePatternRepo
+	Household                    *HouseholdRepo
+	HouseholdMembers             *HouseholdMembersRepo
+	InstallmentPlan              *InstallmentPlanRepo
+	Invitation                   *InvitationRepo
+	Ingress                      *IngressRepo
+	Mailer                       *Mailer
+	Payee                        *PayeeRepo
+	Report                       *ReportRepo
+	Role                         *RoleRepo
+	SavingGoal                   *SavingsGoalRepo
+	Search                       *SearchRepo
+	Tags                         *TagsRepo
+	Transaction                  *TransactionRepo
 }
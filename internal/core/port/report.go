@@ -0,0 +1,78 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+type ReportRepo interface {
+	// PlannedSpending returns the completed expenditures in [from, to) summed per day, category and planned status
+	PlannedSpending(
+		ctx context.Context,
+		params domain.PlannedSpendingParams,
+		from time.Time,
+		to time.Time,
+	) (
+		[]domain.PlannedSpendingEntry,
+		error,
+	)
+	// DeclaredExpenses returns the completed declared expenditures in [from, to) ordered by date
+	DeclaredExpenses(
+		ctx context.Context,
+		params domain.DeclaredExpensesParams,
+		from time.Time,
+		to time.Time,
+	) (
+		[]domain.DeclaredExpense,
+		error,
+	)
+	// PayeeSpending returns the completed expenditures in [from, to) summed per payee, biggest first.
+	// Expenditures without payee are summed in an entry with a nil payee ID.
+	PayeeSpending(
+		ctx context.Context,
+		params domain.PayeeSpendingParams,
+		from time.Time,
+		to time.Time,
+	) (
+		[]domain.PayeeSpending,
+		error,
+	)
+	// TagSpending returns the completed expenditures and ingresses in [from, to) summed per tag, biggest
+	// first within each tag type
+	TagSpending(
+		ctx context.Context,
+		params domain.TagSpendingParams,
+		from time.Time,
+		to time.Time,
+	) (
+		[]domain.TagSpending,
+		error,
+	)
+	// TagCombination sums the completed records of the tag type in [from, to) linked to all or any of
+	// the tags, each record counted once
+	TagCombination(
+		ctx context.Context,
+		params domain.TagCombinationParams,
+		tagType domain.TagType,
+		from time.Time,
+		to time.Time,
+	) (
+		total float64,
+		count int,
+		err error,
+	)
+	// TagCategorySpending returns the completed records of the tag in [from, to) summed per category,
+	// biggest first
+	TagCategorySpending(
+		ctx context.Context,
+		params domain.TagCategoryParams,
+		tagType domain.TagType,
+		from time.Time,
+		to time.Time,
+	) (
+		[]domain.TagCategorySpending,
+		error,
+	)
+}
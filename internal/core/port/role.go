@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+type RoleRepo interface {
+	List(ctx context.Context) ([]domain.Role, error)
+	// SetUserRoles replaces the roles of a user of the household of the context, it refuses to leave
+	// the household without an admin
+	SetUserRoles(ctx context.Context, userID string, roles []string) error
+}
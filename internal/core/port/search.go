@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+)
+
+type SearchRepo interface {
+	Search(
+		ctx context.Context,
+		params domain.SearchParams,
+	) (
+		*domain.SearchResult,
+		error,
+	)
+}
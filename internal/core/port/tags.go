@@ -47,12 +47,53 @@ type TagsRepo interface {
 		error,
 	)
 
+	// Merge moves every link of the source tag to the target one and deletes the source, returning the
+	// number of links moved
+	Merge(
+		ctx context.Context,
+		sourceID string,
+		targetID string,
+	) (
+		int64,
+		error,
+	)
+
 	LinkTagsToType(
 		ctx context.Context,
 		foreignID string,
 		tags *[]*domain.Tag,
 	) error
 
+	RecordExists(
+		ctx context.Context,
+		tagType domain.TagType,
+		recordID string,
+	) (
+		bool,
+		error,
+	)
+
+	SetRecordTags(
+		ctx context.Context,
+		tagType domain.TagType,
+		recordID string,
+		tagIDs []string,
+	) error
+
+	AddRecordTags(
+		ctx context.Context,
+		tagType domain.TagType,
+		recordID string,
+		tagIDs []string,
+	) error
+
+	RemoveRecordTag(
+		ctx context.Context,
+		tagType domain.TagType,
+		recordID string,
+		tagID string,
+	) error
+
 	List(ctx context.Context) (
 		*[]*domain.Tag,
 		error,
@@ -2,6 +2,7 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"ghorkov32/proletariat-budget-be/internal/core/domain"
 	"ghorkov32/proletariat-budget-be/openapi"
@@ -11,4 +12,16 @@ type TransactionRepo interface {
 	Create(ctx context.Context, transaction domain.Transaction) (string, error)
 	GetByID(ctx context.Context, id string) (*domain.Transaction, error)
 	List(ctx context.Context, params openapi.ListTransactionsParams) (*openapi.TransactionList, error)
+	// ListDuePending returns the pending transactions of the given type dated at or before dueAt, oldest first
+	ListDuePending(ctx context.Context, transactionType domain.TransactionType, dueAt time.Time) ([]domain.Transaction, error)
+	// UpdateStatus settles a pending transaction, returns ErrRecordNotFound if it is no longer pending
+	UpdateStatus(ctx context.Context, transaction domain.Transaction) error
+	// Debit records a transaction completed at once and debits its account in the same transaction, from the
+	// balance locked for the update. Returns domain.ErrInsufficientBalance when the balance does not cover it
+	// and domain.ErrAccountNotFound without its account.
+	Debit(ctx context.Context, transaction domain.Transaction) (*domain.Transaction, error)
+	// Post completes a pending transaction and debits its account at once, or credits it for refunds,
+	// from the balance locked for the update. Returns ErrRecordNotFound if it is no longer pending, domain.ErrInsufficientBalance
+	// when the balance does not cover it and domain.ErrAccountNotFound without its account.
+	Post(ctx context.Context, transaction domain.Transaction) error
 }
@@ -108,6 +108,23 @@ func (a *AccountUseCase) Update(
 	*domain.Account,
 	error,
 ) {
+	// The owner must be a member of the household of the account, which the member lookup is scoped to
+	if account.OwnerID != nil {
+		_, err := a.householdMemberRepo.GetByID(
+			ctx,
+			*account.OwnerID,
+		)
+		if err != nil {
+			if errors.Is(
+				err,
+				port.ErrRecordNotFound,
+			) {
+				return nil, domain.ErrMemberNotFound
+			}
+
+			return nil, err
+		}
+	}
 	err := a.accountRepo.Update(
 		ctx,
 		account,
@@ -0,0 +1,358 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+type AllocationPlanUseCase struct {
+	allocationPlanRepo port.AllocationPlanRepo
+	categoryRepo       port.CategoryRepo
+	savingsGoalRepo    port.SavingsGoalRepo
+}
+
+func NewAllocationPlanUseCase(
+	allocationPlanRepo port.AllocationPlanRepo,
+	categoryRepo port.CategoryRepo,
+	savingsGoalRepo port.SavingsGoalRepo,
+) *AllocationPlanUseCase {
+	return &AllocationPlanUseCase{
+		allocationPlanRepo: allocationPlanRepo,
+		categoryRepo:       categoryRepo,
+		savingsGoalRepo:    savingsGoalRepo,
+	}
+}
+
+func (u *AllocationPlanUseCase) Create(
+	ctx context.Context,
+	plan domain.AllocationPlan,
+) (
+	*domain.AllocationPlanStatus,
+	error,
+) {
+	plan.Month = domain.MonthStart(plan.Month)
+
+	err := u.validate(
+		ctx,
+		plan,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := u.allocationPlanRepo.Create(
+		ctx,
+		plan,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrDuplicateKey,
+		) {
+			return nil, domain.ErrAllocationPlanExists
+		}
+
+		return nil, err
+	}
+
+	return u.Get(
+		ctx,
+		id,
+	)
+}
+
+// Get returns the plan compared with the income and actual spending of its month
+func (u *AllocationPlanUseCase) Get(
+	ctx context.Context,
+	id string,
+) (
+	*domain.AllocationPlanStatus,
+	error,
+) {
+	plan, err := u.get(
+		ctx,
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := u.status(
+		ctx,
+		*plan,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+func (u *AllocationPlanUseCase) List(
+	ctx context.Context,
+	params domain.AllocationPlanListParams,
+) (
+	[]domain.AllocationPlanStatus,
+	error,
+) {
+	if params.Month != nil {
+		month := domain.MonthStart(*params.Month)
+		params.Month = &month
+	}
+
+	plans, err := u.allocationPlanRepo.List(
+		ctx,
+		params,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(
+		[]domain.AllocationPlanStatus,
+		0,
+		len(plans),
+	)
+	for _, plan := range plans {
+		status, errStatus := u.status(
+			ctx,
+			plan,
+		)
+		if errStatus != nil {
+			return nil, errStatus
+		}
+		statuses = append(
+			statuses,
+			status,
+		)
+	}
+
+	return statuses, nil
+}
+
+// Update replaces the income basis and the allocations of the plan, its month and currency are kept
+func (u *AllocationPlanUseCase) Update(
+	ctx context.Context,
+	plan domain.AllocationPlan,
+) (
+	*domain.AllocationPlanStatus,
+	error,
+) {
+	existing, err := u.get(
+		ctx,
+		plan.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	plan.Month = existing.Month
+	plan.Currency = existing.Currency
+
+	err = u.validate(
+		ctx,
+		plan,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.allocationPlanRepo.Update(
+		ctx,
+		plan,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrAllocationPlanNotFound
+		}
+
+		return nil, err
+	}
+
+	return u.Get(
+		ctx,
+		plan.ID,
+	)
+}
+
+func (u *AllocationPlanUseCase) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	err := u.allocationPlanRepo.Delete(
+		ctx,
+		id,
+	)
+	if errors.Is(
+		err,
+		port.ErrRecordNotFound,
+	) {
+		return domain.ErrAllocationPlanNotFound
+	}
+
+	return err
+}
+
+func (u *AllocationPlanUseCase) get(
+	ctx context.Context,
+	id string,
+) (
+	*domain.AllocationPlan,
+	error,
+) {
+	plan, err := u.allocationPlanRepo.GetByID(
+		ctx,
+		id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrAllocationPlanNotFound
+		}
+
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (u *AllocationPlanUseCase) status(
+	ctx context.Context,
+	plan domain.AllocationPlan,
+) (
+	domain.AllocationPlanStatus,
+	error,
+) {
+	income, err := u.income(
+		ctx,
+		plan,
+	)
+	if err != nil {
+		return domain.AllocationPlanStatus{}, err
+	}
+
+	from, to := plan.Range()
+	actuals, err := u.allocationPlanRepo.Actuals(
+		ctx,
+		plan.Currency,
+		from,
+		to,
+	)
+	if err != nil {
+		return domain.AllocationPlanStatus{}, err
+	}
+
+	return domain.NewAllocationPlanStatus(
+		plan,
+		income,
+		actuals,
+	), nil
+}
+
+func (u *AllocationPlanUseCase) income(
+	ctx context.Context,
+	plan domain.AllocationPlan,
+) (
+	domain.MonthIncome,
+	error,
+) {
+	from, to := plan.Range()
+	income, err := u.allocationPlanRepo.Ingresses(
+		ctx,
+		plan.Currency,
+		from,
+		to,
+	)
+	if err != nil {
+		return domain.MonthIncome{}, err
+	}
+
+	recurrences, err := u.allocationPlanRepo.IncomeRecurrences(
+		ctx,
+		plan.Currency,
+		plan.Month,
+	)
+	if err != nil {
+		return domain.MonthIncome{}, err
+	}
+	for _, recurrence := range recurrences {
+		income.Recurring += recurrence.MonthlyAmount(plan.Month)
+	}
+
+	return income, nil
+}
+
+// validate checks the plan rules, that every category is an active expenditure one and that the
+// allocations fit in the income of the month on the plan basis
+func (u *AllocationPlanUseCase) validate(
+	ctx context.Context,
+	plan domain.AllocationPlan,
+) error {
+	err := plan.Validate()
+	if err != nil {
+		return err
+	}
+
+	for _, allocation := range plan.Allocations {
+		if allocation.SavingsGoalID != nil {
+			// The foreign key alone would accept the savings goals of another household
+			_, errGoal := u.savingsGoalRepo.GetByID(
+				ctx,
+				*allocation.SavingsGoalID,
+			)
+			if errGoal != nil {
+				if errors.Is(
+					errGoal,
+					port.ErrRecordNotFound,
+				) {
+					return domain.ErrSavingsGoalNotFound
+				}
+
+				return errGoal
+			}
+
+			continue
+		}
+
+		category, errCategory := u.categoryRepo.GetByID(
+			ctx,
+			*allocation.CategoryID,
+		)
+		if errCategory != nil {
+			if errors.Is(
+				errCategory,
+				port.ErrRecordNotFound,
+			) {
+				return domain.ErrCategoryNotFound
+			}
+
+			return errCategory
+		}
+		if !category.Active {
+			return domain.ErrCategoryInactive
+		}
+		if category.CategoryType != domain.CategoryTypeExpenditure {
+			return domain.ErrAllocationCategoryNotExpenses
+		}
+	}
+
+	income, err := u.income(
+		ctx,
+		plan,
+	)
+	if err != nil {
+		return err
+	}
+	if plan.Allocated() > income.Income(plan.IncomeBasis) {
+		return domain.ErrAllocationsExceedIncome
+	}
+
+	return nil
+}
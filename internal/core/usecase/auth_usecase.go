@@ -10,25 +10,27 @@ import (
 )
 
 type AuthUseCase struct {
-	authRepo port.AuthRepo
+	authRepo             port.AuthRepo
+	householdMembersRepo port.HouseholdMembersRepo
 }
 
-func NewAuthUseCase(authRepo port.AuthRepo) *AuthUseCase {
+func NewAuthUseCase(authRepo port.AuthRepo, householdMembersRepo port.HouseholdMembersRepo) *AuthUseCase {
 	return &AuthUseCase{
-		authRepo: authRepo,
+		authRepo:             authRepo,
+		householdMembersRepo: householdMembersRepo,
 	}
 }
 
 func (uc *AuthUseCase) Login(ctx context.Context, email, password string) (*domain.AuthToken, *domain.User, error) {
 	user, err := uc.authRepo.GetUserByEmail(ctx, email)
 	if err != nil {
-		return nil, nil, errors.New("invalid credentials")
+		return nil, nil, domain.ErrInvalidCredentials
 	}
 
 	// Compare password
 	err = bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
 	if err != nil {
-		return nil, nil, errors.New("invalid credentials")
+		return nil, nil, domain.ErrInvalidCredentials
 	}
 
 	// Generate token
@@ -40,6 +42,31 @@ func (uc *AuthUseCase) Login(ctx context.Context, email, password string) (*doma
 	return token, user, nil
 }
 
+// Register creates a user not linked to any member yet and logs it in
+func (uc *AuthUseCase) Register(ctx context.Context, user domain.User, password string) (*domain.AuthToken, *domain.User, error) {
+	if err := user.Validate(password); err != nil {
+		return nil, nil, err
+	}
+
+	hash, err := hashPassword(password)
+	if err != nil {
+		return nil, nil, err
+	}
+	user.Password = hash
+	user.HouseholdMemberID = nil
+
+	id, err := uc.authRepo.CreateUser(ctx, user)
+	if err != nil {
+		if errors.Is(err, port.ErrDuplicateKey) {
+			return nil, nil, domain.ErrUserEmailTaken
+		}
+
+		return nil, nil, err
+	}
+
+	return uc.RefreshToken(ctx, id)
+}
+
 func (uc *AuthUseCase) ValidateToken(ctx context.Context, tokenStr string) (*domain.User, error) {
 	return uc.authRepo.ValidateToken(ctx, tokenStr)
 }
@@ -57,3 +84,51 @@ func (uc *AuthUseCase) RefreshToken(ctx context.Context, userID string) (*domain
 
 	return token, user, nil
 }
+
+// LinkMember binds the user to a member of the household of the context, the user then acts on that
+// household. A member is linked to one user at most. Only admins of that household link themselves to
+// another of its members, other users join it through invitations. Linking never grants a role, admins
+// found their household or are bootstrapped by the operators.
+func (uc *AuthUseCase) LinkMember(ctx context.Context, user domain.User, memberID string) (*domain.User, error) {
+	household, ok := domain.HouseholdIDFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrHouseholdRequired
+	}
+	if user.HouseholdID == nil || *user.HouseholdID != household || !user.HasRole(domain.RoleAdmin) {
+		return nil, domain.ErrForbidden
+	}
+
+	_, err := uc.householdMembersRepo.GetByID(ctx, memberID)
+	if err != nil {
+		if errors.Is(err, port.ErrRecordNotFound) {
+			return nil, domain.ErrMemberNotFound
+		}
+
+		return nil, err
+	}
+
+	if user.HouseholdMemberID == nil || *user.HouseholdMemberID != memberID {
+		err = uc.authRepo.LinkMember(ctx, user.ID, memberID)
+		if err != nil {
+			if errors.Is(err, port.ErrDuplicateKey) {
+				return nil, domain.ErrMemberAlreadyLinked
+			}
+			if errors.Is(err, port.ErrRecordNotFound) {
+				return nil, domain.ErrUserNotFound
+			}
+
+			return nil, err
+		}
+	}
+
+	return uc.authRepo.GetUserByID(ctx, user.ID)
+}
+
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
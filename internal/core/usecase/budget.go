@@ -0,0 +1,489 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+type BudgetUseCase struct {
+	budgetRepo   port.BudgetRepo
+	categoryRepo port.CategoryRepo
+}
+
+func NewBudgetUseCase(
+	budgetRepo port.BudgetRepo,
+	categoryRepo port.CategoryRepo,
+) *BudgetUseCase {
+	return &BudgetUseCase{
+		budgetRepo:   budgetRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+func (u *BudgetUseCase) Create(
+	ctx context.Context,
+	budget domain.Budget,
+) (
+	*domain.Budget,
+	error,
+) {
+	err := u.validate(
+		ctx,
+		budget,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := u.budgetRepo.Create(
+		ctx,
+		budget,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.Get(
+		ctx,
+		id,
+	)
+}
+
+func (u *BudgetUseCase) Get(
+	ctx context.Context,
+	id string,
+) (
+	*domain.Budget,
+	error,
+) {
+	budget, err := u.budgetRepo.GetByID(
+		ctx,
+		id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrBudgetNotFound
+		}
+
+		return nil, err
+	}
+
+	return budget, nil
+}
+
+func (u *BudgetUseCase) List(
+	ctx context.Context,
+	params domain.BudgetListParams,
+) (
+	[]domain.Budget,
+	error,
+) {
+	return u.budgetRepo.List(
+		ctx,
+		params,
+	)
+}
+
+func (u *BudgetUseCase) Update(
+	ctx context.Context,
+	budget domain.Budget,
+) (
+	*domain.Budget,
+	error,
+) {
+	existing, err := u.Get(
+		ctx,
+		budget.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.validate(
+		ctx,
+		budget,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.budgetRepo.Update(
+		ctx,
+		budget,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Period amounts are kept by period number, which no longer points to the same dates
+	if !existing.SamePeriods(budget) {
+		err = u.budgetRepo.DeletePeriodAmounts(
+			ctx,
+			budget.ID,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return u.Get(
+		ctx,
+		budget.ID,
+	)
+}
+
+func (u *BudgetUseCase) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	err := u.budgetRepo.Delete(
+		ctx,
+		id,
+	)
+	if errors.Is(
+		err,
+		port.ErrRecordNotFound,
+	) {
+		return domain.ErrBudgetNotFound
+	}
+
+	return err
+}
+
+// Status returns what was available and spent of the budget in its period containing date
+func (u *BudgetUseCase) Status(
+	ctx context.Context,
+	id string,
+	date time.Time,
+) (
+	*domain.BudgetStatus,
+	error,
+) {
+	budget, err := u.Get(
+		ctx,
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	period, err := budget.PeriodAt(date)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := u.status(
+		ctx,
+		*budget,
+		period.Number,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// History returns the status of every period of the budget up to its period containing date, or up to
+// its last period when the budget ended before date
+func (u *BudgetUseCase) History(
+	ctx context.Context,
+	id string,
+	date time.Time,
+) (
+	*domain.BudgetHistory,
+	error,
+) {
+	budget, err := u.Get(
+		ctx,
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if budget.EndDate != nil && date.After(*budget.EndDate) {
+		date = *budget.EndDate
+	}
+	period, err := budget.PeriodAt(date)
+	if err != nil {
+		return nil, err
+	}
+
+	periods, err := u.history(
+		ctx,
+		*budget,
+		period.Number,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.BudgetHistory{
+		Budget:  *budget,
+		Periods: periods,
+	}, nil
+}
+
+// SetPeriodAmount changes the amount of a single budget period and returns its status
+func (u *BudgetUseCase) SetPeriodAmount(
+	ctx context.Context,
+	id string,
+	number int,
+	amount float32,
+) (
+	*domain.BudgetStatus,
+	error,
+) {
+	if number < 0 {
+		return nil, domain.ErrInvalidBudgetPeriodNumber
+	}
+	if amount < 0 {
+		return nil, domain.ErrInvalidBudgetPeriodAmount
+	}
+
+	budget, err := u.Get(
+		ctx,
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !budget.InRange(number) {
+		return nil, domain.ErrBudgetOutOfRange
+	}
+
+	err = u.budgetRepo.SetPeriodAmount(
+		ctx,
+		id,
+		number,
+		amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := u.status(
+		ctx,
+		*budget,
+		number,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// CopyLastPeriod sets the amount of every active budget in its period containing date to the amount of
+// the period before, and returns the overview of date. Budgets in their first period are left as they are.
+func (u *BudgetUseCase) CopyLastPeriod(
+	ctx context.Context,
+	date time.Time,
+) (
+	*domain.BudgetOverview,
+	error,
+) {
+	budgets, err := u.activeBudgets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, budget := range budgets {
+		period, errPeriod := budget.PeriodAt(date)
+		if errors.Is(
+			errPeriod,
+			domain.ErrBudgetOutOfRange,
+		) || period.Number == 0 {
+			continue
+		}
+
+		amounts, errAmounts := u.budgetRepo.PeriodAmounts(
+			ctx,
+			budget.ID,
+		)
+		if errAmounts != nil {
+			return nil, errAmounts
+		}
+
+		errSet := u.budgetRepo.SetPeriodAmount(
+			ctx,
+			budget.ID,
+			period.Number,
+			budget.PeriodAmount(
+				period.Number-1,
+				amounts,
+			),
+		)
+		if errSet != nil {
+			return nil, errSet
+		}
+	}
+
+	return u.Overview(
+		ctx,
+		date,
+	)
+}
+
+// Overview returns the status of every active budget running on date
+func (u *BudgetUseCase) Overview(
+	ctx context.Context,
+	date time.Time,
+) (
+	*domain.BudgetOverview,
+	error,
+) {
+	budgets, err := u.activeBudgets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(
+		[]domain.BudgetStatus,
+		0,
+		len(budgets),
+	)
+	for _, budget := range budgets {
+		period, errPeriod := budget.PeriodAt(date)
+		if errors.Is(
+			errPeriod,
+			domain.ErrBudgetOutOfRange,
+		) {
+			continue
+		}
+
+		status, errStatus := u.status(
+			ctx,
+			budget,
+			period.Number,
+		)
+		if errStatus != nil {
+			return nil, errStatus
+		}
+		statuses = append(
+			statuses,
+			status,
+		)
+	}
+
+	overview := domain.NewBudgetOverview(
+		date,
+		statuses,
+	)
+
+	return &overview, nil
+}
+
+func (u *BudgetUseCase) activeBudgets(ctx context.Context) (
+	[]domain.Budget,
+	error,
+) {
+	active := true
+
+	return u.budgetRepo.List(
+		ctx,
+		domain.BudgetListParams{Active: &active},
+	)
+}
+
+func (u *BudgetUseCase) status(
+	ctx context.Context,
+	budget domain.Budget,
+	number int,
+) (
+	domain.BudgetStatus,
+	error,
+) {
+	periods, err := u.history(
+		ctx,
+		budget,
+		number,
+	)
+	if err != nil {
+		return domain.BudgetStatus{}, err
+	}
+
+	return domain.BudgetStatus{
+		Budget:             budget,
+		BudgetPeriodStatus: periods[number],
+	}, nil
+}
+
+// history computes the periods of the budget up to last, since what is carried to a period depends on
+// every period before it
+func (u *BudgetUseCase) history(
+	ctx context.Context,
+	budget domain.Budget,
+	last int,
+) (
+	[]domain.BudgetPeriodStatus,
+	error,
+) {
+	amounts, err := u.budgetRepo.PeriodAmounts(
+		ctx,
+		budget.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	from, _ := budget.Period(0).Range()
+	_, to := budget.Period(last).Range()
+	spending, err := u.budgetRepo.DailySpent(
+		ctx,
+		budget.CategoryID,
+		budget.Currency,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return budget.History(
+		last,
+		amounts,
+		spending,
+	), nil
+}
+
+func (u *BudgetUseCase) validate(
+	ctx context.Context,
+	budget domain.Budget,
+) error {
+	err := budget.Validate()
+	if err != nil {
+		return err
+	}
+
+	category, err := u.categoryRepo.GetByID(
+		ctx,
+		budget.CategoryID,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return domain.ErrCategoryNotFound
+		}
+
+		return err
+	}
+	if !category.Active {
+		return domain.ErrCategoryInactive
+	}
+	if category.CategoryType != domain.CategoryTypeExpenditure {
+		return domain.ErrBudgetCategoryNotExpenses
+	}
+
+	return nil
+}
@@ -0,0 +1,354 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+// maxPreviewCategorizations caps the categorizations returned by a rule preview
+const maxPreviewCategorizations = 100
+
+type CategorizationRuleUseCase struct {
+	ruleRepo     port.CategorizationRuleRepo
+	categoryRepo port.CategoryRepo
+	tagsRepo     port.TagsRepo
+}
+
+func NewCategorizationRuleUseCase(
+	ruleRepo port.CategorizationRuleRepo,
+	categoryRepo port.CategoryRepo,
+	tagsRepo port.TagsRepo,
+) *CategorizationRuleUseCase {
+	return &CategorizationRuleUseCase{
+		ruleRepo:     ruleRepo,
+		categoryRepo: categoryRepo,
+		tagsRepo:     tagsRepo,
+	}
+}
+
+func (u *CategorizationRuleUseCase) Create(
+	ctx context.Context,
+	rule domain.CategorizationRule,
+) (
+	*domain.CategorizationRule,
+	error,
+) {
+	err := u.validate(
+		ctx,
+		&rule,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := u.ruleRepo.Create(
+		ctx,
+		rule,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.ruleRepo.GetByID(
+		ctx,
+		id,
+	)
+}
+
+func (u *CategorizationRuleUseCase) Get(
+	ctx context.Context,
+	id string,
+) (
+	*domain.CategorizationRule,
+	error,
+) {
+	rule, err := u.ruleRepo.GetByID(
+		ctx,
+		id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrCategorizationRuleNotFound
+		}
+
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// List returns the rules in the order they are run
+func (u *CategorizationRuleUseCase) List(
+	ctx context.Context,
+	params domain.CategorizationRuleListParams,
+) (
+	[]domain.CategorizationRule,
+	error,
+) {
+	return u.ruleRepo.List(
+		ctx,
+		params,
+	)
+}
+
+// Update replaces the conditions and actions of the rule
+func (u *CategorizationRuleUseCase) Update(
+	ctx context.Context,
+	rule domain.CategorizationRule,
+) (
+	*domain.CategorizationRule,
+	error,
+) {
+	_, err := u.Get(
+		ctx,
+		rule.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.validate(
+		ctx,
+		&rule,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.ruleRepo.Update(
+		ctx,
+		rule,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrCategorizationRuleNotFound
+		}
+
+		return nil, err
+	}
+
+	return u.ruleRepo.GetByID(
+		ctx,
+		rule.ID,
+	)
+}
+
+// Delete removes the rule, what it already assigned is kept
+func (u *CategorizationRuleUseCase) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	err := u.ruleRepo.Delete(
+		ctx,
+		id,
+	)
+	if errors.Is(
+		err,
+		port.ErrRecordNotFound,
+	) {
+		return domain.ErrCategorizationRuleNotFound
+	}
+
+	return err
+}
+
+// Preview runs a rule, saved or not, on past expenditures without changing them. Without dates, the
+// expenditures of the last year are tested.
+func (u *CategorizationRuleUseCase) Preview(
+	ctx context.Context,
+	rule domain.CategorizationRule,
+	params domain.RuleCandidateParams,
+) (
+	*domain.CategorizationRun,
+	error,
+) {
+	err := u.validate(
+		ctx,
+		&rule,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rule.Active = true
+
+	if params.StartDate == nil && params.EndDate == nil && len(params.ExpenditureIDs) == 0 {
+		startDate := time.Now().UTC().AddDate(
+			-1,
+			0,
+			0,
+		)
+		params.StartDate = &startDate
+	}
+
+	run, err := u.run(
+		ctx,
+		[]domain.CategorizationRule{rule},
+		params,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(run.Categorizations) > maxPreviewCategorizations {
+		run.Categorizations = run.Categorizations[:maxPreviewCategorizations]
+	}
+
+	return run, nil
+}
+
+// Apply runs the active rules again on the chosen expenditures and stores what they change. The
+// category of an expenditure is replaced by the one of the first matching rule assigning a category.
+func (u *CategorizationRuleUseCase) Apply(
+	ctx context.Context,
+	params domain.RuleCandidateParams,
+) (
+	*domain.CategorizationRun,
+	error,
+) {
+	if len(params.ExpenditureIDs) == 0 && params.CategoryID == nil {
+		return nil, domain.ErrRuleApplyScopeRequired
+	}
+
+	active := true
+	rules, err := u.ruleRepo.List(
+		ctx,
+		domain.CategorizationRuleListParams{Active: &active},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := u.run(
+		ctx,
+		rules,
+		params,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(run.Categorizations) == 0 {
+		return run, nil
+	}
+
+	err = u.ruleRepo.Apply(
+		ctx,
+		run.Categorizations,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// run categorizes the candidates of the params, keeping only the categorizations changing them
+func (u *CategorizationRuleUseCase) run(
+	ctx context.Context,
+	rules []domain.CategorizationRule,
+	params domain.RuleCandidateParams,
+) (
+	*domain.CategorizationRun,
+	error,
+) {
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := u.ruleRepo.Candidates(
+		ctx,
+		params,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &domain.CategorizationRun{
+		Categorizations: make(
+			[]domain.Categorization,
+			0,
+		),
+	}
+	for _, candidate := range candidates {
+		categorization := domain.Categorize(
+			rules,
+			candidate,
+		)
+		if categorization == nil || !categorization.Changed() {
+			continue
+		}
+		run.Categorizations = append(
+			run.Categorizations,
+			*categorization,
+		)
+	}
+	run.Total = len(run.Categorizations)
+
+	return run, nil
+}
+
+// validate checks the rule, the category it assigns must be an active expenditure category and its
+// tags expenditure tags
+func (u *CategorizationRuleUseCase) validate(
+	ctx context.Context,
+	rule *domain.CategorizationRule,
+) error {
+	err := rule.Validate()
+	if err != nil {
+		return err
+	}
+
+	if rule.CategoryID != nil {
+		category, errCategory := u.categoryRepo.GetByID(
+			ctx,
+			*rule.CategoryID,
+		)
+		if errCategory != nil {
+			if errors.Is(
+				errCategory,
+				port.ErrRecordNotFound,
+			) {
+				return domain.ErrCategoryNotFound
+			}
+
+			return errCategory
+		}
+		if !category.Active {
+			return domain.ErrCategoryInactive
+		}
+		if category.CategoryType != domain.CategoryTypeExpenditure {
+			return domain.ErrRuleCategoryNotExpenses
+		}
+	}
+
+	for _, tagID := range rule.TagIDs {
+		tag, errTag := u.tagsRepo.GetByID(
+			ctx,
+			tagID,
+		)
+		if errTag != nil {
+			if errors.Is(
+				errTag,
+				port.ErrRecordNotFound,
+			) {
+				return domain.ErrTagNotFound
+			}
+
+			return errTag
+		}
+		if tag.TagType != domain.TagTypeExpenditure {
+			return domain.ErrRuleTagNotExpenses
+		}
+	}
+
+	return nil
+}
@@ -44,6 +44,25 @@ func (uc *CategoryUseCase) ListCategories(
 	return categories, nil
 }
 
+// CategoryTree lists the categories nested under their parents
+func (uc *CategoryUseCase) CategoryTree(
+	ctx context.Context,
+	categoryType *domain.CategoryType,
+) (
+	[]domain.CategoryNode,
+	error,
+) {
+	categories, err := uc.ListCategories(
+		ctx,
+		categoryType,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewCategoryTree(categories), nil
+}
+
 func (uc *CategoryUseCase) GetCategory(
 	ctx context.Context,
 	id string,
@@ -76,6 +95,14 @@ func (uc *CategoryUseCase) CreateCategory(
 	*domain.Category,
 	error,
 ) {
+	err := uc.validateParent(
+		ctx,
+		category,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	id, err := uc.categoryRepo.Create(
 		ctx,
 		category,
@@ -95,14 +122,17 @@ func (uc *CategoryUseCase) CreateCategory(
 	return createdCategory, nil
 }
 
+// UpdateCategory updates the category. Activation follows its own rules, see Activate and Deactivate,
+// so the active state, when given, must be the current one.
 func (uc *CategoryUseCase) UpdateCategory(
 	ctx context.Context,
 	category domain.Category,
+	active *bool,
 ) (
 	*domain.Category,
 	error,
 ) {
-	_, err := uc.categoryRepo.GetByID(
+	existing, err := uc.categoryRepo.GetByID(
 		ctx,
 		category.ID,
 	)
@@ -116,6 +146,26 @@ func (uc *CategoryUseCase) UpdateCategory(
 
 		return nil, err
 	}
+	if active != nil && *active != existing.Active {
+		return nil, domain.ErrCategoryActiveChanged
+	}
+	category.Active = existing.Active
+
+	err = uc.validateParent(
+		ctx,
+		category,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.validateSubcategories(
+		ctx,
+		category,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	err = uc.categoryRepo.Update(
 		ctx,
 		category,
@@ -153,6 +203,8 @@ func (uc *CategoryUseCase) DeleteCategory(
 		if port.IsInfrastructureError(err) {
 			return uc.getErrorConstraintType(err)
 		}
+
+		return err
 	}
 
 	return nil
@@ -179,6 +231,26 @@ func (uc *CategoryUseCase) getErrorConstraintType(err error) error {
 		"saving_goal",
 	) {
 		return domain.ErrCategoryUsedInSavingGoal
+	} else if strings.Contains(
+		err.Error(),
+		"budgets",
+	) {
+		return domain.ErrCategoryUsedInBudget
+	} else if strings.Contains(
+		err.Error(),
+		"allocation plans",
+	) {
+		return domain.ErrCategoryUsedInAllocationPlan
+	} else if strings.Contains(
+		err.Error(),
+		"categorization rules",
+	) {
+		return domain.ErrCategoryUsedInCategorizationRule
+	} else if strings.Contains(
+		err.Error(),
+		"subcategories",
+	) {
+		return domain.ErrCategoryHasSubcategories
 	}
 
 	return domain.ErrCategoryUsedInEntity
@@ -206,6 +278,18 @@ func (uc *CategoryUseCase) Activate(
 	if err != nil {
 		return err
 	}
+	if category.ParentID != nil {
+		parent, errParent := uc.categoryRepo.GetByID(
+			ctx,
+			*category.ParentID,
+		)
+		if errParent != nil {
+			return errParent
+		}
+		if !parent.Active {
+			return domain.ErrCategoryParentInactive
+		}
+	}
 	err = uc.categoryRepo.Update(
 		ctx,
 		*category,
@@ -239,13 +323,173 @@ func (uc *CategoryUseCase) Deactivate(
 	if err != nil {
 		return err
 	}
-	err = uc.categoryRepo.Update(
+
+	// Subcategories cannot stay active under an inactive category
+	descendants, err := uc.categoryRepo.Descendants(
 		ctx,
-		*category,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+	ids := []string{category.ID}
+	for _, descendant := range descendants {
+		if descendant.Active {
+			ids = append(
+				ids,
+				descendant.ID,
+			)
+		}
+	}
+
+	return uc.categoryRepo.Deactivate(
+		ctx,
+		ids,
+	)
+}
+
+// Merge moves every expenditure, ingress, savings goal, recurring bill, installment plan, budget,
+// allocation, payee default and subcategory of the source category to the target one, which must have
+// the same type, and then deletes or deactivates the source
+func (uc *CategoryUseCase) Merge(
+	ctx context.Context,
+	sourceID string,
+	targetID string,
+	action domain.CategoryMergeAction,
+) (
+	*domain.CategoryMergeResult,
+	error,
+) {
+	if !action.IsValid() {
+		return nil, domain.ErrInvalidCategoryMergeAction
+	}
+	if sourceID == targetID {
+		return nil, domain.ErrCategoryMergeSameCategory
+	}
+
+	source, err := uc.GetCategory(
+		ctx,
+		sourceID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	target, err := uc.categoryRepo.GetByID(
+		ctx,
+		targetID,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrCategoryMergeTargetNotFound
+		}
+
+		return nil, err
+	}
+	if source.CategoryType != target.CategoryType {
+		return nil, domain.ErrCategoryMergeTypeMismatch
+	}
+	if !target.Active {
+		return nil, domain.ErrCategoryInactive
+	}
+
+	descendants, err := uc.categoryRepo.Descendants(
+		ctx,
+		sourceID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, descendant := range descendants {
+		if descendant.ID == targetID {
+			return nil, domain.ErrCategoryMergeIntoSubcategory
+		}
+	}
+
+	moved, err := uc.categoryRepo.Merge(
+		ctx,
+		sourceID,
+		targetID,
+		action,
 	)
 	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrCategoryNotFound
+		}
+
+		return nil, err
+	}
+
+	return &domain.CategoryMergeResult{
+		Target: *target,
+		Moved:  moved,
+	}, nil
+}
+
+// validateParent checks the category can be placed under its parent: the parent exists, is active, has
+// the same type and is not the category itself or one of its subcategories
+func (uc *CategoryUseCase) validateParent(
+	ctx context.Context,
+	category domain.Category,
+) error {
+	if category.ParentID == nil {
+		return nil
+	}
+	if *category.ParentID == category.ID {
+		return domain.ErrCategoryCycle
+	}
+
+	parent, err := uc.categoryRepo.GetByID(
+		ctx,
+		*category.ParentID,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return domain.ErrCategoryParentNotFound
+		}
+
 		return err
 	}
+	if parent.CategoryType != category.CategoryType {
+		return domain.ErrCategoryParentTypeMismatch
+	}
+	if !parent.Active && category.Active {
+		return domain.ErrCategoryParentInactive
+	}
+
+	return nil
+}
+
+// validateSubcategories checks an existing category keeps the type of its subcategories and is not
+// placed under one of them
+func (uc *CategoryUseCase) validateSubcategories(
+	ctx context.Context,
+	category domain.Category,
+) error {
+	descendants, err := uc.categoryRepo.Descendants(
+		ctx,
+		category.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, descendant := range descendants {
+		if category.ParentID != nil && *category.ParentID == descendant.ID {
+			return domain.ErrCategoryCycle
+		}
+		if descendant.CategoryType != category.CategoryType {
+			return domain.ErrCategoryParentTypeMismatch
+		}
+	}
 
 	return nil
 }
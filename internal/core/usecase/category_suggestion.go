@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+// trainingBatchSize is how many expenditures are loaded at once while training
+const trainingBatchSize = 1000
+
+// CategorySuggestionUseCase suggests categories and tags from models trained in memory on past
+// expenditures, one per household. A model learns the expenditures created since its last training before
+// every suggestion, retraining it from scratch picks up categories changed on past expenditures.
+type CategorySuggestionUseCase struct {
+	suggestionRepo port.CategorySuggestionRepo
+	categoryRepo   port.CategoryRepo
+	tagsRepo       port.TagsRepo
+
+	// mu only guards the models map, each household model has its own lock so training one household
+	// does not hold up the others
+	mu     sync.Mutex
+	models map[string]*householdModel
+}
+
+// householdModel is the model of a household with the lock held while it learns or suggests
+type householdModel struct {
+	mu    sync.Mutex
+	model *domain.SuggestionModel
+}
+
+func NewCategorySuggestionUseCase(
+	suggestionRepo port.CategorySuggestionRepo,
+	categoryRepo port.CategoryRepo,
+	tagsRepo port.TagsRepo,
+) *CategorySuggestionUseCase {
+	return &CategorySuggestionUseCase{
+		suggestionRepo: suggestionRepo,
+		categoryRepo:   categoryRepo,
+		tagsRepo:       tagsRepo,
+		models:         make(map[string]*householdModel),
+	}
+}
+
+// Suggest returns up to limit categories and tags for the description, most likely first. Inactive
+// and deleted categories and deleted tags are left out.
+func (u *CategorySuggestionUseCase) Suggest(
+	ctx context.Context,
+	description string,
+	limit int,
+) (
+	*domain.CategorySuggestion,
+	error,
+) {
+	if limit < 1 {
+		return nil, domain.ErrInvalidSuggestionLimit
+	}
+
+	household, ok := domain.HouseholdIDFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrHouseholdRequired
+	}
+
+	entry := u.householdModel(household)
+	entry.mu.Lock()
+	err := u.train(
+		ctx,
+		entry.model,
+	)
+	if err != nil {
+		entry.mu.Unlock()
+
+		return nil, err
+	}
+	suggestion, err := entry.model.Suggest(description)
+	entry.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	suggestion.Categories, err = u.nameCategories(
+		ctx,
+		suggestion.Categories,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestion.Tags, err = u.nameTags(
+		ctx,
+		suggestion.Tags,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return suggestion, nil
+}
+
+// Retrain drops the model and learns every past expenditure again
+func (u *CategorySuggestionUseCase) Retrain(ctx context.Context) (
+	*domain.SuggestionModelStatus,
+	error,
+) {
+	household, ok := domain.HouseholdIDFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrHouseholdRequired
+	}
+
+	entry := u.householdModel(household)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	model := domain.NewSuggestionModel()
+	err := u.train(
+		ctx,
+		model,
+	)
+	if err != nil {
+		return nil, err
+	}
+	entry.model = model
+
+	status := model.Status()
+
+	return &status, nil
+}
+
+// householdModel returns the model of the household, created empty on first use
+func (u *CategorySuggestionUseCase) householdModel(household string) *householdModel {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	entry, ok := u.models[household]
+	if !ok {
+		entry = &householdModel{
+			model: domain.NewSuggestionModel(),
+		}
+		u.models[household] = entry
+	}
+
+	return entry
+}
+
+// train makes the model learn the expenditures of the household created since its last training, the
+// caller holds the lock of the household model
+func (u *CategorySuggestionUseCase) train(
+	ctx context.Context,
+	model *domain.SuggestionModel,
+) error {
+	for {
+		examples, err := u.suggestionRepo.TrainingExamples(
+			ctx,
+			model.LastExpenditureID,
+			trainingBatchSize,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, example := range examples {
+			model.Learn(example)
+		}
+		if len(examples) < trainingBatchSize {
+			return nil
+		}
+	}
+}
+
+func (u *CategorySuggestionUseCase) nameCategories(
+	ctx context.Context,
+	suggestions []domain.ScoredSuggestion,
+	limit int,
+) (
+	[]domain.ScoredSuggestion,
+	error,
+) {
+	named := make(
+		[]domain.ScoredSuggestion,
+		0,
+		limit,
+	)
+	for _, suggestion := range suggestions {
+		if len(named) == limit {
+			break
+		}
+
+		category, err := u.categoryRepo.GetByID(
+			ctx,
+			suggestion.ID,
+		)
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !category.Active {
+			continue
+		}
+
+		suggestion.Name = category.Name
+		named = append(
+			named,
+			suggestion,
+		)
+	}
+
+	return named, nil
+}
+
+func (u *CategorySuggestionUseCase) nameTags(
+	ctx context.Context,
+	suggestions []domain.ScoredSuggestion,
+	limit int,
+) (
+	[]domain.ScoredSuggestion,
+	error,
+) {
+	named := make(
+		[]domain.ScoredSuggestion,
+		0,
+		limit,
+	)
+	for _, suggestion := range suggestions {
+		if len(named) == limit {
+			break
+		}
+
+		tag, err := u.tagsRepo.GetByID(
+			ctx,
+			suggestion.ID,
+		)
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		suggestion.Name = tag.Name
+		named = append(
+			named,
+			suggestion,
+		)
+	}
+
+	return named, nil
+}
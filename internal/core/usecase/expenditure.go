@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 	"errors"
+	"slices"
+	"time"
 
 	"ghorkov32/proletariat-budget-be/internal/core/domain"
 	"ghorkov32/proletariat-budget-be/internal/core/port"
@@ -14,27 +16,48 @@ type ExpenditureUseCase struct {
 	tagsRepo        port.TagsRepo
 	categoryRepo    port.CategoryRepo
 	transactionRepo port.TransactionRepo
+	payeeRepo       port.PayeeRepo
+	// ruleRepo provides the categorization rules run on new expenditures
+	ruleRepo port.CategorizationRuleRepo
+	// exchangeRateRepo converts expenditures made in another currency than their account
+	exchangeRateRepo port.ExchangeRateRepo
+	// duplicateWindowDays is how many days around an expenditure its duplicates are searched in
+	duplicateWindowDays int
 }
 
+// maxDuplicateCandidates caps the expenditures compared with a new one
+const maxDuplicateCandidates = 50
+
 func NewExpenditureUseCase(
 	expenditureRepo port.ExpenditureRepo,
 	accountRepo port.AccountRepo,
 	tagsRepo port.TagsRepo,
 	categoryRepo port.CategoryRepo,
 	transactionRepo port.TransactionRepo,
+	payeeRepo port.PayeeRepo,
+	ruleRepo port.CategorizationRuleRepo,
+	exchangeRateRepo port.ExchangeRateRepo,
+	duplicateWindowDays int,
 ) *ExpenditureUseCase {
 	return &ExpenditureUseCase{
-		expenditureRepo: expenditureRepo,
-		accountRepo:     accountRepo,
-		tagsRepo:        tagsRepo,
-		categoryRepo:    categoryRepo,
-		transactionRepo: transactionRepo,
+		expenditureRepo:     expenditureRepo,
+		accountRepo:         accountRepo,
+		tagsRepo:            tagsRepo,
+		categoryRepo:        categoryRepo,
+		transactionRepo:     transactionRepo,
+		payeeRepo:           payeeRepo,
+		ruleRepo:            ruleRepo,
+		exchangeRateRepo:    exchangeRateRepo,
+		duplicateWindowDays: duplicateWindowDays,
 	}
 }
 
+// Create records the expenditure and debits its account. Unless force is set, it is rejected
+// with a DuplicateTransactionError when it looks like an expenditure that was already recorded.
 func (u *ExpenditureUseCase) Create(
 	ctx context.Context,
 	expenditure domain.Expenditure,
+	force bool,
 ) (
 	*domain.Expenditure,
 	error,
@@ -48,6 +71,31 @@ func (u *ExpenditureUseCase) Create(
 		return nil, err
 	}
 
+	// Charge foreign currency expenditures in the account currency
+	err = u.chargeInAccountCurrency(
+		ctx,
+		account,
+		expenditure.Transaction,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := u.activeRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the payee and run the categorization rules, they may provide the category
+	err = u.categorize(
+		ctx,
+		&expenditure,
+		rules,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Validate category
 	err = u.validateCategory(
 		ctx,
@@ -57,10 +105,27 @@ func (u *ExpenditureUseCase) Create(
 		return nil, err
 	}
 
+	err = u.validateTags(
+		ctx,
+		expenditure.Tags,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !force {
+		err = u.checkDuplicate(
+			ctx,
+			expenditure,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Process transaction
 	err = u.processTransaction(
 		ctx,
-		account,
 		&expenditure,
 	)
 	if err != nil {
@@ -92,6 +157,166 @@ func (u *ExpenditureUseCase) Create(
 	)
 }
 
+// CreateBatch validates every expenditure of the batch before storing any of them. Failed items are
+// reported by index; in all-or-nothing mode they prevent the whole batch from being created, in
+// best-effort mode the valid items are created anyway. Valid items are stored together and each
+// account balance is updated once.
+func (u *ExpenditureUseCase) CreateBatch(
+	ctx context.Context,
+	expenditures []domain.Expenditure,
+	mode domain.BatchMode,
+	force bool,
+) (
+	*domain.ExpenditureBatchResult,
+	error,
+) {
+	err := domain.ValidateExpenditureBatch(
+		expenditures,
+		mode,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := u.activeRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.ExpenditureBatchResult{
+		Mode: mode,
+	}
+	accounts := make(map[string]*domain.Account)
+	now := time.Now()
+	var prepared []domain.ExpenditureBatchItem
+	for i := range expenditures {
+		errItem := u.prepareBatchItem(
+			ctx,
+			&expenditures[i],
+			prepared,
+			accounts,
+			rules,
+			force,
+			now,
+		)
+		if errItem == nil {
+			prepared = append(
+				prepared,
+				domain.ExpenditureBatchItem{
+					Index:       i,
+					Expenditure: expenditures[i],
+				},
+			)
+
+			continue
+		}
+		if !isExpenditureItemError(errItem) {
+			return nil, errItem
+		}
+		result.Errors = append(
+			result.Errors,
+			domain.BatchItemError{
+				Index: i,
+				Err:   errItem,
+			},
+		)
+	}
+
+	if len(prepared) == 0 || (mode == domain.BatchModeAllOrNothing && len(result.Errors) > 0) {
+		return result, nil
+	}
+
+	batch := make(
+		[]domain.Expenditure,
+		0,
+		len(prepared),
+	)
+	for _, item := range prepared {
+		batch = append(
+			batch,
+			item.Expenditure,
+		)
+	}
+	ids, err := u.expenditureRepo.CreateBatch(
+		ctx,
+		batch,
+		mode,
+	)
+	// The repository checks the balances again on the locked accounts, an item refused there is
+	// reported like the ones refused here
+	var itemErr *domain.BatchItemError
+	if errors.As(
+		err,
+		&itemErr,
+	) {
+		result.Errors = appendBatchItemError(
+			result.Errors,
+			domain.BatchItemError{
+				Index: prepared[itemErr.Index].Index,
+				Err:   itemErr.Err,
+			},
+		)
+
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for j, id := range ids {
+		if id == "" {
+			result.Errors = appendBatchItemError(
+				result.Errors,
+				domain.BatchItemError{
+					Index: prepared[j].Index,
+					Err:   domain.ErrInsufficientBalance,
+				},
+			)
+
+			continue
+		}
+		expenditure, errGet := u.expenditureRepo.GetByID(
+			ctx,
+			id,
+		)
+		if errGet != nil {
+			return nil, errGet
+		}
+		result.Created = append(
+			result.Created,
+			domain.ExpenditureBatchItem{
+				Index:       prepared[j].Index,
+				Expenditure: *expenditure,
+			},
+		)
+	}
+
+	return result, nil
+}
+
+// appendBatchItemError adds the item error, keeping the errors ordered by their index in the batch
+func appendBatchItemError(
+	itemErrors []domain.BatchItemError,
+	itemErr domain.BatchItemError,
+) []domain.BatchItemError {
+	i, _ := slices.BinarySearchFunc(
+		itemErrors,
+		itemErr.Index,
+		func(
+			e domain.BatchItemError,
+			index int,
+		) int {
+			return e.Index - index
+		},
+	)
+
+	return slices.Insert(
+		itemErrors,
+		i,
+		itemErr,
+	)
+}
+
 func (u *ExpenditureUseCase) Get(
 	ctx context.Context,
 	id string,
@@ -117,6 +342,137 @@ func (u *ExpenditureUseCase) Get(
 	return expenditure, nil
 }
 
+// Refund credits an account, by default the one the expenditure was charged to, with part or all
+// of the expenditure. The refund stays linked to it and cannot exceed what is left to refund.
+func (u *ExpenditureUseCase) Refund(
+	ctx context.Context,
+	request domain.RefundRequest,
+) (
+	*domain.Refund,
+	error,
+) {
+	expenditure, err := u.Get(
+		ctx,
+		request.ExpenditureID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if expenditure.Transaction.Status != nil && *expenditure.Transaction.Status != domain.TransactionStatusCompleted {
+		return nil, domain.ErrExpenditureNotRefundable
+	}
+
+	refunds, err := u.refundsOf(
+		ctx,
+		expenditure,
+	)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := request.RefundAmount(refunds.Remaining)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID := expenditure.Transaction.AccountID
+	if request.AccountID != nil {
+		accountID = *request.AccountID
+	}
+	account, err := u.validateAccount(
+		ctx,
+		accountID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := domain.Transaction{
+		AccountID:       accountID,
+		Amount:          amount,
+		Currency:        expenditure.Transaction.Currency,
+		TransactionDate: time.Now(),
+		Description:     "Refund: " + expenditure.Transaction.Description,
+		TransactionType: domain.TransactionTypeRefund,
+	}
+	if request.Date != nil {
+		transaction.TransactionDate = *request.Date
+	}
+	if request.Description != nil {
+		transaction.Description = *request.Description
+	}
+
+	// Refunds to an account in another currency are converted like expenditures
+	err = u.chargeInAccountCurrency(
+		ctx,
+		account,
+		&transaction,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Future-dated refunds stay pending until the poster credits them, like scheduled expenditures
+	if transaction.IsScheduled(time.Now()) {
+		transaction.Schedule()
+	}
+
+	return u.expenditureRepo.CreateRefund(
+		ctx,
+		expenditure.ID,
+		transaction,
+	)
+}
+
+// ListRefunds returns the refunds of the expenditure with what was refunded and is left to refund
+func (u *ExpenditureUseCase) ListRefunds(
+	ctx context.Context,
+	expenditureID string,
+) (
+	*domain.RefundList,
+	error,
+) {
+	expenditure, err := u.Get(
+		ctx,
+		expenditureID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.refundsOf(
+		ctx,
+		expenditure,
+	)
+}
+
+func (u *ExpenditureUseCase) refundsOf(
+	ctx context.Context,
+	expenditure *domain.Expenditure,
+) (
+	*domain.RefundList,
+	error,
+) {
+	refunds, err := u.expenditureRepo.ListRefunds(
+		ctx,
+		expenditure.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &domain.RefundList{
+		Refunds: refunds,
+	}
+	for _, refund := range refunds {
+		if refund.Counts() {
+			list.Refunded += refund.Amount()
+		}
+	}
+	list.Remaining = expenditure.Transaction.Amount - list.Refunded
+
+	return list, nil
+}
+
 func (u *ExpenditureUseCase) List(
 	ctx context.Context,
 	params domain.ExpenditureListParams,
@@ -130,6 +486,131 @@ func (u *ExpenditureUseCase) List(
 	)
 }
 
+// PostScheduled settles every pending expenditure and refund dated at or before now. Each one is
+// either debited from (credited to, for refunds) its account and completed, or marked failed with
+// the reason it could not be posted.
+func (u *ExpenditureUseCase) PostScheduled(
+	ctx context.Context,
+	now time.Time,
+) (
+	posted int,
+	failed int,
+	err error,
+) {
+	transactions, err := u.transactionRepo.ListDuePending(
+		ctx,
+		domain.TransactionTypeExpenditure,
+		now,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+	refunds, err := u.transactionRepo.ListDuePending(
+		ctx,
+		domain.TransactionTypeRefund,
+		now,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+	transactions = append(
+		transactions,
+		refunds...,
+	)
+
+	for _, transaction := range transactions {
+		ok, errPost := u.postScheduledTransaction(
+			ctx,
+			transaction,
+		)
+		if errors.Is(
+			errPost,
+			domain.ErrTransactionNotPending,
+		) {
+			// Already settled by another poster run
+			continue
+		}
+		if errPost != nil {
+			return posted, failed, errPost
+		}
+
+		if ok {
+			posted++
+		} else {
+			failed++
+		}
+	}
+
+	return posted, failed, nil
+}
+
+func (u *ExpenditureUseCase) postScheduledTransaction(
+	ctx context.Context,
+	transaction domain.Transaction,
+) (
+	bool,
+	error,
+) {
+	_, err := u.validateAccount(
+		ctx,
+		transaction.AccountID,
+	)
+	if err == nil {
+		// The balance is checked and debited with the transaction settled, against the locked account
+		err = u.transactionRepo.Post(
+			ctx,
+			transaction,
+		)
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return false, domain.ErrTransactionNotPending
+		}
+		if err == nil {
+			return true, nil
+		}
+	}
+
+	if !errors.Is(
+		err,
+		domain.ErrAccountNotFound,
+	) && !errors.Is(
+		err,
+		domain.ErrAccountInactive,
+	) && !errors.Is(
+		err,
+		domain.ErrInsufficientBalance,
+	) {
+		return false, err
+	}
+
+	transaction.Fail(err)
+
+	return false, u.settleTransaction(
+		ctx,
+		transaction,
+	)
+}
+
+func (u *ExpenditureUseCase) settleTransaction(
+	ctx context.Context,
+	transaction domain.Transaction,
+) error {
+	err := u.transactionRepo.UpdateStatus(
+		ctx,
+		transaction,
+	)
+	if errors.Is(
+		err,
+		port.ErrRecordNotFound,
+	) {
+		return domain.ErrTransactionNotPending
+	}
+
+	return err
+}
+
 func (u *ExpenditureUseCase) validateAccount(
 	ctx context.Context,
 	accountID string,
@@ -185,34 +666,403 @@ func (u *ExpenditureUseCase) validateCategory(
 	return nil
 }
 
-func (u *ExpenditureUseCase) processTransaction(
+// chargeInAccountCurrency converts a transaction made in another currency than its account, with the
+// rate it was given or else the exchange rate of its date. The original amount and currency are kept.
+func (u *ExpenditureUseCase) chargeInAccountCurrency(
 	ctx context.Context,
 	account *domain.Account,
+	transaction *domain.Transaction,
+) error {
+	if transaction.Currency == account.Currency {
+		transaction.ExchangeRate = nil
+
+		return nil
+	}
+
+	if transaction.ExchangeRate == nil {
+		rate, err := u.exchangeRateRepo.GetRate(
+			ctx,
+			transaction.Currency,
+			account.Currency,
+			transaction.TransactionDate,
+		)
+		if err != nil {
+			if errors.Is(
+				err,
+				port.ErrRecordNotFound,
+			) {
+				return domain.ErrExchangeRateNotFound
+			}
+
+			return err
+		}
+		transaction.ExchangeRate = &rate.Rate
+	}
+
+	return transaction.ChargeIn(
+		account.Currency,
+		*transaction.ExchangeRate,
+	)
+}
+
+// categorize resolves the payee of the expenditure and runs the categorization rules on it. Every
+// matching rule adds its tags; expenditures without category take the one of the first matching rule,
+// or else the default category of their payee.
+func (u *ExpenditureUseCase) categorize(
+	ctx context.Context,
 	expenditure *domain.Expenditure,
+	rules []domain.CategorizationRule,
 ) error {
-	if !account.HasSufficientBalance(expenditure.Transaction.Amount) {
-		return domain.ErrInsufficientBalance
+	payee, err := u.resolvePayee(
+		ctx,
+		expenditure,
+	)
+	if err != nil {
+		return err
 	}
 
-	account.DebitBalance(expenditure.Transaction.Amount)
-	expenditure.Transaction.BalanceAfter = &account.CurrentBalance
-	statusCompleted := domain.TransactionStatusCompleted
-	expenditure.Transaction.Status = &statusCompleted
+	hasCategory := expenditure.Category != nil && expenditure.Category.ID != ""
+	categorization := domain.Categorize(
+		rules,
+		domain.NewRuleCandidate(*expenditure),
+	)
+	if categorization != nil {
+		if !hasCategory && categorization.CategoryID != nil {
+			expenditure.Category = &domain.Category{ID: *categorization.CategoryID}
+			hasCategory = true
+		}
+		if len(categorization.AddedTagIDs) > 0 && expenditure.Tags == nil {
+			expenditure.Tags = &[]*domain.Tag{}
+		}
+		for _, tagID := range categorization.AddedTagIDs {
+			// The rule may add a tag the expenditure was given already, it is linked once
+			if slices.ContainsFunc(
+				*expenditure.Tags,
+				func(tag *domain.Tag) bool {
+					return tag.ID == tagID
+				},
+			) {
+				continue
+			}
+			*expenditure.Tags = append(
+				*expenditure.Tags,
+				&domain.Tag{
+					ID:      tagID,
+					TagType: domain.TagTypeExpenditure,
+				},
+			)
+		}
+	}
+
+	if hasCategory {
+		return nil
+	}
+	if payee == nil || payee.DefaultCategoryID == nil {
+		return domain.ErrExpenditureCategoryRequired
+	}
+	expenditure.Category = &domain.Category{ID: *payee.DefaultCategoryID}
 
-	txID, err := u.transactionRepo.Create(
+	return nil
+}
+
+// resolvePayee checks the given payee or, when there is none, looks for a payee whose name or alias
+// matches the description
+func (u *ExpenditureUseCase) resolvePayee(
+	ctx context.Context,
+	expenditure *domain.Expenditure,
+) (
+	*domain.Payee,
+	error,
+) {
+	var payee *domain.Payee
+	var err error
+	if expenditure.PayeeID != nil {
+		payee, err = u.payeeRepo.GetByID(
+			ctx,
+			*expenditure.PayeeID,
+		)
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrPayeeNotFound
+		}
+	} else if expenditure.Transaction.Description != "" {
+		payee, err = u.payeeRepo.FindByAlias(
+			ctx,
+			domain.NormalizePayeeAlias(expenditure.Transaction.Description),
+		)
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			payee, err = nil, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if payee != nil {
+		expenditure.PayeeID = &payee.ID
+	}
+
+	return payee, nil
+}
+
+// activeRules lists the active categorization rules by priority
+func (u *ExpenditureUseCase) activeRules(ctx context.Context) (
+	[]domain.CategorizationRule,
+	error,
+) {
+	active := true
+
+	return u.ruleRepo.List(
 		ctx,
-		*expenditure.Transaction,
+		domain.CategorizationRuleListParams{Active: &active},
+	)
+}
+
+// checkDuplicate looks for an expenditure of the same account, category and amount around the
+// same date with a similar description
+func (u *ExpenditureUseCase) checkDuplicate(
+	ctx context.Context,
+	expenditure domain.Expenditure,
+) error {
+	from, to := domain.DuplicateWindow(
+		expenditure.Transaction.TransactionDate,
+		u.duplicateWindowDays,
+	)
+	limit := maxDuplicateCandidates
+	offset := 0
+	candidates, err := u.expenditureRepo.FindExpenditures(
+		ctx,
+		domain.ExpenditureListParams{
+			AccountID:  &expenditure.Transaction.AccountID,
+			CategoryID: &expenditure.Category.ID,
+			Amount:     &expenditure.Transaction.Amount,
+			StartDate:  &from,
+			EndDate:    &to,
+			Limit:      &limit,
+			Offset:     &offset,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, candidate := range candidates.Expenditures {
+		if candidate.Transaction.Status != nil && *candidate.Transaction.Status == domain.TransactionStatusFailed {
+			continue
+		}
+		if domain.SimilarDescriptions(
+			candidate.Transaction.Description,
+			expenditure.Transaction.Description,
+		) {
+			return &domain.DuplicateTransactionError{
+				Type:       domain.TransactionTypeExpenditure,
+				ExistingID: candidate.ID,
+			}
+		}
+	}
+
+	return nil
+}
+
+// expenditureItemErrors are the errors that reject a single item of a batch, any other error
+// aborts the whole batch
+var expenditureItemErrors = []error{
+	domain.ErrExpenditureAccountRequired,
+	domain.ErrExpenditureCurrencyRequired,
+	domain.ErrExpenditureDescriptionRequired,
+	domain.ErrInvalidExpenditureAmount,
+	domain.ErrAccountNotFound,
+	domain.ErrAccountInactive,
+	domain.ErrCategoryNotFound,
+	domain.ErrCategoryInactive,
+	domain.ErrExpenditureCategoryRequired,
+	domain.ErrPayeeNotFound,
+	domain.ErrTagNotFound,
+	domain.ErrInsufficientBalance,
+	domain.ErrPossibleDuplicate,
+	domain.ErrExchangeRateNotFound,
+	domain.ErrInvalidExchangeRate,
+}
+
+func isExpenditureItemError(err error) bool {
+	for _, itemErr := range expenditureItemErrors {
+		if errors.Is(
+			err,
+			itemErr,
+		) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// prepareBatchItem runs the checks of Create on a batch item and settles its transaction against
+// the in-memory account, so later items of the same account see the balance left by earlier ones.
+// The item is also compared with the items prepared before it, which are not stored yet.
+func (u *ExpenditureUseCase) prepareBatchItem(
+	ctx context.Context,
+	expenditure *domain.Expenditure,
+	prepared []domain.ExpenditureBatchItem,
+	accounts map[string]*domain.Account,
+	rules []domain.CategorizationRule,
+	force bool,
+	now time.Time,
+) error {
+	// Batch items are not checked by the OpenAPI schema, so that they fail by index
+	err := expenditure.Validate()
+	if err != nil {
+		return err
+	}
+
+	account, ok := accounts[expenditure.Transaction.AccountID]
+	if !ok {
+		account, err = u.validateAccount(
+			ctx,
+			expenditure.Transaction.AccountID,
+		)
+		if err != nil {
+			return err
+		}
+		accounts[expenditure.Transaction.AccountID] = account
+	}
+
+	err = u.chargeInAccountCurrency(
+		ctx,
+		account,
+		expenditure.Transaction,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = u.categorize(
+		ctx,
+		expenditure,
+		rules,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = u.validateCategory(
+		ctx,
+		expenditure.Category.ID,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = u.validateTags(
+		ctx,
+		expenditure.Tags,
 	)
 	if err != nil {
 		return err
 	}
 
-	expenditure.Transaction.ID = &txID
+	if !force {
+		err = u.checkDuplicate(
+			ctx,
+			*expenditure,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range prepared {
+			if domain.PossibleDuplicates(
+				item.Expenditure,
+				*expenditure,
+				u.duplicateWindowDays,
+			) {
+				return &domain.DuplicateBatchItemError{Index: item.Index}
+			}
+		}
+	}
+
+	if expenditure.Transaction.IsScheduled(now) {
+		expenditure.Transaction.Schedule()
+
+		return nil
+	}
+	if !account.HasSufficientBalance(expenditure.Transaction.Amount) {
+		return domain.ErrInsufficientBalance
+	}
+	account.DebitBalance(expenditure.Transaction.Amount)
+	expenditure.Transaction.Complete(account.CurrentBalance)
+
+	return nil
+}
+
+// validateTags checks the tags exist in the household of the caller, the foreign keys alone would
+// accept the tags of another household
+func (u *ExpenditureUseCase) validateTags(
+	ctx context.Context,
+	tags *[]*domain.Tag,
+) error {
+	if tags == nil {
+		return nil
+	}
+
+	for _, tag := range *tags {
+		_, err := u.tagsRepo.GetByID(
+			ctx,
+			tag.ID,
+		)
+		if err != nil {
+			if errors.Is(
+				err,
+				port.ErrRecordNotFound,
+			) {
+				return domain.ErrTagNotFound
+			}
 
-	return u.accountRepo.Update(
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u *ExpenditureUseCase) processTransaction(
+	ctx context.Context,
+	expenditure *domain.Expenditure,
+) error {
+	// Future-dated expenditures stay pending until the scheduled poster settles them
+	if expenditure.Transaction.IsScheduled(time.Now()) {
+		expenditure.Transaction.Schedule()
+
+		txID, err := u.transactionRepo.Create(
+			ctx,
+			*expenditure.Transaction,
+		)
+		if err != nil {
+			return err
+		}
+
+		expenditure.Transaction.ID = &txID
+
+		return nil
+	}
+
+	// The balance is checked and debited on the account row locked by the repository, so concurrent
+	// debits and postings cannot overwrite each other
+	transaction, err := u.transactionRepo.Debit(
 		ctx,
-		*account,
+		*expenditure.Transaction,
 	)
+	if err != nil {
+		return err
+	}
+	expenditure.Transaction = transaction
+
+	return nil
 }
 
 func (u *ExpenditureUseCase) createExpenditureRecord(
@@ -0,0 +1,386 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+type ExpenditureRecurrenceUseCase struct {
+	patternRepo  port.ExpenditureRecurrencePatternRepo
+	expenditures *ExpenditureUseCase
+}
+
+func NewExpenditureRecurrenceUseCase(
+	patternRepo port.ExpenditureRecurrencePatternRepo,
+	expenditures *ExpenditureUseCase,
+) *ExpenditureRecurrenceUseCase {
+	return &ExpenditureRecurrenceUseCase{
+		patternRepo:  patternRepo,
+		expenditures: expenditures,
+	}
+}
+
+func (u *ExpenditureRecurrenceUseCase) Create(
+	ctx context.Context,
+	pattern domain.ExpenditureRecurrencePattern,
+) (
+	*domain.ExpenditureRecurrencePattern,
+	error,
+) {
+	err := u.validate(
+		ctx,
+		pattern,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pattern.NextDueDate = pattern.StartDate
+	pattern.Active = true
+
+	id, err := u.patternRepo.Create(
+		ctx,
+		pattern,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.patternRepo.GetByID(
+		ctx,
+		id,
+	)
+}
+
+func (u *ExpenditureRecurrenceUseCase) Get(
+	ctx context.Context,
+	id string,
+) (
+	*domain.ExpenditureRecurrencePattern,
+	error,
+) {
+	pattern, err := u.patternRepo.GetByID(
+		ctx,
+		id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrExpenditureRecurrencePatternNotFound
+		}
+
+		return nil, err
+	}
+
+	return pattern, nil
+}
+
+func (u *ExpenditureRecurrenceUseCase) List(
+	ctx context.Context,
+	params domain.ExpenditureRecurrencePatternListParams,
+) (
+	[]domain.ExpenditureRecurrencePattern,
+	error,
+) {
+	return u.patternRepo.List(
+		ctx,
+		params,
+	)
+}
+
+// Update replaces the pattern definition. Moving the start date restarts the schedule from it,
+// otherwise the pattern keeps its next due date.
+func (u *ExpenditureRecurrenceUseCase) Update(
+	ctx context.Context,
+	pattern domain.ExpenditureRecurrencePattern,
+) (
+	*domain.ExpenditureRecurrencePattern,
+	error,
+) {
+	current, err := u.Get(
+		ctx,
+		pattern.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.validate(
+		ctx,
+		pattern,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pattern.NextDueDate = current.NextDueDate
+	if !pattern.StartDate.Equal(current.StartDate) {
+		pattern.NextDueDate = pattern.StartDate
+	}
+
+	err = u.patternRepo.Update(
+		ctx,
+		pattern,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.patternRepo.GetByID(
+		ctx,
+		pattern.ID,
+	)
+}
+
+func (u *ExpenditureRecurrenceUseCase) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	err := u.patternRepo.Delete(
+		ctx,
+		id,
+	)
+	if errors.Is(
+		err,
+		port.ErrRecordNotFound,
+	) {
+		return domain.ErrExpenditureRecurrencePatternNotFound
+	}
+
+	return err
+}
+
+// Upcoming lists the bills of the active patterns that fall due between from and until
+func (u *ExpenditureRecurrenceUseCase) Upcoming(
+	ctx context.Context,
+	from time.Time,
+	until time.Time,
+) (
+	[]domain.UpcomingBill,
+	error,
+) {
+	if until.Before(from) {
+		return nil, domain.ErrInvalidUpcomingBillsRange
+	}
+
+	active := true
+	patterns, err := u.patternRepo.List(
+		ctx,
+		domain.ExpenditureRecurrencePatternListParams{
+			Active:    &active,
+			DueBefore: &until,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.UpcomingBills(
+		patterns,
+		from,
+		until,
+	), nil
+}
+
+// Post confirms the next occurrence of the pattern and records it as an expenditure. The amount
+// overrides the pattern amount when the actual bill differs from the estimation.
+func (u *ExpenditureRecurrenceUseCase) Post(
+	ctx context.Context,
+	id string,
+	amount *float32,
+) (
+	*domain.Expenditure,
+	error,
+) {
+	pattern, err := u.Get(
+		ctx,
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount != nil && *amount <= 0 {
+		return nil, domain.ErrInvalidRecurrenceAmount
+	}
+
+	return u.postOccurrence(
+		ctx,
+		*pattern,
+		amount,
+	)
+}
+
+// GenerateDue posts every occurrence already due of the auto-post patterns. Patterns whose
+// expenditure can't be created (e.g. insufficient balance) are left due for manual confirmation.
+func (u *ExpenditureRecurrenceUseCase) GenerateDue(
+	ctx context.Context,
+	now time.Time,
+) (
+	generated int,
+	skipped int,
+	err error,
+) {
+	active := true
+	autoPost := true
+	patterns, err := u.patternRepo.List(
+		ctx,
+		domain.ExpenditureRecurrencePatternListParams{
+			Active:    &active,
+			AutoPost:  &autoPost,
+			DueBefore: &now,
+		},
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, pattern := range patterns {
+		for pattern.Active && !pattern.NextDueDate.After(now) {
+			_, errPost := u.postOccurrence(
+				ctx,
+				pattern,
+				nil,
+			)
+			if errPost != nil {
+				if isUnexpectedPostingError(errPost) {
+					return generated, skipped, errPost
+				}
+				skipped++
+
+				break
+			}
+			generated++
+
+			pattern.AdvanceNextDueDate()
+			pattern.Active = !pattern.IsFinished()
+		}
+	}
+
+	return generated, skipped, nil
+}
+
+func (u *ExpenditureRecurrenceUseCase) postOccurrence(
+	ctx context.Context,
+	pattern domain.ExpenditureRecurrencePattern,
+	amount *float32,
+) (
+	*domain.Expenditure,
+	error,
+) {
+	if !pattern.Active {
+		return nil, domain.ErrRecurrencePatternInactive
+	}
+	if pattern.IsFinished() {
+		return nil, domain.ErrRecurrencePatternFinished
+	}
+
+	account, err := u.expenditures.validateAccount(
+		ctx,
+		pattern.AccountID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	expenditure := pattern.NewExpenditure(
+		account.Currency,
+		amount,
+	)
+
+	// Claim the occurrence first so concurrent posters can't record the same bill twice
+	dueDate := pattern.NextDueDate
+	pattern.AdvanceNextDueDate()
+	pattern.Active = !pattern.IsFinished()
+	err = u.patternRepo.AdvanceNextDueDate(
+		ctx,
+		pattern,
+		dueDate,
+	)
+	if errors.Is(
+		err,
+		port.ErrRecordNotFound,
+	) {
+		return nil, domain.ErrRecurrencePatternInactive
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Bills repeat on purpose, so the occurrences are never considered duplicates
+	created, err := u.expenditures.Create(
+		ctx,
+		expenditure,
+		true,
+	)
+	if err != nil {
+		// Release the occurrence so it can be confirmed again
+		claimedDueDate := pattern.NextDueDate
+		pattern.NextDueDate = dueDate
+		pattern.Active = true
+		if errRelease := u.patternRepo.AdvanceNextDueDate(
+			ctx,
+			pattern,
+			claimedDueDate,
+		); errRelease != nil {
+			return nil, errors.Join(
+				err,
+				errRelease,
+			)
+		}
+
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (u *ExpenditureRecurrenceUseCase) validate(
+	ctx context.Context,
+	pattern domain.ExpenditureRecurrencePattern,
+) error {
+	err := pattern.Validate()
+	if err != nil {
+		return err
+	}
+
+	_, err = u.expenditures.validateAccount(
+		ctx,
+		pattern.AccountID,
+	)
+	if err != nil {
+		return err
+	}
+
+	return u.expenditures.validateCategory(
+		ctx,
+		pattern.CategoryID,
+	)
+}
+
+func isUnexpectedPostingError(err error) bool {
+	for _, expected := range []error{
+		domain.ErrAccountNotFound,
+		domain.ErrAccountInactive,
+		domain.ErrInsufficientBalance,
+		domain.ErrCategoryNotFound,
+		domain.ErrCategoryInactive,
+		domain.ErrRecurrencePatternInactive,
+		domain.ErrRecurrencePatternFinished,
+	} {
+		if errors.Is(
+			err,
+			expected,
+		) {
+			return false
+		}
+	}
+
+	return true
+}
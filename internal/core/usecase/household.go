@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+// adminMemberRole is the role of the members created for the users founding a household or
+// bootstrapped as its admins
+const adminMemberRole = "member"
+
+type HouseholdUseCase struct {
+	householdRepo port.HouseholdRepo
+	authRepo      port.AuthRepo
+}
+
+func NewHouseholdUseCase(
+	householdRepo port.HouseholdRepo,
+	authRepo port.AuthRepo,
+) *HouseholdUseCase {
+	return &HouseholdUseCase{
+		householdRepo: householdRepo,
+		authRepo:      authRepo,
+	}
+}
+
+// CreateHousehold creates a household founded by the user of the context, who must not be linked to a
+// member yet. The founder joins it as a member named after them and becomes its admin.
+func (u *HouseholdUseCase) CreateHousehold(
+	ctx context.Context,
+	household domain.Household,
+) (
+	*domain.Household,
+	error,
+) {
+	user, ok := domain.UserFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrUnauthenticated
+	}
+	if user.HouseholdMemberID != nil {
+		return nil, domain.ErrUserAlreadyLinked
+	}
+	if err := household.Validate(); err != nil {
+		return nil, err
+	}
+
+	id, err := u.householdRepo.Create(
+		ctx,
+		household,
+		adminMember(*user),
+		user.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.GetHousehold(
+		ctx,
+		id,
+	)
+}
+
+func (u *HouseholdUseCase) GetHousehold(
+	ctx context.Context,
+	id string,
+) (
+	*domain.Household,
+	error,
+) {
+	household, err := u.householdRepo.GetByID(
+		ctx,
+		id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrHouseholdNotFound
+		}
+
+		return nil, err
+	}
+
+	return household, nil
+}
+
+// BootstrapAdmin makes the registered user of the email an admin of an existing household, through a
+// new member named after them. It is run by the operators of the instance to give a household its
+// first admin, e.g. the default household, since users never become admins by linking themselves.
+func (u *HouseholdUseCase) BootstrapAdmin(
+	ctx context.Context,
+	id string,
+	email string,
+) error {
+	user, err := u.authRepo.GetUserByEmail(
+		ctx,
+		email,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return domain.ErrUserNotFound
+		}
+
+		return err
+	}
+	if user.HouseholdMemberID != nil {
+		return domain.ErrUserAlreadyLinked
+	}
+
+	_, err = u.GetHousehold(
+		ctx,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+
+	return u.householdRepo.AddAdmin(
+		ctx,
+		id,
+		adminMember(*user),
+		user.ID,
+	)
+}
+
+// adminMember is the member created for a user joining a household as its admin
+func adminMember(user domain.User) domain.HouseholdMember {
+	return domain.HouseholdMember{
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Role:      adminMemberRole,
+		Active:    true,
+	}
+}
+
+// CurrentHousehold returns the household the context is scoped to
+func (u *HouseholdUseCase) CurrentHousehold(ctx context.Context) (
+	*domain.Household,
+	error,
+) {
+	id, ok := domain.HouseholdIDFromContext(ctx)
+	if !ok {
+		return nil, domain.ErrHouseholdRequired
+	}
+
+	return u.GetHousehold(
+		ctx,
+		id,
+	)
+}
+
+func (u *HouseholdUseCase) ListHouseholds(ctx context.Context) (
+	[]domain.Household,
+	error,
+) {
+	return u.householdRepo.List(ctx)
+}
@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+type InstallmentPlanUseCase struct {
+	planRepo     port.InstallmentPlanRepo
+	expenditures *ExpenditureUseCase
+}
+
+func NewInstallmentPlanUseCase(
+	planRepo port.InstallmentPlanRepo,
+	expenditures *ExpenditureUseCase,
+) *InstallmentPlanUseCase {
+	return &InstallmentPlanUseCase{
+		planRepo:     planRepo,
+		expenditures: expenditures,
+	}
+}
+
+// Create records the plan and one expenditure per installment. Installments due in the future stay
+// pending until the scheduled poster charges them, the ones already due are charged right away.
+func (u *InstallmentPlanUseCase) Create(
+	ctx context.Context,
+	plan domain.InstallmentPlan,
+) (
+	*domain.InstallmentPlan,
+	error,
+) {
+	err := plan.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := u.expenditures.validateAccount(
+		ctx,
+		plan.AccountID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.expenditures.validateCategory(
+		ctx,
+		plan.CategoryID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.ID, err = u.planRepo.Create(
+		ctx,
+		plan,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Installments repeat on purpose, so they are never considered duplicates
+	result, err := u.expenditures.CreateBatch(
+		ctx,
+		plan.NewExpenditures(account.Currency),
+		domain.BatchModeAllOrNothing,
+		true,
+	)
+	if err == nil && len(result.Errors) > 0 {
+		err = result.Errors[0].Err
+	}
+	if err != nil {
+		// Nothing was charged, so the plan must not be left without its installments
+		if errDelete := u.planRepo.Delete(
+			ctx,
+			plan.ID,
+		); errDelete != nil {
+			return nil, errors.Join(
+				err,
+				errDelete,
+			)
+		}
+
+		return nil, err
+	}
+
+	return u.Get(
+		ctx,
+		plan.ID,
+	)
+}
+
+func (u *InstallmentPlanUseCase) Get(
+	ctx context.Context,
+	id string,
+) (
+	*domain.InstallmentPlan,
+	error,
+) {
+	plan, err := u.planRepo.GetByID(
+		ctx,
+		id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrInstallmentPlanNotFound
+		}
+
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (u *InstallmentPlanUseCase) List(
+	ctx context.Context,
+	params domain.InstallmentPlanListParams,
+) (
+	[]domain.InstallmentPlan,
+	error,
+) {
+	return u.planRepo.List(
+		ctx,
+		params,
+	)
+}
+
+// Obligations returns what is still owed on installment plans per account and its owner
+func (u *InstallmentPlanUseCase) Obligations(
+	ctx context.Context,
+	params domain.InstallmentObligationParams,
+) (
+	[]domain.InstallmentObligation,
+	error,
+) {
+	return u.planRepo.Obligations(
+		ctx,
+		params,
+	)
+}
+
+// PayOff charges every unpaid installment of the plan now, the pending ones and those the poster
+// failed to charge. The account must cover all of them, otherwise none is charged.
+func (u *InstallmentPlanUseCase) PayOff(
+	ctx context.Context,
+	id string,
+	now time.Time,
+) (
+	*domain.InstallmentPlan,
+	error,
+) {
+	plan, err := u.Get(
+		ctx,
+		id,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	unpaid := plan.UnpaidCharges()
+	if len(unpaid) == 0 {
+		return nil, domain.ErrInstallmentPlanPaidOff
+	}
+
+	_, err = u.expenditures.validateAccount(
+		ctx,
+		plan.AccountID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make(
+		[]domain.Transaction,
+		0,
+		len(unpaid),
+	)
+	for _, charge := range unpaid {
+		transactionID := charge.TransactionID
+		transaction := domain.Transaction{
+			ID:     &transactionID,
+			Amount: charge.Amount,
+		}
+		transactions = append(
+			transactions,
+			transaction,
+		)
+	}
+
+	// The repository checks that the account covers every charge on its locked row and computes the
+	// balances after them there, as it may have been debited since
+	err = u.planRepo.PayOff(
+		ctx,
+		plan.AccountID,
+		transactions,
+		now,
+	)
+	if errors.Is(
+		err,
+		port.ErrRecordNotFound,
+	) {
+		// The scheduled poster charged one of them meanwhile
+		return nil, domain.ErrInstallmentPlanChanged
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return u.Get(
+		ctx,
+		plan.ID,
+	)
+}
@@ -0,0 +1,357 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+// invitationTokenBytes is the length of the random invitation tokens, hex encoded when sent
+const invitationTokenBytes = 32
+
+// invitedMemberRole is the role of the members created for the invitees
+const invitedMemberRole = "member"
+
+type InvitationUseCase struct {
+	invitationRepo       port.InvitationRepo
+	authRepo             port.AuthRepo
+	householdMembersRepo port.HouseholdMembersRepo
+	mailer               port.Mailer
+	ttl                  time.Duration
+}
+
+func NewInvitationUseCase(
+	invitationRepo port.InvitationRepo,
+	authRepo port.AuthRepo,
+	householdMembersRepo port.HouseholdMembersRepo,
+	mailer port.Mailer,
+	ttl time.Duration,
+) *InvitationUseCase {
+	return &InvitationUseCase{
+		invitationRepo:       invitationRepo,
+		authRepo:             authRepo,
+		householdMembersRepo: householdMembersRepo,
+		mailer:               mailer,
+		ttl:                  ttl,
+	}
+}
+
+// Invite invites the email to the household of the inviter, who must be linked to one of its members.
+// The email may belong to a user not linked to any member yet, who joins instead of accepting. The
+// invited member, when given, must not be linked to a user yet. Invitees join as members, admins
+// change their roles afterwards.
+// The one-time token is only sent by mail, just its hash is stored.
+func (u *InvitationUseCase) Invite(
+	ctx context.Context,
+	inviter domain.User,
+	invitation domain.Invitation,
+) (
+	*domain.Invitation,
+	error,
+) {
+	if inviter.HouseholdMemberID == nil {
+		return nil, domain.ErrUserNotLinked
+	}
+	invitation.Email = strings.TrimSpace(invitation.Email)
+	if err := invitation.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Users who registered on their own join through the invitation, those of a household cannot
+	existing, err := u.authRepo.GetUserByEmail(
+		ctx,
+		invitation.Email,
+	)
+	if err == nil {
+		if existing.HouseholdMemberID != nil {
+			return nil, domain.ErrUserEmailTaken
+		}
+	} else if !errors.Is(
+		err,
+		port.ErrRecordNotFound,
+	) {
+		return nil, err
+	}
+
+	if invitation.MemberID != nil {
+		_, err = u.householdMembersRepo.GetByID(
+			ctx,
+			*invitation.MemberID,
+		)
+		if err != nil {
+			if errors.Is(
+				err,
+				port.ErrRecordNotFound,
+			) {
+				return nil, domain.ErrMemberNotFound
+			}
+
+			return nil, err
+		}
+
+		// Accepting would fail anyway, the inviter learns it now rather than the invitee when signing up
+		_, err = u.authRepo.GetUserByMemberID(
+			ctx,
+			*invitation.MemberID,
+		)
+		if err == nil {
+			return nil, domain.ErrMemberAlreadyLinked
+		} else if !errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, err
+		}
+	}
+
+	token, err := newInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+	invitation.InvitedBy = inviter.ID
+	invitation.ExpiresAt = time.Now().Add(u.ttl)
+	invitation.ID, err = u.invitationRepo.Create(
+		ctx,
+		invitation,
+		hashInvitationToken(token),
+	)
+	if err != nil {
+		return nil, err
+	}
+	invitation.HouseholdID, _ = domain.HouseholdIDFromContext(ctx)
+
+	err = u.mailer.Send(
+		ctx,
+		domain.Mail{
+			To:      invitation.Email,
+			Subject: "You are invited to join a household budget",
+			Body: fmt.Sprintf(
+				"%s %s invited you to join their household budget. Accept the invitation with this token before %s: %s",
+				inviter.FirstName,
+				inviter.LastName,
+				invitation.ExpiresAt.UTC().Format(time.RFC1123),
+				token,
+			),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to send invitation: %w",
+			err,
+		)
+	}
+
+	return &invitation, nil
+}
+
+// Accept uses the token of an invitation to create the invitee's user, a member of the household linked
+// to the invited member or to a new member named after the invitee, and logs it in
+func (u *InvitationUseCase) Accept(
+	ctx context.Context,
+	acceptance domain.InvitationAcceptance,
+) (
+	*domain.AuthToken,
+	*domain.User,
+	error,
+) {
+	invitation, err := u.invitationRepo.GetByTokenHash(
+		ctx,
+		hashInvitationToken(acceptance.Token),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, nil, domain.ErrInvitationNotFound
+		}
+
+		return nil, nil, err
+	}
+	if err = invitation.Usable(time.Now()); err != nil {
+		return nil, nil, err
+	}
+
+	user := domain.User{
+		Email:     invitation.Email,
+		FirstName: acceptance.FirstName,
+		LastName:  acceptance.LastName,
+		Roles:     []string{domain.RoleMember},
+	}
+	if err = user.Validate(acceptance.Password); err != nil {
+		return nil, nil, err
+	}
+	user.Password, err = hashPassword(acceptance.Password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var newMember *domain.HouseholdMember
+	if invitation.MemberID == nil {
+		newMember = &domain.HouseholdMember{
+			FirstName: acceptance.FirstName,
+			LastName:  acceptance.LastName,
+			Role:      invitedMemberRole,
+			Active:    true,
+		}
+	}
+
+	userID, err := u.invitationRepo.Accept(
+		ctx,
+		*invitation,
+		user,
+		newMember,
+	)
+	if err != nil {
+		// The email was free when inviting, so the user that now holds it was registered since
+		if errors.Is(
+			err,
+			port.ErrDuplicateKey,
+		) {
+			return nil, nil, u.duplicateUserError(
+				ctx,
+				invitation.Email,
+			)
+		}
+
+		return nil, nil, err
+	}
+
+	token, err := u.authRepo.CreateToken(
+		ctx,
+		userID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	created, err := u.authRepo.GetUserByID(
+		ctx,
+		userID,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return token, created, nil
+}
+
+// Join uses the token of an invitation sent to the email of the user, who registered on their own and
+// is not linked to any member yet, to link it to the invited member or to a new member named after it
+func (u *InvitationUseCase) Join(
+	ctx context.Context,
+	user domain.User,
+	token string,
+) (
+	*domain.User,
+	error,
+) {
+	if user.HouseholdMemberID != nil {
+		return nil, domain.ErrUserAlreadyLinked
+	}
+
+	invitation, err := u.invitationRepo.GetByTokenHash(
+		ctx,
+		hashInvitationToken(token),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrInvitationNotFound
+		}
+
+		return nil, err
+	}
+	if err = invitation.Usable(time.Now()); err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(
+		invitation.Email,
+		user.Email,
+	) {
+		return nil, domain.ErrInvitationForOther
+	}
+
+	var newMember *domain.HouseholdMember
+	if invitation.MemberID == nil {
+		newMember = &domain.HouseholdMember{
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+			Role:      invitedMemberRole,
+			Active:    true,
+		}
+	}
+
+	err = u.invitationRepo.Join(
+		ctx,
+		*invitation,
+		user.ID,
+		[]string{domain.RoleMember},
+		newMember,
+	)
+	if err != nil {
+		// The invited member was free when inviting, another user was linked to it since
+		if errors.Is(
+			err,
+			port.ErrDuplicateKey,
+		) {
+			return nil, domain.ErrMemberAlreadyLinked
+		}
+
+		return nil, err
+	}
+
+	return u.authRepo.GetUserByID(
+		ctx,
+		user.ID,
+	)
+}
+
+// duplicateUserError tells apart the two unique keys of users the acceptance can break
+func (u *InvitationUseCase) duplicateUserError(
+	ctx context.Context,
+	email string,
+) error {
+	_, err := u.authRepo.GetUserByEmail(
+		ctx,
+		email,
+	)
+	if err == nil {
+		return domain.ErrUserEmailTaken
+	}
+
+	return domain.ErrMemberAlreadyLinked
+}
+
+func newInvitationToken() (
+	string,
+	error,
+) {
+	token := make(
+		[]byte,
+		invitationTokenBytes,
+	)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf(
+			"failed to generate invitation token: %w",
+			err,
+		)
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+func hashInvitationToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+type PayeeUseCase struct {
+	payeeRepo port.PayeeRepo
+}
+
+func NewPayeeUseCase(payeeRepo port.PayeeRepo) *PayeeUseCase {
+	return &PayeeUseCase{
+		payeeRepo: payeeRepo,
+	}
+}
+
+func (u *PayeeUseCase) Create(
+	ctx context.Context,
+	payee domain.Payee,
+) (
+	*domain.Payee,
+	error,
+) {
+	err := u.validate(
+		ctx,
+		&payee,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := u.payeeRepo.Create(
+		ctx,
+		payee,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrDuplicateKey,
+		) {
+			return nil, domain.ErrPayeeAlreadyExists
+		}
+
+		return nil, err
+	}
+
+	return u.payeeRepo.GetByID(
+		ctx,
+		id,
+	)
+}
+
+func (u *PayeeUseCase) Get(
+	ctx context.Context,
+	id string,
+) (
+	*domain.Payee,
+	error,
+) {
+	payee, err := u.payeeRepo.GetByID(
+		ctx,
+		id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrPayeeNotFound
+		}
+
+		return nil, err
+	}
+
+	return payee, nil
+}
+
+func (u *PayeeUseCase) List(
+	ctx context.Context,
+	params domain.PayeeListParams,
+) (
+	[]domain.Payee,
+	error,
+) {
+	return u.payeeRepo.List(
+		ctx,
+		params,
+	)
+}
+
+// Update replaces the payee name, default category and aliases
+func (u *PayeeUseCase) Update(
+	ctx context.Context,
+	payee domain.Payee,
+) (
+	*domain.Payee,
+	error,
+) {
+	_, err := u.Get(
+		ctx,
+		payee.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.validate(
+		ctx,
+		&payee,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.payeeRepo.Update(
+		ctx,
+		payee,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrPayeeNotFound
+		}
+		if errors.Is(
+			err,
+			port.ErrDuplicateKey,
+		) {
+			return nil, domain.ErrPayeeAlreadyExists
+		}
+
+		return nil, err
+	}
+
+	return u.payeeRepo.GetByID(
+		ctx,
+		payee.ID,
+	)
+}
+
+// Delete removes the payee, its expenditures and ingresses are kept without payee
+func (u *PayeeUseCase) Delete(
+	ctx context.Context,
+	id string,
+) error {
+	err := u.payeeRepo.Delete(
+		ctx,
+		id,
+	)
+	if errors.Is(
+		err,
+		port.ErrRecordNotFound,
+	) {
+		return domain.ErrPayeeNotFound
+	}
+
+	return err
+}
+
+// validate normalizes the payee and makes sure its name and aliases don't match another payee,
+// otherwise descriptions could no longer be resolved to a single payee
+func (u *PayeeUseCase) validate(
+	ctx context.Context,
+	payee *domain.Payee,
+) error {
+	payee.Normalize()
+	err := payee.Validate()
+	if err != nil {
+		return err
+	}
+
+	names := append(
+		[]string{payee.Name},
+		payee.Aliases...,
+	)
+	for _, name := range names {
+		existing, errFind := u.payeeRepo.FindByAlias(
+			ctx,
+			name,
+		)
+		if errors.Is(
+			errFind,
+			port.ErrRecordNotFound,
+		) {
+			continue
+		}
+		if errFind != nil {
+			return errFind
+		}
+		if existing.ID != payee.ID {
+			return domain.ErrPayeeAlreadyExists
+		}
+	}
+
+	return nil
+}
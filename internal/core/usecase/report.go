@@ -0,0 +1,289 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+type ReportUseCase struct {
+	reportRepo port.ReportRepo
+	tagsRepo   port.TagsRepo
+}
+
+func NewReportUseCase(
+	reportRepo port.ReportRepo,
+	tagsRepo port.TagsRepo,
+) *ReportUseCase {
+	return &ReportUseCase{
+		reportRepo: reportRepo,
+		tagsRepo:   tagsRepo,
+	}
+}
+
+// PlannedSpending compares planned and unplanned spending per period and category over the
+// last periods, so the household can see where impulse or emergency spending comes from
+func (u *ReportUseCase) PlannedSpending(
+	ctx context.Context,
+	params domain.PlannedSpendingParams,
+) (
+	*domain.PlannedSpendingReport,
+	error,
+) {
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := params.Range()
+	entries, err := u.reportRepo.PlannedSpending(
+		ctx,
+		params,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewPlannedSpendingReport(
+		params,
+		entries,
+	), nil
+}
+
+// DeclaredExpenses lists the expenditures declared for tax purposes in a fiscal year, grouped by
+// category, tag and household member
+func (u *ReportUseCase) DeclaredExpenses(
+	ctx context.Context,
+	params domain.DeclaredExpensesParams,
+) (
+	*domain.DeclaredExpensesReport,
+	error,
+) {
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := params.Range()
+	expenses, err := u.reportRepo.DeclaredExpenses(
+		ctx,
+		params,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewDeclaredExpensesReport(
+		params,
+		expenses,
+	), nil
+}
+
+// PayeeSpending sums the spending per payee in a date range
+func (u *ReportUseCase) PayeeSpending(
+	ctx context.Context,
+	params domain.PayeeSpendingParams,
+) (
+	*domain.PayeeSpendingReport,
+	error,
+) {
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := params.Range()
+	entries, err := u.reportRepo.PayeeSpending(
+		ctx,
+		params,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewPayeeSpendingReport(
+		params,
+		entries,
+	), nil
+}
+
+// TagSpending sums the expenditures and ingresses per tag in a date range
+func (u *ReportUseCase) TagSpending(
+	ctx context.Context,
+	params domain.TagSpendingParams,
+) (
+	*domain.TagSpendingReport,
+	error,
+) {
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := params.Range()
+	entries, err := u.reportRepo.TagSpending(
+		ctx,
+		params,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewTagSpendingReport(
+		params,
+		entries,
+	), nil
+}
+
+// TagCombination sums the records linked to all of the tags or to any of them in a date range. The
+// tags must all tag expenditures or all tag ingresses.
+func (u *ReportUseCase) TagCombination(
+	ctx context.Context,
+	params domain.TagCombinationParams,
+) (
+	*domain.TagCombinationReport,
+	error,
+) {
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	tagIDs := make(
+		[]string,
+		0,
+		len(params.TagIDs),
+	)
+	tags := make(
+		[]domain.Tag,
+		0,
+		len(params.TagIDs),
+	)
+	seen := make(map[string]bool)
+	for _, tagID := range params.TagIDs {
+		if seen[tagID] {
+			continue
+		}
+		seen[tagID] = true
+
+		tag, errTag := u.reportableTag(
+			ctx,
+			tagID,
+		)
+		if errTag != nil {
+			return nil, errTag
+		}
+		if len(tags) > 0 && tag.TagType != tags[0].TagType {
+			return nil, domain.ErrTagReportTypeMismatch
+		}
+		tagIDs = append(
+			tagIDs,
+			tagID,
+		)
+		tags = append(
+			tags,
+			*tag,
+		)
+	}
+	params.TagIDs = tagIDs
+
+	from, to := params.Range()
+	total, count, err := u.reportRepo.TagCombination(
+		ctx,
+		params,
+		tags[0].TagType,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TagCombinationReport{
+		Currency: params.Currency,
+		From:     params.From,
+		To:       params.To,
+		Tags:     tags,
+		Match:    params.Match,
+		Total:    total,
+		Count:    count,
+	}, nil
+}
+
+// TagCategorySpending breaks the records linked to a tag in a date range down by category
+func (u *ReportUseCase) TagCategorySpending(
+	ctx context.Context,
+	params domain.TagCategoryParams,
+) (
+	*domain.TagCategoryReport,
+	error,
+) {
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := u.reportableTag(
+		ctx,
+		params.TagID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := params.Range()
+	entries, err := u.reportRepo.TagCategorySpending(
+		ctx,
+		params,
+		tag.TagType,
+		from,
+		to,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewTagCategoryReport(
+		params,
+		*tag,
+		entries,
+	), nil
+}
+
+func (u *ReportUseCase) reportableTag(
+	ctx context.Context,
+	tagID string,
+) (
+	*domain.Tag,
+	error,
+) {
+	tag, err := u.tagsRepo.GetByID(
+		ctx,
+		tagID,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrTagNotFound
+		}
+
+		return nil, err
+	}
+	if !tag.TagType.IsReportable() {
+		return nil, domain.ErrTagReportUnsupportedTag
+	}
+
+	return tag, nil
+}
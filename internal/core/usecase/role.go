@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+type RoleUseCase struct {
+	roleRepo port.RoleRepo
+	authRepo port.AuthRepo
+}
+
+func NewRoleUseCase(
+	roleRepo port.RoleRepo,
+	authRepo port.AuthRepo,
+) *RoleUseCase {
+	return &RoleUseCase{
+		roleRepo: roleRepo,
+		authRepo: authRepo,
+	}
+}
+
+// ListRoles lists the roles with the permissions each one grants
+func (u *RoleUseCase) ListRoles(ctx context.Context) (
+	[]domain.Role,
+	error,
+) {
+	roles, err := u.roleRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range roles {
+		roles[i].Permissions = domain.RolePermissions(roles[i].Name)
+	}
+
+	return roles, nil
+}
+
+// ListUsers lists the users of the household of the context with their roles
+func (u *RoleUseCase) ListUsers(ctx context.Context) (
+	[]domain.User,
+	error,
+) {
+	return u.authRepo.ListUsers(ctx)
+}
+
+// SetUserRoles replaces the roles of a user of the household of the context, the household keeps at
+// least one admin
+func (u *RoleUseCase) SetUserRoles(
+	ctx context.Context,
+	userID string,
+	roles []string,
+) (
+	*domain.User,
+	error,
+) {
+	if err := domain.ValidateRoles(roles); err != nil {
+		return nil, err
+	}
+
+	err := u.roleRepo.SetUserRoles(
+		ctx,
+		userID,
+		domain.UniqueRoles(roles),
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrUserNotFound
+		}
+
+		return nil, err
+	}
+
+	return u.authRepo.GetUserByID(
+		ctx,
+		userID,
+	)
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
+	"ghorkov32/proletariat-budget-be/internal/core/port"
+)
+
+type SearchUseCase struct {
+	searchRepo port.SearchRepo
+}
+
+func NewSearchUseCase(searchRepo port.SearchRepo) *SearchUseCase {
+	return &SearchUseCase{searchRepo: searchRepo}
+}
+
+// Search runs a ranked full-text search across expenditures, ingresses and transfers
+// and highlights the words of the query in every matching field of each hit
+func (u *SearchUseCase) Search(
+	ctx context.Context,
+	params domain.SearchParams,
+) (
+	*domain.SearchResult,
+	error,
+) {
+	params.Query = strings.TrimSpace(params.Query)
+	terms := domain.SearchTerms(params.Query)
+	if len(terms) == 0 {
+		return nil, domain.ErrInvalidSearchQuery
+	}
+
+	for _, hitType := range params.Types {
+		if !hitType.IsValid() {
+			return nil, domain.ErrInvalidSearchType
+		}
+	}
+
+	result, err := u.searchRepo.Search(
+		ctx,
+		params,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	highlighter := domain.NewSearchHighlighter(terms)
+	for i := range result.Hits {
+		result.Hits[i].Highlight(highlighter)
+	}
+
+	return result, nil
+}
@@ -91,3 +91,342 @@ func (u *TagsUseCase) DeleteTag(
 
 	return nil
 }
+
+// UpdateTag renames or restyles a tag, its type cannot change as it decides what the tag can be linked to
+func (u *TagsUseCase) UpdateTag(
+	ctx context.Context,
+	id string,
+	tag *domain.Tag,
+) (
+	*domain.Tag,
+	error,
+) {
+	if err := tag.Validate(); err != nil {
+		return nil, err
+	}
+	existing, err := u.tagsRepo.GetByID(
+		ctx,
+		id,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrTagNotFound
+		}
+
+		return nil, err
+	}
+	if existing.TagType != tag.TagType {
+		return nil, domain.ErrTagTypeChange
+	}
+
+	err = u.tagsRepo.Update(
+		ctx,
+		id,
+		*tag,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrDuplicateKey,
+		) {
+			return nil, domain.ErrTagAlreadyExists
+		}
+
+		return nil, err
+	}
+	tag.ID = id
+
+	return tag, nil
+}
+
+// MergeTag moves every record tagged with the source tag to the target one, which must have the same
+// type, and deletes the source
+func (u *TagsUseCase) MergeTag(
+	ctx context.Context,
+	sourceID string,
+	targetID string,
+) (
+	*domain.TagMergeResult,
+	error,
+) {
+	if sourceID == targetID {
+		return nil, domain.ErrTagMergeSameTag
+	}
+
+	source, err := u.tagsRepo.GetByID(
+		ctx,
+		sourceID,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrTagNotFound
+		}
+
+		return nil, err
+	}
+	target, err := u.tagsRepo.GetByID(
+		ctx,
+		targetID,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrTagMergeTargetNotFound
+		}
+
+		return nil, err
+	}
+	if source.TagType != target.TagType {
+		return nil, domain.ErrTagMergeTypeMismatch
+	}
+
+	moved, err := u.tagsRepo.Merge(
+		ctx,
+		sourceID,
+		targetID,
+	)
+	if err != nil {
+		if errors.Is(
+			err,
+			port.ErrRecordNotFound,
+		) {
+			return nil, domain.ErrTagNotFound
+		}
+
+		return nil, err
+	}
+
+	return &domain.TagMergeResult{
+		Target: *target,
+		Moved:  moved,
+	}, nil
+}
+
+// ListRecordTags lists the tags linked to the record of the tag type
+func (u *TagsUseCase) ListRecordTags(
+	ctx context.Context,
+	tagType domain.TagType,
+	recordID string,
+) (
+	[]*domain.Tag,
+	error,
+) {
+	err := u.validateRecord(
+		ctx,
+		tagType,
+		recordID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.recordTags(
+		ctx,
+		tagType,
+		recordID,
+	)
+}
+
+// SetRecordTags replaces the tags of a record, an empty list removes all of them
+func (u *TagsUseCase) SetRecordTags(
+	ctx context.Context,
+	tagType domain.TagType,
+	recordID string,
+	tagIDs []string,
+) (
+	[]*domain.Tag,
+	error,
+) {
+	tagIDs, err := u.validateRecordTags(
+		ctx,
+		tagType,
+		recordID,
+		tagIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.tagsRepo.SetRecordTags(
+		ctx,
+		tagType,
+		recordID,
+		tagIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.recordTags(
+		ctx,
+		tagType,
+		recordID,
+	)
+}
+
+// AddRecordTags links the tags to a record, keeping the ones it already has
+func (u *TagsUseCase) AddRecordTags(
+	ctx context.Context,
+	tagType domain.TagType,
+	recordID string,
+	tagIDs []string,
+) (
+	[]*domain.Tag,
+	error,
+) {
+	tagIDs, err := u.validateRecordTags(
+		ctx,
+		tagType,
+		recordID,
+		tagIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.tagsRepo.AddRecordTags(
+		ctx,
+		tagType,
+		recordID,
+		tagIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.recordTags(
+		ctx,
+		tagType,
+		recordID,
+	)
+}
+
+// RemoveRecordTag unlinks the tag from a record, removing a tag the record does not have is not an error
+func (u *TagsUseCase) RemoveRecordTag(
+	ctx context.Context,
+	tagType domain.TagType,
+	recordID string,
+	tagID string,
+) error {
+	_, err := u.validateRecordTags(
+		ctx,
+		tagType,
+		recordID,
+		[]string{tagID},
+	)
+	if err != nil {
+		return err
+	}
+
+	return u.tagsRepo.RemoveRecordTag(
+		ctx,
+		tagType,
+		recordID,
+		tagID,
+	)
+}
+
+func (u *TagsUseCase) recordTags(
+	ctx context.Context,
+	tagType domain.TagType,
+	recordID string,
+) (
+	[]*domain.Tag,
+	error,
+) {
+	tags, err := u.tagsRepo.ListByType(
+		ctx,
+		tagType,
+		&[]string{recordID},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return *tags, nil
+}
+
+func (u *TagsUseCase) validateRecord(
+	ctx context.Context,
+	tagType domain.TagType,
+	recordID string,
+) error {
+	exists, err := u.tagsRepo.RecordExists(
+		ctx,
+		tagType,
+		recordID,
+	)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return domain.ErrTaggedRecordNotFound
+	}
+
+	return nil
+}
+
+// validateRecordTags checks the record exists and every tag has its type, returning the tags without repetitions
+func (u *TagsUseCase) validateRecordTags(
+	ctx context.Context,
+	tagType domain.TagType,
+	recordID string,
+	tagIDs []string,
+) (
+	[]string,
+	error,
+) {
+	err := u.validateRecord(
+		ctx,
+		tagType,
+		recordID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	unique := make(
+		[]string,
+		0,
+		len(tagIDs),
+	)
+	seen := make(map[string]bool)
+	for _, tagID := range tagIDs {
+		if seen[tagID] {
+			continue
+		}
+		seen[tagID] = true
+
+		tag, errGet := u.tagsRepo.GetByID(
+			ctx,
+			tagID,
+		)
+		if errGet != nil {
+			if errors.Is(
+				errGet,
+				port.ErrRecordNotFound,
+			) {
+				return nil, domain.ErrTagNotFound
+			}
+
+			return nil, errGet
+		}
+		if tag.TagType != tagType {
+			return nil, domain.ErrTagTypeMismatch
+		}
+		unique = append(
+			unique,
+			tagID,
+		)
+	}
+
+	return unique, nil
+}
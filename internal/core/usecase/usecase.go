@@ -1,10 +1,22 @@
 package usecase
 
 type UseCases struct {
-	Account         *AccountUseCase
-	Auth            *AuthUseCase
-	HouseholdMember *HouseholdMemberUseCase
-	Expenditure     *ExpenditureUseCase
-	Category        *CategoryUseCase
-	Tags            *TagsUseCase
+	Account               *AccountUseCase
+	AllocationPlan        *AllocationPlanUseCase
+	Auth                  *AuthUseCase
+	Budget                *BudgetUseCase
+	Household             *HouseholdUseCase
+	HouseholdMember       *HouseholdMemberUseCase
+	Expenditure           *ExpenditureUseCase
+	ExpenditureRecurrence *ExpenditureRecurrenceUseCase
+	InstallmentPlan       *InstallmentPlanUseCase
+	Invitation            *InvitationUseCase
+	CategorizationRule    *CategorizationRuleUseCase
+	Category              *CategoryUseCase
+	CategorySuggestion    *CategorySuggestionUseCase
+	Payee                 *PayeeUseCase
+	Report                *ReportUseCase
+	Role                  *RoleUseCase
+	Search                *SearchUseCase
+	Tags                  *TagsUseCase
 }
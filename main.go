@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"database/sql"
+	"flag"
 	"fmt"
 	"os"
 	"os/signal"
@@ -10,10 +11,13 @@ import (
 	"time"
 
 	"ghorkov32/proletariat-budget-be/config"
+	"ghorkov32/proletariat-budget-be/internal/adapter/driven/mail"
 	"ghorkov32/proletariat-budget-be/internal/adapter/driven/mysql"
 	"ghorkov32/proletariat-budget-be/internal/adapter/driving/middleware"
 	"ghorkov32/proletariat-budget-be/internal/adapter/driving/resthttp"
+	"ghorkov32/proletariat-budget-be/internal/adapter/driving/scheduler"
 	"ghorkov32/proletariat-budget-be/internal/common"
+	"ghorkov32/proletariat-budget-be/internal/core/domain"
 	"ghorkov32/proletariat-budget-be/internal/core/port"
 	"ghorkov32/proletariat-budget-be/internal/core/usecase"
 	"ghorkov32/proletariat-budget-be/openapi"
@@ -21,6 +25,18 @@ import (
 )
 
 func main() {
+	bootstrapAdmin := flag.String(
+		"bootstrap-admin",
+		"",
+		"email of a registered user to make admin of the household given by -household, the application exits afterwards",
+	)
+	household := flag.String(
+		"household",
+		domain.DefaultHouseholdID,
+		"household the user given by -bootstrap-admin becomes admin of",
+	)
+	flag.Parse()
+
 	log.Info().Msg("application starting ...")
 	configs := config.Load()
 	common.SetupLogger(configs.App.LogLevel)
@@ -40,13 +56,33 @@ func main() {
 
 	ports := instantiatePorts(db)
 
-	useCases := instantiateUseCases(ports)
+	useCases := instantiateUseCases(
+		ports,
+		configs.App,
+	)
+
+	if *bootstrapAdmin != "" {
+		err = useCases.Household.BootstrapAdmin(
+			appCtx,
+			*household,
+			*bootstrapAdmin,
+		)
+		if err != nil {
+			db.Close()
+			log.Fatal().Err(err).Msg("failed to bootstrap household admin") //nolint:gocritic // already closing before fatal
+		}
+		log.Info().Str("household", *household).Str("email", *bootstrapAdmin).Msg("household admin bootstrapped")
+
+		return
+	}
 
 	controller := resthttp.NewController(*useCases)
 
 	handler := openapi.NewStrictHandler(
 		controller,
-		nil,
+		[]openapi.StrictMiddlewareFunc{
+			middleware.NewAuthorizationMiddleware().Authorize,
+		},
 	)
 
 	oapiSpecs, errSw := openapi.GetSwagger()
@@ -60,6 +96,8 @@ func main() {
 		resthttp.MetricsCollector,
 		middleware.DetailedRequestLogger,
 		middleware.OpenAPIValidationMiddleware(oapiSpecs),
+		middleware.NewAuthMiddleware(useCases.Auth).Identify,
+		middleware.NewHouseholdMiddleware().Scope,
 	)
 	go httpServer.Start()
 	defer func(ctx context.Context) {
@@ -77,6 +115,21 @@ func main() {
 		syscall.SIGINT,
 	)
 	defer stop()
+
+	expenditurePoster := scheduler.NewExpenditurePoster(
+		useCases.Expenditure,
+		useCases.Household,
+		configs.App.ScheduledPostingInterval,
+	)
+	go expenditurePoster.Start(ctx)
+
+	recurringBillGenerator := scheduler.NewRecurringBillGenerator(
+		useCases.ExpenditureRecurrence,
+		useCases.Household,
+		configs.App.RecurringBillsInterval,
+	)
+	go recurringBillGenerator.Start(ctx)
+
 	log.Info().Msg("waiting for app exiting conditions")
 
 	<-ctx.Done()
@@ -137,63 +190,161 @@ func instantiatePorts(db *sql.DB) *port.Ports {
 		db,
 		os.Getenv("JWT_SECRET"),
 	)
+	allocationPlanRepo := mysql.NewAllocationPlanRepo(db)
+	budgetRepo := mysql.NewBudgetRepo(db)
+	categorizationRuleRepo := mysql.NewCategorizationRuleRepo(db)
 	categoryRepo := mysql.NewCategoryRepo(db)
+	categorySuggestionRepo := mysql.NewCategorySuggestionRepo(db)
 	tagsRepo := mysql.NewTagsRepo(db)
+	exchangeRateRepo := mysql.NewExchangeRateRepo(db)
 	expenditureRepo := mysql.NewExpenditureRepo(
 		db,
 		tagsRepo,
 	)
+	expenditureRecurrencePatternRepo := mysql.NewExpenditureRecurrencePatternRepo(db)
+	householdRepo := mysql.NewHouseholdRepo(db)
 	householdMembersRepo := mysql.NewHouseholdMemberRepository(db)
+	installmentPlanRepo := mysql.NewInstallmentPlanRepo(db)
+	invitationRepo := mysql.NewInvitationRepo(db)
 	ingressRepo := mysql.NewIngressRepo(
 		db,
 		tagsRepo,
 	)
+	mailer := mail.NewLocalMailer()
+	payeeRepo := mysql.NewPayeeRepo(db)
 	savingsGoalRepo := mysql.NewSavingGoalRepo(
 		db,
 		tagsRepo,
 	)
+	reportRepo := mysql.NewReportRepo(db)
+	roleRepo := mysql.NewRoleRepo(db)
+	searchRepo := mysql.NewSearchRepo(db)
 	transactionRepo := mysql.NewTransactionRepo(
 		db,
 		tagsRepo,
 	)
 
 	return &port.Ports{
-		Account:          &accountRepo,
-		Auth:             &authRepo,
-		Category:         &categoryRepo,
-		Expenditure:      &expenditureRepo,
-		HouseholdMembers: &householdMembersRepo,
-		Ingress:          &ingressRepo,
-		SavingGoal:       &savingsGoalRepo,
-		Tags:             &tagsRepo,
-		Transaction:      &transactionRepo,
+		Account:                      &accountRepo,
+		AllocationPlan:               &allocationPlanRepo,
+		Auth:                         &authRepo,
+		Budget:                       &budgetRepo,
+		CategorizationRule:           &categorizationRuleRepo,
+		Category:                     &categoryRepo,
+		CategorySuggestion:           &categorySuggestionRepo,
+		ExchangeRate:                 &exchangeRateRepo,
+		Expenditure:                  &expenditureRepo,
+		ExpenditureRecurrencePattern: &expenditureRecurrencePatternRepo,
+		Household:                    &householdRepo,
+		HouseholdMembers:             &householdMembersRepo,
+		InstallmentPlan:              &installmentPlanRepo,
+		Invitation:                   &invitationRepo,
+		Ingress:                      &ingressRepo,
+		Mailer:                       &mailer,
+		Payee:                        &payeeRepo,
+		Report:                       &reportRepo,
+		Role:                         &roleRepo,
+		SavingGoal:                   &savingsGoalRepo,
+		Search:                       &searchRepo,
+		Tags:                         &tagsRepo,
+		Transaction:                  &transactionRepo,
 	}
 }
 
-func instantiateUseCases(ports *port.Ports) *usecase.UseCases {
+func instantiateUseCases(
+	ports *port.Ports,
+	appConfig *config.App,
+) *usecase.UseCases {
 	account := usecase.NewAccountUseCase(
 		*ports.Account,
 		*ports.HouseholdMembers,
 	)
-	auth := usecase.NewAuthUseCase(*ports.Auth)
+	auth := usecase.NewAuthUseCase(
+		*ports.Auth,
+		*ports.HouseholdMembers,
+	)
+	household := usecase.NewHouseholdUseCase(
+		*ports.Household,
+		*ports.Auth,
+	)
 	householdMember := usecase.NewHouseholdMemberUseCase(*ports.HouseholdMembers)
 	category := usecase.NewCategoryUseCase(*ports.Category)
+	categorySuggestion := usecase.NewCategorySuggestionUseCase(
+		*ports.CategorySuggestion,
+		*ports.Category,
+		*ports.Tags,
+	)
+	categorizationRule := usecase.NewCategorizationRuleUseCase(
+		*ports.CategorizationRule,
+		*ports.Category,
+		*ports.Tags,
+	)
+	allocationPlan := usecase.NewAllocationPlanUseCase(
+		*ports.AllocationPlan,
+		*ports.Category,
+		*ports.SavingGoal,
+	)
+	budget := usecase.NewBudgetUseCase(
+		*ports.Budget,
+		*ports.Category,
+	)
 	expenditure := usecase.NewExpenditureUseCase(
 		*ports.Expenditure,
 		*ports.Account,
 		*ports.Tags,
 		*ports.Category,
 		*ports.Transaction,
+		*ports.Payee,
+		*ports.CategorizationRule,
+		*ports.ExchangeRate,
+		appConfig.DuplicateWindowDays,
+	)
+	expenditureRecurrence := usecase.NewExpenditureRecurrenceUseCase(
+		*ports.ExpenditureRecurrencePattern,
+		expenditure,
+	)
+	installmentPlan := usecase.NewInstallmentPlanUseCase(
+		*ports.InstallmentPlan,
+		expenditure,
+	)
+	invitation := usecase.NewInvitationUseCase(
+		*ports.Invitation,
+		*ports.Auth,
+		*ports.HouseholdMembers,
+		*ports.Mailer,
+		appConfig.InvitationTTL,
 	)
+	payee := usecase.NewPayeeUseCase(*ports.Payee)
 	tags := usecase.NewTagsUseCase(*ports.Tags)
+	report := usecase.NewReportUseCase(
+		*ports.Report,
+		*ports.Tags,
+	)
+	role := usecase.NewRoleUseCase(
+		*ports.Role,
+		*ports.Auth,
+	)
+	search := usecase.NewSearchUseCase(*ports.Search)
 
 	return &usecase.UseCases{
-		Account:         account,
-		Auth:            auth,
-		HouseholdMember: householdMember,
-		Category:        category,
-		Expenditure:     expenditure,
-		Tags:            tags,
+		Account:               account,
+		AllocationPlan:        allocationPlan,
+		Auth:                  auth,
+		Budget:                budget,
+		Household:             household,
+		HouseholdMember:       householdMember,
+		CategorizationRule:    categorizationRule,
+		Category:              category,
+		CategorySuggestion:    categorySuggestion,
+		Expenditure:           expenditure,
+		ExpenditureRecurrence: expenditureRecurrence,
+		InstallmentPlan:       installmentPlan,
+		Invitation:            invitation,
+		Payee:                 payee,
+		Report:                report,
+		Role:                  role,
+		Search:                search,
+		Tags:                  tags,
 		// Instantiate other use cases
 	}
 }
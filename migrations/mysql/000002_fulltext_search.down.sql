@@ -0,0 +1,11 @@
+ALTER TABLE proletariat_budget.transactions
+    DROP INDEX ft_transaction_description;
+
+ALTER TABLE proletariat_budget.ingresses
+    DROP INDEX ft_ingress_source;
+
+ALTER TABLE proletariat_budget.categories
+    DROP INDEX ft_category_name;
+
+ALTER TABLE proletariat_budget.tags
+    DROP INDEX ft_tag_name;
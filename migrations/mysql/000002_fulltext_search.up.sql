@@ -0,0 +1,11 @@
+ALTER TABLE transactions
+    ADD FULLTEXT INDEX ft_transaction_description (description);
+
+ALTER TABLE ingresses
+    ADD FULLTEXT INDEX ft_ingress_source (source);
+
+ALTER TABLE categories
+    ADD FULLTEXT INDEX ft_category_name (name);
+
+ALTER TABLE tags
+    ADD FULLTEXT INDEX ft_tag_name (name);
@@ -0,0 +1,8 @@
+UPDATE proletariat_budget.transactions
+SET balance_after = 0
+WHERE balance_after IS NULL;
+
+ALTER TABLE proletariat_budget.transactions
+    DROP INDEX idx_transaction_status_date,
+    DROP COLUMN failure_reason,
+    MODIFY balance_after DECIMAL(15, 2) NOT NULL;
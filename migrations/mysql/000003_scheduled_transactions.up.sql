@@ -0,0 +1,5 @@
+-- Pending (scheduled) transactions are not applied to the account yet, so they have no balance after
+ALTER TABLE transactions
+    MODIFY balance_after DECIMAL(15, 2) NULL,
+    ADD COLUMN failure_reason VARCHAR(255) NULL AFTER status,
+    ADD INDEX idx_transaction_status_date (status, transaction_date);
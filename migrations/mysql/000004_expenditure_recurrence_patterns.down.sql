@@ -0,0 +1,5 @@
+ALTER TABLE proletariat_budget.expenditures
+    DROP FOREIGN KEY fk_expenditure_recurrence_pattern,
+    DROP COLUMN from_recurrence_pattern_id;
+
+DROP TABLE if exists proletariat_budget.expenditure_recurrence_patterns;
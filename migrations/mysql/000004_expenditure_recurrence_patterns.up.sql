@@ -0,0 +1,26 @@
+CREATE TABLE expenditure_recurrence_patterns
+(
+    id             BIGINT auto_increment PRIMARY KEY,
+    category_id    BIGINT                                        NOT NULL,
+    account_id     BIGINT                                        NOT NULL,
+    amount         DECIMAL(15, 2)                                NOT NULL,
+    amount_type    ENUM ('fixed', 'estimated')                   NOT NULL DEFAULT 'fixed',
+    description    TEXT                                          NOT NULL,
+    frequency      ENUM ('daily', 'weekly', 'monthly', 'yearly') NOT NULL DEFAULT 'monthly',
+    interval_value INT                                           NOT NULL DEFAULT 1,
+    start_date     DATE                                          NOT NULL,
+    end_date       DATE,
+    next_due_date  DATE                                          NOT NULL,
+    auto_post      BOOLEAN                                       NOT NULL DEFAULT FALSE,
+    active         BOOLEAN                                       NOT NULL DEFAULT TRUE,
+    created_at     TIMESTAMP                                     NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at     TIMESTAMP                                     NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_expenditure_recurrence_next_due_date (active, next_due_date),
+    CONSTRAINT fk_expenditure_recurrence_category FOREIGN KEY (category_id) REFERENCES categories (id),
+    CONSTRAINT fk_expenditure_recurrence_account FOREIGN KEY (account_id) REFERENCES accounts (id)
+);
+
+ALTER TABLE expenditures
+    ADD COLUMN from_recurrence_pattern_id BIGINT NULL,
+    ADD CONSTRAINT fk_expenditure_recurrence_pattern FOREIGN KEY (from_recurrence_pattern_id)
+        REFERENCES expenditure_recurrence_patterns (id) ON DELETE SET NULL;
@@ -0,0 +1,10 @@
+ALTER TABLE proletariat_budget.ingresses
+    DROP FOREIGN KEY fk_ingress_payee,
+    DROP COLUMN payee_id;
+
+ALTER TABLE proletariat_budget.expenditures
+    DROP FOREIGN KEY fk_expenditure_payee,
+    DROP COLUMN payee_id;
+
+DROP TABLE if exists proletariat_budget.payee_aliases;
+DROP TABLE if exists proletariat_budget.payees;
@@ -0,0 +1,27 @@
+CREATE TABLE payees
+(
+    id                  BIGINT auto_increment PRIMARY KEY,
+    name                VARCHAR(255) NOT NULL,
+    default_category_id BIGINT,
+    created_at          TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at          TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    CONSTRAINT uq_payee_name UNIQUE (name),
+    CONSTRAINT fk_payee_default_category FOREIGN KEY (default_category_id) REFERENCES categories (id) ON DELETE SET NULL
+);
+
+CREATE TABLE payee_aliases
+(
+    payee_id BIGINT       NOT NULL,
+    alias    VARCHAR(255) NOT NULL,
+    PRIMARY KEY (payee_id, alias),
+    CONSTRAINT uq_payee_alias UNIQUE (alias),
+    CONSTRAINT fk_payee_alias_payee FOREIGN KEY (payee_id) REFERENCES payees (id) ON DELETE CASCADE
+);
+
+ALTER TABLE expenditures
+    ADD COLUMN payee_id BIGINT NULL,
+    ADD CONSTRAINT fk_expenditure_payee FOREIGN KEY (payee_id) REFERENCES payees (id) ON DELETE SET NULL;
+
+ALTER TABLE ingresses
+    ADD COLUMN payee_id BIGINT NULL,
+    ADD CONSTRAINT fk_ingress_payee FOREIGN KEY (payee_id) REFERENCES payees (id) ON DELETE SET NULL;
@@ -0,0 +1,7 @@
+DROP INDEX idx_exchange_rates_pair_date ON proletariat_budget.exchange_rates;
+
+ALTER TABLE proletariat_budget.transactions
+    DROP FOREIGN KEY fk_transaction_original_currency,
+    DROP COLUMN exchange_rate,
+    DROP COLUMN original_currency,
+    DROP COLUMN original_amount;
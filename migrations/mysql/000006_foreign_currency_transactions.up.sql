@@ -0,0 +1,9 @@
+-- Transactions in a currency other than their account keep the original amount and the rate it was
+-- charged at, amount and currency are always in the account currency
+ALTER TABLE transactions
+    ADD COLUMN original_amount   DECIMAL(15, 2) NULL AFTER currency,
+    ADD COLUMN original_currency INT            NULL AFTER original_amount,
+    ADD COLUMN exchange_rate     DECIMAL(15, 6) NULL AFTER original_currency,
+    ADD CONSTRAINT fk_transaction_original_currency FOREIGN KEY (original_currency) REFERENCES currencies (id);
+
+CREATE INDEX idx_exchange_rates_pair_date ON exchange_rates (base_currency, target_currency, date);
@@ -0,0 +1,17 @@
+DROP TABLE if exists proletariat_budget.expenditure_refunds;
+
+-- The refunds credited their accounts, the credits are taken back with the refund transactions
+UPDATE proletariat_budget.accounts a
+    JOIN (SELECT account_id, SUM(amount) AS refunded
+          FROM proletariat_budget.transactions
+          WHERE transaction_type = 'refund'
+            AND status = 'completed'
+          GROUP BY account_id) r ON r.account_id = a.id
+SET a.current_balance = a.current_balance - r.refunded;
+
+DELETE
+FROM proletariat_budget.transactions
+WHERE transaction_type = 'refund';
+
+ALTER TABLE proletariat_budget.transactions
+    MODIFY transaction_type ENUM ('expenditure', 'ingress', 'transfer', 'rollback') NOT NULL;
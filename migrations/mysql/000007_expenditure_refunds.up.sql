@@ -0,0 +1,15 @@
+ALTER TABLE transactions
+    MODIFY transaction_type ENUM ('expenditure', 'ingress', 'transfer', 'rollback', 'refund') NOT NULL;
+
+-- A refund credits an account with part or all of an expenditure, its transaction amount is in the
+-- currency the expenditure was charged in, or converted to the refunded account currency
+CREATE TABLE expenditure_refunds
+(
+    id             BIGINT auto_increment PRIMARY KEY,
+    expenditure_id BIGINT    NOT NULL,
+    transaction_id BIGINT    NOT NULL UNIQUE,
+    created_at     TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_expenditure_refund_expenditure (expenditure_id),
+    CONSTRAINT fk_expenditure_refund_expenditure FOREIGN KEY (expenditure_id) REFERENCES expenditures (id),
+    CONSTRAINT fk_expenditure_refund_transaction FOREIGN KEY (transaction_id) REFERENCES transactions (id)
+);
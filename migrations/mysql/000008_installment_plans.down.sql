@@ -0,0 +1,7 @@
+ALTER TABLE proletariat_budget.expenditures
+    DROP FOREIGN KEY fk_expenditure_installment_plan,
+    DROP INDEX uq_expenditure_installment,
+    DROP COLUMN installment_number,
+    DROP COLUMN installment_plan_id;
+
+DROP TABLE if exists proletariat_budget.installment_plans;
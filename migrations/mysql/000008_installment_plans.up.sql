@@ -0,0 +1,24 @@
+-- An installment plan splits a purchase into monthly charges, each one is an expenditure that stays
+-- pending until its charge date
+CREATE TABLE installment_plans
+(
+    id                BIGINT auto_increment PRIMARY KEY,
+    account_id        BIGINT         NOT NULL,
+    category_id       BIGINT         NOT NULL,
+    description       TEXT           NOT NULL,
+    total_amount      DECIMAL(15, 2) NOT NULL,
+    interest_rate     DECIMAL(7, 4)  NOT NULL DEFAULT 0,
+    installments      INT            NOT NULL,
+    first_charge_date DATE           NOT NULL,
+    created_at        TIMESTAMP      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_installment_plan_account (account_id),
+    CONSTRAINT fk_installment_plan_account FOREIGN KEY (account_id) REFERENCES accounts (id),
+    CONSTRAINT fk_installment_plan_category FOREIGN KEY (category_id) REFERENCES categories (id)
+);
+
+ALTER TABLE expenditures
+    ADD COLUMN installment_plan_id BIGINT NULL,
+    ADD COLUMN installment_number  INT    NULL,
+    ADD CONSTRAINT fk_expenditure_installment_plan FOREIGN KEY (installment_plan_id)
+        REFERENCES installment_plans (id),
+    ADD CONSTRAINT uq_expenditure_installment UNIQUE (installment_plan_id, installment_number);
@@ -0,0 +1 @@
+DROP TABLE if exists proletariat_budget.budgets;
@@ -0,0 +1,19 @@
+-- A budget limits what can be spent in an expenditure category on every period since its start date,
+-- custom periods last period_days
+CREATE TABLE budgets
+(
+    id          BIGINT                               auto_increment PRIMARY KEY,
+    category_id BIGINT                               NOT NULL,
+    amount      DECIMAL(15, 2)                       NOT NULL,
+    currency    INT                                  NOT NULL,
+    period_type ENUM ('weekly', 'monthly', 'custom') NOT NULL DEFAULT 'monthly',
+    period_days INT,
+    start_date  DATE                                 NOT NULL,
+    end_date    DATE,
+    active      BOOLEAN                              NOT NULL DEFAULT TRUE,
+    created_at  TIMESTAMP                            NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMP                            NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_budget_category (category_id),
+    CONSTRAINT fk_budget_category FOREIGN KEY (category_id) REFERENCES categories (id),
+    CONSTRAINT fk_budget_currency FOREIGN KEY (currency) REFERENCES currencies (id)
+);
@@ -0,0 +1,4 @@
+DROP TABLE if exists proletariat_budget.budget_period_amounts;
+
+ALTER TABLE proletariat_budget.budgets
+    DROP COLUMN rollover;
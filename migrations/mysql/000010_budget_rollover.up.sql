@@ -0,0 +1,13 @@
+-- rollover tells what is carried from the remaining amount of a period to the next one
+ALTER TABLE budgets
+    ADD COLUMN rollover ENUM ('reset', 'unspent', 'overspent', 'all') NOT NULL DEFAULT 'reset' AFTER period_days;
+
+-- Amount of a single budget period when it differs from the budget amount, e.g. for seasonal spending
+CREATE TABLE budget_period_amounts
+(
+    budget_id     BIGINT         NOT NULL,
+    period_number INT            NOT NULL,
+    amount        DECIMAL(15, 2) NOT NULL,
+    PRIMARY KEY (budget_id, period_number),
+    CONSTRAINT fk_budget_period_amount_budget FOREIGN KEY (budget_id) REFERENCES budgets (id) ON DELETE CASCADE
+);
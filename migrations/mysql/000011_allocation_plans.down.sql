@@ -0,0 +1,3 @@
+DROP TABLE if exists proletariat_budget.allocations;
+
+DROP TABLE if exists proletariat_budget.allocation_plans;
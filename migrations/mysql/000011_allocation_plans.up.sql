@@ -0,0 +1,25 @@
+-- An allocation plan assigns the income of a month in a currency to categories and savings goals
+CREATE TABLE allocation_plans
+(
+    id           BIGINT auto_increment PRIMARY KEY,
+    month        DATE                       NOT NULL,
+    currency     INT                        NOT NULL,
+    income_basis ENUM ('expected', 'actual') NOT NULL DEFAULT 'expected',
+    created_at   TIMESTAMP                  NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMP                  NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    CONSTRAINT uq_allocation_plan_month UNIQUE (month, currency),
+    CONSTRAINT fk_allocation_plan_currency FOREIGN KEY (currency) REFERENCES currencies (id)
+);
+
+-- Every allocation goes to either a category or a savings goal
+CREATE TABLE allocations
+(
+    id                 BIGINT auto_increment PRIMARY KEY,
+    allocation_plan_id BIGINT         NOT NULL,
+    category_id        BIGINT,
+    savings_goal_id    BIGINT,
+    amount             DECIMAL(15, 2) NOT NULL,
+    CONSTRAINT fk_allocation_plan FOREIGN KEY (allocation_plan_id) REFERENCES allocation_plans (id) ON DELETE CASCADE,
+    CONSTRAINT fk_allocation_category FOREIGN KEY (category_id) REFERENCES categories (id),
+    CONSTRAINT fk_allocation_savings_goal FOREIGN KEY (savings_goal_id) REFERENCES savings_goals (id) ON DELETE CASCADE
+);
@@ -0,0 +1,5 @@
+ALTER TABLE proletariat_budget.categories
+    DROP FOREIGN KEY fk_category_parent;
+
+ALTER TABLE proletariat_budget.categories
+    DROP COLUMN parent_id;
@@ -0,0 +1,4 @@
+-- parent_id groups categories under another category of the same type, e.g. "Food > Groceries"
+ALTER TABLE categories
+    ADD COLUMN parent_id BIGINT NULL AFTER category_type,
+    ADD CONSTRAINT fk_category_parent FOREIGN KEY (parent_id) REFERENCES categories (id);
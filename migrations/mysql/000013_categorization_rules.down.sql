@@ -0,0 +1,3 @@
+DROP TABLE if exists proletariat_budget.categorization_rule_tags;
+
+DROP TABLE if exists proletariat_budget.categorization_rules;
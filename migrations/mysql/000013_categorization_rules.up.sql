@@ -0,0 +1,30 @@
+-- A categorization rule assigns a category and tags to the expenditures matching all its conditions
+CREATE TABLE categorization_rules
+(
+    id                  BIGINT auto_increment PRIMARY KEY,
+    name                VARCHAR(255)                NOT NULL,
+    priority            INT                         NOT NULL DEFAULT 0,
+    active              BOOLEAN                     NOT NULL DEFAULT TRUE,
+    description_match   ENUM ('contains', 'regex'),
+    description_pattern VARCHAR(255),
+    min_amount          DECIMAL(15, 2),
+    max_amount          DECIMAL(15, 2),
+    account_id          BIGINT,
+    payee_id            BIGINT,
+    category_id         BIGINT,
+    created_at          TIMESTAMP                   NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at          TIMESTAMP                   NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_categorization_rule_priority (priority),
+    CONSTRAINT fk_categorization_rule_account FOREIGN KEY (account_id) REFERENCES accounts (id) ON DELETE CASCADE,
+    CONSTRAINT fk_categorization_rule_payee FOREIGN KEY (payee_id) REFERENCES payees (id) ON DELETE CASCADE,
+    CONSTRAINT fk_categorization_rule_category FOREIGN KEY (category_id) REFERENCES categories (id)
+);
+
+CREATE TABLE categorization_rule_tags
+(
+    rule_id BIGINT NOT NULL,
+    tag_id  BIGINT NOT NULL,
+    PRIMARY KEY (rule_id, tag_id),
+    CONSTRAINT fk_categorization_rule_tag_rule FOREIGN KEY (rule_id) REFERENCES categorization_rules (id) ON DELETE CASCADE,
+    CONSTRAINT fk_categorization_rule_tag_tag FOREIGN KEY (tag_id) REFERENCES tags (id) ON DELETE CASCADE
+);
@@ -0,0 +1,3 @@
+ALTER TABLE proletariat_budget.tags
+    DROP INDEX uq_tag_name_type,
+    ADD CONSTRAINT name UNIQUE (name);
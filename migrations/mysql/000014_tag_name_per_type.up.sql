@@ -0,0 +1,3 @@
+ALTER TABLE tags
+    DROP INDEX name,
+    ADD CONSTRAINT uq_tag_name_type UNIQUE (name, type);
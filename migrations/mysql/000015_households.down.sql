@@ -0,0 +1,41 @@
+ALTER TABLE proletariat_budget.categorization_rules
+    DROP FOREIGN KEY fk_categorization_rule_household,
+    DROP COLUMN household_id;
+
+ALTER TABLE proletariat_budget.allocation_plans
+    DROP FOREIGN KEY fk_allocation_plan_household,
+    DROP INDEX uq_allocation_plan_month,
+    DROP COLUMN household_id,
+    ADD CONSTRAINT uq_allocation_plan_month UNIQUE (month, currency);
+
+ALTER TABLE proletariat_budget.payee_aliases
+    DROP FOREIGN KEY fk_payee_alias_household,
+    DROP INDEX uq_payee_alias,
+    DROP COLUMN household_id,
+    ADD CONSTRAINT uq_payee_alias UNIQUE (alias);
+
+ALTER TABLE proletariat_budget.payees
+    DROP FOREIGN KEY fk_payee_household,
+    DROP INDEX uq_payee_name,
+    DROP COLUMN household_id,
+    ADD CONSTRAINT uq_payee_name UNIQUE (name);
+
+ALTER TABLE proletariat_budget.tags
+    DROP FOREIGN KEY fk_tag_household,
+    DROP INDEX uq_tag_name_type,
+    DROP COLUMN household_id,
+    ADD CONSTRAINT uq_tag_name_type UNIQUE (name, type);
+
+ALTER TABLE proletariat_budget.categories
+    DROP FOREIGN KEY fk_category_household,
+    DROP COLUMN household_id;
+
+ALTER TABLE proletariat_budget.accounts
+    DROP FOREIGN KEY fk_account_household,
+    DROP COLUMN household_id;
+
+ALTER TABLE proletariat_budget.household_members
+    DROP FOREIGN KEY fk_household_member_household,
+    DROP COLUMN household_id;
+
+DROP TABLE IF EXISTS proletariat_budget.households;
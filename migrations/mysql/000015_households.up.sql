@@ -0,0 +1,54 @@
+-- A household is a family using the instance. Members, accounts, categories, tags, payees, allocation
+-- plans and categorization rules belong to one, everything else belongs to it through them.
+CREATE TABLE households
+(
+    id         BIGINT auto_increment PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Everything recorded before households existed, or inserted without a household, belongs to the default
+-- household
+INSERT INTO households (id, name)
+VALUES (1, 'Default household');
+
+ALTER TABLE household_members
+    ADD COLUMN household_id BIGINT NOT NULL DEFAULT 1 AFTER id,
+    ADD CONSTRAINT fk_household_member_household FOREIGN KEY (household_id) REFERENCES households (id);
+
+ALTER TABLE accounts
+    ADD COLUMN household_id BIGINT NOT NULL DEFAULT 1 AFTER id,
+    ADD CONSTRAINT fk_account_household FOREIGN KEY (household_id) REFERENCES households (id);
+
+ALTER TABLE categories
+    ADD COLUMN household_id BIGINT NOT NULL DEFAULT 1 AFTER id,
+    ADD CONSTRAINT fk_category_household FOREIGN KEY (household_id) REFERENCES households (id);
+
+ALTER TABLE tags
+    ADD COLUMN household_id BIGINT NOT NULL DEFAULT 1 AFTER id,
+    DROP INDEX uq_tag_name_type,
+    ADD CONSTRAINT uq_tag_name_type UNIQUE (household_id, name, type),
+    ADD CONSTRAINT fk_tag_household FOREIGN KEY (household_id) REFERENCES households (id);
+
+ALTER TABLE payees
+    ADD COLUMN household_id BIGINT NOT NULL DEFAULT 1 AFTER id,
+    DROP INDEX uq_payee_name,
+    ADD CONSTRAINT uq_payee_name UNIQUE (household_id, name),
+    ADD CONSTRAINT fk_payee_household FOREIGN KEY (household_id) REFERENCES households (id);
+
+-- Aliases are resolved within a household, so they only need to be unique in it
+ALTER TABLE payee_aliases
+    ADD COLUMN household_id BIGINT NOT NULL DEFAULT 1 FIRST,
+    DROP INDEX uq_payee_alias,
+    ADD CONSTRAINT uq_payee_alias UNIQUE (household_id, alias),
+    ADD CONSTRAINT fk_payee_alias_household FOREIGN KEY (household_id) REFERENCES households (id);
+
+ALTER TABLE allocation_plans
+    ADD COLUMN household_id BIGINT NOT NULL DEFAULT 1 AFTER id,
+    DROP INDEX uq_allocation_plan_month,
+    ADD CONSTRAINT uq_allocation_plan_month UNIQUE (household_id, month, currency),
+    ADD CONSTRAINT fk_allocation_plan_household FOREIGN KEY (household_id) REFERENCES households (id);
+
+ALTER TABLE categorization_rules
+    ADD COLUMN household_id BIGINT NOT NULL DEFAULT 1 AFTER id,
+    ADD CONSTRAINT fk_categorization_rule_household FOREIGN KEY (household_id) REFERENCES households (id);
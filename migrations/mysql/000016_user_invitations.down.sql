@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS proletariat_budget.invitations;
+
+ALTER TABLE proletariat_budget.users
+    DROP FOREIGN KEY fk_user_household_member,
+    DROP INDEX uq_user_household_member,
+    DROP COLUMN household_member_id;
@@ -0,0 +1,26 @@
+-- A user logs in as at most one household member, and through it acts on the member's household
+ALTER TABLE users
+    ADD COLUMN household_member_id BIGINT NULL AFTER id,
+    ADD CONSTRAINT uq_user_household_member UNIQUE (household_member_id),
+    ADD CONSTRAINT fk_user_household_member FOREIGN KEY (household_member_id) REFERENCES household_members (id);
+
+-- Invitations are sent by email with a one-time token, only its SHA-256 hash is kept. Accepting creates
+-- the invitee's user linked to the invited member, or to a new member when none was given.
+CREATE TABLE invitations
+(
+    id                  BIGINT auto_increment PRIMARY KEY,
+    household_id        BIGINT       NOT NULL,
+    email               VARCHAR(255) NOT NULL,
+    token_hash          CHAR(64)     NOT NULL,
+    household_member_id BIGINT       NULL,
+    invited_by          BIGINT       NOT NULL,
+    expires_at          TIMESTAMP    NOT NULL,
+    accepted_at         TIMESTAMP    NULL,
+    accepted_by         BIGINT       NULL,
+    created_at          TIMESTAMP    NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT uq_invitation_token_hash UNIQUE (token_hash),
+    CONSTRAINT fk_invitation_household FOREIGN KEY (household_id) REFERENCES households (id),
+    CONSTRAINT fk_invitation_household_member FOREIGN KEY (household_member_id) REFERENCES household_members (id) ON DELETE CASCADE,
+    CONSTRAINT fk_invitation_invited_by FOREIGN KEY (invited_by) REFERENCES users (id),
+    CONSTRAINT fk_invitation_accepted_by FOREIGN KEY (accepted_by) REFERENCES users (id)
+);
@@ -0,0 +1,8 @@
+-- Only the seeded roles and the grants of them go, roles added since are kept
+DELETE ur
+FROM proletariat_budget.user_roles ur
+         JOIN proletariat_budget.roles r ON r.id = ur.role_id
+WHERE r.name IN ('admin', 'member', 'viewer');
+
+DELETE FROM proletariat_budget.roles
+WHERE name IN ('admin', 'member', 'viewer');
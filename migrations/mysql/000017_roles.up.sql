@@ -0,0 +1,9 @@
+-- The roles of the permission matrix, a user holds roles in the household of their member
+INSERT INTO roles (name)
+VALUES ('admin'),
+       ('member'),
+       ('viewer');
+
+
+-- Linked users get no role here, households get their first admin when founded or through the
+-- -bootstrap-admin flag of the server
@@ -12,6 +12,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -46,6 +47,39 @@ const (
 	AccountRequestTypeOther      AccountRequestType = "other"
 )
 
+// Defines values for AmountBasis.
+const (
+	Charged  AmountBasis = "charged"
+	Original AmountBasis = "original"
+)
+
+// Defines values for BatchMode.
+const (
+	AllOrNothing BatchMode = "allOrNothing"
+	BestEffort   BatchMode = "bestEffort"
+)
+
+// Defines values for BudgetPeriodType.
+const (
+	BudgetPeriodTypeCustom  BudgetPeriodType = "custom"
+	BudgetPeriodTypeMonthly BudgetPeriodType = "monthly"
+	BudgetPeriodTypeWeekly  BudgetPeriodType = "weekly"
+)
+
+// Defines values for BudgetRollover.
+const (
+	BudgetRolloverAll       BudgetRollover = "all"
+	BudgetRolloverOverspent BudgetRollover = "overspent"
+	BudgetRolloverReset     BudgetRollover = "reset"
+	BudgetRolloverUnspent   BudgetRollover = "unspent"
+)
+
+// Defines values for CategoryMergeAction.
+const (
+	CategoryMergeActionDeactivate CategoryMergeAction = "deactivate"
+	CategoryMergeActionDelete     CategoryMergeAction = "delete"
+)
+
 // Defines values for CategoryType.
 const (
 	CategoryTypeExpenditure CategoryType = "expenditure"
@@ -54,6 +88,12 @@ const (
 	CategoryTypeTransfer    CategoryType = "transfer"
 )
 
+// Defines values for DescriptionMatch.
+const (
+	Contains DescriptionMatch = "contains"
+	Regex    DescriptionMatch = "regex"
+)
+
 // Defines values for ErrorCode.
 const (
 	ErrCodeConflict              ErrorCode = "Conflict"
@@ -64,6 +104,48 @@ const (
 	ErrorCodeNotAuthorized       ErrorCode = "NotAuthorized"
 )
 
+// Defines values for ExpenditureStatus.
+const (
+	ExpenditureStatusCompleted ExpenditureStatus = "completed"
+	ExpenditureStatusFailed    ExpenditureStatus = "failed"
+	ExpenditureStatusPending   ExpenditureStatus = "pending"
+)
+
+// Defines values for IncomeBasis.
+const (
+	Actual   IncomeBasis = "actual"
+	Expected IncomeBasis = "expected"
+)
+
+// Defines values for Permission.
+const (
+	PermissionDelete Permission = "delete"
+	PermissionManage Permission = "manage"
+	PermissionRead   Permission = "read"
+	PermissionWrite  Permission = "write"
+)
+
+// Defines values for PlannedSpendingReportUnplannedTrend.
+const (
+	Decreasing PlannedSpendingReportUnplannedTrend = "decreasing"
+	Increasing PlannedSpendingReportUnplannedTrend = "increasing"
+	Stable     PlannedSpendingReportUnplannedTrend = "stable"
+)
+
+// Defines values for RecurrenceAmountType.
+const (
+	Estimated RecurrenceAmountType = "estimated"
+	Fixed     RecurrenceAmountType = "fixed"
+)
+
+// Defines values for RecurrenceFrequency.
+const (
+	RecurrenceFrequencyDaily   RecurrenceFrequency = "daily"
+	RecurrenceFrequencyMonthly RecurrenceFrequency = "monthly"
+	RecurrenceFrequencyWeekly  RecurrenceFrequency = "weekly"
+	RecurrenceFrequencyYearly  RecurrenceFrequency = "yearly"
+)
+
 // Defines values for RecurrencePatternFrequency.
 const (
 	RecurrencePatternFrequencyDaily   RecurrencePatternFrequency = "daily"
@@ -80,6 +162,13 @@ const (
 	RecurrencePatternRequestFrequencyYearly  RecurrencePatternRequestFrequency = "yearly"
 )
 
+// Defines values for ReportPeriod.
+const (
+	Month   ReportPeriod = "month"
+	Quarter ReportPeriod = "quarter"
+	Year    ReportPeriod = "year"
+)
+
 // Defines values for SavingsGoalAutoContributeFrequency.
 const (
 	SavingsGoalAutoContributeFrequencyDaily   SavingsGoalAutoContributeFrequency = "daily"
@@ -115,6 +204,19 @@ const (
 	SavingsTransactionTypeWithdrawal   SavingsTransactionType = "withdrawal"
 )
 
+// Defines values for SearchHitType.
+const (
+	SearchHitTypeExpenditure SearchHitType = "expenditure"
+	SearchHitTypeIngress     SearchHitType = "ingress"
+	SearchHitTypeTransfer    SearchHitType = "transfer"
+)
+
+// Defines values for TagMatch.
+const (
+	TagMatchAll TagMatch = "all"
+	TagMatchAny TagMatch = "any"
+)
+
 // Defines values for TagType.
 const (
 	TagTypeExpenditure         TagType = "expenditure"
@@ -152,6 +254,7 @@ const (
 const (
 	TransactionTransactionTypeExpenditure TransactionTransactionType = "expenditure"
 	TransactionTransactionTypeIngress     TransactionTransactionType = "ingress"
+	TransactionTransactionTypeRefund      TransactionTransactionType = "refund"
 	TransactionTransactionTypeRollback    TransactionTransactionType = "rollback"
 	TransactionTransactionTypeTransfer    TransactionTransactionType = "transfer"
 )
@@ -171,11 +274,17 @@ const (
 	GetBalancesParamsGroupByType     GetBalancesParamsGroupBy = "type"
 )
 
+// Defines values for GetDeclaredExpensesReportParamsFormat.
+const (
+	Csv  GetDeclaredExpensesReportParamsFormat = "csv"
+	Json GetDeclaredExpensesReportParamsFormat = "json"
+)
+
 // Defines values for ListSavingsGoalsParamsStatus.
 const (
-	ListSavingsGoalsParamsStatusAbandoned ListSavingsGoalsParamsStatus = "abandoned"
-	ListSavingsGoalsParamsStatusActive    ListSavingsGoalsParamsStatus = "active"
-	ListSavingsGoalsParamsStatusCompleted ListSavingsGoalsParamsStatus = "completed"
+	Abandoned ListSavingsGoalsParamsStatus = "abandoned"
+	Active    ListSavingsGoalsParamsStatus = "active"
+	Completed ListSavingsGoalsParamsStatus = "completed"
 )
 
 // Defines values for ListSavingsTransactionsParamsType.
@@ -279,6 +388,101 @@ type AccountRequest struct {
 // AccountRequestType Type of account
 type AccountRequestType string
 
+// Allocation Part of the income assigned to either a category or a savings goal
+type Allocation struct {
+	Amount float32 `json:"amount"`
+
+	// CategoryId Expenditure category the amount is assigned to
+	CategoryId *string `json:"categoryId,omitempty"`
+
+	// SavingsGoalId Savings goal the amount is assigned to
+	SavingsGoalId *string `json:"savingsGoalId,omitempty"`
+}
+
+// AllocationPlan defines model for AllocationPlan.
+type AllocationPlan struct {
+	// ActualIncome Ingresses received in the month
+	ActualIncome float32            `json:"actualIncome"`
+	Allocated    float32            `json:"allocated"`
+	Allocations  []AllocationStatus `json:"allocations"`
+
+	// Contributed Contributions to savings goals in the month
+	Contributed float32   `json:"contributed"`
+	CreatedAt   time.Time `json:"createdAt"`
+	Currency    string    `json:"currency"`
+
+	// ExpectedIncome Income expected from the ingress recurrence patterns and the one-off ingresses of the month
+	ExpectedIncome float32 `json:"expectedIncome"`
+	Id             string  `json:"id"`
+
+	// IncomeBasis Income the allocations must fit in. expected adds up the ingress recurrence patterns and the one-off ingresses of the month, actual the ingresses already received.
+	IncomeBasis IncomeBasis `json:"incomeBasis"`
+
+	// Month First day of the planned month
+	Month openapi_types.Date `json:"month"`
+
+	// Spent Completed expenditures of the month net of their refunds
+	Spent float32 `json:"spent"`
+
+	// ToBeAssigned Income on the plan basis not allocated yet, negative when the plan allocates more than the income
+	ToBeAssigned float32 `json:"toBeAssigned"`
+
+	// Unplanned Spent in categories the plan allocated nothing to
+	Unplanned float32   `json:"unplanned"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// AllocationPlanList defines model for AllocationPlanList.
+type AllocationPlanList struct {
+	Plans []AllocationPlan `json:"plans"`
+
+	// Total Total number of allocation plans
+	Total int `json:"total"`
+}
+
+// AllocationPlanRequest defines model for AllocationPlanRequest.
+type AllocationPlanRequest struct {
+	// Allocations Every category and savings goal can be allocated once
+	Allocations []Allocation `json:"allocations"`
+
+	// Currency ID of the currency of the income and the allocations
+	Currency string `json:"currency"`
+
+	// IncomeBasis Income the allocations must fit in. expected adds up the ingress recurrence patterns and the one-off ingresses of the month, actual the ingresses already received.
+	IncomeBasis *IncomeBasis `json:"incomeBasis,omitempty"`
+
+	// Month Any day of the planned month
+	Month openapi_types.Date `json:"month"`
+}
+
+// AllocationPlanUpdateRequest defines model for AllocationPlanUpdateRequest.
+type AllocationPlanUpdateRequest struct {
+	// Allocations Every category and savings goal can be allocated once
+	Allocations []Allocation `json:"allocations"`
+
+	// IncomeBasis Income the allocations must fit in. expected adds up the ingress recurrence patterns and the one-off ingresses of the month, actual the ingresses already received.
+	IncomeBasis *IncomeBasis `json:"incomeBasis,omitempty"`
+}
+
+// AllocationStatus defines model for AllocationStatus.
+type AllocationStatus struct {
+	// Actual Completed expenditures of the category net of their refunds, or contributions to the savings goal, in the month
+	Actual float32 `json:"actual"`
+	Amount float32 `json:"amount"`
+
+	// CategoryId Expenditure category the amount is assigned to
+	CategoryId *string `json:"categoryId,omitempty"`
+
+	// Difference What is left of the allocation, negative when more was spent or contributed
+	Difference float32 `json:"difference"`
+
+	// SavingsGoalId Savings goal the amount is assigned to
+	SavingsGoalId *string `json:"savingsGoalId,omitempty"`
+}
+
+// AmountBasis Amount used for transactions made in another currency than their account: the amount charged in the account currency (charged) or the amount in the currency it was made in (original). The currency filter applies to the same amount.
+type AmountBasis string
+
 // BalanceSummary defines model for BalanceSummary.
 type BalanceSummary struct {
 	Accounts []struct {
@@ -318,3027 +522,3341 @@ type BalanceSummary struct {
 	TotalBalance float32 `json:"totalBalance"`
 }
 
-// CanDelete defines model for CanDelete.
-type CanDelete struct {
-	// CanDelete Whether the entity can be deleted
-	CanDelete bool `json:"canDelete"`
+// BatchMode What happens to the valid items of a batch when others fail: nothing is created (allOrNothing) or the valid items are created anyway (bestEffort)
+type BatchMode string
 
-	// Reason Explanation when deletion is not allowed
-	Reason *string `json:"reason"`
-}
+// Budget defines model for Budget.
+type Budget struct {
+	// Active Whether the budget shows in the overview, only used on update
+	Active bool `json:"active"`
 
-// Category defines model for Category.
-type Category struct {
-	// Active Whether the category is active
-	Active *bool `json:"active,omitempty"`
+	// Amount What can be spent in the category on each period
+	Amount float32 `json:"amount"`
 
-	// BackgroundColor Background color code for UI representation (hex format)
-	BackgroundColor *string       `json:"backgroundColor,omitempty"`
-	CategoryType    *CategoryType `json:"categoryType,omitempty"`
+	// CategoryId Expenditure category the budget limits
+	CategoryId string `json:"categoryId"`
 
-	// Color Color code for UI representation (hex format)
-	Color *string `json:"color,omitempty"`
+	// CreatedAt Timestamp when the budget was created
+	CreatedAt time.Time `json:"createdAt"`
 
-	// Description Description of the category
-	Description string `json:"description"`
+	// Currency ID of the currency of the budget, only expenditures charged in it count
+	Currency string `json:"currency"`
 
-	// Id Unique identifier for the category
+	// EndDate Last day the budget applies (null for indefinite)
+	EndDate *openapi_types.Date `json:"endDate"`
+
+	// Id Unique identifier for the budget
 	Id string `json:"id"`
 
-	// Name Name of the category
-	Name string `json:"name"`
-}
+	// PeriodDays Length in days of custom periods, only set for custom periods
+	PeriodDays *int `json:"periodDays,omitempty"`
 
-// CategoryRequest defines model for CategoryRequest.
-type CategoryRequest struct {
-	// BackgroundColor Background color code for UI representation (hex format)
-	BackgroundColor *string       `json:"backgroundColor,omitempty"`
-	CategoryType    *CategoryType `json:"categoryType,omitempty"`
+	// PeriodType Length of the budget periods. Monthly periods keep the day of month of the start date, custom periods last periodDays.
+	PeriodType BudgetPeriodType `json:"periodType"`
 
-	// Color Color code for UI representation (hex format)
-	Color *string `json:"color,omitempty"`
+	// Rollover What is carried from the remaining amount of a period to the next one. reset carries nothing, unspent adds what was not spent, overspent deducts what was overspent and all does both.
+	Rollover BudgetRollover `json:"rollover"`
 
-	// Description Description of the category
-	Description string `json:"description"`
+	// StartDate First day of the first period
+	StartDate openapi_types.Date `json:"startDate"`
 
-	// Name Name of the category
-	Name string `json:"name"`
+	// UpdatedAt Timestamp when the budget was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// CategoryType defines model for CategoryType.
-type CategoryType string
+// BudgetHistory defines model for BudgetHistory.
+type BudgetHistory struct {
+	Budget Budget `json:"budget"`
 
-// Error defines model for Error.
-type Error struct {
-	Code    ErrorCode `json:"code"`
-	Message string    `json:"message"`
+	// Periods Every period of the budget from the first one, oldest first
+	Periods []BudgetPeriodStatus `json:"periods"`
 }
 
-// ErrorCode defines model for ErrorCode.
-type ErrorCode string
+// BudgetList defines model for BudgetList.
+type BudgetList struct {
+	Budgets []Budget `json:"budgets"`
 
-// ExchangeRates defines model for ExchangeRates.
-type ExchangeRates struct {
-	// BaseCurrency Base currency for the rates
-	BaseCurrency string `json:"baseCurrency"`
+	// Total Total number of budgets
+	Total int `json:"total"`
+}
 
-	// Date Date of the exchange rates
+// BudgetOverview defines model for BudgetOverview.
+type BudgetOverview struct {
+	// Budgets Status of every active budget running on the date
+	Budgets []BudgetStatus `json:"budgets"`
+
+	// Date Date whose budget periods are shown
 	Date openapi_types.Date `json:"date"`
 
-	// Rates Exchange rates for different currencies
-	Rates map[string]float32 `json:"rates"`
+	// Totals Budgets added up per currency
+	Totals []BudgetTotal `json:"totals"`
 }
 
-// Expenditure defines model for Expenditure.
-type Expenditure struct {
-	// AccountId The account ID this expenditure is associated with
-	AccountId string `json:"accountId"`
-
-	// Amount The expenditure amount
-	Amount   float32  `json:"amount"`
-	Category Category `json:"category"`
+// BudgetPeriod defines model for BudgetPeriod.
+type BudgetPeriod struct {
+	// End Last day of the period
+	End openapi_types.Date `json:"end"`
 
-	// CreatedAt Timestamp when the expenditure was created
-	CreatedAt time.Time `json:"createdAt"`
+	// Number Number of the period, counted from 0 on the budget start date
+	Number int `json:"number"`
 
-	// Currency ID of the currency used for the expenditure
-	Currency string `json:"currency"`
+	// Start First day of the period
+	Start openapi_types.Date `json:"start"`
+}
 
-	// Date The date of the expenditure
-	Date openapi_types.Date `json:"date"`
+// BudgetPeriodAmountRequest defines model for BudgetPeriodAmountRequest.
+type BudgetPeriodAmountRequest struct {
+	// Amount What can be spent in the period instead of the budget amount
+	Amount float32 `json:"amount"`
+}
 
-	// Declared Whether the expenditure has been fiscally declared
-	Declared *bool `json:"declared,omitempty"`
+// BudgetPeriodStatus defines model for BudgetPeriodStatus.
+type BudgetPeriodStatus struct {
+	// Available What could be spent in the period, its amount plus what was carried
+	Available float32 `json:"available"`
 
-	// Description The description of the expenditure
-	Description string `json:"description"`
+	// Budgeted Amount of the period
+	Budgeted float32 `json:"budgeted"`
 
-	// Id Unique identifier for the expenditure
-	Id string `json:"id"`
+	// Carried What the rollover brought from the previous period, negative when it was overspent
+	Carried float32 `json:"carried"`
 
-	// Planned Whether the expenditure is planned or non-planned
-	Planned *bool `json:"planned,omitempty"`
+	// PercentUsed Percentage of the available amount spent
+	PercentUsed float32      `json:"percentUsed"`
+	Period      BudgetPeriod `json:"period"`
 
-	// Tags List of tag IDs associated with this expenditure
-	Tags *[]Tag `json:"tags,omitempty"`
+	// Remaining What was left to spend in the period, negative when overspent
+	Remaining float32 `json:"remaining"`
 
-	// UpdatedAt Timestamp when the expenditure was last updated
-	UpdatedAt time.Time `json:"updatedAt"`
+	// Spent Completed expenditures of the category in the period, net of their refunds
+	Spent float32 `json:"spent"`
 }
 
-// ExpenditureList defines model for ExpenditureList.
-type ExpenditureList struct {
-	Expenditures *[]Expenditure `json:"expenditures,omitempty"`
-	Metadata     *ListMetadata  `json:"metadata,omitempty"`
-}
+// BudgetPeriodType Length of the budget periods. Monthly periods keep the day of month of the start date, custom periods last periodDays.
+type BudgetPeriodType string
 
-// ExpenditureRequest defines model for ExpenditureRequest.
-type ExpenditureRequest struct {
-	// AccountId The account ID this expenditure is associated with
-	AccountId string `json:"accountId"`
+// BudgetRequest defines model for BudgetRequest.
+type BudgetRequest struct {
+	// Active Whether the budget shows in the overview, only used on update
+	Active *bool `json:"active,omitempty"`
 
-	// Amount The expenditure amount
-	Amount   float32  `json:"amount"`
-	Category Category `json:"category"`
+	// Amount What can be spent in the category on each period
+	Amount float32 `json:"amount"`
+
+	// CategoryId Expenditure category the budget limits
+	CategoryId string `json:"categoryId"`
 
-	// Currency ID of the currency used for the expenditure
+	// Currency ID of the currency of the budget, only expenditures charged in it count
 	Currency string `json:"currency"`
 
-	// Date The date of the expenditure
-	Date openapi_types.Date `json:"date"`
+	// EndDate Last day the budget applies (null for indefinite)
+	EndDate *openapi_types.Date `json:"endDate"`
 
-	// Declared Whether the expenditure has been fiscally declared
-	Declared *bool `json:"declared,omitempty"`
+	// PeriodDays Length in days of custom periods, only set for custom periods
+	PeriodDays *int `json:"periodDays,omitempty"`
 
-	// Description The description of the expenditure
-	Description string `json:"description"`
+	// PeriodType Length of the budget periods. Monthly periods keep the day of month of the start date, custom periods last periodDays.
+	PeriodType BudgetPeriodType `json:"periodType"`
 
-	// Planned Whether the expenditure is planned or non-planned
-	Planned *bool `json:"planned,omitempty"`
+	// Rollover What is carried from the remaining amount of a period to the next one. reset carries nothing, unspent adds what was not spent, overspent deducts what was overspent and all does both.
+	Rollover *BudgetRollover `json:"rollover,omitempty"`
 
-	// Tags List of tag IDs associated with this expenditure
-	Tags *[]Tag `json:"tags,omitempty"`
+	// StartDate First day of the first period
+	StartDate openapi_types.Date `json:"startDate"`
 }
 
-// HouseholdMember defines model for HouseholdMember.
-type HouseholdMember struct {
-	// Active Whether the household member is currently active in budget planning
-	Active *bool `json:"active,omitempty"`
+// BudgetRollover What is carried from the remaining amount of a period to the next one. reset carries nothing, unspent adds what was not spent, overspent deducts what was overspent and all does both.
+type BudgetRollover string
 
-	// CreatedAt Timestamp when the record was created
-	CreatedAt time.Time `json:"createdAt"`
+// BudgetStatus defines model for BudgetStatus.
+type BudgetStatus struct {
+	// Available What could be spent in the period, its amount plus what was carried
+	Available float32 `json:"available"`
+	Budget    Budget  `json:"budget"`
 
-	// FirstName First name of the household member
-	FirstName string `json:"firstName"`
+	// Budgeted Amount of the period
+	Budgeted float32 `json:"budgeted"`
 
-	// Id Unique identifier for the household member
-	Id string `json:"id"`
+	// Carried What the rollover brought from the previous period, negative when it was overspent
+	Carried float32 `json:"carried"`
 
-	// LastName Last name of the household member
-	LastName string `json:"lastName"`
+	// PercentUsed Percentage of the available amount spent
+	PercentUsed float32      `json:"percentUsed"`
+	Period      BudgetPeriod `json:"period"`
 
-	// Nickname Nickname or preferred name of the household member
-	Nickname *string `json:"nickname,omitempty"`
+	// Remaining What is left to spend in the period, negative when overspent
+	Remaining float32 `json:"remaining"`
 
-	// Role Role in the household (e.g., parent, child, roommate)
-	Role string `json:"role"`
+	// Spent Completed expenditures of the category in the period, net of their refunds
+	Spent float32 `json:"spent"`
+}
 
-	// UpdatedAt Timestamp when the record was last updated
-	UpdatedAt time.Time `json:"updatedAt"`
+// BudgetTotal defines model for BudgetTotal.
+type BudgetTotal struct {
+	Available float32 `json:"available"`
+	Budgeted  float32 `json:"budgeted"`
+
+	// Currency ID of the currency of the budgets added up
+	Currency  string  `json:"currency"`
+	Remaining float32 `json:"remaining"`
+	Spent     float32 `json:"spent"`
 }
 
-// HouseholdMemberList defines model for HouseholdMemberList.
-type HouseholdMemberList struct {
-	// Members List of household members
-	Members *[]HouseholdMember `json:"members,omitempty"`
+// CanDelete defines model for CanDelete.
+type CanDelete struct {
+	// CanDelete Whether the entity can be deleted
+	CanDelete bool `json:"canDelete"`
+
+	// Reason Explanation when deletion is not allowed
+	Reason *string `json:"reason"`
 }
 
-// HouseholdMemberRequest defines model for HouseholdMemberRequest.
-type HouseholdMemberRequest struct {
-	// Active Whether the household member is currently active in budget planning
-	Active *bool `json:"active,omitempty"`
+// Categorization What the matching rules change in an expenditure
+type Categorization struct {
+	// AddedTagIds Tags of the matching rules the expenditure does not have yet
+	AddedTagIds []string `json:"addedTagIds"`
 
-	// FirstName First name of the household member
-	FirstName string `json:"firstName"`
+	// Amount Amount charged to the account
+	Amount float32 `json:"amount"`
 
-	// LastName Last name of the household member
-	LastName string `json:"lastName"`
+	// CategoryId Category of the first matching rule assigning one
+	CategoryId    *string            `json:"categoryId"`
+	Currency      string             `json:"currency"`
+	Date          openapi_types.Date `json:"date"`
+	Description   string             `json:"description"`
+	ExpenditureId string             `json:"expenditureId"`
 
-	// Nickname Nickname or preferred name of the household member
-	Nickname *string `json:"nickname,omitempty"`
+	// FromCategoryId Category of the expenditure before the rules run
+	FromCategoryId string `json:"fromCategoryId"`
 
-	// Role Role in the household (e.g., parent, child, roommate)
-	Role string `json:"role"`
+	// RuleIds Matching rules by priority
+	RuleIds []string `json:"ruleIds"`
 }
 
-// Ingress defines model for Ingress.
-type Ingress struct {
-	// AccountId The ID of the account this ingress belongs to
-	AccountId string `json:"accountId"`
+// CategorizationRule defines model for CategorizationRule.
+type CategorizationRule struct {
+	// AccountId Account the expenditure is charged to
+	AccountId *string `json:"accountId,omitempty"`
 
-	// Amount The ingress amount
-	Amount   float32  `json:"amount"`
-	Category Category `json:"category"`
+	// Active Whether the rule runs on new expenditures
+	Active bool `json:"active"`
 
-	// CreatedAt The timestamp when the ingress was created
-	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	// CategoryId Expenditure category assigned by the rule
+	CategoryId *string `json:"categoryId,omitempty"`
 
-	// Currency The currency of the ingress
-	Currency string `json:"currency"`
+	// CreatedAt Timestamp when the rule was created
+	CreatedAt   time.Time             `json:"createdAt"`
+	Description *DescriptionCondition `json:"description,omitempty"`
 
-	// Date The date of the ingress
-	Date openapi_types.Date `json:"date"`
+	// Id Unique identifier for the rule
+	Id string `json:"id"`
 
-	// Description Additional details about the ingress
-	Description *string `json:"description,omitempty"`
+	// MaxAmount Maximum amount charged to the account
+	MaxAmount *float32 `json:"maxAmount,omitempty"`
 
-	// Id Unique identifier for the ingress
-	Id                string             `json:"id"`
-	RecurrencePattern *RecurrencePattern `json:"recurrencePattern,omitempty"`
+	// MinAmount Minimum amount charged to the account
+	MinAmount *float32 `json:"minAmount,omitempty"`
 
-	// Source The source of the income
-	Source *string `json:"source,omitempty"`
+	// Name Name of the rule
+	Name string `json:"name"`
 
-	// Tags List of tag IDs associated with this ingress
-	Tags *[]Tag `json:"tags,omitempty"`
-}
+	// PayeeId Payee of the expenditure
+	PayeeId *string `json:"payeeId,omitempty"`
 
-// IngressList defines model for IngressList.
-type IngressList struct {
-	Incomes  *[]Ingress    `json:"incomes,omitempty"`
-	Metadata *ListMetadata `json:"metadata,omitempty"`
-}
+	// Priority Rules run by priority, lowest first
+	Priority int `json:"priority"`
 
-// IngressRequest defines model for IngressRequest.
-type IngressRequest struct {
-	// AccountId The ID of the account this ingress belongs to
-	AccountId string `json:"accountId"`
+	// TagIds Expenditure tags added by the rule
+	TagIds []string `json:"tagIds"`
 
-	// Amount The ingress amount
-	Amount   float32  `json:"amount"`
-	Category Category `json:"category"`
+	// UpdatedAt Timestamp when the rule was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
 
-	// CreatedAt The timestamp when the ingress was created
-	CreatedAt *time.Time `json:"createdAt,omitempty"`
+// CategorizationRuleApplyRequest Expenditures the rules run on, either the given ones or those of a category (e.g. a catch-all category for uncategorized spending), optionally within a date range
+type CategorizationRuleApplyRequest struct {
+	// CategoryId Run the rules on the expenditures of this category
+	CategoryId *string `json:"categoryId,omitempty"`
 
-	// Currency The currency of the ingress
-	Currency string `json:"currency"`
+	// EndDate Last transaction date included
+	EndDate *openapi_types.Date `json:"endDate,omitempty"`
 
-	// Date The date of the ingress
-	Date openapi_types.Date `json:"date"`
+	// ExpenditureIds Expenditures to run the rules on
+	ExpenditureIds *[]string `json:"expenditureIds,omitempty"`
 
-	// Description Additional details about the ingress
-	Description       *string            `json:"description,omitempty"`
-	RecurrencePattern *RecurrencePattern `json:"recurrencePattern,omitempty"`
+	// StartDate First transaction date included
+	StartDate *openapi_types.Date `json:"startDate,omitempty"`
+}
 
-	// Source The source of the income
-	Source *string `json:"source,omitempty"`
+// CategorizationRuleList defines model for CategorizationRuleList.
+type CategorizationRuleList struct {
+	Rules []CategorizationRule `json:"rules"`
 
-	// Tags List of tag IDs associated with this ingress
-	Tags *[]Tag `json:"tags,omitempty"`
+	// Total Total number of rules
+	Total int `json:"total"`
 }
 
-// ListMetadata defines model for ListMetadata.
-type ListMetadata struct {
-	// Limit Limit used for the query
-	Limit int `json:"limit"`
+// CategorizationRulePreviewRequest defines model for CategorizationRulePreviewRequest.
+type CategorizationRulePreviewRequest struct {
+	// CategoryId Run the rules on the expenditures of this category
+	CategoryId *string `json:"categoryId,omitempty"`
 
-	// Offset Offset used for the query
-	Offset int `json:"offset"`
+	// EndDate Last transaction date included
+	EndDate *openapi_types.Date `json:"endDate,omitempty"`
 
-	// Total Total number of elements matching the filter criteria
-	Total int `json:"total"`
-}
+	// ExpenditureIds Expenditures to run the rules on
+	ExpenditureIds *[]string `json:"expenditureIds,omitempty"`
 
-// LoginRequest defines model for LoginRequest.
-type LoginRequest struct {
-	Email    openapi_types.Email `json:"email"`
-	Password string              `json:"password"`
-}
+	// Rule Rule assigning a category and tags to the expenditures matching every one of its conditions. At least one condition and one action are required.
+	Rule CategorizationRuleRequest `json:"rule"`
 
-// LoginResponse defines model for LoginResponse.
-type LoginResponse struct {
-	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
-	Token     *string    `json:"token,omitempty"`
-	User      *User      `json:"user,omitempty"`
+	// StartDate First transaction date included
+	StartDate *openapi_types.Date `json:"startDate,omitempty"`
 }
 
-// RecurrencePattern defines model for RecurrencePattern.
-type RecurrencePattern struct {
-	// Amount Amount for each recurrence
-	Amount float32 `json:"amount"`
+// CategorizationRuleRequest Rule assigning a category and tags to the expenditures matching every one of its conditions. At least one condition and one action are required.
+type CategorizationRuleRequest struct {
+	// AccountId Account the expenditure is charged to
+	AccountId *string `json:"accountId,omitempty"`
 
-	// CreatedAt When the recurrence pattern was created
-	CreatedAt time.Time `json:"created_at"`
+	// Active Whether the rule runs on new expenditures
+	Active *bool `json:"active,omitempty"`
 
-	// Description Description for the recurrence pattern
-	Description string `json:"description"`
+	// CategoryId Expenditure category assigned by the rule
+	CategoryId  *string               `json:"categoryId,omitempty"`
+	Description *DescriptionCondition `json:"description,omitempty"`
 
-	// EndDate End date for the recurrence pattern (null for indefinite)
-	EndDate *openapi_types.Date `json:"end_date"`
+	// MaxAmount Maximum amount charged to the account
+	MaxAmount *float32 `json:"maxAmount,omitempty"`
 
-	// Frequency Frequency of the recurrence
-	Frequency RecurrencePatternFrequency `json:"frequency"`
+	// MinAmount Minimum amount charged to the account
+	MinAmount *float32 `json:"minAmount,omitempty"`
 
-	// Id Unique identifier for the recurrence pattern
-	Id string `json:"id"`
+	// Name Name of the rule
+	Name string `json:"name"`
 
-	// Interval Interval value for the frequency (e.g., every 2 weeks)
-	Interval int `json:"interval"`
+	// PayeeId Payee of the expenditure
+	PayeeId *string `json:"payeeId,omitempty"`
 
-	// ToAccountId ID of the associated ingress
-	ToAccountId string `json:"to_account_id"`
+	// Priority Rules run by priority, lowest first
+	Priority *int `json:"priority,omitempty"`
 
-	// UpdatedAt When the recurrence pattern was last updated
-	UpdatedAt time.Time `json:"updated_at"`
+	// TagIds Expenditure tags added by the rule
+	TagIds *[]string `json:"tagIds,omitempty"`
 }
 
-// RecurrencePatternFrequency Frequency of the recurrence
-type RecurrencePatternFrequency string
+// CategorizationRun defines model for CategorizationRun.
+type CategorizationRun struct {
+	Categorizations []Categorization `json:"categorizations"`
 
-// RecurrencePatternRequest defines model for RecurrencePatternRequest.
-type RecurrencePatternRequest struct {
-	// Amount Amount for each recurrence
-	Amount float32 `json:"amount"`
+	// Total Number of expenditures changed by the rules, previews list at most 100 of them
+	Total int `json:"total"`
+}
 
-	// Description Description for the recurrence pattern
-	Description string `json:"description"`
+// Category defines model for Category.
+type Category struct {
+	// Active Whether the category is active
+	Active *bool `json:"active,omitempty"`
 
-	// EndDate End date for the recurrence pattern (null for indefinite)
-	EndDate *openapi_types.Date `json:"end_date"`
+	// BackgroundColor Background color code for UI representation (hex format)
+	BackgroundColor *string       `json:"backgroundColor,omitempty"`
+	CategoryType    *CategoryType `json:"categoryType,omitempty"`
 
-	// Frequency Frequency of the recurrence
-	Frequency RecurrencePatternRequestFrequency `json:"frequency"`
+	// Children Subcategories, only listed in the category tree
+	Children *[]Category `json:"children,omitempty"`
 
-	// Interval Interval value for the frequency (e.g., every 2 weeks)
-	Interval int `json:"interval"`
+	// Color Color code for UI representation (hex format)
+	Color *string `json:"color,omitempty"`
 
-	// ToAccountId ID of the associated ingress
-	ToAccountId string `json:"to_account_id"`
-}
+	// Description Description of the category
+	Description string `json:"description"`
 
-// RecurrencePatternRequestFrequency Frequency of the recurrence
-type RecurrencePatternRequestFrequency string
+	// Id Unique identifier for the category
+	Id string `json:"id"`
 
-// RollbackRequest defines model for RollbackRequest.
-type RollbackRequest struct {
-	// RollbackReason Reason for rolling back the Expenditure
-	RollbackReason string `json:"rollbackReason"`
+	// Name Name of the category
+	Name string `json:"name"`
+
+	// ParentId Category this one is grouped under, of the same type. Top level categories have none
+	ParentId *string `json:"parentId,omitempty"`
 }
 
-// SavingsContribution defines model for SavingsContribution.
-type SavingsContribution struct {
-	// Amount The contribution amount
-	Amount float32 `json:"amount"`
+// CategoryMergeAction What happens to the source category once everything was moved to the target one
+type CategoryMergeAction string
 
-	// CreatedAt Timestamp when the contribution was created
-	CreatedAt time.Time `json:"createdAt"`
+// CategoryMergeRequest defines model for CategoryMergeRequest.
+type CategoryMergeRequest struct {
+	// SourceAction What happens to the source category once everything was moved to the target one
+	SourceAction *CategoryMergeAction `json:"sourceAction,omitempty"`
 
-	// Date The date of the contribution
-	Date openapi_types.Date `json:"date"`
+	// TargetId Category of the same type everything is moved to
+	TargetId string `json:"targetId"`
+}
 
-	// Id Unique identifier for the contribution
-	Id string `json:"id"`
+// CategoryMergeResult defines model for CategoryMergeResult.
+type CategoryMergeResult struct {
+	// Moved Number of records moved from the source category to the target one
+	Moved  CategoryReassignments `json:"moved"`
+	Target Category              `json:"target"`
+}
 
-	// Notes Additional notes about the contribution
-	Notes *string `json:"notes,omitempty"`
+// CategoryPlannedSpending defines model for CategoryPlannedSpending.
+type CategoryPlannedSpending struct {
+	// CategoryId Category ID
+	CategoryId string `json:"categoryId"`
 
-	// SavingsGoalId ID of the savings goal this contribution belongs to
-	SavingsGoalId string `json:"savingsGoalId"`
+	// CategoryName Category name
+	CategoryName string                `json:"categoryName"`
+	Totals       PlannedSpendingTotals `json:"totals"`
+}
 
-	// SourceAccountId ID of the account the contribution is coming from
-	SourceAccountId string `json:"sourceAccountId"`
+// CategoryReassignments Number of records moved from the source category to the target one
+type CategoryReassignments struct {
+	// Allocations Allocations in plans that also allocated to the target are added to the target allocation
+	Allocations      int64 `json:"allocations"`
+	Budgets          int64 `json:"budgets"`
+	Expenditures     int64 `json:"expenditures"`
+	Ingresses        int64 `json:"ingresses"`
+	InstallmentPlans int64 `json:"installmentPlans"`
 
-	// Tags List of tag IDs associated with this contribution
-	Tags *[]Tag `json:"tags,omitempty"`
+	// Payees Payees defaulting to the source category
+	Payees         int64 `json:"payees"`
+	RecurringBills int64 `json:"recurringBills"`
 
-	// UpdatedAt Timestamp when the contribution was last updated
-	UpdatedAt time.Time `json:"updatedAt"`
+	// Rules Categorization rules assigning the source category
+	Rules         int64 `json:"rules"`
+	SavingsGoals  int64 `json:"savingsGoals"`
+	Subcategories int64 `json:"subcategories"`
 }
 
-// SavingsContributionRequest defines model for SavingsContributionRequest.
-type SavingsContributionRequest struct {
-	// Amount The contribution amount
-	Amount float32 `json:"amount"`
+// CategoryRequest defines model for CategoryRequest.
+type CategoryRequest struct {
+	// BackgroundColor Background color code for UI representation (hex format)
+	BackgroundColor *string       `json:"backgroundColor,omitempty"`
+	CategoryType    *CategoryType `json:"categoryType,omitempty"`
 
-	// Date The date of the contribution
-	Date openapi_types.Date `json:"date"`
+	// Color Color code for UI representation (hex format)
+	Color *string `json:"color,omitempty"`
 
-	// Notes Additional notes about the contribution
-	Notes *string `json:"notes,omitempty"`
+	// Description Description of the category
+	Description string `json:"description"`
 
-	// SourceAccountId ID of the account the contribution is coming from
-	SourceAccountId string `json:"sourceAccountId"`
+	// Name Name of the category
+	Name string `json:"name"`
 
-	// Tags List of tag IDs associated with this contribution
-	Tags *[]Tag `json:"tags,omitempty"`
+	// ParentId Category this one is grouped under, of the same type. Top level categories have none
+	ParentId *string `json:"parentId,omitempty"`
 }
 
-// SavingsGoal defines model for SavingsGoal.
-type SavingsGoal struct {
-	// AccountId ID of the account where funds are stored
-	AccountId string `json:"accountId"`
+// CategorySuggestion defines model for CategorySuggestion.
+type CategorySuggestion struct {
+	// Categories Active categories ranked by their probability, the probabilities of all the learned categories add up to one
+	Categories []ScoredSuggestion `json:"categories"`
 
-	// AutoContribute Whether to automatically contribute to this goal
-	AutoContribute *bool `json:"autoContribute,omitempty"`
+	// Status What the suggestion model learned from
+	Status SuggestionModelStatus `json:"status"`
 
-	// AutoContributeAmount Amount to auto-contribute periodically
-	AutoContributeAmount *float32 `json:"autoContributeAmount,omitempty"`
+	// Tags Tags more likely than not on the expenditure, ranked by their probability
+	Tags []ScoredSuggestion `json:"tags"`
+}
 
-	// AutoContributeFrequency Frequency of auto-contributions
-	AutoContributeFrequency *SavingsGoalAutoContributeFrequency `json:"autoContributeFrequency,omitempty"`
-	Category                Category                            `json:"category"`
+// CategoryType defines model for CategoryType.
+type CategoryType string
 
-	// CreatedAt Timestamp when the savings goal was created
-	CreatedAt *time.Time `json:"createdAt,omitempty"`
+// CategoryUpdateRequest defines model for CategoryUpdateRequest.
+type CategoryUpdateRequest struct {
+	// Active Whether the category is active, only accepted unchanged. Categories are activated and deactivated through their own endpoints, which also handle their subcategories
+	Active *bool `json:"active,omitempty"`
 
-	// Currency Currency of the savings goal
-	Currency string `json:"currency"`
+	// BackgroundColor Background color code for UI representation (hex format)
+	BackgroundColor *string       `json:"backgroundColor,omitempty"`
+	CategoryType    *CategoryType `json:"categoryType,omitempty"`
 
-	// CurrentAmount Current amount saved
-	CurrentAmount *float32 `json:"currentAmount,omitempty"`
+	// Color Color code for UI representation (hex format)
+	Color *string `json:"color,omitempty"`
 
-	// Description Detailed description of the savings goal
-	Description *string `json:"description,omitempty"`
+	// Description Description of the category
+	Description string `json:"description"`
 
-	// Id Unique identifier for the savings goal
-	Id *string `json:"id,omitempty"`
+	// Name Name of the category
+	Name string `json:"name"`
 
-	// InitialAmount Initial amount already saved
-	InitialAmount *float32 `json:"initialAmount,omitempty"`
+	// ParentId Category this one is grouped under, of the same type. Top level categories have none
+	ParentId *string `json:"parentId,omitempty"`
+}
 
-	// Name Name of the savings goal
-	Name string `json:"name"`
+// DeclaredExpense defines model for DeclaredExpense.
+type DeclaredExpense struct {
+	// AccountId Account the expenditure was paid from
+	AccountId string `json:"accountId"`
 
-	// PercentComplete Percentage of goal completed
-	PercentComplete *float32 `json:"percentComplete,omitempty"`
+	// AccountName Name of the account
+	AccountName string `json:"accountName"`
 
-	// Priority Priority level (1-5, where 1 is highest)
-	Priority *int `json:"priority,omitempty"`
+	// Amount Amount of the expenditure
+	Amount float64 `json:"amount"`
 
-	// ProjectedCompletionDate Estimated completion date based on contribution history
-	ProjectedCompletionDate *openapi_types.Date `json:"projectedCompletionDate,omitempty"`
+	// CategoryId Category ID
+	CategoryId string `json:"categoryId"`
 
-	// Status Current status of the savings goal
-	Status *SavingsGoalStatus `json:"status,omitempty"`
+	// CategoryName Category name
+	CategoryName string `json:"categoryName"`
 
-	// Tags List of tag IDs associated with this goal
-	Tags *[]Tag `json:"tags,omitempty"`
+	// Currency ID of the currency of the amount
+	Currency string `json:"currency"`
 
-	// TargetAmount Target amount to save
-	TargetAmount float32 `json:"targetAmount"`
+	// Date Date of the expenditure
+	Date openapi_types.Date `json:"date"`
 
-	// TargetDate Target date to reach the goal (optional)
-	TargetDate *openapi_types.Date `json:"targetDate,omitempty"`
-	UpdatedAt  *time.Time          `json:"updatedAt,omitempty"`
-}
+	// Description Description of the expenditure
+	Description string `json:"description"`
 
-// SavingsGoalAutoContributeFrequency Frequency of auto-contributions
-type SavingsGoalAutoContributeFrequency string
+	// ExpenditureId Expenditure ID
+	ExpenditureId string `json:"expenditureId"`
 
-// SavingsGoalStatus Current status of the savings goal
-type SavingsGoalStatus string
+	// MemberId Household member owning the account
+	MemberId string `json:"memberId"`
 
-// SavingsGoalList defines model for SavingsGoalList.
-type SavingsGoalList struct {
-	Metadata     *ListMetadata  `json:"metadata,omitempty"`
-	SavingsGoals *[]SavingsGoal `json:"savingsGoals,omitempty"`
+	// MemberName Full name of the household member
+	MemberName string     `json:"memberName"`
+	Tags       []TagLabel `json:"tags"`
 }
 
-// SavingsGoalRequest defines model for SavingsGoalRequest.
-type SavingsGoalRequest struct {
-	// AccountId ID of the account where funds are stored
-	AccountId string `json:"accountId"`
+// DeclaredExpenseTotal defines model for DeclaredExpenseTotal.
+type DeclaredExpenseTotal struct {
+	// Count Number of declared expenses
+	Count int `json:"count"`
 
-	// AutoContribute Whether to automatically contribute to this goal
-	AutoContribute *bool `json:"autoContribute,omitempty"`
+	// Currency ID of the currency of the total
+	Currency string `json:"currency"`
 
-	// AutoContributeAmount Amount to auto-contribute periodically
-	AutoContributeAmount *float32 `json:"autoContributeAmount,omitempty"`
+	// Id ID of the grouped category, tag or member, empty for the overall totals
+	Id string `json:"id"`
 
-	// AutoContributeFrequency Frequency of auto-contributions
-	AutoContributeFrequency *SavingsGoalRequestAutoContributeFrequency `json:"autoContributeFrequency,omitempty"`
-	Category                Category                                   `json:"category"`
+	// Name Name of the grouped category, tag or member, empty for the overall totals
+	Name string `json:"name"`
 
-	// Currency Currency of the savings goal
-	Currency string `json:"currency"`
+	// Total Sum of the declared expenses
+	Total float64 `json:"total"`
+}
 
-	// Description Detailed description of the savings goal
-	Description *string `json:"description,omitempty"`
+// DeclaredExpensesReport defines model for DeclaredExpensesReport.
+type DeclaredExpensesReport struct {
+	ByCategory []DeclaredExpenseTotal `json:"byCategory"`
 
-	// InitialAmount Initial amount already saved
-	InitialAmount *float32 `json:"initialAmount,omitempty"`
+	// ByMember Totals per household member owning the paying account
+	ByMember []DeclaredExpenseTotal `json:"byMember"`
 
-	// Name Name of the savings goal
-	Name string `json:"name"`
+	// ByTag Totals per tag, an expense with several tags counts once for each of them
+	ByTag []DeclaredExpenseTotal `json:"byTag"`
 
-	// Priority Priority level (1-5, where 1 is highest)
-	Priority *int `json:"priority,omitempty"`
+	// End Last day of the fiscal year
+	End openapi_types.Date `json:"end"`
 
-	// Tags List of tag IDs associated with this goal
-	Tags *[]Tag `json:"tags,omitempty"`
+	// Expenses Declared expenses ordered by date
+	Expenses []DeclaredExpense `json:"expenses"`
 
-	// TargetAmount Target amount to save
-	TargetAmount float32 `json:"targetAmount"`
+	// FiscalYear Fiscal year of the report, named after the calendar year it starts in
+	FiscalYear int `json:"fiscalYear"`
 
-	// TargetDate Target date to reach the goal (optional)
-	TargetDate *openapi_types.Date `json:"targetDate,omitempty"`
+	// Start First day of the fiscal year
+	Start openapi_types.Date `json:"start"`
+
+	// Totals Total of the declared expenses per currency
+	Totals []DeclaredExpenseTotal `json:"totals"`
 }
 
-// SavingsGoalRequestAutoContributeFrequency Frequency of auto-contributions
-type SavingsGoalRequestAutoContributeFrequency string
+// DescriptionCondition defines model for DescriptionCondition.
+type DescriptionCondition struct {
+	// Match How the pattern is compared with transaction descriptions, contains ignores case
+	Match DescriptionMatch `json:"match"`
 
-// SavingsProgress defines model for SavingsProgress.
-type SavingsProgress struct {
-	ContributionHistory *[]struct {
-		// Amount Total contributions for that month
-		Amount *float32 `json:"amount,omitempty"`
+	// Pattern Text or regular expression the description must match
+	Pattern string `json:"pattern"`
+}
 
-		// Month Month of contributions (YYYY-MM format)
-		Month *openapi_types.Date `json:"month,omitempty"`
-	} `json:"contributionHistory,omitempty"`
+// DescriptionMatch How the pattern is compared with transaction descriptions, contains ignores case
+type DescriptionMatch string
 
-	// Currency Currency of the savings goal
-	Currency string `json:"currency"`
+// Error defines model for Error.
+type Error struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+}
 
-	// CurrentAmount Current amount saved
-	CurrentAmount float32 `json:"currentAmount"`
+// ErrorCode defines model for ErrorCode.
+type ErrorCode string
 
-	// DaysRemaining Number of days remaining until target date
-	DaysRemaining *int `json:"daysRemaining,omitempty"`
+// ExchangeRates defines model for ExchangeRates.
+type ExchangeRates struct {
+	// BaseCurrency Base currency for the rates
+	BaseCurrency string `json:"baseCurrency"`
 
-	// GoalId ID of the savings goal
-	GoalId string `json:"goalId"`
+	// Date Date of the exchange rates
+	Date openapi_types.Date `json:"date"`
 
-	// Name Name of the savings goal
-	Name string `json:"name"`
+	// Rates Exchange rates for different currencies
+	Rates map[string]float32 `json:"rates"`
+}
 
-	// OnTrack Whether the goal is on track to be completed by target date
-	OnTrack *bool `json:"onTrack,omitempty"`
+// Expenditure defines model for Expenditure.
+type Expenditure struct {
+	// AccountId The account ID this expenditure is associated with
+	AccountId string `json:"accountId"`
 
-	// PercentComplete Percentage of goal completed
-	PercentComplete float32 `json:"percentComplete"`
+	// Amount The expenditure amount
+	Amount   float32  `json:"amount"`
+	Category Category `json:"category"`
 
-	// ProjectedCompletionDate Projected date when goal will be reached based on current rate
-	ProjectedCompletionDate *openapi_types.Date `json:"projectedCompletionDate,omitempty"`
-	RecentActivity          *[]struct {
-		// Amount Amount of activity
-		Amount *float32 `json:"amount,omitempty"`
+	// ChargedAmount Amount debited from the account, in the account currency
+	ChargedAmount float32 `json:"chargedAmount"`
 
-		// Date Date of activity
-		Date *openapi_types.Date `json:"date,omitempty"`
+	// ChargedCurrency ID of the account currency the expenditure was charged in
+	ChargedCurrency string `json:"chargedCurrency"`
 
-		// Description Description of activity
-		Description *string `json:"description,omitempty"`
+	// CreatedAt Timestamp when the expenditure was created
+	CreatedAt time.Time `json:"createdAt"`
 
-		// Type Type of activity
-		Type *SavingsProgressRecentActivityType `json:"type,omitempty"`
-	} `json:"recentActivity,omitempty"`
+	// Currency ID of the currency used for the expenditure. When it is not the account currency, the amount is charged to the account converted at exchangeRate.
+	Currency string `json:"currency"`
 
-	// RemainingAmount Amount still needed to reach goal
-	RemainingAmount float32 `json:"remainingAmount"`
+	// Date The date of the expenditure
+	Date openapi_types.Date `json:"date"`
 
-	// TargetAmount Target amount to save
-	TargetAmount float32 `json:"targetAmount"`
+	// Declared Whether the expenditure has been fiscally declared
+	Declared *bool `json:"declared,omitempty"`
 
-	// TargetDate Target date to reach the goal
-	TargetDate *openapi_types.Date `json:"targetDate,omitempty"`
-}
+	// Description The description of the expenditure
+	Description string `json:"description"`
 
-// SavingsProgressRecentActivityType Type of activity
-type SavingsProgressRecentActivityType string
+	// ExchangeRate Units of the account currency charged per unit of the expenditure currency. Defaults to the latest exchange rate dated on or before the expenditure date. Ignored when both currencies are the same.
+	ExchangeRate *float32 `json:"exchangeRate,omitempty"`
 
-// SavingsTransaction defines model for SavingsTransaction.
-type SavingsTransaction struct {
-	// AccountId ID of the account from/to which the money was transferred
-	AccountId *string `json:"accountId,omitempty"`
+	// FailureReason Why a scheduled expenditure could not be posted, only set when failed
+	FailureReason *string `json:"failureReason,omitempty"`
 
-	// Amount Amount of the transaction
-	Amount float32 `json:"amount"`
+	// Id Unique identifier for the expenditure
+	Id string `json:"id"`
 
-	// Date Date and time when the transaction occurred
-	Date time.Time `json:"date"`
+	// InstallmentNumber Number of the installment charged, starting at 1
+	InstallmentNumber *int `json:"installmentNumber,omitempty"`
 
-	// Description Optional description of the transaction
-	Description *string `json:"description,omitempty"`
+	// InstallmentPlanId Installment plan this expenditure charges an installment of, if any
+	InstallmentPlanId *string `json:"installmentPlanId,omitempty"`
 
-	// Id Unique identifier for the transaction
-	Id string `json:"id"`
+	// PayeeId Payee of the expenditure, if any
+	PayeeId *string `json:"payeeId,omitempty"`
 
-	// SavingsGoalId ID of the savings goal this transaction belongs to
-	SavingsGoalId string `json:"savingsGoalId"`
+	// Planned Whether the expenditure is planned or non-planned
+	Planned *bool `json:"planned,omitempty"`
 
-	// Tags List of tag IDs associated with this transaction
+	// RecurrencePatternId Recurring bill this expenditure was generated from, if any
+	RecurrencePatternId *string `json:"recurrencePatternId,omitempty"`
+
+	// Status Posting status of the expenditure. Future-dated expenditures stay pending until their date and do not affect the account balance until then.
+	Status ExpenditureStatus `json:"status"`
+
+	// Tags List of tag IDs associated with this expenditure
 	Tags *[]Tag `json:"tags,omitempty"`
 
-	// TransactionId ID of the related financial transaction (if applicable)
-	TransactionId *string `json:"transactionId,omitempty"`
+	// UpdatedAt Timestamp when the expenditure was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
 
-	// Type Type of transaction
-	Type SavingsTransactionType `json:"type"`
+// ExpenditureBatchCreated defines model for ExpenditureBatchCreated.
+type ExpenditureBatchCreated struct {
+	Expenditure Expenditure `json:"expenditure"`
+
+	// Index Position of the item in the batch request
+	Index int `json:"index"`
 }
 
-// SavingsTransactionType Type of transaction
-type SavingsTransactionType string
+// ExpenditureBatchError defines model for ExpenditureBatchError.
+type ExpenditureBatchError struct {
+	// Index Position of the item in the batch request
+	Index int `json:"index"`
 
-// SavingsTransactionList defines model for SavingsTransactionList.
-type SavingsTransactionList struct {
-	Metadata     *ListMetadata        `json:"metadata,omitempty"`
-	Transactions []SavingsTransaction `json:"transactions"`
+	// Message Why the item could not be created
+	Message string `json:"message"`
 }
 
-// SavingsWithdrawal defines model for SavingsWithdrawal.
-type SavingsWithdrawal struct {
-	// Amount The withdrawal amount
-	Amount float32 `json:"amount"`
+// ExpenditureBatchItem An ExpenditureRequest of a batch. Its fields are all optional here so that missing or invalid ones are reported by the index of the item instead of rejecting the whole request.
+type ExpenditureBatchItem struct {
+	// AccountId The account ID this expenditure is associated with
+	AccountId *string `json:"accountId,omitempty"`
 
-	// CreatedAt Timestamp when the withdrawal was created
-	CreatedAt time.Time `json:"createdAt"`
+	// Amount The expenditure amount, greater than zero
+	Amount   *float32  `json:"amount,omitempty"`
+	Category *Category `json:"category,omitempty"`
 
-	// Date The date of the withdrawal
-	Date openapi_types.Date `json:"date"`
+	// Currency ID of the currency used for the expenditure. When it is not the account currency, the amount is charged to the account converted at exchangeRate.
+	Currency *string `json:"currency,omitempty"`
 
-	// DestinationAccountId ID of the account the withdrawal is going to
-	DestinationAccountId string `json:"destinationAccountId"`
+	// Date The date of the expenditure, defaults to today
+	Date *openapi_types.Date `json:"date,omitempty"`
 
-	// Id Unique identifier for the withdrawal
-	Id string `json:"id"`
+	// Declared Whether the expenditure has been fiscally declared
+	Declared *bool `json:"declared,omitempty"`
 
-	// Notes Additional notes about the withdrawal
-	Notes *string `json:"notes,omitempty"`
+	// Description The description of the expenditure
+	Description *string `json:"description,omitempty"`
 
-	// Reason Reason for the withdrawal
-	Reason string `json:"reason"`
+	// ExchangeRate Units of the account currency charged per unit of the expenditure currency, greater than zero. Defaults to the latest exchange rate dated on or before the expenditure date.
+	ExchangeRate *float32 `json:"exchangeRate,omitempty"`
 
-	// SavingsGoalId ID of the savings goal this withdrawal belongs to
-	SavingsGoalId string `json:"savingsGoalId"`
+	// PayeeId Payee of the expenditure, see ExpenditureRequest
+	PayeeId *string `json:"payeeId,omitempty"`
 
-	// Tags List of tags associated with this withdrawal
-	Tags *[]Tag `json:"tags,omitempty"`
+	// Planned Whether the expenditure is planned or non-planned
+	Planned *bool `json:"planned,omitempty"`
 
-	// UpdatedAt Timestamp when the withdrawal was last updated
-	UpdatedAt time.Time `json:"updatedAt"`
+	// Tags List of tags associated with this expenditure
+	Tags *[]Tag `json:"tags,omitempty"`
 }
 
-// SavingsWithdrawalRequest defines model for SavingsWithdrawalRequest.
-type SavingsWithdrawalRequest struct {
-	// Amount The withdrawal amount
-	Amount float32 `json:"amount"`
+// ExpenditureBatchRequest defines model for ExpenditureBatchRequest.
+type ExpenditureBatchRequest struct {
+	// Items Between 1 and 500 expenditures. The limits and the items are checked by the batch itself, so failed items are reported by index.
+	Items []ExpenditureBatchItem `json:"items"`
 
-	// Date The date of the withdrawal
-	Date openapi_types.Date `json:"date"`
+	// Mode What happens to the valid items of a batch when others fail: nothing is created (allOrNothing) or the valid items are created anyway (bestEffort)
+	Mode BatchMode `json:"mode"`
+}
 
-	// DestinationAccountId ID of the account the withdrawal is going to
-	DestinationAccountId string `json:"destinationAccountId"`
+// ExpenditureBatchResult defines model for ExpenditureBatchResult.
+type ExpenditureBatchResult struct {
+	// Created Created expenditures, ordered by index
+	Created []ExpenditureBatchCreated `json:"created"`
 
-	// Notes Additional notes about the withdrawal
-	Notes *string `json:"notes,omitempty"`
+	// Errors Items that could not be created, ordered by index
+	Errors []ExpenditureBatchError `json:"errors"`
 
-	// Reason Reason for the withdrawal
-	Reason string `json:"reason"`
+	// Mode What happens to the valid items of a batch when others fail: nothing is created (allOrNothing) or the valid items are created anyway (bestEffort)
+	Mode BatchMode `json:"mode"`
+}
 
-	// Tags List of tags associated with this withdrawal
-	Tags *[]Tag `json:"tags,omitempty"`
+// ExpenditureList defines model for ExpenditureList.
+type ExpenditureList struct {
+	Expenditures *[]Expenditure `json:"expenditures,omitempty"`
+	Metadata     *ListMetadata  `json:"metadata,omitempty"`
 }
 
-// Tag defines model for Tag.
-type Tag struct {
-	// BackgroundColor Background color code for UI representation (hex format)
-	BackgroundColor *string `json:"backgroundColor,omitempty"`
+// ExpenditureRecurrencePattern defines model for ExpenditureRecurrencePattern.
+type ExpenditureRecurrencePattern struct {
+	// AccountId Account the bill is paid from
+	AccountId string `json:"accountId"`
 
-	// Color Text color code for UI representation (hex format)
-	Color *string `json:"color,omitempty"`
+	// Active Whether the pattern generates bills, only used on update
+	Active bool `json:"active"`
 
-	// CreatedAt Timestamp when the tag was created
+	// Amount Amount of each bill, or its estimation for estimated bills
+	Amount float32 `json:"amount"`
+
+	// AmountType Whether the bill amount is known in advance (fixed) or only an estimation to be confirmed when the bill is posted (estimated, e.g. utilities)
+	AmountType RecurrenceAmountType `json:"amountType"`
+
+	// AutoPost Whether the expenditures are generated automatically when due, otherwise each bill waits until it is confirmed
+	AutoPost bool `json:"autoPost"`
+
+	// CategoryId Category of the generated expenditures
+	CategoryId string `json:"categoryId"`
+
+	// CreatedAt Timestamp when the recurrence pattern was created
 	CreatedAt time.Time `json:"createdAt"`
 
-	// Description Description of the tag
-	Description *string `json:"description,omitempty"`
+	// Description Description copied to the generated expenditures
+	Description string `json:"description"`
 
-	// Id Unique identifier for the tag
+	// EndDate Date of the last possible bill (null for indefinite)
+	EndDate *openapi_types.Date `json:"endDate"`
+
+	// Frequency How often a recurring bill falls due
+	Frequency RecurrenceFrequency `json:"frequency"`
+
+	// Id Unique identifier for the recurrence pattern
 	Id string `json:"id"`
 
-	// Name Name of the tag
-	Name    string  `json:"name"`
-	TagType TagType `json:"tagType"`
+	// Interval Interval value for the frequency (e.g., every 2 months)
+	Interval int `json:"interval"`
 
-	// UpdatedAt Timestamp when the tag was last updated
-	UpdatedAt time.Time `json:"updatedAt"`
-}
+	// NextDueDate Due date of the next bill to be posted
+	NextDueDate openapi_types.Date `json:"nextDueDate"`
 
-// TagRequest defines model for TagRequest.
-type TagRequest struct {
-	// BackgroundColor Background color code for UI representation (hex format)
-	BackgroundColor *string `json:"backgroundColor,omitempty"`
+	// StartDate Date of the first bill, later bills keep its day of month
+	StartDate openapi_types.Date `json:"startDate"`
 
-	// Color Text color code for UI representation (hex format)
-	Color *string `json:"color,omitempty"`
+	// UpdatedAt Timestamp when the recurrence pattern was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
 
-	// Description Description of the tag
-	Description *string `json:"description,omitempty"`
+// ExpenditureRecurrencePatternList defines model for ExpenditureRecurrencePatternList.
+type ExpenditureRecurrencePatternList struct {
+	Patterns []ExpenditureRecurrencePattern `json:"patterns"`
 
-	// Name Name of the tag
-	Name    string  `json:"name"`
-	TagType TagType `json:"tagType"`
+	// Total Total number of recurrence patterns
+	Total int `json:"total"`
 }
 
-// TagType defines model for TagType.
-type TagType string
+// ExpenditureRecurrencePatternRequest defines model for ExpenditureRecurrencePatternRequest.
+type ExpenditureRecurrencePatternRequest struct {
+	// AccountId Account the bill is paid from
+	AccountId string `json:"accountId"`
 
-// Transaction defines model for Transaction.
-type Transaction struct {
-	// BalanceAfter Account balance after the transaction (for the primary account)
-	BalanceAfter *float32 `json:"balanceAfter,omitempty"`
+	// Active Whether the pattern generates bills, only used on update
+	Active *bool `json:"active,omitempty"`
 
-	// Credit Amount credited (positive value)
-	Credit *float32 `json:"credit"`
+	// Amount Amount of each bill, or its estimation for estimated bills
+	Amount float32 `json:"amount"`
 
-	// Currency Currency of the transaction
-	Currency string `json:"currency"`
+	// AmountType Whether the bill amount is known in advance (fixed) or only an estimation to be confirmed when the bill is posted (estimated, e.g. utilities)
+	AmountType RecurrenceAmountType `json:"amountType"`
 
-	// Date Date and time when the transaction occurred
-	Date time.Time `json:"date"`
+	// AutoPost Whether the expenditures are generated automatically when due, otherwise each bill waits until it is confirmed
+	AutoPost bool `json:"autoPost"`
 
-	// Debit Amount debited (negative value)
-	Debit *float32 `json:"debit"`
+	// CategoryId Category of the generated expenditures
+	CategoryId string `json:"categoryId"`
 
-	// Description Description of the transaction
+	// Description Description copied to the generated expenditures
 	Description string `json:"description"`
 
-	// Fees Any fees associated with the transaction
-	Fees *float32 `json:"fees,omitempty"`
+	// EndDate Date of the last possible bill (null for indefinite)
+	EndDate *openapi_types.Date `json:"endDate"`
 
-	// FromAccountId Source account ID (for transfers, expenditures, savings withdrawals)
-	FromAccountId *string `json:"fromAccountId"`
+	// Frequency How often a recurring bill falls due
+	Frequency RecurrenceFrequency `json:"frequency"`
 
-	// Id Unique identifier for the transaction
-	Id string `json:"id"`
+	// Interval Interval value for the frequency (e.g., every 2 months)
+	Interval int `json:"interval"`
 
-	// OriginalTransactionId ID of the original transaction (for rollbacks)
-	OriginalTransactionId *string `json:"originalTransactionId"`
+	// StartDate Date of the first bill, later bills keep its day of month
+	StartDate openapi_types.Date `json:"startDate"`
+}
 
-	// OriginalTransactionType Type of the original transaction (for rollbacks)
-	OriginalTransactionType *TransactionOriginalTransactionType `json:"originalTransactionType"`
+// ExpenditureRecurrencePostRequest defines model for ExpenditureRecurrencePostRequest.
+type ExpenditureRecurrencePostRequest struct {
+	// Amount Actual amount of the bill, overrides the pattern amount when the bill differs from the estimation
+	Amount *float32 `json:"amount,omitempty"`
+}
 
-	// RelatedEntityId ID of the related entity (expenditure, ingress, transfer, savings goal)
-	RelatedEntityId *string `json:"relatedEntityId"`
+// ExpenditureRequest defines model for ExpenditureRequest.
+type ExpenditureRequest struct {
+	// AccountId The account ID this expenditure is associated with
+	AccountId string `json:"accountId"`
 
-	// RelatedEntityType Type of the related entity
-	RelatedEntityType *TransactionRelatedEntityType `json:"relatedEntityType"`
+	// Amount The expenditure amount
+	Amount   float32   `json:"amount"`
+	Category *Category `json:"category,omitempty"`
 
-	// RollbackReason Reason for the rollback
-	RollbackReason *string `json:"rollbackReason"`
-
-	// Status Status of the transaction
-	Status TransactionStatus `json:"status"`
-
-	// Tags List of tag IDs associated with this transaction
-	Tags *[]Tag `json:"tags,omitempty"`
+	// Currency ID of the currency used for the expenditure. When it is not the account currency, the amount is charged to the account converted at exchangeRate.
+	Currency string `json:"currency"`
 
-	// ToAccountId Destination account ID (for transfers, ingresses, savings contributions)
-	ToAccountId *string `json:"toAccountId"`
+	// Date The date of the expenditure
+	Date openapi_types.Date `json:"date"`
 
-	// TransactionType Type of transaction
-	TransactionType TransactionTransactionType `json:"transactionType"`
-}
+	// Declared Whether the expenditure has been fiscally declared
+	Declared *bool `json:"declared,omitempty"`
 
-// TransactionOriginalTransactionType Type of the original transaction (for rollbacks)
-type TransactionOriginalTransactionType string
+	// Description The description of the expenditure
+	Description string `json:"description"`
 
-// TransactionRelatedEntityType Type of the related entity
-type TransactionRelatedEntityType string
+	// ExchangeRate Units of the account currency charged per unit of the expenditure currency. Defaults to the latest exchange rate dated on or before the expenditure date. Ignored when both currencies are the same.
+	ExchangeRate *float32 `json:"exchangeRate,omitempty"`
 
-// TransactionStatus Status of the transaction
-type TransactionStatus string
+	// PayeeId Payee of the expenditure. When not set, the payee whose name or alias matches the description is used. Its default category is used when no category is given.
+	PayeeId *string `json:"payeeId,omitempty"`
 
-// TransactionTransactionType Type of transaction
-type TransactionTransactionType string
+	// Planned Whether the expenditure is planned or non-planned
+	Planned *bool `json:"planned,omitempty"`
 
-// TransactionList defines model for TransactionList.
-type TransactionList struct {
-	Metadata     ListMetadata  `json:"metadata"`
-	Transactions []Transaction `json:"transactions"`
+	// Tags List of tag IDs associated with this expenditure
+	Tags *[]Tag `json:"tags,omitempty"`
 }
 
-// Transfer defines model for Transfer.
-type Transfer struct {
-	// Date Date of the transfer
-	Date openapi_types.Date `json:"date"`
+// ExpenditureStatus Posting status of the expenditure. Future-dated expenditures stay pending until their date and do not affect the account balance until then.
+type ExpenditureStatus string
 
-	// Description Additional details about the transfer
-	Description *string `json:"description,omitempty"`
+// Household defines model for Household.
+type Household struct {
+	// CreatedAt Timestamp when the household was created
+	CreatedAt time.Time `json:"createdAt"`
 
-	// DestinationAccountId ID of the destination account
-	DestinationAccountId string `json:"destinationAccountId"`
+	// Id Unique identifier for the household, the one the users linked to its members act on
+	Id string `json:"id"`
 
-	// DestinationAmount Amount to be received at destination (for currency conversions)
-	DestinationAmount *float32 `json:"destinationAmount,omitempty"`
+	// Name Name of the household
+	Name string `json:"name"`
+}
 
-	// DestinationCurrencyId ID of the destination currency
-	DestinationCurrencyId *string `json:"destinationCurrencyId,omitempty"`
+// HouseholdMember defines model for HouseholdMember.
+type HouseholdMember struct {
+	// Active Whether the household member is currently active in budget planning
+	Active *bool `json:"active,omitempty"`
 
-	// ExchangeRate Exchange rate used for currency conversion
-	ExchangeRate *float32 `json:"exchangeRate,omitempty"`
+	// CreatedAt Timestamp when the record was created
+	CreatedAt time.Time `json:"createdAt"`
 
-	// Fees Any fees associated with the transfer
-	Fees *float32 `json:"fees,omitempty"`
+	// FirstName First name of the household member
+	FirstName string `json:"firstName"`
 
-	// Id Unique identifier for the transfer
+	// Id Unique identifier for the household member
 	Id string `json:"id"`
 
-	// SourceAccountId ID of the source account
-	SourceAccountId string `json:"sourceAccountId"`
+	// LastName Last name of the household member
+	LastName string `json:"lastName"`
 
-	// SourceAmount Amount to transfer from source account
-	SourceAmount *float32 `json:"sourceAmount,omitempty"`
+	// Nickname Nickname or preferred name of the household member
+	Nickname *string `json:"nickname,omitempty"`
 
-	// SourceCurrencyId ID of the source currency
-	SourceCurrencyId *string `json:"sourceCurrencyId,omitempty"`
+	// Role Role in the household (e.g., parent, child, roommate)
+	Role string `json:"role"`
 
-	// Status Status of the transfer
-	Status TransferStatus `json:"status"`
+	// UpdatedAt Timestamp when the record was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// TransferStatus Status of the transfer
-type TransferStatus string
-
-// TransferList defines model for TransferList.
-type TransferList struct {
-	Metadata  *ListMetadata `json:"metadata,omitempty"`
-	Transfers *[]Transfer   `json:"transfers,omitempty"`
+// HouseholdMemberList defines model for HouseholdMemberList.
+type HouseholdMemberList struct {
+	// Members List of household members
+	Members *[]HouseholdMember `json:"members,omitempty"`
 }
 
-// TransferRequest defines model for TransferRequest.
-type TransferRequest struct {
-	// Date Date of the transfer
-	Date openapi_types.Date `json:"date"`
+// HouseholdMemberRequest defines model for HouseholdMemberRequest.
+type HouseholdMemberRequest struct {
+	// Active Whether the household member is currently active in budget planning
+	Active *bool `json:"active,omitempty"`
 
-	// Description Additional details about the transfer
-	Description *string `json:"description,omitempty"`
+	// FirstName First name of the household member
+	FirstName string `json:"firstName"`
 
-	// DestinationAccountId ID of the destination account
-	DestinationAccountId string `json:"destinationAccountId"`
+	// LastName Last name of the household member
+	LastName string `json:"lastName"`
 
-	// DestinationAmount Amount to be received at destination (for currency conversions)
-	DestinationAmount *float32 `json:"destinationAmount,omitempty"`
+	// Nickname Nickname or preferred name of the household member
+	Nickname *string `json:"nickname,omitempty"`
 
-	// DestinationCurrencyId ID of the destination currency
-	DestinationCurrencyId *string `json:"destinationCurrencyId,omitempty"`
+	// Role Role in the household (e.g., parent, child, roommate)
+	Role string `json:"role"`
+}
 
-	// ExchangeRate Exchange rate used for currency conversion
-	ExchangeRate *float32 `json:"exchangeRate,omitempty"`
+// HouseholdRequest defines model for HouseholdRequest.
+type HouseholdRequest struct {
+	// Name Name of the household
+	Name string `json:"name"`
+}
 
-	// Fees Any fees associated with the transfer
-	Fees *float32 `json:"fees,omitempty"`
+// IncomeBasis Income the allocations must fit in. expected adds up the ingress recurrence patterns and the one-off ingresses of the month, actual the ingresses already received.
+type IncomeBasis string
 
-	// SourceAccountId ID of the source account
-	SourceAccountId string `json:"sourceAccountId"`
+// Ingress defines model for Ingress.
+type Ingress struct {
+	// AccountId The ID of the account this ingress belongs to
+	AccountId string `json:"accountId"`
 
-	// SourceAmount Amount to transfer from source account
-	SourceAmount *float32 `json:"sourceAmount,omitempty"`
+	// Amount The ingress amount
+	Amount   float32  `json:"amount"`
+	Category Category `json:"category"`
 
-	// SourceCurrencyId ID of the source currency
-	SourceCurrencyId *string `json:"sourceCurrencyId,omitempty"`
-}
+	// CreatedAt The timestamp when the ingress was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
-// User defines model for User.
-type User struct {
-	// CreatedAt When the user account was created
-	CreatedAt time.Time `json:"createdAt"`
+	// Currency The currency of the ingress
+	Currency string `json:"currency"`
 
-	// Email User's email address
-	Email openapi_types.Email `json:"email"`
+	// Date The date of the ingress
+	Date openapi_types.Date `json:"date"`
 
-	// FirstName User's first name
-	FirstName string `json:"firstName"`
+	// Description Additional details about the ingress
+	Description *string `json:"description,omitempty"`
 
-	// Id Unique identifier for the user
+	// Id Unique identifier for the ingress
 	Id string `json:"id"`
 
-	// LastName User's last name
-	LastName string `json:"lastName"`
+	// PayeeId Payee the income comes from, if any
+	PayeeId           *string            `json:"payeeId,omitempty"`
+	RecurrencePattern *RecurrencePattern `json:"recurrencePattern,omitempty"`
 
-	// UpdatedAt When the user account was last updated
-	UpdatedAt time.Time `json:"updatedAt"`
+	// Source The source of the income
+	Source *string `json:"source,omitempty"`
+
+	// Tags List of tag IDs associated with this ingress
+	Tags *[]Tag `json:"tags,omitempty"`
 }
 
-// N400 defines model for 400.
-type N400 = Error
+// IngressList defines model for IngressList.
+type IngressList struct {
+	Incomes  *[]Ingress    `json:"incomes,omitempty"`
+	Metadata *ListMetadata `json:"metadata,omitempty"`
+}
 
-// N404 defines model for 404.
-type N404 = Error
+// IngressRequest defines model for IngressRequest.
+type IngressRequest struct {
+	// AccountId The ID of the account this ingress belongs to
+	AccountId string `json:"accountId"`
 
-// N409 defines model for 409.
-type N409 = Error
+	// Amount The ingress amount
+	Amount   float32  `json:"amount"`
+	Category Category `json:"category"`
 
-// N500 defines model for 500.
-type N500 = Error
+	// CreatedAt The timestamp when the ingress was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
-// ListAccountsParams defines parameters for ListAccounts.
-type ListAccountsParams struct {
-	// Type Filter by account type (bank, cash, platform)
-	Type *string `form:"type,omitempty" json:"type,omitempty"`
+	// Currency The currency of the ingress
+	Currency string `json:"currency"`
 
-	// Currency Filter by currency
-	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+	// Date The date of the ingress
+	Date openapi_types.Date `json:"date"`
 
-	// Active Filter by active status
-	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+	// Description Additional details about the ingress
+	Description *string `json:"description,omitempty"`
 
-	// Limit Limit the number of results
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// PayeeId Payee the income comes from, if any
+	PayeeId           *string            `json:"payeeId,omitempty"`
+	RecurrencePattern *RecurrencePattern `json:"recurrencePattern,omitempty"`
 
-	// Offset Offset the result set
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	// Source The source of the income
+	Source *string `json:"source,omitempty"`
+
+	// Tags List of tag IDs associated with this ingress
+	Tags *[]Tag `json:"tags,omitempty"`
 }
 
-// RegisterUserJSONBody defines parameters for RegisterUser.
-type RegisterUserJSONBody struct {
-	// Email User's email address
-	Email openapi_types.Email `json:"email"`
+// InstallmentCharge defines model for InstallmentCharge.
+type InstallmentCharge struct {
+	// Amount Amount of the installment
+	Amount float32 `json:"amount"`
 
-	// FirstName User's first name
-	FirstName string `json:"firstName"`
+	// ChargeDate Date the installment is charged on, or was charged on when paid off early
+	ChargeDate openapi_types.Date `json:"chargeDate"`
 
-	// LastName User's last name
-	LastName string `json:"lastName"`
+	// Currency ID of the currency of the installment
+	Currency string `json:"currency"`
 
-	// Password User's password
-	Password string `json:"password"`
-}
+	// ExpenditureId Expenditure charging the installment
+	ExpenditureId string `json:"expenditureId"`
 
-// GetBalancesParams defines parameters for GetBalances.
-type GetBalancesParams struct {
-	// Currency Convert all balances to this currency
-	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+	// Number Installment number, starting at 1
+	Number int `json:"number"`
 
-	// GroupBy How to group the balance results
-	GroupBy *GetBalancesParamsGroupBy `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+	// Status Posting status of the expenditure. Future-dated expenditures stay pending until their date and do not affect the account balance until then.
+	Status ExpenditureStatus `json:"status"`
 }
 
-// GetBalancesParamsGroupBy defines parameters for GetBalances.
-type GetBalancesParamsGroupBy string
+// InstallmentObligation defines model for InstallmentObligation.
+type InstallmentObligation struct {
+	// AccountId Account the installments are charged to
+	AccountId string `json:"accountId"`
 
-// ListCategoriesParams defines parameters for ListCategories.
-type ListCategoriesParams struct {
-	// Type Filter categories by type
-	Type *CategoryType `form:"type,omitempty" json:"type,omitempty"`
-}
+	// Currency ID of the currency of the installments
+	Currency string `json:"currency"`
 
-// GetExchangeRatesParams defines parameters for GetExchangeRates.
-type GetExchangeRatesParams struct {
-	// BaseCurrency Base currency for rates
-	BaseCurrency string `form:"baseCurrency" json:"baseCurrency"`
+	// HouseholdMemberId Owner of the account
+	HouseholdMemberId string `json:"householdMemberId"`
 
-	// TargetCurrencies Target currencies to get rates for
-	TargetCurrencies *[]string `form:"targetCurrencies,omitempty" json:"targetCurrencies,omitempty"`
+	// PendingInstallments Number of installments not charged yet, failed charges included
+	PendingInstallments int `json:"pendingInstallments"`
+
+	// Plans Number of installment plans with pending installments
+	Plans int `json:"plans"`
+
+	// RemainingAmount What the unpaid installments of the account add up to, failed charges included
+	RemainingAmount float32 `json:"remainingAmount"`
 }
 
-// ListExpendituresParams defines parameters for ListExpenditures.
-type ListExpendituresParams struct {
-	// CategoryId Filter by category ID
-	CategoryId *string `form:"categoryId,omitempty" json:"categoryId,omitempty"`
+// InstallmentObligationList defines model for InstallmentObligationList.
+type InstallmentObligationList struct {
+	// Obligations Remaining obligations by household member and account
+	Obligations []InstallmentObligation `json:"obligations"`
+}
 
-	// Tag Filter by tag
-	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+// InstallmentPlan defines model for InstallmentPlan.
+type InstallmentPlan struct {
+	// AccountId Account the installments are charged to
+	AccountId string `json:"accountId"`
 
-	// StartDate Filter by start date (inclusive)
-	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
+	// CategoryId Category of the installment expenditures
+	CategoryId string `json:"categoryId"`
 
-	// EndDate Filter by end date (inclusive)
-	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
+	// Charges Installments of the plan, by number
+	Charges []InstallmentCharge `json:"charges"`
 
-	// Declared Filter by declared status
-	Declared *bool `form:"declared,omitempty" json:"declared,omitempty"`
+	// CreatedAt Timestamp when the installment plan was created
+	CreatedAt time.Time `json:"createdAt"`
 
-	// Planned Filter by planned status
-	Planned *bool `form:"planned,omitempty" json:"planned,omitempty"`
+	// Description Description of the purchase, copied to every installment with its number
+	Description string `json:"description"`
 
-	// Currency Filter by currency
-	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+	// FinancedAmount Total amount plus its interest, what all the installments add up to
+	FinancedAmount float32 `json:"financedAmount"`
 
-	// Description Filter by description
-	Description *string `form:"description,omitempty" json:"description,omitempty"`
+	// FirstChargeDate Date of the first installment, later installments keep its day of month
+	FirstChargeDate openapi_types.Date `json:"firstChargeDate"`
 
-	// AccountId Filter by account ID
-	AccountId *string `form:"accountId,omitempty" json:"accountId,omitempty"`
+	// Id Unique identifier for the installment plan
+	Id string `json:"id"`
 
-	// Tags Filter by tag IDs
-	Tags *[]string `form:"tags,omitempty" json:"tags,omitempty"`
+	// Installments Number of monthly installments
+	Installments int `json:"installments"`
 
-	// Limit Limit the number of results
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// InterestRate Percentage added once to the total amount to finance it
+	InterestRate float32 `json:"interestRate"`
 
-	// Offset Offset the result set
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
-}
+	// PendingInstallments Number of installments not charged yet, failed charges included
+	PendingInstallments int `json:"pendingInstallments"`
 
-// ListHouseholdMembersParams defines parameters for ListHouseholdMembers.
-type ListHouseholdMembersParams struct {
-	// Active Filter by active status
-	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+	// RemainingAmount What the unpaid installments add up to, failed charges included
+	RemainingAmount float32 `json:"remainingAmount"`
 
-	// Role Filter by household role
-	Role *string `form:"role,omitempty" json:"role,omitempty"`
+	// TotalAmount Price of the purchase, in the account currency
+	TotalAmount float32 `json:"totalAmount"`
 }
 
-// ListIngressesParams defines parameters for ListIngresses.
-type ListIngressesParams struct {
-	// Category Filter by category
-	Category *string `form:"category,omitempty" json:"category,omitempty"`
+// InstallmentPlanList defines model for InstallmentPlanList.
+type InstallmentPlanList struct {
+	Plans []InstallmentPlan `json:"plans"`
 
-	// Source Filter by source
-	Source *string `form:"source,omitempty" json:"source,omitempty"`
+	// Total Total number of installment plans
+	Total int `json:"total"`
+}
 
-	// Tags Filter by tags
-	Tags *[]string `form:"tags,omitempty" json:"tags,omitempty"`
+// InstallmentPlanRequest defines model for InstallmentPlanRequest.
+type InstallmentPlanRequest struct {
+	// AccountId Account the installments are charged to
+	AccountId string `json:"accountId"`
 
-	// StartDate Filter by start date (inclusive)
-	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
+	// CategoryId Category of the installment expenditures
+	CategoryId string `json:"categoryId"`
 
-	// EndDate Filter by end date (inclusive)
-	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
+	// Description Description of the purchase, copied to every installment with its number
+	Description string `json:"description"`
 
-	// IsRecurring Filter by recurring status
-	IsRecurring *bool `form:"isRecurring,omitempty" json:"isRecurring,omitempty"`
+	// FirstChargeDate Date of the first installment, later installments keep its day of month
+	FirstChargeDate openapi_types.Date `json:"firstChargeDate"`
 
-	// Currency Filter by currency
-	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+	// Installments Number of monthly installments
+	Installments int `json:"installments"`
 
-	// Limit Limit the number of results
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// InterestRate Percentage added once to the total amount to finance it
+	InterestRate *float32 `json:"interestRate,omitempty"`
 
-	// Offset Offset the result set
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	// TotalAmount Price of the purchase, in the account currency
+	TotalAmount float32 `json:"totalAmount"`
 }
 
-// ListSavingsGoalsParams defines parameters for ListSavingsGoals.
-type ListSavingsGoalsParams struct {
-	// Category Filter by goal category
-	Category *string `form:"category,omitempty" json:"category,omitempty"`
+// Invitation defines model for Invitation.
+type Invitation struct {
+	// Email Email address the invitation was sent to
+	Email openapi_types.Email `json:"email"`
 
-	// Status Filter by goal status
-	Status *ListSavingsGoalsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	// ExpiresAt Until when the invitation token can be used
+	ExpiresAt time.Time `json:"expiresAt"`
 
-	// Currency Filter by currency
-	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+	// Id Unique identifier for the invitation
+	Id string `json:"id"`
 
-	// AccountId Filter by associated account ID
-	AccountId *string `form:"accountId,omitempty" json:"accountId,omitempty"`
+	// MemberId Household member the invitee will be linked to, a new member is created when missing
+	MemberId *string `json:"memberId,omitempty"`
+}
 
-	// TargetDateBefore Filter by target date before specified date
-	TargetDateBefore *openapi_types.Date `form:"targetDateBefore,omitempty" json:"targetDateBefore,omitempty"`
+// InvitationAcceptRequest defines model for InvitationAcceptRequest.
+type InvitationAcceptRequest struct {
+	// FirstName First name of the new user, and of the new member when the invitation names none
+	FirstName string `json:"firstName"`
 
-	// TargetDateAfter Filter by target date after specified date
-	TargetDateAfter *openapi_types.Date `form:"targetDateAfter,omitempty" json:"targetDateAfter,omitempty"`
+	// LastName Last name of the new user, and of the new member when the invitation names none
+	LastName string `json:"lastName"`
 
-	// Limit Limit the number of results
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// Password Password of the new user
+	Password string `json:"password"`
 
-	// Offset Offset the result set
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	// Token One-time token received in the invitation email
+	Token string `json:"token"`
 }
 
-// ListSavingsGoalsParamsStatus defines parameters for ListSavingsGoals.
-type ListSavingsGoalsParamsStatus string
-
-// ListSavingsContributionsParams defines parameters for ListSavingsContributions.
-type ListSavingsContributionsParams struct {
-	// StartDate Filter by start date (inclusive)
-	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
+// InvitationJoinRequest defines model for InvitationJoinRequest.
+type InvitationJoinRequest struct {
+	// Token One-time token received in the invitation email
+	Token string `json:"token"`
+}
 
-	// EndDate Filter by end date (inclusive)
-	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
+// InvitationRequest defines model for InvitationRequest.
+type InvitationRequest struct {
+	// Email Email address to send the invitation to
+	Email openapi_types.Email `json:"email"`
 
-	// SourceAccountId Filter by source account ID
-	SourceAccountId *string `form:"sourceAccountId,omitempty" json:"sourceAccountId,omitempty"`
+	// MemberId Existing household member not linked to a user yet to link the invitee to, a new member is created when missing
+	MemberId *string `json:"memberId,omitempty"`
 }
 
-// ListSavingsTransactionsParams defines parameters for ListSavingsTransactions.
-type ListSavingsTransactionsParams struct {
-	// Type Filter by transaction type (contribution or withdrawal)
-	Type *ListSavingsTransactionsParamsType `form:"type,omitempty" json:"type,omitempty"`
-
-	// StartDate Filter transactions after this date (inclusive)
-	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
+// ListMetadata defines model for ListMetadata.
+type ListMetadata struct {
+	// Limit Limit used for the query
+	Limit int `json:"limit"`
 
-	// EndDate Filter transactions before this date (inclusive)
-	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
+	// Offset Offset used for the query
+	Offset int `json:"offset"`
 
-	// MinAmount Filter transactions with amount greater than or equal to this value
-	MinAmount *float32 `form:"minAmount,omitempty" json:"minAmount,omitempty"`
+	// Sort Sort expression applied to the query
+	Sort *string `json:"sort,omitempty"`
 
-	// MaxAmount Filter transactions with amount less than or equal to this value
-	MaxAmount *float32 `form:"maxAmount,omitempty" json:"maxAmount,omitempty"`
+	// Total Total number of elements matching the filter criteria
+	Total int `json:"total"`
+}
 
-	// Limit Limit the number of results
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+// LoginRequest defines model for LoginRequest.
+type LoginRequest struct {
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+}
 
-	// Offset Offset the result set
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+// LoginResponse defines model for LoginResponse.
+type LoginResponse struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Token     *string    `json:"token,omitempty"`
+	User      *User      `json:"user,omitempty"`
 }
 
-// ListSavingsTransactionsParamsType defines parameters for ListSavingsTransactions.
-type ListSavingsTransactionsParamsType string
+// Payee defines model for Payee.
+type Payee struct {
+	// Aliases Other spellings of the payee in transaction descriptions, matched ignoring case
+	Aliases []string `json:"aliases"`
 
-// ListSavingsWithdrawalsParams defines parameters for ListSavingsWithdrawals.
-type ListSavingsWithdrawalsParams struct {
-	// StartDate Filter by start date (inclusive)
-	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
+	// CreatedAt Timestamp when the payee was created
+	CreatedAt time.Time `json:"createdAt"`
 
-	// EndDate Filter by end date (inclusive)
-	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
+	// DefaultCategoryId Category used for its expenditures when none is given
+	DefaultCategoryId *string `json:"defaultCategoryId"`
 
-	// DestinationAccountId Filter by destination account ID
-	DestinationAccountId *string `form:"destinationAccountId,omitempty" json:"destinationAccountId,omitempty"`
-}
+	// Id Unique identifier for the payee
+	Id string `json:"id"`
 
-// ListTransactionsParams defines parameters for ListTransactions.
-type ListTransactionsParams struct {
-	// StartDate Filter transactions after this date
-	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
+	// Name Name of the payee
+	Name string `json:"name"`
 
-	// EndDate Filter transactions before this date
-	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
+	// UpdatedAt Timestamp when the payee was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
 
-	// AccountId Filter by account ID
-	AccountId *string `form:"accountId,omitempty" json:"accountId,omitempty"`
+// PayeeList defines model for PayeeList.
+type PayeeList struct {
+	Payees []Payee `json:"payees"`
 
-	// TransactionType Filter by transaction type
-	TransactionType *ListTransactionsParamsTransactionType `form:"transactionType,omitempty" json:"transactionType,omitempty"`
+	// Total Total number of payees
+	Total int `json:"total"`
+}
 
-	// MinAmount Filter by minimum amount
-	MinAmount *float32 `form:"minAmount,omitempty" json:"minAmount,omitempty"`
+// PayeeRequest defines model for PayeeRequest.
+type PayeeRequest struct {
+	// Aliases Other spellings of the payee in transaction descriptions, matched ignoring case
+	Aliases *[]string `json:"aliases,omitempty"`
 
-	// MaxAmount Filter by maximum amount
-	MaxAmount *float32 `form:"maxAmount,omitempty" json:"maxAmount,omitempty"`
+	// DefaultCategoryId Category used for its expenditures when none is given
+	DefaultCategoryId *string `json:"defaultCategoryId"`
 
-	// Currency Filter by currency
-	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+	// Name Name of the payee
+	Name string `json:"name"`
+}
 
-	// Limit Limit the number of results
-	Limit int `form:"limit" json:"limit"`
+// PayeeSpending defines model for PayeeSpending.
+type PayeeSpending struct {
+	// Count Number of expenditures
+	Count int `json:"count"`
 
-	// Offset Offset the result set
-	Offset int `form:"offset" json:"offset"`
+	// PayeeId Payee ID, not set for the expenditures without payee
+	PayeeId   *string `json:"payeeId,omitempty"`
+	PayeeName string  `json:"payeeName"`
+
+	// Total Amount spent at the payee
+	Total float64 `json:"total"`
 }
 
-// ListTransactionsParamsTransactionType defines parameters for ListTransactions.
-type ListTransactionsParamsTransactionType string
+// PayeeSpendingReport defines model for PayeeSpendingReport.
+type PayeeSpendingReport struct {
+	Currency string             `json:"currency"`
+	From     openapi_types.Date `json:"from"`
 
-// ListTransfersParams defines parameters for ListTransfers.
-type ListTransfersParams struct {
-	// SourceAccountId Filter by source account ID
-	SourceAccountId *string `form:"sourceAccountId,omitempty" json:"sourceAccountId,omitempty"`
+	// Payees Spending per payee, biggest first
+	Payees []PayeeSpending    `json:"payees"`
+	To     openapi_types.Date `json:"to"`
 
-	// DestinationAccountId Filter by destination account ID
-	DestinationAccountId *string `form:"destinationAccountId,omitempty" json:"destinationAccountId,omitempty"`
+	// Total Spending of every expenditure in the range, with or without payee
+	Total      float64       `json:"total"`
+	Unassigned PayeeSpending `json:"unassigned"`
+}
 
-	// StartDate Filter by start date (inclusive)
-	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
+// Permission What a role allows on the data of the household: read records and reports, write (create and update) records, delete (delete, deactivate and roll back) records, or manage the household, its invitations and the roles of its users
+type Permission string
 
-	// EndDate Filter by end date (inclusive)
-	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
+// PlannedSpendingPeriod defines model for PlannedSpendingPeriod.
+type PlannedSpendingPeriod struct {
+	// Categories Spending per category, biggest unplanned spending first
+	Categories []CategoryPlannedSpending `json:"categories"`
 
-	// Limit Limit the number of results
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+	// End Last day of the period
+	End openapi_types.Date `json:"end"`
 
-	// Offset Offset the result set
-	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+	// Start First day of the period
+	Start  openapi_types.Date    `json:"start"`
+	Totals PlannedSpendingTotals `json:"totals"`
 }
 
-// CreateAccountJSONRequestBody defines body for CreateAccount for application/json ContentType.
-type CreateAccountJSONRequestBody = AccountRequest
+// PlannedSpendingReport defines model for PlannedSpendingReport.
+type PlannedSpendingReport struct {
+	// Currency ID of the currency of every amount in the report
+	Currency string `json:"currency"`
 
-// UpdateAccountJSONRequestBody defines body for UpdateAccount for application/json ContentType.
-type UpdateAccountJSONRequestBody = Account
+	// Period Calendar period the report figures are grouped by
+	Period ReportPeriod `json:"period"`
 
-// LoginJSONRequestBody defines body for Login for application/json ContentType.
-type LoginJSONRequestBody = LoginRequest
+	// Periods Periods from the oldest to the one containing the end date
+	Periods []PlannedSpendingPeriod `json:"periods"`
 
-// RegisterUserJSONRequestBody defines body for RegisterUser for application/json ContentType.
-type RegisterUserJSONRequestBody RegisterUserJSONBody
+	// TopUnplannedCategories Categories with the biggest unplanned spending over all the periods
+	TopUnplannedCategories []CategoryPlannedSpending `json:"topUnplannedCategories"`
+	Totals                 PlannedSpendingTotals     `json:"totals"`
 
-// CreateCategoryJSONRequestBody defines body for CreateCategory for application/json ContentType.
-type CreateCategoryJSONRequestBody = CategoryRequest
+	// UnplannedTrend Unplanned ratio of the last period compared with the average of the previous ones
+	UnplannedTrend PlannedSpendingReportUnplannedTrend `json:"unplannedTrend"`
+}
 
-// UpdateCategoryJSONRequestBody defines body for UpdateCategory for application/json ContentType.
-type UpdateCategoryJSONRequestBody = CategoryRequest
+// PlannedSpendingReportUnplannedTrend Unplanned ratio of the last period compared with the average of the previous ones
+type PlannedSpendingReportUnplannedTrend string
 
-// CreateExpenditureJSONRequestBody defines body for CreateExpenditure for application/json ContentType.
-type CreateExpenditureJSONRequestBody = ExpenditureRequest
+// PlannedSpendingTotals defines model for PlannedSpendingTotals.
+type PlannedSpendingTotals struct {
+	// Planned Total of the planned expenditures
+	Planned float64 `json:"planned"`
 
-// RollbackExpenditureJSONRequestBody defines body for RollbackExpenditure for application/json ContentType.
-type RollbackExpenditureJSONRequestBody = RollbackRequest
+	// Unplanned Total of the unplanned expenditures
+	Unplanned float64 `json:"unplanned"`
 
-// CreateHouseholdMemberJSONRequestBody defines body for CreateHouseholdMember for application/json ContentType.
-type CreateHouseholdMemberJSONRequestBody = HouseholdMemberRequest
+	// UnplannedRatio Share of the spending that was not planned, from 0 to 1
+	UnplannedRatio float64 `json:"unplannedRatio"`
+}
 
-// UpdateHouseholdMemberJSONRequestBody defines body for UpdateHouseholdMember for application/json ContentType.
-type UpdateHouseholdMemberJSONRequestBody = HouseholdMemberRequest
+// RecurrenceAmountType Whether the bill amount is known in advance (fixed) or only an estimation to be confirmed when the bill is posted (estimated, e.g. utilities)
+type RecurrenceAmountType string
 
-// CreateIngressJSONRequestBody defines body for CreateIngress for application/json ContentType.
-type CreateIngressJSONRequestBody = IngressRequest
+// RecurrenceFrequency How often a recurring bill falls due
+type RecurrenceFrequency string
 
-// UpdateIngressRecurrencePatternJSONRequestBody defines body for UpdateIngressRecurrencePattern for application/json ContentType.
-type UpdateIngressRecurrencePatternJSONRequestBody = RecurrencePatternRequest
+// RecurrencePattern defines model for RecurrencePattern.
+type RecurrencePattern struct {
+	// Amount Amount for each recurrence
+	Amount float32 `json:"amount"`
 
-// RollbackIngressJSONRequestBody defines body for RollbackIngress for application/json ContentType.
-type RollbackIngressJSONRequestBody = RollbackRequest
+	// CreatedAt When the recurrence pattern was created
+	CreatedAt time.Time `json:"created_at"`
 
-// CreateIngressRecurrencePatternJSONRequestBody defines body for CreateIngressRecurrencePattern for application/json ContentType.
-type CreateIngressRecurrencePatternJSONRequestBody = RecurrencePatternRequest
+	// Description Description for the recurrence pattern
+	Description string `json:"description"`
 
-// CreateSavingsGoalJSONRequestBody defines body for CreateSavingsGoal for application/json ContentType.
-type CreateSavingsGoalJSONRequestBody = SavingsGoalRequest
+	// EndDate End date for the recurrence pattern (null for indefinite)
+	EndDate *openapi_types.Date `json:"end_date"`
 
-// UpdateSavingsGoalJSONRequestBody defines body for UpdateSavingsGoal for application/json ContentType.
-type UpdateSavingsGoalJSONRequestBody = SavingsGoalRequest
+	// Frequency Frequency of the recurrence
+	Frequency RecurrencePatternFrequency `json:"frequency"`
 
-// AddSavingsContributionJSONRequestBody defines body for AddSavingsContribution for application/json ContentType.
-type AddSavingsContributionJSONRequestBody = SavingsContributionRequest
+	// Id Unique identifier for the recurrence pattern
+	Id string `json:"id"`
 
-// AddSavingsWithdrawalJSONRequestBody defines body for AddSavingsWithdrawal for application/json ContentType.
-type AddSavingsWithdrawalJSONRequestBody = SavingsWithdrawalRequest
+	// Interval Interval value for the frequency (e.g., every 2 weeks)
+	Interval int `json:"interval"`
 
-// CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
-type CreateTagJSONRequestBody = TagRequest
+	// ToAccountId ID of the associated ingress
+	ToAccountId string `json:"to_account_id"`
 
-// CreateTransferJSONRequestBody defines body for CreateTransfer for application/json ContentType.
-type CreateTransferJSONRequestBody = TransferRequest
+	// UpdatedAt When the recurrence pattern was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
 
-// RollbackTransferJSONRequestBody defines body for RollbackTransfer for application/json ContentType.
-type RollbackTransferJSONRequestBody = RollbackRequest
+// RecurrencePatternFrequency Frequency of the recurrence
+type RecurrencePatternFrequency string
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// List all accounts
-	// (GET /accounts)
-	ListAccounts(w http.ResponseWriter, r *http.Request, params ListAccountsParams)
-	// Create a new money account
-	// (POST /accounts)
-	CreateAccount(w http.ResponseWriter, r *http.Request)
-	// Delete account
-	// (DELETE /accounts/{id})
-	DeleteAccount(w http.ResponseWriter, r *http.Request, id string)
-	// Get account by ID
-	// (GET /accounts/{id})
-	GetAccount(w http.ResponseWriter, r *http.Request, id string)
-	// Update account
-	// (PUT /accounts/{id})
-	UpdateAccount(w http.ResponseWriter, r *http.Request, id string)
-	// Activate an account
-	// (PATCH /accounts/{id}/activate)
-	ActivateAccount(w http.ResponseWriter, r *http.Request, id string)
-	// Checks if account can be deleted
-	// (GET /accounts/{id}/can-delete)
-	CanDeleteAccount(w http.ResponseWriter, r *http.Request, id string)
-	// Deactivate an account
-	// (PATCH /accounts/{id}/deactivate)
-	DeactivateAccount(w http.ResponseWriter, r *http.Request, id string)
-	// Login to the system
-	// (POST /auth/login)
-	Login(w http.ResponseWriter, r *http.Request)
-	// Refresh authentication token
-	// (POST /auth/refresh)
-	RefreshToken(w http.ResponseWriter, r *http.Request)
-	// Register a new user
-	// (POST /auth/register)
-	RegisterUser(w http.ResponseWriter, r *http.Request)
-	// Get current balances
-	// (GET /balances)
-	GetBalances(w http.ResponseWriter, r *http.Request, params GetBalancesParams)
-	// List categories
-	// (GET /categories)
-	ListCategories(w http.ResponseWriter, r *http.Request, params ListCategoriesParams)
-	// Create category
-	// (POST /categories)
-	CreateCategory(w http.ResponseWriter, r *http.Request)
-	// Delete category
-	// (DELETE /categories/{id})
-	DeleteCategory(w http.ResponseWriter, r *http.Request, id string)
-	// Update category
-	// (PUT /categories/{id})
-	UpdateCategory(w http.ResponseWriter, r *http.Request, id string)
-	// Activate a category
-	// (PATCH /categories/{id}/activate)
-	ActivateCategory(w http.ResponseWriter, r *http.Request, id string)
-	// Deactivate a category
-	// (PATCH /categories/{id}/deactivate)
-	DeactivateCategory(w http.ResponseWriter, r *http.Request, id string)
-	// Get current exchange rates
-	// (GET /exchange-rates)
-	GetExchangeRates(w http.ResponseWriter, r *http.Request, params GetExchangeRatesParams)
-	// List all expenditures
-	// (GET /expenditures)
-	ListExpenditures(w http.ResponseWriter, r *http.Request, params ListExpendituresParams)
-	// Create a new expenditure
-	// (POST /expenditures)
-	CreateExpenditure(w http.ResponseWriter, r *http.Request)
-	// Get expenditure by ID
-	// (GET /expenditures/{id})
-	GetExpenditure(w http.ResponseWriter, r *http.Request, id string)
-	// Rollback an expenditure
-	// (POST /expenditures/{id}/rollback)
-	RollbackExpenditure(w http.ResponseWriter, r *http.Request, id string)
-	// List all household members
-	// (GET /household-members)
-	ListHouseholdMembers(w http.ResponseWriter, r *http.Request, params ListHouseholdMembersParams)
-	// Create a new household member
-	// (POST /household-members)
-	CreateHouseholdMember(w http.ResponseWriter, r *http.Request)
-	// Delete household member
-	// (DELETE /household-members/{id})
-	DeleteHouseholdMember(w http.ResponseWriter, r *http.Request, id string)
-	// Get household member by ID
-	// (GET /household-members/{id})
-	GetHouseholdMember(w http.ResponseWriter, r *http.Request, id string)
-	// Update household member
-	// (PUT /household-members/{id})
-	UpdateHouseholdMember(w http.ResponseWriter, r *http.Request, id string)
-	// Activate household member by ID
-	// (PATCH /household-members/{id}/activate)
-	ActivateHouseholdMember(w http.ResponseWriter, r *http.Request, id string)
-	// Deactivate household member by ID
-	// (PATCH /household-members/{id}/deactivate)
-	DeactivateHouseholdMember(w http.ResponseWriter, r *http.Request, id string)
-	// List all ingresses
-	// (GET /ingresses)
-	ListIngresses(w http.ResponseWriter, r *http.Request, params ListIngressesParams)
-	// Create a new ingress
-	// (POST /ingresses)
-	CreateIngress(w http.ResponseWriter, r *http.Request)
-	// Get ingress by ID
-	// (GET /ingresses/{id})
-	GetIngress(w http.ResponseWriter, r *http.Request, id string)
-	// Delete a recurrence pattern
-	// (DELETE /ingresses/{id}/recurrence-pattern/{pattern_id})
-	DeleteIngressRecurrencePattern(w http.ResponseWriter, r *http.Request, id string, patternId string)
-	// Get a specific recurrence pattern
-	// (GET /ingresses/{id}/recurrence-pattern/{pattern_id})
-	GetIngressRecurrencePattern(w http.ResponseWriter, r *http.Request, id string, patternId string)
-	// Update a recurrence pattern
-	// (PUT /ingresses/{id}/recurrence-pattern/{pattern_id})
-	UpdateIngressRecurrencePattern(w http.ResponseWriter, r *http.Request, id string, patternId string)
-	// Rollback an Ingress
-	// (POST /ingresses/{id}/rollback)
-	RollbackIngress(w http.ResponseWriter, r *http.Request, id string)
-	// Create a recurrence pattern for Ingresses
-	// (POST /recurrence-pattern)
-	CreateIngressRecurrencePattern(w http.ResponseWriter, r *http.Request)
-	// List all savings goals
-	// (GET /savings)
-	ListSavingsGoals(w http.ResponseWriter, r *http.Request, params ListSavingsGoalsParams)
-	// Create a new savings goal
-	// (POST /savings)
-	CreateSavingsGoal(w http.ResponseWriter, r *http.Request)
-	// Delete savings goal
-	// (DELETE /savings/{id})
-	DeleteSavingsGoal(w http.ResponseWriter, r *http.Request, id string)
-	// Get savings goal by ID
-	// (GET /savings/{id})
-	GetSavingsGoal(w http.ResponseWriter, r *http.Request, id string)
-	// Update savings goal
-	// (PUT /savings/{id})
-	UpdateSavingsGoal(w http.ResponseWriter, r *http.Request, id string)
-	// List contributions for a savings goal
-	// (GET /savings/{id}/contributions)
-	ListSavingsContributions(w http.ResponseWriter, r *http.Request, id string, params ListSavingsContributionsParams)
-	// Add contribution to savings goal
-	// (POST /savings/{id}/contributions)
-	AddSavingsContribution(w http.ResponseWriter, r *http.Request, id string)
-	// Get savings goal progress
-	// (GET /savings/{id}/progress)
-	GetSavingsProgress(w http.ResponseWriter, r *http.Request, id string)
-	// List transactions for a savings goal
-	// (GET /savings/{id}/transactions)
-	ListSavingsTransactions(w http.ResponseWriter, r *http.Request, id string, params ListSavingsTransactionsParams)
-	// List withdrawals from a savings goal
-	// (GET /savings/{id}/withdrawals)
-	ListSavingsWithdrawals(w http.ResponseWriter, r *http.Request, id string, params ListSavingsWithdrawalsParams)
-	// Add withdrawal from savings goal
-	// (POST /savings/{id}/withdrawals)
-	AddSavingsWithdrawal(w http.ResponseWriter, r *http.Request, id string)
-	// List tags
-	// (GET /tags)
-	ListTags(w http.ResponseWriter, r *http.Request)
-	// Create tag
-	// (POST /tags)
-	CreateTag(w http.ResponseWriter, r *http.Request)
-	// List tags by type
-	// (GET /tags/type/{type})
-	ListTagsByType(w http.ResponseWriter, r *http.Request, pType TagType)
-	// Delete tag
-	// (DELETE /tags/{id})
-	DeleteTag(w http.ResponseWriter, r *http.Request, id string)
-	// List all transactions
-	// (GET /transactions)
-	ListTransactions(w http.ResponseWriter, r *http.Request, params ListTransactionsParams)
-	// List all transfers
-	// (GET /transfers)
-	ListTransfers(w http.ResponseWriter, r *http.Request, params ListTransfersParams)
-	// Create a new money transfer
-	// (POST /transfers)
-	CreateTransfer(w http.ResponseWriter, r *http.Request)
-	// Get transfer by ID
-	// (GET /transfers/{id})
-	GetTransfer(w http.ResponseWriter, r *http.Request, id string)
-	// Rollback a Transfer
-	// (POST /transfers/{id}/rollback)
-	RollbackTransfer(w http.ResponseWriter, r *http.Request, id string)
-}
+// RecurrencePatternRequest defines model for RecurrencePatternRequest.
+type RecurrencePatternRequest struct {
+	// Amount Amount for each recurrence
+	Amount float32 `json:"amount"`
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// Description Description for the recurrence pattern
+	Description string `json:"description"`
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	// EndDate End date for the recurrence pattern (null for indefinite)
+	EndDate *openapi_types.Date `json:"end_date"`
 
-// ListAccounts operation middleware
-func (siw *ServerInterfaceWrapper) ListAccounts(w http.ResponseWriter, r *http.Request) {
+	// Frequency Frequency of the recurrence
+	Frequency RecurrencePatternRequestFrequency `json:"frequency"`
 
-	var err error
+	// Interval Interval value for the frequency (e.g., every 2 weeks)
+	Interval int `json:"interval"`
 
-	ctx := r.Context()
+	// ToAccountId ID of the associated ingress
+	ToAccountId string `json:"to_account_id"`
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// RecurrencePatternRequestFrequency Frequency of the recurrence
+type RecurrencePatternRequestFrequency string
 
-	r = r.WithContext(ctx)
+// Refund defines model for Refund.
+type Refund struct {
+	// AccountId Account credited with the refund
+	AccountId string `json:"accountId"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListAccountsParams
+	// Amount Refunded amount in the currency the expenditure was charged in
+	Amount float32 `json:"amount"`
 
-	// ------------- Optional query parameter "type" -------------
+	// CreatedAt Timestamp when the refund was created
+	CreatedAt time.Time `json:"createdAt"`
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
-		return
-	}
+	// CreditedAmount Amount credited to the account, in the account currency
+	CreditedAmount float32 `json:"creditedAmount"`
 
-	// ------------- Optional query parameter "currency" -------------
+	// CreditedCurrency ID of the currency of the credited account
+	CreditedCurrency string `json:"creditedCurrency"`
 
-	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
-		return
-	}
+	// Date Date of the refund
+	Date openapi_types.Date `json:"date"`
 
-	// ------------- Optional query parameter "active" -------------
+	// Description Description of the refund
+	Description string `json:"description"`
 
-	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
-		return
-	}
+	// ExpenditureId Expenditure the refund belongs to
+	ExpenditureId string `json:"expenditureId"`
 
-	// ------------- Optional query parameter "limit" -------------
+	// Id Unique identifier for the refund
+	Id string `json:"id"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+// RefundList defines model for RefundList.
+type RefundList struct {
+	// Refunded Total refunded, in the currency the expenditure was charged in
+	Refunded float32 `json:"refunded"`
 
-	// ------------- Optional query parameter "offset" -------------
+	// Refunds Refunds of the expenditure, oldest first
+	Refunds []Refund `json:"refunds"`
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
+	// Remaining What is left to refund, in the currency the expenditure was charged in
+	Remaining float32 `json:"remaining"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListAccounts(w, r, params)
-	}))
+// RefundRequest defines model for RefundRequest.
+type RefundRequest struct {
+	// AccountId Account credited with the refund, defaults to the expenditure account
+	AccountId *string `json:"accountId,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Amount Amount to refund in the currency the expenditure was charged in, defaults to what is left to refund
+	Amount *float32 `json:"amount,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// Date Date of the refund, defaults to today
+	Date *openapi_types.Date `json:"date,omitempty"`
+
+	// Description Description of the refund, defaults to the expenditure description
+	Description *string `json:"description,omitempty"`
 }
 
-// CreateAccount operation middleware
-func (siw *ServerInterfaceWrapper) CreateAccount(w http.ResponseWriter, r *http.Request) {
+// ReportPeriod Calendar period the report figures are grouped by
+type ReportPeriod string
 
-	ctx := r.Context()
+// Role defines model for Role.
+type Role struct {
+	// Id Unique identifier for the role
+	Id string `json:"id"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Name Name of the role
+	Name string `json:"name"`
 
-	r = r.WithContext(ctx)
+	// Permissions Permissions the role grants
+	Permissions []Permission `json:"permissions"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateAccount(w, r)
-	}))
+// RollbackRequest defines model for RollbackRequest.
+type RollbackRequest struct {
+	// RollbackReason Reason for rolling back the Expenditure
+	RollbackReason string `json:"rollbackReason"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// SavingsContribution defines model for SavingsContribution.
+type SavingsContribution struct {
+	// Amount The contribution amount
+	Amount float32 `json:"amount"`
 
-	handler.ServeHTTP(w, r)
-}
+	// CreatedAt Timestamp when the contribution was created
+	CreatedAt time.Time `json:"createdAt"`
 
-// DeleteAccount operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+	// Date The date of the contribution
+	Date openapi_types.Date `json:"date"`
 
-	var err error
+	// Id Unique identifier for the contribution
+	Id string `json:"id"`
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Notes Additional notes about the contribution
+	Notes *string `json:"notes,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// SavingsGoalId ID of the savings goal this contribution belongs to
+	SavingsGoalId string `json:"savingsGoalId"`
 
-	ctx := r.Context()
+	// SourceAccountId ID of the account the contribution is coming from
+	SourceAccountId string `json:"sourceAccountId"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Tags List of tag IDs associated with this contribution
+	Tags *[]Tag `json:"tags,omitempty"`
 
-	r = r.WithContext(ctx)
+	// UpdatedAt Timestamp when the contribution was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteAccount(w, r, id)
-	}))
+// SavingsContributionRequest defines model for SavingsContributionRequest.
+type SavingsContributionRequest struct {
+	// Amount The contribution amount
+	Amount float32 `json:"amount"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Date The date of the contribution
+	Date openapi_types.Date `json:"date"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Notes Additional notes about the contribution
+	Notes *string `json:"notes,omitempty"`
 
-// GetAccount operation middleware
-func (siw *ServerInterfaceWrapper) GetAccount(w http.ResponseWriter, r *http.Request) {
+	// SourceAccountId ID of the account the contribution is coming from
+	SourceAccountId string `json:"sourceAccountId"`
 
-	var err error
+	// Tags List of tag IDs associated with this contribution
+	Tags *[]Tag `json:"tags,omitempty"`
+}
 
-	// ------------- Path parameter "id" -------------
-	var id string
+// SavingsGoal defines model for SavingsGoal.
+type SavingsGoal struct {
+	// AccountId ID of the account where funds are stored
+	AccountId string `json:"accountId"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// AutoContribute Whether to automatically contribute to this goal
+	AutoContribute *bool `json:"autoContribute,omitempty"`
 
-	ctx := r.Context()
+	// AutoContributeAmount Amount to auto-contribute periodically
+	AutoContributeAmount *float32 `json:"autoContributeAmount,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// AutoContributeFrequency Frequency of auto-contributions
+	AutoContributeFrequency *SavingsGoalAutoContributeFrequency `json:"autoContributeFrequency,omitempty"`
+	Category                Category                            `json:"category"`
 
-	r = r.WithContext(ctx)
+	// CreatedAt Timestamp when the savings goal was created
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAccount(w, r, id)
-	}))
+	// Currency Currency of the savings goal
+	Currency string `json:"currency"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// CurrentAmount Current amount saved
+	CurrentAmount *float32 `json:"currentAmount,omitempty"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Description Detailed description of the savings goal
+	Description *string `json:"description,omitempty"`
 
-// UpdateAccount operation middleware
-func (siw *ServerInterfaceWrapper) UpdateAccount(w http.ResponseWriter, r *http.Request) {
+	// Id Unique identifier for the savings goal
+	Id *string `json:"id,omitempty"`
 
-	var err error
+	// InitialAmount Initial amount already saved
+	InitialAmount *float32 `json:"initialAmount,omitempty"`
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Name Name of the savings goal
+	Name string `json:"name"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// PercentComplete Percentage of goal completed
+	PercentComplete *float32 `json:"percentComplete,omitempty"`
 
-	ctx := r.Context()
+	// Priority Priority level (1-5, where 1 is highest)
+	Priority *int `json:"priority,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ProjectedCompletionDate Estimated completion date based on contribution history
+	ProjectedCompletionDate *openapi_types.Date `json:"projectedCompletionDate,omitempty"`
 
-	r = r.WithContext(ctx)
+	// Status Current status of the savings goal
+	Status *SavingsGoalStatus `json:"status,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateAccount(w, r, id)
-	}))
+	// Tags List of tag IDs associated with this goal
+	Tags *[]Tag `json:"tags,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// TargetAmount Target amount to save
+	TargetAmount float32 `json:"targetAmount"`
 
-	handler.ServeHTTP(w, r)
+	// TargetDate Target date to reach the goal (optional)
+	TargetDate *openapi_types.Date `json:"targetDate,omitempty"`
+	UpdatedAt  *time.Time          `json:"updatedAt,omitempty"`
 }
 
-// ActivateAccount operation middleware
-func (siw *ServerInterfaceWrapper) ActivateAccount(w http.ResponseWriter, r *http.Request) {
+// SavingsGoalAutoContributeFrequency Frequency of auto-contributions
+type SavingsGoalAutoContributeFrequency string
 
-	var err error
+// SavingsGoalStatus Current status of the savings goal
+type SavingsGoalStatus string
 
-	// ------------- Path parameter "id" -------------
-	var id string
+// SavingsGoalList defines model for SavingsGoalList.
+type SavingsGoalList struct {
+	Metadata     *ListMetadata  `json:"metadata,omitempty"`
+	SavingsGoals *[]SavingsGoal `json:"savingsGoals,omitempty"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// SavingsGoalRequest defines model for SavingsGoalRequest.
+type SavingsGoalRequest struct {
+	// AccountId ID of the account where funds are stored
+	AccountId string `json:"accountId"`
 
-	ctx := r.Context()
+	// AutoContribute Whether to automatically contribute to this goal
+	AutoContribute *bool `json:"autoContribute,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// AutoContributeAmount Amount to auto-contribute periodically
+	AutoContributeAmount *float32 `json:"autoContributeAmount,omitempty"`
 
-	r = r.WithContext(ctx)
+	// AutoContributeFrequency Frequency of auto-contributions
+	AutoContributeFrequency *SavingsGoalRequestAutoContributeFrequency `json:"autoContributeFrequency,omitempty"`
+	Category                Category                                   `json:"category"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ActivateAccount(w, r, id)
-	}))
+	// Currency Currency of the savings goal
+	Currency string `json:"currency"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Description Detailed description of the savings goal
+	Description *string `json:"description,omitempty"`
 
-	handler.ServeHTTP(w, r)
-}
+	// InitialAmount Initial amount already saved
+	InitialAmount *float32 `json:"initialAmount,omitempty"`
 
-// CanDeleteAccount operation middleware
-func (siw *ServerInterfaceWrapper) CanDeleteAccount(w http.ResponseWriter, r *http.Request) {
+	// Name Name of the savings goal
+	Name string `json:"name"`
 
-	var err error
+	// Priority Priority level (1-5, where 1 is highest)
+	Priority *int `json:"priority,omitempty"`
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Tags List of tag IDs associated with this goal
+	Tags *[]Tag `json:"tags,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// TargetAmount Target amount to save
+	TargetAmount float32 `json:"targetAmount"`
 
-	ctx := r.Context()
+	// TargetDate Target date to reach the goal (optional)
+	TargetDate *openapi_types.Date `json:"targetDate,omitempty"`
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// SavingsGoalRequestAutoContributeFrequency Frequency of auto-contributions
+type SavingsGoalRequestAutoContributeFrequency string
 
-	r = r.WithContext(ctx)
+// SavingsProgress defines model for SavingsProgress.
+type SavingsProgress struct {
+	ContributionHistory *[]struct {
+		// Amount Total contributions for that month
+		Amount *float32 `json:"amount,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CanDeleteAccount(w, r, id)
-	}))
+		// Month Month of contributions (YYYY-MM format)
+		Month *openapi_types.Date `json:"month,omitempty"`
+	} `json:"contributionHistory,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Currency Currency of the savings goal
+	Currency string `json:"currency"`
 
-	handler.ServeHTTP(w, r)
-}
+	// CurrentAmount Current amount saved
+	CurrentAmount float32 `json:"currentAmount"`
 
-// DeactivateAccount operation middleware
-func (siw *ServerInterfaceWrapper) DeactivateAccount(w http.ResponseWriter, r *http.Request) {
+	// DaysRemaining Number of days remaining until target date
+	DaysRemaining *int `json:"daysRemaining,omitempty"`
 
-	var err error
+	// GoalId ID of the savings goal
+	GoalId string `json:"goalId"`
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Name Name of the savings goal
+	Name string `json:"name"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// OnTrack Whether the goal is on track to be completed by target date
+	OnTrack *bool `json:"onTrack,omitempty"`
 
-	ctx := r.Context()
+	// PercentComplete Percentage of goal completed
+	PercentComplete float32 `json:"percentComplete"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ProjectedCompletionDate Projected date when goal will be reached based on current rate
+	ProjectedCompletionDate *openapi_types.Date `json:"projectedCompletionDate,omitempty"`
+	RecentActivity          *[]struct {
+		// Amount Amount of activity
+		Amount *float32 `json:"amount,omitempty"`
 
-	r = r.WithContext(ctx)
+		// Date Date of activity
+		Date *openapi_types.Date `json:"date,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeactivateAccount(w, r, id)
-	}))
+		// Description Description of activity
+		Description *string `json:"description,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+		// Type Type of activity
+		Type *SavingsProgressRecentActivityType `json:"type,omitempty"`
+	} `json:"recentActivity,omitempty"`
 
-	handler.ServeHTTP(w, r)
-}
+	// RemainingAmount Amount still needed to reach goal
+	RemainingAmount float32 `json:"remainingAmount"`
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+	// TargetAmount Target amount to save
+	TargetAmount float32 `json:"targetAmount"`
 
-	ctx := r.Context()
+	// TargetDate Target date to reach the goal
+	TargetDate *openapi_types.Date `json:"targetDate,omitempty"`
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// SavingsProgressRecentActivityType Type of activity
+type SavingsProgressRecentActivityType string
 
-	r = r.WithContext(ctx)
+// SavingsTransaction defines model for SavingsTransaction.
+type SavingsTransaction struct {
+	// AccountId ID of the account from/to which the money was transferred
+	AccountId *string `json:"accountId,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Login(w, r)
-	}))
+	// Amount Amount of the transaction
+	Amount float32 `json:"amount"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Date Date and time when the transaction occurred
+	Date time.Time `json:"date"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Description Optional description of the transaction
+	Description *string `json:"description,omitempty"`
 
-// RefreshToken operation middleware
-func (siw *ServerInterfaceWrapper) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	// Id Unique identifier for the transaction
+	Id string `json:"id"`
 
-	ctx := r.Context()
+	// SavingsGoalId ID of the savings goal this transaction belongs to
+	SavingsGoalId string `json:"savingsGoalId"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Tags List of tag IDs associated with this transaction
+	Tags *[]Tag `json:"tags,omitempty"`
 
-	r = r.WithContext(ctx)
+	// TransactionId ID of the related financial transaction (if applicable)
+	TransactionId *string `json:"transactionId,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RefreshToken(w, r)
-	}))
+	// Type Type of transaction
+	Type SavingsTransactionType `json:"type"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// SavingsTransactionType Type of transaction
+type SavingsTransactionType string
 
-	handler.ServeHTTP(w, r)
+// SavingsTransactionList defines model for SavingsTransactionList.
+type SavingsTransactionList struct {
+	Metadata     *ListMetadata        `json:"metadata,omitempty"`
+	Transactions []SavingsTransaction `json:"transactions"`
 }
 
-// RegisterUser operation middleware
-func (siw *ServerInterfaceWrapper) RegisterUser(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RegisterUser(w, r)
-	}))
+// SavingsWithdrawal defines model for SavingsWithdrawal.
+type SavingsWithdrawal struct {
+	// Amount The withdrawal amount
+	Amount float32 `json:"amount"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// CreatedAt Timestamp when the withdrawal was created
+	CreatedAt time.Time `json:"createdAt"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Date The date of the withdrawal
+	Date openapi_types.Date `json:"date"`
 
-// GetBalances operation middleware
-func (siw *ServerInterfaceWrapper) GetBalances(w http.ResponseWriter, r *http.Request) {
+	// DestinationAccountId ID of the account the withdrawal is going to
+	DestinationAccountId string `json:"destinationAccountId"`
 
-	var err error
+	// Id Unique identifier for the withdrawal
+	Id string `json:"id"`
 
-	ctx := r.Context()
+	// Notes Additional notes about the withdrawal
+	Notes *string `json:"notes,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Reason Reason for the withdrawal
+	Reason string `json:"reason"`
 
-	r = r.WithContext(ctx)
+	// SavingsGoalId ID of the savings goal this withdrawal belongs to
+	SavingsGoalId string `json:"savingsGoalId"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetBalancesParams
+	// Tags List of tags associated with this withdrawal
+	Tags *[]Tag `json:"tags,omitempty"`
 
-	// ------------- Optional query parameter "currency" -------------
+	// UpdatedAt Timestamp when the withdrawal was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
-		return
-	}
+// SavingsWithdrawalRequest defines model for SavingsWithdrawalRequest.
+type SavingsWithdrawalRequest struct {
+	// Amount The withdrawal amount
+	Amount float32 `json:"amount"`
 
-	// ------------- Optional query parameter "groupBy" -------------
+	// Date The date of the withdrawal
+	Date openapi_types.Date `json:"date"`
 
-	err = runtime.BindQueryParameter("form", true, false, "groupBy", r.URL.Query(), &params.GroupBy)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupBy", Err: err})
-		return
-	}
+	// DestinationAccountId ID of the account the withdrawal is going to
+	DestinationAccountId string `json:"destinationAccountId"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBalances(w, r, params)
-	}))
+	// Notes Additional notes about the withdrawal
+	Notes *string `json:"notes,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Reason Reason for the withdrawal
+	Reason string `json:"reason"`
 
-	handler.ServeHTTP(w, r)
+	// Tags List of tags associated with this withdrawal
+	Tags *[]Tag `json:"tags,omitempty"`
 }
 
-// ListCategories operation middleware
-func (siw *ServerInterfaceWrapper) ListCategories(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// ScoredSuggestion defines model for ScoredSuggestion.
+type ScoredSuggestion struct {
+	// Confidence Probability given by the model
+	Confidence float64 `json:"confidence"`
 
-	ctx := r.Context()
+	// Id ID of the suggested category or tag
+	Id string `json:"id"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Name Name of the suggested category or tag
+	Name string `json:"name"`
+}
 
-	r = r.WithContext(ctx)
+// SearchHighlight defines model for SearchHighlight.
+type SearchHighlight struct {
+	// Field Matched field (description, source, category or tag)
+	Field string `json:"field"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCategoriesParams
+	// Snippet Field value with the matching words wrapped in <em> tags
+	Snippet string `json:"snippet"`
+}
 
-	// ------------- Optional query parameter "type" -------------
+// SearchHit defines model for SearchHit.
+type SearchHit struct {
+	// AccountId Account of the underlying transaction
+	AccountId string `json:"accountId"`
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
-		return
-	}
+	// Amount Amount of the underlying transaction
+	Amount float32 `json:"amount"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCategories(w, r, params)
-	}))
+	// CategoryName Category name, not present for transfer hits
+	CategoryName *string `json:"categoryName,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Currency Currency of the underlying transaction
+	Currency string `json:"currency"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Date Date of the underlying transaction
+	Date time.Time `json:"date"`
 
-// CreateCategory operation middleware
-func (siw *ServerInterfaceWrapper) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	// Description Transaction description
+	Description *string           `json:"description,omitempty"`
+	Highlights  []SearchHighlight `json:"highlights"`
 
-	ctx := r.Context()
+	// Id Identifier of the matched expenditure, ingress or transfer
+	Id string `json:"id"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Score Relevance score, higher is better
+	Score float64 `json:"score"`
 
-	r = r.WithContext(ctx)
+	// Source Ingress source, only present for ingress hits
+	Source *string `json:"source,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCategory(w, r)
-	}))
+	// Tags Names of the tags linked to the hit
+	Tags *[]string     `json:"tags,omitempty"`
+	Type SearchHitType `json:"type"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// SearchHitType defines model for SearchHitType.
+type SearchHitType string
 
-	handler.ServeHTTP(w, r)
+// SearchResult defines model for SearchResult.
+type SearchResult struct {
+	Hits     []SearchHit  `json:"hits"`
+	Metadata ListMetadata `json:"metadata"`
 }
 
-// DeleteCategory operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+// SuggestionModelStatus What the suggestion model learned from
+type SuggestionModelStatus struct {
+	// Categories Categories seen in the examples
+	Categories int `json:"categories"`
 
-	var err error
+	// Examples Past expenditures with words in their description
+	Examples int `json:"examples"`
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Tags Tags seen in the examples
+	Tags int `json:"tags"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// Vocabulary Distinct words seen in the descriptions
+	Vocabulary int `json:"vocabulary"`
+}
 
-	ctx := r.Context()
+// Tag defines model for Tag.
+type Tag struct {
+	// BackgroundColor Background color code for UI representation (hex format)
+	BackgroundColor *string `json:"backgroundColor,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Color Text color code for UI representation (hex format)
+	Color *string `json:"color,omitempty"`
 
-	r = r.WithContext(ctx)
+	// CreatedAt Timestamp when the tag was created
+	CreatedAt time.Time `json:"createdAt"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCategory(w, r, id)
-	}))
+	// Description Description of the tag
+	Description *string `json:"description,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Id Unique identifier for the tag
+	Id string `json:"id"`
 
-	handler.ServeHTTP(w, r)
+	// Name Name of the tag
+	Name    string  `json:"name"`
+	TagType TagType `json:"tagType"`
+
+	// UpdatedAt Timestamp when the tag was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// UpdateCategory operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+// TagCategoryReport defines model for TagCategoryReport.
+type TagCategoryReport struct {
+	// Categories Amount per category, biggest first
+	Categories []TagCategorySpending `json:"categories"`
+	Count      int                   `json:"count"`
+	Currency   string                `json:"currency"`
+	From       openapi_types.Date    `json:"from"`
+	Tag        Tag                   `json:"tag"`
+	To         openapi_types.Date    `json:"to"`
+	Total      float64               `json:"total"`
+}
 
-	var err error
+// TagCategorySpending defines model for TagCategorySpending.
+type TagCategorySpending struct {
+	CategoryId   string  `json:"categoryId"`
+	CategoryName string  `json:"categoryName"`
+	Count        int     `json:"count"`
+	Total        float64 `json:"total"`
+}
 
-	// ------------- Path parameter "id" -------------
-	var id string
+// TagCombinationReport defines model for TagCombinationReport.
+type TagCombinationReport struct {
+	// Count Number of records matching the tags
+	Count    int                `json:"count"`
+	Currency string             `json:"currency"`
+	From     openapi_types.Date `json:"from"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// Match Records linked to all of the tags, their intersection, or to any of them, their union
+	Match TagMatch           `json:"match"`
+	Tags  []Tag              `json:"tags"`
+	To    openapi_types.Date `json:"to"`
 
-	ctx := r.Context()
+	// Total Amount of the records matching the tags
+	Total float64 `json:"total"`
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// TagLabel defines model for TagLabel.
+type TagLabel struct {
+	// Id Tag ID
+	Id string `json:"id"`
 
-	r = r.WithContext(ctx)
+	// Name Tag name
+	Name string `json:"name"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCategory(w, r, id)
-	}))
+// TagLinkRequest defines model for TagLinkRequest.
+type TagLinkRequest struct {
+	// TagIds Tags of the same type as the record
+	TagIds []string `json:"tagIds"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// TagMatch Records linked to all of the tags, their intersection, or to any of them, their union
+type TagMatch string
 
-	handler.ServeHTTP(w, r)
+// TagMergeRequest defines model for TagMergeRequest.
+type TagMergeRequest struct {
+	// TargetId Tag of the same type every link is moved to
+	TargetId string `json:"targetId"`
 }
 
-// ActivateCategory operation middleware
-func (siw *ServerInterfaceWrapper) ActivateCategory(w http.ResponseWriter, r *http.Request) {
+// TagMergeResult defines model for TagMergeResult.
+type TagMergeResult struct {
+	// Moved Links moved to the target, links to records already tagged with the target are dropped
+	Moved  int64 `json:"moved"`
+	Target Tag   `json:"target"`
+}
 
-	var err error
+// TagRequest defines model for TagRequest.
+type TagRequest struct {
+	// BackgroundColor Background color code for UI representation (hex format)
+	BackgroundColor *string `json:"backgroundColor,omitempty"`
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Color Text color code for UI representation (hex format)
+	Color *string `json:"color,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// Description Description of the tag
+	Description *string `json:"description,omitempty"`
 
-	ctx := r.Context()
+	// Name Name of the tag
+	Name    string  `json:"name"`
+	TagType TagType `json:"tagType"`
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// TagSpending defines model for TagSpending.
+type TagSpending struct {
+	// Count Number of records
+	Count   int     `json:"count"`
+	TagId   string  `json:"tagId"`
+	TagName string  `json:"tagName"`
+	TagType TagType `json:"tagType"`
 
-	r = r.WithContext(ctx)
+	// Total Amount of the records linked to the tag
+	Total float64 `json:"total"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ActivateCategory(w, r, id)
-	}))
+// TagSpendingReport defines model for TagSpendingReport.
+type TagSpendingReport struct {
+	Currency string `json:"currency"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Expenditures Spending per expenditure tag, biggest first
+	Expenditures []TagSpending      `json:"expenditures"`
+	From         openapi_types.Date `json:"from"`
 
-	handler.ServeHTTP(w, r)
+	// Ingresses Income per ingress tag, biggest first
+	Ingresses []TagSpending      `json:"ingresses"`
+	To        openapi_types.Date `json:"to"`
 }
 
-// DeactivateCategory operation middleware
-func (siw *ServerInterfaceWrapper) DeactivateCategory(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// TagType defines model for TagType.
+type TagType string
 
-	// ------------- Path parameter "id" -------------
-	var id string
+// Transaction defines model for Transaction.
+type Transaction struct {
+	// BalanceAfter Account balance after the transaction (for the primary account)
+	BalanceAfter *float32 `json:"balanceAfter,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// Credit Amount credited (positive value)
+	Credit *float32 `json:"credit"`
 
-	ctx := r.Context()
+	// Currency Currency of the transaction
+	Currency string `json:"currency"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Date Date and time when the transaction occurred
+	Date time.Time `json:"date"`
 
-	r = r.WithContext(ctx)
+	// Debit Amount debited (negative value)
+	Debit *float32 `json:"debit"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeactivateCategory(w, r, id)
-	}))
+	// Description Description of the transaction
+	Description string `json:"description"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Fees Any fees associated with the transaction
+	Fees *float32 `json:"fees,omitempty"`
 
-	handler.ServeHTTP(w, r)
-}
+	// FromAccountId Source account ID (for transfers, expenditures, savings withdrawals)
+	FromAccountId *string `json:"fromAccountId"`
 
-// GetExchangeRates operation middleware
-func (siw *ServerInterfaceWrapper) GetExchangeRates(w http.ResponseWriter, r *http.Request) {
+	// Id Unique identifier for the transaction
+	Id string `json:"id"`
 
-	var err error
+	// OriginalTransactionId ID of the original transaction (for rollbacks)
+	OriginalTransactionId *string `json:"originalTransactionId"`
 
-	ctx := r.Context()
+	// OriginalTransactionType Type of the original transaction (for rollbacks)
+	OriginalTransactionType *TransactionOriginalTransactionType `json:"originalTransactionType"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// RelatedEntityId ID of the related entity (expenditure, ingress, transfer, savings goal)
+	RelatedEntityId *string `json:"relatedEntityId"`
 
-	r = r.WithContext(ctx)
+	// RelatedEntityType Type of the related entity
+	RelatedEntityType *TransactionRelatedEntityType `json:"relatedEntityType"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetExchangeRatesParams
+	// RollbackReason Reason for the rollback
+	RollbackReason *string `json:"rollbackReason"`
 
-	// ------------- Required query parameter "baseCurrency" -------------
+	// Status Status of the transaction
+	Status TransactionStatus `json:"status"`
 
-	if paramValue := r.URL.Query().Get("baseCurrency"); paramValue != "" {
+	// Tags List of tag IDs associated with this transaction
+	Tags *[]Tag `json:"tags,omitempty"`
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "baseCurrency"})
-		return
-	}
+	// ToAccountId Destination account ID (for transfers, ingresses, savings contributions)
+	ToAccountId *string `json:"toAccountId"`
 
-	err = runtime.BindQueryParameter("form", true, true, "baseCurrency", r.URL.Query(), &params.BaseCurrency)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "baseCurrency", Err: err})
-		return
-	}
+	// TransactionType Type of transaction
+	TransactionType TransactionTransactionType `json:"transactionType"`
+}
 
-	// ------------- Optional query parameter "targetCurrencies" -------------
+// TransactionOriginalTransactionType Type of the original transaction (for rollbacks)
+type TransactionOriginalTransactionType string
 
-	err = runtime.BindQueryParameter("form", true, false, "targetCurrencies", r.URL.Query(), &params.TargetCurrencies)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "targetCurrencies", Err: err})
-		return
-	}
+// TransactionRelatedEntityType Type of the related entity
+type TransactionRelatedEntityType string
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetExchangeRates(w, r, params)
-	}))
+// TransactionStatus Status of the transaction
+type TransactionStatus string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// TransactionTransactionType Type of transaction
+type TransactionTransactionType string
 
-	handler.ServeHTTP(w, r)
+// TransactionList defines model for TransactionList.
+type TransactionList struct {
+	Metadata     ListMetadata  `json:"metadata"`
+	Transactions []Transaction `json:"transactions"`
 }
 
-// ListExpenditures operation middleware
-func (siw *ServerInterfaceWrapper) ListExpenditures(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// Transfer defines model for Transfer.
+type Transfer struct {
+	// Date Date of the transfer
+	Date openapi_types.Date `json:"date"`
 
-	ctx := r.Context()
+	// Description Additional details about the transfer
+	Description *string `json:"description,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// DestinationAccountId ID of the destination account
+	DestinationAccountId string `json:"destinationAccountId"`
 
-	r = r.WithContext(ctx)
+	// DestinationAmount Amount to be received at destination (for currency conversions)
+	DestinationAmount *float32 `json:"destinationAmount,omitempty"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListExpendituresParams
+	// DestinationCurrencyId ID of the destination currency
+	DestinationCurrencyId *string `json:"destinationCurrencyId,omitempty"`
 
-	// ------------- Optional query parameter "categoryId" -------------
+	// ExchangeRate Exchange rate used for currency conversion
+	ExchangeRate *float32 `json:"exchangeRate,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "categoryId", r.URL.Query(), &params.CategoryId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
-		return
-	}
+	// Fees Any fees associated with the transfer
+	Fees *float32 `json:"fees,omitempty"`
 
-	// ------------- Optional query parameter "tag" -------------
+	// Id Unique identifier for the transfer
+	Id string `json:"id"`
 
-	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
-		return
-	}
+	// SourceAccountId ID of the source account
+	SourceAccountId string `json:"sourceAccountId"`
 
-	// ------------- Optional query parameter "startDate" -------------
+	// SourceAmount Amount to transfer from source account
+	SourceAmount *float32 `json:"sourceAmount,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
-		return
-	}
+	// SourceCurrencyId ID of the source currency
+	SourceCurrencyId *string `json:"sourceCurrencyId,omitempty"`
 
-	// ------------- Optional query parameter "endDate" -------------
+	// Status Status of the transfer
+	Status TransferStatus `json:"status"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
-		return
-	}
+// TransferStatus Status of the transfer
+type TransferStatus string
 
-	// ------------- Optional query parameter "declared" -------------
+// TransferList defines model for TransferList.
+type TransferList struct {
+	Metadata  *ListMetadata `json:"metadata,omitempty"`
+	Transfers *[]Transfer   `json:"transfers,omitempty"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "declared", r.URL.Query(), &params.Declared)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "declared", Err: err})
-		return
-	}
+// TransferRequest defines model for TransferRequest.
+type TransferRequest struct {
+	// Date Date of the transfer
+	Date openapi_types.Date `json:"date"`
 
-	// ------------- Optional query parameter "planned" -------------
+	// Description Additional details about the transfer
+	Description *string `json:"description,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "planned", r.URL.Query(), &params.Planned)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "planned", Err: err})
-		return
-	}
+	// DestinationAccountId ID of the destination account
+	DestinationAccountId string `json:"destinationAccountId"`
 
-	// ------------- Optional query parameter "currency" -------------
+	// DestinationAmount Amount to be received at destination (for currency conversions)
+	DestinationAmount *float32 `json:"destinationAmount,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
-		return
-	}
+	// DestinationCurrencyId ID of the destination currency
+	DestinationCurrencyId *string `json:"destinationCurrencyId,omitempty"`
 
-	// ------------- Optional query parameter "description" -------------
+	// ExchangeRate Exchange rate used for currency conversion
+	ExchangeRate *float32 `json:"exchangeRate,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "description", r.URL.Query(), &params.Description)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "description", Err: err})
-		return
-	}
+	// Fees Any fees associated with the transfer
+	Fees *float32 `json:"fees,omitempty"`
 
-	// ------------- Optional query parameter "accountId" -------------
+	// SourceAccountId ID of the source account
+	SourceAccountId string `json:"sourceAccountId"`
 
-	err = runtime.BindQueryParameter("form", true, false, "accountId", r.URL.Query(), &params.AccountId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
-		return
-	}
+	// SourceAmount Amount to transfer from source account
+	SourceAmount *float32 `json:"sourceAmount,omitempty"`
 
-	// ------------- Optional query parameter "tags" -------------
+	// SourceCurrencyId ID of the source currency
+	SourceCurrencyId *string `json:"sourceCurrencyId,omitempty"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
-		return
-	}
+// UpcomingBill defines model for UpcomingBill.
+type UpcomingBill struct {
+	// AccountId Account the bill will be paid from
+	AccountId string `json:"accountId"`
 
-	// ------------- Optional query parameter "limit" -------------
+	// Amount Amount of the bill, an estimation for estimated bills
+	Amount float32 `json:"amount"`
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+	// AmountType Whether the bill amount is known in advance (fixed) or only an estimation to be confirmed when the bill is posted (estimated, e.g. utilities)
+	AmountType RecurrenceAmountType `json:"amountType"`
 
-	// ------------- Optional query parameter "offset" -------------
+	// AutoPost Whether the bill will be posted automatically
+	AutoPost bool `json:"autoPost"`
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
+	// CategoryId Category of the bill
+	CategoryId string `json:"categoryId"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListExpenditures(w, r, params)
-	}))
+	// Description Description of the bill
+	Description string `json:"description"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// DueDate Date the bill falls due
+	DueDate openapi_types.Date `json:"dueDate"`
 
-	handler.ServeHTTP(w, r)
+	// PatternId Recurrence pattern the bill belongs to
+	PatternId string `json:"patternId"`
 }
 
-// CreateExpenditure operation middleware
-func (siw *ServerInterfaceWrapper) CreateExpenditure(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
+// UpcomingBillList defines model for UpcomingBillList.
+type UpcomingBillList struct {
+	Bills []UpcomingBill `json:"bills"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Total Total number of upcoming bills
+	Total int `json:"total"`
+}
 
-	r = r.WithContext(ctx)
+// User defines model for User.
+type User struct {
+	// CreatedAt When the user account was created
+	CreatedAt time.Time `json:"createdAt"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateExpenditure(w, r)
-	}))
+	// Email User's email address
+	Email openapi_types.Email `json:"email"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// FirstName User's first name
+	FirstName string `json:"firstName"`
 
-	handler.ServeHTTP(w, r)
-}
+	// HouseholdId Household of the linked member, the one the user acts on
+	HouseholdId *string `json:"householdId,omitempty"`
 
-// GetExpenditure operation middleware
-func (siw *ServerInterfaceWrapper) GetExpenditure(w http.ResponseWriter, r *http.Request) {
+	// HouseholdMemberId Household member the user logs in as, missing until the user is linked to one
+	HouseholdMemberId *string `json:"householdMemberId,omitempty"`
 
-	var err error
+	// Id Unique identifier for the user
+	Id string `json:"id"`
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// LastName User's last name
+	LastName string `json:"lastName"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// Roles Names of the roles of the user in their household
+	Roles *[]string `json:"roles,omitempty"`
 
-	ctx := r.Context()
+	// UpdatedAt When the user account was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// UserMemberLinkRequest defines model for UserMemberLinkRequest.
+type UserMemberLinkRequest struct {
+	// MemberId Household member of the current household to log in as
+	MemberId string `json:"memberId"`
+}
 
-	r = r.WithContext(ctx)
+// UserRolesRequest defines model for UserRolesRequest.
+type UserRolesRequest struct {
+	// Roles Names of the roles the user holds from now on, at least one
+	Roles []string `json:"roles"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetExpenditure(w, r, id)
-	}))
+// N400 defines model for 400.
+type N400 = Error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// N403 defines model for 403.
+type N403 = Error
 
-	handler.ServeHTTP(w, r)
-}
+// N404 defines model for 404.
+type N404 = Error
 
-// RollbackExpenditure operation middleware
-func (siw *ServerInterfaceWrapper) RollbackExpenditure(w http.ResponseWriter, r *http.Request) {
+// N409 defines model for 409.
+type N409 = Error
 
-	var err error
+// N500 defines model for 500.
+type N500 = Error
 
-	// ------------- Path parameter "id" -------------
-	var id string
+// ListAccountsParams defines parameters for ListAccounts.
+type ListAccountsParams struct {
+	// Type Filter by account type (bank, cash, platform)
+	Type *string `form:"type,omitempty" json:"type,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// Currency Filter by currency
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
 
-	ctx := r.Context()
+	// Active Filter by active status
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Sort Comma-separated list of sort keys; prefix a key with '-' for descending order. Allowed keys: name, type, currency, initialBalance, currentBalance, createdAt
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 
-	r = r.WithContext(ctx)
+	// Limit Limit the number of results
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RollbackExpenditure(w, r, id)
-	}))
+	// Offset Offset the result set
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// ListAllocationPlansParams defines parameters for ListAllocationPlans.
+type ListAllocationPlansParams struct {
+	// Month Filter by any day of the planned month
+	Month *openapi_types.Date `form:"month,omitempty" json:"month,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// Currency Filter by currency ID
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
 }
 
-// ListHouseholdMembers operation middleware
-func (siw *ServerInterfaceWrapper) ListHouseholdMembers(w http.ResponseWriter, r *http.Request) {
+// RegisterUserJSONBody defines parameters for RegisterUser.
+type RegisterUserJSONBody struct {
+	// Email User's email address
+	Email openapi_types.Email `json:"email"`
 
-	var err error
+	// FirstName User's first name
+	FirstName string `json:"firstName"`
 
-	ctx := r.Context()
+	// LastName User's last name
+	LastName string `json:"lastName"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Password User's password
+	Password string `json:"password"`
+}
 
-	r = r.WithContext(ctx)
+// GetBalancesParams defines parameters for GetBalances.
+type GetBalancesParams struct {
+	// Currency Convert all balances to this currency
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListHouseholdMembersParams
+	// GroupBy How to group the balance results
+	GroupBy *GetBalancesParamsGroupBy `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+}
 
-	// ------------- Optional query parameter "active" -------------
+// GetBalancesParamsGroupBy defines parameters for GetBalances.
+type GetBalancesParamsGroupBy string
 
-	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
-		return
-	}
+// GetBudgetOverviewParams defines parameters for GetBudgetOverview.
+type GetBudgetOverviewParams struct {
+	// Date Date within the periods, defaults to today
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+}
 
-	// ------------- Optional query parameter "role" -------------
+// CopyLastBudgetPeriodParams defines parameters for CopyLastBudgetPeriod.
+type CopyLastBudgetPeriodParams struct {
+	// Date Date within the periods to set, defaults to today
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "role", r.URL.Query(), &params.Role)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
-		return
-	}
+// ListBudgetsParams defines parameters for ListBudgets.
+type ListBudgetsParams struct {
+	// CategoryId Filter by category ID
+	CategoryId *string `form:"categoryId,omitempty" json:"categoryId,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListHouseholdMembers(w, r, params)
-	}))
+	// Active Filter by active status
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// GetBudgetHistoryParams defines parameters for GetBudgetHistory.
+type GetBudgetHistoryParams struct {
+	// Date Date within the last period, defaults to today
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+}
 
-	handler.ServeHTTP(w, r)
+// GetBudgetStatusParams defines parameters for GetBudgetStatus.
+type GetBudgetStatusParams struct {
+	// Date Date within the period, defaults to today
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
 }
 
-// CreateHouseholdMember operation middleware
-func (siw *ServerInterfaceWrapper) CreateHouseholdMember(w http.ResponseWriter, r *http.Request) {
+// ListCategoriesParams defines parameters for ListCategories.
+type ListCategoriesParams struct {
+	// Type Filter categories by type
+	Type *CategoryType `form:"type,omitempty" json:"type,omitempty"`
 
-	ctx := r.Context()
+	// Tree Nest subcategories under their parent categories, total counts the top level categories
+	Tree *bool `form:"tree,omitempty" json:"tree,omitempty"`
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// ListCategorizationRulesParams defines parameters for ListCategorizationRules.
+type ListCategorizationRulesParams struct {
+	// Active Filter by active or inactive rules
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+}
 
-	r = r.WithContext(ctx)
+// SuggestCategoryParams defines parameters for SuggestCategory.
+type SuggestCategoryParams struct {
+	// Description Description of the expenditure, numbers are ignored
+	Description string `form:"description" json:"description"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateHouseholdMember(w, r)
-	}))
+	// Limit Maximum number of categories and of tags suggested
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// GetExchangeRatesParams defines parameters for GetExchangeRates.
+type GetExchangeRatesParams struct {
+	// BaseCurrency Base currency for rates
+	BaseCurrency string `form:"baseCurrency" json:"baseCurrency"`
 
-	handler.ServeHTTP(w, r)
+	// TargetCurrencies Target currencies to get rates for
+	TargetCurrencies *[]string `form:"targetCurrencies,omitempty" json:"targetCurrencies,omitempty"`
 }
 
-// DeleteHouseholdMember operation middleware
-func (siw *ServerInterfaceWrapper) DeleteHouseholdMember(w http.ResponseWriter, r *http.Request) {
+// ListExpendituresParams defines parameters for ListExpenditures.
+type ListExpendituresParams struct {
+	// CategoryId Filter by category ID
+	CategoryId *string `form:"categoryId,omitempty" json:"categoryId,omitempty"`
 
-	var err error
+	// Tag Filter by tag
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// StartDate Filter by start date (inclusive)
+	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// EndDate Filter by end date (inclusive)
+	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
 
-	ctx := r.Context()
+	// Declared Filter by declared status
+	Declared *bool `form:"declared,omitempty" json:"declared,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Planned Filter by planned status
+	Planned *bool `form:"planned,omitempty" json:"planned,omitempty"`
 
-	r = r.WithContext(ctx)
+	// Currency Filter by currency
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteHouseholdMember(w, r, id)
-	}))
+	// Description Filter by description
+	Description *string `form:"description,omitempty" json:"description,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// AccountId Filter by account ID
+	AccountId *string `form:"accountId,omitempty" json:"accountId,omitempty"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Tags Filter by tag IDs
+	Tags *[]string `form:"tags,omitempty" json:"tags,omitempty"`
 
-// GetHouseholdMember operation middleware
-func (siw *ServerInterfaceWrapper) GetHouseholdMember(w http.ResponseWriter, r *http.Request) {
+	// Status Filter by posting status, e.g. pending to list scheduled expenditures
+	Status *ExpenditureStatus `form:"status,omitempty" json:"status,omitempty"`
 
-	var err error
+	// RecurrencePatternId Filter by the recurring bill the expenditures were generated from
+	RecurrencePatternId *string `form:"recurrencePatternId,omitempty" json:"recurrencePatternId,omitempty"`
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// PayeeId Filter by payee ID
+	PayeeId *string `form:"payeeId,omitempty" json:"payeeId,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// Sort Comma-separated list of sort keys; prefix a key with '-' for descending order. Allowed keys: date, amount, description, category, createdAt
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
 
-	ctx := r.Context()
+	// Limit Limit the number of results
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Offset Offset the result set
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
 
-	r = r.WithContext(ctx)
+// CreateExpenditureParams defines parameters for CreateExpenditure.
+type CreateExpenditureParams struct {
+	// Force Create the expenditure even if it looks like a duplicate
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHouseholdMember(w, r, id)
-	}))
+// CreateExpenditureBatchParams defines parameters for CreateExpenditureBatch.
+type CreateExpenditureBatchParams struct {
+	// Force Create the expenditures even if they look like duplicates
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// ListHouseholdMembersParams defines parameters for ListHouseholdMembers.
+type ListHouseholdMembersParams struct {
+	// Active Filter by active status
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Role Filter by household role
+	Role *string `form:"role,omitempty" json:"role,omitempty"`
 
-// UpdateHouseholdMember operation middleware
-func (siw *ServerInterfaceWrapper) UpdateHouseholdMember(w http.ResponseWriter, r *http.Request) {
+	// Sort Comma-separated list of sort keys; prefix a key with '-' for descending order. Allowed keys: firstName, lastName, nickname, role, createdAt
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+}
 
-	var err error
+// ListIngressesParams defines parameters for ListIngresses.
+type ListIngressesParams struct {
+	// Category Filter by category
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Source Filter by source
+	Source *string `form:"source,omitempty" json:"source,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// Tags Filter by tags
+	Tags *[]string `form:"tags,omitempty" json:"tags,omitempty"`
 
-	ctx := r.Context()
+	// StartDate Filter by start date (inclusive)
+	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// EndDate Filter by end date (inclusive)
+	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
 
-	r = r.WithContext(ctx)
+	// IsRecurring Filter by recurring status
+	IsRecurring *bool `form:"isRecurring,omitempty" json:"isRecurring,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateHouseholdMember(w, r, id)
-	}))
+	// Currency Filter by currency
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Limit Limit the number of results
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// Offset Offset the result set
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-// ActivateHouseholdMember operation middleware
-func (siw *ServerInterfaceWrapper) ActivateHouseholdMember(w http.ResponseWriter, r *http.Request) {
+// ListInstallmentObligationsParams defines parameters for ListInstallmentObligations.
+type ListInstallmentObligationsParams struct {
+	// AccountId Filter by account ID
+	AccountId *string `form:"accountId,omitempty" json:"accountId,omitempty"`
 
-	var err error
+	// HouseholdMemberId Filter by the household member owning the accounts
+	HouseholdMemberId *string `form:"householdMemberId,omitempty" json:"householdMemberId,omitempty"`
+}
 
-	// ------------- Path parameter "id" -------------
-	var id string
+// ListInstallmentPlansParams defines parameters for ListInstallmentPlans.
+type ListInstallmentPlansParams struct {
+	// AccountId Filter by account ID
+	AccountId *string `form:"accountId,omitempty" json:"accountId,omitempty"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// ListPayeesParams defines parameters for ListPayees.
+type ListPayeesParams struct {
+	// Name Filter by payees whose name or an alias contains the text
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+}
 
-	ctx := r.Context()
+// ListExpenditureRecurrencePatternsParams defines parameters for ListExpenditureRecurrencePatterns.
+type ListExpenditureRecurrencePatternsParams struct {
+	// Active Filter by active status
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// AccountId Filter by account ID
+	AccountId *string `form:"accountId,omitempty" json:"accountId,omitempty"`
 
-	r = r.WithContext(ctx)
+	// AutoPost Filter by automatic posting
+	AutoPost *bool `form:"autoPost,omitempty" json:"autoPost,omitempty"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ActivateHouseholdMember(w, r, id)
-	}))
+// GetDeclaredExpensesReportParams defines parameters for GetDeclaredExpensesReport.
+type GetDeclaredExpensesReportParams struct {
+	// Year Fiscal year, named after the calendar year it starts in
+	Year int `form:"year" json:"year"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// StartMonth Month the fiscal year starts in, defaults to 1 (January)
+	StartMonth *int `form:"startMonth,omitempty" json:"startMonth,omitempty"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Currency Filter by currency ID
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
 
-// DeactivateHouseholdMember operation middleware
-func (siw *ServerInterfaceWrapper) DeactivateHouseholdMember(w http.ResponseWriter, r *http.Request) {
+	// MemberId Filter by the household member owning the paying account
+	MemberId *string `form:"memberId,omitempty" json:"memberId,omitempty"`
 
-	var err error
+	// Format Response format, defaults to json
+	Format *GetDeclaredExpensesReportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// AmountBasis Amount to report for foreign currency expenditures, defaults to charged
+	AmountBasis *AmountBasis `form:"amountBasis,omitempty" json:"amountBasis,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// RollUp Expenses in subcategories are reported under their top level category, defaults to false
+	RollUp *bool `form:"rollUp,omitempty" json:"rollUp,omitempty"`
+}
 
-	ctx := r.Context()
+// GetDeclaredExpensesReportParamsFormat defines parameters for GetDeclaredExpensesReport.
+type GetDeclaredExpensesReportParamsFormat string
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// GetPayeeSpendingReportParams defines parameters for GetPayeeSpendingReport.
+type GetPayeeSpendingReportParams struct {
+	// Currency ID of the currency of the expenditures to include
+	Currency string `form:"currency" json:"currency"`
 
-	r = r.WithContext(ctx)
+	// From First day of the report, defaults to the first day of the current month
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeactivateHouseholdMember(w, r, id)
-	}))
+	// To Last day of the report, defaults to today
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// AccountId Filter by account ID
+	AccountId *string `form:"accountId,omitempty" json:"accountId,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// AmountBasis Amount to report for foreign currency expenditures, defaults to charged
+	AmountBasis *AmountBasis `form:"amountBasis,omitempty" json:"amountBasis,omitempty"`
 }
 
-// ListIngresses operation middleware
-func (siw *ServerInterfaceWrapper) ListIngresses(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// GetPlannedSpendingReportParams defines parameters for GetPlannedSpendingReport.
+type GetPlannedSpendingReportParams struct {
+	// Currency ID of the currency of the expenditures to include
+	Currency string `form:"currency" json:"currency"`
 
-	ctx := r.Context()
+	// Period Period the figures are grouped by, defaults to month
+	Period *ReportPeriod `form:"period,omitempty" json:"period,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Periods Number of periods in the trend, defaults to 6
+	Periods *int `form:"periods,omitempty" json:"periods,omitempty"`
 
-	r = r.WithContext(ctx)
+	// EndDate Date inside the last period of the report, defaults to today
+	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListIngressesParams
+	// AccountId Filter by account ID
+	AccountId *string `form:"accountId,omitempty" json:"accountId,omitempty"`
 
-	// ------------- Optional query parameter "category" -------------
+	// Top Number of top unplanned categories, defaults to 5
+	Top *int `form:"top,omitempty" json:"top,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
-		return
-	}
+	// AmountBasis Amount to report for foreign currency expenditures, defaults to charged
+	AmountBasis *AmountBasis `form:"amountBasis,omitempty" json:"amountBasis,omitempty"`
 
-	// ------------- Optional query parameter "source" -------------
+	// RollUp Spending in subcategories is reported under their top level category, defaults to false
+	RollUp *bool `form:"rollUp,omitempty" json:"rollUp,omitempty"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "source", r.URL.Query(), &params.Source)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
-		return
-	}
+// GetTagCombinationReportParams defines parameters for GetTagCombinationReport.
+type GetTagCombinationReportParams struct {
+	// TagIds Tags to combine
+	TagIds []string `form:"tagIds" json:"tagIds"`
 
-	// ------------- Optional query parameter "tags" -------------
+	// Match Whether records need all of the tags or any of them, defaults to all
+	Match *TagMatch `form:"match,omitempty" json:"match,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
-		return
-	}
+	// Currency ID of the currency of the records to include
+	Currency string `form:"currency" json:"currency"`
 
-	// ------------- Optional query parameter "startDate" -------------
+	// From First day of the report, defaults to the first day of the current month
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
-		return
-	}
+	// To Last day of the report, defaults to today
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
 
-	// ------------- Optional query parameter "endDate" -------------
+	// AccountId Filter by account ID
+	AccountId *string `form:"accountId,omitempty" json:"accountId,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
-		return
-	}
+	// AmountBasis Amount to report for foreign currency records, defaults to charged
+	AmountBasis *AmountBasis `form:"amountBasis,omitempty" json:"amountBasis,omitempty"`
+}
 
-	// ------------- Optional query parameter "isRecurring" -------------
+// GetTagSpendingReportParams defines parameters for GetTagSpendingReport.
+type GetTagSpendingReportParams struct {
+	// Currency ID of the currency of the records to include
+	Currency string `form:"currency" json:"currency"`
 
-	err = runtime.BindQueryParameter("form", true, false, "isRecurring", r.URL.Query(), &params.IsRecurring)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isRecurring", Err: err})
-		return
-	}
+	// From First day of the report, defaults to the first day of the current month
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
 
-	// ------------- Optional query parameter "currency" -------------
+	// To Last day of the report, defaults to today
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
-		return
-	}
+	// AccountId Filter by account ID
+	AccountId *string `form:"accountId,omitempty" json:"accountId,omitempty"`
 
-	// ------------- Optional query parameter "limit" -------------
+	// AmountBasis Amount to report for foreign currency records, defaults to charged
+	AmountBasis *AmountBasis `form:"amountBasis,omitempty" json:"amountBasis,omitempty"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+// GetTagCategoryReportParams defines parameters for GetTagCategoryReport.
+type GetTagCategoryReportParams struct {
+	// Currency ID of the currency of the records to include
+	Currency string `form:"currency" json:"currency"`
 
-	// ------------- Optional query parameter "offset" -------------
+	// From First day of the report, defaults to the first day of the current month
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
+	// To Last day of the report, defaults to today
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListIngresses(w, r, params)
-	}))
+	// AccountId Filter by account ID
+	AccountId *string `form:"accountId,omitempty" json:"accountId,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// AmountBasis Amount to report for foreign currency records, defaults to charged
+	AmountBasis *AmountBasis `form:"amountBasis,omitempty" json:"amountBasis,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// RollUp Amounts in subcategories are reported under their top level category, defaults to false
+	RollUp *bool `form:"rollUp,omitempty" json:"rollUp,omitempty"`
 }
 
-// CreateIngress operation middleware
-func (siw *ServerInterfaceWrapper) CreateIngress(w http.ResponseWriter, r *http.Request) {
+// ListSavingsGoalsParams defines parameters for ListSavingsGoals.
+type ListSavingsGoalsParams struct {
+	// Category Filter by goal category
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
 
-	ctx := r.Context()
+	// Status Filter by goal status
+	Status *ListSavingsGoalsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Currency Filter by currency
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
 
-	r = r.WithContext(ctx)
+	// AccountId Filter by associated account ID
+	AccountId *string `form:"accountId,omitempty" json:"accountId,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateIngress(w, r)
-	}))
+	// TargetDateBefore Filter by target date before specified date
+	TargetDateBefore *openapi_types.Date `form:"targetDateBefore,omitempty" json:"targetDateBefore,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// TargetDateAfter Filter by target date after specified date
+	TargetDateAfter *openapi_types.Date `form:"targetDateAfter,omitempty" json:"targetDateAfter,omitempty"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Limit Limit the number of results
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-// GetIngress operation middleware
-func (siw *ServerInterfaceWrapper) GetIngress(w http.ResponseWriter, r *http.Request) {
+	// Offset Offset the result set
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
 
-	var err error
+// ListSavingsGoalsParamsStatus defines parameters for ListSavingsGoals.
+type ListSavingsGoalsParamsStatus string
 
-	// ------------- Path parameter "id" -------------
-	var id string
+// ListSavingsContributionsParams defines parameters for ListSavingsContributions.
+type ListSavingsContributionsParams struct {
+	// StartDate Filter by start date (inclusive)
+	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// EndDate Filter by end date (inclusive)
+	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
 
-	ctx := r.Context()
+	// SourceAccountId Filter by source account ID
+	SourceAccountId *string `form:"sourceAccountId,omitempty" json:"sourceAccountId,omitempty"`
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// ListSavingsTransactionsParams defines parameters for ListSavingsTransactions.
+type ListSavingsTransactionsParams struct {
+	// Type Filter by transaction type (contribution or withdrawal)
+	Type *ListSavingsTransactionsParamsType `form:"type,omitempty" json:"type,omitempty"`
 
-	r = r.WithContext(ctx)
+	// StartDate Filter transactions after this date (inclusive)
+	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetIngress(w, r, id)
-	}))
+	// EndDate Filter transactions before this date (inclusive)
+	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// MinAmount Filter transactions with amount greater than or equal to this value
+	MinAmount *float32 `form:"minAmount,omitempty" json:"minAmount,omitempty"`
 
-	handler.ServeHTTP(w, r)
-}
+	// MaxAmount Filter transactions with amount less than or equal to this value
+	MaxAmount *float32 `form:"maxAmount,omitempty" json:"maxAmount,omitempty"`
 
-// DeleteIngressRecurrencePattern operation middleware
-func (siw *ServerInterfaceWrapper) DeleteIngressRecurrencePattern(w http.ResponseWriter, r *http.Request) {
+	// Limit Limit the number of results
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	var err error
+	// Offset Offset the result set
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
 
-	// ------------- Path parameter "id" -------------
-	var id string
+// ListSavingsTransactionsParamsType defines parameters for ListSavingsTransactions.
+type ListSavingsTransactionsParamsType string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// ListSavingsWithdrawalsParams defines parameters for ListSavingsWithdrawals.
+type ListSavingsWithdrawalsParams struct {
+	// StartDate Filter by start date (inclusive)
+	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
 
-	// ------------- Path parameter "pattern_id" -------------
-	var patternId string
+	// EndDate Filter by end date (inclusive)
+	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pattern_id", r.PathValue("pattern_id"), &patternId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pattern_id", Err: err})
-		return
-	}
+	// DestinationAccountId Filter by destination account ID
+	DestinationAccountId *string `form:"destinationAccountId,omitempty" json:"destinationAccountId,omitempty"`
+}
 
-	ctx := r.Context()
+// SearchParams defines parameters for Search.
+type SearchParams struct {
+	// Q Free text query, words shorter than three characters are ignored
+	Q string `form:"q" json:"q"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Type Restrict the hits to the given entity types
+	Type *[]SearchHitType `form:"type,omitempty" json:"type,omitempty"`
 
-	r = r.WithContext(ctx)
+	// StartDate Filter by start date (inclusive)
+	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteIngressRecurrencePattern(w, r, id, patternId)
-	}))
+	// EndDate Filter by end date (inclusive)
+	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Limit Limit the number of results
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	handler.ServeHTTP(w, r)
+	// Offset Offset the result set
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-// GetIngressRecurrencePattern operation middleware
-func (siw *ServerInterfaceWrapper) GetIngressRecurrencePattern(w http.ResponseWriter, r *http.Request) {
+// ListTransactionsParams defines parameters for ListTransactions.
+type ListTransactionsParams struct {
+	// StartDate Filter transactions after this date
+	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
 
-	var err error
+	// EndDate Filter transactions before this date
+	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// AccountId Filter by account ID
+	AccountId *string `form:"accountId,omitempty" json:"accountId,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// TransactionType Filter by transaction type
+	TransactionType *ListTransactionsParamsTransactionType `form:"transactionType,omitempty" json:"transactionType,omitempty"`
 
-	// ------------- Path parameter "pattern_id" -------------
-	var patternId string
+	// MinAmount Filter by minimum amount
+	MinAmount *float32 `form:"minAmount,omitempty" json:"minAmount,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pattern_id", r.PathValue("pattern_id"), &patternId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pattern_id", Err: err})
-		return
-	}
+	// MaxAmount Filter by maximum amount
+	MaxAmount *float32 `form:"maxAmount,omitempty" json:"maxAmount,omitempty"`
 
-	ctx := r.Context()
+	// Currency Filter by currency
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Limit Limit the number of results
+	Limit int `form:"limit" json:"limit"`
 
-	r = r.WithContext(ctx)
+	// Offset Offset the result set
+	Offset int `form:"offset" json:"offset"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetIngressRecurrencePattern(w, r, id, patternId)
-	}))
+// ListTransactionsParamsTransactionType defines parameters for ListTransactions.
+type ListTransactionsParamsTransactionType string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// ListTransfersParams defines parameters for ListTransfers.
+type ListTransfersParams struct {
+	// SourceAccountId Filter by source account ID
+	SourceAccountId *string `form:"sourceAccountId,omitempty" json:"sourceAccountId,omitempty"`
 
-	handler.ServeHTTP(w, r)
-}
+	// DestinationAccountId Filter by destination account ID
+	DestinationAccountId *string `form:"destinationAccountId,omitempty" json:"destinationAccountId,omitempty"`
 
-// UpdateIngressRecurrencePattern operation middleware
-func (siw *ServerInterfaceWrapper) UpdateIngressRecurrencePattern(w http.ResponseWriter, r *http.Request) {
+	// StartDate Filter by start date (inclusive)
+	StartDate *openapi_types.Date `form:"startDate,omitempty" json:"startDate,omitempty"`
 
-	var err error
+	// EndDate Filter by end date (inclusive)
+	EndDate *openapi_types.Date `form:"endDate,omitempty" json:"endDate,omitempty"`
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	// Limit Limit the number of results
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	// Offset Offset the result set
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
 
-	// ------------- Path parameter "pattern_id" -------------
-	var patternId string
+// ListUpcomingBillsParams defines parameters for ListUpcomingBills.
+type ListUpcomingBillsParams struct {
+	// From First due date to include, defaults to today
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pattern_id", r.PathValue("pattern_id"), &patternId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pattern_id", Err: err})
-		return
-	}
+	// To Last due date to include, defaults to 30 days after from
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
 
-	ctx := r.Context()
+// CreateAccountJSONRequestBody defines body for CreateAccount for application/json ContentType.
+type CreateAccountJSONRequestBody = AccountRequest
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// UpdateAccountJSONRequestBody defines body for UpdateAccount for application/json ContentType.
+type UpdateAccountJSONRequestBody = Account
 
-	r = r.WithContext(ctx)
+// CreateAllocationPlanJSONRequestBody defines body for CreateAllocationPlan for application/json ContentType.
+type CreateAllocationPlanJSONRequestBody = AllocationPlanRequest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateIngressRecurrencePattern(w, r, id, patternId)
-	}))
+// UpdateAllocationPlanJSONRequestBody defines body for UpdateAllocationPlan for application/json ContentType.
+type UpdateAllocationPlanJSONRequestBody = AllocationPlanUpdateRequest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody = LoginRequest
 
-	handler.ServeHTTP(w, r)
-}
+// RegisterUserJSONRequestBody defines body for RegisterUser for application/json ContentType.
+type RegisterUserJSONRequestBody RegisterUserJSONBody
 
-// RollbackIngress operation middleware
-func (siw *ServerInterfaceWrapper) RollbackIngress(w http.ResponseWriter, r *http.Request) {
+// CreateBudgetJSONRequestBody defines body for CreateBudget for application/json ContentType.
+type CreateBudgetJSONRequestBody = BudgetRequest
 
-	var err error
+// UpdateBudgetJSONRequestBody defines body for UpdateBudget for application/json ContentType.
+type UpdateBudgetJSONRequestBody = BudgetRequest
 
-	// ------------- Path parameter "id" -------------
-	var id string
+// SetBudgetPeriodAmountJSONRequestBody defines body for SetBudgetPeriodAmount for application/json ContentType.
+type SetBudgetPeriodAmountJSONRequestBody = BudgetPeriodAmountRequest
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// CreateCategoryJSONRequestBody defines body for CreateCategory for application/json ContentType.
+type CreateCategoryJSONRequestBody = CategoryRequest
 
-	ctx := r.Context()
+// UpdateCategoryJSONRequestBody defines body for UpdateCategory for application/json ContentType.
+type UpdateCategoryJSONRequestBody = CategoryUpdateRequest
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// MergeCategoryJSONRequestBody defines body for MergeCategory for application/json ContentType.
+type MergeCategoryJSONRequestBody = CategoryMergeRequest
 
-	r = r.WithContext(ctx)
+// CreateCategorizationRuleJSONRequestBody defines body for CreateCategorizationRule for application/json ContentType.
+type CreateCategorizationRuleJSONRequestBody = CategorizationRuleRequest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RollbackIngress(w, r, id)
-	}))
+// ApplyCategorizationRulesJSONRequestBody defines body for ApplyCategorizationRules for application/json ContentType.
+type ApplyCategorizationRulesJSONRequestBody = CategorizationRuleApplyRequest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// PreviewCategorizationRuleJSONRequestBody defines body for PreviewCategorizationRule for application/json ContentType.
+type PreviewCategorizationRuleJSONRequestBody = CategorizationRulePreviewRequest
 
-	handler.ServeHTTP(w, r)
-}
+// UpdateCategorizationRuleJSONRequestBody defines body for UpdateCategorizationRule for application/json ContentType.
+type UpdateCategorizationRuleJSONRequestBody = CategorizationRuleRequest
 
-// CreateIngressRecurrencePattern operation middleware
-func (siw *ServerInterfaceWrapper) CreateIngressRecurrencePattern(w http.ResponseWriter, r *http.Request) {
+// CreateExpenditureJSONRequestBody defines body for CreateExpenditure for application/json ContentType.
+type CreateExpenditureJSONRequestBody = ExpenditureRequest
 
-	ctx := r.Context()
+// CreateExpenditureBatchJSONRequestBody defines body for CreateExpenditureBatch for application/json ContentType.
+type CreateExpenditureBatchJSONRequestBody = ExpenditureBatchRequest
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// RefundExpenditureJSONRequestBody defines body for RefundExpenditure for application/json ContentType.
+type RefundExpenditureJSONRequestBody = RefundRequest
 
-	r = r.WithContext(ctx)
+// RollbackExpenditureJSONRequestBody defines body for RollbackExpenditure for application/json ContentType.
+type RollbackExpenditureJSONRequestBody = RollbackRequest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateIngressRecurrencePattern(w, r)
-	}))
+// CreateHouseholdMemberJSONRequestBody defines body for CreateHouseholdMember for application/json ContentType.
+type CreateHouseholdMemberJSONRequestBody = HouseholdMemberRequest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// UpdateHouseholdMemberJSONRequestBody defines body for UpdateHouseholdMember for application/json ContentType.
+type UpdateHouseholdMemberJSONRequestBody = HouseholdMemberRequest
 
-	handler.ServeHTTP(w, r)
-}
-
-// ListSavingsGoals operation middleware
-func (siw *ServerInterfaceWrapper) ListSavingsGoals(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListSavingsGoalsParams
-
-	// ------------- Optional query parameter "category" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "currency" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "accountId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "accountId", r.URL.Query(), &params.AccountId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "targetDateBefore" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "targetDateBefore", r.URL.Query(), &params.TargetDateBefore)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "targetDateBefore", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "targetDateAfter" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "targetDateAfter", r.URL.Query(), &params.TargetDateAfter)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "targetDateAfter", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
-		return
-	}
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListSavingsGoals(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// CreateSavingsGoal operation middleware
-func (siw *ServerInterfaceWrapper) CreateSavingsGoal(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateSavingsGoal(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// DeleteSavingsGoal operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSavingsGoal(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteSavingsGoal(w, r, id)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// GetSavingsGoal operation middleware
-func (siw *ServerInterfaceWrapper) GetSavingsGoal(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// CreateHouseholdJSONRequestBody defines body for CreateHousehold for application/json ContentType.
+type CreateHouseholdJSONRequestBody = HouseholdRequest
 
-	r = r.WithContext(ctx)
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSavingsGoal(w, r, id)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// UpdateSavingsGoal operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSavingsGoal(w http.ResponseWriter, r *http.Request) {
+// CreateIngressJSONRequestBody defines body for CreateIngress for application/json ContentType.
+type CreateIngressJSONRequestBody = IngressRequest
 
-	var err error
+// UpdateIngressRecurrencePatternJSONRequestBody defines body for UpdateIngressRecurrencePattern for application/json ContentType.
+type UpdateIngressRecurrencePatternJSONRequestBody = RecurrencePatternRequest
 
-	// ------------- Path parameter "id" -------------
-	var id string
+// RollbackIngressJSONRequestBody defines body for RollbackIngress for application/json ContentType.
+type RollbackIngressJSONRequestBody = RollbackRequest
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// CreateInstallmentPlanJSONRequestBody defines body for CreateInstallmentPlan for application/json ContentType.
+type CreateInstallmentPlanJSONRequestBody = InstallmentPlanRequest
 
-	ctx := r.Context()
+// CreateInvitationJSONRequestBody defines body for CreateInvitation for application/json ContentType.
+type CreateInvitationJSONRequestBody = InvitationRequest
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// AcceptInvitationJSONRequestBody defines body for AcceptInvitation for application/json ContentType.
+type AcceptInvitationJSONRequestBody = InvitationAcceptRequest
 
-	r = r.WithContext(ctx)
+// JoinHouseholdJSONRequestBody defines body for JoinHousehold for application/json ContentType.
+type JoinHouseholdJSONRequestBody = InvitationJoinRequest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateSavingsGoal(w, r, id)
-	}))
+// CreatePayeeJSONRequestBody defines body for CreatePayee for application/json ContentType.
+type CreatePayeeJSONRequestBody = PayeeRequest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// UpdatePayeeJSONRequestBody defines body for UpdatePayee for application/json ContentType.
+type UpdatePayeeJSONRequestBody = PayeeRequest
 
-	handler.ServeHTTP(w, r)
-}
+// CreateIngressRecurrencePatternJSONRequestBody defines body for CreateIngressRecurrencePattern for application/json ContentType.
+type CreateIngressRecurrencePatternJSONRequestBody = RecurrencePatternRequest
 
-// ListSavingsContributions operation middleware
-func (siw *ServerInterfaceWrapper) ListSavingsContributions(w http.ResponseWriter, r *http.Request) {
+// CreateExpenditureRecurrencePatternJSONRequestBody defines body for CreateExpenditureRecurrencePattern for application/json ContentType.
+type CreateExpenditureRecurrencePatternJSONRequestBody = ExpenditureRecurrencePatternRequest
 
-	var err error
+// UpdateExpenditureRecurrencePatternJSONRequestBody defines body for UpdateExpenditureRecurrencePattern for application/json ContentType.
+type UpdateExpenditureRecurrencePatternJSONRequestBody = ExpenditureRecurrencePatternRequest
 
-	// ------------- Path parameter "id" -------------
-	var id string
+// PostExpenditureRecurrencePatternJSONRequestBody defines body for PostExpenditureRecurrencePattern for application/json ContentType.
+type PostExpenditureRecurrencePatternJSONRequestBody = ExpenditureRecurrencePostRequest
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// CreateSavingsGoalJSONRequestBody defines body for CreateSavingsGoal for application/json ContentType.
+type CreateSavingsGoalJSONRequestBody = SavingsGoalRequest
 
-	ctx := r.Context()
+// UpdateSavingsGoalJSONRequestBody defines body for UpdateSavingsGoal for application/json ContentType.
+type UpdateSavingsGoalJSONRequestBody = SavingsGoalRequest
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// AddSavingsContributionJSONRequestBody defines body for AddSavingsContribution for application/json ContentType.
+type AddSavingsContributionJSONRequestBody = SavingsContributionRequest
 
-	r = r.WithContext(ctx)
+// AddSavingsWithdrawalJSONRequestBody defines body for AddSavingsWithdrawal for application/json ContentType.
+type AddSavingsWithdrawalJSONRequestBody = SavingsWithdrawalRequest
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListSavingsContributionsParams
+// CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
+type CreateTagJSONRequestBody = TagRequest
 
-	// ------------- Optional query parameter "startDate" -------------
+// AddRecordTagsJSONRequestBody defines body for AddRecordTags for application/json ContentType.
+type AddRecordTagsJSONRequestBody = TagLinkRequest
 
-	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
-		return
-	}
+// SetRecordTagsJSONRequestBody defines body for SetRecordTags for application/json ContentType.
+type SetRecordTagsJSONRequestBody = TagLinkRequest
 
-	// ------------- Optional query parameter "endDate" -------------
+// UpdateTagJSONRequestBody defines body for UpdateTag for application/json ContentType.
+type UpdateTagJSONRequestBody = TagRequest
 
-	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
-		return
-	}
+// MergeTagJSONRequestBody defines body for MergeTag for application/json ContentType.
+type MergeTagJSONRequestBody = TagMergeRequest
 
-	// ------------- Optional query parameter "sourceAccountId" -------------
+// CreateTransferJSONRequestBody defines body for CreateTransfer for application/json ContentType.
+type CreateTransferJSONRequestBody = TransferRequest
 
-	err = runtime.BindQueryParameter("form", true, false, "sourceAccountId", r.URL.Query(), &params.SourceAccountId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceAccountId", Err: err})
-		return
-	}
+// RollbackTransferJSONRequestBody defines body for RollbackTransfer for application/json ContentType.
+type RollbackTransferJSONRequestBody = RollbackRequest
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListSavingsContributions(w, r, id, params)
-	}))
+// LinkCurrentUserMemberJSONRequestBody defines body for LinkCurrentUserMember for application/json ContentType.
+type LinkCurrentUserMemberJSONRequestBody = UserMemberLinkRequest
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// SetUserRolesJSONRequestBody defines body for SetUserRoles for application/json ContentType.
+type SetUserRolesJSONRequestBody = UserRolesRequest
 
-	handler.ServeHTTP(w, r)
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List all accounts
+	// (GET /accounts)
+	ListAccounts(w http.ResponseWriter, r *http.Request, params ListAccountsParams)
+	// Create a new money account
+	// (POST /accounts)
+	CreateAccount(w http.ResponseWriter, r *http.Request)
+	// Delete account
+	// (DELETE /accounts/{id})
+	DeleteAccount(w http.ResponseWriter, r *http.Request, id string)
+	// Get account by ID
+	// (GET /accounts/{id})
+	GetAccount(w http.ResponseWriter, r *http.Request, id string)
+	// Update account
+	// (PUT /accounts/{id})
+	UpdateAccount(w http.ResponseWriter, r *http.Request, id string)
+	// Activate an account
+	// (PATCH /accounts/{id}/activate)
+	ActivateAccount(w http.ResponseWriter, r *http.Request, id string)
+	// Checks if account can be deleted
+	// (GET /accounts/{id}/can-delete)
+	CanDeleteAccount(w http.ResponseWriter, r *http.Request, id string)
+	// Deactivate an account
+	// (PATCH /accounts/{id}/deactivate)
+	DeactivateAccount(w http.ResponseWriter, r *http.Request, id string)
+	// List allocation plans
+	// (GET /allocation-plans)
+	ListAllocationPlans(w http.ResponseWriter, r *http.Request, params ListAllocationPlansParams)
+	// Create an allocation plan
+	// (POST /allocation-plans)
+	CreateAllocationPlan(w http.ResponseWriter, r *http.Request)
+	// Delete allocation plan
+	// (DELETE /allocation-plans/{id})
+	DeleteAllocationPlan(w http.ResponseWriter, r *http.Request, id string)
+	// Get allocation plan by ID
+	// (GET /allocation-plans/{id})
+	GetAllocationPlan(w http.ResponseWriter, r *http.Request, id string)
+	// Update allocation plan
+	// (PUT /allocation-plans/{id})
+	UpdateAllocationPlan(w http.ResponseWriter, r *http.Request, id string)
+	// Login to the system
+	// (POST /auth/login)
+	Login(w http.ResponseWriter, r *http.Request)
+	// Refresh authentication token
+	// (POST /auth/refresh)
+	RefreshToken(w http.ResponseWriter, r *http.Request)
+	// Register a new user
+	// (POST /auth/register)
+	RegisterUser(w http.ResponseWriter, r *http.Request)
+	// Get current balances
+	// (GET /balances)
+	GetBalances(w http.ResponseWriter, r *http.Request, params GetBalancesParams)
+	// Household budget overview
+	// (GET /budget-overview)
+	GetBudgetOverview(w http.ResponseWriter, r *http.Request, params GetBudgetOverviewParams)
+	// Copy the amounts of the last budget period
+	// (POST /budget-overview/copy-last-period)
+	CopyLastBudgetPeriod(w http.ResponseWriter, r *http.Request, params CopyLastBudgetPeriodParams)
+	// List budgets
+	// (GET /budgets)
+	ListBudgets(w http.ResponseWriter, r *http.Request, params ListBudgetsParams)
+	// Create a budget
+	// (POST /budgets)
+	CreateBudget(w http.ResponseWriter, r *http.Request)
+	// Delete budget
+	// (DELETE /budgets/{id})
+	DeleteBudget(w http.ResponseWriter, r *http.Request, id string)
+	// Get budget by ID
+	// (GET /budgets/{id})
+	GetBudget(w http.ResponseWriter, r *http.Request, id string)
+	// Update budget
+	// (PUT /budgets/{id})
+	UpdateBudget(w http.ResponseWriter, r *http.Request, id string)
+	// Get the history of a budget
+	// (GET /budgets/{id}/history)
+	GetBudgetHistory(w http.ResponseWriter, r *http.Request, id string, params GetBudgetHistoryParams)
+	// Set the amount of a budget period
+	// (PUT /budgets/{id}/periods/{number})
+	SetBudgetPeriodAmount(w http.ResponseWriter, r *http.Request, id string, number int)
+	// Get the status of a budget
+	// (GET /budgets/{id}/status)
+	GetBudgetStatus(w http.ResponseWriter, r *http.Request, id string, params GetBudgetStatusParams)
+	// List categories
+	// (GET /categories)
+	ListCategories(w http.ResponseWriter, r *http.Request, params ListCategoriesParams)
+	// Create category
+	// (POST /categories)
+	CreateCategory(w http.ResponseWriter, r *http.Request)
+	// Delete category
+	// (DELETE /categories/{id})
+	DeleteCategory(w http.ResponseWriter, r *http.Request, id string)
+	// Update category
+	// (PUT /categories/{id})
+	UpdateCategory(w http.ResponseWriter, r *http.Request, id string)
+	// Activate a category
+	// (PATCH /categories/{id}/activate)
+	ActivateCategory(w http.ResponseWriter, r *http.Request, id string)
+	// Deactivate a category
+	// (PATCH /categories/{id}/deactivate)
+	DeactivateCategory(w http.ResponseWriter, r *http.Request, id string)
+	// Merge a category into another one
+	// (POST /categories/{id}/merge)
+	MergeCategory(w http.ResponseWriter, r *http.Request, id string)
+	// List categorization rules
+	// (GET /categorization-rules)
+	ListCategorizationRules(w http.ResponseWriter, r *http.Request, params ListCategorizationRulesParams)
+	// Create a categorization rule
+	// (POST /categorization-rules)
+	CreateCategorizationRule(w http.ResponseWriter, r *http.Request)
+	// Run the categorization rules on past expenditures
+	// (POST /categorization-rules/apply)
+	ApplyCategorizationRules(w http.ResponseWriter, r *http.Request)
+	// Test a categorization rule against past expenditures
+	// (POST /categorization-rules/preview)
+	PreviewCategorizationRule(w http.ResponseWriter, r *http.Request)
+	// Delete categorization rule
+	// (DELETE /categorization-rules/{id})
+	DeleteCategorizationRule(w http.ResponseWriter, r *http.Request, id string)
+	// Get categorization rule by ID
+	// (GET /categorization-rules/{id})
+	GetCategorizationRule(w http.ResponseWriter, r *http.Request, id string)
+	// Update categorization rule
+	// (PUT /categorization-rules/{id})
+	UpdateCategorizationRule(w http.ResponseWriter, r *http.Request, id string)
+	// Suggest a category and tags for a description
+	// (GET /category-suggestions)
+	SuggestCategory(w http.ResponseWriter, r *http.Request, params SuggestCategoryParams)
+	// Retrain the category suggestion model
+	// (POST /category-suggestions/retrain)
+	RetrainCategorySuggestions(w http.ResponseWriter, r *http.Request)
+	// Get current exchange rates
+	// (GET /exchange-rates)
+	GetExchangeRates(w http.ResponseWriter, r *http.Request, params GetExchangeRatesParams)
+	// List all expenditures
+	// (GET /expenditures)
+	ListExpenditures(w http.ResponseWriter, r *http.Request, params ListExpendituresParams)
+	// Create a new expenditure
+	// (POST /expenditures)
+	CreateExpenditure(w http.ResponseWriter, r *http.Request, params CreateExpenditureParams)
+	// Create expenditures in bulk
+	// (POST /expenditures/batch)
+	CreateExpenditureBatch(w http.ResponseWriter, r *http.Request, params CreateExpenditureBatchParams)
+	// Get expenditure by ID
+	// (GET /expenditures/{id})
+	GetExpenditure(w http.ResponseWriter, r *http.Request, id string)
+	// List the refunds of an expenditure
+	// (GET /expenditures/{id}/refunds)
+	ListExpenditureRefunds(w http.ResponseWriter, r *http.Request, id string)
+	// Refund an expenditure
+	// (POST /expenditures/{id}/refunds)
+	RefundExpenditure(w http.ResponseWriter, r *http.Request, id string)
+	// Rollback an expenditure
+	// (POST /expenditures/{id}/rollback)
+	RollbackExpenditure(w http.ResponseWriter, r *http.Request, id string)
+	// List all household members
+	// (GET /household-members)
+	ListHouseholdMembers(w http.ResponseWriter, r *http.Request, params ListHouseholdMembersParams)
+	// Create a new household member
+	// (POST /household-members)
+	CreateHouseholdMember(w http.ResponseWriter, r *http.Request)
+	// Delete household member
+	// (DELETE /household-members/{id})
+	DeleteHouseholdMember(w http.ResponseWriter, r *http.Request, id string)
+	// Get household member by ID
+	// (GET /household-members/{id})
+	GetHouseholdMember(w http.ResponseWriter, r *http.Request, id string)
+	// Update household member
+	// (PUT /household-members/{id})
+	UpdateHouseholdMember(w http.ResponseWriter, r *http.Request, id string)
+	// Activate household member by ID
+	// (PATCH /household-members/{id}/activate)
+	ActivateHouseholdMember(w http.ResponseWriter, r *http.Request, id string)
+	// Deactivate household member by ID
+	// (PATCH /household-members/{id}/deactivate)
+	DeactivateHouseholdMember(w http.ResponseWriter, r *http.Request, id string)
+	// Create a new household
+	// (POST /households)
+	CreateHousehold(w http.ResponseWriter, r *http.Request)
+	// Get the current household
+	// (GET /households/current)
+	GetCurrentHousehold(w http.ResponseWriter, r *http.Request)
+	// List all ingresses
+	// (GET /ingresses)
+	ListIngresses(w http.ResponseWriter, r *http.Request, params ListIngressesParams)
+	// Create a new ingress
+	// (POST /ingresses)
+	CreateIngress(w http.ResponseWriter, r *http.Request)
+	// Get ingress by ID
+	// (GET /ingresses/{id})
+	GetIngress(w http.ResponseWriter, r *http.Request, id string)
+	// Delete a recurrence pattern
+	// (DELETE /ingresses/{id}/recurrence-pattern/{pattern_id})
+	DeleteIngressRecurrencePattern(w http.ResponseWriter, r *http.Request, id string, patternId string)
+	// Get a specific recurrence pattern
+	// (GET /ingresses/{id}/recurrence-pattern/{pattern_id})
+	GetIngressRecurrencePattern(w http.ResponseWriter, r *http.Request, id string, patternId string)
+	// Update a recurrence pattern
+	// (PUT /ingresses/{id}/recurrence-pattern/{pattern_id})
+	UpdateIngressRecurrencePattern(w http.ResponseWriter, r *http.Request, id string, patternId string)
+	// Rollback an Ingress
+	// (POST /ingresses/{id}/rollback)
+	RollbackIngress(w http.ResponseWriter, r *http.Request, id string)
+	// List remaining installment obligations
+	// (GET /installment-obligations)
+	ListInstallmentObligations(w http.ResponseWriter, r *http.Request, params ListInstallmentObligationsParams)
+	// List installment plans
+	// (GET /installment-plans)
+	ListInstallmentPlans(w http.ResponseWriter, r *http.Request, params ListInstallmentPlansParams)
+	// Create an installment plan
+	// (POST /installment-plans)
+	CreateInstallmentPlan(w http.ResponseWriter, r *http.Request)
+	// Get an installment plan
+	// (GET /installment-plans/{id})
+	GetInstallmentPlan(w http.ResponseWriter, r *http.Request, id string)
+	// Pay off an installment plan early
+	// (POST /installment-plans/{id}/payoff)
+	PayOffInstallmentPlan(w http.ResponseWriter, r *http.Request, id string)
+	// Invite someone to the household
+	// (POST /invitations)
+	CreateInvitation(w http.ResponseWriter, r *http.Request)
+	// Accept an invitation
+	// (POST /invitations/accept)
+	AcceptInvitation(w http.ResponseWriter, r *http.Request)
+	// Join a household through an invitation
+	// (POST /invitations/join)
+	JoinHousehold(w http.ResponseWriter, r *http.Request)
+	// List payees
+	// (GET /payees)
+	ListPayees(w http.ResponseWriter, r *http.Request, params ListPayeesParams)
+	// Create a payee
+	// (POST /payees)
+	CreatePayee(w http.ResponseWriter, r *http.Request)
+	// Delete payee
+	// (DELETE /payees/{id})
+	DeletePayee(w http.ResponseWriter, r *http.Request, id string)
+	// Get payee by ID
+	// (GET /payees/{id})
+	GetPayee(w http.ResponseWriter, r *http.Request, id string)
+	// Update payee
+	// (PUT /payees/{id})
+	UpdatePayee(w http.ResponseWriter, r *http.Request, id string)
+	// Create a recurrence pattern for Ingresses
+	// (POST /recurrence-pattern)
+	CreateIngressRecurrencePattern(w http.ResponseWriter, r *http.Request)
+	// List recurring bills
+	// (GET /recurring-bills)
+	ListExpenditureRecurrencePatterns(w http.ResponseWriter, r *http.Request, params ListExpenditureRecurrencePatternsParams)
+	// Create a recurring bill
+	// (POST /recurring-bills)
+	CreateExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request)
+	// Delete recurring bill
+	// (DELETE /recurring-bills/{id})
+	DeleteExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request, id string)
+	// Get recurring bill by ID
+	// (GET /recurring-bills/{id})
+	GetExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request, id string)
+	// Update recurring bill
+	// (PUT /recurring-bills/{id})
+	UpdateExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request, id string)
+	// Post the next bill
+	// (POST /recurring-bills/{id}/post)
+	PostExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request, id string)
+	// Declared expenses report
+	// (GET /reports/declared-expenses)
+	GetDeclaredExpensesReport(w http.ResponseWriter, r *http.Request, params GetDeclaredExpensesReportParams)
+	// Spending per payee report
+	// (GET /reports/payee-spending)
+	GetPayeeSpendingReport(w http.ResponseWriter, r *http.Request, params GetPayeeSpendingReportParams)
+	// Planned vs unplanned spending report
+	// (GET /reports/planned-spending)
+	GetPlannedSpendingReport(w http.ResponseWriter, r *http.Request, params GetPlannedSpendingReportParams)
+	// Tag combination report
+	// (GET /reports/tag-combination)
+	GetTagCombinationReport(w http.ResponseWriter, r *http.Request, params GetTagCombinationReportParams)
+	// Spending per tag report
+	// (GET /reports/tag-spending)
+	GetTagSpendingReport(w http.ResponseWriter, r *http.Request, params GetTagSpendingReportParams)
+	// Tag spending per category report
+	// (GET /reports/tag-spending/{tagId}/categories)
+	GetTagCategoryReport(w http.ResponseWriter, r *http.Request, tagId string, params GetTagCategoryReportParams)
+	// List roles
+	// (GET /roles)
+	ListRoles(w http.ResponseWriter, r *http.Request)
+	// List all savings goals
+	// (GET /savings)
+	ListSavingsGoals(w http.ResponseWriter, r *http.Request, params ListSavingsGoalsParams)
+	// Create a new savings goal
+	// (POST /savings)
+	CreateSavingsGoal(w http.ResponseWriter, r *http.Request)
+	// Delete savings goal
+	// (DELETE /savings/{id})
+	DeleteSavingsGoal(w http.ResponseWriter, r *http.Request, id string)
+	// Get savings goal by ID
+	// (GET /savings/{id})
+	GetSavingsGoal(w http.ResponseWriter, r *http.Request, id string)
+	// Update savings goal
+	// (PUT /savings/{id})
+	UpdateSavingsGoal(w http.ResponseWriter, r *http.Request, id string)
+	// List contributions for a savings goal
+	// (GET /savings/{id}/contributions)
+	ListSavingsContributions(w http.ResponseWriter, r *http.Request, id string, params ListSavingsContributionsParams)
+	// Add contribution to savings goal
+	// (POST /savings/{id}/contributions)
+	AddSavingsContribution(w http.ResponseWriter, r *http.Request, id string)
+	// Get savings goal progress
+	// (GET /savings/{id}/progress)
+	GetSavingsProgress(w http.ResponseWriter, r *http.Request, id string)
+	// List transactions for a savings goal
+	// (GET /savings/{id}/transactions)
+	ListSavingsTransactions(w http.ResponseWriter, r *http.Request, id string, params ListSavingsTransactionsParams)
+	// List withdrawals from a savings goal
+	// (GET /savings/{id}/withdrawals)
+	ListSavingsWithdrawals(w http.ResponseWriter, r *http.Request, id string, params ListSavingsWithdrawalsParams)
+	// Add withdrawal from savings goal
+	// (POST /savings/{id}/withdrawals)
+	AddSavingsWithdrawal(w http.ResponseWriter, r *http.Request, id string)
+	// Search transactions
+	// (GET /search)
+	Search(w http.ResponseWriter, r *http.Request, params SearchParams)
+	// List tags
+	// (GET /tags)
+	ListTags(w http.ResponseWriter, r *http.Request)
+	// Create tag
+	// (POST /tags)
+	CreateTag(w http.ResponseWriter, r *http.Request)
+	// List tags by type
+	// (GET /tags/type/{type})
+	ListTagsByType(w http.ResponseWriter, r *http.Request, pType TagType)
+	// List the tags of a record
+	// (GET /tags/type/{type}/records/{recordId})
+	ListRecordTags(w http.ResponseWriter, r *http.Request, pType TagType, recordId string)
+	// Add tags to a record
+	// (POST /tags/type/{type}/records/{recordId})
+	AddRecordTags(w http.ResponseWriter, r *http.Request, pType TagType, recordId string)
+	// Replace the tags of a record
+	// (PUT /tags/type/{type}/records/{recordId})
+	SetRecordTags(w http.ResponseWriter, r *http.Request, pType TagType, recordId string)
+	// Remove a tag from a record
+	// (DELETE /tags/type/{type}/records/{recordId}/{tagId})
+	RemoveRecordTag(w http.ResponseWriter, r *http.Request, pType TagType, recordId string, tagId string)
+	// Delete tag
+	// (DELETE /tags/{id})
+	DeleteTag(w http.ResponseWriter, r *http.Request, id string)
+	// Update tag
+	// (PUT /tags/{id})
+	UpdateTag(w http.ResponseWriter, r *http.Request, id string)
+	// Merge a tag into another one
+	// (POST /tags/{id}/merge)
+	MergeTag(w http.ResponseWriter, r *http.Request, id string)
+	// List all transactions
+	// (GET /transactions)
+	ListTransactions(w http.ResponseWriter, r *http.Request, params ListTransactionsParams)
+	// List all transfers
+	// (GET /transfers)
+	ListTransfers(w http.ResponseWriter, r *http.Request, params ListTransfersParams)
+	// Create a new money transfer
+	// (POST /transfers)
+	CreateTransfer(w http.ResponseWriter, r *http.Request)
+	// Get transfer by ID
+	// (GET /transfers/{id})
+	GetTransfer(w http.ResponseWriter, r *http.Request, id string)
+	// Rollback a Transfer
+	// (POST /transfers/{id}/rollback)
+	RollbackTransfer(w http.ResponseWriter, r *http.Request, id string)
+	// List upcoming bills
+	// (GET /upcoming-bills)
+	ListUpcomingBills(w http.ResponseWriter, r *http.Request, params ListUpcomingBillsParams)
+	// List the users of the household
+	// (GET /users)
+	ListUsers(w http.ResponseWriter, r *http.Request)
+	// Get the authenticated user
+	// (GET /users/me)
+	GetCurrentUser(w http.ResponseWriter, r *http.Request)
+	// Link the authenticated user to a household member
+	// (PUT /users/me/household-member)
+	LinkCurrentUserMember(w http.ResponseWriter, r *http.Request)
+	// Set the roles of a user
+	// (PUT /users/{id}/roles)
+	SetUserRoles(w http.ResponseWriter, r *http.Request, id string)
 }
 
-// AddSavingsContribution operation middleware
-func (siw *ServerInterfaceWrapper) AddSavingsContribution(w http.ResponseWriter, r *http.Request) {
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
 
-	var err error
+type MiddlewareFunc func(http.Handler) http.Handler
 
-	// ------------- Path parameter "id" -------------
-	var id string
+// ListAccounts operation middleware
+func (siw *ServerInterfaceWrapper) ListAccounts(w http.ResponseWriter, r *http.Request) {
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	var err error
 
 	ctx := r.Context()
 
@@ -3346,39 +3864,59 @@ func (siw *ServerInterfaceWrapper) AddSavingsContribution(w http.ResponseWriter,
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddSavingsContribution(w, r, id)
-	}))
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAccountsParams
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "currency" -------------
 
-// GetSavingsProgress operation middleware
-func (siw *ServerInterfaceWrapper) GetSavingsProgress(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
 
-	var err error
+	// ------------- Optional query parameter "active" -------------
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
 		return
 	}
 
-	ctx := r.Context()
+	// ------------- Optional query parameter "limit" -------------
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-	r = r.WithContext(ctx)
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSavingsProgress(w, r, id)
+		siw.Handler.ListAccounts(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3388,13 +3926,33 @@ func (siw *ServerInterfaceWrapper) GetSavingsProgress(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// ListSavingsTransactions operation middleware
-func (siw *ServerInterfaceWrapper) ListSavingsTransactions(w http.ResponseWriter, r *http.Request) {
+// CreateAccount operation middleware
+func (siw *ServerInterfaceWrapper) CreateAccount(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAccount(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAccount operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAccount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3408,67 +3966,70 @@ func (siw *ServerInterfaceWrapper) ListSavingsTransactions(w http.ResponseWriter
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListSavingsTransactionsParams
-
-	// ------------- Optional query parameter "type" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAccount(w, r, id)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "startDate" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
-		return
-	}
+// GetAccount operation middleware
+func (siw *ServerInterfaceWrapper) GetAccount(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "endDate" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "minAmount" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "minAmount", r.URL.Query(), &params.MinAmount)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minAmount", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// ------------- Optional query parameter "maxAmount" -------------
+	r = r.WithContext(ctx)
 
-	err = runtime.BindQueryParameter("form", true, false, "maxAmount", r.URL.Query(), &params.MaxAmount)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxAmount", Err: err})
-		return
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAccount(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
+// UpdateAccount operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAccount(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "offset" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListSavingsTransactions(w, r, id, params)
+		siw.Handler.UpdateAccount(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3478,8 +4039,8 @@ func (siw *ServerInterfaceWrapper) ListSavingsTransactions(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// ListSavingsWithdrawals operation middleware
-func (siw *ServerInterfaceWrapper) ListSavingsWithdrawals(w http.ResponseWriter, r *http.Request) {
+// ActivateAccount operation middleware
+func (siw *ServerInterfaceWrapper) ActivateAccount(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3498,35 +4059,39 @@ func (siw *ServerInterfaceWrapper) ListSavingsWithdrawals(w http.ResponseWriter,
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListSavingsWithdrawalsParams
-
-	// ------------- Optional query parameter "startDate" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ActivateAccount(w, r, id)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "endDate" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
-		return
-	}
+// CanDeleteAccount operation middleware
+func (siw *ServerInterfaceWrapper) CanDeleteAccount(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "destinationAccountId" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "destinationAccountId", r.URL.Query(), &params.DestinationAccountId)
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "destinationAccountId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListSavingsWithdrawals(w, r, id, params)
+		siw.Handler.CanDeleteAccount(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3536,8 +4101,8 @@ func (siw *ServerInterfaceWrapper) ListSavingsWithdrawals(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// AddSavingsWithdrawal operation middleware
-func (siw *ServerInterfaceWrapper) AddSavingsWithdrawal(w http.ResponseWriter, r *http.Request) {
+// DeactivateAccount operation middleware
+func (siw *ServerInterfaceWrapper) DeactivateAccount(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3557,7 +4122,7 @@ func (siw *ServerInterfaceWrapper) AddSavingsWithdrawal(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddSavingsWithdrawal(w, r, id)
+		siw.Handler.DeactivateAccount(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3567,8 +4132,10 @@ func (siw *ServerInterfaceWrapper) AddSavingsWithdrawal(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListTags operation middleware
-func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Request) {
+// ListAllocationPlans operation middleware
+func (siw *ServerInterfaceWrapper) ListAllocationPlans(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -3576,8 +4143,27 @@ func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Reque
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAllocationPlansParams
+
+	// ------------- Optional query parameter "month" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "month", r.URL.Query(), &params.Month)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "month", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTags(w, r)
+		siw.Handler.ListAllocationPlans(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3587,8 +4173,8 @@ func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// CreateTag operation middleware
-func (siw *ServerInterfaceWrapper) CreateTag(w http.ResponseWriter, r *http.Request) {
+// CreateAllocationPlan operation middleware
+func (siw *ServerInterfaceWrapper) CreateAllocationPlan(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3597,7 +4183,7 @@ func (siw *ServerInterfaceWrapper) CreateTag(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTag(w, r)
+		siw.Handler.CreateAllocationPlan(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3607,17 +4193,17 @@ func (siw *ServerInterfaceWrapper) CreateTag(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// ListTagsByType operation middleware
-func (siw *ServerInterfaceWrapper) ListTagsByType(w http.ResponseWriter, r *http.Request) {
+// DeleteAllocationPlan operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAllocationPlan(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "type" -------------
-	var pType TagType
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "type", r.PathValue("type"), &pType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -3628,7 +4214,7 @@ func (siw *ServerInterfaceWrapper) ListTagsByType(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTagsByType(w, r, pType)
+		siw.Handler.DeleteAllocationPlan(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3638,8 +4224,8 @@ func (siw *ServerInterfaceWrapper) ListTagsByType(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTag operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Request) {
+// GetAllocationPlan operation middleware
+func (siw *ServerInterfaceWrapper) GetAllocationPlan(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3659,7 +4245,7 @@ func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTag(w, r, id)
+		siw.Handler.GetAllocationPlan(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3669,68 +4255,105 @@ func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// ListTransactions operation middleware
-func (siw *ServerInterfaceWrapper) ListTransactions(w http.ResponseWriter, r *http.Request) {
+// UpdateAllocationPlan operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAllocationPlan(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTransactionsParams
-
-	// ------------- Optional query parameter "startDate" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateAllocationPlan(w, r, id)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "endDate" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
-		return
-	}
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "accountId" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "accountId", r.URL.Query(), &params.AccountId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// ------------- Optional query parameter "transactionType" -------------
+	r = r.WithContext(ctx)
 
-	err = runtime.BindQueryParameter("form", true, false, "transactionType", r.URL.Query(), &params.TransactionType)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transactionType", Err: err})
-		return
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Login(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "minAmount" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "minAmount", r.URL.Query(), &params.MinAmount)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minAmount", Err: err})
-		return
-	}
+// RefreshToken operation middleware
+func (siw *ServerInterfaceWrapper) RefreshToken(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "maxAmount" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "maxAmount", r.URL.Query(), &params.MaxAmount)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxAmount", Err: err})
-		return
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RefreshToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RegisterUser operation middleware
+func (siw *ServerInterfaceWrapper) RegisterUser(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegisterUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// GetBalances operation middleware
+func (siw *ServerInterfaceWrapper) GetBalances(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBalancesParams
+
 	// ------------- Optional query parameter "currency" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
@@ -3739,38 +4362,49 @@ func (siw *ServerInterfaceWrapper) ListTransactions(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Required query parameter "limit" -------------
-
-	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+	// ------------- Optional query parameter "groupBy" -------------
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+	err = runtime.BindQueryParameter("form", true, false, "groupBy", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupBy", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBalances(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Required query parameter "offset" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+// GetBudgetOverview operation middleware
+func (siw *ServerInterfaceWrapper) GetBudgetOverview(w http.ResponseWriter, r *http.Request) {
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
-		return
-	}
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, true, "offset", r.URL.Query(), &params.Offset)
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBudgetOverviewParams
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTransactions(w, r, params)
+		siw.Handler.GetBudgetOverview(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3780,8 +4414,8 @@ func (siw *ServerInterfaceWrapper) ListTransactions(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// ListTransfers operation middleware
-func (siw *ServerInterfaceWrapper) ListTransfers(w http.ResponseWriter, r *http.Request) {
+// CopyLastBudgetPeriod operation middleware
+func (siw *ServerInterfaceWrapper) CopyLastBudgetPeriod(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3792,58 +4426,59 @@ func (siw *ServerInterfaceWrapper) ListTransfers(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTransfersParams
+	var params CopyLastBudgetPeriodParams
 
-	// ------------- Optional query parameter "sourceAccountId" -------------
+	// ------------- Optional query parameter "date" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "sourceAccountId", r.URL.Query(), &params.SourceAccountId)
+	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceAccountId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "destinationAccountId" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CopyLastBudgetPeriod(w, r, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "destinationAccountId", r.URL.Query(), &params.DestinationAccountId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "destinationAccountId", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "startDate" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
-		return
-	}
+// ListBudgets operation middleware
+func (siw *ServerInterfaceWrapper) ListBudgets(w http.ResponseWriter, r *http.Request) {
 
-	// ------------- Optional query parameter "endDate" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
-		return
-	}
+	ctx := r.Context()
 
-	// ------------- Optional query parameter "limit" -------------
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBudgetsParams
+
+	// ------------- Optional query parameter "categoryId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "categoryId", r.URL.Query(), &params.CategoryId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "active" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTransfers(w, r, params)
+		siw.Handler.ListBudgets(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3853,8 +4488,8 @@ func (siw *ServerInterfaceWrapper) ListTransfers(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// CreateTransfer operation middleware
-func (siw *ServerInterfaceWrapper) CreateTransfer(w http.ResponseWriter, r *http.Request) {
+// CreateBudget operation middleware
+func (siw *ServerInterfaceWrapper) CreateBudget(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -3863,7 +4498,7 @@ func (siw *ServerInterfaceWrapper) CreateTransfer(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTransfer(w, r)
+		siw.Handler.CreateBudget(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3873,8 +4508,8 @@ func (siw *ServerInterfaceWrapper) CreateTransfer(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetTransfer operation middleware
-func (siw *ServerInterfaceWrapper) GetTransfer(w http.ResponseWriter, r *http.Request) {
+// DeleteBudget operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBudget(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3894,7 +4529,7 @@ func (siw *ServerInterfaceWrapper) GetTransfer(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTransfer(w, r, id)
+		siw.Handler.DeleteBudget(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3904,8 +4539,8 @@ func (siw *ServerInterfaceWrapper) GetTransfer(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// RollbackTransfer operation middleware
-func (siw *ServerInterfaceWrapper) RollbackTransfer(w http.ResponseWriter, r *http.Request) {
+// GetBudget operation middleware
+func (siw *ServerInterfaceWrapper) GetBudget(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3925,7 +4560,7 @@ func (siw *ServerInterfaceWrapper) RollbackTransfer(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RollbackTransfer(w, r, id)
+		siw.Handler.GetBudget(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3935,2746 +4570,10027 @@ func (siw *ServerInterfaceWrapper) RollbackTransfer(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// UpdateBudget operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBudget(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	ctx := r.Context()
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RequiredParamError struct {
-	ParamName string
-}
+	r = r.WithContext(ctx)
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateBudget(w, r, id)
+	}))
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+	handler.ServeHTTP(w, r)
 }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+// GetBudgetHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetBudgetHistory(w http.ResponseWriter, r *http.Request) {
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	var err error
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	ctx := r.Context()
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
-}
+	r = r.WithContext(ctx)
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBudgetHistoryParams
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// ------------- Optional query parameter "date" -------------
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
-}
+	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBudgetHistory(w, r, id, params)
+	}))
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
-
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	handler.ServeHTTP(w, r)
+}
 
-	m.HandleFunc("GET "+options.BaseURL+"/accounts", wrapper.ListAccounts)
-	m.HandleFunc("POST "+options.BaseURL+"/accounts", wrapper.CreateAccount)
-	m.HandleFunc("DELETE "+options.BaseURL+"/accounts/{id}", wrapper.DeleteAccount)
-	m.HandleFunc("GET "+options.BaseURL+"/accounts/{id}", wrapper.GetAccount)
-	m.HandleFunc("PUT "+options.BaseURL+"/accounts/{id}", wrapper.UpdateAccount)
-	m.HandleFunc("PATCH "+options.BaseURL+"/accounts/{id}/activate", wrapper.ActivateAccount)
-	m.HandleFunc("GET "+options.BaseURL+"/accounts/{id}/can-delete", wrapper.CanDeleteAccount)
-	m.HandleFunc("PATCH "+options.BaseURL+"/accounts/{id}/deactivate", wrapper.DeactivateAccount)
-	m.HandleFunc("POST "+options.BaseURL+"/auth/login", wrapper.Login)
-	m.HandleFunc("POST "+options.BaseURL+"/auth/refresh", wrapper.RefreshToken)
-	m.HandleFunc("POST "+options.BaseURL+"/auth/register", wrapper.RegisterUser)
-	m.HandleFunc("GET "+options.BaseURL+"/balances", wrapper.GetBalances)
-	m.HandleFunc("GET "+options.BaseURL+"/categories", wrapper.ListCategories)
-	m.HandleFunc("POST "+options.BaseURL+"/categories", wrapper.CreateCategory)
-	m.HandleFunc("DELETE "+options.BaseURL+"/categories/{id}", wrapper.DeleteCategory)
-	m.HandleFunc("PUT "+options.BaseURL+"/categories/{id}", wrapper.UpdateCategory)
-	m.HandleFunc("PATCH "+options.BaseURL+"/categories/{id}/activate", wrapper.ActivateCategory)
-	m.HandleFunc("PATCH "+options.BaseURL+"/categories/{id}/deactivate", wrapper.DeactivateCategory)
-	m.HandleFunc("GET "+options.BaseURL+"/exchange-rates", wrapper.GetExchangeRates)
-	m.HandleFunc("GET "+options.BaseURL+"/expenditures", wrapper.ListExpenditures)
-	m.HandleFunc("POST "+options.BaseURL+"/expenditures", wrapper.CreateExpenditure)
-	m.HandleFunc("GET "+options.BaseURL+"/expenditures/{id}", wrapper.GetExpenditure)
-	m.HandleFunc("POST "+options.BaseURL+"/expenditures/{id}/rollback", wrapper.RollbackExpenditure)
-	m.HandleFunc("GET "+options.BaseURL+"/household-members", wrapper.ListHouseholdMembers)
-	m.HandleFunc("POST "+options.BaseURL+"/household-members", wrapper.CreateHouseholdMember)
-	m.HandleFunc("DELETE "+options.BaseURL+"/household-members/{id}", wrapper.DeleteHouseholdMember)
-	m.HandleFunc("GET "+options.BaseURL+"/household-members/{id}", wrapper.GetHouseholdMember)
-	m.HandleFunc("PUT "+options.BaseURL+"/household-members/{id}", wrapper.UpdateHouseholdMember)
-	m.HandleFunc("PATCH "+options.BaseURL+"/household-members/{id}/activate", wrapper.ActivateHouseholdMember)
-	m.HandleFunc("PATCH "+options.BaseURL+"/household-members/{id}/deactivate", wrapper.DeactivateHouseholdMember)
-	m.HandleFunc("GET "+options.BaseURL+"/ingresses", wrapper.ListIngresses)
-	m.HandleFunc("POST "+options.BaseURL+"/ingresses", wrapper.CreateIngress)
-	m.HandleFunc("GET "+options.BaseURL+"/ingresses/{id}", wrapper.GetIngress)
-	m.HandleFunc("DELETE "+options.BaseURL+"/ingresses/{id}/recurrence-pattern/{pattern_id}", wrapper.DeleteIngressRecurrencePattern)
-	m.HandleFunc("GET "+options.BaseURL+"/ingresses/{id}/recurrence-pattern/{pattern_id}", wrapper.GetIngressRecurrencePattern)
-	m.HandleFunc("PUT "+options.BaseURL+"/ingresses/{id}/recurrence-pattern/{pattern_id}", wrapper.UpdateIngressRecurrencePattern)
-	m.HandleFunc("POST "+options.BaseURL+"/ingresses/{id}/rollback", wrapper.RollbackIngress)
-	m.HandleFunc("POST "+options.BaseURL+"/recurrence-pattern", wrapper.CreateIngressRecurrencePattern)
-	m.HandleFunc("GET "+options.BaseURL+"/savings", wrapper.ListSavingsGoals)
-	m.HandleFunc("POST "+options.BaseURL+"/savings", wrapper.CreateSavingsGoal)
-	m.HandleFunc("DELETE "+options.BaseURL+"/savings/{id}", wrapper.DeleteSavingsGoal)
-	m.HandleFunc("GET "+options.BaseURL+"/savings/{id}", wrapper.GetSavingsGoal)
-	m.HandleFunc("PUT "+options.BaseURL+"/savings/{id}", wrapper.UpdateSavingsGoal)
-	m.HandleFunc("GET "+options.BaseURL+"/savings/{id}/contributions", wrapper.ListSavingsContributions)
-	m.HandleFunc("POST "+options.BaseURL+"/savings/{id}/contributions", wrapper.AddSavingsContribution)
-	m.HandleFunc("GET "+options.BaseURL+"/savings/{id}/progress", wrapper.GetSavingsProgress)
-	m.HandleFunc("GET "+options.BaseURL+"/savings/{id}/transactions", wrapper.ListSavingsTransactions)
-	m.HandleFunc("GET "+options.BaseURL+"/savings/{id}/withdrawals", wrapper.ListSavingsWithdrawals)
-	m.HandleFunc("POST "+options.BaseURL+"/savings/{id}/withdrawals", wrapper.AddSavingsWithdrawal)
-	m.HandleFunc("GET "+options.BaseURL+"/tags", wrapper.ListTags)
-	m.HandleFunc("POST "+options.BaseURL+"/tags", wrapper.CreateTag)
-	m.HandleFunc("GET "+options.BaseURL+"/tags/type/{type}", wrapper.ListTagsByType)
-	m.HandleFunc("DELETE "+options.BaseURL+"/tags/{id}", wrapper.DeleteTag)
-	m.HandleFunc("GET "+options.BaseURL+"/transactions", wrapper.ListTransactions)
-	m.HandleFunc("GET "+options.BaseURL+"/transfers", wrapper.ListTransfers)
-	m.HandleFunc("POST "+options.BaseURL+"/transfers", wrapper.CreateTransfer)
-	m.HandleFunc("GET "+options.BaseURL+"/transfers/{id}", wrapper.GetTransfer)
-	m.HandleFunc("POST "+options.BaseURL+"/transfers/{id}/rollback", wrapper.RollbackTransfer)
+// SetBudgetPeriodAmount operation middleware
+func (siw *ServerInterfaceWrapper) SetBudgetPeriodAmount(w http.ResponseWriter, r *http.Request) {
 
-	return m
-}
+	var err error
 
-type N200Response struct {
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type N204Response struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type N400JSONResponse Error
+	// ------------- Path parameter "number" -------------
+	var number int
 
-type N401Response struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "number", r.PathValue("number"), &number, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "number", Err: err})
+		return
+	}
 
-type N404JSONResponse Error
+	ctx := r.Context()
 
-type N409JSONResponse Error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type N500JSONResponse Error
+	r = r.WithContext(ctx)
 
-type N501Response struct {
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetBudgetPeriodAmount(w, r, id, number)
+	}))
 
-type ListAccountsRequestObject struct {
-	Params ListAccountsParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListAccountsResponseObject interface {
-	VisitListAccountsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type ListAccounts200JSONResponse AccountList
+// GetBudgetStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetBudgetStatus(w http.ResponseWriter, r *http.Request) {
 
-func (response ListAccounts200JSONResponse) VisitListAccountsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type ListAccounts401Response = N401Response
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response ListAccounts401Response) VisitListAccountsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx := r.Context()
 
-type ListAccounts500JSONResponse struct{ N500JSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response ListAccounts500JSONResponse) VisitListAccountsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBudgetStatusParams
 
-type CreateAccountRequestObject struct {
-	Body *CreateAccountJSONRequestBody
-}
+	// ------------- Optional query parameter "date" -------------
 
-type CreateAccountResponseObject interface {
-	VisitCreateAccountResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
 
-type CreateAccount201JSONResponse Account
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBudgetStatus(w, r, id, params)
+	}))
 
-func (response CreateAccount201JSONResponse) VisitCreateAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateAccount400JSONResponse struct{ N400JSONResponse }
-
-func (response CreateAccount400JSONResponse) VisitCreateAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// ListCategories operation middleware
+func (siw *ServerInterfaceWrapper) ListCategories(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type CreateAccount401Response = N401Response
+	ctx := r.Context()
 
-func (response CreateAccount401Response) VisitCreateAccountResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type CreateAccount500JSONResponse struct{ N500JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response CreateAccount500JSONResponse) VisitCreateAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCategoriesParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "type" -------------
 
-type DeleteAccountRequestObject struct {
-	Id string `json:"id"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
 
-type DeleteAccountResponseObject interface {
-	VisitDeleteAccountResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "tree" -------------
 
-type DeleteAccount204Response = N204Response
+	err = runtime.BindQueryParameter("form", true, false, "tree", r.URL.Query(), &params.Tree)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tree", Err: err})
+		return
+	}
 
-func (response DeleteAccount204Response) VisitDeleteAccountResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCategories(w, r, params)
+	}))
 
-type DeleteAccount401Response = N401Response
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response DeleteAccount401Response) VisitDeleteAccountResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteAccount404JSONResponse struct{ N404JSONResponse }
+// CreateCategory operation middleware
+func (siw *ServerInterfaceWrapper) CreateCategory(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteAccount404JSONResponse) VisitDeleteAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeleteAccount409JSONResponse struct{ N409JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response DeleteAccount409JSONResponse) VisitDeleteAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCategory(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteAccount500JSONResponse struct{ N500JSONResponse }
+// DeleteCategory operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCategory(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteAccount500JSONResponse) VisitDeleteAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type GetAccountRequestObject struct {
-	Id string `json:"id"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetAccountResponseObject interface {
-	VisitGetAccountResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type GetAccount200JSONResponse Account
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetAccount200JSONResponse) VisitGetAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCategory(w, r, id)
+	}))
 
-type GetAccount401Response = N401Response
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response GetAccount401Response) VisitGetAccountResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type GetAccount404JSONResponse struct{ N404JSONResponse }
-
-func (response GetAccount404JSONResponse) VisitGetAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// UpdateCategory operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCategory(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetAccount500JSONResponse struct{ N500JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response GetAccount500JSONResponse) VisitGetAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type UpdateAccountRequestObject struct {
-	Id   string `json:"id"`
-	Body *UpdateAccountJSONRequestBody
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UpdateAccountResponseObject interface {
-	VisitUpdateAccountResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type UpdateAccount200JSONResponse Account
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCategory(w, r, id)
+	}))
 
-func (response UpdateAccount200JSONResponse) VisitUpdateAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateAccount400JSONResponse struct{ N400JSONResponse }
+// ActivateCategory operation middleware
+func (siw *ServerInterfaceWrapper) ActivateCategory(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateAccount400JSONResponse) VisitUpdateAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type UpdateAccount401Response = N401Response
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response UpdateAccount401Response) VisitUpdateAccountResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx := r.Context()
 
-type UpdateAccount404JSONResponse struct{ N404JSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response UpdateAccount404JSONResponse) VisitUpdateAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ActivateCategory(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateAccount500JSONResponse struct{ N500JSONResponse }
+// DeactivateCategory operation middleware
+func (siw *ServerInterfaceWrapper) DeactivateCategory(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateAccount500JSONResponse) VisitUpdateAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type ActivateAccountRequestObject struct {
-	Id string `json:"id"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type ActivateAccountResponseObject interface {
-	VisitActivateAccountResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type ActivateAccount200Response = N200Response
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response ActivateAccount200Response) VisitActivateAccountResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type ActivateAccount400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeactivateCategory(w, r, id)
+	}))
 
-func (response ActivateAccount400JSONResponse) VisitActivateAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ActivateAccount401Response = N401Response
+// MergeCategory operation middleware
+func (siw *ServerInterfaceWrapper) MergeCategory(w http.ResponseWriter, r *http.Request) {
 
-func (response ActivateAccount401Response) VisitActivateAccountResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type ActivateAccount404JSONResponse struct{ N404JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response ActivateAccount404JSONResponse) VisitActivateAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type ActivateAccount500JSONResponse struct{ N500JSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response ActivateAccount500JSONResponse) VisitActivateAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergeCategory(w, r, id)
+	}))
 
-type CanDeleteAccountRequestObject struct {
-	Id string `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CanDeleteAccountResponseObject interface {
-	VisitCanDeleteAccountResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type CanDeleteAccount200JSONResponse CanDelete
+// ListCategorizationRules operation middleware
+func (siw *ServerInterfaceWrapper) ListCategorizationRules(w http.ResponseWriter, r *http.Request) {
 
-func (response CanDeleteAccount200JSONResponse) VisitCanDeleteAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type CanDeleteAccount400JSONResponse struct{ N400JSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response CanDeleteAccount400JSONResponse) VisitCanDeleteAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCategorizationRulesParams
 
-type CanDeleteAccount401Response = N401Response
+	// ------------- Optional query parameter "active" -------------
 
-func (response CanDeleteAccount401Response) VisitCanDeleteAccountResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
+		return
+	}
 
-type CanDeleteAccount404JSONResponse struct{ N404JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCategorizationRules(w, r, params)
+	}))
 
-func (response CanDeleteAccount404JSONResponse) VisitCanDeleteAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CanDeleteAccount500JSONResponse struct{ N500JSONResponse }
+// CreateCategorizationRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateCategorizationRule(w http.ResponseWriter, r *http.Request) {
 
-func (response CanDeleteAccount500JSONResponse) VisitCanDeleteAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeactivateAccountRequestObject struct {
-	Id string `json:"id"`
-}
+	r = r.WithContext(ctx)
 
-type DeactivateAccountResponseObject interface {
-	VisitDeactivateAccountResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCategorizationRule(w, r)
+	}))
 
-type DeactivateAccount204Response = N204Response
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response DeactivateAccount204Response) VisitDeactivateAccountResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type DeactivateAccount400JSONResponse struct{ N400JSONResponse }
+// ApplyCategorizationRules operation middleware
+func (siw *ServerInterfaceWrapper) ApplyCategorizationRules(w http.ResponseWriter, r *http.Request) {
 
-func (response DeactivateAccount400JSONResponse) VisitDeactivateAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeactivateAccount401Response = N401Response
+	r = r.WithContext(ctx)
 
-func (response DeactivateAccount401Response) VisitDeactivateAccountResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyCategorizationRules(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type DeactivateAccount404JSONResponse struct{ N404JSONResponse }
+// PreviewCategorizationRule operation middleware
+func (siw *ServerInterfaceWrapper) PreviewCategorizationRule(w http.ResponseWriter, r *http.Request) {
 
-func (response DeactivateAccount404JSONResponse) VisitDeactivateAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeactivateAccount500JSONResponse struct{ N500JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response DeactivateAccount500JSONResponse) VisitDeactivateAccountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewCategorizationRule(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type LoginRequestObject struct {
-	Body *LoginJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type LoginResponseObject interface {
-	VisitLoginResponse(w http.ResponseWriter) error
-}
+// DeleteCategorizationRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCategorizationRule(w http.ResponseWriter, r *http.Request) {
 
-type Login200JSONResponse LoginResponse
+	var err error
 
-func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type Login401JSONResponse LoginResponse
+	ctx := r.Context()
 
-func (response Login401JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type RefreshTokenRequestObject struct {
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCategorizationRule(w, r, id)
+	}))
 
-type RefreshTokenResponseObject interface {
-	VisitRefreshTokenResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type RefreshToken200JSONResponse LoginResponse
+// GetCategorizationRule operation middleware
+func (siw *ServerInterfaceWrapper) GetCategorizationRule(w http.ResponseWriter, r *http.Request) {
 
-func (response RefreshToken200JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type RefreshToken401JSONResponse LoginResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response RefreshToken401JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RegisterUserRequestObject struct {
-	Body *RegisterUserJSONRequestBody
-}
+	r = r.WithContext(ctx)
 
-type RegisterUserResponseObject interface {
-	VisitRegisterUserResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCategorizationRule(w, r, id)
+	}))
 
-type RegisterUser201JSONResponse struct {
-	// ExpiresAt Token expiration time
-	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// Token JWT authentication token
-	Token *string `json:"token,omitempty"`
-	User  *User   `json:"user,omitempty"`
+	handler.ServeHTTP(w, r)
 }
 
-func (response RegisterUser201JSONResponse) VisitRegisterUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// UpdateCategorizationRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCategorizationRule(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type RegisterUser400JSONResponse Error
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response RegisterUser400JSONResponse) VisitRegisterUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type RegisterUser500JSONResponse Error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response RegisterUser500JSONResponse) VisitRegisterUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCategorizationRule(w, r, id)
+	}))
 
-type GetBalancesRequestObject struct {
-	Params GetBalancesParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetBalancesResponseObject interface {
-	VisitGetBalancesResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetBalances200JSONResponse BalanceSummary
+// SuggestCategory operation middleware
+func (siw *ServerInterfaceWrapper) SuggestCategory(w http.ResponseWriter, r *http.Request) {
 
-func (response GetBalances200JSONResponse) VisitGetBalancesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetBalances401Response = N401Response
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetBalances401Response) VisitGetBalancesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type GetBalances500JSONResponse struct{ N500JSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SuggestCategoryParams
 
-func (response GetBalances500JSONResponse) VisitGetBalancesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Required query parameter "description" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if paramValue := r.URL.Query().Get("description"); paramValue != "" {
 
-type GetBalances501Response = N501Response
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "description"})
+		return
+	}
 
-func (response GetBalances501Response) VisitGetBalancesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(501)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, true, "description", r.URL.Query(), &params.Description)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "description", Err: err})
+		return
+	}
 
-type ListCategoriesRequestObject struct {
-	Params ListCategoriesParams
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type ListCategoriesResponseObject interface {
-	VisitListCategoriesResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type ListCategories200JSONResponse struct {
-	Categories *[]Category `json:"categories,omitempty"`
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SuggestCategory(w, r, params)
+	}))
 
-	// Total Total number of categories
-	Total *int `json:"total,omitempty"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response ListCategories200JSONResponse) VisitListCategoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// RetrainCategorySuggestions operation middleware
+func (siw *ServerInterfaceWrapper) RetrainCategorySuggestions(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type ListCategories401Response = N401Response
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response ListCategories401Response) VisitListCategoriesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type ListCategories500JSONResponse struct{ N500JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetrainCategorySuggestions(w, r)
+	}))
 
-func (response ListCategories500JSONResponse) VisitListCategoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateCategoryRequestObject struct {
-	Body *CreateCategoryJSONRequestBody
-}
+// GetExchangeRates operation middleware
+func (siw *ServerInterfaceWrapper) GetExchangeRates(w http.ResponseWriter, r *http.Request) {
 
-type CreateCategoryResponseObject interface {
-	VisitCreateCategoryResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type CreateCategory201JSONResponse Category
+	ctx := r.Context()
 
-func (response CreateCategory201JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type CreateCategory400JSONResponse struct{ N400JSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExchangeRatesParams
 
-func (response CreateCategory400JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Required query parameter "baseCurrency" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if paramValue := r.URL.Query().Get("baseCurrency"); paramValue != "" {
 
-type CreateCategory401Response = N401Response
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "baseCurrency"})
+		return
+	}
 
-func (response CreateCategory401Response) VisitCreateCategoryResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, true, "baseCurrency", r.URL.Query(), &params.BaseCurrency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "baseCurrency", Err: err})
+		return
+	}
 
-type CreateCategory500JSONResponse struct{ N500JSONResponse }
+	// ------------- Optional query parameter "targetCurrencies" -------------
 
-func (response CreateCategory500JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, false, "targetCurrencies", r.URL.Query(), &params.TargetCurrencies)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "targetCurrencies", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExchangeRates(w, r, params)
+	}))
 
-type DeleteCategoryRequestObject struct {
-	Id string `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteCategoryResponseObject interface {
-	VisitDeleteCategoryResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteCategory204Response = N204Response
-
-func (response DeleteCategory204Response) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// ListExpenditures operation middleware
+func (siw *ServerInterfaceWrapper) ListExpenditures(w http.ResponseWriter, r *http.Request) {
 
-type DeleteCategory400JSONResponse struct{ N400JSONResponse }
+	var err error
 
-func (response DeleteCategory400JSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeleteCategory401Response = N401Response
+	r = r.WithContext(ctx)
 
-func (response DeleteCategory401Response) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListExpendituresParams
 
-type DeleteCategory404JSONResponse struct{ N404JSONResponse }
+	// ------------- Optional query parameter "categoryId" -------------
 
-func (response DeleteCategory404JSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "categoryId", r.URL.Query(), &params.CategoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "categoryId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "tag" -------------
 
-type DeleteCategory500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
 
-func (response DeleteCategory500JSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "startDate" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
 
-type UpdateCategoryRequestObject struct {
-	Id   string `json:"id"`
-	Body *UpdateCategoryJSONRequestBody
-}
+	// ------------- Optional query parameter "endDate" -------------
 
-type UpdateCategoryResponseObject interface {
-	VisitUpdateCategoryResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
 
-type UpdateCategory200JSONResponse Category
+	// ------------- Optional query parameter "declared" -------------
 
-func (response UpdateCategory200JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "declared", r.URL.Query(), &params.Declared)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "declared", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "planned" -------------
 
-type UpdateCategory400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "planned", r.URL.Query(), &params.Planned)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "planned", Err: err})
+		return
+	}
 
-func (response UpdateCategory400JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "currency" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
 
-type UpdateCategory404JSONResponse struct{ N404JSONResponse }
+	// ------------- Optional query parameter "description" -------------
 
-func (response UpdateCategory404JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "description", r.URL.Query(), &params.Description)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "description", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "accountId" -------------
 
-type UpdateCategory500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "accountId", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
+		return
+	}
 
-func (response UpdateCategory500JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "tags" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
 
-type ActivateCategoryRequestObject struct {
-	Id string `json:"id"`
-}
+	// ------------- Optional query parameter "status" -------------
 
-type ActivateCategoryResponseObject interface {
-	VisitActivateCategoryResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
 
-type ActivateCategory204Response = N204Response
+	// ------------- Optional query parameter "recurrencePatternId" -------------
 
-func (response ActivateCategory204Response) VisitActivateCategoryResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "recurrencePatternId", r.URL.Query(), &params.RecurrencePatternId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recurrencePatternId", Err: err})
+		return
+	}
 
-type ActivateCategory400JSONResponse struct{ N400JSONResponse }
+	// ------------- Optional query parameter "payeeId" -------------
 
-func (response ActivateCategory400JSONResponse) VisitActivateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "payeeId", r.URL.Query(), &params.PayeeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "payeeId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "sort" -------------
 
-type ActivateCategory401Response = N401Response
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
 
-func (response ActivateCategory401Response) VisitActivateCategoryResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type ActivateCategory404JSONResponse struct{ N404JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response ActivateCategory404JSONResponse) VisitActivateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Optional query parameter "offset" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-type ActivateCategory500JSONResponse struct{ N500JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListExpenditures(w, r, params)
+	}))
 
-func (response ActivateCategory500JSONResponse) VisitActivateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeactivateCategoryRequestObject struct {
-	Id string `json:"id"`
-}
+// CreateExpenditure operation middleware
+func (siw *ServerInterfaceWrapper) CreateExpenditure(w http.ResponseWriter, r *http.Request) {
 
-type DeactivateCategoryResponseObject interface {
-	VisitDeactivateCategoryResponse(w http.ResponseWriter) error
-}
-
-type DeactivateCategory204Response = N204Response
+	var err error
 
-func (response DeactivateCategory204Response) VisitDeactivateCategoryResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	ctx := r.Context()
 
-type DeactivateCategory400JSONResponse struct{ N400JSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response DeactivateCategory400JSONResponse) VisitDeactivateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateExpenditureParams
 
-type DeactivateCategory401Response = N401Response
+	// ------------- Optional query parameter "force" -------------
 
-func (response DeactivateCategory401Response) VisitDeactivateCategoryResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
 
-type DeactivateCategory404JSONResponse struct{ N404JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateExpenditure(w, r, params)
+	}))
 
-func (response DeactivateCategory404JSONResponse) VisitDeactivateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeactivateCategory500JSONResponse struct{ N500JSONResponse }
+// CreateExpenditureBatch operation middleware
+func (siw *ServerInterfaceWrapper) CreateExpenditureBatch(w http.ResponseWriter, r *http.Request) {
 
-func (response DeactivateCategory500JSONResponse) VisitDeactivateCategoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetExchangeRatesRequestObject struct {
-	Params GetExchangeRatesParams
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetExchangeRatesResponseObject interface {
-	VisitGetExchangeRatesResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GetExchangeRates200JSONResponse ExchangeRates
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateExpenditureBatchParams
 
-func (response GetExchangeRates200JSONResponse) VisitGetExchangeRatesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "force" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
 
-type GetExchangeRates400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateExpenditureBatch(w, r, params)
+	}))
 
-func (response GetExchangeRates400JSONResponse) VisitGetExchangeRatesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetExchangeRates401Response = N401Response
+// GetExpenditure operation middleware
+func (siw *ServerInterfaceWrapper) GetExpenditure(w http.ResponseWriter, r *http.Request) {
 
-func (response GetExchangeRates401Response) VisitGetExchangeRatesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type GetExchangeRates500JSONResponse struct{ N500JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response GetExchangeRates500JSONResponse) VisitGetExchangeRatesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type ListExpendituresRequestObject struct {
-	Params ListExpendituresParams
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ListExpendituresResponseObject interface {
-	VisitListExpendituresResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type ListExpenditures200JSONResponse ExpenditureList
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExpenditure(w, r, id)
+	}))
 
-func (response ListExpenditures200JSONResponse) VisitListExpendituresResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ListExpenditures401Response = N401Response
+// ListExpenditureRefunds operation middleware
+func (siw *ServerInterfaceWrapper) ListExpenditureRefunds(w http.ResponseWriter, r *http.Request) {
 
-func (response ListExpenditures401Response) VisitListExpendituresResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type ListExpenditures500JSONResponse struct{ N500JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response ListExpenditures500JSONResponse) VisitListExpendituresResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type CreateExpenditureRequestObject struct {
-	Body *CreateExpenditureJSONRequestBody
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type CreateExpenditureResponseObject interface {
-	VisitCreateExpenditureResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type CreateExpenditure201JSONResponse Expenditure
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListExpenditureRefunds(w, r, id)
+	}))
 
-func (response CreateExpenditure201JSONResponse) VisitCreateExpenditureResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateExpenditure400JSONResponse struct{ N400JSONResponse }
-
-func (response CreateExpenditure400JSONResponse) VisitCreateExpenditureResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// RefundExpenditure operation middleware
+func (siw *ServerInterfaceWrapper) RefundExpenditure(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type CreateExpenditure401Response = N401Response
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response CreateExpenditure401Response) VisitCreateExpenditureResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type CreateExpenditure409JSONResponse struct{ N409JSONResponse }
+	ctx := r.Context()
 
-func (response CreateExpenditure409JSONResponse) VisitCreateExpenditureResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type CreateExpenditure500JSONResponse struct{ N500JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RefundExpenditure(w, r, id)
+	}))
 
-func (response CreateExpenditure500JSONResponse) VisitCreateExpenditureResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetExpenditureRequestObject struct {
-	Id string `json:"id"`
-}
+// RollbackExpenditure operation middleware
+func (siw *ServerInterfaceWrapper) RollbackExpenditure(w http.ResponseWriter, r *http.Request) {
 
-type GetExpenditureResponseObject interface {
-	VisitGetExpenditureResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetExpenditure200JSONResponse Expenditure
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response GetExpenditure200JSONResponse) VisitGetExpenditureResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetExpenditure401Response = N401Response
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetExpenditure401Response) VisitGetExpenditureResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type GetExpenditure404JSONResponse struct{ N404JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RollbackExpenditure(w, r, id)
+	}))
 
-func (response GetExpenditure404JSONResponse) VisitGetExpenditureResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetExpenditure500JSONResponse struct{ N500JSONResponse }
+// ListHouseholdMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListHouseholdMembers(w http.ResponseWriter, r *http.Request) {
 
-func (response GetExpenditure500JSONResponse) VisitGetExpenditureResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type RollbackExpenditureRequestObject struct {
-	Id   string `json:"id"`
-	Body *RollbackExpenditureJSONRequestBody
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RollbackExpenditureResponseObject interface {
-	VisitRollbackExpenditureResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type RollbackExpenditure201Response struct {
-}
-
-func (response RollbackExpenditure201Response) VisitRollbackExpenditureResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListHouseholdMembersParams
 
-type RollbackExpenditure404Response struct {
-}
+	// ------------- Optional query parameter "active" -------------
 
-func (response RollbackExpenditure404Response) VisitRollbackExpenditureResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
+		return
+	}
 
-type RollbackExpenditure409Response struct {
-}
+	// ------------- Optional query parameter "role" -------------
 
-func (response RollbackExpenditure409Response) VisitRollbackExpenditureResponse(w http.ResponseWriter) error {
-	w.WriteHeader(409)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "role", r.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		return
+	}
 
-type ListHouseholdMembersRequestObject struct {
-	Params ListHouseholdMembersParams
-}
+	// ------------- Optional query parameter "sort" -------------
 
-type ListHouseholdMembersResponseObject interface {
-	VisitListHouseholdMembersResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
 
-type ListHouseholdMembers200JSONResponse HouseholdMemberList
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListHouseholdMembers(w, r, params)
+	}))
 
-func (response ListHouseholdMembers200JSONResponse) VisitListHouseholdMembersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ListHouseholdMembers401Response = N401Response
+// CreateHouseholdMember operation middleware
+func (siw *ServerInterfaceWrapper) CreateHouseholdMember(w http.ResponseWriter, r *http.Request) {
 
-func (response ListHouseholdMembers401Response) VisitListHouseholdMembersResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx := r.Context()
 
-type ListHouseholdMembers500JSONResponse struct{ N500JSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response ListHouseholdMembers500JSONResponse) VisitListHouseholdMembersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateHouseholdMember(w, r)
+	}))
 
-type CreateHouseholdMemberRequestObject struct {
-	Body *CreateHouseholdMemberJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateHouseholdMemberResponseObject interface {
-	VisitCreateHouseholdMemberResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type CreateHouseholdMember201JSONResponse HouseholdMember
-
-func (response CreateHouseholdMember201JSONResponse) VisitCreateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// DeleteHouseholdMember operation middleware
+func (siw *ServerInterfaceWrapper) DeleteHouseholdMember(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type CreateHouseholdMember400JSONResponse struct{ N400JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response CreateHouseholdMember400JSONResponse) VisitCreateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type CreateHouseholdMember401Response = N401Response
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response CreateHouseholdMember401Response) VisitCreateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type CreateHouseholdMember500JSONResponse struct{ N500JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteHouseholdMember(w, r, id)
+	}))
 
-func (response CreateHouseholdMember500JSONResponse) VisitCreateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteHouseholdMemberRequestObject struct {
-	Id string `json:"id"`
-}
+// GetHouseholdMember operation middleware
+func (siw *ServerInterfaceWrapper) GetHouseholdMember(w http.ResponseWriter, r *http.Request) {
 
-type DeleteHouseholdMemberResponseObject interface {
-	VisitDeleteHouseholdMemberResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type DeleteHouseholdMember204Response = N204Response
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response DeleteHouseholdMember204Response) VisitDeleteHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type DeleteHouseholdMember400JSONResponse struct{ N400JSONResponse }
+	ctx := r.Context()
 
-func (response DeleteHouseholdMember400JSONResponse) VisitDeleteHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type DeleteHouseholdMember401Response = N401Response
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHouseholdMember(w, r, id)
+	}))
 
-func (response DeleteHouseholdMember401Response) VisitDeleteHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteHouseholdMember404JSONResponse struct{ N404JSONResponse }
+	handler.ServeHTTP(w, r)
+}
 
-func (response DeleteHouseholdMember404JSONResponse) VisitDeleteHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// UpdateHouseholdMember operation middleware
+func (siw *ServerInterfaceWrapper) UpdateHouseholdMember(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type DeleteHouseholdMember500JSONResponse struct{ N500JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response DeleteHouseholdMember500JSONResponse) VisitDeleteHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetHouseholdMemberRequestObject struct {
-	Id string `json:"id"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetHouseholdMemberResponseObject interface {
-	VisitGetHouseholdMemberResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GetHouseholdMember200JSONResponse HouseholdMember
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateHouseholdMember(w, r, id)
+	}))
 
-func (response GetHouseholdMember200JSONResponse) VisitGetHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetHouseholdMember401Response = N401Response
+// ActivateHouseholdMember operation middleware
+func (siw *ServerInterfaceWrapper) ActivateHouseholdMember(w http.ResponseWriter, r *http.Request) {
 
-func (response GetHouseholdMember401Response) VisitGetHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type GetHouseholdMember404JSONResponse struct{ N404JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response GetHouseholdMember404JSONResponse) VisitGetHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetHouseholdMember500JSONResponse struct{ N500JSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetHouseholdMember500JSONResponse) VisitGetHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ActivateHouseholdMember(w, r, id)
+	}))
 
-type UpdateHouseholdMemberRequestObject struct {
-	Id   string `json:"id"`
-	Body *UpdateHouseholdMemberJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateHouseholdMemberResponseObject interface {
-	VisitUpdateHouseholdMemberResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateHouseholdMember200JSONResponse HouseholdMember
-
-func (response UpdateHouseholdMember200JSONResponse) VisitUpdateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// DeactivateHouseholdMember operation middleware
+func (siw *ServerInterfaceWrapper) DeactivateHouseholdMember(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type UpdateHouseholdMember400JSONResponse struct{ N400JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response UpdateHouseholdMember400JSONResponse) VisitUpdateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type UpdateHouseholdMember401Response = N401Response
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response UpdateHouseholdMember401Response) VisitUpdateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type UpdateHouseholdMember404JSONResponse struct{ N404JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeactivateHouseholdMember(w, r, id)
+	}))
 
-func (response UpdateHouseholdMember404JSONResponse) VisitUpdateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateHouseholdMember500JSONResponse struct{ N500JSONResponse }
+// CreateHousehold operation middleware
+func (siw *ServerInterfaceWrapper) CreateHousehold(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateHouseholdMember500JSONResponse) VisitUpdateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ActivateHouseholdMemberRequestObject struct {
-	Id string `json:"id"`
-}
+	r = r.WithContext(ctx)
 
-type ActivateHouseholdMemberResponseObject interface {
-	VisitActivateHouseholdMemberResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateHousehold(w, r)
+	}))
 
-type ActivateHouseholdMember204Response = N204Response
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response ActivateHouseholdMember204Response) VisitActivateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type ActivateHouseholdMember400JSONResponse struct{ N400JSONResponse }
-
-func (response ActivateHouseholdMember400JSONResponse) VisitActivateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetCurrentHousehold operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentHousehold(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type ActivateHouseholdMember401Response = N401Response
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response ActivateHouseholdMember401Response) VisitActivateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type ActivateHouseholdMember404JSONResponse struct{ N404JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentHousehold(w, r)
+	}))
 
-func (response ActivateHouseholdMember404JSONResponse) VisitActivateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ActivateHouseholdMember500JSONResponse struct{ N500JSONResponse }
-
-func (response ActivateHouseholdMember500JSONResponse) VisitActivateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// ListIngresses operation middleware
+func (siw *ServerInterfaceWrapper) ListIngresses(w http.ResponseWriter, r *http.Request) {
 
-type DeactivateHouseholdMemberRequestObject struct {
-	Id string `json:"id"`
-}
+	var err error
 
-type DeactivateHouseholdMemberResponseObject interface {
-	VisitDeactivateHouseholdMemberResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type DeactivateHouseholdMember204Response = N204Response
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response DeactivateHouseholdMember204Response) VisitDeactivateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type DeactivateHouseholdMember400JSONResponse struct{ N400JSONResponse }
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListIngressesParams
 
-func (response DeactivateHouseholdMember400JSONResponse) VisitDeactivateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "category" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
+	}
 
-type DeactivateHouseholdMember401Response = N401Response
+	// ------------- Optional query parameter "source" -------------
 
-func (response DeactivateHouseholdMember401Response) VisitDeactivateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "source", r.URL.Query(), &params.Source)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
+		return
+	}
 
-type DeactivateHouseholdMember404JSONResponse struct{ N404JSONResponse }
+	// ------------- Optional query parameter "tags" -------------
 
-func (response DeactivateHouseholdMember404JSONResponse) VisitDeactivateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "tags", r.URL.Query(), &params.Tags)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tags", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "startDate" -------------
 
-type DeactivateHouseholdMember500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
 
-func (response DeactivateHouseholdMember500JSONResponse) VisitDeactivateHouseholdMemberResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "endDate" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
 
-type ListIngressesRequestObject struct {
-	Params ListIngressesParams
-}
+	// ------------- Optional query parameter "isRecurring" -------------
 
-type ListIngressesResponseObject interface {
-	VisitListIngressesResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "isRecurring", r.URL.Query(), &params.IsRecurring)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "isRecurring", Err: err})
+		return
+	}
 
-type ListIngresses200JSONResponse []IngressList
+	// ------------- Optional query parameter "currency" -------------
 
-func (response ListIngresses200JSONResponse) VisitListIngressesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "limit" -------------
 
-type ListIngresses401Response = N401Response
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-func (response ListIngresses401Response) VisitListIngressesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	// ------------- Optional query parameter "offset" -------------
 
-type ListIngresses500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-func (response ListIngresses500JSONResponse) VisitListIngressesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListIngresses(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateIngressRequestObject struct {
-	Body *CreateIngressJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type CreateIngressResponseObject interface {
-	VisitCreateIngressResponse(w http.ResponseWriter) error
-}
+// CreateIngress operation middleware
+func (siw *ServerInterfaceWrapper) CreateIngress(w http.ResponseWriter, r *http.Request) {
 
-type CreateIngress201JSONResponse Ingress
+	ctx := r.Context()
 
-func (response CreateIngress201JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type CreateIngress400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateIngress(w, r)
+	}))
 
-func (response CreateIngress400JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateIngress401Response = N401Response
-
-func (response CreateIngress401Response) VisitCreateIngressResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
-
-type CreateIngress500JSONResponse struct{ N500JSONResponse }
+// GetIngress operation middleware
+func (siw *ServerInterfaceWrapper) GetIngress(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateIngress500JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type GetIngressRequestObject struct {
-	Id string `json:"id"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetIngressResponseObject interface {
-	VisitGetIngressResponse(w http.ResponseWriter) error
-}
+	ctx := r.Context()
 
-type GetIngress200JSONResponse Ingress
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetIngress200JSONResponse) VisitGetIngressResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIngress(w, r, id)
+	}))
 
-type GetIngress401Response = N401Response
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response GetIngress401Response) VisitGetIngressResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type GetIngress404JSONResponse struct{ N404JSONResponse }
+// DeleteIngressRecurrencePattern operation middleware
+func (siw *ServerInterfaceWrapper) DeleteIngressRecurrencePattern(w http.ResponseWriter, r *http.Request) {
 
-func (response GetIngress404JSONResponse) VisitGetIngressResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type GetIngress500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetIngress500JSONResponse) VisitGetIngressResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "pattern_id" -------------
+	var patternId string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "pattern_id", r.PathValue("pattern_id"), &patternId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pattern_id", Err: err})
+		return
+	}
 
-type DeleteIngressRecurrencePatternRequestObject struct {
-	Id        string `json:"id"`
-	PatternId string `json:"pattern_id"`
-}
+	ctx := r.Context()
 
-type DeleteIngressRecurrencePatternResponseObject interface {
-	VisitDeleteIngressRecurrencePatternResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeleteIngressRecurrencePattern204Response = N204Response
+	r = r.WithContext(ctx)
 
-func (response DeleteIngressRecurrencePattern204Response) VisitDeleteIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteIngressRecurrencePattern(w, r, id, patternId)
+	}))
 
-type DeleteIngressRecurrencePattern401Response = N401Response
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (response DeleteIngressRecurrencePattern401Response) VisitDeleteIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteIngressRecurrencePattern404JSONResponse struct{ N404JSONResponse }
+// GetIngressRecurrencePattern operation middleware
+func (siw *ServerInterfaceWrapper) GetIngressRecurrencePattern(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteIngressRecurrencePattern404JSONResponse) VisitDeleteIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type DeleteIngressRecurrencePattern500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response DeleteIngressRecurrencePattern500JSONResponse) VisitDeleteIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Path parameter "pattern_id" -------------
+	var patternId string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "pattern_id", r.PathValue("pattern_id"), &patternId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pattern_id", Err: err})
+		return
+	}
 
-type GetIngressRecurrencePatternRequestObject struct {
-	Id        string `json:"id"`
-	PatternId string `json:"pattern_id"`
-}
+	ctx := r.Context()
 
-type GetIngressRecurrencePatternResponseObject interface {
-	VisitGetIngressRecurrencePatternResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetIngressRecurrencePattern200JSONResponse struct {
-	Data *RecurrencePattern `json:"data,omitempty"`
-}
+	r = r.WithContext(ctx)
 
-func (response GetIngressRecurrencePattern200JSONResponse) VisitGetIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIngressRecurrencePattern(w, r, id, patternId)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetIngressRecurrencePattern401Response = N401Response
+// UpdateIngressRecurrencePattern operation middleware
+func (siw *ServerInterfaceWrapper) UpdateIngressRecurrencePattern(w http.ResponseWriter, r *http.Request) {
 
-func (response GetIngressRecurrencePattern401Response) VisitGetIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type GetIngressRecurrencePattern404JSONResponse struct{ N404JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response GetIngressRecurrencePattern404JSONResponse) VisitGetIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "pattern_id" -------------
+	var patternId string
 
-type GetIngressRecurrencePattern500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "pattern_id", r.PathValue("pattern_id"), &patternId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pattern_id", Err: err})
+		return
+	}
 
-func (response GetIngressRecurrencePattern500JSONResponse) VisitGetIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UpdateIngressRecurrencePatternRequestObject struct {
-	Id        string `json:"id"`
-	PatternId string `json:"pattern_id"`
-	Body      *UpdateIngressRecurrencePatternJSONRequestBody
-}
+	r = r.WithContext(ctx)
 
-type UpdateIngressRecurrencePatternResponseObject interface {
-	VisitUpdateIngressRecurrencePatternResponse(w http.ResponseWriter) error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateIngressRecurrencePattern(w, r, id, patternId)
+	}))
 
-type UpdateIngressRecurrencePattern200JSONResponse struct {
-	Data *RecurrencePattern `json:"data,omitempty"`
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response UpdateIngressRecurrencePattern200JSONResponse) VisitUpdateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// RollbackIngress operation middleware
+func (siw *ServerInterfaceWrapper) RollbackIngress(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type UpdateIngressRecurrencePattern400JSONResponse struct{ N400JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response UpdateIngressRecurrencePattern400JSONResponse) VisitUpdateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type UpdateIngressRecurrencePattern401Response = N401Response
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response UpdateIngressRecurrencePattern401Response) VisitUpdateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type UpdateIngressRecurrencePattern404JSONResponse struct{ N404JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RollbackIngress(w, r, id)
+	}))
 
-func (response UpdateIngressRecurrencePattern404JSONResponse) VisitUpdateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateIngressRecurrencePattern500JSONResponse struct{ N500JSONResponse }
+// ListInstallmentObligations operation middleware
+func (siw *ServerInterfaceWrapper) ListInstallmentObligations(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateIngressRecurrencePattern500JSONResponse) VisitUpdateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type RollbackIngressRequestObject struct {
-	Id   string `json:"id"`
-	Body *RollbackIngressJSONRequestBody
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type RollbackIngressResponseObject interface {
-	VisitRollbackIngressResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type RollbackIngress201Response struct {
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListInstallmentObligationsParams
 
-func (response RollbackIngress201Response) VisitRollbackIngressResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
-}
+	// ------------- Optional query parameter "accountId" -------------
 
-type RollbackIngress404Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "accountId", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
+		return
+	}
 
-func (response RollbackIngress404Response) VisitRollbackIngressResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	// ------------- Optional query parameter "householdMemberId" -------------
 
-type RollbackIngress409Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "householdMemberId", r.URL.Query(), &params.HouseholdMemberId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "householdMemberId", Err: err})
+		return
+	}
 
-func (response RollbackIngress409Response) VisitRollbackIngressResponse(w http.ResponseWriter) error {
-	w.WriteHeader(409)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListInstallmentObligations(w, r, params)
+	}))
 
-type CreateIngressRecurrencePatternRequestObject struct {
-	Body *CreateIngressRecurrencePatternJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateIngressRecurrencePatternResponseObject interface {
-	VisitCreateIngressRecurrencePatternResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type CreateIngressRecurrencePattern201JSONResponse struct {
-	Data *RecurrencePattern `json:"data,omitempty"`
-}
+// ListInstallmentPlans operation middleware
+func (siw *ServerInterfaceWrapper) ListInstallmentPlans(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateIngressRecurrencePattern201JSONResponse) VisitCreateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type CreateIngressRecurrencePattern400JSONResponse struct{ N400JSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response CreateIngressRecurrencePattern400JSONResponse) VisitCreateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListInstallmentPlansParams
 
-type CreateIngressRecurrencePattern401Response = N401Response
+	// ------------- Optional query parameter "accountId" -------------
 
-func (response CreateIngressRecurrencePattern401Response) VisitCreateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "accountId", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
+		return
+	}
 
-type CreateIngressRecurrencePattern404JSONResponse struct{ N404JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListInstallmentPlans(w, r, params)
+	}))
 
-func (response CreateIngressRecurrencePattern404JSONResponse) VisitCreateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateIngressRecurrencePattern500JSONResponse struct{ N500JSONResponse }
-
-func (response CreateIngressRecurrencePattern500JSONResponse) VisitCreateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// CreateInstallmentPlan operation middleware
+func (siw *ServerInterfaceWrapper) CreateInstallmentPlan(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type ListSavingsGoalsRequestObject struct {
-	Params ListSavingsGoalsParams
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ListSavingsGoalsResponseObject interface {
-	VisitListSavingsGoalsResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type ListSavingsGoals200JSONResponse []SavingsGoalList
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateInstallmentPlan(w, r)
+	}))
 
-func (response ListSavingsGoals200JSONResponse) VisitListSavingsGoalsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ListSavingsGoals401Response = N401Response
+// GetInstallmentPlan operation middleware
+func (siw *ServerInterfaceWrapper) GetInstallmentPlan(w http.ResponseWriter, r *http.Request) {
 
-func (response ListSavingsGoals401Response) VisitListSavingsGoalsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type ListSavingsGoals500JSONResponse struct{ N500JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response ListSavingsGoals500JSONResponse) VisitListSavingsGoalsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type CreateSavingsGoalRequestObject struct {
-	Body *CreateSavingsGoalJSONRequestBody
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type CreateSavingsGoalResponseObject interface {
-	VisitCreateSavingsGoalResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type CreateSavingsGoal201JSONResponse SavingsGoal
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInstallmentPlan(w, r, id)
+	}))
 
-func (response CreateSavingsGoal201JSONResponse) VisitCreateSavingsGoalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateSavingsGoal400JSONResponse struct{ N400JSONResponse }
+// PayOffInstallmentPlan operation middleware
+func (siw *ServerInterfaceWrapper) PayOffInstallmentPlan(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateSavingsGoal400JSONResponse) VisitCreateSavingsGoalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type CreateSavingsGoal401Response = N401Response
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response CreateSavingsGoal401Response) VisitCreateSavingsGoalResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx := r.Context()
 
-type CreateSavingsGoal500JSONResponse struct{ N500JSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response CreateSavingsGoal500JSONResponse) VisitCreateSavingsGoalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PayOffInstallmentPlan(w, r, id)
+	}))
 
-type DeleteSavingsGoalRequestObject struct {
-	Id string `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteSavingsGoalResponseObject interface {
-	VisitDeleteSavingsGoalResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteSavingsGoal204Response = N204Response
+// CreateInvitation operation middleware
+func (siw *ServerInterfaceWrapper) CreateInvitation(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteSavingsGoal204Response) VisitDeleteSavingsGoalResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	ctx := r.Context()
 
-type DeleteSavingsGoal401Response = N401Response
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response DeleteSavingsGoal401Response) VisitDeleteSavingsGoalResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type DeleteSavingsGoal404JSONResponse struct{ N404JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateInvitation(w, r)
+	}))
 
-func (response DeleteSavingsGoal404JSONResponse) VisitDeleteSavingsGoalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteSavingsGoal500JSONResponse struct{ N500JSONResponse }
-
-func (response DeleteSavingsGoal500JSONResponse) VisitDeleteSavingsGoalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// AcceptInvitation operation middleware
+func (siw *ServerInterfaceWrapper) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptInvitation(w, r)
+	}))
 
-type GetSavingsGoalRequestObject struct {
-	Id string `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetSavingsGoalResponseObject interface {
-	VisitGetSavingsGoalResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetSavingsGoal200JSONResponse SavingsGoal
+// JoinHousehold operation middleware
+func (siw *ServerInterfaceWrapper) JoinHousehold(w http.ResponseWriter, r *http.Request) {
 
-func (response GetSavingsGoal200JSONResponse) VisitGetSavingsGoalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetSavingsGoal401Response = N401Response
+	r = r.WithContext(ctx)
 
-func (response GetSavingsGoal401Response) VisitGetSavingsGoalResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.JoinHousehold(w, r)
+	}))
 
-type GetSavingsGoal404JSONResponse struct{ N404JSONResponse }
-
-func (response GetSavingsGoal404JSONResponse) VisitGetSavingsGoalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetSavingsGoal500JSONResponse struct{ N500JSONResponse }
+// ListPayees operation middleware
+func (siw *ServerInterfaceWrapper) ListPayees(w http.ResponseWriter, r *http.Request) {
 
-func (response GetSavingsGoal500JSONResponse) VisitGetSavingsGoalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type UpdateSavingsGoalRequestObject struct {
-	Id   string `json:"id"`
-	Body *UpdateSavingsGoalJSONRequestBody
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UpdateSavingsGoalResponseObject interface {
-	VisitUpdateSavingsGoalResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type UpdateSavingsGoal200JSONResponse SavingsGoal
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPayeesParams
 
-func (response UpdateSavingsGoal200JSONResponse) VisitUpdateSavingsGoalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "name" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-type UpdateSavingsGoal400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPayees(w, r, params)
+	}))
 
-func (response UpdateSavingsGoal400JSONResponse) VisitUpdateSavingsGoalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateSavingsGoal401Response = N401Response
-
-func (response UpdateSavingsGoal401Response) VisitUpdateSavingsGoalResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+// CreatePayee operation middleware
+func (siw *ServerInterfaceWrapper) CreatePayee(w http.ResponseWriter, r *http.Request) {
 
-type UpdateSavingsGoal404JSONResponse struct{ N404JSONResponse }
+	ctx := r.Context()
 
-func (response UpdateSavingsGoal404JSONResponse) VisitUpdateSavingsGoalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type UpdateSavingsGoal500JSONResponse struct{ N500JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePayee(w, r)
+	}))
 
-func (response UpdateSavingsGoal500JSONResponse) VisitUpdateSavingsGoalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ListSavingsContributionsRequestObject struct {
-	Id     string `json:"id"`
-	Params ListSavingsContributionsParams
-}
+// DeletePayee operation middleware
+func (siw *ServerInterfaceWrapper) DeletePayee(w http.ResponseWriter, r *http.Request) {
 
-type ListSavingsContributionsResponseObject interface {
-	VisitListSavingsContributionsResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type ListSavingsContributions200JSONResponse []SavingsContribution
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response ListSavingsContributions200JSONResponse) VisitListSavingsContributionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type ListSavingsContributions401Response = N401Response
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response ListSavingsContributions401Response) VisitListSavingsContributionsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type ListSavingsContributions404JSONResponse struct{ N404JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePayee(w, r, id)
+	}))
 
-func (response ListSavingsContributions404JSONResponse) VisitListSavingsContributionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ListSavingsContributions500JSONResponse struct{ N500JSONResponse }
-
-func (response ListSavingsContributions500JSONResponse) VisitListSavingsContributionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetPayee operation middleware
+func (siw *ServerInterfaceWrapper) GetPayee(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type AddSavingsContributionRequestObject struct {
-	Id   string `json:"id"`
-	Body *AddSavingsContributionJSONRequestBody
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type AddSavingsContributionResponseObject interface {
-	VisitAddSavingsContributionResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type AddSavingsContribution201JSONResponse SavingsContribution
+	ctx := r.Context()
 
-func (response AddSavingsContribution201JSONResponse) VisitAddSavingsContributionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type AddSavingsContribution400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPayee(w, r, id)
+	}))
 
-func (response AddSavingsContribution400JSONResponse) VisitAddSavingsContributionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type AddSavingsContribution401Response = N401Response
+// UpdatePayee operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePayee(w http.ResponseWriter, r *http.Request) {
 
-func (response AddSavingsContribution401Response) VisitAddSavingsContributionResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type AddSavingsContribution404JSONResponse struct{ N404JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response AddSavingsContribution404JSONResponse) VisitAddSavingsContributionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type AddSavingsContribution500JSONResponse struct{ N500JSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response AddSavingsContribution500JSONResponse) VisitAddSavingsContributionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePayee(w, r, id)
+	}))
 
-type GetSavingsProgressRequestObject struct {
-	Id string `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetSavingsProgressResponseObject interface {
-	VisitGetSavingsProgressResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetSavingsProgress200JSONResponse SavingsProgress
-
-func (response GetSavingsProgress200JSONResponse) VisitGetSavingsProgressResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// CreateIngressRecurrencePattern operation middleware
+func (siw *ServerInterfaceWrapper) CreateIngressRecurrencePattern(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type GetSavingsProgress401Response = N401Response
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response GetSavingsProgress401Response) VisitGetSavingsProgressResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	r = r.WithContext(ctx)
 
-type GetSavingsProgress404JSONResponse struct{ N404JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateIngressRecurrencePattern(w, r)
+	}))
 
-func (response GetSavingsProgress404JSONResponse) VisitGetSavingsProgressResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetSavingsProgress500JSONResponse struct{ N500JSONResponse }
+// ListExpenditureRecurrencePatterns operation middleware
+func (siw *ServerInterfaceWrapper) ListExpenditureRecurrencePatterns(w http.ResponseWriter, r *http.Request) {
 
-func (response GetSavingsProgress500JSONResponse) VisitGetSavingsProgressResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type ListSavingsTransactionsRequestObject struct {
-	Id     string `json:"id"`
-	Params ListSavingsTransactionsParams
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ListSavingsTransactionsResponseObject interface {
-	VisitListSavingsTransactionsResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type ListSavingsTransactions200JSONResponse SavingsTransactionList
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListExpenditureRecurrencePatternsParams
 
-func (response ListSavingsTransactions200JSONResponse) VisitListSavingsTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "active" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
+		return
+	}
 
-type ListSavingsTransactions400JSONResponse struct{ N400JSONResponse }
+	// ------------- Optional query parameter "accountId" -------------
 
-func (response ListSavingsTransactions400JSONResponse) VisitListSavingsTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "accountId", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "autoPost" -------------
 
-type ListSavingsTransactions401Response = N401Response
+	err = runtime.BindQueryParameter("form", true, false, "autoPost", r.URL.Query(), &params.AutoPost)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "autoPost", Err: err})
+		return
+	}
 
-func (response ListSavingsTransactions401Response) VisitListSavingsTransactionsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListExpenditureRecurrencePatterns(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type ListSavingsTransactions404JSONResponse struct{ N404JSONResponse }
+// CreateExpenditureRecurrencePattern operation middleware
+func (siw *ServerInterfaceWrapper) CreateExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request) {
 
-func (response ListSavingsTransactions404JSONResponse) VisitListSavingsTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ListSavingsTransactions500JSONResponse struct{ N500JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response ListSavingsTransactions500JSONResponse) VisitListSavingsTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateExpenditureRecurrencePattern(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListSavingsWithdrawalsRequestObject struct {
-	Id     string `json:"id"`
-	Params ListSavingsWithdrawalsParams
+	handler.ServeHTTP(w, r)
 }
 
-type ListSavingsWithdrawalsResponseObject interface {
-	VisitListSavingsWithdrawalsResponse(w http.ResponseWriter) error
-}
+// DeleteExpenditureRecurrencePattern operation middleware
+func (siw *ServerInterfaceWrapper) DeleteExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request) {
 
-type ListSavingsWithdrawals200JSONResponse []SavingsWithdrawal
+	var err error
 
-func (response ListSavingsWithdrawals200JSONResponse) VisitListSavingsWithdrawalsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type ListSavingsWithdrawals401Response = N401Response
+	ctx := r.Context()
 
-func (response ListSavingsWithdrawals401Response) VisitListSavingsWithdrawalsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ListSavingsWithdrawals404JSONResponse struct{ N404JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response ListSavingsWithdrawals404JSONResponse) VisitListSavingsWithdrawalsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteExpenditureRecurrencePattern(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListSavingsWithdrawals500JSONResponse struct{ N500JSONResponse }
+	handler.ServeHTTP(w, r)
+}
 
-func (response ListSavingsWithdrawals500JSONResponse) VisitListSavingsWithdrawalsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetExpenditureRecurrencePattern operation middleware
+func (siw *ServerInterfaceWrapper) GetExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type AddSavingsWithdrawalRequestObject struct {
-	Id   string `json:"id"`
-	Body *AddSavingsWithdrawalJSONRequestBody
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type AddSavingsWithdrawalResponseObject interface {
-	VisitAddSavingsWithdrawalResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type AddSavingsWithdrawal201JSONResponse SavingsWithdrawal
+	ctx := r.Context()
 
-func (response AddSavingsWithdrawal201JSONResponse) VisitAddSavingsWithdrawalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type AddSavingsWithdrawal400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExpenditureRecurrencePattern(w, r, id)
+	}))
 
-func (response AddSavingsWithdrawal400JSONResponse) VisitAddSavingsWithdrawalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type AddSavingsWithdrawal401Response = N401Response
+// UpdateExpenditureRecurrencePattern operation middleware
+func (siw *ServerInterfaceWrapper) UpdateExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request) {
 
-func (response AddSavingsWithdrawal401Response) VisitAddSavingsWithdrawalResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type AddSavingsWithdrawal404JSONResponse struct{ N404JSONResponse }
+	// ------------- Path parameter "id" -------------
+	var id string
 
-func (response AddSavingsWithdrawal404JSONResponse) VisitAddSavingsWithdrawalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type AddSavingsWithdrawal500JSONResponse struct{ N500JSONResponse }
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response AddSavingsWithdrawal500JSONResponse) VisitAddSavingsWithdrawalResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateExpenditureRecurrencePattern(w, r, id)
+	}))
 
-type ListTagsRequestObject struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type ListTagsResponseObject interface {
-	VisitListTagsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type ListTags200JSONResponse []Tag
+// PostExpenditureRecurrencePattern operation middleware
+func (siw *ServerInterfaceWrapper) PostExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request) {
 
-func (response ListTags200JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type ListTags400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response ListTags400JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type ListTags500JSONResponse struct{ N500JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response ListTags500JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostExpenditureRecurrencePattern(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateTagRequestObject struct {
-	Body *CreateTagJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type CreateTagResponseObject interface {
-	VisitCreateTagResponse(w http.ResponseWriter) error
-}
+// GetDeclaredExpensesReport operation middleware
+func (siw *ServerInterfaceWrapper) GetDeclaredExpensesReport(w http.ResponseWriter, r *http.Request) {
 
-type CreateTag201JSONResponse Tag
+	var err error
 
-func (response CreateTag201JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type CreateTag400JSONResponse struct{ N400JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response CreateTag400JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDeclaredExpensesReportParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Required query parameter "year" -------------
 
-type CreateTag409JSONResponse struct{ N409JSONResponse }
+	if paramValue := r.URL.Query().Get("year"); paramValue != "" {
 
-func (response CreateTag409JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "year"})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "year", r.URL.Query(), &params.Year)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
 
-type CreateTag500JSONResponse struct{ N500JSONResponse }
+	// ------------- Optional query parameter "startMonth" -------------
 
-func (response CreateTag500JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, false, "startMonth", r.URL.Query(), &params.StartMonth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startMonth", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "currency" -------------
 
-type ListTagsByTypeRequestObject struct {
-	Type TagType `json:"type"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
 
-type ListTagsByTypeResponseObject interface {
-	VisitListTagsByTypeResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "memberId" -------------
 
-type ListTagsByType200JSONResponse []Tag
-
-func (response ListTagsByType200JSONResponse) VisitListTagsByTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "memberId", r.URL.Query(), &params.MemberId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "memberId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "format" -------------
 
-type ListTagsByType400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
 
-func (response ListTagsByType400JSONResponse) VisitListTagsByTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "amountBasis" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "amountBasis", r.URL.Query(), &params.AmountBasis)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amountBasis", Err: err})
+		return
+	}
 
-type ListTagsByType401Response = N401Response
+	// ------------- Optional query parameter "rollUp" -------------
 
-func (response ListTagsByType401Response) VisitListTagsByTypeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "rollUp", r.URL.Query(), &params.RollUp)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rollUp", Err: err})
+		return
+	}
 
-type ListTagsByType404JSONResponse struct{ N404JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDeclaredExpensesReport(w, r, params)
+	}))
 
-func (response ListTagsByType404JSONResponse) VisitListTagsByTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type ListTagsByType500JSONResponse struct{ N500JSONResponse }
+// GetPayeeSpendingReport operation middleware
+func (siw *ServerInterfaceWrapper) GetPayeeSpendingReport(w http.ResponseWriter, r *http.Request) {
 
-func (response ListTagsByType500JSONResponse) VisitListTagsByTypeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type DeleteTagRequestObject struct {
-	Id string `json:"id"`
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type DeleteTagResponseObject interface {
-	VisitDeleteTagResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type DeleteTag204Response = N204Response
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPayeeSpendingReportParams
 
-func (response DeleteTag204Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	// ------------- Required query parameter "currency" -------------
 
-type DeleteTag400JSONResponse struct{ N400JSONResponse }
+	if paramValue := r.URL.Query().Get("currency"); paramValue != "" {
 
-func (response DeleteTag400JSONResponse) VisitDeleteTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "currency"})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
 
-type DeleteTag401Response = N401Response
+	// ------------- Optional query parameter "from" -------------
 
-func (response DeleteTag401Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-type DeleteTag404JSONResponse struct{ N404JSONResponse }
+	// ------------- Optional query parameter "to" -------------
 
-func (response DeleteTag404JSONResponse) VisitDeleteTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "accountId" -------------
 
-type DeleteTag500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "accountId", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
+		return
+	}
 
-func (response DeleteTag500JSONResponse) VisitDeleteTagResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "amountBasis" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "amountBasis", r.URL.Query(), &params.AmountBasis)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amountBasis", Err: err})
+		return
+	}
 
-type ListTransactionsRequestObject struct {
-	Params ListTransactionsParams
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPayeeSpendingReport(w, r, params)
+	}))
 
-type ListTransactionsResponseObject interface {
-	VisitListTransactionsResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type ListTransactions200JSONResponse TransactionList
+// GetPlannedSpendingReport operation middleware
+func (siw *ServerInterfaceWrapper) GetPlannedSpendingReport(w http.ResponseWriter, r *http.Request) {
 
-func (response ListTransactions200JSONResponse) VisitListTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type ListTransactions400JSONResponse Error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response ListTransactions400JSONResponse) VisitListTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPlannedSpendingReportParams
 
-type ListTransactions500JSONResponse Error
+	// ------------- Required query parameter "currency" -------------
 
-func (response ListTransactions500JSONResponse) VisitListTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	if paramValue := r.URL.Query().Get("currency"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "currency"})
+		return
+	}
 
-type ListTransfersRequestObject struct {
-	Params ListTransfersParams
-}
+	err = runtime.BindQueryParameter("form", true, true, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
 
-type ListTransfersResponseObject interface {
-	VisitListTransfersResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "period" -------------
 
-type ListTransfers200JSONResponse []TransferList
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
 
-func (response ListTransfers200JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "periods" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "periods", r.URL.Query(), &params.Periods)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "periods", Err: err})
+		return
+	}
 
-type ListTransfers401Response = N401Response
+	// ------------- Optional query parameter "endDate" -------------
 
-func (response ListTransfers401Response) VisitListTransfersResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
 
-type ListTransfers500JSONResponse struct{ N500JSONResponse }
+	// ------------- Optional query parameter "accountId" -------------
 
-func (response ListTransfers500JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, false, "accountId", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "top" -------------
 
-type CreateTransferRequestObject struct {
-	Body *CreateTransferJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "top", r.URL.Query(), &params.Top)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "top", Err: err})
+		return
+	}
 
-type CreateTransferResponseObject interface {
-	VisitCreateTransferResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "amountBasis" -------------
 
-type CreateTransfer201JSONResponse Transfer
+	err = runtime.BindQueryParameter("form", true, false, "amountBasis", r.URL.Query(), &params.AmountBasis)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amountBasis", Err: err})
+		return
+	}
 
-func (response CreateTransfer201JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Optional query parameter "rollUp" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "rollUp", r.URL.Query(), &params.RollUp)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rollUp", Err: err})
+		return
+	}
 
-type CreateTransfer400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlannedSpendingReport(w, r, params)
+	}))
 
-func (response CreateTransfer400JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type CreateTransfer401Response = N401Response
-
-func (response CreateTransfer401Response) VisitCreateTransferResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+// GetTagCombinationReport operation middleware
+func (siw *ServerInterfaceWrapper) GetTagCombinationReport(w http.ResponseWriter, r *http.Request) {
 
-type CreateTransfer500JSONResponse struct{ N500JSONResponse }
+	var err error
 
-func (response CreateTransfer500JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetTransferRequestObject struct {
-	Id string `json:"id"`
-}
+	r = r.WithContext(ctx)
 
-type GetTransferResponseObject interface {
-	VisitGetTransferResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTagCombinationReportParams
 
-type GetTransfer200JSONResponse Transfer
+	// ------------- Required query parameter "tagIds" -------------
 
-func (response GetTransfer200JSONResponse) VisitGetTransferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if paramValue := r.URL.Query().Get("tagIds"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "tagIds"})
+		return
+	}
 
-type GetTransfer401Response = N401Response
+	err = runtime.BindQueryParameter("form", true, true, "tagIds", r.URL.Query(), &params.TagIds)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagIds", Err: err})
+		return
+	}
 
-func (response GetTransfer401Response) VisitGetTransferResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	// ------------- Optional query parameter "match" -------------
 
-type GetTransfer404JSONResponse struct{ N404JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "match", r.URL.Query(), &params.Match)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "match", Err: err})
+		return
+	}
 
-func (response GetTransfer404JSONResponse) VisitGetTransferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Required query parameter "currency" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if paramValue := r.URL.Query().Get("currency"); paramValue != "" {
 
-type GetTransfer500JSONResponse struct{ N500JSONResponse }
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "currency"})
+		return
+	}
 
-func (response GetTransfer500JSONResponse) VisitGetTransferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, true, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "from" -------------
 
-type RollbackTransferRequestObject struct {
-	Id   string `json:"id"`
-	Body *RollbackTransferJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-type RollbackTransferResponseObject interface {
-	VisitRollbackTransferResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "to" -------------
 
-type RollbackTransfer201Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-func (response RollbackTransfer201Response) VisitRollbackTransferResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
-}
+	// ------------- Optional query parameter "accountId" -------------
 
-type RollbackTransfer404Response struct {
+	err = runtime.BindQueryParameter("form", true, false, "accountId", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "amountBasis" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "amountBasis", r.URL.Query(), &params.AmountBasis)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amountBasis", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTagCombinationReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response RollbackTransfer404Response) VisitRollbackTransferResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+// GetTagSpendingReport operation middleware
+func (siw *ServerInterfaceWrapper) GetTagSpendingReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTagSpendingReportParams
+
+	// ------------- Required query parameter "currency" -------------
+
+	if paramValue := r.URL.Query().Get("currency"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "currency"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "accountId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "accountId", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "amountBasis" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "amountBasis", r.URL.Query(), &params.AmountBasis)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amountBasis", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTagSpendingReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type RollbackTransfer409Response struct {
+// GetTagCategoryReport operation middleware
+func (siw *ServerInterfaceWrapper) GetTagCategoryReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tagId" -------------
+	var tagId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", r.PathValue("tagId"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTagCategoryReportParams
+
+	// ------------- Required query parameter "currency" -------------
+
+	if paramValue := r.URL.Query().Get("currency"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "currency"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "accountId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "accountId", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "amountBasis" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "amountBasis", r.URL.Query(), &params.AmountBasis)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amountBasis", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "rollUp" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rollUp", r.URL.Query(), &params.RollUp)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rollUp", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTagCategoryReport(w, r, tagId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func (response RollbackTransfer409Response) VisitRollbackTransferResponse(w http.ResponseWriter) error {
-	w.WriteHeader(409)
-	return nil
+// ListRoles operation middleware
+func (siw *ServerInterfaceWrapper) ListRoles(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRoles(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// List all accounts
-	// (GET /accounts)
-	ListAccounts(ctx context.Context, request ListAccountsRequestObject) (ListAccountsResponseObject, error)
-	// Create a new money account
-	// (POST /accounts)
-	CreateAccount(ctx context.Context, request CreateAccountRequestObject) (CreateAccountResponseObject, error)
-	// Delete account
-	// (DELETE /accounts/{id})
-	DeleteAccount(ctx context.Context, request DeleteAccountRequestObject) (DeleteAccountResponseObject, error)
-	// Get account by ID
-	// (GET /accounts/{id})
-	GetAccount(ctx context.Context, request GetAccountRequestObject) (GetAccountResponseObject, error)
-	// Update account
-	// (PUT /accounts/{id})
-	UpdateAccount(ctx context.Context, request UpdateAccountRequestObject) (UpdateAccountResponseObject, error)
-	// Activate an account
-	// (PATCH /accounts/{id}/activate)
-	ActivateAccount(ctx context.Context, request ActivateAccountRequestObject) (ActivateAccountResponseObject, error)
-	// Checks if account can be deleted
-	// (GET /accounts/{id}/can-delete)
-	CanDeleteAccount(ctx context.Context, request CanDeleteAccountRequestObject) (CanDeleteAccountResponseObject, error)
-	// Deactivate an account
-	// (PATCH /accounts/{id}/deactivate)
-	DeactivateAccount(ctx context.Context, request DeactivateAccountRequestObject) (DeactivateAccountResponseObject, error)
-	// Login to the system
-	// (POST /auth/login)
-	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
-	// Refresh authentication token
-	// (POST /auth/refresh)
-	RefreshToken(ctx context.Context, request RefreshTokenRequestObject) (RefreshTokenResponseObject, error)
-	// Register a new user
-	// (POST /auth/register)
-	RegisterUser(ctx context.Context, request RegisterUserRequestObject) (RegisterUserResponseObject, error)
-	// Get current balances
-	// (GET /balances)
-	GetBalances(ctx context.Context, request GetBalancesRequestObject) (GetBalancesResponseObject, error)
-	// List categories
-	// (GET /categories)
-	ListCategories(ctx context.Context, request ListCategoriesRequestObject) (ListCategoriesResponseObject, error)
-	// Create category
-	// (POST /categories)
-	CreateCategory(ctx context.Context, request CreateCategoryRequestObject) (CreateCategoryResponseObject, error)
-	// Delete category
-	// (DELETE /categories/{id})
-	DeleteCategory(ctx context.Context, request DeleteCategoryRequestObject) (DeleteCategoryResponseObject, error)
-	// Update category
-	// (PUT /categories/{id})
+// ListSavingsGoals operation middleware
+func (siw *ServerInterfaceWrapper) ListSavingsGoals(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSavingsGoalsParams
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "accountId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "accountId", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "targetDateBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetDateBefore", r.URL.Query(), &params.TargetDateBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "targetDateBefore", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "targetDateAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetDateAfter", r.URL.Query(), &params.TargetDateAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "targetDateAfter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSavingsGoals(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSavingsGoal operation middleware
+func (siw *ServerInterfaceWrapper) CreateSavingsGoal(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSavingsGoal(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSavingsGoal operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSavingsGoal(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSavingsGoal(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSavingsGoal operation middleware
+func (siw *ServerInterfaceWrapper) GetSavingsGoal(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSavingsGoal(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateSavingsGoal operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSavingsGoal(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSavingsGoal(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSavingsContributions operation middleware
+func (siw *ServerInterfaceWrapper) ListSavingsContributions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSavingsContributionsParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sourceAccountId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sourceAccountId", r.URL.Query(), &params.SourceAccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceAccountId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSavingsContributions(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddSavingsContribution operation middleware
+func (siw *ServerInterfaceWrapper) AddSavingsContribution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddSavingsContribution(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSavingsProgress operation middleware
+func (siw *ServerInterfaceWrapper) GetSavingsProgress(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSavingsProgress(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSavingsTransactions operation middleware
+func (siw *ServerInterfaceWrapper) ListSavingsTransactions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSavingsTransactionsParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "minAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minAmount", r.URL.Query(), &params.MinAmount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minAmount", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxAmount", r.URL.Query(), &params.MaxAmount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxAmount", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSavingsTransactions(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSavingsWithdrawals operation middleware
+func (siw *ServerInterfaceWrapper) ListSavingsWithdrawals(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSavingsWithdrawalsParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "destinationAccountId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "destinationAccountId", r.URL.Query(), &params.DestinationAccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "destinationAccountId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSavingsWithdrawals(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddSavingsWithdrawal operation middleware
+func (siw *ServerInterfaceWrapper) AddSavingsWithdrawal(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddSavingsWithdrawal(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Search operation middleware
+func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Search(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTags operation middleware
+func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTags(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTag operation middleware
+func (siw *ServerInterfaceWrapper) CreateTag(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTag(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTagsByType operation middleware
+func (siw *ServerInterfaceWrapper) ListTagsByType(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "type" -------------
+	var pType TagType
+
+	err = runtime.BindStyledParameterWithOptions("simple", "type", r.PathValue("type"), &pType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTagsByType(w, r, pType)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRecordTags operation middleware
+func (siw *ServerInterfaceWrapper) ListRecordTags(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "type" -------------
+	var pType TagType
+
+	err = runtime.BindStyledParameterWithOptions("simple", "type", r.PathValue("type"), &pType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "recordId" -------------
+	var recordId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recordId", r.PathValue("recordId"), &recordId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recordId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRecordTags(w, r, pType, recordId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddRecordTags operation middleware
+func (siw *ServerInterfaceWrapper) AddRecordTags(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "type" -------------
+	var pType TagType
+
+	err = runtime.BindStyledParameterWithOptions("simple", "type", r.PathValue("type"), &pType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "recordId" -------------
+	var recordId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recordId", r.PathValue("recordId"), &recordId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recordId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddRecordTags(w, r, pType, recordId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetRecordTags operation middleware
+func (siw *ServerInterfaceWrapper) SetRecordTags(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "type" -------------
+	var pType TagType
+
+	err = runtime.BindStyledParameterWithOptions("simple", "type", r.PathValue("type"), &pType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "recordId" -------------
+	var recordId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recordId", r.PathValue("recordId"), &recordId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recordId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetRecordTags(w, r, pType, recordId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveRecordTag operation middleware
+func (siw *ServerInterfaceWrapper) RemoveRecordTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "type" -------------
+	var pType TagType
+
+	err = runtime.BindStyledParameterWithOptions("simple", "type", r.PathValue("type"), &pType, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "recordId" -------------
+	var recordId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recordId", r.PathValue("recordId"), &recordId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recordId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tagId" -------------
+	var tagId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", r.PathValue("tagId"), &tagId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tagId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveRecordTag(w, r, pType, recordId, tagId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTag(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTag operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTag(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MergeTag operation middleware
+func (siw *ServerInterfaceWrapper) MergeTag(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MergeTag(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTransactions operation middleware
+func (siw *ServerInterfaceWrapper) ListTransactions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTransactionsParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "accountId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "accountId", r.URL.Query(), &params.AccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "accountId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "transactionType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "transactionType", r.URL.Query(), &params.TransactionType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "transactionType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "minAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minAmount", r.URL.Query(), &params.MinAmount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minAmount", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxAmount" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxAmount", r.URL.Query(), &params.MaxAmount)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxAmount", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "limit" -------------
+
+	if paramValue := r.URL.Query().Get("limit"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "offset" -------------
+
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTransactions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTransfers operation middleware
+func (siw *ServerInterfaceWrapper) ListTransfers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTransfersParams
+
+	// ------------- Optional query parameter "sourceAccountId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sourceAccountId", r.URL.Query(), &params.SourceAccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceAccountId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "destinationAccountId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "destinationAccountId", r.URL.Query(), &params.DestinationAccountId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "destinationAccountId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTransfers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTransfer operation middleware
+func (siw *ServerInterfaceWrapper) CreateTransfer(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTransfer(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTransfer operation middleware
+func (siw *ServerInterfaceWrapper) GetTransfer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTransfer(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RollbackTransfer operation middleware
+func (siw *ServerInterfaceWrapper) RollbackTransfer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RollbackTransfer(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUpcomingBills operation middleware
+func (siw *ServerInterfaceWrapper) ListUpcomingBills(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUpcomingBillsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUpcomingBills(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUsers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCurrentUser operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LinkCurrentUserMember operation middleware
+func (siw *ServerInterfaceWrapper) LinkCurrentUserMember(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LinkCurrentUserMember(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetUserRoles operation middleware
+func (siw *ServerInterfaceWrapper) SetUserRoles(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetUserRoles(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/accounts", wrapper.ListAccounts)
+	m.HandleFunc("POST "+options.BaseURL+"/accounts", wrapper.CreateAccount)
+	m.HandleFunc("DELETE "+options.BaseURL+"/accounts/{id}", wrapper.DeleteAccount)
+	m.HandleFunc("GET "+options.BaseURL+"/accounts/{id}", wrapper.GetAccount)
+	m.HandleFunc("PUT "+options.BaseURL+"/accounts/{id}", wrapper.UpdateAccount)
+	m.HandleFunc("PATCH "+options.BaseURL+"/accounts/{id}/activate", wrapper.ActivateAccount)
+	m.HandleFunc("GET "+options.BaseURL+"/accounts/{id}/can-delete", wrapper.CanDeleteAccount)
+	m.HandleFunc("PATCH "+options.BaseURL+"/accounts/{id}/deactivate", wrapper.DeactivateAccount)
+	m.HandleFunc("GET "+options.BaseURL+"/allocation-plans", wrapper.ListAllocationPlans)
+	m.HandleFunc("POST "+options.BaseURL+"/allocation-plans", wrapper.CreateAllocationPlan)
+	m.HandleFunc("DELETE "+options.BaseURL+"/allocation-plans/{id}", wrapper.DeleteAllocationPlan)
+	m.HandleFunc("GET "+options.BaseURL+"/allocation-plans/{id}", wrapper.GetAllocationPlan)
+	m.HandleFunc("PUT "+options.BaseURL+"/allocation-plans/{id}", wrapper.UpdateAllocationPlan)
+	m.HandleFunc("POST "+options.BaseURL+"/auth/login", wrapper.Login)
+	m.HandleFunc("POST "+options.BaseURL+"/auth/refresh", wrapper.RefreshToken)
+	m.HandleFunc("POST "+options.BaseURL+"/auth/register", wrapper.RegisterUser)
+	m.HandleFunc("GET "+options.BaseURL+"/balances", wrapper.GetBalances)
+	m.HandleFunc("GET "+options.BaseURL+"/budget-overview", wrapper.GetBudgetOverview)
+	m.HandleFunc("POST "+options.BaseURL+"/budget-overview/copy-last-period", wrapper.CopyLastBudgetPeriod)
+	m.HandleFunc("GET "+options.BaseURL+"/budgets", wrapper.ListBudgets)
+	m.HandleFunc("POST "+options.BaseURL+"/budgets", wrapper.CreateBudget)
+	m.HandleFunc("DELETE "+options.BaseURL+"/budgets/{id}", wrapper.DeleteBudget)
+	m.HandleFunc("GET "+options.BaseURL+"/budgets/{id}", wrapper.GetBudget)
+	m.HandleFunc("PUT "+options.BaseURL+"/budgets/{id}", wrapper.UpdateBudget)
+	m.HandleFunc("GET "+options.BaseURL+"/budgets/{id}/history", wrapper.GetBudgetHistory)
+	m.HandleFunc("PUT "+options.BaseURL+"/budgets/{id}/periods/{number}", wrapper.SetBudgetPeriodAmount)
+	m.HandleFunc("GET "+options.BaseURL+"/budgets/{id}/status", wrapper.GetBudgetStatus)
+	m.HandleFunc("GET "+options.BaseURL+"/categories", wrapper.ListCategories)
+	m.HandleFunc("POST "+options.BaseURL+"/categories", wrapper.CreateCategory)
+	m.HandleFunc("DELETE "+options.BaseURL+"/categories/{id}", wrapper.DeleteCategory)
+	m.HandleFunc("PUT "+options.BaseURL+"/categories/{id}", wrapper.UpdateCategory)
+	m.HandleFunc("PATCH "+options.BaseURL+"/categories/{id}/activate", wrapper.ActivateCategory)
+	m.HandleFunc("PATCH "+options.BaseURL+"/categories/{id}/deactivate", wrapper.DeactivateCategory)
+	m.HandleFunc("POST "+options.BaseURL+"/categories/{id}/merge", wrapper.MergeCategory)
+	m.HandleFunc("GET "+options.BaseURL+"/categorization-rules", wrapper.ListCategorizationRules)
+	m.HandleFunc("POST "+options.BaseURL+"/categorization-rules", wrapper.CreateCategorizationRule)
+	m.HandleFunc("POST "+options.BaseURL+"/categorization-rules/apply", wrapper.ApplyCategorizationRules)
+	m.HandleFunc("POST "+options.BaseURL+"/categorization-rules/preview", wrapper.PreviewCategorizationRule)
+	m.HandleFunc("DELETE "+options.BaseURL+"/categorization-rules/{id}", wrapper.DeleteCategorizationRule)
+	m.HandleFunc("GET "+options.BaseURL+"/categorization-rules/{id}", wrapper.GetCategorizationRule)
+	m.HandleFunc("PUT "+options.BaseURL+"/categorization-rules/{id}", wrapper.UpdateCategorizationRule)
+	m.HandleFunc("GET "+options.BaseURL+"/category-suggestions", wrapper.SuggestCategory)
+	m.HandleFunc("POST "+options.BaseURL+"/category-suggestions/retrain", wrapper.RetrainCategorySuggestions)
+	m.HandleFunc("GET "+options.BaseURL+"/exchange-rates", wrapper.GetExchangeRates)
+	m.HandleFunc("GET "+options.BaseURL+"/expenditures", wrapper.ListExpenditures)
+	m.HandleFunc("POST "+options.BaseURL+"/expenditures", wrapper.CreateExpenditure)
+	m.HandleFunc("POST "+options.BaseURL+"/expenditures/batch", wrapper.CreateExpenditureBatch)
+	m.HandleFunc("GET "+options.BaseURL+"/expenditures/{id}", wrapper.GetExpenditure)
+	m.HandleFunc("GET "+options.BaseURL+"/expenditures/{id}/refunds", wrapper.ListExpenditureRefunds)
+	m.HandleFunc("POST "+options.BaseURL+"/expenditures/{id}/refunds", wrapper.RefundExpenditure)
+	m.HandleFunc("POST "+options.BaseURL+"/expenditures/{id}/rollback", wrapper.RollbackExpenditure)
+	m.HandleFunc("GET "+options.BaseURL+"/household-members", wrapper.ListHouseholdMembers)
+	m.HandleFunc("POST "+options.BaseURL+"/household-members", wrapper.CreateHouseholdMember)
+	m.HandleFunc("DELETE "+options.BaseURL+"/household-members/{id}", wrapper.DeleteHouseholdMember)
+	m.HandleFunc("GET "+options.BaseURL+"/household-members/{id}", wrapper.GetHouseholdMember)
+	m.HandleFunc("PUT "+options.BaseURL+"/household-members/{id}", wrapper.UpdateHouseholdMember)
+	m.HandleFunc("PATCH "+options.BaseURL+"/household-members/{id}/activate", wrapper.ActivateHouseholdMember)
+	m.HandleFunc("PATCH "+options.BaseURL+"/household-members/{id}/deactivate", wrapper.DeactivateHouseholdMember)
+	m.HandleFunc("POST "+options.BaseURL+"/households", wrapper.CreateHousehold)
+	m.HandleFunc("GET "+options.BaseURL+"/households/current", wrapper.GetCurrentHousehold)
+	m.HandleFunc("GET "+options.BaseURL+"/ingresses", wrapper.ListIngresses)
+	m.HandleFunc("POST "+options.BaseURL+"/ingresses", wrapper.CreateIngress)
+	m.HandleFunc("GET "+options.BaseURL+"/ingresses/{id}", wrapper.GetIngress)
+	m.HandleFunc("DELETE "+options.BaseURL+"/ingresses/{id}/recurrence-pattern/{pattern_id}", wrapper.DeleteIngressRecurrencePattern)
+	m.HandleFunc("GET "+options.BaseURL+"/ingresses/{id}/recurrence-pattern/{pattern_id}", wrapper.GetIngressRecurrencePattern)
+	m.HandleFunc("PUT "+options.BaseURL+"/ingresses/{id}/recurrence-pattern/{pattern_id}", wrapper.UpdateIngressRecurrencePattern)
+	m.HandleFunc("POST "+options.BaseURL+"/ingresses/{id}/rollback", wrapper.RollbackIngress)
+	m.HandleFunc("GET "+options.BaseURL+"/installment-obligations", wrapper.ListInstallmentObligations)
+	m.HandleFunc("GET "+options.BaseURL+"/installment-plans", wrapper.ListInstallmentPlans)
+	m.HandleFunc("POST "+options.BaseURL+"/installment-plans", wrapper.CreateInstallmentPlan)
+	m.HandleFunc("GET "+options.BaseURL+"/installment-plans/{id}", wrapper.GetInstallmentPlan)
+	m.HandleFunc("POST "+options.BaseURL+"/installment-plans/{id}/payoff", wrapper.PayOffInstallmentPlan)
+	m.HandleFunc("POST "+options.BaseURL+"/invitations", wrapper.CreateInvitation)
+	m.HandleFunc("POST "+options.BaseURL+"/invitations/accept", wrapper.AcceptInvitation)
+	m.HandleFunc("POST "+options.BaseURL+"/invitations/join", wrapper.JoinHousehold)
+	m.HandleFunc("GET "+options.BaseURL+"/payees", wrapper.ListPayees)
+	m.HandleFunc("POST "+options.BaseURL+"/payees", wrapper.CreatePayee)
+	m.HandleFunc("DELETE "+options.BaseURL+"/payees/{id}", wrapper.DeletePayee)
+	m.HandleFunc("GET "+options.BaseURL+"/payees/{id}", wrapper.GetPayee)
+	m.HandleFunc("PUT "+options.BaseURL+"/payees/{id}", wrapper.UpdatePayee)
+	m.HandleFunc("POST "+options.BaseURL+"/recurrence-pattern", wrapper.CreateIngressRecurrencePattern)
+	m.HandleFunc("GET "+options.BaseURL+"/recurring-bills", wrapper.ListExpenditureRecurrencePatterns)
+	m.HandleFunc("POST "+options.BaseURL+"/recurring-bills", wrapper.CreateExpenditureRecurrencePattern)
+	m.HandleFunc("DELETE "+options.BaseURL+"/recurring-bills/{id}", wrapper.DeleteExpenditureRecurrencePattern)
+	m.HandleFunc("GET "+options.BaseURL+"/recurring-bills/{id}", wrapper.GetExpenditureRecurrencePattern)
+	m.HandleFunc("PUT "+options.BaseURL+"/recurring-bills/{id}", wrapper.UpdateExpenditureRecurrencePattern)
+	m.HandleFunc("POST "+options.BaseURL+"/recurring-bills/{id}/post", wrapper.PostExpenditureRecurrencePattern)
+	m.HandleFunc("GET "+options.BaseURL+"/reports/declared-expenses", wrapper.GetDeclaredExpensesReport)
+	m.HandleFunc("GET "+options.BaseURL+"/reports/payee-spending", wrapper.GetPayeeSpendingReport)
+	m.HandleFunc("GET "+options.BaseURL+"/reports/planned-spending", wrapper.GetPlannedSpendingReport)
+	m.HandleFunc("GET "+options.BaseURL+"/reports/tag-combination", wrapper.GetTagCombinationReport)
+	m.HandleFunc("GET "+options.BaseURL+"/reports/tag-spending", wrapper.GetTagSpendingReport)
+	m.HandleFunc("GET "+options.BaseURL+"/reports/tag-spending/{tagId}/categories", wrapper.GetTagCategoryReport)
+	m.HandleFunc("GET "+options.BaseURL+"/roles", wrapper.ListRoles)
+	m.HandleFunc("GET "+options.BaseURL+"/savings", wrapper.ListSavingsGoals)
+	m.HandleFunc("POST "+options.BaseURL+"/savings", wrapper.CreateSavingsGoal)
+	m.HandleFunc("DELETE "+options.BaseURL+"/savings/{id}", wrapper.DeleteSavingsGoal)
+	m.HandleFunc("GET "+options.BaseURL+"/savings/{id}", wrapper.GetSavingsGoal)
+	m.HandleFunc("PUT "+options.BaseURL+"/savings/{id}", wrapper.UpdateSavingsGoal)
+	m.HandleFunc("GET "+options.BaseURL+"/savings/{id}/contributions", wrapper.ListSavingsContributions)
+	m.HandleFunc("POST "+options.BaseURL+"/savings/{id}/contributions", wrapper.AddSavingsContribution)
+	m.HandleFunc("GET "+options.BaseURL+"/savings/{id}/progress", wrapper.GetSavingsProgress)
+	m.HandleFunc("GET "+options.BaseURL+"/savings/{id}/transactions", wrapper.ListSavingsTransactions)
+	m.HandleFunc("GET "+options.BaseURL+"/savings/{id}/withdrawals", wrapper.ListSavingsWithdrawals)
+	m.HandleFunc("POST "+options.BaseURL+"/savings/{id}/withdrawals", wrapper.AddSavingsWithdrawal)
+	m.HandleFunc("GET "+options.BaseURL+"/search", wrapper.Search)
+	m.HandleFunc("GET "+options.BaseURL+"/tags", wrapper.ListTags)
+	m.HandleFunc("POST "+options.BaseURL+"/tags", wrapper.CreateTag)
+	m.HandleFunc("GET "+options.BaseURL+"/tags/type/{type}", wrapper.ListTagsByType)
+	m.HandleFunc("GET "+options.BaseURL+"/tags/type/{type}/records/{recordId}", wrapper.ListRecordTags)
+	m.HandleFunc("POST "+options.BaseURL+"/tags/type/{type}/records/{recordId}", wrapper.AddRecordTags)
+	m.HandleFunc("PUT "+options.BaseURL+"/tags/type/{type}/records/{recordId}", wrapper.SetRecordTags)
+	m.HandleFunc("DELETE "+options.BaseURL+"/tags/type/{type}/records/{recordId}/{tagId}", wrapper.RemoveRecordTag)
+	m.HandleFunc("DELETE "+options.BaseURL+"/tags/{id}", wrapper.DeleteTag)
+	m.HandleFunc("PUT "+options.BaseURL+"/tags/{id}", wrapper.UpdateTag)
+	m.HandleFunc("POST "+options.BaseURL+"/tags/{id}/merge", wrapper.MergeTag)
+	m.HandleFunc("GET "+options.BaseURL+"/transactions", wrapper.ListTransactions)
+	m.HandleFunc("GET "+options.BaseURL+"/transfers", wrapper.ListTransfers)
+	m.HandleFunc("POST "+options.BaseURL+"/transfers", wrapper.CreateTransfer)
+	m.HandleFunc("GET "+options.BaseURL+"/transfers/{id}", wrapper.GetTransfer)
+	m.HandleFunc("POST "+options.BaseURL+"/transfers/{id}/rollback", wrapper.RollbackTransfer)
+	m.HandleFunc("GET "+options.BaseURL+"/upcoming-bills", wrapper.ListUpcomingBills)
+	m.HandleFunc("GET "+options.BaseURL+"/users", wrapper.ListUsers)
+	m.HandleFunc("GET "+options.BaseURL+"/users/me", wrapper.GetCurrentUser)
+	m.HandleFunc("PUT "+options.BaseURL+"/users/me/household-member", wrapper.LinkCurrentUserMember)
+	m.HandleFunc("PUT "+options.BaseURL+"/users/{id}/roles", wrapper.SetUserRoles)
+
+	return m
+}
+
+type N200Response struct {
+}
+
+type N204Response struct {
+}
+
+type N400JSONResponse Error
+
+type N401Response struct {
+}
+
+type N403JSONResponse Error
+
+type N404JSONResponse Error
+
+type N409JSONResponse Error
+
+type N500JSONResponse Error
+
+type N501Response struct {
+}
+
+type ListAccountsRequestObject struct {
+	Params ListAccountsParams
+}
+
+type ListAccountsResponseObject interface {
+	VisitListAccountsResponse(w http.ResponseWriter) error
+}
+
+type ListAccounts200JSONResponse AccountList
+
+func (response ListAccounts200JSONResponse) VisitListAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAccounts400JSONResponse struct{ N400JSONResponse }
+
+func (response ListAccounts400JSONResponse) VisitListAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAccounts401Response = N401Response
+
+func (response ListAccounts401Response) VisitListAccountsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListAccounts500JSONResponse struct{ N500JSONResponse }
+
+func (response ListAccounts500JSONResponse) VisitListAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccountRequestObject struct {
+	Body *CreateAccountJSONRequestBody
+}
+
+type CreateAccountResponseObject interface {
+	VisitCreateAccountResponse(w http.ResponseWriter) error
+}
+
+type CreateAccount201JSONResponse Account
+
+func (response CreateAccount201JSONResponse) VisitCreateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccount400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateAccount400JSONResponse) VisitCreateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAccount401Response = N401Response
+
+func (response CreateAccount401Response) VisitCreateAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateAccount500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateAccount500JSONResponse) VisitCreateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccountRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteAccountResponseObject interface {
+	VisitDeleteAccountResponse(w http.ResponseWriter) error
+}
+
+type DeleteAccount204Response = N204Response
+
+func (response DeleteAccount204Response) VisitDeleteAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAccount401Response = N401Response
+
+func (response DeleteAccount401Response) VisitDeleteAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteAccount404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteAccount404JSONResponse) VisitDeleteAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccount409JSONResponse struct{ N409JSONResponse }
+
+func (response DeleteAccount409JSONResponse) VisitDeleteAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccount500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteAccount500JSONResponse) VisitDeleteAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetAccountResponseObject interface {
+	VisitGetAccountResponse(w http.ResponseWriter) error
+}
+
+type GetAccount200JSONResponse Account
+
+func (response GetAccount200JSONResponse) VisitGetAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccount401Response = N401Response
+
+func (response GetAccount401Response) VisitGetAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetAccount404JSONResponse struct{ N404JSONResponse }
+
+func (response GetAccount404JSONResponse) VisitGetAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccount500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAccount500JSONResponse) VisitGetAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAccountRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateAccountJSONRequestBody
+}
+
+type UpdateAccountResponseObject interface {
+	VisitUpdateAccountResponse(w http.ResponseWriter) error
+}
+
+type UpdateAccount200JSONResponse Account
+
+func (response UpdateAccount200JSONResponse) VisitUpdateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAccount400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateAccount400JSONResponse) VisitUpdateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAccount401Response = N401Response
+
+func (response UpdateAccount401Response) VisitUpdateAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateAccount404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateAccount404JSONResponse) VisitUpdateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAccount500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateAccount500JSONResponse) VisitUpdateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateAccountRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ActivateAccountResponseObject interface {
+	VisitActivateAccountResponse(w http.ResponseWriter) error
+}
+
+type ActivateAccount200Response = N200Response
+
+func (response ActivateAccount200Response) VisitActivateAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ActivateAccount400JSONResponse struct{ N400JSONResponse }
+
+func (response ActivateAccount400JSONResponse) VisitActivateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateAccount401Response = N401Response
+
+func (response ActivateAccount401Response) VisitActivateAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ActivateAccount404JSONResponse struct{ N404JSONResponse }
+
+func (response ActivateAccount404JSONResponse) VisitActivateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateAccount500JSONResponse struct{ N500JSONResponse }
+
+func (response ActivateAccount500JSONResponse) VisitActivateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CanDeleteAccountRequestObject struct {
+	Id string `json:"id"`
+}
+
+type CanDeleteAccountResponseObject interface {
+	VisitCanDeleteAccountResponse(w http.ResponseWriter) error
+}
+
+type CanDeleteAccount200JSONResponse CanDelete
+
+func (response CanDeleteAccount200JSONResponse) VisitCanDeleteAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CanDeleteAccount400JSONResponse struct{ N400JSONResponse }
+
+func (response CanDeleteAccount400JSONResponse) VisitCanDeleteAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CanDeleteAccount401Response = N401Response
+
+func (response CanDeleteAccount401Response) VisitCanDeleteAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CanDeleteAccount404JSONResponse struct{ N404JSONResponse }
+
+func (response CanDeleteAccount404JSONResponse) VisitCanDeleteAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CanDeleteAccount500JSONResponse struct{ N500JSONResponse }
+
+func (response CanDeleteAccount500JSONResponse) VisitCanDeleteAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeactivateAccountRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeactivateAccountResponseObject interface {
+	VisitDeactivateAccountResponse(w http.ResponseWriter) error
+}
+
+type DeactivateAccount204Response = N204Response
+
+func (response DeactivateAccount204Response) VisitDeactivateAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeactivateAccount400JSONResponse struct{ N400JSONResponse }
+
+func (response DeactivateAccount400JSONResponse) VisitDeactivateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeactivateAccount401Response = N401Response
+
+func (response DeactivateAccount401Response) VisitDeactivateAccountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeactivateAccount404JSONResponse struct{ N404JSONResponse }
+
+func (response DeactivateAccount404JSONResponse) VisitDeactivateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeactivateAccount500JSONResponse struct{ N500JSONResponse }
+
+func (response DeactivateAccount500JSONResponse) VisitDeactivateAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAllocationPlansRequestObject struct {
+	Params ListAllocationPlansParams
+}
+
+type ListAllocationPlansResponseObject interface {
+	VisitListAllocationPlansResponse(w http.ResponseWriter) error
+}
+
+type ListAllocationPlans200JSONResponse AllocationPlanList
+
+func (response ListAllocationPlans200JSONResponse) VisitListAllocationPlansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAllocationPlans401Response = N401Response
+
+func (response ListAllocationPlans401Response) VisitListAllocationPlansResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListAllocationPlans500JSONResponse struct{ N500JSONResponse }
+
+func (response ListAllocationPlans500JSONResponse) VisitListAllocationPlansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAllocationPlanRequestObject struct {
+	Body *CreateAllocationPlanJSONRequestBody
+}
+
+type CreateAllocationPlanResponseObject interface {
+	VisitCreateAllocationPlanResponse(w http.ResponseWriter) error
+}
+
+type CreateAllocationPlan201JSONResponse AllocationPlan
+
+func (response CreateAllocationPlan201JSONResponse) VisitCreateAllocationPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAllocationPlan400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateAllocationPlan400JSONResponse) VisitCreateAllocationPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAllocationPlan401Response = N401Response
+
+func (response CreateAllocationPlan401Response) VisitCreateAllocationPlanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateAllocationPlan409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateAllocationPlan409JSONResponse) VisitCreateAllocationPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAllocationPlan500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateAllocationPlan500JSONResponse) VisitCreateAllocationPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAllocationPlanRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteAllocationPlanResponseObject interface {
+	VisitDeleteAllocationPlanResponse(w http.ResponseWriter) error
+}
+
+type DeleteAllocationPlan204Response = N204Response
+
+func (response DeleteAllocationPlan204Response) VisitDeleteAllocationPlanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAllocationPlan401Response = N401Response
+
+func (response DeleteAllocationPlan401Response) VisitDeleteAllocationPlanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteAllocationPlan404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteAllocationPlan404JSONResponse) VisitDeleteAllocationPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAllocationPlan500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteAllocationPlan500JSONResponse) VisitDeleteAllocationPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllocationPlanRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetAllocationPlanResponseObject interface {
+	VisitGetAllocationPlanResponse(w http.ResponseWriter) error
+}
+
+type GetAllocationPlan200JSONResponse AllocationPlan
+
+func (response GetAllocationPlan200JSONResponse) VisitGetAllocationPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllocationPlan401Response = N401Response
+
+func (response GetAllocationPlan401Response) VisitGetAllocationPlanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetAllocationPlan404JSONResponse struct{ N404JSONResponse }
+
+func (response GetAllocationPlan404JSONResponse) VisitGetAllocationPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllocationPlan500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAllocationPlan500JSONResponse) VisitGetAllocationPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAllocationPlanRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateAllocationPlanJSONRequestBody
+}
+
+type UpdateAllocationPlanResponseObject interface {
+	VisitUpdateAllocationPlanResponse(w http.ResponseWriter) error
+}
+
+type UpdateAllocationPlan200JSONResponse AllocationPlan
+
+func (response UpdateAllocationPlan200JSONResponse) VisitUpdateAllocationPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAllocationPlan400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateAllocationPlan400JSONResponse) VisitUpdateAllocationPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAllocationPlan401Response = N401Response
+
+func (response UpdateAllocationPlan401Response) VisitUpdateAllocationPlanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateAllocationPlan404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateAllocationPlan404JSONResponse) VisitUpdateAllocationPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAllocationPlan500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateAllocationPlan500JSONResponse) VisitUpdateAllocationPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginRequestObject struct {
+	Body *LoginJSONRequestBody
+}
+
+type LoginResponseObject interface {
+	VisitLoginResponse(w http.ResponseWriter) error
+}
+
+type Login200JSONResponse LoginResponse
+
+func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Login401JSONResponse LoginResponse
+
+func (response Login401JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshTokenRequestObject struct {
+}
+
+type RefreshTokenResponseObject interface {
+	VisitRefreshTokenResponse(w http.ResponseWriter) error
+}
+
+type RefreshToken200JSONResponse LoginResponse
+
+func (response RefreshToken200JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshToken401JSONResponse LoginResponse
+
+func (response RefreshToken401JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterUserRequestObject struct {
+	Body *RegisterUserJSONRequestBody
+}
+
+type RegisterUserResponseObject interface {
+	VisitRegisterUserResponse(w http.ResponseWriter) error
+}
+
+type RegisterUser201JSONResponse struct {
+	// ExpiresAt Token expiration time
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Token JWT authentication token
+	Token *string `json:"token,omitempty"`
+	User  *User   `json:"user,omitempty"`
+}
+
+func (response RegisterUser201JSONResponse) VisitRegisterUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterUser400JSONResponse Error
+
+func (response RegisterUser400JSONResponse) VisitRegisterUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterUser500JSONResponse Error
+
+func (response RegisterUser500JSONResponse) VisitRegisterUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBalancesRequestObject struct {
+	Params GetBalancesParams
+}
+
+type GetBalancesResponseObject interface {
+	VisitGetBalancesResponse(w http.ResponseWriter) error
+}
+
+type GetBalances200JSONResponse BalanceSummary
+
+func (response GetBalances200JSONResponse) VisitGetBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBalances401Response = N401Response
+
+func (response GetBalances401Response) VisitGetBalancesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetBalances500JSONResponse struct{ N500JSONResponse }
+
+func (response GetBalances500JSONResponse) VisitGetBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBalances501Response = N501Response
+
+func (response GetBalances501Response) VisitGetBalancesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(501)
+	return nil
+}
+
+type GetBudgetOverviewRequestObject struct {
+	Params GetBudgetOverviewParams
+}
+
+type GetBudgetOverviewResponseObject interface {
+	VisitGetBudgetOverviewResponse(w http.ResponseWriter) error
+}
+
+type GetBudgetOverview200JSONResponse BudgetOverview
+
+func (response GetBudgetOverview200JSONResponse) VisitGetBudgetOverviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetOverview401Response = N401Response
+
+func (response GetBudgetOverview401Response) VisitGetBudgetOverviewResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetBudgetOverview500JSONResponse struct{ N500JSONResponse }
+
+func (response GetBudgetOverview500JSONResponse) VisitGetBudgetOverviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyLastBudgetPeriodRequestObject struct {
+	Params CopyLastBudgetPeriodParams
+}
+
+type CopyLastBudgetPeriodResponseObject interface {
+	VisitCopyLastBudgetPeriodResponse(w http.ResponseWriter) error
+}
+
+type CopyLastBudgetPeriod200JSONResponse BudgetOverview
+
+func (response CopyLastBudgetPeriod200JSONResponse) VisitCopyLastBudgetPeriodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CopyLastBudgetPeriod401Response = N401Response
+
+func (response CopyLastBudgetPeriod401Response) VisitCopyLastBudgetPeriodResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CopyLastBudgetPeriod500JSONResponse struct{ N500JSONResponse }
+
+func (response CopyLastBudgetPeriod500JSONResponse) VisitCopyLastBudgetPeriodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBudgetsRequestObject struct {
+	Params ListBudgetsParams
+}
+
+type ListBudgetsResponseObject interface {
+	VisitListBudgetsResponse(w http.ResponseWriter) error
+}
+
+type ListBudgets200JSONResponse BudgetList
+
+func (response ListBudgets200JSONResponse) VisitListBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBudgets401Response = N401Response
+
+func (response ListBudgets401Response) VisitListBudgetsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListBudgets500JSONResponse struct{ N500JSONResponse }
+
+func (response ListBudgets500JSONResponse) VisitListBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBudgetRequestObject struct {
+	Body *CreateBudgetJSONRequestBody
+}
+
+type CreateBudgetResponseObject interface {
+	VisitCreateBudgetResponse(w http.ResponseWriter) error
+}
+
+type CreateBudget201JSONResponse Budget
+
+func (response CreateBudget201JSONResponse) VisitCreateBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBudget400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateBudget400JSONResponse) VisitCreateBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateBudget401Response = N401Response
+
+func (response CreateBudget401Response) VisitCreateBudgetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateBudget500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateBudget500JSONResponse) VisitCreateBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBudgetRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteBudgetResponseObject interface {
+	VisitDeleteBudgetResponse(w http.ResponseWriter) error
+}
+
+type DeleteBudget204Response = N204Response
+
+func (response DeleteBudget204Response) VisitDeleteBudgetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteBudget401Response = N401Response
+
+func (response DeleteBudget401Response) VisitDeleteBudgetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteBudget404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteBudget404JSONResponse) VisitDeleteBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBudget500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteBudget500JSONResponse) VisitDeleteBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetBudgetResponseObject interface {
+	VisitGetBudgetResponse(w http.ResponseWriter) error
+}
+
+type GetBudget200JSONResponse Budget
+
+func (response GetBudget200JSONResponse) VisitGetBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudget401Response = N401Response
+
+func (response GetBudget401Response) VisitGetBudgetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetBudget404JSONResponse struct{ N404JSONResponse }
+
+func (response GetBudget404JSONResponse) VisitGetBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudget500JSONResponse struct{ N500JSONResponse }
+
+func (response GetBudget500JSONResponse) VisitGetBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateBudgetRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateBudgetJSONRequestBody
+}
+
+type UpdateBudgetResponseObject interface {
+	VisitUpdateBudgetResponse(w http.ResponseWriter) error
+}
+
+type UpdateBudget200JSONResponse Budget
+
+func (response UpdateBudget200JSONResponse) VisitUpdateBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateBudget400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateBudget400JSONResponse) VisitUpdateBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateBudget401Response = N401Response
+
+func (response UpdateBudget401Response) VisitUpdateBudgetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateBudget404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateBudget404JSONResponse) VisitUpdateBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateBudget500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateBudget500JSONResponse) VisitUpdateBudgetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetHistoryRequestObject struct {
+	Id     string `json:"id"`
+	Params GetBudgetHistoryParams
+}
+
+type GetBudgetHistoryResponseObject interface {
+	VisitGetBudgetHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetBudgetHistory200JSONResponse BudgetHistory
+
+func (response GetBudgetHistory200JSONResponse) VisitGetBudgetHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetHistory400JSONResponse struct{ N400JSONResponse }
+
+func (response GetBudgetHistory400JSONResponse) VisitGetBudgetHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetHistory401Response = N401Response
+
+func (response GetBudgetHistory401Response) VisitGetBudgetHistoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetBudgetHistory404JSONResponse struct{ N404JSONResponse }
+
+func (response GetBudgetHistory404JSONResponse) VisitGetBudgetHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetHistory500JSONResponse struct{ N500JSONResponse }
+
+func (response GetBudgetHistory500JSONResponse) VisitGetBudgetHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetBudgetPeriodAmountRequestObject struct {
+	Id     string `json:"id"`
+	Number int    `json:"number"`
+	Body   *SetBudgetPeriodAmountJSONRequestBody
+}
+
+type SetBudgetPeriodAmountResponseObject interface {
+	VisitSetBudgetPeriodAmountResponse(w http.ResponseWriter) error
+}
+
+type SetBudgetPeriodAmount200JSONResponse BudgetStatus
+
+func (response SetBudgetPeriodAmount200JSONResponse) VisitSetBudgetPeriodAmountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetBudgetPeriodAmount400JSONResponse struct{ N400JSONResponse }
+
+func (response SetBudgetPeriodAmount400JSONResponse) VisitSetBudgetPeriodAmountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetBudgetPeriodAmount401Response = N401Response
+
+func (response SetBudgetPeriodAmount401Response) VisitSetBudgetPeriodAmountResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SetBudgetPeriodAmount404JSONResponse struct{ N404JSONResponse }
+
+func (response SetBudgetPeriodAmount404JSONResponse) VisitSetBudgetPeriodAmountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetBudgetPeriodAmount500JSONResponse struct{ N500JSONResponse }
+
+func (response SetBudgetPeriodAmount500JSONResponse) VisitSetBudgetPeriodAmountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetStatusRequestObject struct {
+	Id     string `json:"id"`
+	Params GetBudgetStatusParams
+}
+
+type GetBudgetStatusResponseObject interface {
+	VisitGetBudgetStatusResponse(w http.ResponseWriter) error
+}
+
+type GetBudgetStatus200JSONResponse BudgetStatus
+
+func (response GetBudgetStatus200JSONResponse) VisitGetBudgetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetStatus400JSONResponse struct{ N400JSONResponse }
+
+func (response GetBudgetStatus400JSONResponse) VisitGetBudgetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetStatus401Response = N401Response
+
+func (response GetBudgetStatus401Response) VisitGetBudgetStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetBudgetStatus404JSONResponse struct{ N404JSONResponse }
+
+func (response GetBudgetStatus404JSONResponse) VisitGetBudgetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetStatus500JSONResponse struct{ N500JSONResponse }
+
+func (response GetBudgetStatus500JSONResponse) VisitGetBudgetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCategoriesRequestObject struct {
+	Params ListCategoriesParams
+}
+
+type ListCategoriesResponseObject interface {
+	VisitListCategoriesResponse(w http.ResponseWriter) error
+}
+
+type ListCategories200JSONResponse struct {
+	Categories *[]Category `json:"categories,omitempty"`
+
+	// Total Total number of categories
+	Total *int `json:"total,omitempty"`
+}
+
+func (response ListCategories200JSONResponse) VisitListCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCategories401Response = N401Response
+
+func (response ListCategories401Response) VisitListCategoriesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListCategories500JSONResponse struct{ N500JSONResponse }
+
+func (response ListCategories500JSONResponse) VisitListCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategoryRequestObject struct {
+	Body *CreateCategoryJSONRequestBody
+}
+
+type CreateCategoryResponseObject interface {
+	VisitCreateCategoryResponse(w http.ResponseWriter) error
+}
+
+type CreateCategory201JSONResponse Category
+
+func (response CreateCategory201JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategory400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateCategory400JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategory401Response = N401Response
+
+func (response CreateCategory401Response) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateCategory500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCategory500JSONResponse) VisitCreateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategoryRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteCategoryResponseObject interface {
+	VisitDeleteCategoryResponse(w http.ResponseWriter) error
+}
+
+type DeleteCategory204Response = N204Response
+
+func (response DeleteCategory204Response) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCategory400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteCategory400JSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategory401Response = N401Response
+
+func (response DeleteCategory401Response) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteCategory404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteCategory404JSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategory500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteCategory500JSONResponse) VisitDeleteCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategoryRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateCategoryJSONRequestBody
+}
+
+type UpdateCategoryResponseObject interface {
+	VisitUpdateCategoryResponse(w http.ResponseWriter) error
+}
+
+type UpdateCategory200JSONResponse Category
+
+func (response UpdateCategory200JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategory400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateCategory400JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategory404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateCategory404JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategory500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateCategory500JSONResponse) VisitUpdateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateCategoryRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ActivateCategoryResponseObject interface {
+	VisitActivateCategoryResponse(w http.ResponseWriter) error
+}
+
+type ActivateCategory204Response = N204Response
+
+func (response ActivateCategory204Response) VisitActivateCategoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ActivateCategory400JSONResponse struct{ N400JSONResponse }
+
+func (response ActivateCategory400JSONResponse) VisitActivateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateCategory401Response = N401Response
+
+func (response ActivateCategory401Response) VisitActivateCategoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ActivateCategory404JSONResponse struct{ N404JSONResponse }
+
+func (response ActivateCategory404JSONResponse) VisitActivateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateCategory500JSONResponse struct{ N500JSONResponse }
+
+func (response ActivateCategory500JSONResponse) VisitActivateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeactivateCategoryRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeactivateCategoryResponseObject interface {
+	VisitDeactivateCategoryResponse(w http.ResponseWriter) error
+}
+
+type DeactivateCategory204Response = N204Response
+
+func (response DeactivateCategory204Response) VisitDeactivateCategoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeactivateCategory400JSONResponse struct{ N400JSONResponse }
+
+func (response DeactivateCategory400JSONResponse) VisitDeactivateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeactivateCategory401Response = N401Response
+
+func (response DeactivateCategory401Response) VisitDeactivateCategoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeactivateCategory404JSONResponse struct{ N404JSONResponse }
+
+func (response DeactivateCategory404JSONResponse) VisitDeactivateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeactivateCategory500JSONResponse struct{ N500JSONResponse }
+
+func (response DeactivateCategory500JSONResponse) VisitDeactivateCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeCategoryRequestObject struct {
+	Id   string `json:"id"`
+	Body *MergeCategoryJSONRequestBody
+}
+
+type MergeCategoryResponseObject interface {
+	VisitMergeCategoryResponse(w http.ResponseWriter) error
+}
+
+type MergeCategory200JSONResponse CategoryMergeResult
+
+func (response MergeCategory200JSONResponse) VisitMergeCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeCategory400JSONResponse struct{ N400JSONResponse }
+
+func (response MergeCategory400JSONResponse) VisitMergeCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeCategory401Response = N401Response
+
+func (response MergeCategory401Response) VisitMergeCategoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type MergeCategory404JSONResponse struct{ N404JSONResponse }
+
+func (response MergeCategory404JSONResponse) VisitMergeCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeCategory500JSONResponse struct{ N500JSONResponse }
+
+func (response MergeCategory500JSONResponse) VisitMergeCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCategorizationRulesRequestObject struct {
+	Params ListCategorizationRulesParams
+}
+
+type ListCategorizationRulesResponseObject interface {
+	VisitListCategorizationRulesResponse(w http.ResponseWriter) error
+}
+
+type ListCategorizationRules200JSONResponse CategorizationRuleList
+
+func (response ListCategorizationRules200JSONResponse) VisitListCategorizationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCategorizationRules401Response = N401Response
+
+func (response ListCategorizationRules401Response) VisitListCategorizationRulesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListCategorizationRules500JSONResponse struct{ N500JSONResponse }
+
+func (response ListCategorizationRules500JSONResponse) VisitListCategorizationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategorizationRuleRequestObject struct {
+	Body *CreateCategorizationRuleJSONRequestBody
+}
+
+type CreateCategorizationRuleResponseObject interface {
+	VisitCreateCategorizationRuleResponse(w http.ResponseWriter) error
+}
+
+type CreateCategorizationRule201JSONResponse CategorizationRule
+
+func (response CreateCategorizationRule201JSONResponse) VisitCreateCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategorizationRule400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateCategorizationRule400JSONResponse) VisitCreateCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCategorizationRule401Response = N401Response
+
+func (response CreateCategorizationRule401Response) VisitCreateCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateCategorizationRule500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCategorizationRule500JSONResponse) VisitCreateCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyCategorizationRulesRequestObject struct {
+	Body *ApplyCategorizationRulesJSONRequestBody
+}
+
+type ApplyCategorizationRulesResponseObject interface {
+	VisitApplyCategorizationRulesResponse(w http.ResponseWriter) error
+}
+
+type ApplyCategorizationRules200JSONResponse CategorizationRun
+
+func (response ApplyCategorizationRules200JSONResponse) VisitApplyCategorizationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyCategorizationRules400JSONResponse struct{ N400JSONResponse }
+
+func (response ApplyCategorizationRules400JSONResponse) VisitApplyCategorizationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApplyCategorizationRules401Response = N401Response
+
+func (response ApplyCategorizationRules401Response) VisitApplyCategorizationRulesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ApplyCategorizationRules500JSONResponse struct{ N500JSONResponse }
+
+func (response ApplyCategorizationRules500JSONResponse) VisitApplyCategorizationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewCategorizationRuleRequestObject struct {
+	Body *PreviewCategorizationRuleJSONRequestBody
+}
+
+type PreviewCategorizationRuleResponseObject interface {
+	VisitPreviewCategorizationRuleResponse(w http.ResponseWriter) error
+}
+
+type PreviewCategorizationRule200JSONResponse CategorizationRun
+
+func (response PreviewCategorizationRule200JSONResponse) VisitPreviewCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewCategorizationRule400JSONResponse struct{ N400JSONResponse }
+
+func (response PreviewCategorizationRule400JSONResponse) VisitPreviewCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewCategorizationRule401Response = N401Response
+
+func (response PreviewCategorizationRule401Response) VisitPreviewCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PreviewCategorizationRule500JSONResponse struct{ N500JSONResponse }
+
+func (response PreviewCategorizationRule500JSONResponse) VisitPreviewCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategorizationRuleRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteCategorizationRuleResponseObject interface {
+	VisitDeleteCategorizationRuleResponse(w http.ResponseWriter) error
+}
+
+type DeleteCategorizationRule204Response = N204Response
+
+func (response DeleteCategorizationRule204Response) VisitDeleteCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCategorizationRule401Response = N401Response
+
+func (response DeleteCategorizationRule401Response) VisitDeleteCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteCategorizationRule404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteCategorizationRule404JSONResponse) VisitDeleteCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategorizationRule500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteCategorizationRule500JSONResponse) VisitDeleteCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategorizationRuleRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetCategorizationRuleResponseObject interface {
+	VisitGetCategorizationRuleResponse(w http.ResponseWriter) error
+}
+
+type GetCategorizationRule200JSONResponse CategorizationRule
+
+func (response GetCategorizationRule200JSONResponse) VisitGetCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategorizationRule401Response = N401Response
+
+func (response GetCategorizationRule401Response) VisitGetCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetCategorizationRule404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCategorizationRule404JSONResponse) VisitGetCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategorizationRule500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCategorizationRule500JSONResponse) VisitGetCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategorizationRuleRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateCategorizationRuleJSONRequestBody
+}
+
+type UpdateCategorizationRuleResponseObject interface {
+	VisitUpdateCategorizationRuleResponse(w http.ResponseWriter) error
+}
+
+type UpdateCategorizationRule200JSONResponse CategorizationRule
+
+func (response UpdateCategorizationRule200JSONResponse) VisitUpdateCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategorizationRule400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateCategorizationRule400JSONResponse) VisitUpdateCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategorizationRule401Response = N401Response
+
+func (response UpdateCategorizationRule401Response) VisitUpdateCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateCategorizationRule404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateCategorizationRule404JSONResponse) VisitUpdateCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCategorizationRule500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateCategorizationRule500JSONResponse) VisitUpdateCategorizationRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuggestCategoryRequestObject struct {
+	Params SuggestCategoryParams
+}
+
+type SuggestCategoryResponseObject interface {
+	VisitSuggestCategoryResponse(w http.ResponseWriter) error
+}
+
+type SuggestCategory200JSONResponse CategorySuggestion
+
+func (response SuggestCategory200JSONResponse) VisitSuggestCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuggestCategory400JSONResponse struct{ N400JSONResponse }
+
+func (response SuggestCategory400JSONResponse) VisitSuggestCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SuggestCategory401Response = N401Response
+
+func (response SuggestCategory401Response) VisitSuggestCategoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SuggestCategory500JSONResponse struct{ N500JSONResponse }
+
+func (response SuggestCategory500JSONResponse) VisitSuggestCategoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetrainCategorySuggestionsRequestObject struct {
+}
+
+type RetrainCategorySuggestionsResponseObject interface {
+	VisitRetrainCategorySuggestionsResponse(w http.ResponseWriter) error
+}
+
+type RetrainCategorySuggestions200JSONResponse SuggestionModelStatus
+
+func (response RetrainCategorySuggestions200JSONResponse) VisitRetrainCategorySuggestionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetrainCategorySuggestions401Response = N401Response
+
+func (response RetrainCategorySuggestions401Response) VisitRetrainCategorySuggestionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RetrainCategorySuggestions500JSONResponse struct{ N500JSONResponse }
+
+func (response RetrainCategorySuggestions500JSONResponse) VisitRetrainCategorySuggestionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExchangeRatesRequestObject struct {
+	Params GetExchangeRatesParams
+}
+
+type GetExchangeRatesResponseObject interface {
+	VisitGetExchangeRatesResponse(w http.ResponseWriter) error
+}
+
+type GetExchangeRates200JSONResponse ExchangeRates
+
+func (response GetExchangeRates200JSONResponse) VisitGetExchangeRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExchangeRates400JSONResponse struct{ N400JSONResponse }
+
+func (response GetExchangeRates400JSONResponse) VisitGetExchangeRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExchangeRates401Response = N401Response
+
+func (response GetExchangeRates401Response) VisitGetExchangeRatesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetExchangeRates500JSONResponse struct{ N500JSONResponse }
+
+func (response GetExchangeRates500JSONResponse) VisitGetExchangeRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListExpendituresRequestObject struct {
+	Params ListExpendituresParams
+}
+
+type ListExpendituresResponseObject interface {
+	VisitListExpendituresResponse(w http.ResponseWriter) error
+}
+
+type ListExpenditures200JSONResponse ExpenditureList
+
+func (response ListExpenditures200JSONResponse) VisitListExpendituresResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListExpenditures400JSONResponse struct{ N400JSONResponse }
+
+func (response ListExpenditures400JSONResponse) VisitListExpendituresResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListExpenditures401Response = N401Response
+
+func (response ListExpenditures401Response) VisitListExpendituresResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListExpenditures500JSONResponse struct{ N500JSONResponse }
+
+func (response ListExpenditures500JSONResponse) VisitListExpendituresResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpenditureRequestObject struct {
+	Params CreateExpenditureParams
+	Body   *CreateExpenditureJSONRequestBody
+}
+
+type CreateExpenditureResponseObject interface {
+	VisitCreateExpenditureResponse(w http.ResponseWriter) error
+}
+
+type CreateExpenditure201JSONResponse Expenditure
+
+func (response CreateExpenditure201JSONResponse) VisitCreateExpenditureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpenditure400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateExpenditure400JSONResponse) VisitCreateExpenditureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpenditure401Response = N401Response
+
+func (response CreateExpenditure401Response) VisitCreateExpenditureResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateExpenditure409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateExpenditure409JSONResponse) VisitCreateExpenditureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpenditure500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateExpenditure500JSONResponse) VisitCreateExpenditureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpenditureBatchRequestObject struct {
+	Params CreateExpenditureBatchParams
+	Body   *CreateExpenditureBatchJSONRequestBody
+}
+
+type CreateExpenditureBatchResponseObject interface {
+	VisitCreateExpenditureBatchResponse(w http.ResponseWriter) error
+}
+
+type CreateExpenditureBatch201JSONResponse ExpenditureBatchResult
+
+func (response CreateExpenditureBatch201JSONResponse) VisitCreateExpenditureBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpenditureBatch400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateExpenditureBatch400JSONResponse) VisitCreateExpenditureBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpenditureBatch401Response = N401Response
+
+func (response CreateExpenditureBatch401Response) VisitCreateExpenditureBatchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateExpenditureBatch422JSONResponse ExpenditureBatchResult
+
+func (response CreateExpenditureBatch422JSONResponse) VisitCreateExpenditureBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpenditureBatch500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateExpenditureBatch500JSONResponse) VisitCreateExpenditureBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExpenditureRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetExpenditureResponseObject interface {
+	VisitGetExpenditureResponse(w http.ResponseWriter) error
+}
+
+type GetExpenditure200JSONResponse Expenditure
+
+func (response GetExpenditure200JSONResponse) VisitGetExpenditureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExpenditure401Response = N401Response
+
+func (response GetExpenditure401Response) VisitGetExpenditureResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetExpenditure404JSONResponse struct{ N404JSONResponse }
+
+func (response GetExpenditure404JSONResponse) VisitGetExpenditureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExpenditure500JSONResponse struct{ N500JSONResponse }
+
+func (response GetExpenditure500JSONResponse) VisitGetExpenditureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListExpenditureRefundsRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ListExpenditureRefundsResponseObject interface {
+	VisitListExpenditureRefundsResponse(w http.ResponseWriter) error
+}
+
+type ListExpenditureRefunds200JSONResponse RefundList
+
+func (response ListExpenditureRefunds200JSONResponse) VisitListExpenditureRefundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListExpenditureRefunds401Response = N401Response
+
+func (response ListExpenditureRefunds401Response) VisitListExpenditureRefundsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListExpenditureRefunds404JSONResponse struct{ N404JSONResponse }
+
+func (response ListExpenditureRefunds404JSONResponse) VisitListExpenditureRefundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListExpenditureRefunds500JSONResponse struct{ N500JSONResponse }
+
+func (response ListExpenditureRefunds500JSONResponse) VisitListExpenditureRefundsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefundExpenditureRequestObject struct {
+	Id   string `json:"id"`
+	Body *RefundExpenditureJSONRequestBody
+}
+
+type RefundExpenditureResponseObject interface {
+	VisitRefundExpenditureResponse(w http.ResponseWriter) error
+}
+
+type RefundExpenditure201JSONResponse Refund
+
+func (response RefundExpenditure201JSONResponse) VisitRefundExpenditureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefundExpenditure400JSONResponse struct{ N400JSONResponse }
+
+func (response RefundExpenditure400JSONResponse) VisitRefundExpenditureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefundExpenditure401Response = N401Response
+
+func (response RefundExpenditure401Response) VisitRefundExpenditureResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RefundExpenditure404JSONResponse struct{ N404JSONResponse }
+
+func (response RefundExpenditure404JSONResponse) VisitRefundExpenditureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefundExpenditure409JSONResponse struct{ N409JSONResponse }
+
+func (response RefundExpenditure409JSONResponse) VisitRefundExpenditureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefundExpenditure500JSONResponse struct{ N500JSONResponse }
+
+func (response RefundExpenditure500JSONResponse) VisitRefundExpenditureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackExpenditureRequestObject struct {
+	Id   string `json:"id"`
+	Body *RollbackExpenditureJSONRequestBody
+}
+
+type RollbackExpenditureResponseObject interface {
+	VisitRollbackExpenditureResponse(w http.ResponseWriter) error
+}
+
+type RollbackExpenditure201Response struct {
+}
+
+func (response RollbackExpenditure201Response) VisitRollbackExpenditureResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type RollbackExpenditure404Response struct {
+}
+
+func (response RollbackExpenditure404Response) VisitRollbackExpenditureResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RollbackExpenditure409Response struct {
+}
+
+func (response RollbackExpenditure409Response) VisitRollbackExpenditureResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type ListHouseholdMembersRequestObject struct {
+	Params ListHouseholdMembersParams
+}
+
+type ListHouseholdMembersResponseObject interface {
+	VisitListHouseholdMembersResponse(w http.ResponseWriter) error
+}
+
+type ListHouseholdMembers200JSONResponse HouseholdMemberList
+
+func (response ListHouseholdMembers200JSONResponse) VisitListHouseholdMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListHouseholdMembers400JSONResponse struct{ N400JSONResponse }
+
+func (response ListHouseholdMembers400JSONResponse) VisitListHouseholdMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListHouseholdMembers401Response = N401Response
+
+func (response ListHouseholdMembers401Response) VisitListHouseholdMembersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListHouseholdMembers500JSONResponse struct{ N500JSONResponse }
+
+func (response ListHouseholdMembers500JSONResponse) VisitListHouseholdMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHouseholdMemberRequestObject struct {
+	Body *CreateHouseholdMemberJSONRequestBody
+}
+
+type CreateHouseholdMemberResponseObject interface {
+	VisitCreateHouseholdMemberResponse(w http.ResponseWriter) error
+}
+
+type CreateHouseholdMember201JSONResponse HouseholdMember
+
+func (response CreateHouseholdMember201JSONResponse) VisitCreateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHouseholdMember400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateHouseholdMember400JSONResponse) VisitCreateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHouseholdMember401Response = N401Response
+
+func (response CreateHouseholdMember401Response) VisitCreateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateHouseholdMember500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateHouseholdMember500JSONResponse) VisitCreateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHouseholdMemberRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteHouseholdMemberResponseObject interface {
+	VisitDeleteHouseholdMemberResponse(w http.ResponseWriter) error
+}
+
+type DeleteHouseholdMember204Response = N204Response
+
+func (response DeleteHouseholdMember204Response) VisitDeleteHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteHouseholdMember400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteHouseholdMember400JSONResponse) VisitDeleteHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHouseholdMember401Response = N401Response
+
+func (response DeleteHouseholdMember401Response) VisitDeleteHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteHouseholdMember404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteHouseholdMember404JSONResponse) VisitDeleteHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHouseholdMember500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteHouseholdMember500JSONResponse) VisitDeleteHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHouseholdMemberRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetHouseholdMemberResponseObject interface {
+	VisitGetHouseholdMemberResponse(w http.ResponseWriter) error
+}
+
+type GetHouseholdMember200JSONResponse HouseholdMember
+
+func (response GetHouseholdMember200JSONResponse) VisitGetHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHouseholdMember401Response = N401Response
+
+func (response GetHouseholdMember401Response) VisitGetHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetHouseholdMember404JSONResponse struct{ N404JSONResponse }
+
+func (response GetHouseholdMember404JSONResponse) VisitGetHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHouseholdMember500JSONResponse struct{ N500JSONResponse }
+
+func (response GetHouseholdMember500JSONResponse) VisitGetHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHouseholdMemberRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateHouseholdMemberJSONRequestBody
+}
+
+type UpdateHouseholdMemberResponseObject interface {
+	VisitUpdateHouseholdMemberResponse(w http.ResponseWriter) error
+}
+
+type UpdateHouseholdMember200JSONResponse HouseholdMember
+
+func (response UpdateHouseholdMember200JSONResponse) VisitUpdateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHouseholdMember400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateHouseholdMember400JSONResponse) VisitUpdateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHouseholdMember401Response = N401Response
+
+func (response UpdateHouseholdMember401Response) VisitUpdateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateHouseholdMember404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateHouseholdMember404JSONResponse) VisitUpdateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHouseholdMember500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateHouseholdMember500JSONResponse) VisitUpdateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateHouseholdMemberRequestObject struct {
+	Id string `json:"id"`
+}
+
+type ActivateHouseholdMemberResponseObject interface {
+	VisitActivateHouseholdMemberResponse(w http.ResponseWriter) error
+}
+
+type ActivateHouseholdMember204Response = N204Response
+
+func (response ActivateHouseholdMember204Response) VisitActivateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ActivateHouseholdMember400JSONResponse struct{ N400JSONResponse }
+
+func (response ActivateHouseholdMember400JSONResponse) VisitActivateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateHouseholdMember401Response = N401Response
+
+func (response ActivateHouseholdMember401Response) VisitActivateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ActivateHouseholdMember404JSONResponse struct{ N404JSONResponse }
+
+func (response ActivateHouseholdMember404JSONResponse) VisitActivateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateHouseholdMember500JSONResponse struct{ N500JSONResponse }
+
+func (response ActivateHouseholdMember500JSONResponse) VisitActivateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeactivateHouseholdMemberRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeactivateHouseholdMemberResponseObject interface {
+	VisitDeactivateHouseholdMemberResponse(w http.ResponseWriter) error
+}
+
+type DeactivateHouseholdMember204Response = N204Response
+
+func (response DeactivateHouseholdMember204Response) VisitDeactivateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeactivateHouseholdMember400JSONResponse struct{ N400JSONResponse }
+
+func (response DeactivateHouseholdMember400JSONResponse) VisitDeactivateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeactivateHouseholdMember401Response = N401Response
+
+func (response DeactivateHouseholdMember401Response) VisitDeactivateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeactivateHouseholdMember404JSONResponse struct{ N404JSONResponse }
+
+func (response DeactivateHouseholdMember404JSONResponse) VisitDeactivateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeactivateHouseholdMember500JSONResponse struct{ N500JSONResponse }
+
+func (response DeactivateHouseholdMember500JSONResponse) VisitDeactivateHouseholdMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHouseholdRequestObject struct {
+	Body *CreateHouseholdJSONRequestBody
+}
+
+type CreateHouseholdResponseObject interface {
+	VisitCreateHouseholdResponse(w http.ResponseWriter) error
+}
+
+type CreateHousehold201JSONResponse Household
+
+func (response CreateHousehold201JSONResponse) VisitCreateHouseholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHousehold400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateHousehold400JSONResponse) VisitCreateHouseholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHousehold401Response = N401Response
+
+func (response CreateHousehold401Response) VisitCreateHouseholdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateHousehold409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateHousehold409JSONResponse) VisitCreateHouseholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHousehold500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateHousehold500JSONResponse) VisitCreateHouseholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentHouseholdRequestObject struct {
+}
+
+type GetCurrentHouseholdResponseObject interface {
+	VisitGetCurrentHouseholdResponse(w http.ResponseWriter) error
+}
+
+type GetCurrentHousehold200JSONResponse Household
+
+func (response GetCurrentHousehold200JSONResponse) VisitGetCurrentHouseholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentHousehold401Response = N401Response
+
+func (response GetCurrentHousehold401Response) VisitGetCurrentHouseholdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetCurrentHousehold404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCurrentHousehold404JSONResponse) VisitGetCurrentHouseholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentHousehold500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCurrentHousehold500JSONResponse) VisitGetCurrentHouseholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListIngressesRequestObject struct {
+	Params ListIngressesParams
+}
+
+type ListIngressesResponseObject interface {
+	VisitListIngressesResponse(w http.ResponseWriter) error
+}
+
+type ListIngresses200JSONResponse []IngressList
+
+func (response ListIngresses200JSONResponse) VisitListIngressesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListIngresses401Response = N401Response
+
+func (response ListIngresses401Response) VisitListIngressesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListIngresses500JSONResponse struct{ N500JSONResponse }
+
+func (response ListIngresses500JSONResponse) VisitListIngressesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIngressRequestObject struct {
+	Body *CreateIngressJSONRequestBody
+}
+
+type CreateIngressResponseObject interface {
+	VisitCreateIngressResponse(w http.ResponseWriter) error
+}
+
+type CreateIngress201JSONResponse Ingress
+
+func (response CreateIngress201JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIngress400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateIngress400JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIngress401Response = N401Response
+
+func (response CreateIngress401Response) VisitCreateIngressResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateIngress500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateIngress500JSONResponse) VisitCreateIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIngressRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetIngressResponseObject interface {
+	VisitGetIngressResponse(w http.ResponseWriter) error
+}
+
+type GetIngress200JSONResponse Ingress
+
+func (response GetIngress200JSONResponse) VisitGetIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIngress401Response = N401Response
+
+func (response GetIngress401Response) VisitGetIngressResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetIngress404JSONResponse struct{ N404JSONResponse }
+
+func (response GetIngress404JSONResponse) VisitGetIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIngress500JSONResponse struct{ N500JSONResponse }
+
+func (response GetIngress500JSONResponse) VisitGetIngressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteIngressRecurrencePatternRequestObject struct {
+	Id        string `json:"id"`
+	PatternId string `json:"pattern_id"`
+}
+
+type DeleteIngressRecurrencePatternResponseObject interface {
+	VisitDeleteIngressRecurrencePatternResponse(w http.ResponseWriter) error
+}
+
+type DeleteIngressRecurrencePattern204Response = N204Response
+
+func (response DeleteIngressRecurrencePattern204Response) VisitDeleteIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteIngressRecurrencePattern401Response = N401Response
+
+func (response DeleteIngressRecurrencePattern401Response) VisitDeleteIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteIngressRecurrencePattern404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteIngressRecurrencePattern404JSONResponse) VisitDeleteIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteIngressRecurrencePattern500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteIngressRecurrencePattern500JSONResponse) VisitDeleteIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIngressRecurrencePatternRequestObject struct {
+	Id        string `json:"id"`
+	PatternId string `json:"pattern_id"`
+}
+
+type GetIngressRecurrencePatternResponseObject interface {
+	VisitGetIngressRecurrencePatternResponse(w http.ResponseWriter) error
+}
+
+type GetIngressRecurrencePattern200JSONResponse struct {
+	Data *RecurrencePattern `json:"data,omitempty"`
+}
+
+func (response GetIngressRecurrencePattern200JSONResponse) VisitGetIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIngressRecurrencePattern401Response = N401Response
+
+func (response GetIngressRecurrencePattern401Response) VisitGetIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetIngressRecurrencePattern404JSONResponse struct{ N404JSONResponse }
+
+func (response GetIngressRecurrencePattern404JSONResponse) VisitGetIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIngressRecurrencePattern500JSONResponse struct{ N500JSONResponse }
+
+func (response GetIngressRecurrencePattern500JSONResponse) VisitGetIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIngressRecurrencePatternRequestObject struct {
+	Id        string `json:"id"`
+	PatternId string `json:"pattern_id"`
+	Body      *UpdateIngressRecurrencePatternJSONRequestBody
+}
+
+type UpdateIngressRecurrencePatternResponseObject interface {
+	VisitUpdateIngressRecurrencePatternResponse(w http.ResponseWriter) error
+}
+
+type UpdateIngressRecurrencePattern200JSONResponse struct {
+	Data *RecurrencePattern `json:"data,omitempty"`
+}
+
+func (response UpdateIngressRecurrencePattern200JSONResponse) VisitUpdateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIngressRecurrencePattern400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateIngressRecurrencePattern400JSONResponse) VisitUpdateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIngressRecurrencePattern401Response = N401Response
+
+func (response UpdateIngressRecurrencePattern401Response) VisitUpdateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateIngressRecurrencePattern404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateIngressRecurrencePattern404JSONResponse) VisitUpdateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIngressRecurrencePattern500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateIngressRecurrencePattern500JSONResponse) VisitUpdateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackIngressRequestObject struct {
+	Id   string `json:"id"`
+	Body *RollbackIngressJSONRequestBody
+}
+
+type RollbackIngressResponseObject interface {
+	VisitRollbackIngressResponse(w http.ResponseWriter) error
+}
+
+type RollbackIngress201Response struct {
+}
+
+func (response RollbackIngress201Response) VisitRollbackIngressResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type RollbackIngress404Response struct {
+}
+
+func (response RollbackIngress404Response) VisitRollbackIngressResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RollbackIngress409Response struct {
+}
+
+func (response RollbackIngress409Response) VisitRollbackIngressResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type ListInstallmentObligationsRequestObject struct {
+	Params ListInstallmentObligationsParams
+}
+
+type ListInstallmentObligationsResponseObject interface {
+	VisitListInstallmentObligationsResponse(w http.ResponseWriter) error
+}
+
+type ListInstallmentObligations200JSONResponse InstallmentObligationList
+
+func (response ListInstallmentObligations200JSONResponse) VisitListInstallmentObligationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInstallmentObligations401Response = N401Response
+
+func (response ListInstallmentObligations401Response) VisitListInstallmentObligationsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListInstallmentObligations500JSONResponse struct{ N500JSONResponse }
+
+func (response ListInstallmentObligations500JSONResponse) VisitListInstallmentObligationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInstallmentPlansRequestObject struct {
+	Params ListInstallmentPlansParams
+}
+
+type ListInstallmentPlansResponseObject interface {
+	VisitListInstallmentPlansResponse(w http.ResponseWriter) error
+}
+
+type ListInstallmentPlans200JSONResponse InstallmentPlanList
+
+func (response ListInstallmentPlans200JSONResponse) VisitListInstallmentPlansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInstallmentPlans401Response = N401Response
+
+func (response ListInstallmentPlans401Response) VisitListInstallmentPlansResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListInstallmentPlans500JSONResponse struct{ N500JSONResponse }
+
+func (response ListInstallmentPlans500JSONResponse) VisitListInstallmentPlansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInstallmentPlanRequestObject struct {
+	Body *CreateInstallmentPlanJSONRequestBody
+}
+
+type CreateInstallmentPlanResponseObject interface {
+	VisitCreateInstallmentPlanResponse(w http.ResponseWriter) error
+}
+
+type CreateInstallmentPlan201JSONResponse InstallmentPlan
+
+func (response CreateInstallmentPlan201JSONResponse) VisitCreateInstallmentPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInstallmentPlan400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateInstallmentPlan400JSONResponse) VisitCreateInstallmentPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInstallmentPlan401Response = N401Response
+
+func (response CreateInstallmentPlan401Response) VisitCreateInstallmentPlanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateInstallmentPlan500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateInstallmentPlan500JSONResponse) VisitCreateInstallmentPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInstallmentPlanRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetInstallmentPlanResponseObject interface {
+	VisitGetInstallmentPlanResponse(w http.ResponseWriter) error
+}
+
+type GetInstallmentPlan200JSONResponse InstallmentPlan
+
+func (response GetInstallmentPlan200JSONResponse) VisitGetInstallmentPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInstallmentPlan401Response = N401Response
+
+func (response GetInstallmentPlan401Response) VisitGetInstallmentPlanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetInstallmentPlan404JSONResponse struct{ N404JSONResponse }
+
+func (response GetInstallmentPlan404JSONResponse) VisitGetInstallmentPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInstallmentPlan500JSONResponse struct{ N500JSONResponse }
+
+func (response GetInstallmentPlan500JSONResponse) VisitGetInstallmentPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PayOffInstallmentPlanRequestObject struct {
+	Id string `json:"id"`
+}
+
+type PayOffInstallmentPlanResponseObject interface {
+	VisitPayOffInstallmentPlanResponse(w http.ResponseWriter) error
+}
+
+type PayOffInstallmentPlan200JSONResponse InstallmentPlan
+
+func (response PayOffInstallmentPlan200JSONResponse) VisitPayOffInstallmentPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PayOffInstallmentPlan400JSONResponse struct{ N400JSONResponse }
+
+func (response PayOffInstallmentPlan400JSONResponse) VisitPayOffInstallmentPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PayOffInstallmentPlan401Response = N401Response
+
+func (response PayOffInstallmentPlan401Response) VisitPayOffInstallmentPlanResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PayOffInstallmentPlan404JSONResponse struct{ N404JSONResponse }
+
+func (response PayOffInstallmentPlan404JSONResponse) VisitPayOffInstallmentPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PayOffInstallmentPlan409JSONResponse struct{ N409JSONResponse }
+
+func (response PayOffInstallmentPlan409JSONResponse) VisitPayOffInstallmentPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PayOffInstallmentPlan500JSONResponse struct{ N500JSONResponse }
+
+func (response PayOffInstallmentPlan500JSONResponse) VisitPayOffInstallmentPlanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvitationRequestObject struct {
+	Body *CreateInvitationJSONRequestBody
+}
+
+type CreateInvitationResponseObject interface {
+	VisitCreateInvitationResponse(w http.ResponseWriter) error
+}
+
+type CreateInvitation201JSONResponse Invitation
+
+func (response CreateInvitation201JSONResponse) VisitCreateInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvitation400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateInvitation400JSONResponse) VisitCreateInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvitation401Response = N401Response
+
+func (response CreateInvitation401Response) VisitCreateInvitationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateInvitation403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateInvitation403JSONResponse) VisitCreateInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvitation409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateInvitation409JSONResponse) VisitCreateInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvitation500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateInvitation500JSONResponse) VisitCreateInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptInvitationRequestObject struct {
+	Body *AcceptInvitationJSONRequestBody
+}
+
+type AcceptInvitationResponseObject interface {
+	VisitAcceptInvitationResponse(w http.ResponseWriter) error
+}
+
+type AcceptInvitation201JSONResponse LoginResponse
+
+func (response AcceptInvitation201JSONResponse) VisitAcceptInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptInvitation400JSONResponse struct{ N400JSONResponse }
+
+func (response AcceptInvitation400JSONResponse) VisitAcceptInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptInvitation404JSONResponse struct{ N404JSONResponse }
+
+func (response AcceptInvitation404JSONResponse) VisitAcceptInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptInvitation409JSONResponse struct{ N409JSONResponse }
+
+func (response AcceptInvitation409JSONResponse) VisitAcceptInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptInvitation500JSONResponse struct{ N500JSONResponse }
+
+func (response AcceptInvitation500JSONResponse) VisitAcceptInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type JoinHouseholdRequestObject struct {
+	Body *JoinHouseholdJSONRequestBody
+}
+
+type JoinHouseholdResponseObject interface {
+	VisitJoinHouseholdResponse(w http.ResponseWriter) error
+}
+
+type JoinHousehold200JSONResponse User
+
+func (response JoinHousehold200JSONResponse) VisitJoinHouseholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type JoinHousehold400JSONResponse struct{ N400JSONResponse }
+
+func (response JoinHousehold400JSONResponse) VisitJoinHouseholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type JoinHousehold401Response = N401Response
+
+func (response JoinHousehold401Response) VisitJoinHouseholdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type JoinHousehold403JSONResponse struct{ N403JSONResponse }
+
+func (response JoinHousehold403JSONResponse) VisitJoinHouseholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type JoinHousehold404JSONResponse struct{ N404JSONResponse }
+
+func (response JoinHousehold404JSONResponse) VisitJoinHouseholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type JoinHousehold409JSONResponse struct{ N409JSONResponse }
+
+func (response JoinHousehold409JSONResponse) VisitJoinHouseholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type JoinHousehold500JSONResponse struct{ N500JSONResponse }
+
+func (response JoinHousehold500JSONResponse) VisitJoinHouseholdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPayeesRequestObject struct {
+	Params ListPayeesParams
+}
+
+type ListPayeesResponseObject interface {
+	VisitListPayeesResponse(w http.ResponseWriter) error
+}
+
+type ListPayees200JSONResponse PayeeList
+
+func (response ListPayees200JSONResponse) VisitListPayeesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPayees401Response = N401Response
+
+func (response ListPayees401Response) VisitListPayeesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListPayees500JSONResponse struct{ N500JSONResponse }
+
+func (response ListPayees500JSONResponse) VisitListPayeesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePayeeRequestObject struct {
+	Body *CreatePayeeJSONRequestBody
+}
+
+type CreatePayeeResponseObject interface {
+	VisitCreatePayeeResponse(w http.ResponseWriter) error
+}
+
+type CreatePayee201JSONResponse Payee
+
+func (response CreatePayee201JSONResponse) VisitCreatePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePayee400JSONResponse struct{ N400JSONResponse }
+
+func (response CreatePayee400JSONResponse) VisitCreatePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePayee401Response = N401Response
+
+func (response CreatePayee401Response) VisitCreatePayeeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreatePayee409JSONResponse struct{ N409JSONResponse }
+
+func (response CreatePayee409JSONResponse) VisitCreatePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePayee500JSONResponse struct{ N500JSONResponse }
+
+func (response CreatePayee500JSONResponse) VisitCreatePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePayeeRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeletePayeeResponseObject interface {
+	VisitDeletePayeeResponse(w http.ResponseWriter) error
+}
+
+type DeletePayee204Response = N204Response
+
+func (response DeletePayee204Response) VisitDeletePayeeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePayee401Response = N401Response
+
+func (response DeletePayee401Response) VisitDeletePayeeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeletePayee404JSONResponse struct{ N404JSONResponse }
+
+func (response DeletePayee404JSONResponse) VisitDeletePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePayee500JSONResponse struct{ N500JSONResponse }
+
+func (response DeletePayee500JSONResponse) VisitDeletePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPayeeRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetPayeeResponseObject interface {
+	VisitGetPayeeResponse(w http.ResponseWriter) error
+}
+
+type GetPayee200JSONResponse Payee
+
+func (response GetPayee200JSONResponse) VisitGetPayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPayee401Response = N401Response
+
+func (response GetPayee401Response) VisitGetPayeeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetPayee404JSONResponse struct{ N404JSONResponse }
+
+func (response GetPayee404JSONResponse) VisitGetPayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPayee500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPayee500JSONResponse) VisitGetPayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePayeeRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdatePayeeJSONRequestBody
+}
+
+type UpdatePayeeResponseObject interface {
+	VisitUpdatePayeeResponse(w http.ResponseWriter) error
+}
+
+type UpdatePayee200JSONResponse Payee
+
+func (response UpdatePayee200JSONResponse) VisitUpdatePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePayee400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdatePayee400JSONResponse) VisitUpdatePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePayee401Response = N401Response
+
+func (response UpdatePayee401Response) VisitUpdatePayeeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdatePayee404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdatePayee404JSONResponse) VisitUpdatePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePayee409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdatePayee409JSONResponse) VisitUpdatePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePayee500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdatePayee500JSONResponse) VisitUpdatePayeeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIngressRecurrencePatternRequestObject struct {
+	Body *CreateIngressRecurrencePatternJSONRequestBody
+}
+
+type CreateIngressRecurrencePatternResponseObject interface {
+	VisitCreateIngressRecurrencePatternResponse(w http.ResponseWriter) error
+}
+
+type CreateIngressRecurrencePattern201JSONResponse struct {
+	Data *RecurrencePattern `json:"data,omitempty"`
+}
+
+func (response CreateIngressRecurrencePattern201JSONResponse) VisitCreateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIngressRecurrencePattern400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateIngressRecurrencePattern400JSONResponse) VisitCreateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIngressRecurrencePattern401Response = N401Response
+
+func (response CreateIngressRecurrencePattern401Response) VisitCreateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateIngressRecurrencePattern404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateIngressRecurrencePattern404JSONResponse) VisitCreateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIngressRecurrencePattern500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateIngressRecurrencePattern500JSONResponse) VisitCreateIngressRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListExpenditureRecurrencePatternsRequestObject struct {
+	Params ListExpenditureRecurrencePatternsParams
+}
+
+type ListExpenditureRecurrencePatternsResponseObject interface {
+	VisitListExpenditureRecurrencePatternsResponse(w http.ResponseWriter) error
+}
+
+type ListExpenditureRecurrencePatterns200JSONResponse ExpenditureRecurrencePatternList
+
+func (response ListExpenditureRecurrencePatterns200JSONResponse) VisitListExpenditureRecurrencePatternsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListExpenditureRecurrencePatterns401Response = N401Response
+
+func (response ListExpenditureRecurrencePatterns401Response) VisitListExpenditureRecurrencePatternsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListExpenditureRecurrencePatterns500JSONResponse struct{ N500JSONResponse }
+
+func (response ListExpenditureRecurrencePatterns500JSONResponse) VisitListExpenditureRecurrencePatternsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpenditureRecurrencePatternRequestObject struct {
+	Body *CreateExpenditureRecurrencePatternJSONRequestBody
+}
+
+type CreateExpenditureRecurrencePatternResponseObject interface {
+	VisitCreateExpenditureRecurrencePatternResponse(w http.ResponseWriter) error
+}
+
+type CreateExpenditureRecurrencePattern201JSONResponse ExpenditureRecurrencePattern
+
+func (response CreateExpenditureRecurrencePattern201JSONResponse) VisitCreateExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpenditureRecurrencePattern400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateExpenditureRecurrencePattern400JSONResponse) VisitCreateExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExpenditureRecurrencePattern401Response = N401Response
+
+func (response CreateExpenditureRecurrencePattern401Response) VisitCreateExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateExpenditureRecurrencePattern500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateExpenditureRecurrencePattern500JSONResponse) VisitCreateExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteExpenditureRecurrencePatternRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteExpenditureRecurrencePatternResponseObject interface {
+	VisitDeleteExpenditureRecurrencePatternResponse(w http.ResponseWriter) error
+}
+
+type DeleteExpenditureRecurrencePattern204Response = N204Response
+
+func (response DeleteExpenditureRecurrencePattern204Response) VisitDeleteExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteExpenditureRecurrencePattern401Response = N401Response
+
+func (response DeleteExpenditureRecurrencePattern401Response) VisitDeleteExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteExpenditureRecurrencePattern404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteExpenditureRecurrencePattern404JSONResponse) VisitDeleteExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteExpenditureRecurrencePattern500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteExpenditureRecurrencePattern500JSONResponse) VisitDeleteExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExpenditureRecurrencePatternRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetExpenditureRecurrencePatternResponseObject interface {
+	VisitGetExpenditureRecurrencePatternResponse(w http.ResponseWriter) error
+}
+
+type GetExpenditureRecurrencePattern200JSONResponse ExpenditureRecurrencePattern
+
+func (response GetExpenditureRecurrencePattern200JSONResponse) VisitGetExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExpenditureRecurrencePattern401Response = N401Response
+
+func (response GetExpenditureRecurrencePattern401Response) VisitGetExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetExpenditureRecurrencePattern404JSONResponse struct{ N404JSONResponse }
+
+func (response GetExpenditureRecurrencePattern404JSONResponse) VisitGetExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExpenditureRecurrencePattern500JSONResponse struct{ N500JSONResponse }
+
+func (response GetExpenditureRecurrencePattern500JSONResponse) VisitGetExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateExpenditureRecurrencePatternRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateExpenditureRecurrencePatternJSONRequestBody
+}
+
+type UpdateExpenditureRecurrencePatternResponseObject interface {
+	VisitUpdateExpenditureRecurrencePatternResponse(w http.ResponseWriter) error
+}
+
+type UpdateExpenditureRecurrencePattern200JSONResponse ExpenditureRecurrencePattern
+
+func (response UpdateExpenditureRecurrencePattern200JSONResponse) VisitUpdateExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateExpenditureRecurrencePattern400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateExpenditureRecurrencePattern400JSONResponse) VisitUpdateExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateExpenditureRecurrencePattern401Response = N401Response
+
+func (response UpdateExpenditureRecurrencePattern401Response) VisitUpdateExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateExpenditureRecurrencePattern404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateExpenditureRecurrencePattern404JSONResponse) VisitUpdateExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateExpenditureRecurrencePattern500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateExpenditureRecurrencePattern500JSONResponse) VisitUpdateExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostExpenditureRecurrencePatternRequestObject struct {
+	Id   string `json:"id"`
+	Body *PostExpenditureRecurrencePatternJSONRequestBody
+}
+
+type PostExpenditureRecurrencePatternResponseObject interface {
+	VisitPostExpenditureRecurrencePatternResponse(w http.ResponseWriter) error
+}
+
+type PostExpenditureRecurrencePattern201JSONResponse Expenditure
+
+func (response PostExpenditureRecurrencePattern201JSONResponse) VisitPostExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostExpenditureRecurrencePattern400JSONResponse struct{ N400JSONResponse }
+
+func (response PostExpenditureRecurrencePattern400JSONResponse) VisitPostExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostExpenditureRecurrencePattern401Response = N401Response
+
+func (response PostExpenditureRecurrencePattern401Response) VisitPostExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PostExpenditureRecurrencePattern404JSONResponse struct{ N404JSONResponse }
+
+func (response PostExpenditureRecurrencePattern404JSONResponse) VisitPostExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostExpenditureRecurrencePattern409JSONResponse struct{ N409JSONResponse }
+
+func (response PostExpenditureRecurrencePattern409JSONResponse) VisitPostExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostExpenditureRecurrencePattern500JSONResponse struct{ N500JSONResponse }
+
+func (response PostExpenditureRecurrencePattern500JSONResponse) VisitPostExpenditureRecurrencePatternResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeclaredExpensesReportRequestObject struct {
+	Params GetDeclaredExpensesReportParams
+}
+
+type GetDeclaredExpensesReportResponseObject interface {
+	VisitGetDeclaredExpensesReportResponse(w http.ResponseWriter) error
+}
+
+type GetDeclaredExpensesReport200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetDeclaredExpensesReport200JSONResponse struct {
+	Body    DeclaredExpensesReport
+	Headers GetDeclaredExpensesReport200ResponseHeaders
+}
+
+func (response GetDeclaredExpensesReport200JSONResponse) VisitGetDeclaredExpensesReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetDeclaredExpensesReport200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetDeclaredExpensesReport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetDeclaredExpensesReport200TextcsvResponse) VisitGetDeclaredExpensesReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetDeclaredExpensesReport400JSONResponse struct{ N400JSONResponse }
+
+func (response GetDeclaredExpensesReport400JSONResponse) VisitGetDeclaredExpensesReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDeclaredExpensesReport401Response = N401Response
+
+func (response GetDeclaredExpensesReport401Response) VisitGetDeclaredExpensesReportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetDeclaredExpensesReport500JSONResponse struct{ N500JSONResponse }
+
+func (response GetDeclaredExpensesReport500JSONResponse) VisitGetDeclaredExpensesReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPayeeSpendingReportRequestObject struct {
+	Params GetPayeeSpendingReportParams
+}
+
+type GetPayeeSpendingReportResponseObject interface {
+	VisitGetPayeeSpendingReportResponse(w http.ResponseWriter) error
+}
+
+type GetPayeeSpendingReport200JSONResponse PayeeSpendingReport
+
+func (response GetPayeeSpendingReport200JSONResponse) VisitGetPayeeSpendingReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPayeeSpendingReport400JSONResponse struct{ N400JSONResponse }
+
+func (response GetPayeeSpendingReport400JSONResponse) VisitGetPayeeSpendingReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPayeeSpendingReport401Response = N401Response
+
+func (response GetPayeeSpendingReport401Response) VisitGetPayeeSpendingReportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetPayeeSpendingReport500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPayeeSpendingReport500JSONResponse) VisitGetPayeeSpendingReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlannedSpendingReportRequestObject struct {
+	Params GetPlannedSpendingReportParams
+}
+
+type GetPlannedSpendingReportResponseObject interface {
+	VisitGetPlannedSpendingReportResponse(w http.ResponseWriter) error
+}
+
+type GetPlannedSpendingReport200JSONResponse PlannedSpendingReport
+
+func (response GetPlannedSpendingReport200JSONResponse) VisitGetPlannedSpendingReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlannedSpendingReport400JSONResponse struct{ N400JSONResponse }
+
+func (response GetPlannedSpendingReport400JSONResponse) VisitGetPlannedSpendingReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPlannedSpendingReport401Response = N401Response
+
+func (response GetPlannedSpendingReport401Response) VisitGetPlannedSpendingReportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetPlannedSpendingReport500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPlannedSpendingReport500JSONResponse) VisitGetPlannedSpendingReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagCombinationReportRequestObject struct {
+	Params GetTagCombinationReportParams
+}
+
+type GetTagCombinationReportResponseObject interface {
+	VisitGetTagCombinationReportResponse(w http.ResponseWriter) error
+}
+
+type GetTagCombinationReport200JSONResponse TagCombinationReport
+
+func (response GetTagCombinationReport200JSONResponse) VisitGetTagCombinationReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagCombinationReport400JSONResponse struct{ N400JSONResponse }
+
+func (response GetTagCombinationReport400JSONResponse) VisitGetTagCombinationReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagCombinationReport401Response = N401Response
+
+func (response GetTagCombinationReport401Response) VisitGetTagCombinationReportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetTagCombinationReport500JSONResponse struct{ N500JSONResponse }
+
+func (response GetTagCombinationReport500JSONResponse) VisitGetTagCombinationReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagSpendingReportRequestObject struct {
+	Params GetTagSpendingReportParams
+}
+
+type GetTagSpendingReportResponseObject interface {
+	VisitGetTagSpendingReportResponse(w http.ResponseWriter) error
+}
+
+type GetTagSpendingReport200JSONResponse TagSpendingReport
+
+func (response GetTagSpendingReport200JSONResponse) VisitGetTagSpendingReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagSpendingReport400JSONResponse struct{ N400JSONResponse }
+
+func (response GetTagSpendingReport400JSONResponse) VisitGetTagSpendingReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagSpendingReport401Response = N401Response
+
+func (response GetTagSpendingReport401Response) VisitGetTagSpendingReportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetTagSpendingReport500JSONResponse struct{ N500JSONResponse }
+
+func (response GetTagSpendingReport500JSONResponse) VisitGetTagSpendingReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagCategoryReportRequestObject struct {
+	TagId  string `json:"tagId"`
+	Params GetTagCategoryReportParams
+}
+
+type GetTagCategoryReportResponseObject interface {
+	VisitGetTagCategoryReportResponse(w http.ResponseWriter) error
+}
+
+type GetTagCategoryReport200JSONResponse TagCategoryReport
+
+func (response GetTagCategoryReport200JSONResponse) VisitGetTagCategoryReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagCategoryReport400JSONResponse struct{ N400JSONResponse }
+
+func (response GetTagCategoryReport400JSONResponse) VisitGetTagCategoryReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagCategoryReport401Response = N401Response
+
+func (response GetTagCategoryReport401Response) VisitGetTagCategoryReportResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetTagCategoryReport404JSONResponse struct{ N404JSONResponse }
+
+func (response GetTagCategoryReport404JSONResponse) VisitGetTagCategoryReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagCategoryReport500JSONResponse struct{ N500JSONResponse }
+
+func (response GetTagCategoryReport500JSONResponse) VisitGetTagCategoryReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRolesRequestObject struct {
+}
+
+type ListRolesResponseObject interface {
+	VisitListRolesResponse(w http.ResponseWriter) error
+}
+
+type ListRoles200JSONResponse []Role
+
+func (response ListRoles200JSONResponse) VisitListRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoles401Response = N401Response
+
+func (response ListRoles401Response) VisitListRolesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListRoles403JSONResponse struct{ N403JSONResponse }
+
+func (response ListRoles403JSONResponse) VisitListRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRoles500JSONResponse struct{ N500JSONResponse }
+
+func (response ListRoles500JSONResponse) VisitListRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavingsGoalsRequestObject struct {
+	Params ListSavingsGoalsParams
+}
+
+type ListSavingsGoalsResponseObject interface {
+	VisitListSavingsGoalsResponse(w http.ResponseWriter) error
+}
+
+type ListSavingsGoals200JSONResponse []SavingsGoalList
+
+func (response ListSavingsGoals200JSONResponse) VisitListSavingsGoalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavingsGoals401Response = N401Response
+
+func (response ListSavingsGoals401Response) VisitListSavingsGoalsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListSavingsGoals500JSONResponse struct{ N500JSONResponse }
+
+func (response ListSavingsGoals500JSONResponse) VisitListSavingsGoalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavingsGoalRequestObject struct {
+	Body *CreateSavingsGoalJSONRequestBody
+}
+
+type CreateSavingsGoalResponseObject interface {
+	VisitCreateSavingsGoalResponse(w http.ResponseWriter) error
+}
+
+type CreateSavingsGoal201JSONResponse SavingsGoal
+
+func (response CreateSavingsGoal201JSONResponse) VisitCreateSavingsGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavingsGoal400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateSavingsGoal400JSONResponse) VisitCreateSavingsGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSavingsGoal401Response = N401Response
+
+func (response CreateSavingsGoal401Response) VisitCreateSavingsGoalResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateSavingsGoal500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateSavingsGoal500JSONResponse) VisitCreateSavingsGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavingsGoalRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteSavingsGoalResponseObject interface {
+	VisitDeleteSavingsGoalResponse(w http.ResponseWriter) error
+}
+
+type DeleteSavingsGoal204Response = N204Response
+
+func (response DeleteSavingsGoal204Response) VisitDeleteSavingsGoalResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteSavingsGoal401Response = N401Response
+
+func (response DeleteSavingsGoal401Response) VisitDeleteSavingsGoalResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteSavingsGoal404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteSavingsGoal404JSONResponse) VisitDeleteSavingsGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSavingsGoal500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteSavingsGoal500JSONResponse) VisitDeleteSavingsGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavingsGoalRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetSavingsGoalResponseObject interface {
+	VisitGetSavingsGoalResponse(w http.ResponseWriter) error
+}
+
+type GetSavingsGoal200JSONResponse SavingsGoal
+
+func (response GetSavingsGoal200JSONResponse) VisitGetSavingsGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavingsGoal401Response = N401Response
+
+func (response GetSavingsGoal401Response) VisitGetSavingsGoalResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetSavingsGoal404JSONResponse struct{ N404JSONResponse }
+
+func (response GetSavingsGoal404JSONResponse) VisitGetSavingsGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavingsGoal500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSavingsGoal500JSONResponse) VisitGetSavingsGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSavingsGoalRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateSavingsGoalJSONRequestBody
+}
+
+type UpdateSavingsGoalResponseObject interface {
+	VisitUpdateSavingsGoalResponse(w http.ResponseWriter) error
+}
+
+type UpdateSavingsGoal200JSONResponse SavingsGoal
+
+func (response UpdateSavingsGoal200JSONResponse) VisitUpdateSavingsGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSavingsGoal400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateSavingsGoal400JSONResponse) VisitUpdateSavingsGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSavingsGoal401Response = N401Response
+
+func (response UpdateSavingsGoal401Response) VisitUpdateSavingsGoalResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateSavingsGoal404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateSavingsGoal404JSONResponse) VisitUpdateSavingsGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSavingsGoal500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateSavingsGoal500JSONResponse) VisitUpdateSavingsGoalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavingsContributionsRequestObject struct {
+	Id     string `json:"id"`
+	Params ListSavingsContributionsParams
+}
+
+type ListSavingsContributionsResponseObject interface {
+	VisitListSavingsContributionsResponse(w http.ResponseWriter) error
+}
+
+type ListSavingsContributions200JSONResponse []SavingsContribution
+
+func (response ListSavingsContributions200JSONResponse) VisitListSavingsContributionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavingsContributions401Response = N401Response
+
+func (response ListSavingsContributions401Response) VisitListSavingsContributionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListSavingsContributions404JSONResponse struct{ N404JSONResponse }
+
+func (response ListSavingsContributions404JSONResponse) VisitListSavingsContributionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavingsContributions500JSONResponse struct{ N500JSONResponse }
+
+func (response ListSavingsContributions500JSONResponse) VisitListSavingsContributionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSavingsContributionRequestObject struct {
+	Id   string `json:"id"`
+	Body *AddSavingsContributionJSONRequestBody
+}
+
+type AddSavingsContributionResponseObject interface {
+	VisitAddSavingsContributionResponse(w http.ResponseWriter) error
+}
+
+type AddSavingsContribution201JSONResponse SavingsContribution
+
+func (response AddSavingsContribution201JSONResponse) VisitAddSavingsContributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSavingsContribution400JSONResponse struct{ N400JSONResponse }
+
+func (response AddSavingsContribution400JSONResponse) VisitAddSavingsContributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSavingsContribution401Response = N401Response
+
+func (response AddSavingsContribution401Response) VisitAddSavingsContributionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AddSavingsContribution404JSONResponse struct{ N404JSONResponse }
+
+func (response AddSavingsContribution404JSONResponse) VisitAddSavingsContributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSavingsContribution500JSONResponse struct{ N500JSONResponse }
+
+func (response AddSavingsContribution500JSONResponse) VisitAddSavingsContributionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavingsProgressRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetSavingsProgressResponseObject interface {
+	VisitGetSavingsProgressResponse(w http.ResponseWriter) error
+}
+
+type GetSavingsProgress200JSONResponse SavingsProgress
+
+func (response GetSavingsProgress200JSONResponse) VisitGetSavingsProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavingsProgress401Response = N401Response
+
+func (response GetSavingsProgress401Response) VisitGetSavingsProgressResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetSavingsProgress404JSONResponse struct{ N404JSONResponse }
+
+func (response GetSavingsProgress404JSONResponse) VisitGetSavingsProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSavingsProgress500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSavingsProgress500JSONResponse) VisitGetSavingsProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavingsTransactionsRequestObject struct {
+	Id     string `json:"id"`
+	Params ListSavingsTransactionsParams
+}
+
+type ListSavingsTransactionsResponseObject interface {
+	VisitListSavingsTransactionsResponse(w http.ResponseWriter) error
+}
+
+type ListSavingsTransactions200JSONResponse SavingsTransactionList
+
+func (response ListSavingsTransactions200JSONResponse) VisitListSavingsTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavingsTransactions400JSONResponse struct{ N400JSONResponse }
+
+func (response ListSavingsTransactions400JSONResponse) VisitListSavingsTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavingsTransactions401Response = N401Response
+
+func (response ListSavingsTransactions401Response) VisitListSavingsTransactionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListSavingsTransactions404JSONResponse struct{ N404JSONResponse }
+
+func (response ListSavingsTransactions404JSONResponse) VisitListSavingsTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavingsTransactions500JSONResponse struct{ N500JSONResponse }
+
+func (response ListSavingsTransactions500JSONResponse) VisitListSavingsTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavingsWithdrawalsRequestObject struct {
+	Id     string `json:"id"`
+	Params ListSavingsWithdrawalsParams
+}
+
+type ListSavingsWithdrawalsResponseObject interface {
+	VisitListSavingsWithdrawalsResponse(w http.ResponseWriter) error
+}
+
+type ListSavingsWithdrawals200JSONResponse []SavingsWithdrawal
+
+func (response ListSavingsWithdrawals200JSONResponse) VisitListSavingsWithdrawalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavingsWithdrawals401Response = N401Response
+
+func (response ListSavingsWithdrawals401Response) VisitListSavingsWithdrawalsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListSavingsWithdrawals404JSONResponse struct{ N404JSONResponse }
+
+func (response ListSavingsWithdrawals404JSONResponse) VisitListSavingsWithdrawalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSavingsWithdrawals500JSONResponse struct{ N500JSONResponse }
+
+func (response ListSavingsWithdrawals500JSONResponse) VisitListSavingsWithdrawalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSavingsWithdrawalRequestObject struct {
+	Id   string `json:"id"`
+	Body *AddSavingsWithdrawalJSONRequestBody
+}
+
+type AddSavingsWithdrawalResponseObject interface {
+	VisitAddSavingsWithdrawalResponse(w http.ResponseWriter) error
+}
+
+type AddSavingsWithdrawal201JSONResponse SavingsWithdrawal
+
+func (response AddSavingsWithdrawal201JSONResponse) VisitAddSavingsWithdrawalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSavingsWithdrawal400JSONResponse struct{ N400JSONResponse }
+
+func (response AddSavingsWithdrawal400JSONResponse) VisitAddSavingsWithdrawalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSavingsWithdrawal401Response = N401Response
+
+func (response AddSavingsWithdrawal401Response) VisitAddSavingsWithdrawalResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AddSavingsWithdrawal404JSONResponse struct{ N404JSONResponse }
+
+func (response AddSavingsWithdrawal404JSONResponse) VisitAddSavingsWithdrawalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSavingsWithdrawal500JSONResponse struct{ N500JSONResponse }
+
+func (response AddSavingsWithdrawal500JSONResponse) VisitAddSavingsWithdrawalResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchRequestObject struct {
+	Params SearchParams
+}
+
+type SearchResponseObject interface {
+	VisitSearchResponse(w http.ResponseWriter) error
+}
+
+type Search200JSONResponse SearchResult
+
+func (response Search200JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Search400JSONResponse struct{ N400JSONResponse }
+
+func (response Search400JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Search401Response = N401Response
+
+func (response Search401Response) VisitSearchResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type Search500JSONResponse struct{ N500JSONResponse }
+
+func (response Search500JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTagsRequestObject struct {
+}
+
+type ListTagsResponseObject interface {
+	VisitListTagsResponse(w http.ResponseWriter) error
+}
+
+type ListTags200JSONResponse []Tag
+
+func (response ListTags200JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTags400JSONResponse struct{ N400JSONResponse }
+
+func (response ListTags400JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTags500JSONResponse struct{ N500JSONResponse }
+
+func (response ListTags500JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTagRequestObject struct {
+	Body *CreateTagJSONRequestBody
+}
+
+type CreateTagResponseObject interface {
+	VisitCreateTagResponse(w http.ResponseWriter) error
+}
+
+type CreateTag201JSONResponse Tag
+
+func (response CreateTag201JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTag400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateTag400JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTag409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateTag409JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTag500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateTag500JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTagsByTypeRequestObject struct {
+	Type TagType `json:"type"`
+}
+
+type ListTagsByTypeResponseObject interface {
+	VisitListTagsByTypeResponse(w http.ResponseWriter) error
+}
+
+type ListTagsByType200JSONResponse []Tag
+
+func (response ListTagsByType200JSONResponse) VisitListTagsByTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTagsByType400JSONResponse struct{ N400JSONResponse }
+
+func (response ListTagsByType400JSONResponse) VisitListTagsByTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTagsByType401Response = N401Response
+
+func (response ListTagsByType401Response) VisitListTagsByTypeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListTagsByType404JSONResponse struct{ N404JSONResponse }
+
+func (response ListTagsByType404JSONResponse) VisitListTagsByTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTagsByType500JSONResponse struct{ N500JSONResponse }
+
+func (response ListTagsByType500JSONResponse) VisitListTagsByTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRecordTagsRequestObject struct {
+	Type     TagType `json:"type"`
+	RecordId string  `json:"recordId"`
+}
+
+type ListRecordTagsResponseObject interface {
+	VisitListRecordTagsResponse(w http.ResponseWriter) error
+}
+
+type ListRecordTags200JSONResponse []Tag
+
+func (response ListRecordTags200JSONResponse) VisitListRecordTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRecordTags400JSONResponse struct{ N400JSONResponse }
+
+func (response ListRecordTags400JSONResponse) VisitListRecordTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRecordTags401Response = N401Response
+
+func (response ListRecordTags401Response) VisitListRecordTagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListRecordTags404JSONResponse struct{ N404JSONResponse }
+
+func (response ListRecordTags404JSONResponse) VisitListRecordTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRecordTags500JSONResponse struct{ N500JSONResponse }
+
+func (response ListRecordTags500JSONResponse) VisitListRecordTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddRecordTagsRequestObject struct {
+	Type     TagType `json:"type"`
+	RecordId string  `json:"recordId"`
+	Body     *AddRecordTagsJSONRequestBody
+}
+
+type AddRecordTagsResponseObject interface {
+	VisitAddRecordTagsResponse(w http.ResponseWriter) error
+}
+
+type AddRecordTags200JSONResponse []Tag
+
+func (response AddRecordTags200JSONResponse) VisitAddRecordTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddRecordTags400JSONResponse struct{ N400JSONResponse }
+
+func (response AddRecordTags400JSONResponse) VisitAddRecordTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddRecordTags401Response = N401Response
+
+func (response AddRecordTags401Response) VisitAddRecordTagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AddRecordTags404JSONResponse struct{ N404JSONResponse }
+
+func (response AddRecordTags404JSONResponse) VisitAddRecordTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddRecordTags500JSONResponse struct{ N500JSONResponse }
+
+func (response AddRecordTags500JSONResponse) VisitAddRecordTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRecordTagsRequestObject struct {
+	Type     TagType `json:"type"`
+	RecordId string  `json:"recordId"`
+	Body     *SetRecordTagsJSONRequestBody
+}
+
+type SetRecordTagsResponseObject interface {
+	VisitSetRecordTagsResponse(w http.ResponseWriter) error
+}
+
+type SetRecordTags200JSONResponse []Tag
+
+func (response SetRecordTags200JSONResponse) VisitSetRecordTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRecordTags400JSONResponse struct{ N400JSONResponse }
+
+func (response SetRecordTags400JSONResponse) VisitSetRecordTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRecordTags401Response = N401Response
+
+func (response SetRecordTags401Response) VisitSetRecordTagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SetRecordTags404JSONResponse struct{ N404JSONResponse }
+
+func (response SetRecordTags404JSONResponse) VisitSetRecordTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetRecordTags500JSONResponse struct{ N500JSONResponse }
+
+func (response SetRecordTags500JSONResponse) VisitSetRecordTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveRecordTagRequestObject struct {
+	Type     TagType `json:"type"`
+	RecordId string  `json:"recordId"`
+	TagId    string  `json:"tagId"`
+}
+
+type RemoveRecordTagResponseObject interface {
+	VisitRemoveRecordTagResponse(w http.ResponseWriter) error
+}
+
+type RemoveRecordTag204Response = N204Response
+
+func (response RemoveRecordTag204Response) VisitRemoveRecordTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveRecordTag400JSONResponse struct{ N400JSONResponse }
+
+func (response RemoveRecordTag400JSONResponse) VisitRemoveRecordTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveRecordTag401Response = N401Response
+
+func (response RemoveRecordTag401Response) VisitRemoveRecordTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RemoveRecordTag404JSONResponse struct{ N404JSONResponse }
+
+func (response RemoveRecordTag404JSONResponse) VisitRemoveRecordTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveRecordTag500JSONResponse struct{ N500JSONResponse }
+
+func (response RemoveRecordTag500JSONResponse) VisitRemoveRecordTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTagRequestObject struct {
+	Id string `json:"id"`
+}
+
+type DeleteTagResponseObject interface {
+	VisitDeleteTagResponse(w http.ResponseWriter) error
+}
+
+type DeleteTag204Response = N204Response
+
+func (response DeleteTag204Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTag400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteTag400JSONResponse) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTag401Response = N401Response
+
+func (response DeleteTag401Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteTag404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteTag404JSONResponse) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTag500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteTag500JSONResponse) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTagRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateTagJSONRequestBody
+}
+
+type UpdateTagResponseObject interface {
+	VisitUpdateTagResponse(w http.ResponseWriter) error
+}
+
+type UpdateTag200JSONResponse Tag
+
+func (response UpdateTag200JSONResponse) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTag400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateTag400JSONResponse) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTag401Response = N401Response
+
+func (response UpdateTag401Response) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateTag404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateTag404JSONResponse) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTag409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdateTag409JSONResponse) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTag500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateTag500JSONResponse) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeTagRequestObject struct {
+	Id   string `json:"id"`
+	Body *MergeTagJSONRequestBody
+}
+
+type MergeTagResponseObject interface {
+	VisitMergeTagResponse(w http.ResponseWriter) error
+}
+
+type MergeTag200JSONResponse TagMergeResult
+
+func (response MergeTag200JSONResponse) VisitMergeTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeTag400JSONResponse struct{ N400JSONResponse }
+
+func (response MergeTag400JSONResponse) VisitMergeTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeTag401Response = N401Response
+
+func (response MergeTag401Response) VisitMergeTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type MergeTag404JSONResponse struct{ N404JSONResponse }
+
+func (response MergeTag404JSONResponse) VisitMergeTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeTag500JSONResponse struct{ N500JSONResponse }
+
+func (response MergeTag500JSONResponse) VisitMergeTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTransactionsRequestObject struct {
+	Params ListTransactionsParams
+}
+
+type ListTransactionsResponseObject interface {
+	VisitListTransactionsResponse(w http.ResponseWriter) error
+}
+
+type ListTransactions200JSONResponse TransactionList
+
+func (response ListTransactions200JSONResponse) VisitListTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTransactions400JSONResponse Error
+
+func (response ListTransactions400JSONResponse) VisitListTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTransactions500JSONResponse Error
+
+func (response ListTransactions500JSONResponse) VisitListTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTransfersRequestObject struct {
+	Params ListTransfersParams
+}
+
+type ListTransfersResponseObject interface {
+	VisitListTransfersResponse(w http.ResponseWriter) error
+}
+
+type ListTransfers200JSONResponse []TransferList
+
+func (response ListTransfers200JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTransfers401Response = N401Response
+
+func (response ListTransfers401Response) VisitListTransfersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListTransfers500JSONResponse struct{ N500JSONResponse }
+
+func (response ListTransfers500JSONResponse) VisitListTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransferRequestObject struct {
+	Body *CreateTransferJSONRequestBody
+}
+
+type CreateTransferResponseObject interface {
+	VisitCreateTransferResponse(w http.ResponseWriter) error
+}
+
+type CreateTransfer201JSONResponse Transfer
+
+func (response CreateTransfer201JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransfer400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateTransfer400JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransfer401Response = N401Response
+
+func (response CreateTransfer401Response) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateTransfer500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateTransfer500JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransferRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetTransferResponseObject interface {
+	VisitGetTransferResponse(w http.ResponseWriter) error
+}
+
+type GetTransfer200JSONResponse Transfer
+
+func (response GetTransfer200JSONResponse) VisitGetTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfer401Response = N401Response
+
+func (response GetTransfer401Response) VisitGetTransferResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetTransfer404JSONResponse struct{ N404JSONResponse }
+
+func (response GetTransfer404JSONResponse) VisitGetTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfer500JSONResponse struct{ N500JSONResponse }
+
+func (response GetTransfer500JSONResponse) VisitGetTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackTransferRequestObject struct {
+	Id   string `json:"id"`
+	Body *RollbackTransferJSONRequestBody
+}
+
+type RollbackTransferResponseObject interface {
+	VisitRollbackTransferResponse(w http.ResponseWriter) error
+}
+
+type RollbackTransfer201Response struct {
+}
+
+func (response RollbackTransfer201Response) VisitRollbackTransferResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type RollbackTransfer404Response struct {
+}
+
+func (response RollbackTransfer404Response) VisitRollbackTransferResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RollbackTransfer409Response struct {
+}
+
+func (response RollbackTransfer409Response) VisitRollbackTransferResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type ListUpcomingBillsRequestObject struct {
+	Params ListUpcomingBillsParams
+}
+
+type ListUpcomingBillsResponseObject interface {
+	VisitListUpcomingBillsResponse(w http.ResponseWriter) error
+}
+
+type ListUpcomingBills200JSONResponse UpcomingBillList
+
+func (response ListUpcomingBills200JSONResponse) VisitListUpcomingBillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUpcomingBills400JSONResponse struct{ N400JSONResponse }
+
+func (response ListUpcomingBills400JSONResponse) VisitListUpcomingBillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUpcomingBills401Response = N401Response
+
+func (response ListUpcomingBills401Response) VisitListUpcomingBillsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListUpcomingBills500JSONResponse struct{ N500JSONResponse }
+
+func (response ListUpcomingBills500JSONResponse) VisitListUpcomingBillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsersRequestObject struct {
+}
+
+type ListUsersResponseObject interface {
+	VisitListUsersResponse(w http.ResponseWriter) error
+}
+
+type ListUsers200JSONResponse []User
+
+func (response ListUsers200JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsers401Response = N401Response
+
+func (response ListUsers401Response) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListUsers403JSONResponse struct{ N403JSONResponse }
+
+func (response ListUsers403JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsers500JSONResponse struct{ N500JSONResponse }
+
+func (response ListUsers500JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUserRequestObject struct {
+}
+
+type GetCurrentUserResponseObject interface {
+	VisitGetCurrentUserResponse(w http.ResponseWriter) error
+}
+
+type GetCurrentUser200JSONResponse User
+
+func (response GetCurrentUser200JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUser401Response = N401Response
+
+func (response GetCurrentUser401Response) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetCurrentUser500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCurrentUser500JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkCurrentUserMemberRequestObject struct {
+	Body *LinkCurrentUserMemberJSONRequestBody
+}
+
+type LinkCurrentUserMemberResponseObject interface {
+	VisitLinkCurrentUserMemberResponse(w http.ResponseWriter) error
+}
+
+type LinkCurrentUserMember200JSONResponse User
+
+func (response LinkCurrentUserMember200JSONResponse) VisitLinkCurrentUserMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkCurrentUserMember401Response = N401Response
+
+func (response LinkCurrentUserMember401Response) VisitLinkCurrentUserMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type LinkCurrentUserMember403JSONResponse struct{ N403JSONResponse }
+
+func (response LinkCurrentUserMember403JSONResponse) VisitLinkCurrentUserMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkCurrentUserMember404JSONResponse struct{ N404JSONResponse }
+
+func (response LinkCurrentUserMember404JSONResponse) VisitLinkCurrentUserMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkCurrentUserMember409JSONResponse struct{ N409JSONResponse }
+
+func (response LinkCurrentUserMember409JSONResponse) VisitLinkCurrentUserMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LinkCurrentUserMember500JSONResponse struct{ N500JSONResponse }
+
+func (response LinkCurrentUserMember500JSONResponse) VisitLinkCurrentUserMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserRolesRequestObject struct {
+	Id   string `json:"id"`
+	Body *SetUserRolesJSONRequestBody
+}
+
+type SetUserRolesResponseObject interface {
+	VisitSetUserRolesResponse(w http.ResponseWriter) error
+}
+
+type SetUserRoles200JSONResponse User
+
+func (response SetUserRoles200JSONResponse) VisitSetUserRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserRoles400JSONResponse struct{ N400JSONResponse }
+
+func (response SetUserRoles400JSONResponse) VisitSetUserRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserRoles401Response = N401Response
+
+func (response SetUserRoles401Response) VisitSetUserRolesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SetUserRoles403JSONResponse struct{ N403JSONResponse }
+
+func (response SetUserRoles403JSONResponse) VisitSetUserRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserRoles404JSONResponse struct{ N404JSONResponse }
+
+func (response SetUserRoles404JSONResponse) VisitSetUserRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserRoles409JSONResponse struct{ N409JSONResponse }
+
+func (response SetUserRoles409JSONResponse) VisitSetUserRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetUserRoles500JSONResponse struct{ N500JSONResponse }
+
+func (response SetUserRoles500JSONResponse) VisitSetUserRolesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List all accounts
+	// (GET /accounts)
+	ListAccounts(ctx context.Context, request ListAccountsRequestObject) (ListAccountsResponseObject, error)
+	// Create a new money account
+	// (POST /accounts)
+	CreateAccount(ctx context.Context, request CreateAccountRequestObject) (CreateAccountResponseObject, error)
+	// Delete account
+	// (DELETE /accounts/{id})
+	DeleteAccount(ctx context.Context, request DeleteAccountRequestObject) (DeleteAccountResponseObject, error)
+	// Get account by ID
+	// (GET /accounts/{id})
+	GetAccount(ctx context.Context, request GetAccountRequestObject) (GetAccountResponseObject, error)
+	// Update account
+	// (PUT /accounts/{id})
+	UpdateAccount(ctx context.Context, request UpdateAccountRequestObject) (UpdateAccountResponseObject, error)
+	// Activate an account
+	// (PATCH /accounts/{id}/activate)
+	ActivateAccount(ctx context.Context, request ActivateAccountRequestObject) (ActivateAccountResponseObject, error)
+	// Checks if account can be deleted
+	// (GET /accounts/{id}/can-delete)
+	CanDeleteAccount(ctx context.Context, request CanDeleteAccountRequestObject) (CanDeleteAccountResponseObject, error)
+	// Deactivate an account
+	// (PATCH /accounts/{id}/deactivate)
+	DeactivateAccount(ctx context.Context, request DeactivateAccountRequestObject) (DeactivateAccountResponseObject, error)
+	// List allocation plans
+	// (GET /allocation-plans)
+	ListAllocationPlans(ctx context.Context, request ListAllocationPlansRequestObject) (ListAllocationPlansResponseObject, error)
+	// Create an allocation plan
+	// (POST /allocation-plans)
+	CreateAllocationPlan(ctx context.Context, request CreateAllocationPlanRequestObject) (CreateAllocationPlanResponseObject, error)
+	// Delete allocation plan
+	// (DELETE /allocation-plans/{id})
+	DeleteAllocationPlan(ctx context.Context, request DeleteAllocationPlanRequestObject) (DeleteAllocationPlanResponseObject, error)
+	// Get allocation plan by ID
+	// (GET /allocation-plans/{id})
+	GetAllocationPlan(ctx context.Context, request GetAllocationPlanRequestObject) (GetAllocationPlanResponseObject, error)
+	// Update allocation plan
+	// (PUT /allocation-plans/{id})
+	UpdateAllocationPlan(ctx context.Context, request UpdateAllocationPlanRequestObject) (UpdateAllocationPlanResponseObject, error)
+	// Login to the system
+	// (POST /auth/login)
+	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
+	// Refresh authentication token
+	// (POST /auth/refresh)
+	RefreshToken(ctx context.Context, request RefreshTokenRequestObject) (RefreshTokenResponseObject, error)
+	// Register a new user
+	// (POST /auth/register)
+	RegisterUser(ctx context.Context, request RegisterUserRequestObject) (RegisterUserResponseObject, error)
+	// Get current balances
+	// (GET /balances)
+	GetBalances(ctx context.Context, request GetBalancesRequestObject) (GetBalancesResponseObject, error)
+	// Household budget overview
+	// (GET /budget-overview)
+	GetBudgetOverview(ctx context.Context, request GetBudgetOverviewRequestObject) (GetBudgetOverviewResponseObject, error)
+	// Copy the amounts of the last budget period
+	// (POST /budget-overview/copy-last-period)
+	CopyLastBudgetPeriod(ctx context.Context, request CopyLastBudgetPeriodRequestObject) (CopyLastBudgetPeriodResponseObject, error)
+	// List budgets
+	// (GET /budgets)
+	ListBudgets(ctx context.Context, request ListBudgetsRequestObject) (ListBudgetsResponseObject, error)
+	// Create a budget
+	// (POST /budgets)
+	CreateBudget(ctx context.Context, request CreateBudgetRequestObject) (CreateBudgetResponseObject, error)
+	// Delete budget
+	// (DELETE /budgets/{id})
+	DeleteBudget(ctx context.Context, request DeleteBudgetRequestObject) (DeleteBudgetResponseObject, error)
+	// Get budget by ID
+	// (GET /budgets/{id})
+	GetBudget(ctx context.Context, request GetBudgetRequestObject) (GetBudgetResponseObject, error)
+	// Update budget
+	// (PUT /budgets/{id})
+	UpdateBudget(ctx context.Context, request UpdateBudgetRequestObject) (UpdateBudgetResponseObject, error)
+	// Get the history of a budget
+	// (GET /budgets/{id}/history)
+	GetBudgetHistory(ctx context.Context, request GetBudgetHistoryRequestObject) (GetBudgetHistoryResponseObject, error)
+	// Set the amount of a budget period
+	// (PUT /budgets/{id}/periods/{number})
+	SetBudgetPeriodAmount(ctx context.Context, request SetBudgetPeriodAmountRequestObject) (SetBudgetPeriodAmountResponseObject, error)
+	// Get the status of a budget
+	// (GET /budgets/{id}/status)
+	GetBudgetStatus(ctx context.Context, request GetBudgetStatusRequestObject) (GetBudgetStatusResponseObject, error)
+	// List categories
+	// (GET /categories)
+	ListCategories(ctx context.Context, request ListCategoriesRequestObject) (ListCategoriesResponseObject, error)
+	// Create category
+	// (POST /categories)
+	CreateCategory(ctx context.Context, request CreateCategoryRequestObject) (CreateCategoryResponseObject, error)
+	// Delete category
+	// (DELETE /categories/{id})
+	DeleteCategory(ctx context.Context, request DeleteCategoryRequestObject) (DeleteCategoryResponseObject, error)
+	// Update category
+	// (PUT /categories/{id})
 	UpdateCategory(ctx context.Context, request UpdateCategoryRequestObject) (UpdateCategoryResponseObject, error)
 	// Activate a category
 	// (PATCH /categories/{id}/activate)
@@ -6682,6 +14598,36 @@ type StrictServerInterface interface {
 	// Deactivate a category
 	// (PATCH /categories/{id}/deactivate)
 	DeactivateCategory(ctx context.Context, request DeactivateCategoryRequestObject) (DeactivateCategoryResponseObject, error)
+	// Merge a category into another one
+	// (POST /categories/{id}/merge)
+	MergeCategory(ctx context.Context, request MergeCategoryRequestObject) (MergeCategoryResponseObject, error)
+	// List categorization rules
+	// (GET /categorization-rules)
+	ListCategorizationRules(ctx context.Context, request ListCategorizationRulesRequestObject) (ListCategorizationRulesResponseObject, error)
+	// Create a categorization rule
+	// (POST /categorization-rules)
+	CreateCategorizationRule(ctx context.Context, request CreateCategorizationRuleRequestObject) (CreateCategorizationRuleResponseObject, error)
+	// Run the categorization rules on past expenditures
+	// (POST /categorization-rules/apply)
+	ApplyCategorizationRules(ctx context.Context, request ApplyCategorizationRulesRequestObject) (ApplyCategorizationRulesResponseObject, error)
+	// Test a categorization rule against past expenditures
+	// (POST /categorization-rules/preview)
+	PreviewCategorizationRule(ctx context.Context, request PreviewCategorizationRuleRequestObject) (PreviewCategorizationRuleResponseObject, error)
+	// Delete categorization rule
+	// (DELETE /categorization-rules/{id})
+	DeleteCategorizationRule(ctx context.Context, request DeleteCategorizationRuleRequestObject) (DeleteCategorizationRuleResponseObject, error)
+	// Get categorization rule by ID
+	// (GET /categorization-rules/{id})
+	GetCategorizationRule(ctx context.Context, request GetCategorizationRuleRequestObject) (GetCategorizationRuleResponseObject, error)
+	// Update categorization rule
+	// (PUT /categorization-rules/{id})
+	UpdateCategorizationRule(ctx context.Context, request UpdateCategorizationRuleRequestObject) (UpdateCategorizationRuleResponseObject, error)
+	// Suggest a category and tags for a description
+	// (GET /category-suggestions)
+	SuggestCategory(ctx context.Context, request SuggestCategoryRequestObject) (SuggestCategoryResponseObject, error)
+	// Retrain the category suggestion model
+	// (POST /category-suggestions/retrain)
+	RetrainCategorySuggestions(ctx context.Context, request RetrainCategorySuggestionsRequestObject) (RetrainCategorySuggestionsResponseObject, error)
 	// Get current exchange rates
 	// (GET /exchange-rates)
 	GetExchangeRates(ctx context.Context, request GetExchangeRatesRequestObject) (GetExchangeRatesResponseObject, error)
@@ -6691,9 +14637,18 @@ type StrictServerInterface interface {
 	// Create a new expenditure
 	// (POST /expenditures)
 	CreateExpenditure(ctx context.Context, request CreateExpenditureRequestObject) (CreateExpenditureResponseObject, error)
+	// Create expenditures in bulk
+	// (POST /expenditures/batch)
+	CreateExpenditureBatch(ctx context.Context, request CreateExpenditureBatchRequestObject) (CreateExpenditureBatchResponseObject, error)
 	// Get expenditure by ID
 	// (GET /expenditures/{id})
 	GetExpenditure(ctx context.Context, request GetExpenditureRequestObject) (GetExpenditureResponseObject, error)
+	// List the refunds of an expenditure
+	// (GET /expenditures/{id}/refunds)
+	ListExpenditureRefunds(ctx context.Context, request ListExpenditureRefundsRequestObject) (ListExpenditureRefundsResponseObject, error)
+	// Refund an expenditure
+	// (POST /expenditures/{id}/refunds)
+	RefundExpenditure(ctx context.Context, request RefundExpenditureRequestObject) (RefundExpenditureResponseObject, error)
 	// Rollback an expenditure
 	// (POST /expenditures/{id}/rollback)
 	RollbackExpenditure(ctx context.Context, request RollbackExpenditureRequestObject) (RollbackExpenditureResponseObject, error)
@@ -6718,6 +14673,12 @@ type StrictServerInterface interface {
 	// Deactivate household member by ID
 	// (PATCH /household-members/{id}/deactivate)
 	DeactivateHouseholdMember(ctx context.Context, request DeactivateHouseholdMemberRequestObject) (DeactivateHouseholdMemberResponseObject, error)
+	// Create a new household
+	// (POST /households)
+	CreateHousehold(ctx context.Context, request CreateHouseholdRequestObject) (CreateHouseholdResponseObject, error)
+	// Get the current household
+	// (GET /households/current)
+	GetCurrentHousehold(ctx context.Context, request GetCurrentHouseholdRequestObject) (GetCurrentHouseholdResponseObject, error)
 	// List all ingresses
 	// (GET /ingresses)
 	ListIngresses(ctx context.Context, request ListIngressesRequestObject) (ListIngressesResponseObject, error)
@@ -6739,9 +14700,87 @@ type StrictServerInterface interface {
 	// Rollback an Ingress
 	// (POST /ingresses/{id}/rollback)
 	RollbackIngress(ctx context.Context, request RollbackIngressRequestObject) (RollbackIngressResponseObject, error)
+	// List remaining installment obligations
+	// (GET /installment-obligations)
+	ListInstallmentObligations(ctx context.Context, request ListInstallmentObligationsRequestObject) (ListInstallmentObligationsResponseObject, error)
+	// List installment plans
+	// (GET /installment-plans)
+	ListInstallmentPlans(ctx context.Context, request ListInstallmentPlansRequestObject) (ListInstallmentPlansResponseObject, error)
+	// Create an installment plan
+	// (POST /installment-plans)
+	CreateInstallmentPlan(ctx context.Context, request CreateInstallmentPlanRequestObject) (CreateInstallmentPlanResponseObject, error)
+	// Get an installment plan
+	// (GET /installment-plans/{id})
+	GetInstallmentPlan(ctx context.Context, request GetInstallmentPlanRequestObject) (GetInstallmentPlanResponseObject, error)
+	// Pay off an installment plan early
+	// (POST /installment-plans/{id}/payoff)
+	PayOffInstallmentPlan(ctx context.Context, request PayOffInstallmentPlanRequestObject) (PayOffInstallmentPlanResponseObject, error)
+	// Invite someone to the household
+	// (POST /invitations)
+	CreateInvitation(ctx context.Context, request CreateInvitationRequestObject) (CreateInvitationResponseObject, error)
+	// Accept an invitation
+	// (POST /invitations/accept)
+	AcceptInvitation(ctx context.Context, request AcceptInvitationRequestObject) (AcceptInvitationResponseObject, error)
+	// Join a household through an invitation
+	// (POST /invitations/join)
+	JoinHousehold(ctx context.Context, request JoinHouseholdRequestObject) (JoinHouseholdResponseObject, error)
+	// List payees
+	// (GET /payees)
+	ListPayees(ctx context.Context, request ListPayeesRequestObject) (ListPayeesResponseObject, error)
+	// Create a payee
+	// (POST /payees)
+	CreatePayee(ctx context.Context, request CreatePayeeRequestObject) (CreatePayeeResponseObject, error)
+	// Delete payee
+	// (DELETE /payees/{id})
+	DeletePayee(ctx context.Context, request DeletePayeeRequestObject) (DeletePayeeResponseObject, error)
+	// Get payee by ID
+	// (GET /payees/{id})
+	GetPayee(ctx context.Context, request GetPayeeRequestObject) (GetPayeeResponseObject, error)
+	// Update payee
+	// (PUT /payees/{id})
+	UpdatePayee(ctx context.Context, request UpdatePayeeRequestObject) (UpdatePayeeResponseObject, error)
 	// Create a recurrence pattern for Ingresses
 	// (POST /recurrence-pattern)
 	CreateIngressRecurrencePattern(ctx context.Context, request CreateIngressRecurrencePatternRequestObject) (CreateIngressRecurrencePatternResponseObject, error)
+	// List recurring bills
+	// (GET /recurring-bills)
+	ListExpenditureRecurrencePatterns(ctx context.Context, request ListExpenditureRecurrencePatternsRequestObject) (ListExpenditureRecurrencePatternsResponseObject, error)
+	// Create a recurring bill
+	// (POST /recurring-bills)
+	CreateExpenditureRecurrencePattern(ctx context.Context, request CreateExpenditureRecurrencePatternRequestObject) (CreateExpenditureRecurrencePatternResponseObject, error)
+	// Delete recurring bill
+	// (DELETE /recurring-bills/{id})
+	DeleteExpenditureRecurrencePattern(ctx context.Context, request DeleteExpenditureRecurrencePatternRequestObject) (DeleteExpenditureRecurrencePatternResponseObject, error)
+	// Get recurring bill by ID
+	// (GET /recurring-bills/{id})
+	GetExpenditureRecurrencePattern(ctx context.Context, request GetExpenditureRecurrencePatternRequestObject) (GetExpenditureRecurrencePatternResponseObject, error)
+	// Update recurring bill
+	// (PUT /recurring-bills/{id})
+	UpdateExpenditureRecurrencePattern(ctx context.Context, request UpdateExpenditureRecurrencePatternRequestObject) (UpdateExpenditureRecurrencePatternResponseObject, error)
+	// Post the next bill
+	// (POST /recurring-bills/{id}/post)
+	PostExpenditureRecurrencePattern(ctx context.Context, request PostExpenditureRecurrencePatternRequestObject) (PostExpenditureRecurrencePatternResponseObject, error)
+	// Declared expenses report
+	// (GET /reports/declared-expenses)
+	GetDeclaredExpensesReport(ctx context.Context, request GetDeclaredExpensesReportRequestObject) (GetDeclaredExpensesReportResponseObject, error)
+	// Spending per payee report
+	// (GET /reports/payee-spending)
+	GetPayeeSpendingReport(ctx context.Context, request GetPayeeSpendingReportRequestObject) (GetPayeeSpendingReportResponseObject, error)
+	// Planned vs unplanned spending report
+	// (GET /reports/planned-spending)
+	GetPlannedSpendingReport(ctx context.Context, request GetPlannedSpendingReportRequestObject) (GetPlannedSpendingReportResponseObject, error)
+	// Tag combination report
+	// (GET /reports/tag-combination)
+	GetTagCombinationReport(ctx context.Context, request GetTagCombinationReportRequestObject) (GetTagCombinationReportResponseObject, error)
+	// Spending per tag report
+	// (GET /reports/tag-spending)
+	GetTagSpendingReport(ctx context.Context, request GetTagSpendingReportRequestObject) (GetTagSpendingReportResponseObject, error)
+	// Tag spending per category report
+	// (GET /reports/tag-spending/{tagId}/categories)
+	GetTagCategoryReport(ctx context.Context, request GetTagCategoryReportRequestObject) (GetTagCategoryReportResponseObject, error)
+	// List roles
+	// (GET /roles)
+	ListRoles(ctx context.Context, request ListRolesRequestObject) (ListRolesResponseObject, error)
 	// List all savings goals
 	// (GET /savings)
 	ListSavingsGoals(ctx context.Context, request ListSavingsGoalsRequestObject) (ListSavingsGoalsResponseObject, error)
@@ -6775,6 +14814,9 @@ type StrictServerInterface interface {
 	// Add withdrawal from savings goal
 	// (POST /savings/{id}/withdrawals)
 	AddSavingsWithdrawal(ctx context.Context, request AddSavingsWithdrawalRequestObject) (AddSavingsWithdrawalResponseObject, error)
+	// Search transactions
+	// (GET /search)
+	Search(ctx context.Context, request SearchRequestObject) (SearchResponseObject, error)
 	// List tags
 	// (GET /tags)
 	ListTags(ctx context.Context, request ListTagsRequestObject) (ListTagsResponseObject, error)
@@ -6784,9 +14826,27 @@ type StrictServerInterface interface {
 	// List tags by type
 	// (GET /tags/type/{type})
 	ListTagsByType(ctx context.Context, request ListTagsByTypeRequestObject) (ListTagsByTypeResponseObject, error)
+	// List the tags of a record
+	// (GET /tags/type/{type}/records/{recordId})
+	ListRecordTags(ctx context.Context, request ListRecordTagsRequestObject) (ListRecordTagsResponseObject, error)
+	// Add tags to a record
+	// (POST /tags/type/{type}/records/{recordId})
+	AddRecordTags(ctx context.Context, request AddRecordTagsRequestObject) (AddRecordTagsResponseObject, error)
+	// Replace the tags of a record
+	// (PUT /tags/type/{type}/records/{recordId})
+	SetRecordTags(ctx context.Context, request SetRecordTagsRequestObject) (SetRecordTagsResponseObject, error)
+	// Remove a tag from a record
+	// (DELETE /tags/type/{type}/records/{recordId}/{tagId})
+	RemoveRecordTag(ctx context.Context, request RemoveRecordTagRequestObject) (RemoveRecordTagResponseObject, error)
 	// Delete tag
 	// (DELETE /tags/{id})
 	DeleteTag(ctx context.Context, request DeleteTagRequestObject) (DeleteTagResponseObject, error)
+	// Update tag
+	// (PUT /tags/{id})
+	UpdateTag(ctx context.Context, request UpdateTagRequestObject) (UpdateTagResponseObject, error)
+	// Merge a tag into another one
+	// (POST /tags/{id}/merge)
+	MergeTag(ctx context.Context, request MergeTagRequestObject) (MergeTagResponseObject, error)
 	// List all transactions
 	// (GET /transactions)
 	ListTransactions(ctx context.Context, request ListTransactionsRequestObject) (ListTransactionsResponseObject, error)
@@ -6802,56 +14862,1971 @@ type StrictServerInterface interface {
 	// Rollback a Transfer
 	// (POST /transfers/{id}/rollback)
 	RollbackTransfer(ctx context.Context, request RollbackTransferRequestObject) (RollbackTransferResponseObject, error)
+	// List upcoming bills
+	// (GET /upcoming-bills)
+	ListUpcomingBills(ctx context.Context, request ListUpcomingBillsRequestObject) (ListUpcomingBillsResponseObject, error)
+	// List the users of the household
+	// (GET /users)
+	ListUsers(ctx context.Context, request ListUsersRequestObject) (ListUsersResponseObject, error)
+	// Get the authenticated user
+	// (GET /users/me)
+	GetCurrentUser(ctx context.Context, request GetCurrentUserRequestObject) (GetCurrentUserResponseObject, error)
+	// Link the authenticated user to a household member
+	// (PUT /users/me/household-member)
+	LinkCurrentUserMember(ctx context.Context, request LinkCurrentUserMemberRequestObject) (LinkCurrentUserMemberResponseObject, error)
+	// Set the roles of a user
+	// (PUT /users/{id}/roles)
+	SetUserRoles(ctx context.Context, request SetUserRolesRequestObject) (SetUserRolesResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListAccounts operation middleware
+func (sh *strictHandler) ListAccounts(w http.ResponseWriter, r *http.Request, params ListAccountsParams) {
+	var request ListAccountsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAccounts(ctx, request.(ListAccountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAccounts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAccountsResponseObject); ok {
+		if err := validResponse.VisitListAccountsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAccount operation middleware
+func (sh *strictHandler) CreateAccount(w http.ResponseWriter, r *http.Request) {
+	var request CreateAccountRequestObject
+
+	var body CreateAccountJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAccount(ctx, request.(CreateAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAccountResponseObject); ok {
+		if err := validResponse.VisitCreateAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAccount operation middleware
+func (sh *strictHandler) DeleteAccount(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteAccountRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAccount(ctx, request.(DeleteAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAccountResponseObject); ok {
+		if err := validResponse.VisitDeleteAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAccount operation middleware
+func (sh *strictHandler) GetAccount(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetAccountRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAccount(ctx, request.(GetAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAccountResponseObject); ok {
+		if err := validResponse.VisitGetAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateAccount operation middleware
+func (sh *strictHandler) UpdateAccount(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateAccountRequestObject
+
+	request.Id = id
+
+	var body UpdateAccountJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAccount(ctx, request.(UpdateAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateAccountResponseObject); ok {
+		if err := validResponse.VisitUpdateAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ActivateAccount operation middleware
+func (sh *strictHandler) ActivateAccount(w http.ResponseWriter, r *http.Request, id string) {
+	var request ActivateAccountRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ActivateAccount(ctx, request.(ActivateAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ActivateAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ActivateAccountResponseObject); ok {
+		if err := validResponse.VisitActivateAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CanDeleteAccount operation middleware
+func (sh *strictHandler) CanDeleteAccount(w http.ResponseWriter, r *http.Request, id string) {
+	var request CanDeleteAccountRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CanDeleteAccount(ctx, request.(CanDeleteAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CanDeleteAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CanDeleteAccountResponseObject); ok {
+		if err := validResponse.VisitCanDeleteAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeactivateAccount operation middleware
+func (sh *strictHandler) DeactivateAccount(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeactivateAccountRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeactivateAccount(ctx, request.(DeactivateAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeactivateAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeactivateAccountResponseObject); ok {
+		if err := validResponse.VisitDeactivateAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAllocationPlans operation middleware
+func (sh *strictHandler) ListAllocationPlans(w http.ResponseWriter, r *http.Request, params ListAllocationPlansParams) {
+	var request ListAllocationPlansRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAllocationPlans(ctx, request.(ListAllocationPlansRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAllocationPlans")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAllocationPlansResponseObject); ok {
+		if err := validResponse.VisitListAllocationPlansResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAllocationPlan operation middleware
+func (sh *strictHandler) CreateAllocationPlan(w http.ResponseWriter, r *http.Request) {
+	var request CreateAllocationPlanRequestObject
+
+	var body CreateAllocationPlanJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAllocationPlan(ctx, request.(CreateAllocationPlanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAllocationPlan")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAllocationPlanResponseObject); ok {
+		if err := validResponse.VisitCreateAllocationPlanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAllocationPlan operation middleware
+func (sh *strictHandler) DeleteAllocationPlan(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteAllocationPlanRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAllocationPlan(ctx, request.(DeleteAllocationPlanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAllocationPlan")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAllocationPlanResponseObject); ok {
+		if err := validResponse.VisitDeleteAllocationPlanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAllocationPlan operation middleware
+func (sh *strictHandler) GetAllocationPlan(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetAllocationPlanRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAllocationPlan(ctx, request.(GetAllocationPlanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAllocationPlan")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAllocationPlanResponseObject); ok {
+		if err := validResponse.VisitGetAllocationPlanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateAllocationPlan operation middleware
+func (sh *strictHandler) UpdateAllocationPlan(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateAllocationPlanRequestObject
+
+	request.Id = id
+
+	var body UpdateAllocationPlanJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAllocationPlan(ctx, request.(UpdateAllocationPlanRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAllocationPlan")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateAllocationPlanResponseObject); ok {
+		if err := validResponse.VisitUpdateAllocationPlanResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Login operation middleware
+func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var request LoginRequestObject
+
+	var body LoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Login")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoginResponseObject); ok {
+		if err := validResponse.VisitLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RefreshToken operation middleware
+func (sh *strictHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	var request RefreshTokenRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RefreshToken(ctx, request.(RefreshTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RefreshToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RefreshTokenResponseObject); ok {
+		if err := validResponse.VisitRefreshTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RegisterUser operation middleware
+func (sh *strictHandler) RegisterUser(w http.ResponseWriter, r *http.Request) {
+	var request RegisterUserRequestObject
+
+	var body RegisterUserJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RegisterUser(ctx, request.(RegisterUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RegisterUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RegisterUserResponseObject); ok {
+		if err := validResponse.VisitRegisterUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBalances operation middleware
+func (sh *strictHandler) GetBalances(w http.ResponseWriter, r *http.Request, params GetBalancesParams) {
+	var request GetBalancesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBalances(ctx, request.(GetBalancesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBalances")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBalancesResponseObject); ok {
+		if err := validResponse.VisitGetBalancesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBudgetOverview operation middleware
+func (sh *strictHandler) GetBudgetOverview(w http.ResponseWriter, r *http.Request, params GetBudgetOverviewParams) {
+	var request GetBudgetOverviewRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBudgetOverview(ctx, request.(GetBudgetOverviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBudgetOverview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBudgetOverviewResponseObject); ok {
+		if err := validResponse.VisitGetBudgetOverviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CopyLastBudgetPeriod operation middleware
+func (sh *strictHandler) CopyLastBudgetPeriod(w http.ResponseWriter, r *http.Request, params CopyLastBudgetPeriodParams) {
+	var request CopyLastBudgetPeriodRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CopyLastBudgetPeriod(ctx, request.(CopyLastBudgetPeriodRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CopyLastBudgetPeriod")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CopyLastBudgetPeriodResponseObject); ok {
+		if err := validResponse.VisitCopyLastBudgetPeriodResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListBudgets operation middleware
+func (sh *strictHandler) ListBudgets(w http.ResponseWriter, r *http.Request, params ListBudgetsParams) {
+	var request ListBudgetsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListBudgets(ctx, request.(ListBudgetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListBudgets")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListBudgetsResponseObject); ok {
+		if err := validResponse.VisitListBudgetsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateBudget operation middleware
+func (sh *strictHandler) CreateBudget(w http.ResponseWriter, r *http.Request) {
+	var request CreateBudgetRequestObject
+
+	var body CreateBudgetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateBudget(ctx, request.(CreateBudgetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateBudget")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateBudgetResponseObject); ok {
+		if err := validResponse.VisitCreateBudgetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteBudget operation middleware
+func (sh *strictHandler) DeleteBudget(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteBudgetRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBudget(ctx, request.(DeleteBudgetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBudget")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteBudgetResponseObject); ok {
+		if err := validResponse.VisitDeleteBudgetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBudget operation middleware
+func (sh *strictHandler) GetBudget(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetBudgetRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBudget(ctx, request.(GetBudgetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBudget")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBudgetResponseObject); ok {
+		if err := validResponse.VisitGetBudgetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateBudget operation middleware
+func (sh *strictHandler) UpdateBudget(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateBudgetRequestObject
+
+	request.Id = id
+
+	var body UpdateBudgetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateBudget(ctx, request.(UpdateBudgetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateBudget")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateBudgetResponseObject); ok {
+		if err := validResponse.VisitUpdateBudgetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBudgetHistory operation middleware
+func (sh *strictHandler) GetBudgetHistory(w http.ResponseWriter, r *http.Request, id string, params GetBudgetHistoryParams) {
+	var request GetBudgetHistoryRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBudgetHistory(ctx, request.(GetBudgetHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBudgetHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBudgetHistoryResponseObject); ok {
+		if err := validResponse.VisitGetBudgetHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetBudgetPeriodAmount operation middleware
+func (sh *strictHandler) SetBudgetPeriodAmount(w http.ResponseWriter, r *http.Request, id string, number int) {
+	var request SetBudgetPeriodAmountRequestObject
+
+	request.Id = id
+	request.Number = number
+
+	var body SetBudgetPeriodAmountJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetBudgetPeriodAmount(ctx, request.(SetBudgetPeriodAmountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetBudgetPeriodAmount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetBudgetPeriodAmountResponseObject); ok {
+		if err := validResponse.VisitSetBudgetPeriodAmountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBudgetStatus operation middleware
+func (sh *strictHandler) GetBudgetStatus(w http.ResponseWriter, r *http.Request, id string, params GetBudgetStatusParams) {
+	var request GetBudgetStatusRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBudgetStatus(ctx, request.(GetBudgetStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBudgetStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBudgetStatusResponseObject); ok {
+		if err := validResponse.VisitGetBudgetStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCategories operation middleware
+func (sh *strictHandler) ListCategories(w http.ResponseWriter, r *http.Request, params ListCategoriesParams) {
+	var request ListCategoriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCategories(ctx, request.(ListCategoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCategories")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCategoriesResponseObject); ok {
+		if err := validResponse.VisitListCategoriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCategory operation middleware
+func (sh *strictHandler) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	var request CreateCategoryRequestObject
+
+	var body CreateCategoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCategory(ctx, request.(CreateCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCategoryResponseObject); ok {
+		if err := validResponse.VisitCreateCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCategory operation middleware
+func (sh *strictHandler) DeleteCategory(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteCategoryRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCategory(ctx, request.(DeleteCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCategoryResponseObject); ok {
+		if err := validResponse.VisitDeleteCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCategory operation middleware
+func (sh *strictHandler) UpdateCategory(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateCategoryRequestObject
+
+	request.Id = id
+
+	var body UpdateCategoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCategory(ctx, request.(UpdateCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCategoryResponseObject); ok {
+		if err := validResponse.VisitUpdateCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ActivateCategory operation middleware
+func (sh *strictHandler) ActivateCategory(w http.ResponseWriter, r *http.Request, id string) {
+	var request ActivateCategoryRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ActivateCategory(ctx, request.(ActivateCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ActivateCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ActivateCategoryResponseObject); ok {
+		if err := validResponse.VisitActivateCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeactivateCategory operation middleware
+func (sh *strictHandler) DeactivateCategory(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeactivateCategoryRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeactivateCategory(ctx, request.(DeactivateCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeactivateCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeactivateCategoryResponseObject); ok {
+		if err := validResponse.VisitDeactivateCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MergeCategory operation middleware
+func (sh *strictHandler) MergeCategory(w http.ResponseWriter, r *http.Request, id string) {
+	var request MergeCategoryRequestObject
+
+	request.Id = id
+
+	var body MergeCategoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MergeCategory(ctx, request.(MergeCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MergeCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MergeCategoryResponseObject); ok {
+		if err := validResponse.VisitMergeCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCategorizationRules operation middleware
+func (sh *strictHandler) ListCategorizationRules(w http.ResponseWriter, r *http.Request, params ListCategorizationRulesParams) {
+	var request ListCategorizationRulesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCategorizationRules(ctx, request.(ListCategorizationRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCategorizationRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCategorizationRulesResponseObject); ok {
+		if err := validResponse.VisitListCategorizationRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCategorizationRule operation middleware
+func (sh *strictHandler) CreateCategorizationRule(w http.ResponseWriter, r *http.Request) {
+	var request CreateCategorizationRuleRequestObject
+
+	var body CreateCategorizationRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCategorizationRule(ctx, request.(CreateCategorizationRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCategorizationRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCategorizationRuleResponseObject); ok {
+		if err := validResponse.VisitCreateCategorizationRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApplyCategorizationRules operation middleware
+func (sh *strictHandler) ApplyCategorizationRules(w http.ResponseWriter, r *http.Request) {
+	var request ApplyCategorizationRulesRequestObject
+
+	var body ApplyCategorizationRulesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApplyCategorizationRules(ctx, request.(ApplyCategorizationRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApplyCategorizationRules")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApplyCategorizationRulesResponseObject); ok {
+		if err := validResponse.VisitApplyCategorizationRulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PreviewCategorizationRule operation middleware
+func (sh *strictHandler) PreviewCategorizationRule(w http.ResponseWriter, r *http.Request) {
+	var request PreviewCategorizationRuleRequestObject
+
+	var body PreviewCategorizationRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PreviewCategorizationRule(ctx, request.(PreviewCategorizationRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PreviewCategorizationRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PreviewCategorizationRuleResponseObject); ok {
+		if err := validResponse.VisitPreviewCategorizationRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCategorizationRule operation middleware
+func (sh *strictHandler) DeleteCategorizationRule(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteCategorizationRuleRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCategorizationRule(ctx, request.(DeleteCategorizationRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCategorizationRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCategorizationRuleResponseObject); ok {
+		if err := validResponse.VisitDeleteCategorizationRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCategorizationRule operation middleware
+func (sh *strictHandler) GetCategorizationRule(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetCategorizationRuleRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCategorizationRule(ctx, request.(GetCategorizationRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCategorizationRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCategorizationRuleResponseObject); ok {
+		if err := validResponse.VisitGetCategorizationRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCategorizationRule operation middleware
+func (sh *strictHandler) UpdateCategorizationRule(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateCategorizationRuleRequestObject
+
+	request.Id = id
+
+	var body UpdateCategorizationRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCategorizationRule(ctx, request.(UpdateCategorizationRuleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCategorizationRule")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCategorizationRuleResponseObject); ok {
+		if err := validResponse.VisitUpdateCategorizationRuleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SuggestCategory operation middleware
+func (sh *strictHandler) SuggestCategory(w http.ResponseWriter, r *http.Request, params SuggestCategoryParams) {
+	var request SuggestCategoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SuggestCategory(ctx, request.(SuggestCategoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SuggestCategory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SuggestCategoryResponseObject); ok {
+		if err := validResponse.VisitSuggestCategoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RetrainCategorySuggestions operation middleware
+func (sh *strictHandler) RetrainCategorySuggestions(w http.ResponseWriter, r *http.Request) {
+	var request RetrainCategorySuggestionsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RetrainCategorySuggestions(ctx, request.(RetrainCategorySuggestionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RetrainCategorySuggestions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RetrainCategorySuggestionsResponseObject); ok {
+		if err := validResponse.VisitRetrainCategorySuggestionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExchangeRates operation middleware
+func (sh *strictHandler) GetExchangeRates(w http.ResponseWriter, r *http.Request, params GetExchangeRatesParams) {
+	var request GetExchangeRatesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExchangeRates(ctx, request.(GetExchangeRatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExchangeRates")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetExchangeRatesResponseObject); ok {
+		if err := validResponse.VisitGetExchangeRatesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListExpenditures operation middleware
+func (sh *strictHandler) ListExpenditures(w http.ResponseWriter, r *http.Request, params ListExpendituresParams) {
+	var request ListExpendituresRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListExpenditures(ctx, request.(ListExpendituresRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListExpenditures")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListExpendituresResponseObject); ok {
+		if err := validResponse.VisitListExpendituresResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateExpenditure operation middleware
+func (sh *strictHandler) CreateExpenditure(w http.ResponseWriter, r *http.Request, params CreateExpenditureParams) {
+	var request CreateExpenditureRequestObject
+
+	request.Params = params
+
+	var body CreateExpenditureJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateExpenditure(ctx, request.(CreateExpenditureRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateExpenditure")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateExpenditureResponseObject); ok {
+		if err := validResponse.VisitCreateExpenditureResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateExpenditureBatch operation middleware
+func (sh *strictHandler) CreateExpenditureBatch(w http.ResponseWriter, r *http.Request, params CreateExpenditureBatchParams) {
+	var request CreateExpenditureBatchRequestObject
+
+	request.Params = params
+
+	var body CreateExpenditureBatchJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateExpenditureBatch(ctx, request.(CreateExpenditureBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateExpenditureBatch")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateExpenditureBatchResponseObject); ok {
+		if err := validResponse.VisitCreateExpenditureBatchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExpenditure operation middleware
+func (sh *strictHandler) GetExpenditure(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetExpenditureRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExpenditure(ctx, request.(GetExpenditureRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExpenditure")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetExpenditureResponseObject); ok {
+		if err := validResponse.VisitGetExpenditureResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListExpenditureRefunds operation middleware
+func (sh *strictHandler) ListExpenditureRefunds(w http.ResponseWriter, r *http.Request, id string) {
+	var request ListExpenditureRefundsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListExpenditureRefunds(ctx, request.(ListExpenditureRefundsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListExpenditureRefunds")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListExpenditureRefundsResponseObject); ok {
+		if err := validResponse.VisitListExpenditureRefundsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RefundExpenditure operation middleware
+func (sh *strictHandler) RefundExpenditure(w http.ResponseWriter, r *http.Request, id string) {
+	var request RefundExpenditureRequestObject
+
+	request.Id = id
+
+	var body RefundExpenditureJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RefundExpenditure(ctx, request.(RefundExpenditureRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RefundExpenditure")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RefundExpenditureResponseObject); ok {
+		if err := validResponse.VisitRefundExpenditureResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RollbackExpenditure operation middleware
+func (sh *strictHandler) RollbackExpenditure(w http.ResponseWriter, r *http.Request, id string) {
+	var request RollbackExpenditureRequestObject
+
+	request.Id = id
+
+	var body RollbackExpenditureJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RollbackExpenditure(ctx, request.(RollbackExpenditureRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RollbackExpenditure")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RollbackExpenditureResponseObject); ok {
+		if err := validResponse.VisitRollbackExpenditureResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListHouseholdMembers operation middleware
+func (sh *strictHandler) ListHouseholdMembers(w http.ResponseWriter, r *http.Request, params ListHouseholdMembersParams) {
+	var request ListHouseholdMembersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListHouseholdMembers(ctx, request.(ListHouseholdMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListHouseholdMembers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListHouseholdMembersResponseObject); ok {
+		if err := validResponse.VisitListHouseholdMembersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateHouseholdMember operation middleware
+func (sh *strictHandler) CreateHouseholdMember(w http.ResponseWriter, r *http.Request) {
+	var request CreateHouseholdMemberRequestObject
+
+	var body CreateHouseholdMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateHouseholdMember(ctx, request.(CreateHouseholdMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateHouseholdMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateHouseholdMemberResponseObject); ok {
+		if err := validResponse.VisitCreateHouseholdMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteHouseholdMember operation middleware
+func (sh *strictHandler) DeleteHouseholdMember(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteHouseholdMemberRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteHouseholdMember(ctx, request.(DeleteHouseholdMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteHouseholdMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteHouseholdMemberResponseObject); ok {
+		if err := validResponse.VisitDeleteHouseholdMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetHouseholdMember operation middleware
+func (sh *strictHandler) GetHouseholdMember(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetHouseholdMemberRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHouseholdMember(ctx, request.(GetHouseholdMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHouseholdMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHouseholdMemberResponseObject); ok {
+		if err := validResponse.VisitGetHouseholdMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateHouseholdMember operation middleware
+func (sh *strictHandler) UpdateHouseholdMember(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateHouseholdMemberRequestObject
+
+	request.Id = id
+
+	var body UpdateHouseholdMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateHouseholdMember(ctx, request.(UpdateHouseholdMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateHouseholdMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateHouseholdMemberResponseObject); ok {
+		if err := validResponse.VisitUpdateHouseholdMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ActivateHouseholdMember operation middleware
+func (sh *strictHandler) ActivateHouseholdMember(w http.ResponseWriter, r *http.Request, id string) {
+	var request ActivateHouseholdMemberRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ActivateHouseholdMember(ctx, request.(ActivateHouseholdMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ActivateHouseholdMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ActivateHouseholdMemberResponseObject); ok {
+		if err := validResponse.VisitActivateHouseholdMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeactivateHouseholdMember operation middleware
+func (sh *strictHandler) DeactivateHouseholdMember(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeactivateHouseholdMemberRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeactivateHouseholdMember(ctx, request.(DeactivateHouseholdMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeactivateHouseholdMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeactivateHouseholdMemberResponseObject); ok {
+		if err := validResponse.VisitDeactivateHouseholdMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateHousehold operation middleware
+func (sh *strictHandler) CreateHousehold(w http.ResponseWriter, r *http.Request) {
+	var request CreateHouseholdRequestObject
+
+	var body CreateHouseholdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateHousehold(ctx, request.(CreateHouseholdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateHousehold")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateHouseholdResponseObject); ok {
+		if err := validResponse.VisitCreateHouseholdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCurrentHousehold operation middleware
+func (sh *strictHandler) GetCurrentHousehold(w http.ResponseWriter, r *http.Request) {
+	var request GetCurrentHouseholdRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCurrentHousehold(ctx, request.(GetCurrentHouseholdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCurrentHousehold")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCurrentHouseholdResponseObject); ok {
+		if err := validResponse.VisitGetCurrentHouseholdResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListIngresses operation middleware
+func (sh *strictHandler) ListIngresses(w http.ResponseWriter, r *http.Request, params ListIngressesParams) {
+	var request ListIngressesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListIngresses(ctx, request.(ListIngressesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListIngresses")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListIngressesResponseObject); ok {
+		if err := validResponse.VisitListIngressesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// CreateIngress operation middleware
+func (sh *strictHandler) CreateIngress(w http.ResponseWriter, r *http.Request) {
+	var request CreateIngressRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	var body CreateIngressJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateIngress(ctx, request.(CreateIngressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateIngress")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateIngressResponseObject); ok {
+		if err := validResponse.VisitCreateIngressResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// GetIngress operation middleware
+func (sh *strictHandler) GetIngress(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetIngressRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIngress(ctx, request.(GetIngressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIngress")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIngressResponseObject); ok {
+		if err := validResponse.VisitGetIngressResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// DeleteIngressRecurrencePattern operation middleware
+func (sh *strictHandler) DeleteIngressRecurrencePattern(w http.ResponseWriter, r *http.Request, id string, patternId string) {
+	var request DeleteIngressRecurrencePatternRequestObject
+
+	request.Id = id
+	request.PatternId = patternId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteIngressRecurrencePattern(ctx, request.(DeleteIngressRecurrencePatternRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteIngressRecurrencePattern")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteIngressRecurrencePatternResponseObject); ok {
+		if err := validResponse.VisitDeleteIngressRecurrencePatternResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// GetIngressRecurrencePattern operation middleware
+func (sh *strictHandler) GetIngressRecurrencePattern(w http.ResponseWriter, r *http.Request, id string, patternId string) {
+	var request GetIngressRecurrencePatternRequestObject
+
+	request.Id = id
+	request.PatternId = patternId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIngressRecurrencePattern(ctx, request.(GetIngressRecurrencePatternRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIngressRecurrencePattern")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIngressRecurrencePatternResponseObject); ok {
+		if err := validResponse.VisitGetIngressRecurrencePatternResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListAccounts operation middleware
-func (sh *strictHandler) ListAccounts(w http.ResponseWriter, r *http.Request, params ListAccountsParams) {
-	var request ListAccountsRequestObject
+// UpdateIngressRecurrencePattern operation middleware
+func (sh *strictHandler) UpdateIngressRecurrencePattern(w http.ResponseWriter, r *http.Request, id string, patternId string) {
+	var request UpdateIngressRecurrencePatternRequestObject
+
+	request.Id = id
+	request.PatternId = patternId
+
+	var body UpdateIngressRecurrencePatternJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateIngressRecurrencePattern(ctx, request.(UpdateIngressRecurrencePatternRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateIngressRecurrencePattern")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateIngressRecurrencePatternResponseObject); ok {
+		if err := validResponse.VisitUpdateIngressRecurrencePatternResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RollbackIngress operation middleware
+func (sh *strictHandler) RollbackIngress(w http.ResponseWriter, r *http.Request, id string) {
+	var request RollbackIngressRequestObject
+
+	request.Id = id
+
+	var body RollbackIngressJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RollbackIngress(ctx, request.(RollbackIngressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RollbackIngress")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RollbackIngressResponseObject); ok {
+		if err := validResponse.VisitRollbackIngressResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListInstallmentObligations operation middleware
+func (sh *strictHandler) ListInstallmentObligations(w http.ResponseWriter, r *http.Request, params ListInstallmentObligationsParams) {
+	var request ListInstallmentObligationsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListAccounts(ctx, request.(ListAccountsRequestObject))
+		return sh.ssi.ListInstallmentObligations(ctx, request.(ListInstallmentObligationsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListAccounts")
+		handler = middleware(handler, "ListInstallmentObligations")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListAccountsResponseObject); ok {
-		if err := validResponse.VisitListAccountsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListInstallmentObligationsResponseObject); ok {
+		if err := validResponse.VisitListInstallmentObligationsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6859,11 +16834,37 @@ func (sh *strictHandler) ListAccounts(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// CreateAccount operation middleware
-func (sh *strictHandler) CreateAccount(w http.ResponseWriter, r *http.Request) {
-	var request CreateAccountRequestObject
+// ListInstallmentPlans operation middleware
+func (sh *strictHandler) ListInstallmentPlans(w http.ResponseWriter, r *http.Request, params ListInstallmentPlansParams) {
+	var request ListInstallmentPlansRequestObject
 
-	var body CreateAccountJSONRequestBody
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListInstallmentPlans(ctx, request.(ListInstallmentPlansRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListInstallmentPlans")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListInstallmentPlansResponseObject); ok {
+		if err := validResponse.VisitListInstallmentPlansResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateInstallmentPlan operation middleware
+func (sh *strictHandler) CreateInstallmentPlan(w http.ResponseWriter, r *http.Request) {
+	var request CreateInstallmentPlanRequestObject
+
+	var body CreateInstallmentPlanJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6871,18 +16872,18 @@ func (sh *strictHandler) CreateAccount(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateAccount(ctx, request.(CreateAccountRequestObject))
+		return sh.ssi.CreateInstallmentPlan(ctx, request.(CreateInstallmentPlanRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateAccount")
+		handler = middleware(handler, "CreateInstallmentPlan")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateAccountResponseObject); ok {
-		if err := validResponse.VisitCreateAccountResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateInstallmentPlanResponseObject); ok {
+		if err := validResponse.VisitCreateInstallmentPlanResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6890,25 +16891,25 @@ func (sh *strictHandler) CreateAccount(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteAccount operation middleware
-func (sh *strictHandler) DeleteAccount(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteAccountRequestObject
+// GetInstallmentPlan operation middleware
+func (sh *strictHandler) GetInstallmentPlan(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetInstallmentPlanRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteAccount(ctx, request.(DeleteAccountRequestObject))
+		return sh.ssi.GetInstallmentPlan(ctx, request.(GetInstallmentPlanRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteAccount")
+		handler = middleware(handler, "GetInstallmentPlan")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteAccountResponseObject); ok {
-		if err := validResponse.VisitDeleteAccountResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetInstallmentPlanResponseObject); ok {
+		if err := validResponse.VisitGetInstallmentPlanResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6916,25 +16917,25 @@ func (sh *strictHandler) DeleteAccount(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetAccount operation middleware
-func (sh *strictHandler) GetAccount(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetAccountRequestObject
-
+// PayOffInstallmentPlan operation middleware
+func (sh *strictHandler) PayOffInstallmentPlan(w http.ResponseWriter, r *http.Request, id string) {
+	var request PayOffInstallmentPlanRequestObject
+
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAccount(ctx, request.(GetAccountRequestObject))
+		return sh.ssi.PayOffInstallmentPlan(ctx, request.(PayOffInstallmentPlanRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAccount")
+		handler = middleware(handler, "PayOffInstallmentPlan")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAccountResponseObject); ok {
-		if err := validResponse.VisitGetAccountResponse(w); err != nil {
+	} else if validResponse, ok := response.(PayOffInstallmentPlanResponseObject); ok {
+		if err := validResponse.VisitPayOffInstallmentPlanResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6942,13 +16943,11 @@ func (sh *strictHandler) GetAccount(w http.ResponseWriter, r *http.Request, id s
 	}
 }
 
-// UpdateAccount operation middleware
-func (sh *strictHandler) UpdateAccount(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateAccountRequestObject
-
-	request.Id = id
+// CreateInvitation operation middleware
+func (sh *strictHandler) CreateInvitation(w http.ResponseWriter, r *http.Request) {
+	var request CreateInvitationRequestObject
 
-	var body UpdateAccountJSONRequestBody
+	var body CreateInvitationJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -6956,18 +16955,18 @@ func (sh *strictHandler) UpdateAccount(w http.ResponseWriter, r *http.Request, i
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateAccount(ctx, request.(UpdateAccountRequestObject))
+		return sh.ssi.CreateInvitation(ctx, request.(CreateInvitationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateAccount")
+		handler = middleware(handler, "CreateInvitation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateAccountResponseObject); ok {
-		if err := validResponse.VisitUpdateAccountResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateInvitationResponseObject); ok {
+		if err := validResponse.VisitCreateInvitationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -6975,25 +16974,30 @@ func (sh *strictHandler) UpdateAccount(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// ActivateAccount operation middleware
-func (sh *strictHandler) ActivateAccount(w http.ResponseWriter, r *http.Request, id string) {
-	var request ActivateAccountRequestObject
+// AcceptInvitation operation middleware
+func (sh *strictHandler) AcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	var request AcceptInvitationRequestObject
 
-	request.Id = id
+	var body AcceptInvitationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ActivateAccount(ctx, request.(ActivateAccountRequestObject))
+		return sh.ssi.AcceptInvitation(ctx, request.(AcceptInvitationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ActivateAccount")
+		handler = middleware(handler, "AcceptInvitation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ActivateAccountResponseObject); ok {
-		if err := validResponse.VisitActivateAccountResponse(w); err != nil {
+	} else if validResponse, ok := response.(AcceptInvitationResponseObject); ok {
+		if err := validResponse.VisitAcceptInvitationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7001,25 +17005,30 @@ func (sh *strictHandler) ActivateAccount(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// CanDeleteAccount operation middleware
-func (sh *strictHandler) CanDeleteAccount(w http.ResponseWriter, r *http.Request, id string) {
-	var request CanDeleteAccountRequestObject
+// JoinHousehold operation middleware
+func (sh *strictHandler) JoinHousehold(w http.ResponseWriter, r *http.Request) {
+	var request JoinHouseholdRequestObject
 
-	request.Id = id
+	var body JoinHouseholdJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CanDeleteAccount(ctx, request.(CanDeleteAccountRequestObject))
+		return sh.ssi.JoinHousehold(ctx, request.(JoinHouseholdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CanDeleteAccount")
+		handler = middleware(handler, "JoinHousehold")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CanDeleteAccountResponseObject); ok {
-		if err := validResponse.VisitCanDeleteAccountResponse(w); err != nil {
+	} else if validResponse, ok := response.(JoinHouseholdResponseObject); ok {
+		if err := validResponse.VisitJoinHouseholdResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7027,25 +17036,25 @@ func (sh *strictHandler) CanDeleteAccount(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// DeactivateAccount operation middleware
-func (sh *strictHandler) DeactivateAccount(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeactivateAccountRequestObject
+// ListPayees operation middleware
+func (sh *strictHandler) ListPayees(w http.ResponseWriter, r *http.Request, params ListPayeesParams) {
+	var request ListPayeesRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeactivateAccount(ctx, request.(DeactivateAccountRequestObject))
+		return sh.ssi.ListPayees(ctx, request.(ListPayeesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeactivateAccount")
+		handler = middleware(handler, "ListPayees")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeactivateAccountResponseObject); ok {
-		if err := validResponse.VisitDeactivateAccountResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPayeesResponseObject); ok {
+		if err := validResponse.VisitListPayeesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7053,11 +17062,11 @@ func (sh *strictHandler) DeactivateAccount(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// Login operation middleware
-func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
-	var request LoginRequestObject
+// CreatePayee operation middleware
+func (sh *strictHandler) CreatePayee(w http.ResponseWriter, r *http.Request) {
+	var request CreatePayeeRequestObject
 
-	var body LoginJSONRequestBody
+	var body CreatePayeeJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -7065,18 +17074,18 @@ func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+		return sh.ssi.CreatePayee(ctx, request.(CreatePayeeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Login")
+		handler = middleware(handler, "CreatePayee")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LoginResponseObject); ok {
-		if err := validResponse.VisitLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreatePayeeResponseObject); ok {
+		if err := validResponse.VisitCreatePayeeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7084,23 +17093,25 @@ func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// RefreshToken operation middleware
-func (sh *strictHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
-	var request RefreshTokenRequestObject
+// DeletePayee operation middleware
+func (sh *strictHandler) DeletePayee(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeletePayeeRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RefreshToken(ctx, request.(RefreshTokenRequestObject))
+		return sh.ssi.DeletePayee(ctx, request.(DeletePayeeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RefreshToken")
+		handler = middleware(handler, "DeletePayee")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RefreshTokenResponseObject); ok {
-		if err := validResponse.VisitRefreshTokenResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeletePayeeResponseObject); ok {
+		if err := validResponse.VisitDeletePayeeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7108,30 +17119,25 @@ func (sh *strictHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// RegisterUser operation middleware
-func (sh *strictHandler) RegisterUser(w http.ResponseWriter, r *http.Request) {
-	var request RegisterUserRequestObject
+// GetPayee operation middleware
+func (sh *strictHandler) GetPayee(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetPayeeRequestObject
 
-	var body RegisterUserJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RegisterUser(ctx, request.(RegisterUserRequestObject))
+		return sh.ssi.GetPayee(ctx, request.(GetPayeeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RegisterUser")
+		handler = middleware(handler, "GetPayee")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RegisterUserResponseObject); ok {
-		if err := validResponse.VisitRegisterUserResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPayeeResponseObject); ok {
+		if err := validResponse.VisitGetPayeeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7139,25 +17145,32 @@ func (sh *strictHandler) RegisterUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetBalances operation middleware
-func (sh *strictHandler) GetBalances(w http.ResponseWriter, r *http.Request, params GetBalancesParams) {
-	var request GetBalancesRequestObject
+// UpdatePayee operation middleware
+func (sh *strictHandler) UpdatePayee(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdatePayeeRequestObject
 
-	request.Params = params
+	request.Id = id
+
+	var body UpdatePayeeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetBalances(ctx, request.(GetBalancesRequestObject))
+		return sh.ssi.UpdatePayee(ctx, request.(UpdatePayeeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetBalances")
+		handler = middleware(handler, "UpdatePayee")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetBalancesResponseObject); ok {
-		if err := validResponse.VisitGetBalancesResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdatePayeeResponseObject); ok {
+		if err := validResponse.VisitUpdatePayeeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7165,25 +17178,30 @@ func (sh *strictHandler) GetBalances(w http.ResponseWriter, r *http.Request, par
 	}
 }
 
-// ListCategories operation middleware
-func (sh *strictHandler) ListCategories(w http.ResponseWriter, r *http.Request, params ListCategoriesParams) {
-	var request ListCategoriesRequestObject
+// CreateIngressRecurrencePattern operation middleware
+func (sh *strictHandler) CreateIngressRecurrencePattern(w http.ResponseWriter, r *http.Request) {
+	var request CreateIngressRecurrencePatternRequestObject
 
-	request.Params = params
+	var body CreateIngressRecurrencePatternJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCategories(ctx, request.(ListCategoriesRequestObject))
+		return sh.ssi.CreateIngressRecurrencePattern(ctx, request.(CreateIngressRecurrencePatternRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCategories")
+		handler = middleware(handler, "CreateIngressRecurrencePattern")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCategoriesResponseObject); ok {
-		if err := validResponse.VisitListCategoriesResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateIngressRecurrencePatternResponseObject); ok {
+		if err := validResponse.VisitCreateIngressRecurrencePatternResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7191,30 +17209,25 @@ func (sh *strictHandler) ListCategories(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// CreateCategory operation middleware
-func (sh *strictHandler) CreateCategory(w http.ResponseWriter, r *http.Request) {
-	var request CreateCategoryRequestObject
+// ListExpenditureRecurrencePatterns operation middleware
+func (sh *strictHandler) ListExpenditureRecurrencePatterns(w http.ResponseWriter, r *http.Request, params ListExpenditureRecurrencePatternsParams) {
+	var request ListExpenditureRecurrencePatternsRequestObject
 
-	var body CreateCategoryJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCategory(ctx, request.(CreateCategoryRequestObject))
+		return sh.ssi.ListExpenditureRecurrencePatterns(ctx, request.(ListExpenditureRecurrencePatternsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCategory")
+		handler = middleware(handler, "ListExpenditureRecurrencePatterns")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCategoryResponseObject); ok {
-		if err := validResponse.VisitCreateCategoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListExpenditureRecurrencePatternsResponseObject); ok {
+		if err := validResponse.VisitListExpenditureRecurrencePatternsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7222,25 +17235,30 @@ func (sh *strictHandler) CreateCategory(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// DeleteCategory operation middleware
-func (sh *strictHandler) DeleteCategory(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteCategoryRequestObject
+// CreateExpenditureRecurrencePattern operation middleware
+func (sh *strictHandler) CreateExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request) {
+	var request CreateExpenditureRecurrencePatternRequestObject
 
-	request.Id = id
+	var body CreateExpenditureRecurrencePatternJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCategory(ctx, request.(DeleteCategoryRequestObject))
+		return sh.ssi.CreateExpenditureRecurrencePattern(ctx, request.(CreateExpenditureRecurrencePatternRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCategory")
+		handler = middleware(handler, "CreateExpenditureRecurrencePattern")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCategoryResponseObject); ok {
-		if err := validResponse.VisitDeleteCategoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateExpenditureRecurrencePatternResponseObject); ok {
+		if err := validResponse.VisitCreateExpenditureRecurrencePatternResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7248,32 +17266,25 @@ func (sh *strictHandler) DeleteCategory(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// UpdateCategory operation middleware
-func (sh *strictHandler) UpdateCategory(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateCategoryRequestObject
+// DeleteExpenditureRecurrencePattern operation middleware
+func (sh *strictHandler) DeleteExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteExpenditureRecurrencePatternRequestObject
 
 	request.Id = id
 
-	var body UpdateCategoryJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateCategory(ctx, request.(UpdateCategoryRequestObject))
+		return sh.ssi.DeleteExpenditureRecurrencePattern(ctx, request.(DeleteExpenditureRecurrencePatternRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateCategory")
+		handler = middleware(handler, "DeleteExpenditureRecurrencePattern")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateCategoryResponseObject); ok {
-		if err := validResponse.VisitUpdateCategoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteExpenditureRecurrencePatternResponseObject); ok {
+		if err := validResponse.VisitDeleteExpenditureRecurrencePatternResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7281,25 +17292,25 @@ func (sh *strictHandler) UpdateCategory(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// ActivateCategory operation middleware
-func (sh *strictHandler) ActivateCategory(w http.ResponseWriter, r *http.Request, id string) {
-	var request ActivateCategoryRequestObject
+// GetExpenditureRecurrencePattern operation middleware
+func (sh *strictHandler) GetExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetExpenditureRecurrencePatternRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ActivateCategory(ctx, request.(ActivateCategoryRequestObject))
+		return sh.ssi.GetExpenditureRecurrencePattern(ctx, request.(GetExpenditureRecurrencePatternRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ActivateCategory")
+		handler = middleware(handler, "GetExpenditureRecurrencePattern")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ActivateCategoryResponseObject); ok {
-		if err := validResponse.VisitActivateCategoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetExpenditureRecurrencePatternResponseObject); ok {
+		if err := validResponse.VisitGetExpenditureRecurrencePatternResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7307,25 +17318,32 @@ func (sh *strictHandler) ActivateCategory(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// DeactivateCategory operation middleware
-func (sh *strictHandler) DeactivateCategory(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeactivateCategoryRequestObject
+// UpdateExpenditureRecurrencePattern operation middleware
+func (sh *strictHandler) UpdateExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateExpenditureRecurrencePatternRequestObject
 
 	request.Id = id
 
+	var body UpdateExpenditureRecurrencePatternJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeactivateCategory(ctx, request.(DeactivateCategoryRequestObject))
+		return sh.ssi.UpdateExpenditureRecurrencePattern(ctx, request.(UpdateExpenditureRecurrencePatternRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeactivateCategory")
+		handler = middleware(handler, "UpdateExpenditureRecurrencePattern")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeactivateCategoryResponseObject); ok {
-		if err := validResponse.VisitDeactivateCategoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateExpenditureRecurrencePatternResponseObject); ok {
+		if err := validResponse.VisitUpdateExpenditureRecurrencePatternResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7333,25 +17351,32 @@ func (sh *strictHandler) DeactivateCategory(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetExchangeRates operation middleware
-func (sh *strictHandler) GetExchangeRates(w http.ResponseWriter, r *http.Request, params GetExchangeRatesParams) {
-	var request GetExchangeRatesRequestObject
+// PostExpenditureRecurrencePattern operation middleware
+func (sh *strictHandler) PostExpenditureRecurrencePattern(w http.ResponseWriter, r *http.Request, id string) {
+	var request PostExpenditureRecurrencePatternRequestObject
 
-	request.Params = params
+	request.Id = id
+
+	var body PostExpenditureRecurrencePatternJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetExchangeRates(ctx, request.(GetExchangeRatesRequestObject))
+		return sh.ssi.PostExpenditureRecurrencePattern(ctx, request.(PostExpenditureRecurrencePatternRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetExchangeRates")
+		handler = middleware(handler, "PostExpenditureRecurrencePattern")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetExchangeRatesResponseObject); ok {
-		if err := validResponse.VisitGetExchangeRatesResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostExpenditureRecurrencePatternResponseObject); ok {
+		if err := validResponse.VisitPostExpenditureRecurrencePatternResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7359,25 +17384,25 @@ func (sh *strictHandler) GetExchangeRates(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// ListExpenditures operation middleware
-func (sh *strictHandler) ListExpenditures(w http.ResponseWriter, r *http.Request, params ListExpendituresParams) {
-	var request ListExpendituresRequestObject
+// GetDeclaredExpensesReport operation middleware
+func (sh *strictHandler) GetDeclaredExpensesReport(w http.ResponseWriter, r *http.Request, params GetDeclaredExpensesReportParams) {
+	var request GetDeclaredExpensesReportRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListExpenditures(ctx, request.(ListExpendituresRequestObject))
+		return sh.ssi.GetDeclaredExpensesReport(ctx, request.(GetDeclaredExpensesReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListExpenditures")
+		handler = middleware(handler, "GetDeclaredExpensesReport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListExpendituresResponseObject); ok {
-		if err := validResponse.VisitListExpendituresResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetDeclaredExpensesReportResponseObject); ok {
+		if err := validResponse.VisitGetDeclaredExpensesReportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7385,30 +17410,25 @@ func (sh *strictHandler) ListExpenditures(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// CreateExpenditure operation middleware
-func (sh *strictHandler) CreateExpenditure(w http.ResponseWriter, r *http.Request) {
-	var request CreateExpenditureRequestObject
+// GetPayeeSpendingReport operation middleware
+func (sh *strictHandler) GetPayeeSpendingReport(w http.ResponseWriter, r *http.Request, params GetPayeeSpendingReportParams) {
+	var request GetPayeeSpendingReportRequestObject
 
-	var body CreateExpenditureJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateExpenditure(ctx, request.(CreateExpenditureRequestObject))
+		return sh.ssi.GetPayeeSpendingReport(ctx, request.(GetPayeeSpendingReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateExpenditure")
+		handler = middleware(handler, "GetPayeeSpendingReport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateExpenditureResponseObject); ok {
-		if err := validResponse.VisitCreateExpenditureResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPayeeSpendingReportResponseObject); ok {
+		if err := validResponse.VisitGetPayeeSpendingReportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7416,25 +17436,25 @@ func (sh *strictHandler) CreateExpenditure(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetExpenditure operation middleware
-func (sh *strictHandler) GetExpenditure(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetExpenditureRequestObject
+// GetPlannedSpendingReport operation middleware
+func (sh *strictHandler) GetPlannedSpendingReport(w http.ResponseWriter, r *http.Request, params GetPlannedSpendingReportParams) {
+	var request GetPlannedSpendingReportRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetExpenditure(ctx, request.(GetExpenditureRequestObject))
+		return sh.ssi.GetPlannedSpendingReport(ctx, request.(GetPlannedSpendingReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetExpenditure")
+		handler = middleware(handler, "GetPlannedSpendingReport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetExpenditureResponseObject); ok {
-		if err := validResponse.VisitGetExpenditureResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPlannedSpendingReportResponseObject); ok {
+		if err := validResponse.VisitGetPlannedSpendingReportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7442,32 +17462,25 @@ func (sh *strictHandler) GetExpenditure(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// RollbackExpenditure operation middleware
-func (sh *strictHandler) RollbackExpenditure(w http.ResponseWriter, r *http.Request, id string) {
-	var request RollbackExpenditureRequestObject
-
-	request.Id = id
+// GetTagCombinationReport operation middleware
+func (sh *strictHandler) GetTagCombinationReport(w http.ResponseWriter, r *http.Request, params GetTagCombinationReportParams) {
+	var request GetTagCombinationReportRequestObject
 
-	var body RollbackExpenditureJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RollbackExpenditure(ctx, request.(RollbackExpenditureRequestObject))
+		return sh.ssi.GetTagCombinationReport(ctx, request.(GetTagCombinationReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RollbackExpenditure")
+		handler = middleware(handler, "GetTagCombinationReport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RollbackExpenditureResponseObject); ok {
-		if err := validResponse.VisitRollbackExpenditureResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTagCombinationReportResponseObject); ok {
+		if err := validResponse.VisitGetTagCombinationReportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7475,25 +17488,25 @@ func (sh *strictHandler) RollbackExpenditure(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// ListHouseholdMembers operation middleware
-func (sh *strictHandler) ListHouseholdMembers(w http.ResponseWriter, r *http.Request, params ListHouseholdMembersParams) {
-	var request ListHouseholdMembersRequestObject
+// GetTagSpendingReport operation middleware
+func (sh *strictHandler) GetTagSpendingReport(w http.ResponseWriter, r *http.Request, params GetTagSpendingReportParams) {
+	var request GetTagSpendingReportRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListHouseholdMembers(ctx, request.(ListHouseholdMembersRequestObject))
+		return sh.ssi.GetTagSpendingReport(ctx, request.(GetTagSpendingReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListHouseholdMembers")
+		handler = middleware(handler, "GetTagSpendingReport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListHouseholdMembersResponseObject); ok {
-		if err := validResponse.VisitListHouseholdMembersResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTagSpendingReportResponseObject); ok {
+		if err := validResponse.VisitGetTagSpendingReportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7501,30 +17514,26 @@ func (sh *strictHandler) ListHouseholdMembers(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// CreateHouseholdMember operation middleware
-func (sh *strictHandler) CreateHouseholdMember(w http.ResponseWriter, r *http.Request) {
-	var request CreateHouseholdMemberRequestObject
-
-	var body CreateHouseholdMemberJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
+// GetTagCategoryReport operation middleware
+func (sh *strictHandler) GetTagCategoryReport(w http.ResponseWriter, r *http.Request, tagId string, params GetTagCategoryReportParams) {
+	var request GetTagCategoryReportRequestObject
+
+	request.TagId = tagId
+	request.Params = params
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateHouseholdMember(ctx, request.(CreateHouseholdMemberRequestObject))
+		return sh.ssi.GetTagCategoryReport(ctx, request.(GetTagCategoryReportRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateHouseholdMember")
+		handler = middleware(handler, "GetTagCategoryReport")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateHouseholdMemberResponseObject); ok {
-		if err := validResponse.VisitCreateHouseholdMemberResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTagCategoryReportResponseObject); ok {
+		if err := validResponse.VisitGetTagCategoryReportResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7532,25 +17541,23 @@ func (sh *strictHandler) CreateHouseholdMember(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// DeleteHouseholdMember operation middleware
-func (sh *strictHandler) DeleteHouseholdMember(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteHouseholdMemberRequestObject
-
-	request.Id = id
+// ListRoles operation middleware
+func (sh *strictHandler) ListRoles(w http.ResponseWriter, r *http.Request) {
+	var request ListRolesRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteHouseholdMember(ctx, request.(DeleteHouseholdMemberRequestObject))
+		return sh.ssi.ListRoles(ctx, request.(ListRolesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteHouseholdMember")
+		handler = middleware(handler, "ListRoles")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteHouseholdMemberResponseObject); ok {
-		if err := validResponse.VisitDeleteHouseholdMemberResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListRolesResponseObject); ok {
+		if err := validResponse.VisitListRolesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7558,25 +17565,25 @@ func (sh *strictHandler) DeleteHouseholdMember(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// GetHouseholdMember operation middleware
-func (sh *strictHandler) GetHouseholdMember(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetHouseholdMemberRequestObject
+// ListSavingsGoals operation middleware
+func (sh *strictHandler) ListSavingsGoals(w http.ResponseWriter, r *http.Request, params ListSavingsGoalsParams) {
+	var request ListSavingsGoalsRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHouseholdMember(ctx, request.(GetHouseholdMemberRequestObject))
+		return sh.ssi.ListSavingsGoals(ctx, request.(ListSavingsGoalsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHouseholdMember")
+		handler = middleware(handler, "ListSavingsGoals")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHouseholdMemberResponseObject); ok {
-		if err := validResponse.VisitGetHouseholdMemberResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListSavingsGoalsResponseObject); ok {
+		if err := validResponse.VisitListSavingsGoalsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7584,13 +17591,11 @@ func (sh *strictHandler) GetHouseholdMember(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// UpdateHouseholdMember operation middleware
-func (sh *strictHandler) UpdateHouseholdMember(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateHouseholdMemberRequestObject
-
-	request.Id = id
+// CreateSavingsGoal operation middleware
+func (sh *strictHandler) CreateSavingsGoal(w http.ResponseWriter, r *http.Request) {
+	var request CreateSavingsGoalRequestObject
 
-	var body UpdateHouseholdMemberJSONRequestBody
+	var body CreateSavingsGoalJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -7598,18 +17603,18 @@ func (sh *strictHandler) UpdateHouseholdMember(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateHouseholdMember(ctx, request.(UpdateHouseholdMemberRequestObject))
+		return sh.ssi.CreateSavingsGoal(ctx, request.(CreateSavingsGoalRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateHouseholdMember")
+		handler = middleware(handler, "CreateSavingsGoal")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateHouseholdMemberResponseObject); ok {
-		if err := validResponse.VisitUpdateHouseholdMemberResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSavingsGoalResponseObject); ok {
+		if err := validResponse.VisitCreateSavingsGoalResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7617,25 +17622,25 @@ func (sh *strictHandler) UpdateHouseholdMember(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// ActivateHouseholdMember operation middleware
-func (sh *strictHandler) ActivateHouseholdMember(w http.ResponseWriter, r *http.Request, id string) {
-	var request ActivateHouseholdMemberRequestObject
+// DeleteSavingsGoal operation middleware
+func (sh *strictHandler) DeleteSavingsGoal(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteSavingsGoalRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ActivateHouseholdMember(ctx, request.(ActivateHouseholdMemberRequestObject))
+		return sh.ssi.DeleteSavingsGoal(ctx, request.(DeleteSavingsGoalRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ActivateHouseholdMember")
+		handler = middleware(handler, "DeleteSavingsGoal")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ActivateHouseholdMemberResponseObject); ok {
-		if err := validResponse.VisitActivateHouseholdMemberResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteSavingsGoalResponseObject); ok {
+		if err := validResponse.VisitDeleteSavingsGoalResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7643,25 +17648,25 @@ func (sh *strictHandler) ActivateHouseholdMember(w http.ResponseWriter, r *http.
 	}
 }
 
-// DeactivateHouseholdMember operation middleware
-func (sh *strictHandler) DeactivateHouseholdMember(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeactivateHouseholdMemberRequestObject
+// GetSavingsGoal operation middleware
+func (sh *strictHandler) GetSavingsGoal(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetSavingsGoalRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeactivateHouseholdMember(ctx, request.(DeactivateHouseholdMemberRequestObject))
+		return sh.ssi.GetSavingsGoal(ctx, request.(GetSavingsGoalRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeactivateHouseholdMember")
+		handler = middleware(handler, "GetSavingsGoal")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeactivateHouseholdMemberResponseObject); ok {
-		if err := validResponse.VisitDeactivateHouseholdMemberResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSavingsGoalResponseObject); ok {
+		if err := validResponse.VisitGetSavingsGoalResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7669,25 +17674,32 @@ func (sh *strictHandler) DeactivateHouseholdMember(w http.ResponseWriter, r *htt
 	}
 }
 
-// ListIngresses operation middleware
-func (sh *strictHandler) ListIngresses(w http.ResponseWriter, r *http.Request, params ListIngressesParams) {
-	var request ListIngressesRequestObject
+// UpdateSavingsGoal operation middleware
+func (sh *strictHandler) UpdateSavingsGoal(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateSavingsGoalRequestObject
 
-	request.Params = params
+	request.Id = id
+
+	var body UpdateSavingsGoalJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListIngresses(ctx, request.(ListIngressesRequestObject))
+		return sh.ssi.UpdateSavingsGoal(ctx, request.(UpdateSavingsGoalRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListIngresses")
+		handler = middleware(handler, "UpdateSavingsGoal")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListIngressesResponseObject); ok {
-		if err := validResponse.VisitListIngressesResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSavingsGoalResponseObject); ok {
+		if err := validResponse.VisitUpdateSavingsGoalResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7695,30 +17707,26 @@ func (sh *strictHandler) ListIngresses(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
-// CreateIngress operation middleware
-func (sh *strictHandler) CreateIngress(w http.ResponseWriter, r *http.Request) {
-	var request CreateIngressRequestObject
+// ListSavingsContributions operation middleware
+func (sh *strictHandler) ListSavingsContributions(w http.ResponseWriter, r *http.Request, id string, params ListSavingsContributionsParams) {
+	var request ListSavingsContributionsRequestObject
 
-	var body CreateIngressJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateIngress(ctx, request.(CreateIngressRequestObject))
+		return sh.ssi.ListSavingsContributions(ctx, request.(ListSavingsContributionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateIngress")
+		handler = middleware(handler, "ListSavingsContributions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateIngressResponseObject); ok {
-		if err := validResponse.VisitCreateIngressResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListSavingsContributionsResponseObject); ok {
+		if err := validResponse.VisitListSavingsContributionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7726,25 +17734,32 @@ func (sh *strictHandler) CreateIngress(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetIngress operation middleware
-func (sh *strictHandler) GetIngress(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetIngressRequestObject
+// AddSavingsContribution operation middleware
+func (sh *strictHandler) AddSavingsContribution(w http.ResponseWriter, r *http.Request, id string) {
+	var request AddSavingsContributionRequestObject
 
 	request.Id = id
 
+	var body AddSavingsContributionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetIngress(ctx, request.(GetIngressRequestObject))
+		return sh.ssi.AddSavingsContribution(ctx, request.(AddSavingsContributionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetIngress")
+		handler = middleware(handler, "AddSavingsContribution")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetIngressResponseObject); ok {
-		if err := validResponse.VisitGetIngressResponse(w); err != nil {
+	} else if validResponse, ok := response.(AddSavingsContributionResponseObject); ok {
+		if err := validResponse.VisitAddSavingsContributionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7752,26 +17767,25 @@ func (sh *strictHandler) GetIngress(w http.ResponseWriter, r *http.Request, id s
 	}
 }
 
-// DeleteIngressRecurrencePattern operation middleware
-func (sh *strictHandler) DeleteIngressRecurrencePattern(w http.ResponseWriter, r *http.Request, id string, patternId string) {
-	var request DeleteIngressRecurrencePatternRequestObject
+// GetSavingsProgress operation middleware
+func (sh *strictHandler) GetSavingsProgress(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetSavingsProgressRequestObject
 
 	request.Id = id
-	request.PatternId = patternId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteIngressRecurrencePattern(ctx, request.(DeleteIngressRecurrencePatternRequestObject))
+		return sh.ssi.GetSavingsProgress(ctx, request.(GetSavingsProgressRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteIngressRecurrencePattern")
+		handler = middleware(handler, "GetSavingsProgress")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteIngressRecurrencePatternResponseObject); ok {
-		if err := validResponse.VisitDeleteIngressRecurrencePatternResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSavingsProgressResponseObject); ok {
+		if err := validResponse.VisitGetSavingsProgressResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7779,26 +17793,26 @@ func (sh *strictHandler) DeleteIngressRecurrencePattern(w http.ResponseWriter, r
 	}
 }
 
-// GetIngressRecurrencePattern operation middleware
-func (sh *strictHandler) GetIngressRecurrencePattern(w http.ResponseWriter, r *http.Request, id string, patternId string) {
-	var request GetIngressRecurrencePatternRequestObject
+// ListSavingsTransactions operation middleware
+func (sh *strictHandler) ListSavingsTransactions(w http.ResponseWriter, r *http.Request, id string, params ListSavingsTransactionsParams) {
+	var request ListSavingsTransactionsRequestObject
 
 	request.Id = id
-	request.PatternId = patternId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetIngressRecurrencePattern(ctx, request.(GetIngressRecurrencePatternRequestObject))
+		return sh.ssi.ListSavingsTransactions(ctx, request.(ListSavingsTransactionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetIngressRecurrencePattern")
+		handler = middleware(handler, "ListSavingsTransactions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetIngressRecurrencePatternResponseObject); ok {
-		if err := validResponse.VisitGetIngressRecurrencePatternResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListSavingsTransactionsResponseObject); ok {
+		if err := validResponse.VisitListSavingsTransactionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7806,33 +17820,26 @@ func (sh *strictHandler) GetIngressRecurrencePattern(w http.ResponseWriter, r *h
 	}
 }
 
-// UpdateIngressRecurrencePattern operation middleware
-func (sh *strictHandler) UpdateIngressRecurrencePattern(w http.ResponseWriter, r *http.Request, id string, patternId string) {
-	var request UpdateIngressRecurrencePatternRequestObject
+// ListSavingsWithdrawals operation middleware
+func (sh *strictHandler) ListSavingsWithdrawals(w http.ResponseWriter, r *http.Request, id string, params ListSavingsWithdrawalsParams) {
+	var request ListSavingsWithdrawalsRequestObject
 
 	request.Id = id
-	request.PatternId = patternId
-
-	var body UpdateIngressRecurrencePatternJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateIngressRecurrencePattern(ctx, request.(UpdateIngressRecurrencePatternRequestObject))
+		return sh.ssi.ListSavingsWithdrawals(ctx, request.(ListSavingsWithdrawalsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateIngressRecurrencePattern")
+		handler = middleware(handler, "ListSavingsWithdrawals")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateIngressRecurrencePatternResponseObject); ok {
-		if err := validResponse.VisitUpdateIngressRecurrencePatternResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListSavingsWithdrawalsResponseObject); ok {
+		if err := validResponse.VisitListSavingsWithdrawalsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7840,13 +17847,13 @@ func (sh *strictHandler) UpdateIngressRecurrencePattern(w http.ResponseWriter, r
 	}
 }
 
-// RollbackIngress operation middleware
-func (sh *strictHandler) RollbackIngress(w http.ResponseWriter, r *http.Request, id string) {
-	var request RollbackIngressRequestObject
+// AddSavingsWithdrawal operation middleware
+func (sh *strictHandler) AddSavingsWithdrawal(w http.ResponseWriter, r *http.Request, id string) {
+	var request AddSavingsWithdrawalRequestObject
 
 	request.Id = id
 
-	var body RollbackIngressJSONRequestBody
+	var body AddSavingsWithdrawalJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -7854,18 +17861,18 @@ func (sh *strictHandler) RollbackIngress(w http.ResponseWriter, r *http.Request,
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RollbackIngress(ctx, request.(RollbackIngressRequestObject))
+		return sh.ssi.AddSavingsWithdrawal(ctx, request.(AddSavingsWithdrawalRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RollbackIngress")
+		handler = middleware(handler, "AddSavingsWithdrawal")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RollbackIngressResponseObject); ok {
-		if err := validResponse.VisitRollbackIngressResponse(w); err != nil {
+	} else if validResponse, ok := response.(AddSavingsWithdrawalResponseObject); ok {
+		if err := validResponse.VisitAddSavingsWithdrawalResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7873,30 +17880,25 @@ func (sh *strictHandler) RollbackIngress(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// CreateIngressRecurrencePattern operation middleware
-func (sh *strictHandler) CreateIngressRecurrencePattern(w http.ResponseWriter, r *http.Request) {
-	var request CreateIngressRecurrencePatternRequestObject
+// Search operation middleware
+func (sh *strictHandler) Search(w http.ResponseWriter, r *http.Request, params SearchParams) {
+	var request SearchRequestObject
 
-	var body CreateIngressRecurrencePatternJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateIngressRecurrencePattern(ctx, request.(CreateIngressRecurrencePatternRequestObject))
+		return sh.ssi.Search(ctx, request.(SearchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateIngressRecurrencePattern")
+		handler = middleware(handler, "Search")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateIngressRecurrencePatternResponseObject); ok {
-		if err := validResponse.VisitCreateIngressRecurrencePatternResponse(w); err != nil {
+	} else if validResponse, ok := response.(SearchResponseObject); ok {
+		if err := validResponse.VisitSearchResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7904,25 +17906,23 @@ func (sh *strictHandler) CreateIngressRecurrencePattern(w http.ResponseWriter, r
 	}
 }
 
-// ListSavingsGoals operation middleware
-func (sh *strictHandler) ListSavingsGoals(w http.ResponseWriter, r *http.Request, params ListSavingsGoalsParams) {
-	var request ListSavingsGoalsRequestObject
-
-	request.Params = params
+// ListTags operation middleware
+func (sh *strictHandler) ListTags(w http.ResponseWriter, r *http.Request) {
+	var request ListTagsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListSavingsGoals(ctx, request.(ListSavingsGoalsRequestObject))
+		return sh.ssi.ListTags(ctx, request.(ListTagsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListSavingsGoals")
+		handler = middleware(handler, "ListTags")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListSavingsGoalsResponseObject); ok {
-		if err := validResponse.VisitListSavingsGoalsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListTagsResponseObject); ok {
+		if err := validResponse.VisitListTagsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7930,11 +17930,11 @@ func (sh *strictHandler) ListSavingsGoals(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// CreateSavingsGoal operation middleware
-func (sh *strictHandler) CreateSavingsGoal(w http.ResponseWriter, r *http.Request) {
-	var request CreateSavingsGoalRequestObject
+// CreateTag operation middleware
+func (sh *strictHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
+	var request CreateTagRequestObject
 
-	var body CreateSavingsGoalJSONRequestBody
+	var body CreateTagJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -7942,18 +17942,18 @@ func (sh *strictHandler) CreateSavingsGoal(w http.ResponseWriter, r *http.Reques
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateSavingsGoal(ctx, request.(CreateSavingsGoalRequestObject))
+		return sh.ssi.CreateTag(ctx, request.(CreateTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateSavingsGoal")
+		handler = middleware(handler, "CreateTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateSavingsGoalResponseObject); ok {
-		if err := validResponse.VisitCreateSavingsGoalResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateTagResponseObject); ok {
+		if err := validResponse.VisitCreateTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7961,25 +17961,25 @@ func (sh *strictHandler) CreateSavingsGoal(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// DeleteSavingsGoal operation middleware
-func (sh *strictHandler) DeleteSavingsGoal(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteSavingsGoalRequestObject
+// ListTagsByType operation middleware
+func (sh *strictHandler) ListTagsByType(w http.ResponseWriter, r *http.Request, pType TagType) {
+	var request ListTagsByTypeRequestObject
 
-	request.Id = id
+	request.Type = pType
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteSavingsGoal(ctx, request.(DeleteSavingsGoalRequestObject))
+		return sh.ssi.ListTagsByType(ctx, request.(ListTagsByTypeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteSavingsGoal")
+		handler = middleware(handler, "ListTagsByType")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteSavingsGoalResponseObject); ok {
-		if err := validResponse.VisitDeleteSavingsGoalResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListTagsByTypeResponseObject); ok {
+		if err := validResponse.VisitListTagsByTypeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7987,25 +17987,26 @@ func (sh *strictHandler) DeleteSavingsGoal(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetSavingsGoal operation middleware
-func (sh *strictHandler) GetSavingsGoal(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetSavingsGoalRequestObject
+// ListRecordTags operation middleware
+func (sh *strictHandler) ListRecordTags(w http.ResponseWriter, r *http.Request, pType TagType, recordId string) {
+	var request ListRecordTagsRequestObject
 
-	request.Id = id
+	request.Type = pType
+	request.RecordId = recordId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSavingsGoal(ctx, request.(GetSavingsGoalRequestObject))
+		return sh.ssi.ListRecordTags(ctx, request.(ListRecordTagsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSavingsGoal")
+		handler = middleware(handler, "ListRecordTags")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSavingsGoalResponseObject); ok {
-		if err := validResponse.VisitGetSavingsGoalResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListRecordTagsResponseObject); ok {
+		if err := validResponse.VisitListRecordTagsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8013,13 +18014,14 @@ func (sh *strictHandler) GetSavingsGoal(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// UpdateSavingsGoal operation middleware
-func (sh *strictHandler) UpdateSavingsGoal(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateSavingsGoalRequestObject
+// AddRecordTags operation middleware
+func (sh *strictHandler) AddRecordTags(w http.ResponseWriter, r *http.Request, pType TagType, recordId string) {
+	var request AddRecordTagsRequestObject
 
-	request.Id = id
+	request.Type = pType
+	request.RecordId = recordId
 
-	var body UpdateSavingsGoalJSONRequestBody
+	var body AddRecordTagsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8027,18 +18029,18 @@ func (sh *strictHandler) UpdateSavingsGoal(w http.ResponseWriter, r *http.Reques
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateSavingsGoal(ctx, request.(UpdateSavingsGoalRequestObject))
+		return sh.ssi.AddRecordTags(ctx, request.(AddRecordTagsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateSavingsGoal")
+		handler = middleware(handler, "AddRecordTags")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateSavingsGoalResponseObject); ok {
-		if err := validResponse.VisitUpdateSavingsGoalResponse(w); err != nil {
+	} else if validResponse, ok := response.(AddRecordTagsResponseObject); ok {
+		if err := validResponse.VisitAddRecordTagsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8046,26 +18048,33 @@ func (sh *strictHandler) UpdateSavingsGoal(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// ListSavingsContributions operation middleware
-func (sh *strictHandler) ListSavingsContributions(w http.ResponseWriter, r *http.Request, id string, params ListSavingsContributionsParams) {
-	var request ListSavingsContributionsRequestObject
+// SetRecordTags operation middleware
+func (sh *strictHandler) SetRecordTags(w http.ResponseWriter, r *http.Request, pType TagType, recordId string) {
+	var request SetRecordTagsRequestObject
 
-	request.Id = id
-	request.Params = params
+	request.Type = pType
+	request.RecordId = recordId
+
+	var body SetRecordTagsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListSavingsContributions(ctx, request.(ListSavingsContributionsRequestObject))
+		return sh.ssi.SetRecordTags(ctx, request.(SetRecordTagsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListSavingsContributions")
+		handler = middleware(handler, "SetRecordTags")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListSavingsContributionsResponseObject); ok {
-		if err := validResponse.VisitListSavingsContributionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(SetRecordTagsResponseObject); ok {
+		if err := validResponse.VisitSetRecordTagsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8073,32 +18082,27 @@ func (sh *strictHandler) ListSavingsContributions(w http.ResponseWriter, r *http
 	}
 }
 
-// AddSavingsContribution operation middleware
-func (sh *strictHandler) AddSavingsContribution(w http.ResponseWriter, r *http.Request, id string) {
-	var request AddSavingsContributionRequestObject
-
-	request.Id = id
+// RemoveRecordTag operation middleware
+func (sh *strictHandler) RemoveRecordTag(w http.ResponseWriter, r *http.Request, pType TagType, recordId string, tagId string) {
+	var request RemoveRecordTagRequestObject
 
-	var body AddSavingsContributionJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Type = pType
+	request.RecordId = recordId
+	request.TagId = tagId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AddSavingsContribution(ctx, request.(AddSavingsContributionRequestObject))
+		return sh.ssi.RemoveRecordTag(ctx, request.(RemoveRecordTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddSavingsContribution")
+		handler = middleware(handler, "RemoveRecordTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AddSavingsContributionResponseObject); ok {
-		if err := validResponse.VisitAddSavingsContributionResponse(w); err != nil {
+	} else if validResponse, ok := response.(RemoveRecordTagResponseObject); ok {
+		if err := validResponse.VisitRemoveRecordTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8106,25 +18110,25 @@ func (sh *strictHandler) AddSavingsContribution(w http.ResponseWriter, r *http.R
 	}
 }
 
-// GetSavingsProgress operation middleware
-func (sh *strictHandler) GetSavingsProgress(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetSavingsProgressRequestObject
+// DeleteTag operation middleware
+func (sh *strictHandler) DeleteTag(w http.ResponseWriter, r *http.Request, id string) {
+	var request DeleteTagRequestObject
 
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSavingsProgress(ctx, request.(GetSavingsProgressRequestObject))
+		return sh.ssi.DeleteTag(ctx, request.(DeleteTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSavingsProgress")
+		handler = middleware(handler, "DeleteTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSavingsProgressResponseObject); ok {
-		if err := validResponse.VisitGetSavingsProgressResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteTagResponseObject); ok {
+		if err := validResponse.VisitDeleteTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8132,26 +18136,32 @@ func (sh *strictHandler) GetSavingsProgress(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// ListSavingsTransactions operation middleware
-func (sh *strictHandler) ListSavingsTransactions(w http.ResponseWriter, r *http.Request, id string, params ListSavingsTransactionsParams) {
-	var request ListSavingsTransactionsRequestObject
+// UpdateTag operation middleware
+func (sh *strictHandler) UpdateTag(w http.ResponseWriter, r *http.Request, id string) {
+	var request UpdateTagRequestObject
 
 	request.Id = id
-	request.Params = params
+
+	var body UpdateTagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListSavingsTransactions(ctx, request.(ListSavingsTransactionsRequestObject))
+		return sh.ssi.UpdateTag(ctx, request.(UpdateTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListSavingsTransactions")
+		handler = middleware(handler, "UpdateTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListSavingsTransactionsResponseObject); ok {
-		if err := validResponse.VisitListSavingsTransactionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateTagResponseObject); ok {
+		if err := validResponse.VisitUpdateTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8159,26 +18169,32 @@ func (sh *strictHandler) ListSavingsTransactions(w http.ResponseWriter, r *http.
 	}
 }
 
-// ListSavingsWithdrawals operation middleware
-func (sh *strictHandler) ListSavingsWithdrawals(w http.ResponseWriter, r *http.Request, id string, params ListSavingsWithdrawalsParams) {
-	var request ListSavingsWithdrawalsRequestObject
+// MergeTag operation middleware
+func (sh *strictHandler) MergeTag(w http.ResponseWriter, r *http.Request, id string) {
+	var request MergeTagRequestObject
 
 	request.Id = id
-	request.Params = params
+
+	var body MergeTagJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListSavingsWithdrawals(ctx, request.(ListSavingsWithdrawalsRequestObject))
+		return sh.ssi.MergeTag(ctx, request.(MergeTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListSavingsWithdrawals")
+		handler = middleware(handler, "MergeTag")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListSavingsWithdrawalsResponseObject); ok {
-		if err := validResponse.VisitListSavingsWithdrawalsResponse(w); err != nil {
+	} else if validResponse, ok := response.(MergeTagResponseObject); ok {
+		if err := validResponse.VisitMergeTagResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8186,32 +18202,25 @@ func (sh *strictHandler) ListSavingsWithdrawals(w http.ResponseWriter, r *http.R
 	}
 }
 
-// AddSavingsWithdrawal operation middleware
-func (sh *strictHandler) AddSavingsWithdrawal(w http.ResponseWriter, r *http.Request, id string) {
-	var request AddSavingsWithdrawalRequestObject
-
-	request.Id = id
+// ListTransactions operation middleware
+func (sh *strictHandler) ListTransactions(w http.ResponseWriter, r *http.Request, params ListTransactionsParams) {
+	var request ListTransactionsRequestObject
 
-	var body AddSavingsWithdrawalJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AddSavingsWithdrawal(ctx, request.(AddSavingsWithdrawalRequestObject))
+		return sh.ssi.ListTransactions(ctx, request.(ListTransactionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddSavingsWithdrawal")
+		handler = middleware(handler, "ListTransactions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AddSavingsWithdrawalResponseObject); ok {
-		if err := validResponse.VisitAddSavingsWithdrawalResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListTransactionsResponseObject); ok {
+		if err := validResponse.VisitListTransactionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8219,23 +18228,25 @@ func (sh *strictHandler) AddSavingsWithdrawal(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// ListTags operation middleware
-func (sh *strictHandler) ListTags(w http.ResponseWriter, r *http.Request) {
-	var request ListTagsRequestObject
+// ListTransfers operation middleware
+func (sh *strictHandler) ListTransfers(w http.ResponseWriter, r *http.Request, params ListTransfersParams) {
+	var request ListTransfersRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListTags(ctx, request.(ListTagsRequestObject))
+		return sh.ssi.ListTransfers(ctx, request.(ListTransfersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListTags")
+		handler = middleware(handler, "ListTransfers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListTagsResponseObject); ok {
-		if err := validResponse.VisitListTagsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListTransfersResponseObject); ok {
+		if err := validResponse.VisitListTransfersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8243,11 +18254,11 @@ func (sh *strictHandler) ListTags(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateTag operation middleware
-func (sh *strictHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
-	var request CreateTagRequestObject
+// CreateTransfer operation middleware
+func (sh *strictHandler) CreateTransfer(w http.ResponseWriter, r *http.Request) {
+	var request CreateTransferRequestObject
 
-	var body CreateTagJSONRequestBody
+	var body CreateTransferJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8255,18 +18266,18 @@ func (sh *strictHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateTag(ctx, request.(CreateTagRequestObject))
+		return sh.ssi.CreateTransfer(ctx, request.(CreateTransferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateTag")
+		handler = middleware(handler, "CreateTransfer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateTagResponseObject); ok {
-		if err := validResponse.VisitCreateTagResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateTransferResponseObject); ok {
+		if err := validResponse.VisitCreateTransferResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8274,25 +18285,25 @@ func (sh *strictHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListTagsByType operation middleware
-func (sh *strictHandler) ListTagsByType(w http.ResponseWriter, r *http.Request, pType TagType) {
-	var request ListTagsByTypeRequestObject
+// GetTransfer operation middleware
+func (sh *strictHandler) GetTransfer(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetTransferRequestObject
 
-	request.Type = pType
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListTagsByType(ctx, request.(ListTagsByTypeRequestObject))
+		return sh.ssi.GetTransfer(ctx, request.(GetTransferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListTagsByType")
+		handler = middleware(handler, "GetTransfer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListTagsByTypeResponseObject); ok {
-		if err := validResponse.VisitListTagsByTypeResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTransferResponseObject); ok {
+		if err := validResponse.VisitGetTransferResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8300,25 +18311,32 @@ func (sh *strictHandler) ListTagsByType(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// DeleteTag operation middleware
-func (sh *strictHandler) DeleteTag(w http.ResponseWriter, r *http.Request, id string) {
-	var request DeleteTagRequestObject
+// RollbackTransfer operation middleware
+func (sh *strictHandler) RollbackTransfer(w http.ResponseWriter, r *http.Request, id string) {
+	var request RollbackTransferRequestObject
 
 	request.Id = id
 
+	var body RollbackTransferJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTag(ctx, request.(DeleteTagRequestObject))
+		return sh.ssi.RollbackTransfer(ctx, request.(RollbackTransferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTag")
+		handler = middleware(handler, "RollbackTransfer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteTagResponseObject); ok {
-		if err := validResponse.VisitDeleteTagResponse(w); err != nil {
+	} else if validResponse, ok := response.(RollbackTransferResponseObject); ok {
+		if err := validResponse.VisitRollbackTransferResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8326,25 +18344,25 @@ func (sh *strictHandler) DeleteTag(w http.ResponseWriter, r *http.Request, id st
 	}
 }
 
-// ListTransactions operation middleware
-func (sh *strictHandler) ListTransactions(w http.ResponseWriter, r *http.Request, params ListTransactionsParams) {
-	var request ListTransactionsRequestObject
+// ListUpcomingBills operation middleware
+func (sh *strictHandler) ListUpcomingBills(w http.ResponseWriter, r *http.Request, params ListUpcomingBillsParams) {
+	var request ListUpcomingBillsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListTransactions(ctx, request.(ListTransactionsRequestObject))
+		return sh.ssi.ListUpcomingBills(ctx, request.(ListUpcomingBillsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListTransactions")
+		handler = middleware(handler, "ListUpcomingBills")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListTransactionsResponseObject); ok {
-		if err := validResponse.VisitListTransactionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListUpcomingBillsResponseObject); ok {
+		if err := validResponse.VisitListUpcomingBillsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8352,25 +18370,23 @@ func (sh *strictHandler) ListTransactions(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// ListTransfers operation middleware
-func (sh *strictHandler) ListTransfers(w http.ResponseWriter, r *http.Request, params ListTransfersParams) {
-	var request ListTransfersRequestObject
-
-	request.Params = params
+// ListUsers operation middleware
+func (sh *strictHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	var request ListUsersRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListTransfers(ctx, request.(ListTransfersRequestObject))
+		return sh.ssi.ListUsers(ctx, request.(ListUsersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListTransfers")
+		handler = middleware(handler, "ListUsers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListTransfersResponseObject); ok {
-		if err := validResponse.VisitListTransfersResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListUsersResponseObject); ok {
+		if err := validResponse.VisitListUsersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8378,30 +18394,23 @@ func (sh *strictHandler) ListTransfers(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
-// CreateTransfer operation middleware
-func (sh *strictHandler) CreateTransfer(w http.ResponseWriter, r *http.Request) {
-	var request CreateTransferRequestObject
-
-	var body CreateTransferJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetCurrentUser operation middleware
+func (sh *strictHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+	var request GetCurrentUserRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateTransfer(ctx, request.(CreateTransferRequestObject))
+		return sh.ssi.GetCurrentUser(ctx, request.(GetCurrentUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateTransfer")
+		handler = middleware(handler, "GetCurrentUser")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateTransferResponseObject); ok {
-		if err := validResponse.VisitCreateTransferResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCurrentUserResponseObject); ok {
+		if err := validResponse.VisitGetCurrentUserResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8409,25 +18418,30 @@ func (sh *strictHandler) CreateTransfer(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// GetTransfer operation middleware
-func (sh *strictHandler) GetTransfer(w http.ResponseWriter, r *http.Request, id string) {
-	var request GetTransferRequestObject
+// LinkCurrentUserMember operation middleware
+func (sh *strictHandler) LinkCurrentUserMember(w http.ResponseWriter, r *http.Request) {
+	var request LinkCurrentUserMemberRequestObject
 
-	request.Id = id
+	var body LinkCurrentUserMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTransfer(ctx, request.(GetTransferRequestObject))
+		return sh.ssi.LinkCurrentUserMember(ctx, request.(LinkCurrentUserMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTransfer")
+		handler = middleware(handler, "LinkCurrentUserMember")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTransferResponseObject); ok {
-		if err := validResponse.VisitGetTransferResponse(w); err != nil {
+	} else if validResponse, ok := response.(LinkCurrentUserMemberResponseObject); ok {
+		if err := validResponse.VisitLinkCurrentUserMemberResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8435,13 +18449,13 @@ func (sh *strictHandler) GetTransfer(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// RollbackTransfer operation middleware
-func (sh *strictHandler) RollbackTransfer(w http.ResponseWriter, r *http.Request, id string) {
-	var request RollbackTransferRequestObject
+// SetUserRoles operation middleware
+func (sh *strictHandler) SetUserRoles(w http.ResponseWriter, r *http.Request, id string) {
+	var request SetUserRolesRequestObject
 
 	request.Id = id
 
-	var body RollbackTransferJSONRequestBody
+	var body SetUserRolesJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8449,18 +18463,18 @@ func (sh *strictHandler) RollbackTransfer(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RollbackTransfer(ctx, request.(RollbackTransferRequestObject))
+		return sh.ssi.SetUserRoles(ctx, request.(SetUserRolesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RollbackTransfer")
+		handler = middleware(handler, "SetUserRoles")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RollbackTransferResponseObject); ok {
-		if err := validResponse.VisitRollbackTransferResponse(w); err != nil {
+	} else if validResponse, ok := response.(SetUserRolesResponseObject); ok {
+		if err := validResponse.VisitSetUserRolesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8471,153 +18485,410 @@ func (sh *strictHandler) RollbackTransfer(w http.ResponseWriter, r *http.Request
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3PbtrboX8GwZ+Y6HdmW/Ehqfzp+xKlzdtKM49ze7u7cDERCErYpUAUgO9q5/u93",
-	"8CIBEiRBWZKd1F/aWASwgIWF9cbCtyjOprOMIMJZdPwtoojNMsKQ/GOv3xf/SxCLKZ5xnJHoOPo4j2PE",
-	"WHTfi/b6B9Xv7zMQZ4QjwkWTAzWE+eX4WwRnsxTHULTe/TcTXb5FLJ6gKRT/+i+KRtFx9NNuMa1d9ZXt",
-	"vqY0o9H9/X2vBPIUJuAK/TVHTMMcVKd1MucTRLiGDEYQpyhRrQ/WP8P3GQcX2ZxoiEfrh3iWkVGKY4mQ",
-	"w01swiXhiBKYgo+I3iIKdEMBfeCjEg7wdJaiKSJcbMR9T09AUt5JHGdzPdU0/W0UHf/ZPC3doaCCb9GM",
-	"ZjNEOVa0DFWDSzLK6BSqWZQn9SGFmACOvnIwwihNJCFDTDAZA90fYGuAXoS+QrEIsfzTk/fH4Pz1L0dg",
-	"/1X/APT7Bwegf7i/B/qD/T7o93tAzxFMsjRB9Bi8zSYEnGco6kV8MRODME4xGQukxRRBjpITXp3lNZ4i",
-	"xuF0Bu4miAA+Qfnk7iADumfUi9REo+MogRxtczz1Q5pTigg/hSkkMaqCO1PfwVA1ANnIBmnjYLB32N95",
-	"dWgBHqUZ5AVQMp8OkaQJnFQBfSL4rzkCOBHHdIQRBaOM1sES+znY2/ctaD5LlkRdChkHunsg/u57EUV/",
-	"zTFFSXT8p1hXBaP2Ztqz+5wPlg3/jcRJ/XzfM5T/D8zk/L1ELP+NOZqyttNqztF9DgtSChfi7yniMIG8",
-	"9cCLmbwzbe/vK5POp2zO3vF3fvQ0uPeKWCsz/YcgkgOQ4DHmDGQUTCG7QUk+S0XkYEsQL0UjRJE8NSRd",
-	"vHBm/fPPP/882Ns/8E+B41vPWfx9gvgEOYcCYAZ0c2t0TucoH3eYZSmCpDjs8cKzARRPIV0A06LhnEef",
-	"Pp77Zu0MWJG/SYLFP2EKEsQhThmAw2zOa4G8EwQRT1B8Y9OAwGoCcboA6OsMSUXFMxNMMMcwreVpl+p7",
-	"ztMkN5CnVMCqY2/9fj+ItxHGMZ/70XCBCSSxgG01AwROEdjCI6Dl8jBFLrGcTSBD4BSSG99yRXePiBWD",
-	"NuyiRPCZQfBJ3qIyfHZH1EFoYhO/ZnOGxPF6hwwi1EAVHryYyWlZUyLzqWCeQ7W8GLJJJNB4ixgX6oHk",
-	"oIsZz6JelAn6F6yzWMfQi5USY5Yo0o2sY1Ahlc8e/qa/fZxPxQkJ5Mp+FugRe4Y1XZ4HyrdhoKReUjTH",
-	"GblFlKOk9vToDyBvCXgGqOL+KAEWcpeCX8uiDKZ8AOp4kv9omJE0VSxxJvy0bcbVZBZAoxViK4vpenSc",
-	"lTg1z3jB0kJwM6bZfJbvcxP5ypb/gzyTeCO+CFzdoAXYMpPtSQz0AOLxzouQqcwQjRHhcOxB6of8m1xp",
-	"3Spf9nd+eRVCYHKEk6mxL0rcSQ4Pp7mw4RPMgFy/DWz/sN/f2Qug55AtlhOqPW3X9oIBjGnGGIBpCnK2",
-	"Y83r8NVh8Lxs9uhMweGPORQfZzyD5ByliKMqycT2p3otRuj6fAFiSMAQgUT2SCKf6kIRZD6J+vrrLIVE",
-	"WfVSjstBxF+YAZJxgavsTg5K5mkqZGtJP6oRGcUK/EvnaJwpcRBmopoeTTZqu9oX61G66X3d7C0Dwzm5",
-	"MeRegVS1gPyGTXn5FYoZwvhGHDSSnGVpRn1yxzQAsWgB4ixBctKfLgFFM4qY4BNy77cm6CtQh8BlQD+9",
-	"/uXi8PVR1ItmkHNExcD/96etP0+2L+D2qL999Pnby/v/Z/+5f//iv7yGs17QtRYFIXsv20oh613h2fLL",
-	"Ojg7uTjsr2BZjVr8efGXETteYjHrlcsQzGpMsxjRBZjNaSx0WbacHusF9kaOjX1j+rVAe/yms2121iio",
-	"mIwpYorhzhBJMJ9TqVVSSNgI0agXMXiLyfhNBlNr5GKFyilWZZZZgsIZiRzkTHS5/9yLvm6Ps20BaJvd",
-	"4Nl2NlNW1vYsw4QLvV3wBGnvM6bla4G6K40cbXRjBqaYMZsD67mHwinzUDHLArYP2cVqLEy/z/jJnE8y",
-	"iv8jJ3NJbmGKkw+QwiniEtPG36jcjQqxvegio0OcJIhEPTGI8rn2Cm/oZ9/CBNTtW0gFeTABPp9TeR75",
-	"B8+ErG++mb2m8qM9Qf2TNU/9SzFdgaCv8QSSMbqCHDEf22TorFZJPBV2Y27TGwZP5VAhJj30CfBzyPMT",
-	"ifTsPGPu9ff2t/svtweHZWeaDxQ1y4O5p+CDs9B2za6sGNgzU54DPJIOmdyGwO6Uv0WvP11Fx/2do71e",
-	"9Ob0g/jnq6Ne9PbDH9Hx4OBwZ3+vqtCVSN7Zj55Zrlqdl/4tVhLOAopO9epENx+yxdKW8iN3UzFcBlrQ",
-	"DPo6W4lbt7yaVbl2O3hyrT3ye3OtOYZ7dG1yWatX10NiXdwa15aL8vJcmVH2pgj1lbEsxgKH4A7zSdn9",
-	"cXD40usbrbPcSruu29nGUZD7LrYU+xCVrtlIvzzPVRfDhOdMyNuWc2Baf+nEmAUOEoc5+0fvxpkTFKdQ",
-	"noJGS87C/QQyMESIgBFmMUzTBcjHCDBWGjVQucSqFlq30lw3zDF+h9BND2ASp/MEkzEYZVnSA5PFGCOC",
-	"egCSBDAC4xuvhipMTdIFE5gB3QdkFJCMbJshrFmOYMq8mOBwzDwRCMy4XDccg8vzyjmqnDUb1J8eOyv6",
-	"qd+/uOgL4yE2v4i/5S8Vd48HnZHi/mK6XwZ7+weHL1/9ciQ6K4XeUtCFwhrE5q7huMreShw5P+GWVWB5",
-	"LuyZ9yxWpQ+PTxaXndjHTRb6CM5Tbqi4nhomZkwwlYMKktARwnShTXiACRjOkzHiilqUYuoJ33SS6BTF",
-	"GU2WEuYjTBl/7zXHLsQnFbHQZ6+8wodrByEjConun6GM0HWdIMHxTY39qb+IAzyTET1hMHUdn2apZ+yr",
-	"LJWb746yhXbGOz0wg4JIeiCe4DTpAZpl0ynkMjL0QO3IoowVKUYFwVg7o1cdrjZVTqBfcVJYbuCM5Q0R",
-	"vDyI7dRGsSwW1DbnBm3pUZnHys/08wmsPRNNx8FH9pfasxRsAOoO9cZfN4Zre7aM6oTJ+EEOXz1F/xHG",
-	"JM6mHcweg6C1mjwlpHY1dwpd3xg+UhPTuAVDlGZkzICMYzuWDne1pk4mjxm9au7sHfbXZ/A0KCITBHhV",
-	"5Jh5utpI2SQ52B4cXg/2jvcPjg9f/rNjDpvP/rq2LS+9Oz5if5CZ5RtQredwuz8IM7GWTZ3xwX6XET5J",
-	"F4DBFNIFmMGFTqOo8kSkkYM+mLBBMxFcVTrc9yKWzWlcgyf1rcCUOPbOZE/iKQJnGZ15w+zLG0AexDzQ",
-	"+PlYRmej0aNar9jisYwYn/WjySs/Dj5R47DBCotL8RRzH8anmLuei7/mSMLUADDhaKwYSjYaMeQZ5Df5",
-	"e+goMiRdFw7XSXbZCCCVQszAFPJ4YtK3RjjliIKYYo4ohh4AvgC4ENZy/fkavAjMxpjUygg0hTh13NXq",
-	"F58zATJ2l9HEaZ3/2CZ3zbB5h4a5qpx+rwcSU8QUGw9jtTy7QZJLVE0Q1p4l9onVJUVc+ThRmC5U6drq",
-	"Ev8CudeFk1tHejygg6lNUutguz+QUqt/vN8/7vf/uSaPeXVWzkwGe/tI8KBt9MvRcHuwl+xvw4PDl9sH",
-	"ey9fDg4Grw4UR6szHJfCSMl2XAlaGrzuX6BlP4o//HpnLTlU9bkaveqkSP9BMJ5Yy3dy2gL1q+CAeuBO",
-	"G+muZGneS5ocYB9MxWev8xKR5ItfoXlNEqXQ1E8BbJF5msoGmCRohAnmpSRVueuDve19n8rTknvTi0Yy",
-	"g9CrxF2YT0aNcDdEx4xlWnDUi+4QupH/mCpERb1ogSBNF27aaPHVkz7MEb31SZ9L/QXcwnRu4T6foDYc",
-	"0S2iC7AnvaPMwdKgF00xwVMx54Ff7n3RYv6Lj0FYRkah9fiUwACGMGi3Y/NNsbBi6R6uj9Wduk8eXWVp",
-	"KlSw2iNJ8wb+bC/1u0S8aCrkvWguEfLaTcpoXFgJjm+uH2UqBzvLCKd4mOdzh8kkT+dVBWpja8wNRGpt",
-	"cG6IKiO8JlarsmDYmwyml400rBuCcQZTpbY7q6sxmUXrmuhgNz9oBZUr8oa66+8WNm6gnGABdl1eW9U7",
-	"MAgUXkEGcC2NKCv4VWCgkWQ6BaTW/pUtLOu3FrARkg4WRjSbaqPYS7XSVD2pd/L4HDwlREsKnsq4Is2m",
-	"gRn9D7By6xDwQFNXKATZSOkSgM3pLJ2zNoP39W/vwEfddD1xPk035X1qYN4yEa8r0xadVsWsHQb3gOuZ",
-	"dfnq5s6Hzlhn8NZVxV8FKqndZIK9KofGGbytoXGd4X+WiZa8Nc1f4ivWjRPXrRmynhnNBCmgRAPEGTn3",
-	"q76M46k8VHHeUvG2IWQoARlxD/gEM15OQN3r7x2Gp1MwDvmc1W+l+u6TkJaym6d+2ziCQ0iSjKDE1XTz",
-	"ts3SMlDY1YsscW7qAnbLuOgdORoeKrCPflD8znPsu7j+q1LhboIoAqM5SRiAFAFBMSULuV4YwDnPcuHf",
-	"dBkgA6LpFHKsUmxyMkXim7q3UjqfdRk3LsyTZrNYA9624M0QxVmi5uFmXAUxHxf8RaAh6E4CZ4StxRhc",
-	"bT5Y+dJWLSdd5o7tuYwOoMSXIFULSFG/StQHBN0BlKKYUxyDGNKGy7V1VGLu1mqJBFOKYLKoSqbDwOu0",
-	"7ZcCapf2Ht2BM/8iZhRnFHP/RWj5BaToFqVga7B92NMneiDUuwkeTxBzr1/seQ355bW68koeHLiwtljt",
-	"aqMmJ3D2MA1OrJ6OUa3aci2/GhrhmSQP54JdP5A8FBy/bNdQpDSXV1RhPJEUI7WLLXOF4UVVmNd5sYKu",
-	"l1gBGQcLvnt1zerrB5rleQHlGyMF3/tVqyQN148bbzs6PFTreJAr5b+0JyE7ovpVYEmLTFC+C27rjz/+",
-	"+GP73TvvrSZtPgZtxApv0nZlyg/U0UM5YQIX7ApNVVUMD0vMY2KiIaCmJZgTjlPAi8PgsK6DQx/zGndy",
-	"3VS8MzW6zcN5ObhQF2WqRQrINYXxTXNmrjz3mAmlnlPpNszAEBV2Bhgu6vBUm6O7MsNmf39nf3+lts0H",
-	"01CxQGmUKkMUp6lYuOSIYtm5qaNJlZZWr02c/dC7PEis+ETYHVrGdmRMWtWUZSL0IKvyXZnLS76BO/qr",
-	"ulyV9IJbxkvVVlWjAKNV4ZKLSGgZCYV36oai6861mi3BYHOO02JAMC7IjyCUmNIRQi6XT/ygmwawtKYx",
-	"OFy3phEeJGtWL8bGnWyKmfiUi+LvMmOqblBLpohWQq4pJEzQlaLyB9jFgrJ3eQbuJlijZ5oRtJB+MXOH",
-	"lqKkSwpcwShkCQxrqg/kEJAkMo2tcOVZg4MslpgLd+M1sorfZnl6V8Vuc5f0wFhOy2APCNvYyHGiNqt0",
-	"drvT/zHutOjb42pVzVinKJUIGeU1pGysV8tHdRQdJeyGSI8lQmFlZ74cIoz7rNa3aC23s2/RZolt0QsH",
-	"jklra1jw7wWGuwYuiq6rCl8U272BSLNFW7bEFD+vPs5srWzdUeYSEp9OjLlKL50izNa6lr1sGxZeriEM",
-	"paz/sr3XD1TWOVZ1gjqHeK2VSu+cTDPNAi8sd49q16z3A6TSs2rNRhydOUFfZ8rM0zUR/SZZa0JNA+jX",
-	"U0TH0kUSQwoomkFMlxLuNZLdD/ahQex80gVemiPYpsN649deSsx3yCcaBJRgYXANx6ti/0IbWz/f53Ds",
-	"EBuH45XUgDCzf4TaD9YePFqdq/2LvYvzVdS58s/yGn3lD5nf3uDo5cX+IxSsKpPbFRrPU0iBjgE2FpZt",
-	"d2CWR2+ILHI4Dqkddq2b1ZU01Z9r+Ea5hpWnclWu8xdppJWKVl+0I0NrHV8chm1+dGwFX/mrRleCLnF4",
-	"MuK+ssumtGZeCFE0q9jlW4anzHQ5Yy3FXwS5A2KKElzvZFCfUQK2ZhnD8uqtTAP2Dl6T7xxS57RS2LPZ",
-	"at+kE2PYgB75VWCHoDF8KHY6H+pmHI2QUcH0xet+WVk4IQsgWnn0k+5+pRHNpg1K5kd1J84qj6PoVh86",
-	"1rOrd7Bebr0Uh07mlLem1K/UOZRRPMYEpteh7grToXpATfJ12Co8gK+bHRnhwP1c0cMHPwdMVPtnXsuS",
-	"pmGuHF3+dMuC3zPZ/L2cIHqO/RqENGcu7ehy57NyxIQn9cvJ6OYh66xLpvvoJNH5XVtyZbJYgh2P0y/D",
-	"9KJYyJk0RYlXlD27MStVjBs43nlh8jSxPU1fNs9zsgWCSJ8H8wkvVbRSfEHPgS7Q8oRsQ9C6PWMlh2iq",
-	"9qp0T8of2sURmk+sBKt2lSOlBwZavbrHqipiWNttWaZ0VOeJDOdDetAlmJAdrC3ahvgK6+jps4XrNZDT",
-	"SFfpCaelUWjRnfJ+VybeXrrUu8VdS+MtW7fBC/xdphL1ZOawilVLJgjrHyLo6tZMqpw40I9pQ2rNEJbJ",
-	"JTHCtygBkDtQJdPPa3KoRySY4e75RA4OQy/XmoGN2RS+fu9DEq8/XXkvz1pVeH1F6K1as0WFA88qbVj9",
-	"naMgawJ5/cetBkuJuoLuTXS4A8UcKyYwu12P30o/ZgE6G6YWVGCGuRogjEI0sPBXRkoct4zCWodvcCj0",
-	"E/PVImxw5eYX9+cM0ZrX4cosb8kiBnm9i5JgZYj+LwbkVwCTpHI9Wczsv/WfO7G8KtdeMaOhhJkGOMor",
-	"mTnQ3maTFaRPyAoX7iJooVV3K5Gm55tC33RrXiZrcH/X73hTkYb9FRZpMJtWU/os1HsuDiuK5xTzxUeh",
-	"GChaP0WQInoyV4nFQ/nXhZno29+vI/16pMzNlF+LSU84n6kHKzEZZSZ5GsYCi0KzwFxF12iWIg4phhyc",
-	"qnJ6Jx8uo15kGPdxNNjp7/SlS2KGCJzh6Dja3+nv7Ckn9kTOdNd+DWrsK4NzhficEgYgSLW1CNNUZ0KZ",
-	"zoqTmwR1Xc5GqYmCBUDjfZEG50nxEMzM1KNnUmEuV/mTRXGGiyK2KSyhrSEkNz0QQzbpgVkKuaCAF9L2",
-	"iY4jU6BH24v6ZaPirdAKZdRDdV7dqg5t2z/LDK+rIWpd1w8jvwpXgZBn9FZBqOJH4nAVZYcoYvOU14Ex",
-	"VYQqUKzyQzXVkZQ3RgwOGOI14+vqRI0APve8j/mu5BFY+5FKz1OwxguSH4XiUV7fqPk0d0Wj4r3a5rai",
-	"keQW5lk2Bbb0MpJy0/wZ5WdEWDyzjPnS9CWHYvoWVH5EMp0qrg6oPCzmozk0xVN1+fkp3r5yz6sCUjws",
-	"pp9NO82Sxap3J7eEXXatnwMp0cZg1dB9dJE/4KZkAWDqKenRPE0X1mPRbTTS3xA9qb3S9ODwZz9h3fcK",
-	"7r/7DSf3isb8twLU01IMwMIhNlyoRwBdilENbYpxNu6gfWWiUUeMHYSMe2DGPQppe/SAnVA4aMZ+r03a",
-	"MkzGKWrB9hvEa1Hd3+QZGRWvha9n25bcijeIV1DoZ7JNqojz6KWUcEKBKgQcTqIyz2rSCj73otncs/mf",
-	"pKIpDxn6ihm3XpKt7LxquxHOHMaSN0puWiXfGEveAKWqDe3Isnelhqh9PJsmYcjjiS/zQc2oKit2KkRs",
-	"2jbzsDZx0f/Od95gwUJY6PbHkGwXItsrUeTzsAxgN0208oJlSe8zj0luQLoUr3J6DvxH64Cr57VlvQBd",
-	"+ASleIyHOMX8ez/9xS7V7tDji60q/SXo6TGg83xOISyoaL0SnfW7JcACD2FsaM4nu2k2xiozTluoJWeP",
-	"/Lwe1cSparxh/cStUtzItIBCUbHdm5mAfu5RpgAiwjFMWdn5IIZQhXcQYAvG0dTe7DmfiH5qdvaWUzSi",
-	"iE3qN/1KNbiWdZcfcxPkDICeL0o2vgefCCye4rT9xJI32h7iPz8LblbsjUYhgM4uAK5RGrJJY8x0Wmrd",
-	"LqkWn1SIYNkTWlNL/LuMraw29mEXS/eOZhVOt4obCRJBH/6bsbs+dRJdreZTTP6ByFhQzi+dq67XBDw8",
-	"gY2Ve+Eaarn7Tq5soCkfT1Ht6xsHXUOAeUl4F+rb36/rTpz13ufi7WT4Jsa/4beXn/5zOXiPL9kluTqM",
-	"zy5fXt7M/s//Pnt7tLOz442GPaDevIe7MEQd6xeYQ29Y3epYrXoSuEHMaPYBMmpOua6ihQmYM2RpJeue",
-	"j3rJGDD5lDFAuqHDe0usViFNe011xLSRw+qM/vaAmSlOYjoAGNOMsbLLv+LQOzUAWrT7M5mkoUIIOQxT",
-	"Sm8twatfszsBYEyz+UwqDuZ2Q3N4SbY/dWEVhSHjarGpUmaBrfOvTZ/QWP+oScNDXWel/VyfW1+0HYS0",
-	"HXi8nWWyq9fhdeEv3EzLFKNbVIr+Wj19Ed4z+3NIjLcYT5Y0UkHboHhuSIlDfR3podRTSmdxkBeUMWiX",
-	"XKymIwc9H+Mg3vNGTKvc+OiKDLW7iT3uBoOf7mo0kVrE0xb81FzbKmDni16eFZ/XYYma4R8pflkQlYdj",
-	"6W/fWQTTKUjoIQqXdwWGLmUpSY2OpsilRS2NnCsfbHX+rL+n20luTtuWNwbM2rdWtXvUrX0ijKe/Wcaz",
-	"mjjdpmJvSzCeUgCuMSLWTqem5TMTeqQA3FIkUA6CtEQlQiQRfCaExw6CBJGCuWaxTSHv4BJA9v0L1nD3",
-	"ouIceG3d62i1rk4hs56TlbeJdTefbTWELL9y0IlYejXlJTVkrNwS4pd8udKpNkuzJL8X6bX25Dhn+TCO",
-	"5ZebXDV14/LbWOv0Grjb4ZGEzk0b9gR1bttl4JKlRff5KtQ6De0Xl/47Zo/bXTvljr+2YQbnj3u4Y9kf",
-	"plvIiy5LpXOrQip+Kh4vOyjjkOoyrVuYxOmc4VtUl+suG5/rN4dyaK01W+uhI/PMYjtsRJJVQk5QnEIq",
-	"9Mam7HjTqmN+fAFmlkJC2qDoRksDWfM1AvdSth9Ndosl7yqUczcqFxWKe2LLHh9wec7qj9CSzP/5dkSA",
-	"DMt5atsNCYflb/iWBHJZfyGarJ9Db0tYYwGKYhUY9fkO3Tc612HFWxAeyYNor9GrwRS42rQfcQO5+o4n",
-	"uVRtzE9iZd0nd0CGJfTbtFeb1F+mu/Uf/batf8oJ/j6U1vOHJp3RXvEqsyYr9LKbF2Wpz9HRLVxaKE3/",
-	"yXony08nhzO10vnR4ziVser50IGnXL2vthbJnDsrR57Qm9Xa5DOITZPvj8Q3JTLMpynvbgRykUk2Z2iS",
-	"pcn2FAldpKsZlfcHun8nW+pX0/udBt7hPu6arq4WIIql0SytiwbrT13cWKvjnSX0talOlb3atP5UnUBB",
-	"m/lagKGFUE2qPKpWp6SzaaiupU8hgWM0RZ5LTGqsEibXpGiVoDySslVeq4difi2j9Hu8f1qmixZi83LD",
-	"8EupVTJsCvH66O1vG3ntuFGh11YDN+QN4q270X/Uw/eU9d5aJLfw9SYpX0HAI9x59dCkL57/9OXG45Lu",
-	"j3tJdmXSJfz+7DrPRWvaQDemajo+yzknuL8Ut2wgnQ53HzdPPE4kuxv5FF2fCaiUFLA0CeWVgpcqs5X3",
-	"7mTfX+YwOwdKW6KkS4czZanBuvCl+bhs/GgDwaPnyCxF8ZyKZs3eH8yuTMOnFTj9e4b/gq4naH6hHFmV",
-	"s1Dr2CpY24YdWtjib4b/Fjwv1IHlcNjmYOBlXlx9HXaGHv2R/FJmbd5bdQo336MbyqqH76EQRzJ3DOO5",
-	"dFPr3bBpZk2mYcDWPWUnRhmFNUe5SYExy1xlxM6li10l9xCJ0bZ+AGz3m/7Hl9D7J2yGYjzCMSgGA3oM",
-	"6S+HxKJXn+MyZxGm+wf9FlkLfq4nKEfzinDU88HwrKsOXIG7DeYlP0X/K/RgzXsKelGx70BvfLNH1tzc",
-	"fADdFRzsmehWdHE05HWKKrKDLndeVVGhX3J4qpUZm2iz4ylovCBmOZe7HwI1yPM5WFemSBmja3SxP+ZR",
-	"/IFrVj709PoUruD8qELFfs6NevK5UZetBllV07ZJoKEMQA1jt6mvwar38fWnx+0G3w2323yq8PprdBpq",
-	"C6C0OurWT2R1DAPY72l2CwJ8VD3fiI7hcQABZ93BAAmj0Ymcf/SVDdLZhfbLcnAISZIR7wuYj/nQR/Hg",
-	"1Sbu0cirl1IsD9Eoo8io2EiFDKKmq5bnkKNT2WtV8QJ7PuoN7I7TUe9rP2g2zz7/Wn5vsYeufn+HJ23a",
-	"9+8CL9itXk+w998eRz9HpwhWPXYmXyTPOW2CYJJigmoUCQuXa9IdLAiPFCaw1+irLmWj83uMF9j04CUr",
-	"S4Z3SE91yKwpNbVMQz+Uq7MVuaHJpQHofIN4Iy77j3IknnIcxotUL19t0iGd5T5C6miJxnyOvKfLpx+H",
-	"KH9cv1Rnbr7rPN/e0Uhz+iqfcuHebqRLy1A7cyYQbLD9XdOR3Jd26+2q6iO36wv3dFG87e3uony7dPr0",
-	"DqAq7+k7EO06wKPIF6+tcJIkxlCw1wJ4Fi5zTpLEt9drFTw2pMc1FFz69hQKtNEKk+THEkMnSVIhnO4y",
-	"aUYz5TNvE0cq1osSYHoATBRvFbC9569Oaf5gYK5fR8lBtekpOR6+A/15VuDvabC4ClVZAZ/28t+qaL3d",
-	"BWwNMz4psXhI1Jv+CYV3MGUvllWCru25teV+FQ/hu6OvIcxuuTWtAJh6ldo55xm18BD8NrVxbseuoChG",
-	"6uLXdjZLeV7lwwCPoiM6k9Fu6S6zWaXW6MxFuvy0r28sHUECTVDuIPprDtP8QYVbmM7rvNVTTE6m+hkD",
-	"zwRHaQZ5MUPlhF5mhqng6UtMD35dyfRW4UdP0AjOUx4d7/V763eq59B8wD6vX7ZZjKytPoSz6YJvVlja",
-	"960LyXVWFgmX0IcsCdPRQrd6ghHNpsvIpt8t6M/meXtxQo6J0j9bbXSr8dMz1Itt72Km26T6RI+k70x8",
-	"Tzb6lbyxI056sRK9kO4murXLazXQCziPa57bVF2l4uKrvhf141nnZZoJkkbq124vJglpnlOjGEApdiTT",
-	"+TvKeCIZkCqT/1mla3WRdf087xqOQ7hczVtCEj0dSeNBSoVCi9kriaXAl4NUaWZfMP9aflkHC7iG40c6",
-	"9HJbPQ+lwvGKYvWbKtaptq204eZk7gqy3f0m/lt/nS8nHOu9Mf+BO1XPh4UkF+tx6oVay+as6J2yVR7x",
-	"3/7nhzA73I2uI5zQh6SIrFbdlMZhuMfftqqY94SuI0Ffbl5HV6YjmEeYQBJjWHJv6rc6i9exe0AaT4mQ",
-	"33Y11x74F8mvLvTUICNEWS/XJRwfaU/KefPJUr53/kX8PKiDLzTA9ff47r7NWJ4byLQtuYDrvLxFs+s6",
-	"h69bMNa6s66JSXRSFPOl5Bs2Py/nIx4uwBQTPJ1PtYdxM/5NARZ+DQC7Ir/lky0pUs/ncsfl4frcpAHQ",
-	"N+02XdJfurEHr09h/tj1U3jUupoXzV1pkQtg+2et8RhB1dGHmvfrdA3lOocW7jNdQ2rPo7kqnytJPd/F",
-	"CGJ+I1NJPNzJXJzkDV/C4NahdjjNqEsFcTMKGCJ+h1B++pgmSalxe17He1HnuSmUprW4b/Twj+XDMavz",
-	"OXIMJr/HmxeqnpKl8fooyhFcHQs2FXRWd2HAoZx1ajht+/eUrwlU0Fhz8htLYpgxVpnd5NJFh/oB1rY/",
-	"FxAQFvtTriAArls4hOiH4jnFfCH38BRBiujJnE+i4z8/iw1RerTa4TlNo+NowvnseHc3zWKYTjLGj4/6",
-	"R4Po/vP9/w8AAP//he+/im8WAQA=",
+	"H4sIAAAAAAAC/+y9e3Pbtr4o+lUwWntmJ/vStvxI2+TOnTmOk7TuadpM4ux11+nO6UAiJGGHArQAyI5W",
+	"T777GTwJkAAJypLtpPmnjUUSz9/7+edoSpcrShARfPTszxFDfEUJR+qPk/FY/q9EfMrwSmBKRs9G79bT",
+	"KeJ89LkYnYzP2s9/pWBKiUBEyFfO9BD2l2d/juBqVeEplG8f/TeXn/w54tMFWkL5r39jaDZ6NvrbUb2s",
+	"I/2UH71kjLLR58+fi8aUz2EJ3qJ/rhE3cx63l3W+FgtEhJkZzCCuUKnfPt3/CuXslOF/tSc/2//kv1IB",
+	"XtE1MTM+3f+MF5TMKjxVt/HkLiDgkgjECKzAO8SuEQPmRTn7cQxEBcDLVYWWiAh5EZ8LswAF9ufTKV2b",
+	"pVbVb7PRs9+7l2U+qEHwz9GK0RViAmtEgvqFSzKjbAn1KpqLelNBTIBAnwSYYVSVCosgJpjMgfkeYG+A",
+	"YoQ+QbkJuf3n578+Ay9e/vAUnH4/PgPj8dkZGD85PQHj49MxGI8LYNYIFrQqEXsGfqYLAl5QNCpGYrOS",
+	"g3DBMJnLQ5syBAUqz0V7lVd4ibiAyxW4WSACxAK5xd1ADsyXo2KkFzp6NiqhQAcCL+MzrRlDRDyHFSRT",
+	"1J7uQj8HE/0CoDN/Sv8Mjk+ejA+/f+JNPKsoFPWkZL2cIAUTuGxP9J7gf64RwKWkETOMGJhRlppL3ufx",
+	"yWlsQ+tVueXRVZALYD7PPL/PxYihf64xQ+Xo2e9yX60T9S/TX90HNxid/DeSmPrhc2Eh/xfM1fqjQKz+",
+	"jQVa8j5sNaONPru5IGNwI/9eIgFLKHoRXq7ktX338+fWootRA/eefeGoZ6b7VQNra6W/SCA5AyWeY8EB",
+	"ZWAJ+UdUulVqIAePJPAyNEMMKawh1eZxsOr/+I//+I/jk9Oz+BIEvo7g4t8XSCxQgBQAc2Be90YXbI3c",
+	"uBNKKwRJjezTTeQCGF5CtgH2jQ48H71/9yK26mDAFvMvSyz/CStQIgFxxQGc0LVITvJaAsR0gaYffRiQ",
+	"p1pCXG0A+rRCSkqKrAQTLDCskjTtUj93NE1RA4Wlcq4UeRuPx1m0jXCBxTp+DK8wgWQq5/ZeAwQuEXiE",
+	"Z8Dw5UmFQmC5WECOwHNIPsa2Kz+PsFg5KJ11H/CFPeBz90ZreHpDEOsjEz/RNUcSvV4jexB6oBYN3qzU",
+	"srwlkfVSEs+J3t4U8sVIHuM14kKKB/I3tlkJOipGVML/6IO/j0n0VBqEWR2ReclDgxaofIjRt6qi0xTd",
+	"gkzYQ8ZkSpcIQM7xnKASCAoQVvgKwRQKNKdsIwkGBBxeYzLnYE5hNSqa5HJpRR+3x9MnY7njabXm+Bq9",
+	"xgQv10uL5S2IXNrn4wh02oVcRjjwS4lSJRZrhuoFK/BZOlJTby4ApikUCXZs9vojhVVsznfeUWTOJV+N",
+	"Tta4c3OQ3Vf6poIkxrLEGlaX6kJj5GPOEOeIA4amCF+jEmAtTSwpEQt/sSdP84gG1AtCZXDvJ0+fDPka",
+	"UzJANHDfvBNQrHlMRpBMmOHJ2qyrpWHoh3JaCew+VPPkiZzmHUggAA+RZDVz8yDT/PpHgmtJPjIVqExf",
+	"tvwd2NfAjNGlQXcFBRIG9BQIrKAQiBEOICnVO5SgAzqb2XcRt7QidizjfMm53t6qgiSBeZoePYcc9wLD",
+	"pfeqlA3V6iLMi3EBSuiEAzm5RNDWbkYn45Ozg/Hpwfi4KUhHacTK6KVNCJOjyUNHNWUKjxAQZOkvVvLW",
+	"mpQ8QMCzvHMV9Dk6NwQnCQSUuG2DiTwsQKgADnfBBokCEDSHUh6rdQz1vn2LgyVlCIgFJB7X8Jech/Nr",
+	"Yk4/QlPlcUoENEQcI95eRynXvlDyTkBev39ymKXCBWrWtqqSBZyAIdegGFK2Fq4WIZ32qWjjQi2IhSTN",
+	"P8R8La3JPeKqmhx3G2IsR4yRYkEFrCLSlPzZ6hxSqnIDAb0Cn8S4UTERaI5Y607sJ3qy/q17Kl9tpgnP",
+	"Ia1xXL6wmNzUOawgZYhoEwZyKXuCip2Tze5pWOMgW3AdOcshEPFewaOzbn1o3UT4QlueCQWEhth3jdjG",
+	"0oqNOnaflYOppHbIIxxUmzUGQnYMqrdlUU1Jz9teN9QaWSffrugvPy4lDuVb7qBjrKuQCsK0KVnJz/wr",
+	"KdLS1fH48CSLfpd4ZowTMTsDVBJ4hWZOu6nPuMniFD+T9jNFY4MNoDJY3NMs82DzcvUpBytO2M6UwO+g",
+	"qYH26iFYc1RqwyKDhMOpPuMlLCXZAZAoBbOmSJZNY2YV1me+ljJdQDavxX/zSv35I/PCY0CZ/515372H",
+	"tQHSLuMRZXiOCaweH4Ir/70ZrgRi2kyAPNBY2pEPPX3azD0qRna40YcW4SpGRvV9t15KA1Cm0TFu4YvI",
+	"Itbydvki03w7yTREb2l5nlJyjZhAZdI4ZB4A96Y8ZqYpKyqBJ6psNX+SH543gCfH5Ba3/NiR1NOtTD5x",
+	"040dVz3NMsG0KHFLw0wex0VDKFAySezyU2czZ3S9cvfcBb7qzf+JIov4UT6RZ/URSWQ2SyrUCRQAienh",
+	"45ylrBCbIiLgPHKob9wztdPULr8bH/7wfZ4iI2B17mxIMXHRECHtXsEcqP0HhPrJOI+N5FyxWlAS2678",
+	"DQM4ZZRzyWuAIzu+ZvT9k+x1+SwkWEKgbbhZYmLDcyimi9e0THHIBVytUM2hr2GFS6BgTIniYCK/1zxS",
+	"sRWu3M7PnOKFnbcOPJLiCPtVP3Dcwh8RMuTehmRzAzfg0QRx8XI2o0w89ui+P9SoGNUvxcn/upyjAZ5W",
+	"/X7a0TrMczlRo23luBzmQ9QThVRL/bQTL6K3jR05ERmtKnqN2Mh5g4b5EfU1/YS5oDGmPnG33n/XhoJh",
+	"WiaVB/3YkmpzGs5SNlN2I0pQAWhVIi70L7kqhF7FGzVFylbZOEJ323bdUfRWL8XVdz1AvgJfn9SWirud",
+	"0Kd2vfp6/VFaY9cr++0asWuMbjp32rAiqaOWa0PqjjUY2stla6Kcs8YkZrTiAWeVtjqrsVrLeQGF1Dco",
+	"d0swd6soI1/QGxLV3o+f5Fgg1flFDkGvlQNYlqgE65Wc1JfQBmxY3Xgv5JoFNm62C4A1YrQvFpEy4b32",
+	"rR/649jBnWaZbknCTf6rg+x6mgIoZmuN6GMLPOY+uYBMWEiqDbhtLChG6tUcA3V6e1tYdcxe7fSFOuK+",
+	"i9GiWNoyk5DUlIBhLC/c2nPrLSn3MYJNkmtG8+XGJ+NhLsJ8J1qELre3dw1xJf3ZqR3SdVUm9lgALDFP",
+	"C6uras3BzQJqJjuFjOHQuHB2kmm41kcVs5sbE0ESdjJdCXZx8R3LoS1zBxNG1/OFxypXDF1juubuCEJT",
+	"i7ETyG+tQbtGlMz9G2XkPUdlrzayQMDdoL2J1sQ/PD08Pc6c2VCqXHY/UtC41IFAiQO9gcZQJahaW9mE",
+	"ovAIo2d3lnl2WzmqnMGvta5u19XpD+PBmo4DWgflNTwWHjbWDpH6eEPQ6MP4q6iJ4BdE5mLRoEqGUR+C",
+	"19JcWVlxkYOPCK2M+KBItnbpmY9rdlCA6ZoLunTfKRlb//ECbrhv9LpB6GO1sa4l9S/9cYfy0xG51h+H",
+	"ZZnXgt44pzc10lah4r60zZESoxXkxGkN5goOxigBCE4XKep1j0Ek5qAqvMSCZwaPbOM90vOYow/w0bPW",
+	"YgHaEVG9oQKkfBGVTZ1U5bNiY6N9RNZVpdRQTEo0wwQL9LgtkRyfxAUu+bXmnwGsBLYlgwZJbMRELk7R",
+	"oxCRzCFxpI1B4cPAwHnmQcRxTCJbBUQhl76r9z972m7Wt2/t21YSjN9KSxrUemhSJjzeRib0UMGhbWBh",
+	"8g7GX22avL71zqJEM7iu5GIY4kqhjTtqDImv5QhH1S3XVgYpIzsaexVBn5RWfgjU4GYQbs1TBVgTTWNg",
+	"WXqyF6FGBihqXgpKVK6nwnurfiT9idKgV1LEwYSKhU+u7bbMVKNi5PNnWFUdZPsByJx5AuFQY8vXKqGO",
+	"70U8/e7k8LvT+xNP8e2l0+Ozpw9QPJU+r+Gm+IZlbr+S6pW1vXUQiPqUj5+Mh+qPngcwE/O2FmhqG9Qg",
+	"mSUAz1q1+O5ppr/SgVSt7p1u5YPxWKJ34933HLvZC0heIAnN7Xud+o/SEjsiAouNFaNLVJm1tMVwhiCP",
+	"RX2//CSjhnSYlUJbNYj8ywsJvEFlv/zWkibsDuJb1+F8/0oEozvqvZSOJ8n82brSci+Zm/gGnwS0Q88l",
+	"hF3B+WXM0n8F53XsZTiB/MkbV7N6eQ4LeI3ABgWm/oSzubb/ppSf8zDmwkgxdR5BDt9L6y0XToPyhcVg",
+	"oyYiHSuzd5Zw7qN766G1c/faWBuJNdEQZnP0l2X0Dcm9LwZs3r/MCZrpgFVkbputSZTSrCsUBZzXIbBM",
+	"NmDFMGVYbAaARQNRwi0X9uj8iePieOMkigDm6030o9/bdYXyvabtb3flQVWAuX//qZwmYDzyh534Tt0G",
+	"duQ59YDLeU6Fvd8hLtT2nZ2vVtXGsxYlLR88RBdASWFzgeSDOb5G8kfEtaefcp0SVctij9Dh/FD/MF0c",
+	"SPXJPZI3siZTuzhUarlSxg0UgK50kl21ATdYqnEAKhMaYJIFtAh+F0F8uybeLoyrpi1AKu1TjzLcZuIF",
+	"4ellYjKt1mX7/lOZG44G8L77oOoi/A0NYkq9doZbbOVzFrGJO6rVZrLd1O1Rb+Gy1lMPCjC3n6Td1e0V",
+	"vpEKJ7qJxpoP22uAvm26ywxF35KOR7aaTViSNOVtKHbAMExbSJHMyEEBZjqxRbvuKVH0RZo2ppToRFx+",
+	"CM4FqBDUoRn1AzWy/MUAM2QI2I0dtkXG/tDPpkCBuSfD9aU/G/uXlq/SMr1iImxNFKEi6CY4jqhsP9iG",
+	"7dIRJ5s4R0ybsRsCXBeAvahfvbA3IodYwk+poL7X8JM0zDZjklvyca25j4ca/JeYJGfHZNjsT4ZO3p/e",
+	"3LqKd4IhuJQIwNcT91U0V3wFNwjFgOCNfBARiKODWJnDh9lxEUFmLQ94MnABpKroxUR5CefRg/GM7iKh",
+	"qvnwq2iENh2EgLul5K1uI4dyk5iC7r+yLdsawLLqKJSmI4jMwwPhhbZsohsOKswFgAIsKRfgeDw2QLAc",
+	"9fK35gYzON1mMEfbdFW56XdY1va+QZUjFrgqGSKxalSTOs/QeJTkCdZpEW5GwVB2lJg7nshtD1NdPPG0",
+	"n1i3NYpOHr55jdgcnU9rg4xB/pG2K42KjMBhTtdsGvhwp0hzbh0nrFJD6HVNVYWkscIYIawrxZtQXWvo",
+	"ZaqxO1h50vWt11RvLOeq/LNQ1EmuMsfcoBJY5EL9XeN609vcnJu9C/fMGfB1FTkCNXvu3t8iLR8s5dN6",
+	"9/lQHl3+qDCr6NrEG53B+M5ogUmy2235uXyRdcq14PRrlC278VrJJz8yOkWSRnTHX3adVmOnV/qjTpds",
+	"sNrOaMr4TXawFIamlJUWSp2DrInNMZwdkJ1ZZyCq8BKVoguEpCKw4tTLygzngQwZrt/43Q3n66WYiO/O",
+	"RjERwwsPzng7kLrzPnGlEbLf5wJWlbydNzbHOuMzJebxhJTHgaHbOh0+do15p6VrQWAyf46rKndpToWP",
+	"YpKtWKje8lTBrdfoFWTJXSH3WXzWN2lzLR/5d95YTusEI/ftB0eHqeHmjgtnZgjX3Y30CT44gdOPMj+K",
+	"lBe0oiyWLmhfAFP5BpjSEinZ4/0lYGjFEEdE6Dt8tECfgD67MAToby9/ePXk5VO1ByEQkwP/7789+v38",
+	"4BU8mI0Pnn7487vP/8f/8/Tz43/ros45kTgX/rvy2/gOL7bf1tnF+asn4x1sq7O2mKczN53aYRUt34Qq",
+	"bapzxZI2YLVm0wVMFBPrVz+jk3WyuxVkiHSLRsqySokyl5hURrAmJWJFS2o6BFd0BSp0jSq/5IdyvhEj",
+	"JAaMfTzOLZjlL64Lf96t53PE7fXEtb4YkTvXSSXeqhkkH51qhhlYMTqBE1wpVVkHoNgfsLZBy5uUDyoE",
+	"GUGlPxgsVdKGoIbvZmke76aUodLbUdwcLNb9I7kxZBZh5aW8wHnKw6qy6Sv8EVUm+5xQEbG+F10Htbud",
+	"JvRbDdVyE+4ouqDDEiOrqhj6PwoEBjkig4TPEHNsQXKFTiWmVffifjVpo/7C6RSthEJYY2o4BBceVDIE",
+	"rIpWKnNrrbKVQCxUyJW5VXpDACLlimIieAFuFni60JLfApKyQua1kNP16vOfozrtCzStIEPlS11acas8",
+	"/6axV+quK4i1fNwsAXD25LuuCpzblzW0dSOjo3eHF0QNfnXwi286LelaR65sF2iwd43rJwQrsYiOuEUY",
+	"Uju3aHT8ZBxl1ukEvu7THZi0N1QoSM36AhGhTH4rhWam5mR/mEXa6Nq4WvRplbjapaqWGRvOFdQE+h1J",
+	"CTqqk8qRvv/haXqOOPC8ksHpxEOpRWPaYI6fIUGpsrmWpWUxnis4/wVOULW/OI9OI4BmXDU1C0mOdyvB",
+	"4cU4XINkJgIOp3GKU5sSSjOMX9U2CL5v62TboLA2RmdgMC67hrXiqD3WQjoZVClkdVgFQMuV2Dj7q4xp",
+	"VTKatr3k0bx+qXt3q0jTyYRb4d16aVfRfXMyRvYsg2PE4lgMOfdg2l7fNJmS2QBH/hatKItptRvf+5CF",
+	"slFIj0jGk42pAByPH1Bx42DRQdxWcONVfB4Vu17eFZx3rk3AeeECJTlScTSAIwU+2pumFsa1mV6Cl0q4",
+	"qn1EO11vVhb3DPMprMAGQdZkp0/yU7kdBEd4aQPIAWUlYlr7GJL639h1bMN6L/+QW4lE2rh92q0zBeGF",
+	"4mElgDPhZPMKkRIy/TI22eXShBp6wk/ObpNf3nHwZwfjs8zyp6niAzrgJkVotipFkAd2DXLkXUmY++6W",
+	"XvgUxSKZRws84IqTrUjQQ9sfA8V0MSB+QsWdjj57xqfWAasMKAYYmq8ryOTRMsQ5tkUt6pfBcm2jgYOL",
+	"JkjMKvypvyCk+dIupecUXtutNmXCG0Mi1SgqkoYuVwosFJkKgtDqT3lh+ylwgOeEKh845L730D4fyXXP",
+	"0aeo1q17ukTEm3JAKKwa5EJ+8vlDMfp0MKcHcqID/hGvDmz84oGSxBHTCqwSYjk39bPq039rTtj0jJAe",
+	"Q8y5H8xv1p47T9PcIVdZzx27tHo3nn3jVypsuyG1mEuiKjm9gQwukVD4YNvl6G45+mCL0SvKJrgsERkV",
+	"chDdMqiom/l8iG1MznpwDZkkgVxO79bUXId7EFmQ9yy2spdMPfQXaH7y1ml+qZcrD+iTNoG8hQLxmH2d",
+	"o4ukKPsccr/6oY1FVkPldKTIUET16iJjnoxPTg/G32XqosxuD7pGF2+CjWZU5Gyok/7K1N5tAUxXI7Bh",
+	"6/lz9PL929Gz8eHTk2L04/M38p/fPy1GP7/5h9QinhyenrQNP838LP8+nL6ldxeFf0+nzicB9UcdYfA6",
+	"kuy821xTogkOyrIbubFI1eQMQtHODrMyA81SLjJUruZ0UYNYnYg+KJdrWGJAa9KB+QGyVp26oHgO1N8X",
+	"GwCBvNFyXYWphSbDllAhE6xWlAtUeqnmaoWmAZu/fUz4ejbDUywh3J5jXQrxlgkMKeNP2jbjeR5/zapy",
+	"5H1gr7jQcqdSaAQ47omWbnk7L6O16OtZVEV35SkKjl/NzaUS4y+JzgqAZwCSTfPYU0r44NjI6ARqlMQM",
+	"dfOEN1qmiWYiWJ8wmOCqau9XQvccEcSgpQTRdTCUqjmb583xqFbtyRmW69Jc9a46jtWidzzBxW2xaJDV",
+	"Nm2Lh7t5e1dVMS/0NG1+jkJ+kHmeGvRL9CkCapRj34gr1RxL23WBTVObt5Fp3hORoGcLHVA9/E1tPCEC",
+	"73LxUcLgyb9tOuyGDghvTfATNDZJWxNn1SkHN87pUqBlrPY+aPN+r1jqIbgUXEvzxk1WVS6nCSwQQ4BT",
+	"Hf0kJX2VickA1tKsTqiCzBoF6gBftYHGHbjyaQzJPVjr082CVsjeyqB0hyuP+1++aFMp3dGITjEURl3L",
+	"dYelivk2CIp+rwBzde9Me4//hRgd3lxk6lkHc4N0Bxmk6zLs4SYOwd9NFQmTSR2TqYpGo6h4zoFXwBsK",
+	"gDw15HCQ1BVXIq5M0csoEzTRZDrAl5ZwcwvFwtp9enLaPThYQA4mSMpYym5TbZztKCfQu9OpdtWwivQ4",
+	"1n6iAlVxU2N9G1EhTvCkQG1ve4VkHiSOeWw9SGkhwyF44d+OjBuBQlKhQBVUd6uKdlHmZ0D7k5RNUDo+",
+	"HP+Qg11biFUcoQjlzBazkq2CUjCEuWuMQhkglByYP/0pZ7DiURiKh7fIBEa1OThvkcIWucw1aEpDY8x+",
+	"2cugkhF/btqGKQKJG4lUxypg48l47K+W6z4NuraZa1zjFe6W8Qg1P9KsHwuOqlkhOZrWhrwPfA6muNdh",
+	"7oFEGXGs/6uxWXXWunEF0NsNbsr6ij5kHXc8yH5aC5KNsAb9IDjlwvc7WKlkq1Mxo8cOBjFGWQQALtXl",
+	"iLqCVChm7W5trnP2Xq7Mkwr1RntuL5523Az0HrrR/XYkDihlQ8Hc0krVGGVn1RtazQPvopZDa9JMPZmg",
+	"T+LFGsXT4F+sQ4lIvmwUdlrbgSJuspODk6c5ktDAshLxk92Rxu2fxJYV+rvAK450Zh9bIVxritsk/reO",
+	"dmifufqrdH5kDvptG6GowBJvE5nYHxVqoc1apLiaje+8Fmwdr6jiD+QkqnuYlEAQF1h3SldIb/5EpV7K",
+	"NpWP9Spysgrqyzqvv5EjrAV9Q7nIFkK1JFQb9uQAclNap1GIXq5RoXur3GCO6oMAN1Aew5oIXBllckrJ",
+	"DLNlpgQ7pFJUvcJGmssWpQHSMYxTusK1jpsx58sKTQXDUyw26kwGVWfxfWS62jLlHMsyi+p4913UdqYM",
+	"MMaYkAdsr9wnypYoELuO0bJL80T21lkjxxLdhLryTmEKZ5zoktQ82NtxXy3cjlox/rnqCmMabyulpMp/",
+	"m6rYEnz9qtjRerWnt61X60dAWoOwh+nNQMv6Wrwj9vfrYXk+Sad8eHuEc9UU0Ctua6m6LkrLcIl4QI3N",
+	"m3XHHgnG2qHKa+dhTTcDm9lJjpuwVxAdzrIeiEnxm/3wTu2Hf1FjoUuYc/cqa/kXpo6WtM/PKC0LsNjM",
+	"MSKo0I1qCZx+5PdpYtyxPRFcqvisUlMqWS7bC/YA0HzG4TJmetxNdf/B5kmDdBLdOBKFjeJFtl+Tzi9g",
+	"AFYYmtpUhjr74IG5wmrtAjIW9CDFas3tsRAaPFDl9A6/WGMouHyRZQ91U/0eSUse/W08fvVKJXZO7S/y",
+	"b/VLq6llBNFG2oogl/vH8cnp2ZPvvv/h6djGoXtfqbC9W9lmI/2Fgjj3RnaHJ6SUqdL5bd98zBervHzc",
+	"9TVrgfGrtfz/QdkSrOVHG2DKThi1QifeyXd1Ch9VCABnMzQN2Y5tbuk+I34FfDOoujdTpnukg2+Csh81",
+	"+LrEoKQxNdNCUgfh79/k5ObS1IESTcfWHDFZb0llsgqqpF6dEaAyKnWRxi1yQ9x0mdYck2rhji8GYu7c",
+	"6/yGdMpoZtm6Vh6ElEYUIojKddzDxDXSkWQnSGv0VdahhkfKtrt5pbck0snko758sl2BUseIFUyt8Be4",
+	"xQIJnn5MAJ15IhnDiqEZYpJ3Dx2f0Vizire0QjZYpB7F6Ke6gkABVF2uAjBKl0uolfDbG04tZOzIWFoD",
+	"jHczZtf5NtMWBsaNpIZ8pDlu80J4rp+mMX2e27HxUVarqXsgHjvH6W8YmMSJLnToBPsk8AxjiEtMdGMo",
+	"33zVVQYktqhLMqVL9BxyzAOgVZ7BqaYXTcub/EKtxivboxNrZlJhJ4fAfqxbDq1XJnpLVYuIuR2cq50S",
+	"dEBnM/tu3d1EGc8KALW9yBsOcQArhmC5kQMjfI1KXyzztqG/jcpil3qsfJ+i+SDtPhzGC/1CGnWI3/xW",
+	"lQ3NEuPUFatLzPdA2QPaq7u3cahDLWzt4H2lfFmwm6CKElVnuWlcE6GiNMjKZkdvW9hOnoz3Z2PrkBEX",
+	"CIi2NGDXGQqKTfvU2cHxk6vjk2enZ8+efPe/siXJtMnvKpK9HgP2W9ncYgPq/TzJzNzstH+du0wgUCIB",
+	"ccUBnNC1SM5t22NyWEG2kSaUVCmKHhONnkCRW/kfno6JHxSbn++M8XzMukZd/DL0s/o65GKD5Z1Plwhc",
+	"ULbqqjixhWElcvq3NKq8a95ZpzFFv71jS0rMmeNlBhgYnqYD/Qs/t+RC2UAHeGQCV4yXdxKYKY+f5Odb",
+	"dXjQGlP4RnxKlBfcT7OyXZqUt19KCQiyanOLpufb1N5InEh/09H8qjNqvzaQPTVfOt8p1crdTzjS7wzN",
+	"ato6vybVgL1ZFiZaAaYGos4iZd72fptUeA7j2eeZkSXesdvQT6/VQY5v7pbAxQdB1yJUT2O7++2G1Clu",
+	"icpDE8RSxnZt3rz0F9iRRhccH6F1P4ENEoUNk7X5bV6HFbeapzH4W9lCsRnTmgq7ileYxSePN+r9d+3r",
+	"zruaOCvrJ1EUKdhzQxJ1BQyzNn88Ph3emM/nG21wCFCqubX49drzzka2uKpB3XMeSwk0KwHeazLytmUU",
+	"Ue1nh9WSiS6yl//66+3ZukztHKIzBh/2ZUzzTgruIEzeUSFPzBHVoWdjJISIajfMFN1Evi2N0gSSaTpd",
+	"XMcw+o19saoGIxBDXBS6za+tJBoScYuCAaZlNecvBqvz4Ulk5+k+BCJ7O7p3B4RO2aLtjb/VckEDbPJJ",
+	"nMW1bq/RhzbeJ0J7LYcaioRyyFuE8rYYX2CJ6A/kNZ+ko3gTxOsexasBkZ3+6ewzttOSZFOGuvCCPXUc",
+	"or8QJZlI4uVJw3Ytrxgu52mn3UWfXhVEJnqT2gDF4Ph3G6cY1D7oJF9LYyZJSmUnql2WCdA8GfeFawYk",
+	"obOBk9d+XPdWUCXgbIMFn8MICgxpATjUgAe3v1LjpmjqG4anqA0/GbVPdMfqXUQMdciTQbRpGNbhb6tx",
+	"921ojVOWaywSypok4hHq91L+LK9OmTQ1httBlNTBkbo7H1r1UHHFHDPEY0LOexXp4Qk4bhJBPyJiW0+v",
+	"+d7iLeopb1l11g0mo7lkzOwE1fEaBYCq2Z7nfzRZe2rvJkU/z0lsz7k+1u5LP1c1tpP8ZJBDU+5hzRHT",
+	"MYXej2ZjsZuU33NbXv827s/dT76CnN9QFjUP6yfNuX0odF8HnsIfIvMoYI4YDIiGYgPs1rsGcGsbCdxq",
+	"gIeepvAXFnWhdsPLzxSnpY873Un3OpNrzKNpVNKwsk12skhami68/IR19FxLv5b6Qx3ABRU4SUVC/iV/",
+	"DyjIzkiG3kLsJAN3YesQVYZ2zGmwxCIMKv/nGvkdjT15gc5mHEUG+U39njsKp7EKo+8oE34ZSrhaVV7a",
+	"T2O43kLFTXEfVUjLbq5BrBb3KoEYmDIsEMOwv52Pnq0wp+lOJHoddI4zYLofNn2iFqNVOfDijdKxVr6i",
+	"0e4HAcPPY9qOrrSeKKrbo+a956m8EuXmy7cgqdd3lbJsIrv3HjKq5sl2Vg6Lcav3sKMQNxXajoZ2mFf3",
+	"ksr1tf3KsiwCaqRb2AHMdAOzeM03ae0/ALzWHu2htSmpinHjK1RVsjeY02/UrWHSUeZW0TXJoeeEytty",
+	"ZW6dm3f0y+WLX4CGogqXat35beCNbniRY0RwjEBl5PrR5CaJgSCXvRC3KPTmSfYHfrWx6Be56a3jvtSN",
+	"drSa7Ot0kDKjHJ8ku/al4xwuXxQ26ySWDKa9OHQtBtIS9cxK730Hl0Qx4xDnK1XOUsRv40RWZR3eIKBe",
+	"YU5XgODOUi0BfLdjb38IlTL/7M+IqScvbibVjdEuUmVeqZcKMMGqPZXrj51PD+1ocboYX/9pfsX2juXT",
+	"mTHcBWlEJr4akjkqtBmPsjSIHo9PTw6/f5LVb2hNbHf6gQfTzA6uDUWmKoLSHByl9+bpJPuIKUE+XjcW",
+	"CgABo5WOA73htrtZCQVsxas+AwzB0vValSqyro0km2ExLBB4pDmueqQZ7mP7egF0U2TwSP+/8Bpt6aGo",
+	"tGjA6UfvE8rAEhI4R83EFe0wsjpVHXsqt6J4lHxBZbR4caRy9aNipJaqbGCmSbOeIhpU2uhy+wYxTMth",
+	"bfUCPKobpFhUWhObz8bti4OwK9V5eNv+FSu9xe1Nx7n9GjomGtqq4fZ9ihOdFHo6ljbGziHnOVEkmlzZ",
+	"nGji9dbI6Re0cjDaHR8oxzPw7L6KperpB3VePq1KCbdGC6YE2X4GVoVFMgNvQCOSOI5F+cTqvcWWiw6M",
+	"q5/ZMEPUhW70GjHnbrbnsHvsuxW4FiO38CsWxWN3MoBJmhgWDFGbavanWCAArxGT1NWiJEPXmMqETIJ8",
+	"wonJlCFojEEl8v7gQsnEH/pkWIfsHlerj9rhW+KGW7vPQMYrd9xt72404zjo8GLPMiUi/3AyPswVB/Jm",
+	"XJO+OY+/fzp0zrcSFCIsaQGZu3SHB6qcntTDpRhvBig03o8lugexhePD4x+2kJVdrvaaRP6tlxu73Ggh",
+	"o86kcVVUpK4r8ZHI7p2YAFheK1fgoxn+hMrHUsTQfUKJX6lJl0lzdYoatUowNxXUwCPzjTwpmYoE1sJ0",
+	"w33s4Y+aSv5tX47KGrH6OdG2M3QmEJFyW1iLfQZlxZpy7beSKSFW0bUyq1z9wzhsR8VooyNvu1cyuFDf",
+	"8Op8f8B4GarMonxt6UGmP4yfnY6fjcf/636q9h2fnCIZZ36Afng6OTg+KU8P4NmT7w7OTr777vjs+Puz",
+	"8XjcYTjb6kQaxrOdHEu0vn19a8GC41a1AdXiumPZXW+3evuhKz8vUSc7DCTzpl+7+AeV4WG/0kEbp6Zi",
+	"VaLa1x/xzJiXRnzqWMLd1vxqyPH2Ud31zb+QIXSnXm39tI2YOysfJtcysHqYoH+YEIo/uttwepktsWyi",
+	"DIJw3J+rGq/45eLtmzEd/tLjPHW2JuVW4V9ThkosfEmS6cFuV/JKrwiVDd1ni/49J3m5LQPLNMjVbeVt",
+	"sefV00DJHWtY/Sorhih7x2qGiy0SG9zqYokH21Xe8gPdIhBkC26djPfRgjoy4Vsk1kwphwuK+C1TgDyY",
+	"SeStdvQ7GiiOtHbC0Cwz8ziSwRPNXgtBOAJKiY7Q3ZVcNMrH/W7MkIOU5mSfF3snFHomnqJYPNonwBhK",
+	"Blnz9Hgx84ELhk6YcTEHFZopu4xe7W1O5fusSrQNWLKHVNQX5y87ffu3CUROcaJG44/GzmMUbDi7Onfx",
+	"pQbNhx14uMSb6CU2QXU3VeVyiXFu95R90ufuiwxJTT4h/xwFRs8kGjEqmibGxphWG2XBDM/rEsmmE/pk",
+	"40nDNhr7n2vIdIfPDYIsrnqb4ie3qURhivls4aE2XzbzCRNmZuNXihuN7UM3LpgzqCOK86zCbojebC+/",
+	"bpi/rCjFoVUlnUxJmsPcC/Fei/p3ddbyVWV9gVMdWvcyqBHYzXkb88TW+g5ey5CLC0oEw5O1RZw8Q0zk",
+	"411FHU29Me8g+MifLpQ5KUmle3C9+x8prC47FTfzIphTaLoZBrtLCG7y7QSzGBb81DrKHcVAhfsfFgnV",
+	"ATnZVpur5t7atVWOMy02WeVDkjCiWdT3mTV7CRWId1YPUW94tUOSE1vLUHAKypiuS4pEoVbV4DhPSz2x",
+	"8jiNg9Z9yJUbOdJLIYEutyjfkTqAW9bweKnj7xXnBHzNZJpoXyWPl7+9Bu/Mq/spjGrgpnlPHcRbYuBg",
+	"oi0/2hWxDgjcVrYLXcYuZbrQ6p+wJhsOr0P78/eZltlhPMHflT+bJHzJxFyVMHZhKrtGhRabUUZn+rz8",
+	"MrADVcYVoxIUUGkmxJTEk/1euoYgU/empm0TaBqUBAi+wFzoKjIBlXuSX5mcJ6ry2qsMq/I2j9rItHWf",
+	"He+M4ASSkhLt4PIJj3m3m1tmMrs0y5J4k6pEuU2Bs4CP5gcA+6ifVZgygvZDdOE2V7hR7Vm1aQIyBCTE",
+	"oDKTGcC1oI75d3lZaaMNjANTk4eJ2/iZbKwTzHneq3DL9w+8+bRSptcRtkjIIj7h9K8yvR/hIpTKsQ8P",
+	"yG4bOFw0rLpJSpoy5HZr8UIXDIj0GkhOpKFfkXadj4RMtxwwhfEaxQQLnM7DvdSPLUeylSVbnOnJOA84",
+	"+tXm5NZ+RTfgIr6JFcOUYbGJ5hGrJ6BC16gCj44PnhQGo4+leLfA8wXi4nFPeYBbSXXNndy6Ipt3xfpW",
+	"OyU5eWa3k+Dk7tkcJcWWK/XUSxGX4BHkXIwzwUPPE+ftZhbFzZVNT3qwVc8oKV08st20H7eZecp1m5Eq",
+	"EFSaC04hCL+COeLrG0ZdVdVmdkFN934yIonPIDP1RGXID2iokfGgaBcxOM27Ef1day6lkUnID6d79I9/",
+	"/OMfB69fAz3w45j6mHURrTNsQuT+iPItZfRcSljCDX+b9kPUmSXyReBM/7bbQo0MjbSLGPGaDzLdtKwz",
+	"qbp6t6bl4JU2y7eGpuSKwenH7qg0hfdYh9kzZTY0gWZGhlZdiePnlGxqsjPF5vT08PR0p7rNG/uiJoFK",
+	"KdWKqClfoCii3LZTdQyossbujYpzmqniMCR3fC71Diy2IUx1CU1oB9mV7cr6WWIDD7RXDXGpRKfbxkol",
+	"orGXMiKzOY0RhRsmIilllAze6ISV0JzrvbYFge0te2VulQsJfgShUsdaaL7cxPjjYRLA1pLG8ZN9Sxr5",
+	"kWHd4sXcmpONnBEVLuq/m4QpVtGrswSuEUKu6hTTW+rFErKPlLMVm+NZUoI2yi6mEll1y4ItnMBmIi8b",
+	"dnRLCqFSmvAS1aY8b3BAp9O1WWqeGa+TVPy2csWxW3pbuKVb+nJ6BruF28Y/nMBrs0tjd7j8r6MJWDHy",
+	"dtV96gxV6kB0OS2pY/un/gjPdI2MqYwtfTycdTRON4d7bOEKaxrz1RB51Ge3tkVvu4Nti96ier0XwTxd",
+	"qaJm7L/XJzzUcVF/uiv3RX3dd+BpricLOKb8efd+Zm9n+/YyNw7x4fiY2/AyyMPs7Wvb7rh57uUEYGhh",
+	"/Yf8+CeBiS2YNszF6+1UWedUvlZumc3hXu3Eft9Apiyr3mok6qyJ6xUk/0F4QiXrDajpmPrlErG5bggL",
+	"GWBoBTHbirknOHt82ts6sd2i63Pp9mDbD/brv45CoruhKGuYUobKd2uVRBuVw1WuXKnSQGJmgAmcyMS4",
+	"jS6xoswcSvouURWmFf7wfSytsK4h2hPa2J2mwfUGpK/VlZdlEiq2tBXlD9fV6rI+uejRI8imi5/wfFHh",
+	"+SJaXhFVkV2/NuV31GPwyHtYmGYvRXPZoe2zkU/SYn0Er1YomukvJ9RJOS4q1xU5u1G1I24YXK109b7/",
+	"Wo/Hp1O0VP9HCkWDZYTPX0imzYX+8ch9BVcriomI9+hp9XxDqvea3UDXoW8XlOxSekvEqo2i1d16T55y",
+	"mRwvv0FVvA6mK5MkIVKX8VkxpAqwKrJsNGOwwIIPa5PRNGznH0l/jHL/cWyvEF/Fq1rFxllYzBwgxDdQ",
+	"OqKTRelYLbHSWY1VYcp44dqEeTcXxV9J0mPsuEI6Q1q9UGh3o6oOOUFChPVJ0ynoqVZTpj+co0Aq99oH",
+	"Nrv6FKzFGfyvqgirORX5ilcHU/60wEEKRm9tMauo5tyiTkaPUng1jGO7sNMLZ28kgKhO2mST4P0+iXX4",
+	"cZ2H6KAgFnCuR3uL+LqKELsF3gKsxU5bDPqH6gYp9Mqix+OElNdSuki1IHcdELh7X4sjoEJQpQ6Y+Mn8",
+	"Sj9e3RGOELHpIIaV8WjpUfcwVh9YtCuoGf6Jie15HiNOvTEAV3A+YI3XdAona+UOaBNkVYt2Ksy6/DG9",
+	"F3l/HVNvBVO/8oeRB7w1xC5dSsHZxoorON+VeUJaC/dvl9ASZS0SCTjfSelNu/odGQSGGACu4NxKHcmy",
+	"SR2oZgSjeEmtQRl33kK6qve4YordjVfutHKfgPOM3e2wwl5d/ubsyeHJ8PIviZJ2GrxDrO8tpBi7txQI",
+	"mdqgOX1CmoKyn1LGBVzbHKb2hy0Aibbfa5/lcWYpoeZZ1ltrrDr39OhyYowASQzsqyBqSwEGJaybGtzZ",
+	"vSOKWl4GqiiV2eeat/Kq7KOqZagQZh3/6fHJ4dkucZWP7IlmQtovcIKqvKTGK+V9y2R2cQONHILARvPa",
+	"nxCsxKI9SNIsk9oKJunUQQHnl2VK2nJ+gCUCcmQAuXeHYVnkestwLm26A8oiNzZklpTYzWuLF00VUIOV",
+	"10qgqnzlqjDCp8RnxtFUG5WoDgknVtlf2tfWJPTowUqCDCSNEGj9c2t/cp2IzVHHsbM5incUh/P2uety",
+	"MHJvUqdd0ut2x6oUyLUO10ycOl697LhmpSaOWarJx3pV5sTlNIVaskqCtmhvI5sFnM/9DHhhIk4YAiWj",
+	"0s7WrO7siAEm4ruzhOIgB8mifdFjGRVmi4nDSV5ny+DePKLn7gWgDPBgSktd/uf9JWDIGBN0HbdHC/Qp",
+	"Gtj5t5enr05evVAFdU2Js9H//tuj388PXsGD2fjg6Yc/v/v8f/w/Tz8//rc444+u8gp9ErdZ38nx0+9e",
+	"ne5gfUPT7pvKxls0l3qXa/VlXBl8O5N5c/SOvAcB51cZZpgr81oiFtqOkgDDW5RPN2jY24xR0eBQFkjz",
+	"NAHnbdHzGk4VuBzIIMhdHNVA+SK0pTWucEtdQJ9KveF6E5lSxU6LqAc1N7tLOHuvyqPYXu3sUjdvK/Ea",
+	"41+8Aa0qVbdCta11b9u4jQycJ482iqXW+05ATbfNNPQjRCyoNurhDxNWqf/ifwTuY/tjELkUs712BjZO",
+	"YAVlvdOZQCztajJvAShfa0UJPnINXBheQraxMQWPR/nFwvqrlT1aUY5lhqd2+kUHT5Qc9CbL9iFt7zja",
+	"eUjlpON41FN5OgTN4W1PZzAT7z6jmev4kGqveU42QL4ViZYYHuUqEbYj5OWd8gW5eJfLFwZuDdLxIjCD",
+	"Fy6WqkY6/jh9iHsKVaUMzzGB1VVu8KT9oI2gthRM3i4iE191h1XmTz7Ek9S7UBMt+pIILDZ5gaVIvQse",
+	"xfyZhQOIIoimyzq0YC39xxWuZ+cHk19iyBRPUq/n7DOV2v8uSOmPB9paPh6m9OsO4PJHyWeqKlG8+ltQ",
+	"dUv06aB4L+oArC6y56SZGuKD3MUs0BfZdCIKFb0QX8Ozq/yXGZndXFmqgGQt+Rnwjsp2DypMe0h8tufU",
+	"DuZK7nKG2ABnp/ki7fHcgi3OGr3OBZulAqTzCZIZdAtq5OeQ1e9mQGASnj54Z70HcJLoPQyWZsaW0FtL",
+	"pHnfrYX3B1ZFr9jWetxBYqIXeFyqghF+6HF08tdU1w9QBU0EddSwruN562jrsk2SM8Or/Zl6C5dMUN26",
+	"GIpgVkX9Xe3QKSXXiHFL5mtv2pPcQvd2YKs/5e8/Vl969PL927jlZLqAZI7exosbmacqn7fuwhjZZRgF",
+	"/DRLrYj2revXXBrQlVXOaUBpNh6oM5lFd8z4vfBjN2CSdJNTZRa+0QPkQYiZLAoc0boEDYrbPMJkHHp2",
+	"htb7lS519xxX1VaBuq6ji81HX0FcRgvnbVcamM7cFEWjw4xEA+RKf8k3Qqd5VgVmu4Ac42+0hY6pffSG",
+	"cpHRTsedkm5+E1R/yipVMM3p1eodWrwV661dHK2hX5qKQ1L5NA/bk6zRizTrdGcUdOFpGD5PDk6e5rXk",
+	"VC6f2Cm9bTcDcVMn0sgYmuZ4M+tJizC4BIYY60nmDlE9KKwPygOtPuSNi1gaKXLFJH+8W3RfXpthIijZ",
+	"G8NoP0mnWL43rcazYw5d2x/Vy9/Vd0u3QDrVvX6A7fXz/4zlf7Ntmq4LfEMf4Ij9OwfqKYBl2epwIpf3",
+	"P8yfh1O69CdM9pFXroZ4SoKZUL3RDuf4mS7isfi2Q2gMdX6yD11vPO3W0jWuC9fO0DttwQHtnui1+rh7",
+	"Oj1BPW5F5yqCF/ICqGLVdYkc+wr2nW6U7CCGVA7buDFWm0Bi41ew53IqGLubFzS6WtWZtSdw33Vvrc/B",
+	"xjm7Ax8Uxt8RDptGrK5OWrfCrmjvDYMbNSp4B58fUqspiwbGzhilZT68Bm1gRH0DEiQrOtcQ3LtLN2Fq",
+	"0bLiPe8qx54JNu4u5RpNv1Iiu+WRQmpaFZLXqlHJC7XSy7tFhJVeYHtzUriWvBqLzTvJofQuniPIEDtf",
+	"6/pkE/XXKws6P//9SmWByLdHz8zT+oAXQqxGnz8r7+6M2hpscCpPTa4SC52kK1ckIMNQgOfrco4EOH9z",
+	"KUPojaL1bHR8OD4cy53RFSJwhUfPRqeH48MTHW2yUCs9Miih/pijaLcmsWaEAwgqY+aVYWK6oIr92LTW",
+	"tuVFZrgSiGmzjrxlaN0mylJ8bmeUy2BwiYQyk/zeTjCUo4CJm0YHdj2aQPJR5jTyRSH7VwqJk4+V0XL0",
+	"bPTPNVLljIyh12TmaOEhcuufi/SsngYUG9p7vNXwulquqcKbmMNV1G3N4KTt9hQXdLmEBxzJw5Xyu702",
+	"TpkAH9GG/79gxdAMfwJQ/qnv7t8P/l3xEDmUbZjLSsQOwbnsGI5K9eUzkzooF1G4A5IWbFWL87n2Utsn",
+	"ov7bo2+xfXLd9HjAOf6Cl1grdsSL1JExeKmzrOQXsUk8Ma9VMGc240gYX5EcHHCU2gNV73ZP8KEYMcRX",
+	"lJiQjZPx2CI40vqlqawi5z/6b+M2qgfsEowNWikZW5GPuI/G4fvnYnQ2HqdGdcs8ki+pd49z3j2W7z7J",
+	"GVe+pMjnerlUOUd6iZK4wJpCaIfT7yNHNKTJdhXVYy8UkHFTXdbRDGpK8GmKpaiHfWipCCbXiKusYkdQ",
+	"CoDE9PBxi4DpSc6dJYZpjvaclptd36Qz5Ye8SLA1+tyCo+Ndzx6DIa8bk6IsfD2dIs5na2kYeHjwpO/K",
+	"wEPAsOKA9bmo2eHRn7j8rGEsXm3xhfqdS3OPBbXJRge1hxCjX/QhJri4s/6dyZcGnthZzrhndtynOe8+",
+	"vcVN6DPoPv2iT/yQClSFek77RySSRz2+SxyZUdPhbV/XtuVV/IhE6wjjRLZLNjt33mzLDaVEWTNDXI6a",
+	"NKuLvX8oRqt15PLfK11IIRn6pFJQ5x4QhTev370TypxHku8U3IzWeGck+Q4gVV/oQJJ9pERmY8C9axCO",
+	"59mcmxW1ecVhC4jtu900rI9djL/wm7en4B1Y7vVPITmoWXaUo1ws0PQjBzgsvzWFsqAk0N+WbbkPkh5G",
+	"vjt0d3PFEP6dh+BgKrei+jCYhjKownOsSzB94TBQ31Lyhu6fbbXhr0QPjwC9QHAACarf3onM+sUCYH0O",
+	"eWSoqqhJ31lVkPj2tIgJzL38Rr2bbwkjG1l135pE5UxE+jZMC4WYXcI+qyGnNyOj3ybmAe02ZrG92kGC",
+	"s+01h7i31WHyAlQST0xXCpMlc7fmj2A9PsDVjzTUpE0h5lVjLsc6FUju1mxL2vTruxQ0yHaqKzSoTAo/",
+	"CJsfAlkzs14kl1SZUFnBZopQGUxHHIjKuv+YH6aMKcGF7UtyDya5L9NKuNOYOB9e/51bWu7AFmCtMqQJ",
+	"692gHqOxESNN1PbSBrAHZoK5pVllyDn2mFkcysolQOYH1Rm8VtlVSnAWa1gBbpMm6QxgwR0raptlem5h",
+	"fI9o9qCNNY21tow2UbbQKXg2RtyzEectWlVwGvIixRBqUKr5SWHbNYiFeuyYFGQIfESrpN3n7pmInngQ",
+	"K7lPGP+KLUSD+chaLI4qOsfqwK0I1RDT1eP9wJEa+54Ax8ytR+82MAB9RPW9380CLsk1rHCpEo8RkX5l",
+	"3pSU5RC2TgHfcIGW/qWvxUJ+p1fnXzlDM4b4In3pb/ULV/Qj2iuH6j0DtQJg1ovKO7+D90QeGGX4X6jU",
+	"h2+CXBQ78cNbfv/w+YN/N+YIAQxuAQhzpDmXNMfcJMOnbkm/8V7Hum2LoWEE0pcdEbnbIL4V5FwW+kyO",
+	"5l4IGrxKEEFv/gfnN2MWpNd7ry8x+QWRuYScH/piyuzxeZ9H4+ciUVk7V+sawPJphRni0fqbCnPVCwby",
+	"8RJF4gvPVHzh2VbRuxqZWlP//PerFNrVs6PNz4vJj1P8G/758v2/Lo9/xZf8krx9Mr24/O7y4+r//8+L",
+	"n58eHh7Gpl2bqObO8GxuAqYjN9IGpUAYARbzLb3bHb19yRhlXbzG0BBAmUV1U3QLE7DmyJNR9r0egZgM",
+	"oeOIXSMGkHkxIMANeqsPzYQ5mPjfTjJrion0h/zZmFD7AYBTRjlvxui0VL3ndoIeReRCpYXpmB83h+0p",
+	"vpfwu5/ojZxAZpuvdEaFnrYndky9/zycq+6QP23X+27kMt2J4dOc+jsDGhHoumjc5/4Mm/Ld45x3jyMa",
+	"bxPs0kb3iQp8PaDXiF1jdNML0GJhAy6luUKXCDRxmHooie9YcNNoXqXhm+a2wvQKKmqDiP6ES7EAlWC9",
+	"0nWKayBo44X64De72h7sUAlHcjJTaFuviRfAlHPRqEJLmEIRY97PN/zvFTrDvUegU78B3GXejdW9joef",
+	"NOavoU6vLAF0R1O62hxIYeRA35AvuDZUKwktyuDh8ga3gkGr+tTDqPcZusZ0bT8slP2EeZBvV+xygTUU",
+	"NGzydLX5BXIDq2/0jraDVLlMjsQ3gN1tVCNdbbzbd+kRSsI3ELSy19YFwd0uSvtFtmvSNRlKuwb9dMN7",
+	"jZnfP9z0+R3tFdyha3HibrQNFf0x1c7Or+LoVa6Aispr+Q03gBJD1hwYxtx+evI9Wdj04Pfk5jM7S1OM",
+	"Ly1+emKvqpOcZHrjvHv/qrxwHYdUxMmskwhHeydHHcD4kH1fhp01XV4B3eriTmaLO3dwxTxQD42e3SEI",
+	"fb2upQGU72iBuaBs06uE3iygzkCG1xCrom46zmaFvFIiThPwOanUMQV1GexR7XRGZb6e/c2Mw+SP18oy",
+	"lCBBP5nFD5T0ldBp9Y0vWMy320/DuL3dLxusfzTZjGY3Oiask3PdJX1toZRRJI/+1Emen3tjane7oCJd",
+	"Fb5WcwugTFKmkxwY28A3g3pcQCasqh1Zkt5Z57IinXCDlNLVOmVrUKTGxE1r+oJJnUlliAomXCBYNkiP",
+	"1i4LgA7nh0ra56pcqRfx0yIm71BgNThf7jETpz3RvTJH034w5k4PKiCG+vmXTUveGVpSW6HgMPuDxvG6",
+	"dGQe19RwLDmm+olJfw3hbcbZbUJLc8J31riwhcnri+aBaRh+7kiZeuGr4IC1G+CBMcCwH2IKJxhG16hR",
+	"k8P7MlZ348J/nGPUq8dTjdx1KY2sKhvd2U3aTGS7ubR4LOIC8PXEm121gDYVglZQ+YbqpwVQdbiAqUQi",
+	"71bQFajQNWocSXTpDO3ZVtjV7TKr4pk9sFtUOwuOIVLdrNdZ/y7002vwK/1x79CQ2W4c++x3rytvrznT",
+	"uMqtvTJhn7yoH+9DgKnbot6LjbIGqoib2Dz7wuyU3n1GgSIkrpkFHmSejD2OrvoOHrR0ktaIl+S2Nqm/",
+	"ZnKeupy+K+8sK9B/tfq9e73a/RGeew1fzyI/uzEr3pWpcAvy0yhW0Fk9oB9a7ZvfSNE9FSvYCgRKlAEE",
+	"fi5yPz+C3wDhvhPGtwKFJWJzlI4eek2vETf+gHjnJD9VtwBM1ZC2NY4LZeeDVWXLnJHC6L2Fl0BTgBXc",
+	"IGTNGF7XfbvYf6n3AFtbt4XT1FxWuvtbUABtm9rmS3WnXkx0Pd66KYpKAiOm0gMHqixhXcJAfq2Oyukf",
+	"m0NQHy4Q0iw0oWIBFvC6jtIzicoT+3E7HVk18s1Fmrpgvr9dfYVfAPcPei3fE/P3Gyd3yQHmsr8i76La",
+	"uU/KMZG4QqgqxG8KQfeRDY2JBxITeVbkq3rT1FrW1T3lvzaArSUt2IAVw5TpRmhp65Ge9a2adEABVxW3",
+	"RRnAxPybmREeYghXe6994VwR6ngvJpFg/jYMmcf69nKivjSZ5xzPlfXcg1iVuwtVGwJdb5OUAC9XlEmJ",
+	"3WNPHCylTCO/xkI1NtNdgUwpiRoDOKCk2pjJdEX0YBhrjJBGd7oWEksKMEEzypDpbBOyLEOelclwg9Bh",
+	"t5nHu+v9Gny8ie7X9OPvOE19A37/pcWtRbCiHylSJPZInvgmLZ69XRsy6xM4AOdQyTfqyRxfIxJCNWX6",
+	"IeWmQEstcSnPq/phujiQ9n0F8UrmElR+q/xfioKb7kttSaqBZHKGYAES7ZjOzy+Vkd+ElhjRRmchOgRO",
+	"UoNIITt5WHGecTfIpRZwvwKOW000P/9lQNzUBbor8PjHg8Kst2vii7wBu5FwvIJcgEav7m3RTaUzoJse",
+	"hNMsSqk+qJTYRKgooksJ0iEU6mABbui6Ki36WM4i0UvBN9lI/+78EPzdPNHVSAlljVbF8kxCtPZi8jcI",
+	"MlW+QiAuYnrHG73Te+REZgVfDLpYHAnu7wHiyxXiIs6HNGPgYqcok12zOraigFXY4l9KwsPCpcM66cyW",
+	"Yzns9oc0gfmrivjeSrzILXMdg5lkyeuc0x4/AAHyIceadx14vx7VmeYcGfkuay7VOpfCadN42PIoA7Yd",
+	"nq8Hrxs9BND+emPgb6tFbQ74ej5H3LXWjhNASD7yKPuJpJp5n+p0bAgIlErXc7hBHCxpiSqpBmHJqozy",
+	"5X2jYL/FeDUD1N+yNXGWsvM3l2oxFYLWkhY1THBMpqgW++pNWysFJPxGtUVqM813+uVc43Ok2WXgEdCR",
+	"P1xxaTwnlKHSkptmHGLQ6fEWodGv4ScZpBwNOlLHR2f6Os25JFfU7tNjLDujZ6dFHQt9XNxtex17Ne/c",
+	"tcYog4RiVDa3rnDl4UmnZitRq55COhBCR59FOkD0I4YUAqb1tyumAneVM6jGFY1/2myiAupNAkwDWwuw",
+	"wtOPqqXiKko1rEYNZ8I0ZQyQ9gYxpDEalcbUMjGeBmWUiWm4bbR9q/fYhg2+T/mrnua1PKx03O5rTcuQ",
+	"oYR3ZSTQ84W+seYNd4GT7TV+wKAYUKUG+U3IeUcD8pb4/NJrbt7r1XgOed0dW83CzGcxajaB3PXdvh2B",
+	"vTJ+VD0Y1pVy5C9uu6rO06qiJbLDx1ak/bEXbpiA1GZ3R9wnqQ2vI2oL8G/6AVJWv4pNCJYe3Ltd6H1a",
+	"2K+J1MCWjCF9G9CQ8WVofXhA9R4EnI9SUDzfdtA6Iws8wmRarTm+RqkGkurlF0OTNTpmR6TMnRuRcpcz",
+	"l2haqYrOneUz7FsDm07W09ieBJ2zmJe2nmTPvTlDuadfbt6ymEmz/0fLDV53ZN8WfcDlC55GoS2Jf9ft",
+	"U92lS9++kaxs6RBBNcGSU5brquEoTmOghqNc5uFGdIJR1xGp1p5+oFRCVpwjgnQ/VSmVJtbKXNv+N16b",
+	"/a3uTsdhJWFDPR46+l47w+r0c5s26k1c+FFk3xrB7l1T9TCgL2wlQL8H3BA24R8JBJfcxrDeWBLzKSsP",
+	"wXloXvJDFL1WsZ6ObHJeje2J4yWuIAvMUhDM0I3s1cMBZNLsLd0o2tuuY2SgXNCKco5lBYhyra8b2THP",
+	"xk8LsCYV4kq2nypXPUciFcfinUVv2U31QZPOSUWbyH5bWICK0o8cVPgjArBeWgLe1eoygrV2bzb29nxP",
+	"sTT+qXc7Lb/mVi5NvEqjaFPFOZrYgPc46v6nrM6rkFebgaSIYs2p6kCNn96g7NI4+SWKacvSDOJK2YB1",
+	"EismJfp0CC5V85nf2K9UOfiVSaJ2vZlv1FwyCgHZvNKbBZVOIblkbZuaIPm1vdlHZycnj2WcNZggLl7O",
+	"ZpKtqqHlx7rQsBxU22XtV5BsbuDmEFzWT0xXP8V1LfJx5zHW2cuUNePsDLqaTejhdbyQ/yWCrMKImYXY",
+	"rHm5pW3JzXP58XY0hzuio8KYJNnR26i3/ZCJjtr5/VMes4xUHPU71Y2LKUZKZwnx1kDjXgnSyck97P7X",
+	"sLmZrCBh9loYmsIQ5JQEvNlSitvTxuCkJWVYVx8HkEcb0ZEXM+BvNBkrEAoKdyGF9nHGhxwVEDvStPjZ",
+	"RQH9He+yXkQLXo4Ymq1J2V3eNRCd9Out9e8jE2x3AKaXndJwzKYiBO8BAprSc7Ru6VYdery3UnpKLPyG",
+	"r4W2bOko+abw795R6scKMpFmGdpRrtcKuJAqToWV31PQ5ruFk8b4gt6E5JAgUQfpm637Y0fOwWZxwdIW",
+	"w1vq+H9IVDgnVI6/eHqXBolOPenBpm3ptd+TqKEnT2PZPag2+Sh5B2qQOYVcjE0QbVpVEzj92NH2yLzx",
+	"hUKwWf1wGG6AnBnHz3LoAMCz9gi/MTzH0ivmjyCJiieHPI2GK7i3bTisvDQpLsp7a8CEXeYQqFjYXgQH",
+	"S6QCeAZ6AN33wHw/yA3oOiG8NpMPTe+7dVn2DnN4vTVGq5Qlyjx6KBZx16yqALZXVQEInn4k6l9ytTuw",
+	"iO9TxmqARJ85uQV/D9mm3F5sjZtu38DiQq51uTmqMTEreDG1CZeQwDlaIiIStpXGqe8p5rYxyz3JFs29",
+	"RqDrp+aRfmlpiDG46AG2KDcYkN/RAsOu2lUxePvLlpQaeFG56RyZF/IjEr23Mb5X5HvIxprkIffQ9S4p",
+	"p3UAe87c0EH3XMuNWAdTRGAylq7x8PnG/YLu15uisTPu0qhIdk940VsJbRhRtR9+43NBvbKtqGUH6JTo",
+	"AQNPUJxrGPjUn34DoEads9uDEE/7/lOKlZJA6loJXsdjVKr+t4V01IPlmgtgKn7V5mloV7pBQtua9XAM",
+	"/DfFROc68/otCYZeDomOL5igKV0irurZwHKJiXTec/MJL1xr3CKsUi2zUuTnyuuo4w60ciiXbStcL11m",
+	"NYDKCq/iDOQ+dW2o+uAKtVcujS82xa0+I2O0N7tQznXIvHM47FM99y083Le62S0wfD2xOrlt++PKagyT",
+	"2yh8ZFIdsoqQ1TAqlKNHgYEEYg5M4b8uMFYwL131DpKj6fB6PSEw71sQjUGUqqigF+Md6sNtitBeawcA",
+	"mLKTg/NUlpSgDXBfD7JRX7o5B+ep9CSpbJ1NQtc6EiluvF0nwpQyw/fvNHb/r5oYU8f/d3owMH9rX3xY",
+	"eSt/zRD4rN4Zhl5ox0ULF5KOjJq03bFTAnv0zRLemublOiECCmuEzITAZwbfk7hnRr8nYc/uLXLR5tEX",
+	"6UrA7spiEBJw5oHxgyHcJC3UPszsSarKuLqHbIhuHmEClTvrS5shdhkqGMLFUZ2tdrDS6WpHf5p//JHb",
+	"HIWv0BTP8BTUgwEzhi3cUsNrzPnkSEQjc65PwJOiNd7tGRWxOSL7Sk1Xn90dlst/iD40GDm1KBYUo/re",
+	"gbn4bq+a7Xt2C7irKdg3oNtRV7MSiowowuZhZ3Uee9s+ihIJiCv+QGl/J2wOxILO7kWeg3A4EuhBvuHB",
+	"3uJ1Gye6RzfpfaLi1+tSvTUPiwlc2TG+tYj9Lb73wcf3XmYoZK71zgGdVHgOu4shnpclV/kNis66b7na",
+	"hklwkB4ssELM1RFRHRgEB/SGIFbY6oWuUhWdtYZLmFjdC795Sx0QDLz3siaLiOeR3rj+zmaClPFrEXpx",
+	"e9bxYa9abuSo0xlNS9PD2rtC4EPTHdqqWO9ifGSo33hTQRJDilUFO9DB9x81u1hxHXut/cG6X4FGkHib",
+	"7waE6/XcNWzfEUzJzfWFbLeO8y6hqD15N9SkrJ/vVpXyxYPVmk0XkCPdT2lJiVhUG38aXgAEpwvV48I5",
+	"3yFvZGnIAgH1J6BcI0tNZ2v5XCW9uapGayJwZbLYPNArbDMN7tiJHEgn+GsKzvB8IQBU6f9/XyASVDKo",
+	"+5WZ1wtJ/XUjHWe4PEyadQMo2Jt5N5jl3sy84V6jNsMQ0L40uy9poco29LVpDY6YRdpQczdkKuvSlKNY",
+	"InogwzxQK8DgK+u1BzdOY7eG4RioHK3ghs5mvdF8e1ta0tulCKKtCWPpcCCJaGFXrUbQUhLYq1oy1LFh",
+	"U3qN/BTnZaFjrG4wR4BIDoE7sonfwM1vs9kDx5gVxDKIZvYXSsZ9AyULncUQUNW+2WRRzmssahUtIXQg",
+	"UnI9jX3b1u6iBB0IvERA0I+IuKz4JcSVzFxniJs893bsogbNeMiiAWqnvxRGIL7GAiETv6ian7o3gljG",
+	"GyVhTKdoJVRTI7UAvRqwhBswQRXVRRqhXgqhIhE5qSMs7YyMrueLei3qKNKCiX1jbzKJneDexBG3wyiK",
+	"2qeAy6n2ipinOe+ePowARHUwCHC6RJL0GpyJBaJdetjZwtcjDeBptH3PTVeUBpLSWQOXBTVSoo9l/85N",
+	"hHFY8EI/rW0RTCOMisWIBhLb8XR9rorqLksqklg/4ArDnB0KzTEXSsuVSKcoG4IRrnSudn+HSKYnvCdU",
+	"+4XOMXlrRu/BNg0X8g6IobRbl7x6CPyuRqsPYXLHVIWO+5CcjToStHaAOFyy04DnGcaVitT3oFsHsmNl",
+	"ztMGTd7BhjQPa4UEb4eSS42LlqmhOBdto9zPFJN9h83X9yZnu6eUu/c8nmcnf88RVx4Or3tYCNzNF+WF",
+	"BwndVt7Kx3FVKzrPuqpf9U2qSnWLWVHf6FGzbadm6BvV6laOCrSHGlYYqr7QwrWDEehTKrLULOd+bKtq",
+	"y30WVXPad2hGXdmbsCBgriYnXFT3xlb5S+pOVLlQeSOI1xlUqgGwupnWvSnqr1OTOttsqyXtiTqqse9J",
+	"BNH7ioCDevBVF/1dmSttQV1NcwZUrPAAsdW1t84VqZPjpBRHDNdROXI42Yu0hr2vKsouef65NSnUAOkw",
+	"38Sxje8Kdx5ygG94dDG628UU3zR6O+y/Baher647Zcm7RCFbDtLLgorFiT0s6n1nEPiQo5vugNLru++m",
+	"8+0Y8r6EbqOEJUIW/biqjnyVWMTiw4vjO/5i4vgecgXP28opGZCWittymYEHsjNQngrV6C/SmDrUrdAn",
+	"oYICkqEqQZnmxmU/rAKN+w/7gmtBl1DgqW0ylZpoLegbykVGd4D91z5v3Vqf+hg2o7rjoK5w6hovXOIr",
+	"eK6e5GiWEbRTzinbSKsh51Ni3LlJfGg1nrgrJtA15f13f4gxgQTJt1f7xaU9hpDZCZgRsp2vhZIe6n0I",
+	"Xga6qXHP1L3httJPe0H6q1JbB9xlsUXfiwjZyWmFkXHw44eCvQ9ZMQ5vt6UhRxlJlwzzNj8DaB8FGLuJ",
+	"wYXs32ujzr3qGQypP0xvc9Pssq7kRFRjOBGxleq5v2QW92CQ5OvNTNoBMzyywts94l5cfKRkhtmSGzwx",
+	"6pG6UBvSZ1aiG71NKStd0bYQYUN3sPlMhUwRsKTXiPu/y9ew4GBGZQV3eYRJMVSqFg8GQyn3Yy/usdOh",
+	"hDill8Xi6f9K4YeUixp4MxFUNcg5sp23D9TJ8UyPrVyXlKsazQDtYMrUIeAnmRqxolw3IYNghvkUVmCD",
+	"ICvAnNH1Slsk6k69As4VjrUSrR6p/LIaHTdyP0a5f1wkmvq85wjoCk3/35RfS2wr6Q2pKCxrm4lcnIq0",
+	"uHj3n7IMGDoE50LA6UJnYUjRmlBhWx+qKERO60+nkLENIBRA95GcHylE4Ycx+e+FOaSXZoy36ib6zSre",
+	"2TXjuqawQqSETD2WhMlIAjjVSFy+l0M7OyozvaZEaPesd631xIW18nN57sfg0c+QrCHbdFb2UmMGFpQl",
+	"/ISX6+Xo2fFJMVpiYv4oMhbYrn+VNhPdtnV7X3JgCLCJRSxzMgOLNlpqumAgPTx3RW2TvSyXMDRXISIP",
+	"9/eR+WrKr0cfMmqaneu2xIKarlsK+2eUITwn9dn7hCJcpIl2T6xTNz1+DjnOb8d+7n0TWbDFPEmV+HpS",
+	"10tV6O76MbryqJgBQVegQteo8oiVv4cZrHhHg5jq/er+DIMJgqOs9uiTOJL3HAzmqtpNMIFqJ00YaHFi",
+	"O0dNGZklawsES9NV6EJv5+AF5ivKsf62FeK+ns+RYumSHJvgD033LfnuxJDPD8+k1HE6NZeWPzS5s/KC",
+	"HXCTbDLYEyCogBVX+dpqJM2GtTwPyRwVYILVYescVh2LKBbmw3jfViwWdC1s5MRvpNqkpAFleL1BhoN6",
+	"We0KzVS9xRKVCeadjA14Zw4jj21evrC7aKakh0uldj39DOIW1UVeyVOW7dntIvQ9h6REs9TGi7ZG6tJw",
+	"yChFZ3R5u+qUv8CM5cnEpsQKBN1Vdcx9OXYeOq/aeyBhA31inZvNGx7ZMNTq4RHW9Fp7KWsFCUHlMNpq",
+	"PjJ2dvtXiuIihql+V/5p5QagEgDlaJXENv0WL4wc7yQRldQlf1tSLrzJ7HprUs0QcTWr6/cU5bxr+qzn",
+	"/pIp9Bt9aZoGz9XM8jBqZTUkB13kWN9sNh3Qp6Xnj63sV1dl14CMrQ6gACBc1nedS+JxDev0u6EaliyH",
+	"DDDhuERNgN6Wg+y4yPK+2Eh9GVIxqLHOb73gb/lJkmGGeoE7/3HO+X9xite7Omu6oXhh/vXpXXFyGIv7",
+	"M9BzzWOE/sEy36xl9/FhAecHU7qUmqZVB3vZcKCdWHO8lwtU2+3l3IUBJYlIjCNVa6uwqVl16RP72pqo",
+	"5w1N6RC8NfMoxsxl1AgMptE1ViT4q4yyqWlYrh6pnAa5rElDP5MPTcfzSV3cUP4cZbBXcH5Rn1Uef72C",
+	"c43s6kOULu1/WfJO3rl9sf+/L5DK1bBXRRAqm7ekjsG/DR/LYVUl1q2SRLLJ0xWcv1YfRBaZlkPssr8p",
+	"id+URI+vGrD4evTDKHWJ9ZeBc+CR7IfLoBILzWFJuzC5NSi909gcma9f9Sh/j43uEJwbyAs5kaKhCtqV",
+	"YqDrjWla2tICHUHLVAADRSxbG7yC811pgt8o8DcK/BehwAPtc5JgfBnWOW+lQwjw0Z9KNP18VCuLSZr8",
+	"nCH4kae0gjBQh3YSXeVpCkMTQiqcks7N60MJnoDzeIiT2vztqNk3svqNrD58sppY61/FR9+mHQnBm/s0",
+	"1e7zDljAHUSa9m4vyTdo1cEVZJaRYQryPdPAdgoJUME62Bb/qeN3nO9lhdgSc44p4XUF3zmDREREXjnP",
+	"W7WSu+gxJ2fKaS53Zfe9z+I2t8r/Mkfmrlb9rS+Ww2tM5kNbfZqvwJxK9WpIo893+ssf5Yf5eY5ynn03",
+	"/FRzdGZSuofteC6XZOlUwFExghNISkpQmRXjtedmkvXwkHM6xTqtaf81/SVPM+kTEyQZoW2jg0obhx23",
+	"lsrvpKPqufpqV3zfX4+O7hy4nHP50S2loG99PVM01yMPQ3t7BjTprvt7hpPXpNbsJ7vDpz+OYZIaYLX4",
+	"pwxcjtKWCJaVdjjEMmq9s9xT7oI3wz3ly/p7jCny/nF+iT1BfXiIgpXHwwcUZQrALJ5GqV9uwtBXlbHa",
+	"e7i5eaoZx/kjEp1nOb4XlHjIGafRQ43S1S4ZMtjuXWaYmg5+DRiLpYU+XDp9P0D59WZ4DqbmR/LwGZ6s",
+	"u9uKxZW04FvdN7JuYdkJl56idhEsIFth85Km+9v+q5d3GZOGSJk7946j4Thdsynq16v0e+d30lVqiODt",
+	"X/cQ4TuE04eHgGqdUYTolwHuhb9QnmofqAVDfy/O95LDc87LMnbXe2U8/kz3qyiE8N2GZ/+5bKLxdbGh",
+	"87JsAc5wnrRiVPfF7GNHup8zKoH9AmCiaaucO4p/KaH5jZ1z/zKKm6pPTnHn8AXIz6v6/B4GiWtBldfU",
+	"tROyVKN4rgQc/xPwaELFokHipbVEGlFKBm9gxR9vKwRd+WvL9jg3Rt9DK23PrFmvEMjPwKMAzynzziEl",
+	"CqnpYsbtacgo6pGG2LWDy7J59Zjfj4wYLMaYpYesZpdSY7AW3WhK2/rmyhAkjwmqG0T/XMNKO/AxB9ew",
+	"Wqes1UtMtG83vsBZRaGoV6iN0NussFKxHcOXBz/tZHm7sKMb7/Xo2cm42L9R3c0Wm+zD/nmbR8j66lUG",
+	"ly7pZoukfdmykNpna5NwC3nI4zADNXTvS11FbAve9Hdv9m/qec/MJeLChir36ujeyw9PUa+vfYia7oPq",
+	"A0XJGE58STq6TWKC3k7MRoar6N4t71VBr+e5X/Xch+o2FNdP6x7fX5l23oSZPG6EIJsukrzn1bqqDmQZ",
+	"GqBfBHDKKOeNrFWvFwspNWOcIcYPgUrnQjxQMbwJ6k+N8ZMXdVSZxC0zIJx7fzHDDhlUscMLLIxgucDz",
+	"RSW7p6NSdyeKVfh6p/fbx+0Y0h2ngKLrBbhReMkXlDmpWizkSzLwEk7lMDorY04oS8Zh/vN2KttbJJ9N",
+	"tQip9m0icuf4GhGAiMBio1Q4nqum5bENdWY/YXElP8/IKPyrCgt/zeCcftB5q5YZLdCqkdgQFwnTDzFR",
+	"Qq9OhJYcR0/VU0NO9Y89FqiGJK9z1AxzlwNockaoCYfUZI9QoICFRwV6mUZ8J4GtV3CeIzS+81grYGbf",
+	"ipIPvuBb6Wj6WOxVXUEr8vX3otE5H7HYqCs4N3R81xLVFZzfkwylrjWRS7qT0Ke7akGnr61x4RYzjyTY",
+	"Hv0p//u5MyBdo+FEM9Mkwj3fXOnHDVkiliikX0wz/57L0Zz3brTETBT/7X9+FVac8KIzAOfIJOAc/an/",
+	"cVl+zkhuUPP4DXH1x16GWRrWtFr40Ei8XE+YqvZVwIO9K8mc7b5iHKSzqIf0otQ3O3dJ3Ykswt0Qh47U",
+	"Qk9dcypXESiI9V9N54/9/ca3J0R2YbFhN6aQXzD5yD3lRpg6KTW0FeAjQitbwlc9x8K1X1lAfgjOS12L",
+	"TaOX+9J/SSpwZI5UR/EFJvNDcOVKwizgta6cJbz71EMcxgwvDTzdi3wgz2WPcXjfCESPkcXCYSdt6Gy9",
+	"qTtaSYgMzqZOa9MATwnihbL7LVdioxUGhnSTgro4z3JreH2HxDd4/brh1YBcJk/LlHVsxn9XssB7Ujny",
+	"LQHddbkxwAjeSkCOkuaSalqsgblJnFtQrAZCDpBHXxpjzljEVny1e0qdYi5PbldlDT7cKsHjC8YweYgA",
+	"1lCegV95qTaS9MtRuzJsrCXir3fw5oyi2n6OPr6zftraL0GZ6u+1qVSGlIDzQ/CregAZAmuC/7lGrsqK",
+	"Ys6uPLAhSL5/T740hUQSwYklgWWqL9jDMUaN78IY9a37dr+RSwWWLBGbo3Tb7df02omi2yqHRUQ1VIBt",
+	"q4H8y9R1W1eO0Tkh13AhaHNUPXmYw6VBDKzEYN/wbnp1lSYbUb6udqoE8zaOvJbPMuSCFnfUx7cjZ/+e",
+	"sFNt7v5Q1Eyf8uxIbDVX8xX52dWmDb/HRAKwFE0Rk3CaQMmBscmBa2iGCSRTDBvxysYJr5BlwwVaFqZC",
+	"lGQfoWv+v4hzzhe1Zz6O0Fx3GGljNT/8LxK3gg8Ibs6I5b3/+N0vu+55OqY7MY/32lUqgtuDJzWKywUw",
+	"wCQ/0hDzRyPY2/68XdD3ZANMvXUTMnw3ActyWvgpY1r4acfT7qV0y/YhCWkW5yKRn+wv7jlj9ruOg94y",
+	"ANrjeLtpuskYZdF2m1AaEow44Djcfue8JAIxAivAEZONU5B5MVroJBFAETARj2nOEOvkmJGgaPfdoLpS",
+	"V262/CDoPeTq3lvs8bf4rW/FlbKI3wyxoZWVaky+46pKwkPqgNKo33JrKtlRwASJG4Qc9nHwqJa4XWnO",
+	"KSXXiHFMyeNU7FAtNO1FKzTD31cUkd1dTB+0J/klllJaUoI2wJN4YxAVMC5n5c2rAlTDWaoCUAA5+5Rw",
+	"+u7vIdf9aR1jAvM7fUR2jF2mK4dwccRoVclK/r6BruHYMm94174Pq/Y+iJBd+nAi1EASM06osafJx1l7",
+	"iN8YnmMSmk+Ud9ED4qftzzyJ1MWKeP0Xmk4gu04IrvopxHo1pUtM5gcT1e49RSFeflpBUmobjy4QCpjr",
+	"FK8+1fYn+Vz/OYNVJR+Wa2R7z5nbRaXtVUFZiZju6i5fM3JRWyJ+bxapW9L3SsWqHrgZzytXnl+be1fV",
+	"wfvWcDqWFcSt3cnMuoNi4ftUO/276NM7LXBpkHiAXFUttLFKv260hXANeAZjeJciWIdYqve8GEvdsJ03",
+	"q9S3C0ljpsscx4tGv+daiNy/jC1nyi0avebe1tyWHmoVaZFecX37+qC9Oz9aIu/aWxLRhb5Q+dk+hSJ9",
+	"K/FbgGuxQETIgWW1e3N/+8MjiR9YbBQRfo4gQ+x8LRajZ79/+PzBP/QfjX4ZWV/3eR+5iznQ6KMklJjr",
+	"+zl27Kk1ifbs6QGS6Fc4qJD/kJqV4IAS3fbIvSUbKpmBsI/clJhvoVA9eE0XJVguMelAeTmAfLTkqLrW",
+	"fWudB2cGsOBmMl4A/auG2v+mmABlGmB0PV8ATK6xUMAUJRrkowebr/U57kfWqifYc6DdNhgif/euTDtr",
+	"l5PhSDKETD0Mj30uov5i4DGJRDXoLi0cpfHX6hb6iu8wCsaEttZdHFSkodpFChV1/816ezKYm0tsrpAU",
+	"5SR+K2SORq7KrdfNHPaDVmr8h4hRSmyRpEpaJrzmEftyqn9puOflbYoYSMZQKANnlYNDo9KaVaNno4UQ",
+	"q2dHRxWdwmpBuXj2dPz0ePT5w+f/OwDyFmjqS7oCAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file